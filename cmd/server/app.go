@@ -34,11 +34,14 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	account_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/account"
 	album_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album"
 	album_category_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album_category"
 	article_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article"
 	article_history_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article_history"
+	audit_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/audit"
 	auth_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/auth"
+	blocklist_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/blocklist"
 	captcha_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/captcha"
 	comment_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/comment"
 	config_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/config"
@@ -58,6 +61,7 @@ import (
 	public_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/public"
 	search_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/search"
 	setting_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/setting"
+	share_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/share"
 	sitemap_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/sitemap"
 	statistics_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/statistics"
 	storage_policy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/storage_policy"
@@ -66,12 +70,17 @@ import (
 	thumbnail_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/thumbnail"
 	user_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/user"
 	version_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/version"
+	webdav_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/webdav"
+	webmention_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/webmention"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	account_service "github.com/anzhiyu-c/anheyu-app/pkg/service/account"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/album"
 	album_category_service "github.com/anzhiyu-c/anheyu-app/pkg/service/album_category"
 	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
 	article_history_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_history"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/blocklist"
 	captcha_service "github.com/anzhiyu-c/anheyu-app/pkg/service/captcha"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/cdn"
 	cleanup_service "github.com/anzhiyu-c/anheyu-app/pkg/service/cleanup"
@@ -89,6 +98,7 @@ import (
 	link_service "github.com/anzhiyu-c/anheyu-app/pkg/service/link"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/music"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
+	oauth_service "github.com/anzhiyu-c/anheyu-app/pkg/service/oauth"
 	page_service "github.com/anzhiyu-c/anheyu-app/pkg/service/page"
 	parser_service "github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
 	post_category_service "github.com/anzhiyu-c/anheyu-app/pkg/service/post_category"
@@ -96,6 +106,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/process"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/search"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	share_service "github.com/anzhiyu-c/anheyu-app/pkg/service/share"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/sitemap"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/statistics"
 	subscriber_service "github.com/anzhiyu-c/anheyu-app/pkg/service/subscriber"
@@ -106,6 +117,8 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume/strategy"
+	webdav_service "github.com/anzhiyu-c/anheyu-app/pkg/service/webdav"
+	webmention_service "github.com/anzhiyu-c/anheyu-app/pkg/service/webmention"
 
 	_ "github.com/anzhiyu-c/anheyu-app/ent/runtime"
 )
@@ -212,6 +225,14 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	settingRepo := ent_impl.NewEntSettingRepository(entClient)
 	userRepo := ent_impl.NewEntUserRepository(entClient)
 	userGroupRepo := ent_impl.NewEntUserGroupRepository(entClient)
+	userTwoFactorRepo := ent_impl.NewUserTwoFactorRepo(entClient)
+	passkeyRepo := ent_impl.NewPasskeyRepo(entClient)
+	userIdentityRepo := ent_impl.NewUserIdentityRepo(entClient)
+	accessTokenRepo := ent_impl.NewAccessTokenRepo(entClient)
+	invitationCodeRepo := ent_impl.NewInvitationCodeRepo(entClient)
+	shareRepo := ent_impl.NewShareRepo(entClient)
+	accountDeletionRepo := ent_impl.NewAccountDeletionRepo(entClient)
+	userSessionRepo := ent_impl.NewUserSessionRepo(entClient)
 	fileRepo := ent_impl.NewEntFileRepository(entClient, sqlDB, dbType)
 	entityRepo := ent_impl.NewEntEntityRepository(entClient)
 	fileEntityRepo := ent_impl.NewEntFileEntityRepository(entClient)
@@ -235,6 +256,10 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	notificationTypeRepo := ent_impl.NewEntNotificationTypeRepository(entClient)
 	userNotificationConfigRepo := ent_impl.NewEntUserNotificationConfigRepository(entClient)
 	giveMoneyRepo := ent_impl.NewGiveMoneyRepository(entClient)
+	blockRuleRepo := ent_impl.NewBlockRuleRepo(entClient)
+	commentThreadRepo := ent_impl.NewCommentThreadRepo(entClient)
+	webmentionRepo := ent_impl.NewWebmentionRepo(entClient)
+	auditLogRepo := ent_impl.NewAuditLogRepo(entClient)
 	essayRepo := ent_impl.NewEssayRepository(entClient)
 
 	// --- Phase 4: 初始化应用引导程序 ---
@@ -267,11 +292,17 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	strategyManager.Register(constant.PolicyTypeAliOSS, strategy.NewAliyunOSSStrategy())
 	strategyManager.Register(constant.PolicyTypeS3, strategy.NewAWSS3Strategy())
 	strategyManager.Register(constant.PolicyTypeQiniu, strategy.NewQiniuKodoStrategy())
+	strategyManager.Register(constant.PolicyTypeSFTP, strategy.NewSFTPStrategy())
+	strategyManager.Register(constant.PolicyTypeWebDAV, strategy.NewWebDAVStrategy())
 
 	// 使用智能缓存工厂，自动选择 Redis 或内存缓存
 	cacheSvc := utility.NewCacheServiceWithFallback(redisClient)
 
-	tokenSvc := auth.NewTokenService(userRepo, settingSvc, cacheSvc)
+	tokenSvc := auth.NewTokenService(userRepo, settingSvc, cacheSvc, userSessionRepo)
+	sessionSvc := auth.NewSessionService(userSessionRepo, cacheSvc)
+	twoFactorSvc := auth.NewTwoFactorService(userTwoFactorRepo, userRepo, tokenSvc, settingSvc, cacheSvc)
+	passkeySvc := auth.NewPasskeyService(passkeyRepo, userRepo, settingSvc, cacheSvc)
+	accessTokenSvc := auth.NewAccessTokenService(accessTokenRepo, userRepo)
 	geoSvc, err := utility.NewGeoIPService(settingSvc)
 	if err != nil {
 		log.Printf("警告: GeoIP 服务初始化失败: %v。IP属地将显示为'未知'", err)
@@ -287,12 +318,14 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	storageProviders[constant.PolicyTypeAliOSS] = storage.NewAliOSSProvider()
 	storageProviders[constant.PolicyTypeS3] = storage.NewAWSS3Provider()
 	storageProviders[constant.PolicyTypeQiniu] = storage.NewQiniuKodoProvider()
+	storageProviders[constant.PolicyTypeSFTP] = storage.NewSFTPProvider(localSigningSecret)
+	storageProviders[constant.PolicyTypeWebDAV] = storage.NewWebDAVProvider(localSigningSecret)
 	metadataSvc := file_info.NewMetadataService(metadataRepo)
 	postTagSvc := post_tag_service.NewService(postTagRepo)
 	postCategorySvc := post_category_service.NewService(postCategoryRepo, articleRepo)
 	docSeriesSvc := doc_series_service.NewService(docSeriesRepo)
 	cleanupSvc := cleanup_service.NewCleanupService(cleanupRepo)
-	userSvc := user.NewUserService(userRepo, userGroupRepo)
+	userSvc := user.NewUserService(userRepo, userGroupRepo, sessionSvc)
 	storagePolicySvc := volume.NewStoragePolicyService(storagePolicyRepo, fileRepo, txManager, strategyManager, settingSvc, cacheSvc, storageProviders)
 	thumbnailSvc := thumbnail.NewThumbnailService(metadataSvc, fileRepo, entityRepo, storagePolicySvc, settingSvc, storageProviders)
 	if err != nil {
@@ -302,8 +335,11 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	syncSvc := process.NewSyncService(txManager, fileRepo, entityRepo, fileEntityRepo, storagePolicySvc, eventBus, storageProviders, settingSvc)
 	vfsSvc := volume.NewVFSService(storagePolicySvc, cacheSvc, fileRepo, entityRepo, settingSvc, storageProviders)
 	extractionSvc := file_info.NewExtractionService(fileRepo, settingSvc, metadataSvc, vfsSvc)
-	fileSvc := file_service.NewService(fileRepo, storagePolicyRepo, txManager, entityRepo, fileEntityRepo, userGroupRepo, metadataSvc, extractionSvc, cacheSvc, storagePolicySvc, settingSvc, syncSvc, vfsSvc, storageProviders, eventBus, pathLocker)
-	uploadSvc := file_service.NewUploadService(txManager, eventBus, entityRepo, metadataSvc, cacheSvc, storagePolicySvc, settingSvc, storageProviders)
+	quotaSvc := file_service.NewQuotaService(userRepo)
+	fileSvc := file_service.NewService(fileRepo, storagePolicyRepo, txManager, entityRepo, fileEntityRepo, userGroupRepo, metadataSvc, extractionSvc, cacheSvc, storagePolicySvc, settingSvc, syncSvc, vfsSvc, storageProviders, eventBus, pathLocker, quotaSvc, shareRepo)
+	uploadSvc := file_service.NewUploadService(txManager, eventBus, entityRepo, metadataSvc, cacheSvc, storagePolicySvc, settingSvc, storageProviders, quotaSvc, fileSvc)
+	policyMigrationSvc := file_service.NewPolicyMigrationService(txManager, entityRepo, storagePolicySvc, cacheSvc, fileSvc)
+	archiveSvc := file_service.NewArchiveService(fileSvc, uploadSvc, vfsSvc, quotaSvc, settingSvc, cacheSvc)
 	directLinkSvc := direct_link.NewDirectLinkService(directLinkRepo, fileRepo, userGroupRepo, settingSvc, storagePolicyRepo)
 	statService, err := statistics.NewVisitorStatService(
 		ent_impl.NewVisitorStatRepository(entClient),
@@ -334,8 +370,10 @@ func NewApp(content embed.FS) (*App, func(), error) {
 
 	// 初始化文章历史版本服务（需要在taskBroker之前创建，用于定时清理任务）
 	articleHistorySvc := article_history_service.NewService(articleHistoryRepo, articleRepo, userRepo)
+	// 初始化审计日志服务（需要在taskBroker之前创建，用于定时清理任务）
+	auditSvc := audit.NewService(auditLogRepo, userRepo, settingSvc)
 	// 初始化任务调度器
-	taskBroker := task.NewBroker(uploadSvc, thumbnailSvc, cleanupSvc, articleRepo, commentRepo, emailSvc, cacheSvc, linkCategoryRepo, linkTagRepo, linkRepo, settingSvc, statService, articleHistorySvc, entClient, redisClient)
+	taskBroker := task.NewBroker(uploadSvc, thumbnailSvc, cleanupSvc, articleRepo, commentRepo, emailSvc, cacheSvc, linkCategoryRepo, linkTagRepo, linkRepo, settingSvc, statService, articleHistorySvc, auditSvc, fileSvc, fileSvc, entClient, redisClient)
 	pageSvc := page_service.NewService(pageRepo)
 
 	// 初始化搜索服务
@@ -398,6 +436,9 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	articleSvc := article_service.NewService(articleRepo, postTagRepo, postCategoryRepo, commentRepo, docSeriesRepo, pageRepo, txManager, cacheSvc, geoSvc, taskBroker, settingSvc, parserSvc, fileSvc, directLinkSvc, searchSvc, primaryColorSvc, cdnSvc, subscriberSvc, userRepo)
 	// 注入文章历史版本仓储
 	articleSvc.SetHistoryRepo(articleHistoryRepo)
+	// 注入 Webmention 服务
+	webmentionSvc := webmention_service.NewService(webmentionRepo, articleRepo, settingSvc, taskBroker)
+	articleSvc.SetWebmentionService(webmentionSvc)
 	// articleHistorySvc 已在 taskBroker 之前创建
 	log.Printf("[DEBUG] 正在初始化 PushooService...")
 	pushooSvc := utility.NewPushooService(settingSvc)
@@ -407,10 +448,20 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	linkSvc := link_service.NewService(linkRepo, linkCategoryRepo, linkTagRepo, txManager, taskBroker, settingSvc, pushooSvc, emailSvc, eventBus)
 	log.Printf("[DEBUG] LinkService 初始化完成，PushooService、EmailService 和 EventBus 已注入")
 
-	authSvc := auth.NewAuthService(userRepo, settingSvc, tokenSvc, emailSvc, txManager, articleSvc)
+	invitationSvc := auth.NewInvitationService(invitationCodeRepo, userRepo, userGroupRepo, emailSvc)
+	authSvc := auth.NewAuthService(userRepo, settingSvc, tokenSvc, emailSvc, txManager, articleSvc, sessionSvc, invitationSvc)
+	oauthSvc := oauth_service.NewService(userIdentityRepo, userRepo, authSvc, settingSvc, cacheSvc)
+	loginGuardSvc := auth.NewLoginGuardService(cacheSvc, userRepo, emailSvc)
+	blocklistSvc := blocklist.NewService(blockRuleRepo)
 	log.Printf("[DEBUG] 正在初始化 CommentService，将注入 PushooService 和 NotificationService...")
-	commentSvc := comment_service.NewService(commentRepo, userRepo, txManager, geoSvc, settingSvc, cacheSvc, taskBroker, fileSvc, parserSvc, pushooSvc, notificationSvc)
+	commentSvc := comment_service.NewService(commentRepo, userRepo, txManager, geoSvc, settingSvc, cacheSvc, taskBroker, fileSvc, parserSvc, pushooSvc, notificationSvc, tokenSvc, eventBus, blocklistSvc, commentThreadRepo, articleRepo, pageRepo)
+	commentStreamHub := comment_service.NewStreamHub(commentSvc, eventBus, redisClient)
 	log.Printf("[DEBUG] CommentService 初始化完成，PushooService 和 NotificationService 已注入")
+	accountSvc := account_service.NewService(userRepo, accountDeletionRepo, articleRepo, fileRepo, userNotificationConfigRepo, userIdentityRepo, passkeyRepo, userTwoFactorRepo, articleSvc, commentSvc, sessionSvc, accessTokenSvc, settingSvc)
+	shareSvc := share_service.NewService(shareRepo, fileRepo, userRepo, userGroupRepo, fileSvc, settingSvc)
+	webdavSvc := webdav_service.NewService(authSvc, accessTokenSvc, twoFactorSvc, userRepo, fileRepo, fileSvc, uploadSvc, vfsSvc)
+	// 账户注销服务依赖文章和评论服务，只能在 taskBroker 创建之后注入
+	taskBroker.SetAccountDeletionProcessor(accountSvc)
 	themeSvc := theme.NewThemeService(entClient, userRepo)
 	_ = listener.NewFilePostProcessingListener(eventBus, taskBroker, extractionSvc)
 
@@ -465,26 +516,26 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	log.Printf("[DEBUG] FCircleService 初始化完成")
 
 	// --- Phase 6: 初始化表现层 (Handlers) ---
-	mw := middleware.NewMiddleware(tokenSvc)
-	authHandler := auth_handler.NewAuthHandler(authSvc, tokenSvc, settingSvc, captchaSvc)
+	mw := middleware.NewMiddleware(tokenSvc, accessTokenSvc, auditSvc)
+	authHandler := auth_handler.NewAuthHandler(authSvc, tokenSvc, settingSvc, captchaSvc, twoFactorSvc, passkeySvc, oauthSvc, loginGuardSvc)
 	albumHandler := album_handler.NewAlbumHandler(albumSvc)
 	albumCategoryHandler := album_category_handler.NewHandler(albumCategorySvc)
-	userHandler := user_handler.NewUserHandler(userSvc, settingSvc, fileSvc, directLinkSvc)
+	userHandler := user_handler.NewUserHandler(userSvc, settingSvc, fileSvc, directLinkSvc, twoFactorSvc, accessTokenSvc, sessionSvc, invitationSvc)
 	publicHandler := public_handler.NewPublicHandler(albumSvc, albumCategorySvc)
 	settingHandler := setting_handler.NewSettingHandler(settingSvc, emailSvc, cdnSvc, configBackupSvc)
-	storagePolicyHandler := storage_policy_handler.NewStoragePolicyHandler(storagePolicySvc)
+	storagePolicyHandler := storage_policy_handler.NewStoragePolicyHandler(storagePolicySvc, policyMigrationSvc)
 	giveMoneyHandler := givemoney_handler.NewGiveMoneyHandler(giveMoneySvc)
 	essayHandler := essay_handler.NewHandler(easySvc)
-	fileHandler := file_handler.NewHandler(fileSvc, uploadSvc, settingSvc)
+	fileHandler := file_handler.NewHandler(fileSvc, uploadSvc, settingSvc, quotaSvc, archiveSvc)
 	directLinkHandler := direct_link_handler.NewDirectLinkHandler(directLinkSvc, storageProviders)
-	linkHandler := link_handler.NewHandler(linkSvc)
+	linkHandler := link_handler.NewHandler(linkSvc, blocklistSvc)
 	thumbnailHandler := thumbnail_handler.NewThumbnailHandler(taskBroker, metadataSvc, fileSvc, thumbnailSvc, settingSvc)
 	articleHandler := article_handler.NewHandler(articleSvc)
 	articleHistoryHandler := article_history_handler.NewHandler(articleHistorySvc)
 	postTagHandler := post_tag_handler.NewHandler(postTagSvc)
 	postCategoryHandler := post_category_handler.NewHandler(postCategorySvc)
 	docSeriesHandler := doc_series_handler.NewHandler(docSeriesSvc)
-	commentHandler := comment_handler.NewHandler(commentSvc)
+	commentHandler := comment_handler.NewHandler(commentSvc, commentStreamHub)
 	pageHandler := page_handler.NewHandler(pageSvc)
 	searchHandler := search_handler.NewHandler(searchSvc)
 	statisticsHandler := statistics_handler.NewStatisticsHandler(statService)
@@ -496,7 +547,13 @@ func NewApp(content embed.FS) (*App, func(), error) {
 	notificationHandler := notification_handler.NewHandler(notificationSvc)
 	configBackupHandler := config_handler.NewConfigBackupHandler(configBackupSvc)
 	configImportExportHandler := config_handler.NewConfigImportExportHandler(configImportExportSvc)
-	subscriberHandler := subscriber_handler.NewHandler(subscriberSvc, captchaSvc)
+	blocklistHandler := blocklist_handler.NewHandler(blocklistSvc)
+	webmentionHandler := webmention_handler.NewHandler(webmentionSvc)
+	auditHandler := audit_handler.NewHandler(auditSvc)
+	accountHandler := account_handler.NewHandler(accountSvc)
+	webdavHandler := webdav_handler.NewHandler(webdavSvc, loginGuardSvc, quotaSvc)
+	shareHandler := share_handler.NewHandler(shareSvc, fileSvc, loginGuardSvc)
+	subscriberHandler := subscriber_handler.NewHandler(subscriberSvc, captchaSvc, blocklistSvc, loginGuardSvc)
 	captchaHandler := captcha_handler.NewHandler(captchaSvc)
 	fcircleHandler := fcircle_handler.NewHandler(fcircleSvc, redisClient, linkRepo)
 
@@ -536,6 +593,12 @@ func NewApp(content embed.FS) (*App, func(), error) {
 		subscriberHandler,
 		captchaHandler,
 		fcircleHandler,
+		blocklistHandler,
+		webmentionHandler,
+		auditHandler,
+		accountHandler,
+		webdavHandler,
+		shareHandler,
 	)
 
 	// --- Phase 8: 配置 Gin 引擎 ---
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/accesstoken"
+)
+
+// 个人访问令牌表
+type AccessToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 所属用户ID
+	UserID uint `json:"user_id,omitempty"`
+	// 令牌名称，用于区分用途
+	Name string `json:"name,omitempty"`
+	// 令牌的 SHA-256 哈希，明文只在创建时返回一次
+	TokenHash string `json:"-"`
+	// 令牌明文的前几位，用于在列表中辨认
+	TokenPrefix string `json:"token_prefix,omitempty"`
+	// 授权范围
+	Scopes []string `json:"scopes,omitempty"`
+	// 过期时间，为空表示永不过期
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// 最近一次使用时间
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// 最近一次使用的IP
+	LastUsedIP   string `json:"last_used_ip,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccessToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accesstoken.FieldScopes:
+			values[i] = new([]byte)
+		case accesstoken.FieldID, accesstoken.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case accesstoken.FieldName, accesstoken.FieldTokenHash, accesstoken.FieldTokenPrefix, accesstoken.FieldLastUsedIP:
+			values[i] = new(sql.NullString)
+		case accesstoken.FieldCreatedAt, accesstoken.FieldUpdatedAt, accesstoken.FieldExpiresAt, accesstoken.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccessToken fields.
+func (at *AccessToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accesstoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			at.ID = uint(value.Int64)
+		case accesstoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				at.CreatedAt = value.Time
+			}
+		case accesstoken.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				at.UpdatedAt = value.Time
+			}
+		case accesstoken.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				at.UserID = uint(value.Int64)
+			}
+		case accesstoken.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				at.Name = value.String
+			}
+		case accesstoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				at.TokenHash = value.String
+			}
+		case accesstoken.FieldTokenPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_prefix", values[i])
+			} else if value.Valid {
+				at.TokenPrefix = value.String
+			}
+		case accesstoken.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &at.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case accesstoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				at.ExpiresAt = new(time.Time)
+				*at.ExpiresAt = value.Time
+			}
+		case accesstoken.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				at.LastUsedAt = new(time.Time)
+				*at.LastUsedAt = value.Time
+			}
+		case accesstoken.FieldLastUsedIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_ip", values[i])
+			} else if value.Valid {
+				at.LastUsedIP = value.String
+			}
+		default:
+			at.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccessToken.
+// This includes values selected through modifiers, order, etc.
+func (at *AccessToken) Value(name string) (ent.Value, error) {
+	return at.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AccessToken.
+// Note that you need to call AccessToken.Unwrap() before calling this method if this AccessToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (at *AccessToken) Update() *AccessTokenUpdateOne {
+	return NewAccessTokenClient(at.config).UpdateOne(at)
+}
+
+// Unwrap unwraps the AccessToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (at *AccessToken) Unwrap() *AccessToken {
+	_tx, ok := at.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccessToken is not a transactional entity")
+	}
+	at.config.driver = _tx.drv
+	return at
+}
+
+// String implements the fmt.Stringer.
+func (at *AccessToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccessToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", at.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(at.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(at.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", at.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(at.Name)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("token_prefix=")
+	builder.WriteString(at.TokenPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", at.Scopes))
+	builder.WriteString(", ")
+	if v := at.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := at.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_ip=")
+	builder.WriteString(at.LastUsedIP)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccessTokens is a parsable slice of AccessToken.
+type AccessTokens []*AccessToken
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/accountdeletion"
+)
+
+// 账户注销申请表
+type AccountDeletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 申请时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 申请注销的用户ID
+	UserID uint `json:"user_id,omitempty"`
+	// 已发布内容的处理方式：anonymize-匿名化保留, delete-一并删除
+	ContentMode accountdeletion.ContentMode `json:"content_mode,omitempty"`
+	// 计划执行注销的时间
+	ScheduledAt  time.Time `json:"scheduled_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountDeletion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accountdeletion.FieldID, accountdeletion.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case accountdeletion.FieldContentMode:
+			values[i] = new(sql.NullString)
+		case accountdeletion.FieldCreatedAt, accountdeletion.FieldScheduledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountDeletion fields.
+func (ad *AccountDeletion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accountdeletion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ad.ID = uint(value.Int64)
+		case accountdeletion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ad.CreatedAt = value.Time
+			}
+		case accountdeletion.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ad.UserID = uint(value.Int64)
+			}
+		case accountdeletion.FieldContentMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_mode", values[i])
+			} else if value.Valid {
+				ad.ContentMode = accountdeletion.ContentMode(value.String)
+			}
+		case accountdeletion.FieldScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_at", values[i])
+			} else if value.Valid {
+				ad.ScheduledAt = value.Time
+			}
+		default:
+			ad.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountDeletion.
+// This includes values selected through modifiers, order, etc.
+func (ad *AccountDeletion) Value(name string) (ent.Value, error) {
+	return ad.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AccountDeletion.
+// Note that you need to call AccountDeletion.Unwrap() before calling this method if this AccountDeletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ad *AccountDeletion) Update() *AccountDeletionUpdateOne {
+	return NewAccountDeletionClient(ad.config).UpdateOne(ad)
+}
+
+// Unwrap unwraps the AccountDeletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ad *AccountDeletion) Unwrap() *AccountDeletion {
+	_tx, ok := ad.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountDeletion is not a transactional entity")
+	}
+	ad.config.driver = _tx.drv
+	return ad
+}
+
+// String implements the fmt.Stringer.
+func (ad *AccountDeletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountDeletion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ad.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ad.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ad.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("content_mode=")
+	builder.WriteString(fmt.Sprintf("%v", ad.ContentMode))
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_at=")
+	builder.WriteString(ad.ScheduledAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountDeletions is a parsable slice of AccountDeletion.
+type AccountDeletions []*AccountDeletion
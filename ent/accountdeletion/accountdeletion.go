@@ -0,0 +1,105 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountdeletion
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the accountdeletion type in the database.
+	Label = "account_deletion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldContentMode holds the string denoting the content_mode field in the database.
+	FieldContentMode = "content_mode"
+	// FieldScheduledAt holds the string denoting the scheduled_at field in the database.
+	FieldScheduledAt = "scheduled_at"
+	// Table holds the table name of the accountdeletion in the database.
+	Table = "account_deletions"
+)
+
+// Columns holds all SQL columns for accountdeletion fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUserID,
+	FieldContentMode,
+	FieldScheduledAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// ContentMode defines the type for the "content_mode" enum field.
+type ContentMode string
+
+// ContentModeAnonymize is the default value of the ContentMode enum.
+const DefaultContentMode = ContentModeAnonymize
+
+// ContentMode values.
+const (
+	ContentModeAnonymize ContentMode = "anonymize"
+	ContentModeDelete    ContentMode = "delete"
+)
+
+func (cm ContentMode) String() string {
+	return string(cm)
+}
+
+// ContentModeValidator is a validator for the "content_mode" field enum values. It is called by the builders before save.
+func ContentModeValidator(cm ContentMode) error {
+	switch cm {
+	case ContentModeAnonymize, ContentModeDelete:
+		return nil
+	default:
+		return fmt.Errorf("accountdeletion: invalid enum value for content_mode field: %q", cm)
+	}
+}
+
+// OrderOption defines the ordering options for the AccountDeletion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByContentMode orders the results by the content_mode field.
+func ByContentMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentMode, opts...).ToFunc()
+}
+
+// ByScheduledAt orders the results by the scheduled_at field.
+func ByScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledAt, opts...).ToFunc()
+}
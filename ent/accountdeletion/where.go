@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accountdeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldUserID, v))
+}
+
+// ScheduledAt applies equality check predicate on the "scheduled_at" field. It's identical to ScheduledAtEQ.
+func ScheduledAt(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldUserID, v))
+}
+
+// ContentModeEQ applies the EQ predicate on the "content_mode" field.
+func ContentModeEQ(v ContentMode) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldContentMode, v))
+}
+
+// ContentModeNEQ applies the NEQ predicate on the "content_mode" field.
+func ContentModeNEQ(v ContentMode) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldContentMode, v))
+}
+
+// ContentModeIn applies the In predicate on the "content_mode" field.
+func ContentModeIn(vs ...ContentMode) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldContentMode, vs...))
+}
+
+// ContentModeNotIn applies the NotIn predicate on the "content_mode" field.
+func ContentModeNotIn(vs ...ContentMode) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldContentMode, vs...))
+}
+
+// ScheduledAtEQ applies the EQ predicate on the "scheduled_at" field.
+func ScheduledAtEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtNEQ applies the NEQ predicate on the "scheduled_at" field.
+func ScheduledAtNEQ(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNEQ(FieldScheduledAt, v))
+}
+
+// ScheduledAtIn applies the In predicate on the "scheduled_at" field.
+func ScheduledAtIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtNotIn applies the NotIn predicate on the "scheduled_at" field.
+func ScheduledAtNotIn(vs ...time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldNotIn(FieldScheduledAt, vs...))
+}
+
+// ScheduledAtGT applies the GT predicate on the "scheduled_at" field.
+func ScheduledAtGT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGT(FieldScheduledAt, v))
+}
+
+// ScheduledAtGTE applies the GTE predicate on the "scheduled_at" field.
+func ScheduledAtGTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldGTE(FieldScheduledAt, v))
+}
+
+// ScheduledAtLT applies the LT predicate on the "scheduled_at" field.
+func ScheduledAtLT(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLT(FieldScheduledAt, v))
+}
+
+// ScheduledAtLTE applies the LTE predicate on the "scheduled_at" field.
+func ScheduledAtLTE(v time.Time) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.FieldLTE(FieldScheduledAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccountDeletion) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccountDeletion) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccountDeletion) predicate.AccountDeletion {
+	return predicate.AccountDeletion(sql.NotPredicates(p))
+}
@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/accountdeletion"
+)
+
+// AccountDeletionCreate is the builder for creating a AccountDeletion entity.
+type AccountDeletionCreate struct {
+	config
+	mutation *AccountDeletionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (adc *AccountDeletionCreate) SetCreatedAt(t time.Time) *AccountDeletionCreate {
+	adc.mutation.SetCreatedAt(t)
+	return adc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (adc *AccountDeletionCreate) SetNillableCreatedAt(t *time.Time) *AccountDeletionCreate {
+	if t != nil {
+		adc.SetCreatedAt(*t)
+	}
+	return adc
+}
+
+// SetUserID sets the "user_id" field.
+func (adc *AccountDeletionCreate) SetUserID(u uint) *AccountDeletionCreate {
+	adc.mutation.SetUserID(u)
+	return adc
+}
+
+// SetContentMode sets the "content_mode" field.
+func (adc *AccountDeletionCreate) SetContentMode(am accountdeletion.ContentMode) *AccountDeletionCreate {
+	adc.mutation.SetContentMode(am)
+	return adc
+}
+
+// SetNillableContentMode sets the "content_mode" field if the given value is not nil.
+func (adc *AccountDeletionCreate) SetNillableContentMode(am *accountdeletion.ContentMode) *AccountDeletionCreate {
+	if am != nil {
+		adc.SetContentMode(*am)
+	}
+	return adc
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (adc *AccountDeletionCreate) SetScheduledAt(t time.Time) *AccountDeletionCreate {
+	adc.mutation.SetScheduledAt(t)
+	return adc
+}
+
+// SetID sets the "id" field.
+func (adc *AccountDeletionCreate) SetID(u uint) *AccountDeletionCreate {
+	adc.mutation.SetID(u)
+	return adc
+}
+
+// Mutation returns the AccountDeletionMutation object of the builder.
+func (adc *AccountDeletionCreate) Mutation() *AccountDeletionMutation {
+	return adc.mutation
+}
+
+// Save creates the AccountDeletion in the database.
+func (adc *AccountDeletionCreate) Save(ctx context.Context) (*AccountDeletion, error) {
+	adc.defaults()
+	return withHooks(ctx, adc.sqlSave, adc.mutation, adc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (adc *AccountDeletionCreate) SaveX(ctx context.Context) *AccountDeletion {
+	v, err := adc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (adc *AccountDeletionCreate) Exec(ctx context.Context) error {
+	_, err := adc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adc *AccountDeletionCreate) ExecX(ctx context.Context) {
+	if err := adc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (adc *AccountDeletionCreate) defaults() {
+	if _, ok := adc.mutation.CreatedAt(); !ok {
+		v := accountdeletion.DefaultCreatedAt()
+		adc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := adc.mutation.ContentMode(); !ok {
+		v := accountdeletion.DefaultContentMode
+		adc.mutation.SetContentMode(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (adc *AccountDeletionCreate) check() error {
+	if _, ok := adc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountDeletion.created_at"`)}
+	}
+	if _, ok := adc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AccountDeletion.user_id"`)}
+	}
+	if _, ok := adc.mutation.ContentMode(); !ok {
+		return &ValidationError{Name: "content_mode", err: errors.New(`ent: missing required field "AccountDeletion.content_mode"`)}
+	}
+	if v, ok := adc.mutation.ContentMode(); ok {
+		if err := accountdeletion.ContentModeValidator(v); err != nil {
+			return &ValidationError{Name: "content_mode", err: fmt.Errorf(`ent: validator failed for field "AccountDeletion.content_mode": %w`, err)}
+		}
+	}
+	if _, ok := adc.mutation.ScheduledAt(); !ok {
+		return &ValidationError{Name: "scheduled_at", err: errors.New(`ent: missing required field "AccountDeletion.scheduled_at"`)}
+	}
+	return nil
+}
+
+func (adc *AccountDeletionCreate) sqlSave(ctx context.Context) (*AccountDeletion, error) {
+	if err := adc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := adc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, adc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	adc.mutation.id = &_node.ID
+	adc.mutation.done = true
+	return _node, nil
+}
+
+func (adc *AccountDeletionCreate) createSpec() (*AccountDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountDeletion{config: adc.config}
+		_spec = sqlgraph.NewCreateSpec(accountdeletion.Table, sqlgraph.NewFieldSpec(accountdeletion.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = adc.conflict
+	if id, ok := adc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := adc.mutation.CreatedAt(); ok {
+		_spec.SetField(accountdeletion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := adc.mutation.UserID(); ok {
+		_spec.SetField(accountdeletion.FieldUserID, field.TypeUint, value)
+		_node.UserID = value
+	}
+	if value, ok := adc.mutation.ContentMode(); ok {
+		_spec.SetField(accountdeletion.FieldContentMode, field.TypeEnum, value)
+		_node.ContentMode = value
+	}
+	if value, ok := adc.mutation.ScheduledAt(); ok {
+		_spec.SetField(accountdeletion.FieldScheduledAt, field.TypeTime, value)
+		_node.ScheduledAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountDeletion.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountDeletionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (adc *AccountDeletionCreate) OnConflict(opts ...sql.ConflictOption) *AccountDeletionUpsertOne {
+	adc.conflict = opts
+	return &AccountDeletionUpsertOne{
+		create: adc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (adc *AccountDeletionCreate) OnConflictColumns(columns ...string) *AccountDeletionUpsertOne {
+	adc.conflict = append(adc.conflict, sql.ConflictColumns(columns...))
+	return &AccountDeletionUpsertOne{
+		create: adc,
+	}
+}
+
+type (
+	// AccountDeletionUpsertOne is the builder for "upsert"-ing
+	//  one AccountDeletion node.
+	AccountDeletionUpsertOne struct {
+		create *AccountDeletionCreate
+	}
+
+	// AccountDeletionUpsert is the "OnConflict" setter.
+	AccountDeletionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetContentMode sets the "content_mode" field.
+func (u *AccountDeletionUpsert) SetContentMode(v accountdeletion.ContentMode) *AccountDeletionUpsert {
+	u.Set(accountdeletion.FieldContentMode, v)
+	return u
+}
+
+// UpdateContentMode sets the "content_mode" field to the value that was provided on create.
+func (u *AccountDeletionUpsert) UpdateContentMode() *AccountDeletionUpsert {
+	u.SetExcluded(accountdeletion.FieldContentMode)
+	return u
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *AccountDeletionUpsert) SetScheduledAt(v time.Time) *AccountDeletionUpsert {
+	u.Set(accountdeletion.FieldScheduledAt, v)
+	return u
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *AccountDeletionUpsert) UpdateScheduledAt() *AccountDeletionUpsert {
+	u.SetExcluded(accountdeletion.FieldScheduledAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountdeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountDeletionUpsertOne) UpdateNewValues() *AccountDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accountdeletion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accountdeletion.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(accountdeletion.FieldUserID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccountDeletionUpsertOne) Ignore() *AccountDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountDeletionUpsertOne) DoNothing() *AccountDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountDeletionCreate.OnConflict
+// documentation for more info.
+func (u *AccountDeletionUpsertOne) Update(set func(*AccountDeletionUpsert)) *AccountDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetContentMode sets the "content_mode" field.
+func (u *AccountDeletionUpsertOne) SetContentMode(v accountdeletion.ContentMode) *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetContentMode(v)
+	})
+}
+
+// UpdateContentMode sets the "content_mode" field to the value that was provided on create.
+func (u *AccountDeletionUpsertOne) UpdateContentMode() *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateContentMode()
+	})
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *AccountDeletionUpsertOne) SetScheduledAt(v time.Time) *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetScheduledAt(v)
+	})
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *AccountDeletionUpsertOne) UpdateScheduledAt() *AccountDeletionUpsertOne {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateScheduledAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountDeletionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountDeletionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountDeletionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccountDeletionUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccountDeletionUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccountDeletionCreateBulk is the builder for creating many AccountDeletion entities in bulk.
+type AccountDeletionCreateBulk struct {
+	config
+	err      error
+	builders []*AccountDeletionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccountDeletion entities in the database.
+func (adcb *AccountDeletionCreateBulk) Save(ctx context.Context) ([]*AccountDeletion, error) {
+	if adcb.err != nil {
+		return nil, adcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(adcb.builders))
+	nodes := make([]*AccountDeletion, len(adcb.builders))
+	mutators := make([]Mutator, len(adcb.builders))
+	for i := range adcb.builders {
+		func(i int, root context.Context) {
+			builder := adcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, adcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = adcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, adcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, adcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (adcb *AccountDeletionCreateBulk) SaveX(ctx context.Context) []*AccountDeletion {
+	v, err := adcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (adcb *AccountDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := adcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adcb *AccountDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := adcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccountDeletion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccountDeletionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (adcb *AccountDeletionCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccountDeletionUpsertBulk {
+	adcb.conflict = opts
+	return &AccountDeletionUpsertBulk{
+		create: adcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (adcb *AccountDeletionCreateBulk) OnConflictColumns(columns ...string) *AccountDeletionUpsertBulk {
+	adcb.conflict = append(adcb.conflict, sql.ConflictColumns(columns...))
+	return &AccountDeletionUpsertBulk{
+		create: adcb,
+	}
+}
+
+// AccountDeletionUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccountDeletion nodes.
+type AccountDeletionUpsertBulk struct {
+	create *AccountDeletionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accountdeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccountDeletionUpsertBulk) UpdateNewValues() *AccountDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accountdeletion.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accountdeletion.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(accountdeletion.FieldUserID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccountDeletion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccountDeletionUpsertBulk) Ignore() *AccountDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccountDeletionUpsertBulk) DoNothing() *AccountDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccountDeletionCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccountDeletionUpsertBulk) Update(set func(*AccountDeletionUpsert)) *AccountDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccountDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetContentMode sets the "content_mode" field.
+func (u *AccountDeletionUpsertBulk) SetContentMode(v accountdeletion.ContentMode) *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetContentMode(v)
+	})
+}
+
+// UpdateContentMode sets the "content_mode" field to the value that was provided on create.
+func (u *AccountDeletionUpsertBulk) UpdateContentMode() *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateContentMode()
+	})
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (u *AccountDeletionUpsertBulk) SetScheduledAt(v time.Time) *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.SetScheduledAt(v)
+	})
+}
+
+// UpdateScheduledAt sets the "scheduled_at" field to the value that was provided on create.
+func (u *AccountDeletionUpsertBulk) UpdateScheduledAt() *AccountDeletionUpsertBulk {
+	return u.Update(func(s *AccountDeletionUpsert) {
+		s.UpdateScheduledAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AccountDeletionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccountDeletionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccountDeletionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccountDeletionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/accountdeletion"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// AccountDeletionDelete is the builder for deleting a AccountDeletion entity.
+type AccountDeletionDelete struct {
+	config
+	hooks    []Hook
+	mutation *AccountDeletionMutation
+}
+
+// Where appends a list predicates to the AccountDeletionDelete builder.
+func (add *AccountDeletionDelete) Where(ps ...predicate.AccountDeletion) *AccountDeletionDelete {
+	add.mutation.Where(ps...)
+	return add
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (add *AccountDeletionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, add.sqlExec, add.mutation, add.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (add *AccountDeletionDelete) ExecX(ctx context.Context) int {
+	n, err := add.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (add *AccountDeletionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(accountdeletion.Table, sqlgraph.NewFieldSpec(accountdeletion.FieldID, field.TypeUint))
+	if ps := add.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, add.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	add.mutation.done = true
+	return affected, err
+}
+
+// AccountDeletionDeleteOne is the builder for deleting a single AccountDeletion entity.
+type AccountDeletionDeleteOne struct {
+	add *AccountDeletionDelete
+}
+
+// Where appends a list predicates to the AccountDeletionDelete builder.
+func (addo *AccountDeletionDeleteOne) Where(ps ...predicate.AccountDeletion) *AccountDeletionDeleteOne {
+	addo.add.mutation.Where(ps...)
+	return addo
+}
+
+// Exec executes the deletion query.
+func (addo *AccountDeletionDeleteOne) Exec(ctx context.Context) error {
+	n, err := addo.add.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{accountdeletion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (addo *AccountDeletionDeleteOne) ExecX(ctx context.Context) {
+	if err := addo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
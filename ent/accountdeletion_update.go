@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/accountdeletion"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// AccountDeletionUpdate is the builder for updating AccountDeletion entities.
+type AccountDeletionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AccountDeletionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AccountDeletionUpdate builder.
+func (adu *AccountDeletionUpdate) Where(ps ...predicate.AccountDeletion) *AccountDeletionUpdate {
+	adu.mutation.Where(ps...)
+	return adu
+}
+
+// SetContentMode sets the "content_mode" field.
+func (adu *AccountDeletionUpdate) SetContentMode(am accountdeletion.ContentMode) *AccountDeletionUpdate {
+	adu.mutation.SetContentMode(am)
+	return adu
+}
+
+// SetNillableContentMode sets the "content_mode" field if the given value is not nil.
+func (adu *AccountDeletionUpdate) SetNillableContentMode(am *accountdeletion.ContentMode) *AccountDeletionUpdate {
+	if am != nil {
+		adu.SetContentMode(*am)
+	}
+	return adu
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (adu *AccountDeletionUpdate) SetScheduledAt(t time.Time) *AccountDeletionUpdate {
+	adu.mutation.SetScheduledAt(t)
+	return adu
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (adu *AccountDeletionUpdate) SetNillableScheduledAt(t *time.Time) *AccountDeletionUpdate {
+	if t != nil {
+		adu.SetScheduledAt(*t)
+	}
+	return adu
+}
+
+// Mutation returns the AccountDeletionMutation object of the builder.
+func (adu *AccountDeletionUpdate) Mutation() *AccountDeletionMutation {
+	return adu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (adu *AccountDeletionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, adu.sqlSave, adu.mutation, adu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (adu *AccountDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := adu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (adu *AccountDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := adu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adu *AccountDeletionUpdate) ExecX(ctx context.Context) {
+	if err := adu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (adu *AccountDeletionUpdate) check() error {
+	if v, ok := adu.mutation.ContentMode(); ok {
+		if err := accountdeletion.ContentModeValidator(v); err != nil {
+			return &ValidationError{Name: "content_mode", err: fmt.Errorf(`ent: validator failed for field "AccountDeletion.content_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (adu *AccountDeletionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountDeletionUpdate {
+	adu.modifiers = append(adu.modifiers, modifiers...)
+	return adu
+}
+
+func (adu *AccountDeletionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := adu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountdeletion.Table, accountdeletion.Columns, sqlgraph.NewFieldSpec(accountdeletion.FieldID, field.TypeUint))
+	if ps := adu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := adu.mutation.ContentMode(); ok {
+		_spec.SetField(accountdeletion.FieldContentMode, field.TypeEnum, value)
+	}
+	if value, ok := adu.mutation.ScheduledAt(); ok {
+		_spec.SetField(accountdeletion.FieldScheduledAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(adu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, adu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	adu.mutation.done = true
+	return n, nil
+}
+
+// AccountDeletionUpdateOne is the builder for updating a single AccountDeletion entity.
+type AccountDeletionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountDeletionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetContentMode sets the "content_mode" field.
+func (aduo *AccountDeletionUpdateOne) SetContentMode(am accountdeletion.ContentMode) *AccountDeletionUpdateOne {
+	aduo.mutation.SetContentMode(am)
+	return aduo
+}
+
+// SetNillableContentMode sets the "content_mode" field if the given value is not nil.
+func (aduo *AccountDeletionUpdateOne) SetNillableContentMode(am *accountdeletion.ContentMode) *AccountDeletionUpdateOne {
+	if am != nil {
+		aduo.SetContentMode(*am)
+	}
+	return aduo
+}
+
+// SetScheduledAt sets the "scheduled_at" field.
+func (aduo *AccountDeletionUpdateOne) SetScheduledAt(t time.Time) *AccountDeletionUpdateOne {
+	aduo.mutation.SetScheduledAt(t)
+	return aduo
+}
+
+// SetNillableScheduledAt sets the "scheduled_at" field if the given value is not nil.
+func (aduo *AccountDeletionUpdateOne) SetNillableScheduledAt(t *time.Time) *AccountDeletionUpdateOne {
+	if t != nil {
+		aduo.SetScheduledAt(*t)
+	}
+	return aduo
+}
+
+// Mutation returns the AccountDeletionMutation object of the builder.
+func (aduo *AccountDeletionUpdateOne) Mutation() *AccountDeletionMutation {
+	return aduo.mutation
+}
+
+// Where appends a list predicates to the AccountDeletionUpdate builder.
+func (aduo *AccountDeletionUpdateOne) Where(ps ...predicate.AccountDeletion) *AccountDeletionUpdateOne {
+	aduo.mutation.Where(ps...)
+	return aduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aduo *AccountDeletionUpdateOne) Select(field string, fields ...string) *AccountDeletionUpdateOne {
+	aduo.fields = append([]string{field}, fields...)
+	return aduo
+}
+
+// Save executes the query and returns the updated AccountDeletion entity.
+func (aduo *AccountDeletionUpdateOne) Save(ctx context.Context) (*AccountDeletion, error) {
+	return withHooks(ctx, aduo.sqlSave, aduo.mutation, aduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aduo *AccountDeletionUpdateOne) SaveX(ctx context.Context) *AccountDeletion {
+	node, err := aduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aduo *AccountDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := aduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aduo *AccountDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := aduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aduo *AccountDeletionUpdateOne) check() error {
+	if v, ok := aduo.mutation.ContentMode(); ok {
+		if err := accountdeletion.ContentModeValidator(v); err != nil {
+			return &ValidationError{Name: "content_mode", err: fmt.Errorf(`ent: validator failed for field "AccountDeletion.content_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aduo *AccountDeletionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccountDeletionUpdateOne {
+	aduo.modifiers = append(aduo.modifiers, modifiers...)
+	return aduo
+}
+
+func (aduo *AccountDeletionUpdateOne) sqlSave(ctx context.Context) (_node *AccountDeletion, err error) {
+	if err := aduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountdeletion.Table, accountdeletion.Columns, sqlgraph.NewFieldSpec(accountdeletion.FieldID, field.TypeUint))
+	id, ok := aduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountdeletion.FieldID)
+		for _, f := range fields {
+			if !accountdeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accountdeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aduo.mutation.ContentMode(); ok {
+		_spec.SetField(accountdeletion.FieldContentMode, field.TypeEnum, value)
+	}
+	if value, ok := aduo.mutation.ScheduledAt(); ok {
+		_spec.SetField(accountdeletion.FieldScheduledAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(aduo.modifiers...)
+	_node = &AccountDeletion{config: aduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+)
+
+// 审计日志表
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 操作时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 操作者用户ID，0 表示系统或未登录
+	ActorID uint `json:"actor_id,omitempty"`
+	// 操作者名称快照，用户被删除后仍可追溯
+	ActorName string `json:"actor_name,omitempty"`
+	// 操作者IP
+	IP string `json:"ip,omitempty"`
+	// 操作者 User-Agent
+	UserAgent string `json:"user_agent,omitempty"`
+	// 操作类型，例如 setting.update、user.delete
+	Action string `json:"action,omitempty"`
+	// 操作对象类型，例如 setting、user、article
+	TargetType string `json:"target_type,omitempty"`
+	// 操作对象ID，可能是公共ID、键名或以逗号分隔的多个ID
+	TargetID string `json:"target_id,omitempty"`
+	// 操作摘要
+	Summary string `json:"summary,omitempty"`
+	// 变更内容，格式为 {字段: {before, after}}，敏感字段已脱敏
+	Changes      map[string]interface{} `json:"changes,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldChanges:
+			values[i] = new([]byte)
+		case auditlog.FieldID, auditlog.FieldActorID:
+			values[i] = new(sql.NullInt64)
+		case auditlog.FieldActorName, auditlog.FieldIP, auditlog.FieldUserAgent, auditlog.FieldAction, auditlog.FieldTargetType, auditlog.FieldTargetID, auditlog.FieldSummary:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			al.ID = uint(value.Int64)
+		case auditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				al.CreatedAt = value.Time
+			}
+		case auditlog.FieldActorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				al.ActorID = uint(value.Int64)
+			}
+		case auditlog.FieldActorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_name", values[i])
+			} else if value.Valid {
+				al.ActorName = value.String
+			}
+		case auditlog.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				al.IP = value.String
+			}
+		case auditlog.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				al.UserAgent = value.String
+			}
+		case auditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				al.Action = value.String
+			}
+		case auditlog.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				al.TargetType = value.String
+			}
+		case auditlog.FieldTargetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				al.TargetID = value.String
+			}
+		case auditlog.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				al.Summary = value.String
+			}
+		case auditlog.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &al.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		default:
+			al.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLog.
+// This includes values selected through modifiers, order, etc.
+func (al *AuditLog) Value(name string) (ent.Value, error) {
+	return al.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return NewAuditLogClient(al.config).UpdateOne(al)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(al.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.ActorID))
+	builder.WriteString(", ")
+	builder.WriteString("actor_name=")
+	builder.WriteString(al.ActorName)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(al.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(al.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(al.Action)
+	builder.WriteString(", ")
+	builder.WriteString("target_type=")
+	builder.WriteString(al.TargetType)
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(al.TargetID)
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(al.Summary)
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", al.Changes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
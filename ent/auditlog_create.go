@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// SetActorID sets the "actor_id" field.
+func (alc *AuditLogCreate) SetActorID(u uint) *AuditLogCreate {
+	alc.mutation.SetActorID(u)
+	return alc
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableActorID(u *uint) *AuditLogCreate {
+	if u != nil {
+		alc.SetActorID(*u)
+	}
+	return alc
+}
+
+// SetActorName sets the "actor_name" field.
+func (alc *AuditLogCreate) SetActorName(s string) *AuditLogCreate {
+	alc.mutation.SetActorName(s)
+	return alc
+}
+
+// SetNillableActorName sets the "actor_name" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableActorName(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetActorName(*s)
+	}
+	return alc
+}
+
+// SetIP sets the "ip" field.
+func (alc *AuditLogCreate) SetIP(s string) *AuditLogCreate {
+	alc.mutation.SetIP(s)
+	return alc
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableIP(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetIP(*s)
+	}
+	return alc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alc *AuditLogCreate) SetUserAgent(s string) *AuditLogCreate {
+	alc.mutation.SetUserAgent(s)
+	return alc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableUserAgent(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetUserAgent(*s)
+	}
+	return alc
+}
+
+// SetAction sets the "action" field.
+func (alc *AuditLogCreate) SetAction(s string) *AuditLogCreate {
+	alc.mutation.SetAction(s)
+	return alc
+}
+
+// SetTargetType sets the "target_type" field.
+func (alc *AuditLogCreate) SetTargetType(s string) *AuditLogCreate {
+	alc.mutation.SetTargetType(s)
+	return alc
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTargetType(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetTargetType(*s)
+	}
+	return alc
+}
+
+// SetTargetID sets the "target_id" field.
+func (alc *AuditLogCreate) SetTargetID(s string) *AuditLogCreate {
+	alc.mutation.SetTargetID(s)
+	return alc
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTargetID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetTargetID(*s)
+	}
+	return alc
+}
+
+// SetSummary sets the "summary" field.
+func (alc *AuditLogCreate) SetSummary(s string) *AuditLogCreate {
+	alc.mutation.SetSummary(s)
+	return alc
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableSummary(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetSummary(*s)
+	}
+	return alc
+}
+
+// SetChanges sets the "changes" field.
+func (alc *AuditLogCreate) SetChanges(m map[string]interface{}) *AuditLogCreate {
+	alc.mutation.SetChanges(m)
+	return alc
+}
+
+// SetID sets the "id" field.
+func (alc *AuditLogCreate) SetID(u uint) *AuditLogCreate {
+	alc.mutation.SetID(u)
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	alc.defaults()
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := alc.mutation.ActorID(); !ok {
+		v := auditlog.DefaultActorID
+		alc.mutation.SetActorID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLog.created_at"`)}
+	}
+	if _, ok := alc.mutation.ActorID(); !ok {
+		return &ValidationError{Name: "actor_id", err: errors.New(`ent: missing required field "AuditLog.actor_id"`)}
+	}
+	if v, ok := alc.mutation.ActorName(); ok {
+		if err := auditlog.ActorNameValidator(v); err != nil {
+			return &ValidationError{Name: "actor_name", err: fmt.Errorf(`ent: validator failed for field "AuditLog.actor_name": %w`, err)}
+		}
+	}
+	if v, ok := alc.mutation.IP(); ok {
+		if err := auditlog.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuditLog.ip": %w`, err)}
+		}
+	}
+	if v, ok := alc.mutation.UserAgent(); ok {
+		if err := auditlog.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AuditLog.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditLog.action"`)}
+	}
+	if v, ok := alc.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if v, ok := alc.mutation.TargetType(); ok {
+		if err := auditlog.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "AuditLog.target_type": %w`, err)}
+		}
+	}
+	if v, ok := alc.mutation.TargetID(); ok {
+		if err := auditlog.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`ent: validator failed for field "AuditLog.target_id": %w`, err)}
+		}
+	}
+	if v, ok := alc.mutation.Summary(); ok {
+		if err := auditlog.SummaryValidator(v); err != nil {
+			return &ValidationError{Name: "summary", err: fmt.Errorf(`ent: validator failed for field "AuditLog.summary": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = alc.conflict
+	if id, ok := alc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := alc.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUint, value)
+		_node.ActorID = value
+	}
+	if value, ok := alc.mutation.ActorName(); ok {
+		_spec.SetField(auditlog.FieldActorName, field.TypeString, value)
+		_node.ActorName = value
+	}
+	if value, ok := alc.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := alc.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := alc.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := alc.mutation.TargetType(); ok {
+		_spec.SetField(auditlog.FieldTargetType, field.TypeString, value)
+		_node.TargetType = value
+	}
+	if value, ok := alc.mutation.TargetID(); ok {
+		_spec.SetField(auditlog.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := alc.mutation.Summary(); ok {
+		_spec.SetField(auditlog.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := alc.mutation.Changes(); ok {
+		_spec.SetField(auditlog.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertOne {
+	alc.conflict = opts
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflictColumns(columns ...string) *AuditLogUpsertOne {
+	alc.conflict = append(alc.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+type (
+	// AuditLogUpsertOne is the builder for "upsert"-ing
+	//  one AuditLog node.
+	AuditLogUpsertOne struct {
+		create *AuditLogCreate
+	}
+
+	// AuditLogUpsert is the "OnConflict" setter.
+	AuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertOne) UpdateNewValues() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditlog.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(auditlog.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ActorID(); exists {
+			s.SetIgnore(auditlog.FieldActorID)
+		}
+		if _, exists := u.create.mutation.ActorName(); exists {
+			s.SetIgnore(auditlog.FieldActorName)
+		}
+		if _, exists := u.create.mutation.IP(); exists {
+			s.SetIgnore(auditlog.FieldIP)
+		}
+		if _, exists := u.create.mutation.UserAgent(); exists {
+			s.SetIgnore(auditlog.FieldUserAgent)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(auditlog.FieldAction)
+		}
+		if _, exists := u.create.mutation.TargetType(); exists {
+			s.SetIgnore(auditlog.FieldTargetType)
+		}
+		if _, exists := u.create.mutation.TargetID(); exists {
+			s.SetIgnore(auditlog.FieldTargetID)
+		}
+		if _, exists := u.create.mutation.Summary(); exists {
+			s.SetIgnore(auditlog.FieldSummary)
+		}
+		if _, exists := u.create.mutation.Changes(); exists {
+			s.SetIgnore(auditlog.FieldChanges)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogUpsertOne) Ignore() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertOne) DoNothing() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertOne) Update(set func(*AuditLogUpsert)) *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if alcb.err != nil {
+		return nil, alcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = alcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertBulk {
+	alcb.conflict = opts
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflictColumns(columns ...string) *AuditLogUpsertBulk {
+	alcb.conflict = append(alcb.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// AuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLog nodes.
+type AuditLogUpsertBulk struct {
+	create *AuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) UpdateNewValues() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditlog.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(auditlog.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ActorID(); exists {
+				s.SetIgnore(auditlog.FieldActorID)
+			}
+			if _, exists := b.mutation.ActorName(); exists {
+				s.SetIgnore(auditlog.FieldActorName)
+			}
+			if _, exists := b.mutation.IP(); exists {
+				s.SetIgnore(auditlog.FieldIP)
+			}
+			if _, exists := b.mutation.UserAgent(); exists {
+				s.SetIgnore(auditlog.FieldUserAgent)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(auditlog.FieldAction)
+			}
+			if _, exists := b.mutation.TargetType(); exists {
+				s.SetIgnore(auditlog.FieldTargetType)
+			}
+			if _, exists := b.mutation.TargetID(); exists {
+				s.SetIgnore(auditlog.FieldTargetID)
+			}
+			if _, exists := b.mutation.Summary(); exists {
+				s.SetIgnore(auditlog.FieldSummary)
+			}
+			if _, exists := b.mutation.Changes(); exists {
+				s.SetIgnore(auditlog.FieldChanges)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) Ignore() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertBulk) DoNothing() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertBulk) Update(set func(*AuditLogUpsert)) *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
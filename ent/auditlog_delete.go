@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// AuditLogDelete is the builder for deleting a AuditLog entity.
+type AuditLogDelete struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogDelete builder.
+func (ald *AuditLogDelete) Where(ps ...predicate.AuditLog) *AuditLogDelete {
+	ald.mutation.Where(ps...)
+	return ald
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ald *AuditLogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ald.sqlExec, ald.mutation, ald.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ald *AuditLogDelete) ExecX(ctx context.Context) int {
+	n, err := ald.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ald *AuditLogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint))
+	if ps := ald.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ald.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ald.mutation.done = true
+	return affected, err
+}
+
+// AuditLogDeleteOne is the builder for deleting a single AuditLog entity.
+type AuditLogDeleteOne struct {
+	ald *AuditLogDelete
+}
+
+// Where appends a list predicates to the AuditLogDelete builder.
+func (aldo *AuditLogDeleteOne) Where(ps ...predicate.AuditLog) *AuditLogDeleteOne {
+	aldo.ald.mutation.Where(ps...)
+	return aldo
+}
+
+// Exec executes the deletion query.
+func (aldo *AuditLogDeleteOne) Exec(ctx context.Context) error {
+	n, err := aldo.ald.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{auditlog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aldo *AuditLogDeleteOne) ExecX(ctx context.Context) {
+	if err := aldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (alu *AuditLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogUpdate {
+	alu.modifiers = append(alu.modifiers, modifiers...)
+	return alu
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if alu.mutation.ActorNameCleared() {
+		_spec.ClearField(auditlog.FieldActorName, field.TypeString)
+	}
+	if alu.mutation.IPCleared() {
+		_spec.ClearField(auditlog.FieldIP, field.TypeString)
+	}
+	if alu.mutation.UserAgentCleared() {
+		_spec.ClearField(auditlog.FieldUserAgent, field.TypeString)
+	}
+	if alu.mutation.TargetTypeCleared() {
+		_spec.ClearField(auditlog.FieldTargetType, field.TypeString)
+	}
+	if alu.mutation.TargetIDCleared() {
+		_spec.ClearField(auditlog.FieldTargetID, field.TypeString)
+	}
+	if alu.mutation.SummaryCleared() {
+		_spec.ClearField(auditlog.FieldSummary, field.TypeString)
+	}
+	if alu.mutation.ChangesCleared() {
+		_spec.ClearField(auditlog.FieldChanges, field.TypeJSON)
+	}
+	_spec.AddModifiers(alu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (aluo *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	return withHooks(ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aluo *AuditLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogUpdateOne {
+	aluo.modifiers = append(aluo.modifiers, modifiers...)
+	return aluo
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUint))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aluo.mutation.ActorNameCleared() {
+		_spec.ClearField(auditlog.FieldActorName, field.TypeString)
+	}
+	if aluo.mutation.IPCleared() {
+		_spec.ClearField(auditlog.FieldIP, field.TypeString)
+	}
+	if aluo.mutation.UserAgentCleared() {
+		_spec.ClearField(auditlog.FieldUserAgent, field.TypeString)
+	}
+	if aluo.mutation.TargetTypeCleared() {
+		_spec.ClearField(auditlog.FieldTargetType, field.TypeString)
+	}
+	if aluo.mutation.TargetIDCleared() {
+		_spec.ClearField(auditlog.FieldTargetID, field.TypeString)
+	}
+	if aluo.mutation.SummaryCleared() {
+		_spec.ClearField(auditlog.FieldSummary, field.TypeString)
+	}
+	if aluo.mutation.ChangesCleared() {
+		_spec.ClearField(auditlog.FieldChanges, field.TypeJSON)
+	}
+	_spec.AddModifiers(aluo.modifiers...)
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
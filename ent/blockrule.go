@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/blockrule"
+)
+
+// 黑名单规则表
+type BlockRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 规则类型: ip(IP或CIDR网段), email(邮箱), email_md5(邮箱MD5), user_agent(UA正则), user(用户公共ID)
+	Type blockrule.Type `json:"type,omitempty"`
+	// 规则内容
+	Value string `json:"value,omitempty"`
+	// 封禁原因
+	Reason string `json:"reason,omitempty"`
+	// 过期时间，为NULL表示永久有效
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlockRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blockrule.FieldID:
+			values[i] = new(sql.NullInt64)
+		case blockrule.FieldType, blockrule.FieldValue, blockrule.FieldReason:
+			values[i] = new(sql.NullString)
+		case blockrule.FieldCreatedAt, blockrule.FieldUpdatedAt, blockrule.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlockRule fields.
+func (br *BlockRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blockrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			br.ID = uint(value.Int64)
+		case blockrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				br.CreatedAt = value.Time
+			}
+		case blockrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				br.UpdatedAt = value.Time
+			}
+		case blockrule.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				br.Type = blockrule.Type(value.String)
+			}
+		case blockrule.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				br.Value = value.String
+			}
+		case blockrule.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				br.Reason = value.String
+			}
+		case blockrule.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				br.ExpiresAt = new(time.Time)
+				*br.ExpiresAt = value.Time
+			}
+		default:
+			br.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the BlockRule.
+// This includes values selected through modifiers, order, etc.
+func (br *BlockRule) GetValue(name string) (ent.Value, error) {
+	return br.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BlockRule.
+// Note that you need to call BlockRule.Unwrap() before calling this method if this BlockRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (br *BlockRule) Update() *BlockRuleUpdateOne {
+	return NewBlockRuleClient(br.config).UpdateOne(br)
+}
+
+// Unwrap unwraps the BlockRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (br *BlockRule) Unwrap() *BlockRule {
+	_tx, ok := br.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlockRule is not a transactional entity")
+	}
+	br.config.driver = _tx.drv
+	return br
+}
+
+// String implements the fmt.Stringer.
+func (br *BlockRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlockRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", br.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(br.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(br.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", br.Type))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(br.Value)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(br.Reason)
+	builder.WriteString(", ")
+	if v := br.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlockRules is a parsable slice of BlockRule.
+type BlockRules []*BlockRule
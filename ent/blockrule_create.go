@@ -0,0 +1,811 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/blockrule"
+)
+
+// BlockRuleCreate is the builder for creating a BlockRule entity.
+type BlockRuleCreate struct {
+	config
+	mutation *BlockRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (brc *BlockRuleCreate) SetCreatedAt(t time.Time) *BlockRuleCreate {
+	brc.mutation.SetCreatedAt(t)
+	return brc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (brc *BlockRuleCreate) SetNillableCreatedAt(t *time.Time) *BlockRuleCreate {
+	if t != nil {
+		brc.SetCreatedAt(*t)
+	}
+	return brc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (brc *BlockRuleCreate) SetUpdatedAt(t time.Time) *BlockRuleCreate {
+	brc.mutation.SetUpdatedAt(t)
+	return brc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (brc *BlockRuleCreate) SetNillableUpdatedAt(t *time.Time) *BlockRuleCreate {
+	if t != nil {
+		brc.SetUpdatedAt(*t)
+	}
+	return brc
+}
+
+// SetType sets the "type" field.
+func (brc *BlockRuleCreate) SetType(b blockrule.Type) *BlockRuleCreate {
+	brc.mutation.SetType(b)
+	return brc
+}
+
+// SetValue sets the "value" field.
+func (brc *BlockRuleCreate) SetValue(s string) *BlockRuleCreate {
+	brc.mutation.SetValue(s)
+	return brc
+}
+
+// SetReason sets the "reason" field.
+func (brc *BlockRuleCreate) SetReason(s string) *BlockRuleCreate {
+	brc.mutation.SetReason(s)
+	return brc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (brc *BlockRuleCreate) SetNillableReason(s *string) *BlockRuleCreate {
+	if s != nil {
+		brc.SetReason(*s)
+	}
+	return brc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (brc *BlockRuleCreate) SetExpiresAt(t time.Time) *BlockRuleCreate {
+	brc.mutation.SetExpiresAt(t)
+	return brc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (brc *BlockRuleCreate) SetNillableExpiresAt(t *time.Time) *BlockRuleCreate {
+	if t != nil {
+		brc.SetExpiresAt(*t)
+	}
+	return brc
+}
+
+// SetID sets the "id" field.
+func (brc *BlockRuleCreate) SetID(u uint) *BlockRuleCreate {
+	brc.mutation.SetID(u)
+	return brc
+}
+
+// Mutation returns the BlockRuleMutation object of the builder.
+func (brc *BlockRuleCreate) Mutation() *BlockRuleMutation {
+	return brc.mutation
+}
+
+// Save creates the BlockRule in the database.
+func (brc *BlockRuleCreate) Save(ctx context.Context) (*BlockRule, error) {
+	brc.defaults()
+	return withHooks(ctx, brc.sqlSave, brc.mutation, brc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (brc *BlockRuleCreate) SaveX(ctx context.Context) *BlockRule {
+	v, err := brc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (brc *BlockRuleCreate) Exec(ctx context.Context) error {
+	_, err := brc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brc *BlockRuleCreate) ExecX(ctx context.Context) {
+	if err := brc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (brc *BlockRuleCreate) defaults() {
+	if _, ok := brc.mutation.CreatedAt(); !ok {
+		v := blockrule.DefaultCreatedAt()
+		brc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := brc.mutation.UpdatedAt(); !ok {
+		v := blockrule.DefaultUpdatedAt()
+		brc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (brc *BlockRuleCreate) check() error {
+	if _, ok := brc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BlockRule.created_at"`)}
+	}
+	if _, ok := brc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BlockRule.updated_at"`)}
+	}
+	if _, ok := brc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "BlockRule.type"`)}
+	}
+	if v, ok := brc.mutation.GetType(); ok {
+		if err := blockrule.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "BlockRule.type": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "BlockRule.value"`)}
+	}
+	if v, ok := brc.mutation.Value(); ok {
+		if err := blockrule.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "BlockRule.value": %w`, err)}
+		}
+	}
+	if v, ok := brc.mutation.Reason(); ok {
+		if err := blockrule.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "BlockRule.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (brc *BlockRuleCreate) sqlSave(ctx context.Context) (*BlockRule, error) {
+	if err := brc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := brc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, brc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	brc.mutation.id = &_node.ID
+	brc.mutation.done = true
+	return _node, nil
+}
+
+func (brc *BlockRuleCreate) createSpec() (*BlockRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BlockRule{config: brc.config}
+		_spec = sqlgraph.NewCreateSpec(blockrule.Table, sqlgraph.NewFieldSpec(blockrule.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = brc.conflict
+	if id, ok := brc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := brc.mutation.CreatedAt(); ok {
+		_spec.SetField(blockrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := brc.mutation.UpdatedAt(); ok {
+		_spec.SetField(blockrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := brc.mutation.GetType(); ok {
+		_spec.SetField(blockrule.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := brc.mutation.Value(); ok {
+		_spec.SetField(blockrule.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := brc.mutation.Reason(); ok {
+		_spec.SetField(blockrule.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := brc.mutation.ExpiresAt(); ok {
+		_spec.SetField(blockrule.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BlockRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlockRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (brc *BlockRuleCreate) OnConflict(opts ...sql.ConflictOption) *BlockRuleUpsertOne {
+	brc.conflict = opts
+	return &BlockRuleUpsertOne{
+		create: brc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BlockRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (brc *BlockRuleCreate) OnConflictColumns(columns ...string) *BlockRuleUpsertOne {
+	brc.conflict = append(brc.conflict, sql.ConflictColumns(columns...))
+	return &BlockRuleUpsertOne{
+		create: brc,
+	}
+}
+
+type (
+	// BlockRuleUpsertOne is the builder for "upsert"-ing
+	//  one BlockRule node.
+	BlockRuleUpsertOne struct {
+		create *BlockRuleCreate
+	}
+
+	// BlockRuleUpsert is the "OnConflict" setter.
+	BlockRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlockRuleUpsert) SetUpdatedAt(v time.Time) *BlockRuleUpsert {
+	u.Set(blockrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlockRuleUpsert) UpdateUpdatedAt() *BlockRuleUpsert {
+	u.SetExcluded(blockrule.FieldUpdatedAt)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *BlockRuleUpsert) SetType(v blockrule.Type) *BlockRuleUpsert {
+	u.Set(blockrule.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *BlockRuleUpsert) UpdateType() *BlockRuleUpsert {
+	u.SetExcluded(blockrule.FieldType)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *BlockRuleUpsert) SetValue(v string) *BlockRuleUpsert {
+	u.Set(blockrule.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *BlockRuleUpsert) UpdateValue() *BlockRuleUpsert {
+	u.SetExcluded(blockrule.FieldValue)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *BlockRuleUpsert) SetReason(v string) *BlockRuleUpsert {
+	u.Set(blockrule.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *BlockRuleUpsert) UpdateReason() *BlockRuleUpsert {
+	u.SetExcluded(blockrule.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *BlockRuleUpsert) ClearReason() *BlockRuleUpsert {
+	u.SetNull(blockrule.FieldReason)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *BlockRuleUpsert) SetExpiresAt(v time.Time) *BlockRuleUpsert {
+	u.Set(blockrule.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *BlockRuleUpsert) UpdateExpiresAt() *BlockRuleUpsert {
+	u.SetExcluded(blockrule.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *BlockRuleUpsert) ClearExpiresAt() *BlockRuleUpsert {
+	u.SetNull(blockrule.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BlockRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(blockrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BlockRuleUpsertOne) UpdateNewValues() *BlockRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(blockrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(blockrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BlockRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BlockRuleUpsertOne) Ignore() *BlockRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlockRuleUpsertOne) DoNothing() *BlockRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlockRuleCreate.OnConflict
+// documentation for more info.
+func (u *BlockRuleUpsertOne) Update(set func(*BlockRuleUpsert)) *BlockRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlockRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlockRuleUpsertOne) SetUpdatedAt(v time.Time) *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlockRuleUpsertOne) UpdateUpdatedAt() *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *BlockRuleUpsertOne) SetType(v blockrule.Type) *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *BlockRuleUpsertOne) UpdateType() *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *BlockRuleUpsertOne) SetValue(v string) *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *BlockRuleUpsertOne) UpdateValue() *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *BlockRuleUpsertOne) SetReason(v string) *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *BlockRuleUpsertOne) UpdateReason() *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *BlockRuleUpsertOne) ClearReason() *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *BlockRuleUpsertOne) SetExpiresAt(v time.Time) *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *BlockRuleUpsertOne) UpdateExpiresAt() *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *BlockRuleUpsertOne) ClearExpiresAt() *BlockRuleUpsertOne {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BlockRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlockRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlockRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BlockRuleUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BlockRuleUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BlockRuleCreateBulk is the builder for creating many BlockRule entities in bulk.
+type BlockRuleCreateBulk struct {
+	config
+	err      error
+	builders []*BlockRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BlockRule entities in the database.
+func (brcb *BlockRuleCreateBulk) Save(ctx context.Context) ([]*BlockRule, error) {
+	if brcb.err != nil {
+		return nil, brcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(brcb.builders))
+	nodes := make([]*BlockRule, len(brcb.builders))
+	mutators := make([]Mutator, len(brcb.builders))
+	for i := range brcb.builders {
+		func(i int, root context.Context) {
+			builder := brcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlockRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, brcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = brcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, brcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, brcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (brcb *BlockRuleCreateBulk) SaveX(ctx context.Context) []*BlockRule {
+	v, err := brcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (brcb *BlockRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := brcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brcb *BlockRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := brcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BlockRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlockRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (brcb *BlockRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *BlockRuleUpsertBulk {
+	brcb.conflict = opts
+	return &BlockRuleUpsertBulk{
+		create: brcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BlockRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (brcb *BlockRuleCreateBulk) OnConflictColumns(columns ...string) *BlockRuleUpsertBulk {
+	brcb.conflict = append(brcb.conflict, sql.ConflictColumns(columns...))
+	return &BlockRuleUpsertBulk{
+		create: brcb,
+	}
+}
+
+// BlockRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of BlockRule nodes.
+type BlockRuleUpsertBulk struct {
+	create *BlockRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BlockRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(blockrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BlockRuleUpsertBulk) UpdateNewValues() *BlockRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(blockrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(blockrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BlockRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BlockRuleUpsertBulk) Ignore() *BlockRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlockRuleUpsertBulk) DoNothing() *BlockRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlockRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *BlockRuleUpsertBulk) Update(set func(*BlockRuleUpsert)) *BlockRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlockRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BlockRuleUpsertBulk) SetUpdatedAt(v time.Time) *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BlockRuleUpsertBulk) UpdateUpdatedAt() *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *BlockRuleUpsertBulk) SetType(v blockrule.Type) *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *BlockRuleUpsertBulk) UpdateType() *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *BlockRuleUpsertBulk) SetValue(v string) *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *BlockRuleUpsertBulk) UpdateValue() *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *BlockRuleUpsertBulk) SetReason(v string) *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *BlockRuleUpsertBulk) UpdateReason() *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *BlockRuleUpsertBulk) ClearReason() *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *BlockRuleUpsertBulk) SetExpiresAt(v time.Time) *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *BlockRuleUpsertBulk) UpdateExpiresAt() *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *BlockRuleUpsertBulk) ClearExpiresAt() *BlockRuleUpsertBulk {
+	return u.Update(func(s *BlockRuleUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BlockRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BlockRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlockRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlockRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
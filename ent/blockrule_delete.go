@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/blockrule"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// BlockRuleDelete is the builder for deleting a BlockRule entity.
+type BlockRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *BlockRuleMutation
+}
+
+// Where appends a list predicates to the BlockRuleDelete builder.
+func (brd *BlockRuleDelete) Where(ps ...predicate.BlockRule) *BlockRuleDelete {
+	brd.mutation.Where(ps...)
+	return brd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (brd *BlockRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, brd.sqlExec, brd.mutation, brd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brd *BlockRuleDelete) ExecX(ctx context.Context) int {
+	n, err := brd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (brd *BlockRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(blockrule.Table, sqlgraph.NewFieldSpec(blockrule.FieldID, field.TypeUint))
+	if ps := brd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, brd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	brd.mutation.done = true
+	return affected, err
+}
+
+// BlockRuleDeleteOne is the builder for deleting a single BlockRule entity.
+type BlockRuleDeleteOne struct {
+	brd *BlockRuleDelete
+}
+
+// Where appends a list predicates to the BlockRuleDelete builder.
+func (brdo *BlockRuleDeleteOne) Where(ps ...predicate.BlockRule) *BlockRuleDeleteOne {
+	brdo.brd.mutation.Where(ps...)
+	return brdo
+}
+
+// Exec executes the deletion query.
+func (brdo *BlockRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := brdo.brd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{blockrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brdo *BlockRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := brdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/blockrule"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// BlockRuleQuery is the builder for querying BlockRule entities.
+type BlockRuleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []blockrule.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BlockRule
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BlockRuleQuery builder.
+func (brq *BlockRuleQuery) Where(ps ...predicate.BlockRule) *BlockRuleQuery {
+	brq.predicates = append(brq.predicates, ps...)
+	return brq
+}
+
+// Limit the number of records to be returned by this query.
+func (brq *BlockRuleQuery) Limit(limit int) *BlockRuleQuery {
+	brq.ctx.Limit = &limit
+	return brq
+}
+
+// Offset to start from.
+func (brq *BlockRuleQuery) Offset(offset int) *BlockRuleQuery {
+	brq.ctx.Offset = &offset
+	return brq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (brq *BlockRuleQuery) Unique(unique bool) *BlockRuleQuery {
+	brq.ctx.Unique = &unique
+	return brq
+}
+
+// Order specifies how the records should be ordered.
+func (brq *BlockRuleQuery) Order(o ...blockrule.OrderOption) *BlockRuleQuery {
+	brq.order = append(brq.order, o...)
+	return brq
+}
+
+// First returns the first BlockRule entity from the query.
+// Returns a *NotFoundError when no BlockRule was found.
+func (brq *BlockRuleQuery) First(ctx context.Context) (*BlockRule, error) {
+	nodes, err := brq.Limit(1).All(setContextOp(ctx, brq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{blockrule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (brq *BlockRuleQuery) FirstX(ctx context.Context) *BlockRule {
+	node, err := brq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BlockRule ID from the query.
+// Returns a *NotFoundError when no BlockRule ID was found.
+func (brq *BlockRuleQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = brq.Limit(1).IDs(setContextOp(ctx, brq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{blockrule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (brq *BlockRuleQuery) FirstIDX(ctx context.Context) uint {
+	id, err := brq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BlockRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BlockRule entity is found.
+// Returns a *NotFoundError when no BlockRule entities are found.
+func (brq *BlockRuleQuery) Only(ctx context.Context) (*BlockRule, error) {
+	nodes, err := brq.Limit(2).All(setContextOp(ctx, brq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{blockrule.Label}
+	default:
+		return nil, &NotSingularError{blockrule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (brq *BlockRuleQuery) OnlyX(ctx context.Context) *BlockRule {
+	node, err := brq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BlockRule ID in the query.
+// Returns a *NotSingularError when more than one BlockRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (brq *BlockRuleQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = brq.Limit(2).IDs(setContextOp(ctx, brq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{blockrule.Label}
+	default:
+		err = &NotSingularError{blockrule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (brq *BlockRuleQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := brq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BlockRules.
+func (brq *BlockRuleQuery) All(ctx context.Context) ([]*BlockRule, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryAll)
+	if err := brq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BlockRule, *BlockRuleQuery]()
+	return withInterceptors[[]*BlockRule](ctx, brq, qr, brq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (brq *BlockRuleQuery) AllX(ctx context.Context) []*BlockRule {
+	nodes, err := brq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BlockRule IDs.
+func (brq *BlockRuleQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if brq.ctx.Unique == nil && brq.path != nil {
+		brq.Unique(true)
+	}
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryIDs)
+	if err = brq.Select(blockrule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (brq *BlockRuleQuery) IDsX(ctx context.Context) []uint {
+	ids, err := brq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (brq *BlockRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryCount)
+	if err := brq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, brq, querierCount[*BlockRuleQuery](), brq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (brq *BlockRuleQuery) CountX(ctx context.Context) int {
+	count, err := brq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (brq *BlockRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryExist)
+	switch _, err := brq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (brq *BlockRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := brq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BlockRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (brq *BlockRuleQuery) Clone() *BlockRuleQuery {
+	if brq == nil {
+		return nil
+	}
+	return &BlockRuleQuery{
+		config:     brq.config,
+		ctx:        brq.ctx.Clone(),
+		order:      append([]blockrule.OrderOption{}, brq.order...),
+		inters:     append([]Interceptor{}, brq.inters...),
+		predicates: append([]predicate.BlockRule{}, brq.predicates...),
+		// clone intermediate query.
+		sql:       brq.sql.Clone(),
+		path:      brq.path,
+		modifiers: append([]func(*sql.Selector){}, brq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BlockRule.Query().
+//		GroupBy(blockrule.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (brq *BlockRuleQuery) GroupBy(field string, fields ...string) *BlockRuleGroupBy {
+	brq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BlockRuleGroupBy{build: brq}
+	grbuild.flds = &brq.ctx.Fields
+	grbuild.label = blockrule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.BlockRule.Query().
+//		Select(blockrule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (brq *BlockRuleQuery) Select(fields ...string) *BlockRuleSelect {
+	brq.ctx.Fields = append(brq.ctx.Fields, fields...)
+	sbuild := &BlockRuleSelect{BlockRuleQuery: brq}
+	sbuild.label = blockrule.Label
+	sbuild.flds, sbuild.scan = &brq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BlockRuleSelect configured with the given aggregations.
+func (brq *BlockRuleQuery) Aggregate(fns ...AggregateFunc) *BlockRuleSelect {
+	return brq.Select().Aggregate(fns...)
+}
+
+func (brq *BlockRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range brq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, brq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range brq.ctx.Fields {
+		if !blockrule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if brq.path != nil {
+		prev, err := brq.path(ctx)
+		if err != nil {
+			return err
+		}
+		brq.sql = prev
+	}
+	return nil
+}
+
+func (brq *BlockRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BlockRule, error) {
+	var (
+		nodes = []*BlockRule{}
+		_spec = brq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BlockRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BlockRule{config: brq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(brq.modifiers) > 0 {
+		_spec.Modifiers = brq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, brq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (brq *BlockRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := brq.querySpec()
+	if len(brq.modifiers) > 0 {
+		_spec.Modifiers = brq.modifiers
+	}
+	_spec.Node.Columns = brq.ctx.Fields
+	if len(brq.ctx.Fields) > 0 {
+		_spec.Unique = brq.ctx.Unique != nil && *brq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, brq.driver, _spec)
+}
+
+func (brq *BlockRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(blockrule.Table, blockrule.Columns, sqlgraph.NewFieldSpec(blockrule.FieldID, field.TypeUint))
+	_spec.From = brq.sql
+	if unique := brq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if brq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := brq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blockrule.FieldID)
+		for i := range fields {
+			if fields[i] != blockrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := brq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := brq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := brq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := brq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (brq *BlockRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(brq.driver.Dialect())
+	t1 := builder.Table(blockrule.Table)
+	columns := brq.ctx.Fields
+	if len(columns) == 0 {
+		columns = blockrule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if brq.sql != nil {
+		selector = brq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if brq.ctx.Unique != nil && *brq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range brq.modifiers {
+		m(selector)
+	}
+	for _, p := range brq.predicates {
+		p(selector)
+	}
+	for _, p := range brq.order {
+		p(selector)
+	}
+	if offset := brq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := brq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (brq *BlockRuleQuery) Modify(modifiers ...func(s *sql.Selector)) *BlockRuleSelect {
+	brq.modifiers = append(brq.modifiers, modifiers...)
+	return brq.Select()
+}
+
+// BlockRuleGroupBy is the group-by builder for BlockRule entities.
+type BlockRuleGroupBy struct {
+	selector
+	build *BlockRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (brgb *BlockRuleGroupBy) Aggregate(fns ...AggregateFunc) *BlockRuleGroupBy {
+	brgb.fns = append(brgb.fns, fns...)
+	return brgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (brgb *BlockRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, brgb.build.ctx, ent.OpQueryGroupBy)
+	if err := brgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlockRuleQuery, *BlockRuleGroupBy](ctx, brgb.build, brgb, brgb.build.inters, v)
+}
+
+func (brgb *BlockRuleGroupBy) sqlScan(ctx context.Context, root *BlockRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(brgb.fns))
+	for _, fn := range brgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*brgb.flds)+len(brgb.fns))
+		for _, f := range *brgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*brgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := brgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BlockRuleSelect is the builder for selecting fields of BlockRule entities.
+type BlockRuleSelect struct {
+	*BlockRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (brs *BlockRuleSelect) Aggregate(fns ...AggregateFunc) *BlockRuleSelect {
+	brs.fns = append(brs.fns, fns...)
+	return brs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (brs *BlockRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, brs.ctx, ent.OpQuerySelect)
+	if err := brs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlockRuleQuery, *BlockRuleSelect](ctx, brs.BlockRuleQuery, brs, brs.inters, v)
+}
+
+func (brs *BlockRuleSelect) sqlScan(ctx context.Context, root *BlockRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(brs.fns))
+	for _, fn := range brs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*brs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := brs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (brs *BlockRuleSelect) Modify(modifiers ...func(s *sql.Selector)) *BlockRuleSelect {
+	brs.modifiers = append(brs.modifiers, modifiers...)
+	return brs
+}
@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/blockrule"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// BlockRuleUpdate is the builder for updating BlockRule entities.
+type BlockRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BlockRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BlockRuleUpdate builder.
+func (bru *BlockRuleUpdate) Where(ps ...predicate.BlockRule) *BlockRuleUpdate {
+	bru.mutation.Where(ps...)
+	return bru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bru *BlockRuleUpdate) SetUpdatedAt(t time.Time) *BlockRuleUpdate {
+	bru.mutation.SetUpdatedAt(t)
+	return bru
+}
+
+// SetType sets the "type" field.
+func (bru *BlockRuleUpdate) SetType(b blockrule.Type) *BlockRuleUpdate {
+	bru.mutation.SetType(b)
+	return bru
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (bru *BlockRuleUpdate) SetNillableType(b *blockrule.Type) *BlockRuleUpdate {
+	if b != nil {
+		bru.SetType(*b)
+	}
+	return bru
+}
+
+// SetValue sets the "value" field.
+func (bru *BlockRuleUpdate) SetValue(s string) *BlockRuleUpdate {
+	bru.mutation.SetValue(s)
+	return bru
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (bru *BlockRuleUpdate) SetNillableValue(s *string) *BlockRuleUpdate {
+	if s != nil {
+		bru.SetValue(*s)
+	}
+	return bru
+}
+
+// SetReason sets the "reason" field.
+func (bru *BlockRuleUpdate) SetReason(s string) *BlockRuleUpdate {
+	bru.mutation.SetReason(s)
+	return bru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (bru *BlockRuleUpdate) SetNillableReason(s *string) *BlockRuleUpdate {
+	if s != nil {
+		bru.SetReason(*s)
+	}
+	return bru
+}
+
+// ClearReason clears the value of the "reason" field.
+func (bru *BlockRuleUpdate) ClearReason() *BlockRuleUpdate {
+	bru.mutation.ClearReason()
+	return bru
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (bru *BlockRuleUpdate) SetExpiresAt(t time.Time) *BlockRuleUpdate {
+	bru.mutation.SetExpiresAt(t)
+	return bru
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (bru *BlockRuleUpdate) SetNillableExpiresAt(t *time.Time) *BlockRuleUpdate {
+	if t != nil {
+		bru.SetExpiresAt(*t)
+	}
+	return bru
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (bru *BlockRuleUpdate) ClearExpiresAt() *BlockRuleUpdate {
+	bru.mutation.ClearExpiresAt()
+	return bru
+}
+
+// Mutation returns the BlockRuleMutation object of the builder.
+func (bru *BlockRuleUpdate) Mutation() *BlockRuleMutation {
+	return bru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bru *BlockRuleUpdate) Save(ctx context.Context) (int, error) {
+	bru.defaults()
+	return withHooks(ctx, bru.sqlSave, bru.mutation, bru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bru *BlockRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := bru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bru *BlockRuleUpdate) Exec(ctx context.Context) error {
+	_, err := bru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bru *BlockRuleUpdate) ExecX(ctx context.Context) {
+	if err := bru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bru *BlockRuleUpdate) defaults() {
+	if _, ok := bru.mutation.UpdatedAt(); !ok {
+		v := blockrule.UpdateDefaultUpdatedAt()
+		bru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bru *BlockRuleUpdate) check() error {
+	if v, ok := bru.mutation.GetType(); ok {
+		if err := blockrule.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "BlockRule.type": %w`, err)}
+		}
+	}
+	if v, ok := bru.mutation.Value(); ok {
+		if err := blockrule.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "BlockRule.value": %w`, err)}
+		}
+	}
+	if v, ok := bru.mutation.Reason(); ok {
+		if err := blockrule.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "BlockRule.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bru *BlockRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlockRuleUpdate {
+	bru.modifiers = append(bru.modifiers, modifiers...)
+	return bru
+}
+
+func (bru *BlockRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blockrule.Table, blockrule.Columns, sqlgraph.NewFieldSpec(blockrule.FieldID, field.TypeUint))
+	if ps := bru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bru.mutation.UpdatedAt(); ok {
+		_spec.SetField(blockrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := bru.mutation.GetType(); ok {
+		_spec.SetField(blockrule.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := bru.mutation.Value(); ok {
+		_spec.SetField(blockrule.FieldValue, field.TypeString, value)
+	}
+	if value, ok := bru.mutation.Reason(); ok {
+		_spec.SetField(blockrule.FieldReason, field.TypeString, value)
+	}
+	if bru.mutation.ReasonCleared() {
+		_spec.ClearField(blockrule.FieldReason, field.TypeString)
+	}
+	if value, ok := bru.mutation.ExpiresAt(); ok {
+		_spec.SetField(blockrule.FieldExpiresAt, field.TypeTime, value)
+	}
+	if bru.mutation.ExpiresAtCleared() {
+		_spec.ClearField(blockrule.FieldExpiresAt, field.TypeTime)
+	}
+	_spec.AddModifiers(bru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, bru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blockrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bru.mutation.done = true
+	return n, nil
+}
+
+// BlockRuleUpdateOne is the builder for updating a single BlockRule entity.
+type BlockRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BlockRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bruo *BlockRuleUpdateOne) SetUpdatedAt(t time.Time) *BlockRuleUpdateOne {
+	bruo.mutation.SetUpdatedAt(t)
+	return bruo
+}
+
+// SetType sets the "type" field.
+func (bruo *BlockRuleUpdateOne) SetType(b blockrule.Type) *BlockRuleUpdateOne {
+	bruo.mutation.SetType(b)
+	return bruo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (bruo *BlockRuleUpdateOne) SetNillableType(b *blockrule.Type) *BlockRuleUpdateOne {
+	if b != nil {
+		bruo.SetType(*b)
+	}
+	return bruo
+}
+
+// SetValue sets the "value" field.
+func (bruo *BlockRuleUpdateOne) SetValue(s string) *BlockRuleUpdateOne {
+	bruo.mutation.SetValue(s)
+	return bruo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (bruo *BlockRuleUpdateOne) SetNillableValue(s *string) *BlockRuleUpdateOne {
+	if s != nil {
+		bruo.SetValue(*s)
+	}
+	return bruo
+}
+
+// SetReason sets the "reason" field.
+func (bruo *BlockRuleUpdateOne) SetReason(s string) *BlockRuleUpdateOne {
+	bruo.mutation.SetReason(s)
+	return bruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (bruo *BlockRuleUpdateOne) SetNillableReason(s *string) *BlockRuleUpdateOne {
+	if s != nil {
+		bruo.SetReason(*s)
+	}
+	return bruo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (bruo *BlockRuleUpdateOne) ClearReason() *BlockRuleUpdateOne {
+	bruo.mutation.ClearReason()
+	return bruo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (bruo *BlockRuleUpdateOne) SetExpiresAt(t time.Time) *BlockRuleUpdateOne {
+	bruo.mutation.SetExpiresAt(t)
+	return bruo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (bruo *BlockRuleUpdateOne) SetNillableExpiresAt(t *time.Time) *BlockRuleUpdateOne {
+	if t != nil {
+		bruo.SetExpiresAt(*t)
+	}
+	return bruo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (bruo *BlockRuleUpdateOne) ClearExpiresAt() *BlockRuleUpdateOne {
+	bruo.mutation.ClearExpiresAt()
+	return bruo
+}
+
+// Mutation returns the BlockRuleMutation object of the builder.
+func (bruo *BlockRuleUpdateOne) Mutation() *BlockRuleMutation {
+	return bruo.mutation
+}
+
+// Where appends a list predicates to the BlockRuleUpdate builder.
+func (bruo *BlockRuleUpdateOne) Where(ps ...predicate.BlockRule) *BlockRuleUpdateOne {
+	bruo.mutation.Where(ps...)
+	return bruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bruo *BlockRuleUpdateOne) Select(field string, fields ...string) *BlockRuleUpdateOne {
+	bruo.fields = append([]string{field}, fields...)
+	return bruo
+}
+
+// Save executes the query and returns the updated BlockRule entity.
+func (bruo *BlockRuleUpdateOne) Save(ctx context.Context) (*BlockRule, error) {
+	bruo.defaults()
+	return withHooks(ctx, bruo.sqlSave, bruo.mutation, bruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bruo *BlockRuleUpdateOne) SaveX(ctx context.Context) *BlockRule {
+	node, err := bruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bruo *BlockRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := bruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bruo *BlockRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := bruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bruo *BlockRuleUpdateOne) defaults() {
+	if _, ok := bruo.mutation.UpdatedAt(); !ok {
+		v := blockrule.UpdateDefaultUpdatedAt()
+		bruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bruo *BlockRuleUpdateOne) check() error {
+	if v, ok := bruo.mutation.GetType(); ok {
+		if err := blockrule.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "BlockRule.type": %w`, err)}
+		}
+	}
+	if v, ok := bruo.mutation.Value(); ok {
+		if err := blockrule.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "BlockRule.value": %w`, err)}
+		}
+	}
+	if v, ok := bruo.mutation.Reason(); ok {
+		if err := blockrule.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "BlockRule.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bruo *BlockRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlockRuleUpdateOne {
+	bruo.modifiers = append(bruo.modifiers, modifiers...)
+	return bruo
+}
+
+func (bruo *BlockRuleUpdateOne) sqlSave(ctx context.Context) (_node *BlockRule, err error) {
+	if err := bruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blockrule.Table, blockrule.Columns, sqlgraph.NewFieldSpec(blockrule.FieldID, field.TypeUint))
+	id, ok := bruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlockRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blockrule.FieldID)
+		for _, f := range fields {
+			if !blockrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blockrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(blockrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := bruo.mutation.GetType(); ok {
+		_spec.SetField(blockrule.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := bruo.mutation.Value(); ok {
+		_spec.SetField(blockrule.FieldValue, field.TypeString, value)
+	}
+	if value, ok := bruo.mutation.Reason(); ok {
+		_spec.SetField(blockrule.FieldReason, field.TypeString, value)
+	}
+	if bruo.mutation.ReasonCleared() {
+		_spec.ClearField(blockrule.FieldReason, field.TypeString)
+	}
+	if value, ok := bruo.mutation.ExpiresAt(); ok {
+		_spec.SetField(blockrule.FieldExpiresAt, field.TypeTime, value)
+	}
+	if bruo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(blockrule.FieldExpiresAt, field.TypeTime)
+	}
+	_spec.AddModifiers(bruo.modifiers...)
+	_node = &BlockRule{config: bruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blockrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bruo.mutation.done = true
+	return _node, nil
+}
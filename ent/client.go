@@ -15,11 +15,16 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/anzhiyu-c/anheyu-app/ent/accesstoken"
+	"github.com/anzhiyu-c/anheyu-app/ent/accountdeletion"
 	"github.com/anzhiyu-c/anheyu-app/ent/album"
 	"github.com/anzhiyu-c/anheyu-app/ent/albumcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/article"
 	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+	"github.com/anzhiyu-c/anheyu-app/ent/blockrule"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentthread"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/docseries"
 	"github.com/anzhiyu-c/anheyu-app/ent/entity"
@@ -29,25 +34,32 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/givemoney"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
 	"github.com/anzhiyu-c/anheyu-app/ent/link"
 	"github.com/anzhiyu-c/anheyu-app/ent/linkcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/linktag"
 	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
 	"github.com/anzhiyu-c/anheyu-app/ent/notificationtype"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
+	"github.com/anzhiyu-c/anheyu-app/ent/passkey"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
 	"github.com/anzhiyu-c/anheyu-app/ent/setting"
+	"github.com/anzhiyu-c/anheyu-app/ent/share"
 	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
 	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
 	"github.com/anzhiyu-c/anheyu-app/ent/tag"
 	"github.com/anzhiyu-c/anheyu-app/ent/urlstat"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
 	"github.com/anzhiyu-c/anheyu-app/ent/usernotificationconfig"
+	"github.com/anzhiyu-c/anheyu-app/ent/usersession"
+	"github.com/anzhiyu-c/anheyu-app/ent/usertwofactor"
 	"github.com/anzhiyu-c/anheyu-app/ent/visitorlog"
 	"github.com/anzhiyu-c/anheyu-app/ent/visitorstat"
+	"github.com/anzhiyu-c/anheyu-app/ent/webmention"
 )
 
 // Client is the client that holds all ent builders.
@@ -55,6 +67,10 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
+	// AccountDeletion is the client for interacting with the AccountDeletion builders.
+	AccountDeletion *AccountDeletionClient
 	// Album is the client for interacting with the Album builders.
 	Album *AlbumClient
 	// AlbumCategory is the client for interacting with the AlbumCategory builders.
@@ -63,8 +79,14 @@ type Client struct {
 	Article *ArticleClient
 	// ArticleHistory is the client for interacting with the ArticleHistory builders.
 	ArticleHistory *ArticleHistoryClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// BlockRule is the client for interacting with the BlockRule builders.
+	BlockRule *BlockRuleClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// CommentThread is the client for interacting with the CommentThread builders.
+	CommentThread *CommentThreadClient
 	// DirectLink is the client for interacting with the DirectLink builders.
 	DirectLink *DirectLinkClient
 	// DocSeries is the client for interacting with the DocSeries builders.
@@ -83,6 +105,8 @@ type Client struct {
 	FileEntity *FileEntityClient
 	// GiveMoney is the client for interacting with the GiveMoney builders.
 	GiveMoney *GiveMoneyClient
+	// InvitationCode is the client for interacting with the InvitationCode builders.
+	InvitationCode *InvitationCodeClient
 	// Link is the client for interacting with the Link builders.
 	Link *LinkClient
 	// LinkCategory is the client for interacting with the LinkCategory builders.
@@ -95,12 +119,16 @@ type Client struct {
 	NotificationType *NotificationTypeClient
 	// Page is the client for interacting with the Page builders.
 	Page *PageClient
+	// Passkey is the client for interacting with the Passkey builders.
+	Passkey *PasskeyClient
 	// PostCategory is the client for interacting with the PostCategory builders.
 	PostCategory *PostCategoryClient
 	// PostTag is the client for interacting with the PostTag builders.
 	PostTag *PostTagClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// Share is the client for interacting with the Share builders.
+	Share *ShareClient
 	// StoragePolicy is the client for interacting with the StoragePolicy builders.
 	StoragePolicy *StoragePolicyClient
 	// Subscriber is the client for interacting with the Subscriber builders.
@@ -113,14 +141,22 @@ type Client struct {
 	User *UserClient
 	// UserGroup is the client for interacting with the UserGroup builders.
 	UserGroup *UserGroupClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 	// UserInstalledTheme is the client for interacting with the UserInstalledTheme builders.
 	UserInstalledTheme *UserInstalledThemeClient
 	// UserNotificationConfig is the client for interacting with the UserNotificationConfig builders.
 	UserNotificationConfig *UserNotificationConfigClient
+	// UserSession is the client for interacting with the UserSession builders.
+	UserSession *UserSessionClient
+	// UserTwoFactor is the client for interacting with the UserTwoFactor builders.
+	UserTwoFactor *UserTwoFactorClient
 	// VisitorLog is the client for interacting with the VisitorLog builders.
 	VisitorLog *VisitorLogClient
 	// VisitorStat is the client for interacting with the VisitorStat builders.
 	VisitorStat *VisitorStatClient
+	// Webmention is the client for interacting with the Webmention builders.
+	Webmention *WebmentionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -132,11 +168,16 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccessToken = NewAccessTokenClient(c.config)
+	c.AccountDeletion = NewAccountDeletionClient(c.config)
 	c.Album = NewAlbumClient(c.config)
 	c.AlbumCategory = NewAlbumCategoryClient(c.config)
 	c.Article = NewArticleClient(c.config)
 	c.ArticleHistory = NewArticleHistoryClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.BlockRule = NewBlockRuleClient(c.config)
 	c.Comment = NewCommentClient(c.config)
+	c.CommentThread = NewCommentThreadClient(c.config)
 	c.DirectLink = NewDirectLinkClient(c.config)
 	c.DocSeries = NewDocSeriesClient(c.config)
 	c.Entity = NewEntityClient(c.config)
@@ -146,25 +187,32 @@ func (c *Client) init() {
 	c.File = NewFileClient(c.config)
 	c.FileEntity = NewFileEntityClient(c.config)
 	c.GiveMoney = NewGiveMoneyClient(c.config)
+	c.InvitationCode = NewInvitationCodeClient(c.config)
 	c.Link = NewLinkClient(c.config)
 	c.LinkCategory = NewLinkCategoryClient(c.config)
 	c.LinkTag = NewLinkTagClient(c.config)
 	c.Metadata = NewMetadataClient(c.config)
 	c.NotificationType = NewNotificationTypeClient(c.config)
 	c.Page = NewPageClient(c.config)
+	c.Passkey = NewPasskeyClient(c.config)
 	c.PostCategory = NewPostCategoryClient(c.config)
 	c.PostTag = NewPostTagClient(c.config)
 	c.Setting = NewSettingClient(c.config)
+	c.Share = NewShareClient(c.config)
 	c.StoragePolicy = NewStoragePolicyClient(c.config)
 	c.Subscriber = NewSubscriberClient(c.config)
 	c.Tag = NewTagClient(c.config)
 	c.URLStat = NewURLStatClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserGroup = NewUserGroupClient(c.config)
+	c.UserIdentity = NewUserIdentityClient(c.config)
 	c.UserInstalledTheme = NewUserInstalledThemeClient(c.config)
 	c.UserNotificationConfig = NewUserNotificationConfigClient(c.config)
+	c.UserSession = NewUserSessionClient(c.config)
+	c.UserTwoFactor = NewUserTwoFactorClient(c.config)
 	c.VisitorLog = NewVisitorLogClient(c.config)
 	c.VisitorStat = NewVisitorStatClient(c.config)
+	c.Webmention = NewWebmentionClient(c.config)
 }
 
 type (
@@ -257,11 +305,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		AccessToken:            NewAccessTokenClient(cfg),
+		AccountDeletion:        NewAccountDeletionClient(cfg),
 		Album:                  NewAlbumClient(cfg),
 		AlbumCategory:          NewAlbumCategoryClient(cfg),
 		Article:                NewArticleClient(cfg),
 		ArticleHistory:         NewArticleHistoryClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		BlockRule:              NewBlockRuleClient(cfg),
 		Comment:                NewCommentClient(cfg),
+		CommentThread:          NewCommentThreadClient(cfg),
 		DirectLink:             NewDirectLinkClient(cfg),
 		DocSeries:              NewDocSeriesClient(cfg),
 		Entity:                 NewEntityClient(cfg),
@@ -271,25 +324,32 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		File:                   NewFileClient(cfg),
 		FileEntity:             NewFileEntityClient(cfg),
 		GiveMoney:              NewGiveMoneyClient(cfg),
+		InvitationCode:         NewInvitationCodeClient(cfg),
 		Link:                   NewLinkClient(cfg),
 		LinkCategory:           NewLinkCategoryClient(cfg),
 		LinkTag:                NewLinkTagClient(cfg),
 		Metadata:               NewMetadataClient(cfg),
 		NotificationType:       NewNotificationTypeClient(cfg),
 		Page:                   NewPageClient(cfg),
+		Passkey:                NewPasskeyClient(cfg),
 		PostCategory:           NewPostCategoryClient(cfg),
 		PostTag:                NewPostTagClient(cfg),
 		Setting:                NewSettingClient(cfg),
+		Share:                  NewShareClient(cfg),
 		StoragePolicy:          NewStoragePolicyClient(cfg),
 		Subscriber:             NewSubscriberClient(cfg),
 		Tag:                    NewTagClient(cfg),
 		URLStat:                NewURLStatClient(cfg),
 		User:                   NewUserClient(cfg),
 		UserGroup:              NewUserGroupClient(cfg),
+		UserIdentity:           NewUserIdentityClient(cfg),
 		UserInstalledTheme:     NewUserInstalledThemeClient(cfg),
 		UserNotificationConfig: NewUserNotificationConfigClient(cfg),
+		UserSession:            NewUserSessionClient(cfg),
+		UserTwoFactor:          NewUserTwoFactorClient(cfg),
 		VisitorLog:             NewVisitorLogClient(cfg),
 		VisitorStat:            NewVisitorStatClient(cfg),
+		Webmention:             NewWebmentionClient(cfg),
 	}, nil
 }
 
@@ -309,11 +369,16 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		AccessToken:            NewAccessTokenClient(cfg),
+		AccountDeletion:        NewAccountDeletionClient(cfg),
 		Album:                  NewAlbumClient(cfg),
 		AlbumCategory:          NewAlbumCategoryClient(cfg),
 		Article:                NewArticleClient(cfg),
 		ArticleHistory:         NewArticleHistoryClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		BlockRule:              NewBlockRuleClient(cfg),
 		Comment:                NewCommentClient(cfg),
+		CommentThread:          NewCommentThreadClient(cfg),
 		DirectLink:             NewDirectLinkClient(cfg),
 		DocSeries:              NewDocSeriesClient(cfg),
 		Entity:                 NewEntityClient(cfg),
@@ -323,32 +388,39 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		File:                   NewFileClient(cfg),
 		FileEntity:             NewFileEntityClient(cfg),
 		GiveMoney:              NewGiveMoneyClient(cfg),
+		InvitationCode:         NewInvitationCodeClient(cfg),
 		Link:                   NewLinkClient(cfg),
 		LinkCategory:           NewLinkCategoryClient(cfg),
 		LinkTag:                NewLinkTagClient(cfg),
 		Metadata:               NewMetadataClient(cfg),
 		NotificationType:       NewNotificationTypeClient(cfg),
 		Page:                   NewPageClient(cfg),
+		Passkey:                NewPasskeyClient(cfg),
 		PostCategory:           NewPostCategoryClient(cfg),
 		PostTag:                NewPostTagClient(cfg),
 		Setting:                NewSettingClient(cfg),
+		Share:                  NewShareClient(cfg),
 		StoragePolicy:          NewStoragePolicyClient(cfg),
 		Subscriber:             NewSubscriberClient(cfg),
 		Tag:                    NewTagClient(cfg),
 		URLStat:                NewURLStatClient(cfg),
 		User:                   NewUserClient(cfg),
 		UserGroup:              NewUserGroupClient(cfg),
+		UserIdentity:           NewUserIdentityClient(cfg),
 		UserInstalledTheme:     NewUserInstalledThemeClient(cfg),
 		UserNotificationConfig: NewUserNotificationConfigClient(cfg),
+		UserSession:            NewUserSessionClient(cfg),
+		UserTwoFactor:          NewUserTwoFactorClient(cfg),
 		VisitorLog:             NewVisitorLogClient(cfg),
 		VisitorStat:            NewVisitorStatClient(cfg),
+		Webmention:             NewWebmentionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Album.
+//		AccessToken.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -371,12 +443,15 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Album, c.AlbumCategory, c.Article, c.ArticleHistory, c.Comment, c.DirectLink,
-		c.DocSeries, c.Entity, c.Essay, c.FCirclePost, c.FCircleStatistic, c.File,
-		c.FileEntity, c.GiveMoney, c.Link, c.LinkCategory, c.LinkTag, c.Metadata,
-		c.NotificationType, c.Page, c.PostCategory, c.PostTag, c.Setting,
-		c.StoragePolicy, c.Subscriber, c.Tag, c.URLStat, c.User, c.UserGroup,
-		c.UserInstalledTheme, c.UserNotificationConfig, c.VisitorLog, c.VisitorStat,
+		c.AccessToken, c.AccountDeletion, c.Album, c.AlbumCategory, c.Article,
+		c.ArticleHistory, c.AuditLog, c.BlockRule, c.Comment, c.CommentThread,
+		c.DirectLink, c.DocSeries, c.Entity, c.Essay, c.FCirclePost,
+		c.FCircleStatistic, c.File, c.FileEntity, c.GiveMoney, c.InvitationCode,
+		c.Link, c.LinkCategory, c.LinkTag, c.Metadata, c.NotificationType, c.Page,
+		c.Passkey, c.PostCategory, c.PostTag, c.Setting, c.Share, c.StoragePolicy,
+		c.Subscriber, c.Tag, c.URLStat, c.User, c.UserGroup, c.UserIdentity,
+		c.UserInstalledTheme, c.UserNotificationConfig, c.UserSession, c.UserTwoFactor,
+		c.VisitorLog, c.VisitorStat, c.Webmention,
 	} {
 		n.Use(hooks...)
 	}
@@ -386,12 +461,15 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Album, c.AlbumCategory, c.Article, c.ArticleHistory, c.Comment, c.DirectLink,
-		c.DocSeries, c.Entity, c.Essay, c.FCirclePost, c.FCircleStatistic, c.File,
-		c.FileEntity, c.GiveMoney, c.Link, c.LinkCategory, c.LinkTag, c.Metadata,
-		c.NotificationType, c.Page, c.PostCategory, c.PostTag, c.Setting,
-		c.StoragePolicy, c.Subscriber, c.Tag, c.URLStat, c.User, c.UserGroup,
-		c.UserInstalledTheme, c.UserNotificationConfig, c.VisitorLog, c.VisitorStat,
+		c.AccessToken, c.AccountDeletion, c.Album, c.AlbumCategory, c.Article,
+		c.ArticleHistory, c.AuditLog, c.BlockRule, c.Comment, c.CommentThread,
+		c.DirectLink, c.DocSeries, c.Entity, c.Essay, c.FCirclePost,
+		c.FCircleStatistic, c.File, c.FileEntity, c.GiveMoney, c.InvitationCode,
+		c.Link, c.LinkCategory, c.LinkTag, c.Metadata, c.NotificationType, c.Page,
+		c.Passkey, c.PostCategory, c.PostTag, c.Setting, c.Share, c.StoragePolicy,
+		c.Subscriber, c.Tag, c.URLStat, c.User, c.UserGroup, c.UserIdentity,
+		c.UserInstalledTheme, c.UserNotificationConfig, c.UserSession, c.UserTwoFactor,
+		c.VisitorLog, c.VisitorStat, c.Webmention,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -400,6 +478,10 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccessTokenMutation:
+		return c.AccessToken.mutate(ctx, m)
+	case *AccountDeletionMutation:
+		return c.AccountDeletion.mutate(ctx, m)
 	case *AlbumMutation:
 		return c.Album.mutate(ctx, m)
 	case *AlbumCategoryMutation:
@@ -408,8 +490,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Article.mutate(ctx, m)
 	case *ArticleHistoryMutation:
 		return c.ArticleHistory.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *BlockRuleMutation:
+		return c.BlockRule.mutate(ctx, m)
 	case *CommentMutation:
 		return c.Comment.mutate(ctx, m)
+	case *CommentThreadMutation:
+		return c.CommentThread.mutate(ctx, m)
 	case *DirectLinkMutation:
 		return c.DirectLink.mutate(ctx, m)
 	case *DocSeriesMutation:
@@ -428,6 +516,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.FileEntity.mutate(ctx, m)
 	case *GiveMoneyMutation:
 		return c.GiveMoney.mutate(ctx, m)
+	case *InvitationCodeMutation:
+		return c.InvitationCode.mutate(ctx, m)
 	case *LinkMutation:
 		return c.Link.mutate(ctx, m)
 	case *LinkCategoryMutation:
@@ -440,12 +530,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.NotificationType.mutate(ctx, m)
 	case *PageMutation:
 		return c.Page.mutate(ctx, m)
+	case *PasskeyMutation:
+		return c.Passkey.mutate(ctx, m)
 	case *PostCategoryMutation:
 		return c.PostCategory.mutate(ctx, m)
 	case *PostTagMutation:
 		return c.PostTag.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
+	case *ShareMutation:
+		return c.Share.mutate(ctx, m)
 	case *StoragePolicyMutation:
 		return c.StoragePolicy.mutate(ctx, m)
 	case *SubscriberMutation:
@@ -458,19 +552,293 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.User.mutate(ctx, m)
 	case *UserGroupMutation:
 		return c.UserGroup.mutate(ctx, m)
+	case *UserIdentityMutation:
+		return c.UserIdentity.mutate(ctx, m)
 	case *UserInstalledThemeMutation:
 		return c.UserInstalledTheme.mutate(ctx, m)
 	case *UserNotificationConfigMutation:
 		return c.UserNotificationConfig.mutate(ctx, m)
+	case *UserSessionMutation:
+		return c.UserSession.mutate(ctx, m)
+	case *UserTwoFactorMutation:
+		return c.UserTwoFactor.mutate(ctx, m)
 	case *VisitorLogMutation:
 		return c.VisitorLog.mutate(ctx, m)
 	case *VisitorStatMutation:
 		return c.VisitorStat.mutate(ctx, m)
+	case *WebmentionMutation:
+		return c.Webmention.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AccessTokenClient is a client for the AccessToken schema.
+type AccessTokenClient struct {
+	config
+}
+
+// NewAccessTokenClient returns a client for the AccessToken from the given config.
+func NewAccessTokenClient(c config) *AccessTokenClient {
+	return &AccessTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accesstoken.Hooks(f(g(h())))`.
+func (c *AccessTokenClient) Use(hooks ...Hook) {
+	c.hooks.AccessToken = append(c.hooks.AccessToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accesstoken.Intercept(f(g(h())))`.
+func (c *AccessTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessToken = append(c.inters.AccessToken, interceptors...)
+}
+
+// Create returns a builder for creating a AccessToken entity.
+func (c *AccessTokenClient) Create() *AccessTokenCreate {
+	mutation := newAccessTokenMutation(c.config, OpCreate)
+	return &AccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccessToken entities.
+func (c *AccessTokenClient) CreateBulk(builders ...*AccessTokenCreate) *AccessTokenCreateBulk {
+	return &AccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccessTokenClient) MapCreateBulk(slice any, setFunc func(*AccessTokenCreate, int)) *AccessTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccessTokenCreateBulk{err: fmt.Errorf("calling to AccessTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccessTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccessToken.
+func (c *AccessTokenClient) Update() *AccessTokenUpdate {
+	mutation := newAccessTokenMutation(c.config, OpUpdate)
+	return &AccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccessTokenClient) UpdateOne(at *AccessToken) *AccessTokenUpdateOne {
+	mutation := newAccessTokenMutation(c.config, OpUpdateOne, withAccessToken(at))
+	return &AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccessTokenClient) UpdateOneID(id uint) *AccessTokenUpdateOne {
+	mutation := newAccessTokenMutation(c.config, OpUpdateOne, withAccessTokenID(id))
+	return &AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccessToken.
+func (c *AccessTokenClient) Delete() *AccessTokenDelete {
+	mutation := newAccessTokenMutation(c.config, OpDelete)
+	return &AccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccessTokenClient) DeleteOne(at *AccessToken) *AccessTokenDeleteOne {
+	return c.DeleteOneID(at.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccessTokenClient) DeleteOneID(id uint) *AccessTokenDeleteOne {
+	builder := c.Delete().Where(accesstoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccessTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for AccessToken.
+func (c *AccessTokenClient) Query() *AccessTokenQuery {
+	return &AccessTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccessToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccessToken entity by its id.
+func (c *AccessTokenClient) Get(ctx context.Context, id uint) (*AccessToken, error) {
+	return c.Query().Where(accesstoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccessTokenClient) GetX(ctx context.Context, id uint) *AccessToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AccessTokenClient) Hooks() []Hook {
+	return c.hooks.AccessToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccessTokenClient) Interceptors() []Interceptor {
+	return c.inters.AccessToken
+}
+
+func (c *AccessTokenClient) mutate(ctx context.Context, m *AccessTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccessToken mutation op: %q", m.Op())
+	}
+}
+
+// AccountDeletionClient is a client for the AccountDeletion schema.
+type AccountDeletionClient struct {
+	config
+}
+
+// NewAccountDeletionClient returns a client for the AccountDeletion from the given config.
+func NewAccountDeletionClient(c config) *AccountDeletionClient {
+	return &AccountDeletionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accountdeletion.Hooks(f(g(h())))`.
+func (c *AccountDeletionClient) Use(hooks ...Hook) {
+	c.hooks.AccountDeletion = append(c.hooks.AccountDeletion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accountdeletion.Intercept(f(g(h())))`.
+func (c *AccountDeletionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccountDeletion = append(c.inters.AccountDeletion, interceptors...)
+}
+
+// Create returns a builder for creating a AccountDeletion entity.
+func (c *AccountDeletionClient) Create() *AccountDeletionCreate {
+	mutation := newAccountDeletionMutation(c.config, OpCreate)
+	return &AccountDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccountDeletion entities.
+func (c *AccountDeletionClient) CreateBulk(builders ...*AccountDeletionCreate) *AccountDeletionCreateBulk {
+	return &AccountDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccountDeletionClient) MapCreateBulk(slice any, setFunc func(*AccountDeletionCreate, int)) *AccountDeletionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccountDeletionCreateBulk{err: fmt.Errorf("calling to AccountDeletionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccountDeletionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccountDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccountDeletion.
+func (c *AccountDeletionClient) Update() *AccountDeletionUpdate {
+	mutation := newAccountDeletionMutation(c.config, OpUpdate)
+	return &AccountDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccountDeletionClient) UpdateOne(ad *AccountDeletion) *AccountDeletionUpdateOne {
+	mutation := newAccountDeletionMutation(c.config, OpUpdateOne, withAccountDeletion(ad))
+	return &AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccountDeletionClient) UpdateOneID(id uint) *AccountDeletionUpdateOne {
+	mutation := newAccountDeletionMutation(c.config, OpUpdateOne, withAccountDeletionID(id))
+	return &AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccountDeletion.
+func (c *AccountDeletionClient) Delete() *AccountDeletionDelete {
+	mutation := newAccountDeletionMutation(c.config, OpDelete)
+	return &AccountDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccountDeletionClient) DeleteOne(ad *AccountDeletion) *AccountDeletionDeleteOne {
+	return c.DeleteOneID(ad.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccountDeletionClient) DeleteOneID(id uint) *AccountDeletionDeleteOne {
+	builder := c.Delete().Where(accountdeletion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccountDeletionDeleteOne{builder}
+}
+
+// Query returns a query builder for AccountDeletion.
+func (c *AccountDeletionClient) Query() *AccountDeletionQuery {
+	return &AccountDeletionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccountDeletion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccountDeletion entity by its id.
+func (c *AccountDeletionClient) Get(ctx context.Context, id uint) (*AccountDeletion, error) {
+	return c.Query().Where(accountdeletion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccountDeletionClient) GetX(ctx context.Context, id uint) *AccountDeletion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AccountDeletionClient) Hooks() []Hook {
+	return c.hooks.AccountDeletion
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccountDeletionClient) Interceptors() []Interceptor {
+	return c.inters.AccountDeletion
+}
+
+func (c *AccountDeletionClient) mutate(ctx context.Context, m *AccountDeletionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccountDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccountDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccountDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccountDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccountDeletion mutation op: %q", m.Op())
+	}
+}
+
 // AlbumClient is a client for the Album schema.
 type AlbumClient struct {
 	config
@@ -1133,107 +1501,107 @@ func (c *ArticleHistoryClient) mutate(ctx context.Context, m *ArticleHistoryMuta
 	}
 }
 
-// CommentClient is a client for the Comment schema.
-type CommentClient struct {
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
 	config
 }
 
-// NewCommentClient returns a client for the Comment from the given config.
-func NewCommentClient(c config) *CommentClient {
-	return &CommentClient{config: c}
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
-func (c *CommentClient) Use(hooks ...Hook) {
-	c.hooks.Comment = append(c.hooks.Comment, hooks...)
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
-func (c *CommentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Comment = append(c.inters.Comment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a Comment entity.
-func (c *CommentClient) Create() *CommentCreate {
-	mutation := newCommentMutation(c.config, OpCreate)
-	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Comment entities.
-func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
-	return &CommentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CommentClient) MapCreateBulk(slice any, setFunc func(*CommentCreate, int)) *CommentCreateBulk {
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CommentCreateBulk{err: fmt.Errorf("calling to CommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CommentCreate, rv.Len())
+	builders := make([]*AuditLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CommentCreateBulk{config: c.config, builders: builders}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Comment.
-func (c *CommentClient) Update() *CommentUpdate {
-	mutation := newCommentMutation(c.config, OpUpdate)
-	return &CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CommentClient) UpdateOne(co *Comment) *CommentUpdateOne {
-	mutation := newCommentMutation(c.config, OpUpdateOne, withComment(co))
-	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CommentClient) UpdateOneID(id uint) *CommentUpdateOne {
-	mutation := newCommentMutation(c.config, OpUpdateOne, withCommentID(id))
-	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOneID(id uint) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Comment.
-func (c *CommentClient) Delete() *CommentDelete {
-	mutation := newCommentMutation(c.config, OpDelete)
-	return &CommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CommentClient) DeleteOne(co *Comment) *CommentDeleteOne {
-	return c.DeleteOneID(co.ID)
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CommentClient) DeleteOneID(id uint) *CommentDeleteOne {
-	builder := c.Delete().Where(comment.ID(id))
+func (c *AuditLogClient) DeleteOneID(id uint) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CommentDeleteOne{builder}
+	return &AuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for Comment.
-func (c *CommentClient) Query() *CommentQuery {
-	return &CommentQuery{
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeComment},
+		ctx:    &QueryContext{Type: TypeAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Comment entity by its id.
-func (c *CommentClient) Get(ctx context.Context, id uint) (*Comment, error) {
-	return c.Query().Where(comment.ID(id)).Only(ctx)
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id uint) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CommentClient) GetX(ctx context.Context, id uint) *Comment {
+func (c *AuditLogClient) GetX(ctx context.Context, id uint) *AuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1241,77 +1609,476 @@ func (c *CommentClient) GetX(ctx context.Context, id uint) *Comment {
 	return obj
 }
 
-// QueryUser queries the user edge of a Comment.
-func (c *CommentClient) QueryUser(co *Comment) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(comment.Table, comment.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, comment.UserTable, comment.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryChildren queries the children edge of a Comment.
-func (c *CommentClient) QueryChildren(co *Comment) *CommentQuery {
-	query := (&CommentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(comment.Table, comment.FieldID, id),
-			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, comment.ChildrenTable, comment.ChildrenColumn),
-		)
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryParent queries the parent edge of a Comment.
-func (c *CommentClient) QueryParent(co *Comment) *CommentQuery {
-	query := (&CommentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(comment.Table, comment.FieldID, id),
-			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, comment.ParentTable, comment.ParentColumn),
-		)
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *CommentClient) Hooks() []Hook {
-	hooks := c.hooks.Comment
-	return append(hooks[:len(hooks):len(hooks)], comment.Hooks[:]...)
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *CommentClient) Interceptors() []Interceptor {
-	return c.inters.Comment
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
 }
 
-func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value, error) {
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Comment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
+// BlockRuleClient is a client for the BlockRule schema.
+type BlockRuleClient struct {
+	config
+}
+
+// NewBlockRuleClient returns a client for the BlockRule from the given config.
+func NewBlockRuleClient(c config) *BlockRuleClient {
+	return &BlockRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `blockrule.Hooks(f(g(h())))`.
+func (c *BlockRuleClient) Use(hooks ...Hook) {
+	c.hooks.BlockRule = append(c.hooks.BlockRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `blockrule.Intercept(f(g(h())))`.
+func (c *BlockRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BlockRule = append(c.inters.BlockRule, interceptors...)
+}
+
+// Create returns a builder for creating a BlockRule entity.
+func (c *BlockRuleClient) Create() *BlockRuleCreate {
+	mutation := newBlockRuleMutation(c.config, OpCreate)
+	return &BlockRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BlockRule entities.
+func (c *BlockRuleClient) CreateBulk(builders ...*BlockRuleCreate) *BlockRuleCreateBulk {
+	return &BlockRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BlockRuleClient) MapCreateBulk(slice any, setFunc func(*BlockRuleCreate, int)) *BlockRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BlockRuleCreateBulk{err: fmt.Errorf("calling to BlockRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BlockRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BlockRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BlockRule.
+func (c *BlockRuleClient) Update() *BlockRuleUpdate {
+	mutation := newBlockRuleMutation(c.config, OpUpdate)
+	return &BlockRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BlockRuleClient) UpdateOne(br *BlockRule) *BlockRuleUpdateOne {
+	mutation := newBlockRuleMutation(c.config, OpUpdateOne, withBlockRule(br))
+	return &BlockRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BlockRuleClient) UpdateOneID(id uint) *BlockRuleUpdateOne {
+	mutation := newBlockRuleMutation(c.config, OpUpdateOne, withBlockRuleID(id))
+	return &BlockRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BlockRule.
+func (c *BlockRuleClient) Delete() *BlockRuleDelete {
+	mutation := newBlockRuleMutation(c.config, OpDelete)
+	return &BlockRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlockRuleClient) DeleteOne(br *BlockRule) *BlockRuleDeleteOne {
+	return c.DeleteOneID(br.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BlockRuleClient) DeleteOneID(id uint) *BlockRuleDeleteOne {
+	builder := c.Delete().Where(blockrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlockRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for BlockRule.
+func (c *BlockRuleClient) Query() *BlockRuleQuery {
+	return &BlockRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBlockRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BlockRule entity by its id.
+func (c *BlockRuleClient) Get(ctx context.Context, id uint) (*BlockRule, error) {
+	return c.Query().Where(blockrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlockRuleClient) GetX(ctx context.Context, id uint) *BlockRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BlockRuleClient) Hooks() []Hook {
+	return c.hooks.BlockRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *BlockRuleClient) Interceptors() []Interceptor {
+	return c.inters.BlockRule
+}
+
+func (c *BlockRuleClient) mutate(ctx context.Context, m *BlockRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BlockRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BlockRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BlockRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BlockRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BlockRule mutation op: %q", m.Op())
+	}
+}
+
+// CommentClient is a client for the Comment schema.
+type CommentClient struct {
+	config
+}
+
+// NewCommentClient returns a client for the Comment from the given config.
+func NewCommentClient(c config) *CommentClient {
+	return &CommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
+func (c *CommentClient) Use(hooks ...Hook) {
+	c.hooks.Comment = append(c.hooks.Comment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
+func (c *CommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
+}
+
+// Create returns a builder for creating a Comment entity.
+func (c *CommentClient) Create() *CommentCreate {
+	mutation := newCommentMutation(c.config, OpCreate)
+	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Comment entities.
+func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
+	return &CommentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommentClient) MapCreateBulk(slice any, setFunc func(*CommentCreate, int)) *CommentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommentCreateBulk{err: fmt.Errorf("calling to CommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Comment.
+func (c *CommentClient) Update() *CommentUpdate {
+	mutation := newCommentMutation(c.config, OpUpdate)
+	return &CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommentClient) UpdateOne(co *Comment) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withComment(co))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommentClient) UpdateOneID(id uint) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withCommentID(id))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Comment.
+func (c *CommentClient) Delete() *CommentDelete {
+	mutation := newCommentMutation(c.config, OpDelete)
+	return &CommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommentClient) DeleteOne(co *Comment) *CommentDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommentClient) DeleteOneID(id uint) *CommentDeleteOne {
+	builder := c.Delete().Where(comment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommentDeleteOne{builder}
+}
+
+// Query returns a query builder for Comment.
+func (c *CommentClient) Query() *CommentQuery {
+	return &CommentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeComment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Comment entity by its id.
+func (c *CommentClient) Get(ctx context.Context, id uint) (*Comment, error) {
+	return c.Query().Where(comment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentClient) GetX(ctx context.Context, id uint) *Comment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Comment.
+func (c *CommentClient) QueryUser(co *Comment) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.UserTable, comment.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Comment.
+func (c *CommentClient) QueryChildren(co *Comment) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, comment.ChildrenTable, comment.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Comment.
+func (c *CommentClient) QueryParent(co *Comment) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.ParentTable, comment.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CommentClient) Hooks() []Hook {
+	hooks := c.hooks.Comment
+	return append(hooks[:len(hooks):len(hooks)], comment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommentClient) Interceptors() []Interceptor {
+	return c.inters.Comment
+}
+
+func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Comment mutation op: %q", m.Op())
+	}
+}
+
+// CommentThreadClient is a client for the CommentThread schema.
+type CommentThreadClient struct {
+	config
+}
+
+// NewCommentThreadClient returns a client for the CommentThread from the given config.
+func NewCommentThreadClient(c config) *CommentThreadClient {
+	return &CommentThreadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commentthread.Hooks(f(g(h())))`.
+func (c *CommentThreadClient) Use(hooks ...Hook) {
+	c.hooks.CommentThread = append(c.hooks.CommentThread, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commentthread.Intercept(f(g(h())))`.
+func (c *CommentThreadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommentThread = append(c.inters.CommentThread, interceptors...)
+}
+
+// Create returns a builder for creating a CommentThread entity.
+func (c *CommentThreadClient) Create() *CommentThreadCreate {
+	mutation := newCommentThreadMutation(c.config, OpCreate)
+	return &CommentThreadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommentThread entities.
+func (c *CommentThreadClient) CreateBulk(builders ...*CommentThreadCreate) *CommentThreadCreateBulk {
+	return &CommentThreadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommentThreadClient) MapCreateBulk(slice any, setFunc func(*CommentThreadCreate, int)) *CommentThreadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommentThreadCreateBulk{err: fmt.Errorf("calling to CommentThreadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommentThreadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommentThreadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommentThread.
+func (c *CommentThreadClient) Update() *CommentThreadUpdate {
+	mutation := newCommentThreadMutation(c.config, OpUpdate)
+	return &CommentThreadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommentThreadClient) UpdateOne(ct *CommentThread) *CommentThreadUpdateOne {
+	mutation := newCommentThreadMutation(c.config, OpUpdateOne, withCommentThread(ct))
+	return &CommentThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommentThreadClient) UpdateOneID(id uint) *CommentThreadUpdateOne {
+	mutation := newCommentThreadMutation(c.config, OpUpdateOne, withCommentThreadID(id))
+	return &CommentThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommentThread.
+func (c *CommentThreadClient) Delete() *CommentThreadDelete {
+	mutation := newCommentThreadMutation(c.config, OpDelete)
+	return &CommentThreadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommentThreadClient) DeleteOne(ct *CommentThread) *CommentThreadDeleteOne {
+	return c.DeleteOneID(ct.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommentThreadClient) DeleteOneID(id uint) *CommentThreadDeleteOne {
+	builder := c.Delete().Where(commentthread.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommentThreadDeleteOne{builder}
+}
+
+// Query returns a query builder for CommentThread.
+func (c *CommentThreadClient) Query() *CommentThreadQuery {
+	return &CommentThreadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommentThread},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommentThread entity by its id.
+func (c *CommentThreadClient) Get(ctx context.Context, id uint) (*CommentThread, error) {
+	return c.Query().Where(commentthread.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentThreadClient) GetX(ctx context.Context, id uint) *CommentThread {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommentThreadClient) Hooks() []Hook {
+	return c.hooks.CommentThread
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommentThreadClient) Interceptors() []Interceptor {
+	return c.inters.CommentThread
+}
+
+func (c *CommentThreadClient) mutate(ctx context.Context, m *CommentThreadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentThreadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentThreadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentThreadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CommentThread mutation op: %q", m.Op())
 	}
 }
 
@@ -2705,7 +3472,140 @@ func (c *GiveMoneyClient) mutate(ctx context.Context, m *GiveMoneyMutation) (Val
 	case OpDelete, OpDeleteOne:
 		return (&GiveMoneyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown GiveMoney mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown GiveMoney mutation op: %q", m.Op())
+	}
+}
+
+// InvitationCodeClient is a client for the InvitationCode schema.
+type InvitationCodeClient struct {
+	config
+}
+
+// NewInvitationCodeClient returns a client for the InvitationCode from the given config.
+func NewInvitationCodeClient(c config) *InvitationCodeClient {
+	return &InvitationCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invitationcode.Hooks(f(g(h())))`.
+func (c *InvitationCodeClient) Use(hooks ...Hook) {
+	c.hooks.InvitationCode = append(c.hooks.InvitationCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invitationcode.Intercept(f(g(h())))`.
+func (c *InvitationCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvitationCode = append(c.inters.InvitationCode, interceptors...)
+}
+
+// Create returns a builder for creating a InvitationCode entity.
+func (c *InvitationCodeClient) Create() *InvitationCodeCreate {
+	mutation := newInvitationCodeMutation(c.config, OpCreate)
+	return &InvitationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvitationCode entities.
+func (c *InvitationCodeClient) CreateBulk(builders ...*InvitationCodeCreate) *InvitationCodeCreateBulk {
+	return &InvitationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvitationCodeClient) MapCreateBulk(slice any, setFunc func(*InvitationCodeCreate, int)) *InvitationCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvitationCodeCreateBulk{err: fmt.Errorf("calling to InvitationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvitationCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvitationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvitationCode.
+func (c *InvitationCodeClient) Update() *InvitationCodeUpdate {
+	mutation := newInvitationCodeMutation(c.config, OpUpdate)
+	return &InvitationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvitationCodeClient) UpdateOne(ic *InvitationCode) *InvitationCodeUpdateOne {
+	mutation := newInvitationCodeMutation(c.config, OpUpdateOne, withInvitationCode(ic))
+	return &InvitationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvitationCodeClient) UpdateOneID(id uint) *InvitationCodeUpdateOne {
+	mutation := newInvitationCodeMutation(c.config, OpUpdateOne, withInvitationCodeID(id))
+	return &InvitationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvitationCode.
+func (c *InvitationCodeClient) Delete() *InvitationCodeDelete {
+	mutation := newInvitationCodeMutation(c.config, OpDelete)
+	return &InvitationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvitationCodeClient) DeleteOne(ic *InvitationCode) *InvitationCodeDeleteOne {
+	return c.DeleteOneID(ic.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvitationCodeClient) DeleteOneID(id uint) *InvitationCodeDeleteOne {
+	builder := c.Delete().Where(invitationcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvitationCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for InvitationCode.
+func (c *InvitationCodeClient) Query() *InvitationCodeQuery {
+	return &InvitationCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvitationCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InvitationCode entity by its id.
+func (c *InvitationCodeClient) Get(ctx context.Context, id uint) (*InvitationCode, error) {
+	return c.Query().Where(invitationcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvitationCodeClient) GetX(ctx context.Context, id uint) *InvitationCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvitationCodeClient) Hooks() []Hook {
+	return c.hooks.InvitationCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvitationCodeClient) Interceptors() []Interceptor {
+	return c.inters.InvitationCode
+}
+
+func (c *InvitationCodeClient) mutate(ctx context.Context, m *InvitationCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvitationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvitationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvitationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvitationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InvitationCode mutation op: %q", m.Op())
 	}
 }
 
@@ -3605,6 +4505,139 @@ func (c *PageClient) mutate(ctx context.Context, m *PageMutation) (Value, error)
 	}
 }
 
+// PasskeyClient is a client for the Passkey schema.
+type PasskeyClient struct {
+	config
+}
+
+// NewPasskeyClient returns a client for the Passkey from the given config.
+func NewPasskeyClient(c config) *PasskeyClient {
+	return &PasskeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passkey.Hooks(f(g(h())))`.
+func (c *PasskeyClient) Use(hooks ...Hook) {
+	c.hooks.Passkey = append(c.hooks.Passkey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passkey.Intercept(f(g(h())))`.
+func (c *PasskeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Passkey = append(c.inters.Passkey, interceptors...)
+}
+
+// Create returns a builder for creating a Passkey entity.
+func (c *PasskeyClient) Create() *PasskeyCreate {
+	mutation := newPasskeyMutation(c.config, OpCreate)
+	return &PasskeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Passkey entities.
+func (c *PasskeyClient) CreateBulk(builders ...*PasskeyCreate) *PasskeyCreateBulk {
+	return &PasskeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasskeyClient) MapCreateBulk(slice any, setFunc func(*PasskeyCreate, int)) *PasskeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasskeyCreateBulk{err: fmt.Errorf("calling to PasskeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasskeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasskeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Passkey.
+func (c *PasskeyClient) Update() *PasskeyUpdate {
+	mutation := newPasskeyMutation(c.config, OpUpdate)
+	return &PasskeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasskeyClient) UpdateOne(pa *Passkey) *PasskeyUpdateOne {
+	mutation := newPasskeyMutation(c.config, OpUpdateOne, withPasskey(pa))
+	return &PasskeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasskeyClient) UpdateOneID(id uint) *PasskeyUpdateOne {
+	mutation := newPasskeyMutation(c.config, OpUpdateOne, withPasskeyID(id))
+	return &PasskeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Passkey.
+func (c *PasskeyClient) Delete() *PasskeyDelete {
+	mutation := newPasskeyMutation(c.config, OpDelete)
+	return &PasskeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasskeyClient) DeleteOne(pa *Passkey) *PasskeyDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasskeyClient) DeleteOneID(id uint) *PasskeyDeleteOne {
+	builder := c.Delete().Where(passkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasskeyDeleteOne{builder}
+}
+
+// Query returns a query builder for Passkey.
+func (c *PasskeyClient) Query() *PasskeyQuery {
+	return &PasskeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasskey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Passkey entity by its id.
+func (c *PasskeyClient) Get(ctx context.Context, id uint) (*Passkey, error) {
+	return c.Query().Where(passkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasskeyClient) GetX(ctx context.Context, id uint) *Passkey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PasskeyClient) Hooks() []Hook {
+	return c.hooks.Passkey
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasskeyClient) Interceptors() []Interceptor {
+	return c.inters.Passkey
+}
+
+func (c *PasskeyClient) mutate(ctx context.Context, m *PasskeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasskeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasskeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasskeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasskeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Passkey mutation op: %q", m.Op())
+	}
+}
+
 // PostCategoryClient is a client for the PostCategory schema.
 type PostCategoryClient struct {
 	config
@@ -4039,6 +5072,139 @@ func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value,
 	}
 }
 
+// ShareClient is a client for the Share schema.
+type ShareClient struct {
+	config
+}
+
+// NewShareClient returns a client for the Share from the given config.
+func NewShareClient(c config) *ShareClient {
+	return &ShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `share.Hooks(f(g(h())))`.
+func (c *ShareClient) Use(hooks ...Hook) {
+	c.hooks.Share = append(c.hooks.Share, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `share.Intercept(f(g(h())))`.
+func (c *ShareClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Share = append(c.inters.Share, interceptors...)
+}
+
+// Create returns a builder for creating a Share entity.
+func (c *ShareClient) Create() *ShareCreate {
+	mutation := newShareMutation(c.config, OpCreate)
+	return &ShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Share entities.
+func (c *ShareClient) CreateBulk(builders ...*ShareCreate) *ShareCreateBulk {
+	return &ShareCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShareClient) MapCreateBulk(slice any, setFunc func(*ShareCreate, int)) *ShareCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShareCreateBulk{err: fmt.Errorf("calling to ShareClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShareCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShareCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Share.
+func (c *ShareClient) Update() *ShareUpdate {
+	mutation := newShareMutation(c.config, OpUpdate)
+	return &ShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareClient) UpdateOne(s *Share) *ShareUpdateOne {
+	mutation := newShareMutation(c.config, OpUpdateOne, withShare(s))
+	return &ShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareClient) UpdateOneID(id uint) *ShareUpdateOne {
+	mutation := newShareMutation(c.config, OpUpdateOne, withShareID(id))
+	return &ShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Share.
+func (c *ShareClient) Delete() *ShareDelete {
+	mutation := newShareMutation(c.config, OpDelete)
+	return &ShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShareClient) DeleteOne(s *Share) *ShareDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShareClient) DeleteOneID(id uint) *ShareDeleteOne {
+	builder := c.Delete().Where(share.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareDeleteOne{builder}
+}
+
+// Query returns a query builder for Share.
+func (c *ShareClient) Query() *ShareQuery {
+	return &ShareQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShare},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Share entity by its id.
+func (c *ShareClient) Get(ctx context.Context, id uint) (*Share, error) {
+	return c.Query().Where(share.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareClient) GetX(ctx context.Context, id uint) *Share {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ShareClient) Hooks() []Hook {
+	return c.hooks.Share
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShareClient) Interceptors() []Interceptor {
+	return c.inters.Share
+}
+
+func (c *ShareClient) mutate(ctx context.Context, m *ShareMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Share mutation op: %q", m.Op())
+	}
+}
+
 // StoragePolicyClient is a client for the StoragePolicy schema.
 type StoragePolicyClient struct {
 	config
@@ -4876,18 +6042,168 @@ func (c *UserGroupClient) DeleteOneID(id uint) *UserGroupDeleteOne {
 func (c *UserGroupClient) Query() *UserGroupQuery {
 	return &UserGroupQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUserGroup},
+		ctx:    &QueryContext{Type: TypeUserGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserGroup entity by its id.
+func (c *UserGroupClient) Get(ctx context.Context, id uint) (*UserGroup, error) {
+	return c.Query().Where(usergroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserGroupClient) GetX(ctx context.Context, id uint) *UserGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a UserGroup.
+func (c *UserGroupClient) QueryUsers(ug *UserGroup) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ug.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usergroup.Table, usergroup.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, usergroup.UsersTable, usergroup.UsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(ug.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserGroupClient) Hooks() []Hook {
+	hooks := c.hooks.UserGroup
+	return append(hooks[:len(hooks):len(hooks)], usergroup.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserGroupClient) Interceptors() []Interceptor {
+	return c.inters.UserGroup
+}
+
+func (c *UserGroupClient) mutate(ctx context.Context, m *UserGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserGroup mutation op: %q", m.Op())
+	}
+}
+
+// UserIdentityClient is a client for the UserIdentity schema.
+type UserIdentityClient struct {
+	config
+}
+
+// NewUserIdentityClient returns a client for the UserIdentity from the given config.
+func NewUserIdentityClient(c config) *UserIdentityClient {
+	return &UserIdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `useridentity.Hooks(f(g(h())))`.
+func (c *UserIdentityClient) Use(hooks ...Hook) {
+	c.hooks.UserIdentity = append(c.hooks.UserIdentity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `useridentity.Intercept(f(g(h())))`.
+func (c *UserIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserIdentity = append(c.inters.UserIdentity, interceptors...)
+}
+
+// Create returns a builder for creating a UserIdentity entity.
+func (c *UserIdentityClient) Create() *UserIdentityCreate {
+	mutation := newUserIdentityMutation(c.config, OpCreate)
+	return &UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserIdentity entities.
+func (c *UserIdentityClient) CreateBulk(builders ...*UserIdentityCreate) *UserIdentityCreateBulk {
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserIdentityClient) MapCreateBulk(slice any, setFunc func(*UserIdentityCreate, int)) *UserIdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserIdentityCreateBulk{err: fmt.Errorf("calling to UserIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserIdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserIdentity.
+func (c *UserIdentityClient) Update() *UserIdentityUpdate {
+	mutation := newUserIdentityMutation(c.config, OpUpdate)
+	return &UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserIdentityClient) UpdateOne(ui *UserIdentity) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentity(ui))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserIdentityClient) UpdateOneID(id uint) *UserIdentityUpdateOne {
+	mutation := newUserIdentityMutation(c.config, OpUpdateOne, withUserIdentityID(id))
+	return &UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserIdentity.
+func (c *UserIdentityClient) Delete() *UserIdentityDelete {
+	mutation := newUserIdentityMutation(c.config, OpDelete)
+	return &UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserIdentityClient) DeleteOne(ui *UserIdentity) *UserIdentityDeleteOne {
+	return c.DeleteOneID(ui.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserIdentityClient) DeleteOneID(id uint) *UserIdentityDeleteOne {
+	builder := c.Delete().Where(useridentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserIdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for UserIdentity.
+func (c *UserIdentityClient) Query() *UserIdentityQuery {
+	return &UserIdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserIdentity},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UserGroup entity by its id.
-func (c *UserGroupClient) Get(ctx context.Context, id uint) (*UserGroup, error) {
-	return c.Query().Where(usergroup.ID(id)).Only(ctx)
+// Get returns a UserIdentity entity by its id.
+func (c *UserIdentityClient) Get(ctx context.Context, id uint) (*UserIdentity, error) {
+	return c.Query().Where(useridentity.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserGroupClient) GetX(ctx context.Context, id uint) *UserGroup {
+func (c *UserIdentityClient) GetX(ctx context.Context, id uint) *UserIdentity {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4895,45 +6211,28 @@ func (c *UserGroupClient) GetX(ctx context.Context, id uint) *UserGroup {
 	return obj
 }
 
-// QueryUsers queries the users edge of a UserGroup.
-func (c *UserGroupClient) QueryUsers(ug *UserGroup) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ug.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(usergroup.Table, usergroup.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, usergroup.UsersTable, usergroup.UsersColumn),
-		)
-		fromV = sqlgraph.Neighbors(ug.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *UserGroupClient) Hooks() []Hook {
-	hooks := c.hooks.UserGroup
-	return append(hooks[:len(hooks):len(hooks)], usergroup.Hooks[:]...)
+func (c *UserIdentityClient) Hooks() []Hook {
+	return c.hooks.UserIdentity
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserGroupClient) Interceptors() []Interceptor {
-	return c.inters.UserGroup
+func (c *UserIdentityClient) Interceptors() []Interceptor {
+	return c.inters.UserIdentity
 }
 
-func (c *UserGroupClient) mutate(ctx context.Context, m *UserGroupMutation) (Value, error) {
+func (c *UserIdentityClient) mutate(ctx context.Context, m *UserIdentityMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown UserGroup mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UserIdentity mutation op: %q", m.Op())
 	}
 }
 
@@ -5252,6 +6551,272 @@ func (c *UserNotificationConfigClient) mutate(ctx context.Context, m *UserNotifi
 	}
 }
 
+// UserSessionClient is a client for the UserSession schema.
+type UserSessionClient struct {
+	config
+}
+
+// NewUserSessionClient returns a client for the UserSession from the given config.
+func NewUserSessionClient(c config) *UserSessionClient {
+	return &UserSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usersession.Hooks(f(g(h())))`.
+func (c *UserSessionClient) Use(hooks ...Hook) {
+	c.hooks.UserSession = append(c.hooks.UserSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usersession.Intercept(f(g(h())))`.
+func (c *UserSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserSession = append(c.inters.UserSession, interceptors...)
+}
+
+// Create returns a builder for creating a UserSession entity.
+func (c *UserSessionClient) Create() *UserSessionCreate {
+	mutation := newUserSessionMutation(c.config, OpCreate)
+	return &UserSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserSession entities.
+func (c *UserSessionClient) CreateBulk(builders ...*UserSessionCreate) *UserSessionCreateBulk {
+	return &UserSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserSessionClient) MapCreateBulk(slice any, setFunc func(*UserSessionCreate, int)) *UserSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserSessionCreateBulk{err: fmt.Errorf("calling to UserSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserSession.
+func (c *UserSessionClient) Update() *UserSessionUpdate {
+	mutation := newUserSessionMutation(c.config, OpUpdate)
+	return &UserSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserSessionClient) UpdateOne(us *UserSession) *UserSessionUpdateOne {
+	mutation := newUserSessionMutation(c.config, OpUpdateOne, withUserSession(us))
+	return &UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserSessionClient) UpdateOneID(id uint) *UserSessionUpdateOne {
+	mutation := newUserSessionMutation(c.config, OpUpdateOne, withUserSessionID(id))
+	return &UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserSession.
+func (c *UserSessionClient) Delete() *UserSessionDelete {
+	mutation := newUserSessionMutation(c.config, OpDelete)
+	return &UserSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserSessionClient) DeleteOne(us *UserSession) *UserSessionDeleteOne {
+	return c.DeleteOneID(us.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserSessionClient) DeleteOneID(id uint) *UserSessionDeleteOne {
+	builder := c.Delete().Where(usersession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for UserSession.
+func (c *UserSessionClient) Query() *UserSessionQuery {
+	return &UserSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserSession entity by its id.
+func (c *UserSessionClient) Get(ctx context.Context, id uint) (*UserSession, error) {
+	return c.Query().Where(usersession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserSessionClient) GetX(ctx context.Context, id uint) *UserSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserSessionClient) Hooks() []Hook {
+	return c.hooks.UserSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserSessionClient) Interceptors() []Interceptor {
+	return c.inters.UserSession
+}
+
+func (c *UserSessionClient) mutate(ctx context.Context, m *UserSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserSession mutation op: %q", m.Op())
+	}
+}
+
+// UserTwoFactorClient is a client for the UserTwoFactor schema.
+type UserTwoFactorClient struct {
+	config
+}
+
+// NewUserTwoFactorClient returns a client for the UserTwoFactor from the given config.
+func NewUserTwoFactorClient(c config) *UserTwoFactorClient {
+	return &UserTwoFactorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usertwofactor.Hooks(f(g(h())))`.
+func (c *UserTwoFactorClient) Use(hooks ...Hook) {
+	c.hooks.UserTwoFactor = append(c.hooks.UserTwoFactor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usertwofactor.Intercept(f(g(h())))`.
+func (c *UserTwoFactorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserTwoFactor = append(c.inters.UserTwoFactor, interceptors...)
+}
+
+// Create returns a builder for creating a UserTwoFactor entity.
+func (c *UserTwoFactorClient) Create() *UserTwoFactorCreate {
+	mutation := newUserTwoFactorMutation(c.config, OpCreate)
+	return &UserTwoFactorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserTwoFactor entities.
+func (c *UserTwoFactorClient) CreateBulk(builders ...*UserTwoFactorCreate) *UserTwoFactorCreateBulk {
+	return &UserTwoFactorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserTwoFactorClient) MapCreateBulk(slice any, setFunc func(*UserTwoFactorCreate, int)) *UserTwoFactorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserTwoFactorCreateBulk{err: fmt.Errorf("calling to UserTwoFactorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserTwoFactorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserTwoFactorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserTwoFactor.
+func (c *UserTwoFactorClient) Update() *UserTwoFactorUpdate {
+	mutation := newUserTwoFactorMutation(c.config, OpUpdate)
+	return &UserTwoFactorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserTwoFactorClient) UpdateOne(utf *UserTwoFactor) *UserTwoFactorUpdateOne {
+	mutation := newUserTwoFactorMutation(c.config, OpUpdateOne, withUserTwoFactor(utf))
+	return &UserTwoFactorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserTwoFactorClient) UpdateOneID(id uint) *UserTwoFactorUpdateOne {
+	mutation := newUserTwoFactorMutation(c.config, OpUpdateOne, withUserTwoFactorID(id))
+	return &UserTwoFactorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserTwoFactor.
+func (c *UserTwoFactorClient) Delete() *UserTwoFactorDelete {
+	mutation := newUserTwoFactorMutation(c.config, OpDelete)
+	return &UserTwoFactorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserTwoFactorClient) DeleteOne(utf *UserTwoFactor) *UserTwoFactorDeleteOne {
+	return c.DeleteOneID(utf.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserTwoFactorClient) DeleteOneID(id uint) *UserTwoFactorDeleteOne {
+	builder := c.Delete().Where(usertwofactor.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserTwoFactorDeleteOne{builder}
+}
+
+// Query returns a query builder for UserTwoFactor.
+func (c *UserTwoFactorClient) Query() *UserTwoFactorQuery {
+	return &UserTwoFactorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserTwoFactor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserTwoFactor entity by its id.
+func (c *UserTwoFactorClient) Get(ctx context.Context, id uint) (*UserTwoFactor, error) {
+	return c.Query().Where(usertwofactor.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserTwoFactorClient) GetX(ctx context.Context, id uint) *UserTwoFactor {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserTwoFactorClient) Hooks() []Hook {
+	return c.hooks.UserTwoFactor
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserTwoFactorClient) Interceptors() []Interceptor {
+	return c.inters.UserTwoFactor
+}
+
+func (c *UserTwoFactorClient) mutate(ctx context.Context, m *UserTwoFactorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserTwoFactorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserTwoFactorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserTwoFactorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserTwoFactorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserTwoFactor mutation op: %q", m.Op())
+	}
+}
+
 // VisitorLogClient is a client for the VisitorLog schema.
 type VisitorLogClient struct {
 	config
@@ -5518,21 +7083,159 @@ func (c *VisitorStatClient) mutate(ctx context.Context, m *VisitorStatMutation)
 	}
 }
 
+// WebmentionClient is a client for the Webmention schema.
+type WebmentionClient struct {
+	config
+}
+
+// NewWebmentionClient returns a client for the Webmention from the given config.
+func NewWebmentionClient(c config) *WebmentionClient {
+	return &WebmentionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webmention.Hooks(f(g(h())))`.
+func (c *WebmentionClient) Use(hooks ...Hook) {
+	c.hooks.Webmention = append(c.hooks.Webmention, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webmention.Intercept(f(g(h())))`.
+func (c *WebmentionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Webmention = append(c.inters.Webmention, interceptors...)
+}
+
+// Create returns a builder for creating a Webmention entity.
+func (c *WebmentionClient) Create() *WebmentionCreate {
+	mutation := newWebmentionMutation(c.config, OpCreate)
+	return &WebmentionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webmention entities.
+func (c *WebmentionClient) CreateBulk(builders ...*WebmentionCreate) *WebmentionCreateBulk {
+	return &WebmentionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebmentionClient) MapCreateBulk(slice any, setFunc func(*WebmentionCreate, int)) *WebmentionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebmentionCreateBulk{err: fmt.Errorf("calling to WebmentionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebmentionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebmentionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webmention.
+func (c *WebmentionClient) Update() *WebmentionUpdate {
+	mutation := newWebmentionMutation(c.config, OpUpdate)
+	return &WebmentionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebmentionClient) UpdateOne(w *Webmention) *WebmentionUpdateOne {
+	mutation := newWebmentionMutation(c.config, OpUpdateOne, withWebmention(w))
+	return &WebmentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebmentionClient) UpdateOneID(id uint) *WebmentionUpdateOne {
+	mutation := newWebmentionMutation(c.config, OpUpdateOne, withWebmentionID(id))
+	return &WebmentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webmention.
+func (c *WebmentionClient) Delete() *WebmentionDelete {
+	mutation := newWebmentionMutation(c.config, OpDelete)
+	return &WebmentionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebmentionClient) DeleteOne(w *Webmention) *WebmentionDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebmentionClient) DeleteOneID(id uint) *WebmentionDeleteOne {
+	builder := c.Delete().Where(webmention.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebmentionDeleteOne{builder}
+}
+
+// Query returns a query builder for Webmention.
+func (c *WebmentionClient) Query() *WebmentionQuery {
+	return &WebmentionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebmention},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Webmention entity by its id.
+func (c *WebmentionClient) Get(ctx context.Context, id uint) (*Webmention, error) {
+	return c.Query().Where(webmention.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebmentionClient) GetX(ctx context.Context, id uint) *Webmention {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebmentionClient) Hooks() []Hook {
+	return c.hooks.Webmention
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebmentionClient) Interceptors() []Interceptor {
+	return c.inters.Webmention
+}
+
+func (c *WebmentionClient) mutate(ctx context.Context, m *WebmentionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebmentionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebmentionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebmentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebmentionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Webmention mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Album, AlbumCategory, Article, ArticleHistory, Comment, DirectLink, DocSeries,
-		Entity, Essay, FCirclePost, FCircleStatistic, File, FileEntity, GiveMoney,
-		Link, LinkCategory, LinkTag, Metadata, NotificationType, Page, PostCategory,
-		PostTag, Setting, StoragePolicy, Subscriber, Tag, URLStat, User, UserGroup,
-		UserInstalledTheme, UserNotificationConfig, VisitorLog, VisitorStat []ent.Hook
+		AccessToken, AccountDeletion, Album, AlbumCategory, Article, ArticleHistory,
+		AuditLog, BlockRule, Comment, CommentThread, DirectLink, DocSeries, Entity,
+		Essay, FCirclePost, FCircleStatistic, File, FileEntity, GiveMoney,
+		InvitationCode, Link, LinkCategory, LinkTag, Metadata, NotificationType, Page,
+		Passkey, PostCategory, PostTag, Setting, Share, StoragePolicy, Subscriber, Tag,
+		URLStat, User, UserGroup, UserIdentity, UserInstalledTheme,
+		UserNotificationConfig, UserSession, UserTwoFactor, VisitorLog, VisitorStat,
+		Webmention []ent.Hook
 	}
 	inters struct {
-		Album, AlbumCategory, Article, ArticleHistory, Comment, DirectLink, DocSeries,
-		Entity, Essay, FCirclePost, FCircleStatistic, File, FileEntity, GiveMoney,
-		Link, LinkCategory, LinkTag, Metadata, NotificationType, Page, PostCategory,
-		PostTag, Setting, StoragePolicy, Subscriber, Tag, URLStat, User, UserGroup,
-		UserInstalledTheme, UserNotificationConfig, VisitorLog,
-		VisitorStat []ent.Interceptor
+		AccessToken, AccountDeletion, Album, AlbumCategory, Article, ArticleHistory,
+		AuditLog, BlockRule, Comment, CommentThread, DirectLink, DocSeries, Entity,
+		Essay, FCirclePost, FCircleStatistic, File, FileEntity, GiveMoney,
+		InvitationCode, Link, LinkCategory, LinkTag, Metadata, NotificationType, Page,
+		Passkey, PostCategory, PostTag, Setting, Share, StoragePolicy, Subscriber, Tag,
+		URLStat, User, UserGroup, UserIdentity, UserInstalledTheme,
+		UserNotificationConfig, UserSession, UserTwoFactor, VisitorLog, VisitorStat,
+		Webmention []ent.Interceptor
 	}
 )
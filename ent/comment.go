@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
 
 // 评论表
@@ -62,6 +64,10 @@ type Comment struct {
 	LikeCount int `json:"like_count,omitempty"`
 	// 评论置顶时间，为NULL表示未置顶
 	PinnedAt *time.Time `json:"pinned_at,omitempty"`
+	// 作者最后一次自助编辑的时间，为NULL表示未编辑过
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// 作者自助编辑的历史记录 (仅管理员可见)
+	EditHistory []model.CommentEditRecord `json:"edit_history,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CommentQuery when eager-loading is set.
 	Edges            CommentEdges `json:"edges"`
@@ -118,13 +124,15 @@ func (*Comment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case comment.FieldEditHistory:
+			values[i] = new([]byte)
 		case comment.FieldIsAdminComment, comment.FieldIsAnonymous:
 			values[i] = new(sql.NullBool)
 		case comment.FieldID, comment.FieldUserID, comment.FieldParentID, comment.FieldReplyToID, comment.FieldStatus, comment.FieldLikeCount:
 			values[i] = new(sql.NullInt64)
 		case comment.FieldTargetPath, comment.FieldTargetTitle, comment.FieldNickname, comment.FieldEmail, comment.FieldEmailMd5, comment.FieldWebsite, comment.FieldContent, comment.FieldContentHTML, comment.FieldUserAgent, comment.FieldIPAddress, comment.FieldIPLocation:
 			values[i] = new(sql.NullString)
-		case comment.FieldDeletedAt, comment.FieldCreatedAt, comment.FieldUpdatedAt, comment.FieldPinnedAt:
+		case comment.FieldDeletedAt, comment.FieldCreatedAt, comment.FieldUpdatedAt, comment.FieldPinnedAt, comment.FieldEditedAt:
 			values[i] = new(sql.NullTime)
 		case comment.ForeignKeys[0]: // article_comments
 			values[i] = new(sql.NullInt64)
@@ -291,6 +299,21 @@ func (c *Comment) assignValues(columns []string, values []any) error {
 				c.PinnedAt = new(time.Time)
 				*c.PinnedAt = value.Time
 			}
+		case comment.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				c.EditedAt = new(time.Time)
+				*c.EditedAt = value.Time
+			}
+		case comment.FieldEditHistory:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field edit_history", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.EditHistory); err != nil {
+					return fmt.Errorf("unmarshal field edit_history: %w", err)
+				}
+			}
 		case comment.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field article_comments", value)
@@ -434,6 +457,14 @@ func (c *Comment) String() string {
 		builder.WriteString("pinned_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := c.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("edit_history=")
+	builder.WriteString(fmt.Sprintf("%v", c.EditHistory))
 	builder.WriteByte(')')
 	return builder.String()
 }
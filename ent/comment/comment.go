@@ -59,6 +59,10 @@ const (
 	FieldLikeCount = "like_count"
 	// FieldPinnedAt holds the string denoting the pinned_at field in the database.
 	FieldPinnedAt = "pinned_at"
+	// FieldEditedAt holds the string denoting the edited_at field in the database.
+	FieldEditedAt = "edited_at"
+	// FieldEditHistory holds the string denoting the edit_history field in the database.
+	FieldEditHistory = "edit_history"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
@@ -109,6 +113,8 @@ var Columns = []string{
 	FieldIPLocation,
 	FieldLikeCount,
 	FieldPinnedAt,
+	FieldEditedAt,
+	FieldEditHistory,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "comments"
@@ -297,6 +303,11 @@ func ByPinnedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPinnedAt, opts...).ToFunc()
 }
 
+// ByEditedAt orders the results by the edited_at field.
+func ByEditedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditedAt, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
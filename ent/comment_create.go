@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
 
 // CommentCreate is the builder for creating a Comment entity.
@@ -283,6 +284,26 @@ func (cc *CommentCreate) SetNillablePinnedAt(t *time.Time) *CommentCreate {
 	return cc
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (cc *CommentCreate) SetEditedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetEditedAt(t)
+	return cc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableEditedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetEditedAt(*t)
+	}
+	return cc
+}
+
+// SetEditHistory sets the "edit_history" field.
+func (cc *CommentCreate) SetEditHistory(mer []model.CommentEditRecord) *CommentCreate {
+	cc.mutation.SetEditHistory(mer)
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CommentCreate) SetID(u uint) *CommentCreate {
 	cc.mutation.SetID(u)
@@ -609,6 +630,14 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldPinnedAt, field.TypeTime, value)
 		_node.PinnedAt = &value
 	}
+	if value, ok := cc.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if value, ok := cc.mutation.EditHistory(); ok {
+		_spec.SetField(comment.FieldEditHistory, field.TypeJSON, value)
+		_node.EditHistory = value
+	}
 	if nodes := cc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1041,6 +1070,42 @@ func (u *CommentUpsert) ClearPinnedAt() *CommentUpsert {
 	return u
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (u *CommentUpsert) SetEditedAt(v time.Time) *CommentUpsert {
+	u.Set(comment.FieldEditedAt, v)
+	return u
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateEditedAt() *CommentUpsert {
+	u.SetExcluded(comment.FieldEditedAt)
+	return u
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *CommentUpsert) ClearEditedAt() *CommentUpsert {
+	u.SetNull(comment.FieldEditedAt)
+	return u
+}
+
+// SetEditHistory sets the "edit_history" field.
+func (u *CommentUpsert) SetEditHistory(v []model.CommentEditRecord) *CommentUpsert {
+	u.Set(comment.FieldEditHistory, v)
+	return u
+}
+
+// UpdateEditHistory sets the "edit_history" field to the value that was provided on create.
+func (u *CommentUpsert) UpdateEditHistory() *CommentUpsert {
+	u.SetExcluded(comment.FieldEditHistory)
+	return u
+}
+
+// ClearEditHistory clears the value of the "edit_history" field.
+func (u *CommentUpsert) ClearEditHistory() *CommentUpsert {
+	u.SetNull(comment.FieldEditHistory)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1477,6 +1542,48 @@ func (u *CommentUpsertOne) ClearPinnedAt() *CommentUpsertOne {
 	})
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (u *CommentUpsertOne) SetEditedAt(v time.Time) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateEditedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *CommentUpsertOne) ClearEditedAt() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetEditHistory sets the "edit_history" field.
+func (u *CommentUpsertOne) SetEditHistory(v []model.CommentEditRecord) *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetEditHistory(v)
+	})
+}
+
+// UpdateEditHistory sets the "edit_history" field to the value that was provided on create.
+func (u *CommentUpsertOne) UpdateEditHistory() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateEditHistory()
+	})
+}
+
+// ClearEditHistory clears the value of the "edit_history" field.
+func (u *CommentUpsertOne) ClearEditHistory() *CommentUpsertOne {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearEditHistory()
+	})
+}
+
 // Exec executes the query.
 func (u *CommentUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2079,6 +2186,48 @@ func (u *CommentUpsertBulk) ClearPinnedAt() *CommentUpsertBulk {
 	})
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (u *CommentUpsertBulk) SetEditedAt(v time.Time) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetEditedAt(v)
+	})
+}
+
+// UpdateEditedAt sets the "edited_at" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateEditedAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateEditedAt()
+	})
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (u *CommentUpsertBulk) ClearEditedAt() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearEditedAt()
+	})
+}
+
+// SetEditHistory sets the "edit_history" field.
+func (u *CommentUpsertBulk) SetEditHistory(v []model.CommentEditRecord) *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.SetEditHistory(v)
+	})
+}
+
+// UpdateEditHistory sets the "edit_history" field to the value that was provided on create.
+func (u *CommentUpsertBulk) UpdateEditHistory() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.UpdateEditHistory()
+	})
+}
+
+// ClearEditHistory clears the value of the "edit_history" field.
+func (u *CommentUpsertBulk) ClearEditHistory() *CommentUpsertBulk {
+	return u.Update(func(s *CommentUpsert) {
+		s.ClearEditHistory()
+	})
+}
+
 // Exec executes the query.
 func (u *CommentUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
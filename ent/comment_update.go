@@ -10,10 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
 
 // CommentUpdate is the builder for updating Comment entities.
@@ -397,6 +399,44 @@ func (cu *CommentUpdate) ClearPinnedAt() *CommentUpdate {
 	return cu
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (cu *CommentUpdate) SetEditedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetEditedAt(t)
+	return cu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableEditedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetEditedAt(*t)
+	}
+	return cu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cu *CommentUpdate) ClearEditedAt() *CommentUpdate {
+	cu.mutation.ClearEditedAt()
+	return cu
+}
+
+// SetEditHistory sets the "edit_history" field.
+func (cu *CommentUpdate) SetEditHistory(mer []model.CommentEditRecord) *CommentUpdate {
+	cu.mutation.SetEditHistory(mer)
+	return cu
+}
+
+// AppendEditHistory appends mer to the "edit_history" field.
+func (cu *CommentUpdate) AppendEditHistory(mer []model.CommentEditRecord) *CommentUpdate {
+	cu.mutation.AppendEditHistory(mer)
+	return cu
+}
+
+// ClearEditHistory clears the value of the "edit_history" field.
+func (cu *CommentUpdate) ClearEditHistory() *CommentUpdate {
+	cu.mutation.ClearEditHistory()
+	return cu
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (cu *CommentUpdate) SetUser(u *User) *CommentUpdate {
 	return cu.SetUserID(u.ID)
@@ -689,6 +729,23 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.PinnedAtCleared() {
 		_spec.ClearField(comment.FieldPinnedAt, field.TypeTime)
 	}
+	if value, ok := cu.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cu.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.EditHistory(); ok {
+		_spec.SetField(comment.FieldEditHistory, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedEditHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, comment.FieldEditHistory, value)
+		})
+	}
+	if cu.mutation.EditHistoryCleared() {
+		_spec.ClearField(comment.FieldEditHistory, field.TypeJSON)
+	}
 	if cu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1181,6 +1238,44 @@ func (cuo *CommentUpdateOne) ClearPinnedAt() *CommentUpdateOne {
 	return cuo
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (cuo *CommentUpdateOne) SetEditedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetEditedAt(t)
+	return cuo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableEditedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetEditedAt(*t)
+	}
+	return cuo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cuo *CommentUpdateOne) ClearEditedAt() *CommentUpdateOne {
+	cuo.mutation.ClearEditedAt()
+	return cuo
+}
+
+// SetEditHistory sets the "edit_history" field.
+func (cuo *CommentUpdateOne) SetEditHistory(mer []model.CommentEditRecord) *CommentUpdateOne {
+	cuo.mutation.SetEditHistory(mer)
+	return cuo
+}
+
+// AppendEditHistory appends mer to the "edit_history" field.
+func (cuo *CommentUpdateOne) AppendEditHistory(mer []model.CommentEditRecord) *CommentUpdateOne {
+	cuo.mutation.AppendEditHistory(mer)
+	return cuo
+}
+
+// ClearEditHistory clears the value of the "edit_history" field.
+func (cuo *CommentUpdateOne) ClearEditHistory() *CommentUpdateOne {
+	cuo.mutation.ClearEditHistory()
+	return cuo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (cuo *CommentUpdateOne) SetUser(u *User) *CommentUpdateOne {
 	return cuo.SetUserID(u.ID)
@@ -1503,6 +1598,23 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	if cuo.mutation.PinnedAtCleared() {
 		_spec.ClearField(comment.FieldPinnedAt, field.TypeTime)
 	}
+	if value, ok := cuo.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.EditHistory(); ok {
+		_spec.SetField(comment.FieldEditHistory, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedEditHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, comment.FieldEditHistory, value)
+		})
+	}
+	if cuo.mutation.EditHistoryCleared() {
+		_spec.ClearField(comment.FieldEditHistory, field.TypeJSON)
+	}
 	if cuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentthread"
+)
+
+// 评论区状态表
+type CommentThread struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 评论区对应的目标路径，与评论的 target_path 一致
+	TargetPath string `json:"target_path,omitempty"`
+	// 评论区状态: open(开放), closed(关闭并隐藏已有评论), readonly(只读，保留已有评论但不再接受新评论)
+	State        commentthread.State `json:"state,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommentThread) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commentthread.FieldID:
+			values[i] = new(sql.NullInt64)
+		case commentthread.FieldTargetPath, commentthread.FieldState:
+			values[i] = new(sql.NullString)
+		case commentthread.FieldCreatedAt, commentthread.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommentThread fields.
+func (ct *CommentThread) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commentthread.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ct.ID = uint(value.Int64)
+		case commentthread.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ct.CreatedAt = value.Time
+			}
+		case commentthread.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ct.UpdatedAt = value.Time
+			}
+		case commentthread.FieldTargetPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_path", values[i])
+			} else if value.Valid {
+				ct.TargetPath = value.String
+			}
+		case commentthread.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				ct.State = commentthread.State(value.String)
+			}
+		default:
+			ct.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommentThread.
+// This includes values selected through modifiers, order, etc.
+func (ct *CommentThread) Value(name string) (ent.Value, error) {
+	return ct.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CommentThread.
+// Note that you need to call CommentThread.Unwrap() before calling this method if this CommentThread
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ct *CommentThread) Update() *CommentThreadUpdateOne {
+	return NewCommentThreadClient(ct.config).UpdateOne(ct)
+}
+
+// Unwrap unwraps the CommentThread entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ct *CommentThread) Unwrap() *CommentThread {
+	_tx, ok := ct.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentThread is not a transactional entity")
+	}
+	ct.config.driver = _tx.drv
+	return ct
+}
+
+// String implements the fmt.Stringer.
+func (ct *CommentThread) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommentThread(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ct.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ct.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ct.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("target_path=")
+	builder.WriteString(ct.TargetPath)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", ct.State))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommentThreads is a parsable slice of CommentThread.
+type CommentThreads []*CommentThread
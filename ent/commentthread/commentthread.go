@@ -0,0 +1,112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commentthread
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the commentthread type in the database.
+	Label = "comment_thread"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldTargetPath holds the string denoting the target_path field in the database.
+	FieldTargetPath = "target_path"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// Table holds the table name of the commentthread in the database.
+	Table = "comment_threads"
+)
+
+// Columns holds all SQL columns for commentthread fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldTargetPath,
+	FieldState,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// TargetPathValidator is a validator for the "target_path" field. It is called by the builders before save.
+	TargetPathValidator func(string) error
+)
+
+// State defines the type for the "state" enum field.
+type State string
+
+// StateOpen is the default value of the State enum.
+const DefaultState = StateOpen
+
+// State values.
+const (
+	StateOpen     State = "open"
+	StateClosed   State = "closed"
+	StateReadonly State = "readonly"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StateOpen, StateClosed, StateReadonly:
+		return nil
+	default:
+		return fmt.Errorf("commentthread: invalid enum value for state field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the CommentThread queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTargetPath orders the results by the target_path field.
+func ByTargetPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetPath, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commentthread
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TargetPath applies equality check predicate on the "target_path" field. It's identical to TargetPathEQ.
+func TargetPath(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldEQ(FieldTargetPath, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TargetPathEQ applies the EQ predicate on the "target_path" field.
+func TargetPathEQ(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldEQ(FieldTargetPath, v))
+}
+
+// TargetPathNEQ applies the NEQ predicate on the "target_path" field.
+func TargetPathNEQ(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldNEQ(FieldTargetPath, v))
+}
+
+// TargetPathIn applies the In predicate on the "target_path" field.
+func TargetPathIn(vs ...string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldIn(FieldTargetPath, vs...))
+}
+
+// TargetPathNotIn applies the NotIn predicate on the "target_path" field.
+func TargetPathNotIn(vs ...string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldNotIn(FieldTargetPath, vs...))
+}
+
+// TargetPathGT applies the GT predicate on the "target_path" field.
+func TargetPathGT(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldGT(FieldTargetPath, v))
+}
+
+// TargetPathGTE applies the GTE predicate on the "target_path" field.
+func TargetPathGTE(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldGTE(FieldTargetPath, v))
+}
+
+// TargetPathLT applies the LT predicate on the "target_path" field.
+func TargetPathLT(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldLT(FieldTargetPath, v))
+}
+
+// TargetPathLTE applies the LTE predicate on the "target_path" field.
+func TargetPathLTE(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldLTE(FieldTargetPath, v))
+}
+
+// TargetPathContains applies the Contains predicate on the "target_path" field.
+func TargetPathContains(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldContains(FieldTargetPath, v))
+}
+
+// TargetPathHasPrefix applies the HasPrefix predicate on the "target_path" field.
+func TargetPathHasPrefix(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldHasPrefix(FieldTargetPath, v))
+}
+
+// TargetPathHasSuffix applies the HasSuffix predicate on the "target_path" field.
+func TargetPathHasSuffix(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldHasSuffix(FieldTargetPath, v))
+}
+
+// TargetPathEqualFold applies the EqualFold predicate on the "target_path" field.
+func TargetPathEqualFold(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldEqualFold(FieldTargetPath, v))
+}
+
+// TargetPathContainsFold applies the ContainsFold predicate on the "target_path" field.
+func TargetPathContainsFold(v string) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldContainsFold(FieldTargetPath, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.CommentThread {
+	return predicate.CommentThread(sql.FieldNotIn(FieldState, vs...))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CommentThread) predicate.CommentThread {
+	return predicate.CommentThread(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CommentThread) predicate.CommentThread {
+	return predicate.CommentThread(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CommentThread) predicate.CommentThread {
+	return predicate.CommentThread(sql.NotPredicates(p))
+}
@@ -0,0 +1,662 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentthread"
+)
+
+// CommentThreadCreate is the builder for creating a CommentThread entity.
+type CommentThreadCreate struct {
+	config
+	mutation *CommentThreadMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ctc *CommentThreadCreate) SetCreatedAt(t time.Time) *CommentThreadCreate {
+	ctc.mutation.SetCreatedAt(t)
+	return ctc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ctc *CommentThreadCreate) SetNillableCreatedAt(t *time.Time) *CommentThreadCreate {
+	if t != nil {
+		ctc.SetCreatedAt(*t)
+	}
+	return ctc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ctc *CommentThreadCreate) SetUpdatedAt(t time.Time) *CommentThreadCreate {
+	ctc.mutation.SetUpdatedAt(t)
+	return ctc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ctc *CommentThreadCreate) SetNillableUpdatedAt(t *time.Time) *CommentThreadCreate {
+	if t != nil {
+		ctc.SetUpdatedAt(*t)
+	}
+	return ctc
+}
+
+// SetTargetPath sets the "target_path" field.
+func (ctc *CommentThreadCreate) SetTargetPath(s string) *CommentThreadCreate {
+	ctc.mutation.SetTargetPath(s)
+	return ctc
+}
+
+// SetState sets the "state" field.
+func (ctc *CommentThreadCreate) SetState(c commentthread.State) *CommentThreadCreate {
+	ctc.mutation.SetState(c)
+	return ctc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ctc *CommentThreadCreate) SetNillableState(c *commentthread.State) *CommentThreadCreate {
+	if c != nil {
+		ctc.SetState(*c)
+	}
+	return ctc
+}
+
+// SetID sets the "id" field.
+func (ctc *CommentThreadCreate) SetID(u uint) *CommentThreadCreate {
+	ctc.mutation.SetID(u)
+	return ctc
+}
+
+// Mutation returns the CommentThreadMutation object of the builder.
+func (ctc *CommentThreadCreate) Mutation() *CommentThreadMutation {
+	return ctc.mutation
+}
+
+// Save creates the CommentThread in the database.
+func (ctc *CommentThreadCreate) Save(ctx context.Context) (*CommentThread, error) {
+	ctc.defaults()
+	return withHooks(ctx, ctc.sqlSave, ctc.mutation, ctc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ctc *CommentThreadCreate) SaveX(ctx context.Context) *CommentThread {
+	v, err := ctc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctc *CommentThreadCreate) Exec(ctx context.Context) error {
+	_, err := ctc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctc *CommentThreadCreate) ExecX(ctx context.Context) {
+	if err := ctc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ctc *CommentThreadCreate) defaults() {
+	if _, ok := ctc.mutation.CreatedAt(); !ok {
+		v := commentthread.DefaultCreatedAt()
+		ctc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ctc.mutation.UpdatedAt(); !ok {
+		v := commentthread.DefaultUpdatedAt()
+		ctc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ctc.mutation.State(); !ok {
+		v := commentthread.DefaultState
+		ctc.mutation.SetState(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctc *CommentThreadCreate) check() error {
+	if _, ok := ctc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CommentThread.created_at"`)}
+	}
+	if _, ok := ctc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CommentThread.updated_at"`)}
+	}
+	if _, ok := ctc.mutation.TargetPath(); !ok {
+		return &ValidationError{Name: "target_path", err: errors.New(`ent: missing required field "CommentThread.target_path"`)}
+	}
+	if v, ok := ctc.mutation.TargetPath(); ok {
+		if err := commentthread.TargetPathValidator(v); err != nil {
+			return &ValidationError{Name: "target_path", err: fmt.Errorf(`ent: validator failed for field "CommentThread.target_path": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "CommentThread.state"`)}
+	}
+	if v, ok := ctc.mutation.State(); ok {
+		if err := commentthread.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "CommentThread.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ctc *CommentThreadCreate) sqlSave(ctx context.Context) (*CommentThread, error) {
+	if err := ctc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ctc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ctc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	ctc.mutation.id = &_node.ID
+	ctc.mutation.done = true
+	return _node, nil
+}
+
+func (ctc *CommentThreadCreate) createSpec() (*CommentThread, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommentThread{config: ctc.config}
+		_spec = sqlgraph.NewCreateSpec(commentthread.Table, sqlgraph.NewFieldSpec(commentthread.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = ctc.conflict
+	if id, ok := ctc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ctc.mutation.CreatedAt(); ok {
+		_spec.SetField(commentthread.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ctc.mutation.UpdatedAt(); ok {
+		_spec.SetField(commentthread.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ctc.mutation.TargetPath(); ok {
+		_spec.SetField(commentthread.FieldTargetPath, field.TypeString, value)
+		_node.TargetPath = value
+	}
+	if value, ok := ctc.mutation.State(); ok {
+		_spec.SetField(commentthread.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CommentThread.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentThreadUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ctc *CommentThreadCreate) OnConflict(opts ...sql.ConflictOption) *CommentThreadUpsertOne {
+	ctc.conflict = opts
+	return &CommentThreadUpsertOne{
+		create: ctc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CommentThread.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ctc *CommentThreadCreate) OnConflictColumns(columns ...string) *CommentThreadUpsertOne {
+	ctc.conflict = append(ctc.conflict, sql.ConflictColumns(columns...))
+	return &CommentThreadUpsertOne{
+		create: ctc,
+	}
+}
+
+type (
+	// CommentThreadUpsertOne is the builder for "upsert"-ing
+	//  one CommentThread node.
+	CommentThreadUpsertOne struct {
+		create *CommentThreadCreate
+	}
+
+	// CommentThreadUpsert is the "OnConflict" setter.
+	CommentThreadUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentThreadUpsert) SetUpdatedAt(v time.Time) *CommentThreadUpsert {
+	u.Set(commentthread.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentThreadUpsert) UpdateUpdatedAt() *CommentThreadUpsert {
+	u.SetExcluded(commentthread.FieldUpdatedAt)
+	return u
+}
+
+// SetTargetPath sets the "target_path" field.
+func (u *CommentThreadUpsert) SetTargetPath(v string) *CommentThreadUpsert {
+	u.Set(commentthread.FieldTargetPath, v)
+	return u
+}
+
+// UpdateTargetPath sets the "target_path" field to the value that was provided on create.
+func (u *CommentThreadUpsert) UpdateTargetPath() *CommentThreadUpsert {
+	u.SetExcluded(commentthread.FieldTargetPath)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *CommentThreadUpsert) SetState(v commentthread.State) *CommentThreadUpsert {
+	u.Set(commentthread.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *CommentThreadUpsert) UpdateState() *CommentThreadUpsert {
+	u.SetExcluded(commentthread.FieldState)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CommentThread.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(commentthread.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CommentThreadUpsertOne) UpdateNewValues() *CommentThreadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(commentthread.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(commentthread.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CommentThread.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CommentThreadUpsertOne) Ignore() *CommentThreadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentThreadUpsertOne) DoNothing() *CommentThreadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentThreadCreate.OnConflict
+// documentation for more info.
+func (u *CommentThreadUpsertOne) Update(set func(*CommentThreadUpsert)) *CommentThreadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentThreadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentThreadUpsertOne) SetUpdatedAt(v time.Time) *CommentThreadUpsertOne {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentThreadUpsertOne) UpdateUpdatedAt() *CommentThreadUpsertOne {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTargetPath sets the "target_path" field.
+func (u *CommentThreadUpsertOne) SetTargetPath(v string) *CommentThreadUpsertOne {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.SetTargetPath(v)
+	})
+}
+
+// UpdateTargetPath sets the "target_path" field to the value that was provided on create.
+func (u *CommentThreadUpsertOne) UpdateTargetPath() *CommentThreadUpsertOne {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.UpdateTargetPath()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *CommentThreadUpsertOne) SetState(v commentthread.State) *CommentThreadUpsertOne {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *CommentThreadUpsertOne) UpdateState() *CommentThreadUpsertOne {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.UpdateState()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentThreadUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommentThreadCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentThreadUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CommentThreadUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CommentThreadUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CommentThreadCreateBulk is the builder for creating many CommentThread entities in bulk.
+type CommentThreadCreateBulk struct {
+	config
+	err      error
+	builders []*CommentThreadCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CommentThread entities in the database.
+func (ctcb *CommentThreadCreateBulk) Save(ctx context.Context) ([]*CommentThread, error) {
+	if ctcb.err != nil {
+		return nil, ctcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ctcb.builders))
+	nodes := make([]*CommentThread, len(ctcb.builders))
+	mutators := make([]Mutator, len(ctcb.builders))
+	for i := range ctcb.builders {
+		func(i int, root context.Context) {
+			builder := ctcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentThreadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ctcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ctcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ctcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ctcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctcb *CommentThreadCreateBulk) SaveX(ctx context.Context) []*CommentThread {
+	v, err := ctcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctcb *CommentThreadCreateBulk) Exec(ctx context.Context) error {
+	_, err := ctcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctcb *CommentThreadCreateBulk) ExecX(ctx context.Context) {
+	if err := ctcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CommentThread.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommentThreadUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ctcb *CommentThreadCreateBulk) OnConflict(opts ...sql.ConflictOption) *CommentThreadUpsertBulk {
+	ctcb.conflict = opts
+	return &CommentThreadUpsertBulk{
+		create: ctcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CommentThread.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ctcb *CommentThreadCreateBulk) OnConflictColumns(columns ...string) *CommentThreadUpsertBulk {
+	ctcb.conflict = append(ctcb.conflict, sql.ConflictColumns(columns...))
+	return &CommentThreadUpsertBulk{
+		create: ctcb,
+	}
+}
+
+// CommentThreadUpsertBulk is the builder for "upsert"-ing
+// a bulk of CommentThread nodes.
+type CommentThreadUpsertBulk struct {
+	create *CommentThreadCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CommentThread.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(commentthread.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CommentThreadUpsertBulk) UpdateNewValues() *CommentThreadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(commentthread.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(commentthread.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CommentThread.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CommentThreadUpsertBulk) Ignore() *CommentThreadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommentThreadUpsertBulk) DoNothing() *CommentThreadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommentThreadCreateBulk.OnConflict
+// documentation for more info.
+func (u *CommentThreadUpsertBulk) Update(set func(*CommentThreadUpsert)) *CommentThreadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommentThreadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommentThreadUpsertBulk) SetUpdatedAt(v time.Time) *CommentThreadUpsertBulk {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommentThreadUpsertBulk) UpdateUpdatedAt() *CommentThreadUpsertBulk {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetTargetPath sets the "target_path" field.
+func (u *CommentThreadUpsertBulk) SetTargetPath(v string) *CommentThreadUpsertBulk {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.SetTargetPath(v)
+	})
+}
+
+// UpdateTargetPath sets the "target_path" field to the value that was provided on create.
+func (u *CommentThreadUpsertBulk) UpdateTargetPath() *CommentThreadUpsertBulk {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.UpdateTargetPath()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *CommentThreadUpsertBulk) SetState(v commentthread.State) *CommentThreadUpsertBulk {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *CommentThreadUpsertBulk) UpdateState() *CommentThreadUpsertBulk {
+	return u.Update(func(s *CommentThreadUpsert) {
+		s.UpdateState()
+	})
+}
+
+// Exec executes the query.
+func (u *CommentThreadUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CommentThreadCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommentThreadCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommentThreadUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentthread"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// CommentThreadDelete is the builder for deleting a CommentThread entity.
+type CommentThreadDelete struct {
+	config
+	hooks    []Hook
+	mutation *CommentThreadMutation
+}
+
+// Where appends a list predicates to the CommentThreadDelete builder.
+func (ctd *CommentThreadDelete) Where(ps ...predicate.CommentThread) *CommentThreadDelete {
+	ctd.mutation.Where(ps...)
+	return ctd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ctd *CommentThreadDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ctd.sqlExec, ctd.mutation, ctd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctd *CommentThreadDelete) ExecX(ctx context.Context) int {
+	n, err := ctd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ctd *CommentThreadDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(commentthread.Table, sqlgraph.NewFieldSpec(commentthread.FieldID, field.TypeUint))
+	if ps := ctd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ctd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ctd.mutation.done = true
+	return affected, err
+}
+
+// CommentThreadDeleteOne is the builder for deleting a single CommentThread entity.
+type CommentThreadDeleteOne struct {
+	ctd *CommentThreadDelete
+}
+
+// Where appends a list predicates to the CommentThreadDelete builder.
+func (ctdo *CommentThreadDeleteOne) Where(ps ...predicate.CommentThread) *CommentThreadDeleteOne {
+	ctdo.ctd.mutation.Where(ps...)
+	return ctdo
+}
+
+// Exec executes the deletion query.
+func (ctdo *CommentThreadDeleteOne) Exec(ctx context.Context) error {
+	n, err := ctdo.ctd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{commentthread.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctdo *CommentThreadDeleteOne) ExecX(ctx context.Context) {
+	if err := ctdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentthread"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// CommentThreadQuery is the builder for querying CommentThread entities.
+type CommentThreadQuery struct {
+	config
+	ctx        *QueryContext
+	order      []commentthread.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CommentThread
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CommentThreadQuery builder.
+func (ctq *CommentThreadQuery) Where(ps ...predicate.CommentThread) *CommentThreadQuery {
+	ctq.predicates = append(ctq.predicates, ps...)
+	return ctq
+}
+
+// Limit the number of records to be returned by this query.
+func (ctq *CommentThreadQuery) Limit(limit int) *CommentThreadQuery {
+	ctq.ctx.Limit = &limit
+	return ctq
+}
+
+// Offset to start from.
+func (ctq *CommentThreadQuery) Offset(offset int) *CommentThreadQuery {
+	ctq.ctx.Offset = &offset
+	return ctq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ctq *CommentThreadQuery) Unique(unique bool) *CommentThreadQuery {
+	ctq.ctx.Unique = &unique
+	return ctq
+}
+
+// Order specifies how the records should be ordered.
+func (ctq *CommentThreadQuery) Order(o ...commentthread.OrderOption) *CommentThreadQuery {
+	ctq.order = append(ctq.order, o...)
+	return ctq
+}
+
+// First returns the first CommentThread entity from the query.
+// Returns a *NotFoundError when no CommentThread was found.
+func (ctq *CommentThreadQuery) First(ctx context.Context) (*CommentThread, error) {
+	nodes, err := ctq.Limit(1).All(setContextOp(ctx, ctq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{commentthread.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ctq *CommentThreadQuery) FirstX(ctx context.Context) *CommentThread {
+	node, err := ctq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CommentThread ID from the query.
+// Returns a *NotFoundError when no CommentThread ID was found.
+func (ctq *CommentThreadQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = ctq.Limit(1).IDs(setContextOp(ctx, ctq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{commentthread.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ctq *CommentThreadQuery) FirstIDX(ctx context.Context) uint {
+	id, err := ctq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CommentThread entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CommentThread entity is found.
+// Returns a *NotFoundError when no CommentThread entities are found.
+func (ctq *CommentThreadQuery) Only(ctx context.Context) (*CommentThread, error) {
+	nodes, err := ctq.Limit(2).All(setContextOp(ctx, ctq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{commentthread.Label}
+	default:
+		return nil, &NotSingularError{commentthread.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ctq *CommentThreadQuery) OnlyX(ctx context.Context) *CommentThread {
+	node, err := ctq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CommentThread ID in the query.
+// Returns a *NotSingularError when more than one CommentThread ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ctq *CommentThreadQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = ctq.Limit(2).IDs(setContextOp(ctx, ctq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{commentthread.Label}
+	default:
+		err = &NotSingularError{commentthread.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ctq *CommentThreadQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := ctq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CommentThreads.
+func (ctq *CommentThreadQuery) All(ctx context.Context) ([]*CommentThread, error) {
+	ctx = setContextOp(ctx, ctq.ctx, ent.OpQueryAll)
+	if err := ctq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CommentThread, *CommentThreadQuery]()
+	return withInterceptors[[]*CommentThread](ctx, ctq, qr, ctq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ctq *CommentThreadQuery) AllX(ctx context.Context) []*CommentThread {
+	nodes, err := ctq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CommentThread IDs.
+func (ctq *CommentThreadQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if ctq.ctx.Unique == nil && ctq.path != nil {
+		ctq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ctq.ctx, ent.OpQueryIDs)
+	if err = ctq.Select(commentthread.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ctq *CommentThreadQuery) IDsX(ctx context.Context) []uint {
+	ids, err := ctq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ctq *CommentThreadQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ctq.ctx, ent.OpQueryCount)
+	if err := ctq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ctq, querierCount[*CommentThreadQuery](), ctq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ctq *CommentThreadQuery) CountX(ctx context.Context) int {
+	count, err := ctq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ctq *CommentThreadQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ctq.ctx, ent.OpQueryExist)
+	switch _, err := ctq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ctq *CommentThreadQuery) ExistX(ctx context.Context) bool {
+	exist, err := ctq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CommentThreadQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ctq *CommentThreadQuery) Clone() *CommentThreadQuery {
+	if ctq == nil {
+		return nil
+	}
+	return &CommentThreadQuery{
+		config:     ctq.config,
+		ctx:        ctq.ctx.Clone(),
+		order:      append([]commentthread.OrderOption{}, ctq.order...),
+		inters:     append([]Interceptor{}, ctq.inters...),
+		predicates: append([]predicate.CommentThread{}, ctq.predicates...),
+		// clone intermediate query.
+		sql:       ctq.sql.Clone(),
+		path:      ctq.path,
+		modifiers: append([]func(*sql.Selector){}, ctq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CommentThread.Query().
+//		GroupBy(commentthread.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ctq *CommentThreadQuery) GroupBy(field string, fields ...string) *CommentThreadGroupBy {
+	ctq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CommentThreadGroupBy{build: ctq}
+	grbuild.flds = &ctq.ctx.Fields
+	grbuild.label = commentthread.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.CommentThread.Query().
+//		Select(commentthread.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ctq *CommentThreadQuery) Select(fields ...string) *CommentThreadSelect {
+	ctq.ctx.Fields = append(ctq.ctx.Fields, fields...)
+	sbuild := &CommentThreadSelect{CommentThreadQuery: ctq}
+	sbuild.label = commentthread.Label
+	sbuild.flds, sbuild.scan = &ctq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CommentThreadSelect configured with the given aggregations.
+func (ctq *CommentThreadQuery) Aggregate(fns ...AggregateFunc) *CommentThreadSelect {
+	return ctq.Select().Aggregate(fns...)
+}
+
+func (ctq *CommentThreadQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ctq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ctq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ctq.ctx.Fields {
+		if !commentthread.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ctq.path != nil {
+		prev, err := ctq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ctq.sql = prev
+	}
+	return nil
+}
+
+func (ctq *CommentThreadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CommentThread, error) {
+	var (
+		nodes = []*CommentThread{}
+		_spec = ctq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CommentThread).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CommentThread{config: ctq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ctq.modifiers) > 0 {
+		_spec.Modifiers = ctq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ctq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ctq *CommentThreadQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ctq.querySpec()
+	if len(ctq.modifiers) > 0 {
+		_spec.Modifiers = ctq.modifiers
+	}
+	_spec.Node.Columns = ctq.ctx.Fields
+	if len(ctq.ctx.Fields) > 0 {
+		_spec.Unique = ctq.ctx.Unique != nil && *ctq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ctq.driver, _spec)
+}
+
+func (ctq *CommentThreadQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(commentthread.Table, commentthread.Columns, sqlgraph.NewFieldSpec(commentthread.FieldID, field.TypeUint))
+	_spec.From = ctq.sql
+	if unique := ctq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ctq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ctq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commentthread.FieldID)
+		for i := range fields {
+			if fields[i] != commentthread.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ctq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ctq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ctq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ctq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ctq *CommentThreadQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ctq.driver.Dialect())
+	t1 := builder.Table(commentthread.Table)
+	columns := ctq.ctx.Fields
+	if len(columns) == 0 {
+		columns = commentthread.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ctq.sql != nil {
+		selector = ctq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ctq.ctx.Unique != nil && *ctq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ctq.modifiers {
+		m(selector)
+	}
+	for _, p := range ctq.predicates {
+		p(selector)
+	}
+	for _, p := range ctq.order {
+		p(selector)
+	}
+	if offset := ctq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ctq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ctq *CommentThreadQuery) Modify(modifiers ...func(s *sql.Selector)) *CommentThreadSelect {
+	ctq.modifiers = append(ctq.modifiers, modifiers...)
+	return ctq.Select()
+}
+
+// CommentThreadGroupBy is the group-by builder for CommentThread entities.
+type CommentThreadGroupBy struct {
+	selector
+	build *CommentThreadQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ctgb *CommentThreadGroupBy) Aggregate(fns ...AggregateFunc) *CommentThreadGroupBy {
+	ctgb.fns = append(ctgb.fns, fns...)
+	return ctgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ctgb *CommentThreadGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ctgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ctgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CommentThreadQuery, *CommentThreadGroupBy](ctx, ctgb.build, ctgb, ctgb.build.inters, v)
+}
+
+func (ctgb *CommentThreadGroupBy) sqlScan(ctx context.Context, root *CommentThreadQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ctgb.fns))
+	for _, fn := range ctgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ctgb.flds)+len(ctgb.fns))
+		for _, f := range *ctgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ctgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ctgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CommentThreadSelect is the builder for selecting fields of CommentThread entities.
+type CommentThreadSelect struct {
+	*CommentThreadQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cts *CommentThreadSelect) Aggregate(fns ...AggregateFunc) *CommentThreadSelect {
+	cts.fns = append(cts.fns, fns...)
+	return cts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cts *CommentThreadSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cts.ctx, ent.OpQuerySelect)
+	if err := cts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CommentThreadQuery, *CommentThreadSelect](ctx, cts.CommentThreadQuery, cts, cts.inters, v)
+}
+
+func (cts *CommentThreadSelect) sqlScan(ctx context.Context, root *CommentThreadQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cts.fns))
+	for _, fn := range cts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cts *CommentThreadSelect) Modify(modifiers ...func(s *sql.Selector)) *CommentThreadSelect {
+	cts.modifiers = append(cts.modifiers, modifiers...)
+	return cts
+}
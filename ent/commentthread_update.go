@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentthread"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// CommentThreadUpdate is the builder for updating CommentThread entities.
+type CommentThreadUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CommentThreadMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CommentThreadUpdate builder.
+func (ctu *CommentThreadUpdate) Where(ps ...predicate.CommentThread) *CommentThreadUpdate {
+	ctu.mutation.Where(ps...)
+	return ctu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ctu *CommentThreadUpdate) SetUpdatedAt(t time.Time) *CommentThreadUpdate {
+	ctu.mutation.SetUpdatedAt(t)
+	return ctu
+}
+
+// SetTargetPath sets the "target_path" field.
+func (ctu *CommentThreadUpdate) SetTargetPath(s string) *CommentThreadUpdate {
+	ctu.mutation.SetTargetPath(s)
+	return ctu
+}
+
+// SetNillableTargetPath sets the "target_path" field if the given value is not nil.
+func (ctu *CommentThreadUpdate) SetNillableTargetPath(s *string) *CommentThreadUpdate {
+	if s != nil {
+		ctu.SetTargetPath(*s)
+	}
+	return ctu
+}
+
+// SetState sets the "state" field.
+func (ctu *CommentThreadUpdate) SetState(c commentthread.State) *CommentThreadUpdate {
+	ctu.mutation.SetState(c)
+	return ctu
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ctu *CommentThreadUpdate) SetNillableState(c *commentthread.State) *CommentThreadUpdate {
+	if c != nil {
+		ctu.SetState(*c)
+	}
+	return ctu
+}
+
+// Mutation returns the CommentThreadMutation object of the builder.
+func (ctu *CommentThreadUpdate) Mutation() *CommentThreadMutation {
+	return ctu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ctu *CommentThreadUpdate) Save(ctx context.Context) (int, error) {
+	ctu.defaults()
+	return withHooks(ctx, ctu.sqlSave, ctu.mutation, ctu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctu *CommentThreadUpdate) SaveX(ctx context.Context) int {
+	affected, err := ctu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ctu *CommentThreadUpdate) Exec(ctx context.Context) error {
+	_, err := ctu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctu *CommentThreadUpdate) ExecX(ctx context.Context) {
+	if err := ctu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ctu *CommentThreadUpdate) defaults() {
+	if _, ok := ctu.mutation.UpdatedAt(); !ok {
+		v := commentthread.UpdateDefaultUpdatedAt()
+		ctu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctu *CommentThreadUpdate) check() error {
+	if v, ok := ctu.mutation.TargetPath(); ok {
+		if err := commentthread.TargetPathValidator(v); err != nil {
+			return &ValidationError{Name: "target_path", err: fmt.Errorf(`ent: validator failed for field "CommentThread.target_path": %w`, err)}
+		}
+	}
+	if v, ok := ctu.mutation.State(); ok {
+		if err := commentthread.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "CommentThread.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ctu *CommentThreadUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentThreadUpdate {
+	ctu.modifiers = append(ctu.modifiers, modifiers...)
+	return ctu
+}
+
+func (ctu *CommentThreadUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ctu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commentthread.Table, commentthread.Columns, sqlgraph.NewFieldSpec(commentthread.FieldID, field.TypeUint))
+	if ps := ctu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ctu.mutation.UpdatedAt(); ok {
+		_spec.SetField(commentthread.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ctu.mutation.TargetPath(); ok {
+		_spec.SetField(commentthread.FieldTargetPath, field.TypeString, value)
+	}
+	if value, ok := ctu.mutation.State(); ok {
+		_spec.SetField(commentthread.FieldState, field.TypeEnum, value)
+	}
+	_spec.AddModifiers(ctu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ctu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentthread.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ctu.mutation.done = true
+	return n, nil
+}
+
+// CommentThreadUpdateOne is the builder for updating a single CommentThread entity.
+type CommentThreadUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CommentThreadMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ctuo *CommentThreadUpdateOne) SetUpdatedAt(t time.Time) *CommentThreadUpdateOne {
+	ctuo.mutation.SetUpdatedAt(t)
+	return ctuo
+}
+
+// SetTargetPath sets the "target_path" field.
+func (ctuo *CommentThreadUpdateOne) SetTargetPath(s string) *CommentThreadUpdateOne {
+	ctuo.mutation.SetTargetPath(s)
+	return ctuo
+}
+
+// SetNillableTargetPath sets the "target_path" field if the given value is not nil.
+func (ctuo *CommentThreadUpdateOne) SetNillableTargetPath(s *string) *CommentThreadUpdateOne {
+	if s != nil {
+		ctuo.SetTargetPath(*s)
+	}
+	return ctuo
+}
+
+// SetState sets the "state" field.
+func (ctuo *CommentThreadUpdateOne) SetState(c commentthread.State) *CommentThreadUpdateOne {
+	ctuo.mutation.SetState(c)
+	return ctuo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ctuo *CommentThreadUpdateOne) SetNillableState(c *commentthread.State) *CommentThreadUpdateOne {
+	if c != nil {
+		ctuo.SetState(*c)
+	}
+	return ctuo
+}
+
+// Mutation returns the CommentThreadMutation object of the builder.
+func (ctuo *CommentThreadUpdateOne) Mutation() *CommentThreadMutation {
+	return ctuo.mutation
+}
+
+// Where appends a list predicates to the CommentThreadUpdate builder.
+func (ctuo *CommentThreadUpdateOne) Where(ps ...predicate.CommentThread) *CommentThreadUpdateOne {
+	ctuo.mutation.Where(ps...)
+	return ctuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ctuo *CommentThreadUpdateOne) Select(field string, fields ...string) *CommentThreadUpdateOne {
+	ctuo.fields = append([]string{field}, fields...)
+	return ctuo
+}
+
+// Save executes the query and returns the updated CommentThread entity.
+func (ctuo *CommentThreadUpdateOne) Save(ctx context.Context) (*CommentThread, error) {
+	ctuo.defaults()
+	return withHooks(ctx, ctuo.sqlSave, ctuo.mutation, ctuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctuo *CommentThreadUpdateOne) SaveX(ctx context.Context) *CommentThread {
+	node, err := ctuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ctuo *CommentThreadUpdateOne) Exec(ctx context.Context) error {
+	_, err := ctuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctuo *CommentThreadUpdateOne) ExecX(ctx context.Context) {
+	if err := ctuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ctuo *CommentThreadUpdateOne) defaults() {
+	if _, ok := ctuo.mutation.UpdatedAt(); !ok {
+		v := commentthread.UpdateDefaultUpdatedAt()
+		ctuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctuo *CommentThreadUpdateOne) check() error {
+	if v, ok := ctuo.mutation.TargetPath(); ok {
+		if err := commentthread.TargetPathValidator(v); err != nil {
+			return &ValidationError{Name: "target_path", err: fmt.Errorf(`ent: validator failed for field "CommentThread.target_path": %w`, err)}
+		}
+	}
+	if v, ok := ctuo.mutation.State(); ok {
+		if err := commentthread.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "CommentThread.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ctuo *CommentThreadUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentThreadUpdateOne {
+	ctuo.modifiers = append(ctuo.modifiers, modifiers...)
+	return ctuo
+}
+
+func (ctuo *CommentThreadUpdateOne) sqlSave(ctx context.Context) (_node *CommentThread, err error) {
+	if err := ctuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commentthread.Table, commentthread.Columns, sqlgraph.NewFieldSpec(commentthread.FieldID, field.TypeUint))
+	id, ok := ctuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CommentThread.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ctuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commentthread.FieldID)
+		for _, f := range fields {
+			if !commentthread.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != commentthread.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ctuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ctuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(commentthread.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ctuo.mutation.TargetPath(); ok {
+		_spec.SetField(commentthread.FieldTargetPath, field.TypeString, value)
+	}
+	if value, ok := ctuo.mutation.State(); ok {
+		_spec.SetField(commentthread.FieldState, field.TypeEnum, value)
+	}
+	_spec.AddModifiers(ctuo.modifiers...)
+	_node = &CommentThread{config: ctuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ctuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentthread.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ctuo.mutation.done = true
+	return _node, nil
+}
@@ -12,11 +12,16 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/anzhiyu-c/anheyu-app/ent/accesstoken"
+	"github.com/anzhiyu-c/anheyu-app/ent/accountdeletion"
 	"github.com/anzhiyu-c/anheyu-app/ent/album"
 	"github.com/anzhiyu-c/anheyu-app/ent/albumcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/article"
 	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+	"github.com/anzhiyu-c/anheyu-app/ent/blockrule"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentthread"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/docseries"
 	"github.com/anzhiyu-c/anheyu-app/ent/entity"
@@ -26,25 +31,32 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/givemoney"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
 	"github.com/anzhiyu-c/anheyu-app/ent/link"
 	"github.com/anzhiyu-c/anheyu-app/ent/linkcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/linktag"
 	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
 	"github.com/anzhiyu-c/anheyu-app/ent/notificationtype"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
+	"github.com/anzhiyu-c/anheyu-app/ent/passkey"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
 	"github.com/anzhiyu-c/anheyu-app/ent/setting"
+	"github.com/anzhiyu-c/anheyu-app/ent/share"
 	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
 	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
 	"github.com/anzhiyu-c/anheyu-app/ent/tag"
 	"github.com/anzhiyu-c/anheyu-app/ent/urlstat"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
 	"github.com/anzhiyu-c/anheyu-app/ent/usernotificationconfig"
+	"github.com/anzhiyu-c/anheyu-app/ent/usersession"
+	"github.com/anzhiyu-c/anheyu-app/ent/usertwofactor"
 	"github.com/anzhiyu-c/anheyu-app/ent/visitorlog"
 	"github.com/anzhiyu-c/anheyu-app/ent/visitorstat"
+	"github.com/anzhiyu-c/anheyu-app/ent/webmention"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -105,11 +117,16 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			accesstoken.Table:            accesstoken.ValidColumn,
+			accountdeletion.Table:        accountdeletion.ValidColumn,
 			album.Table:                  album.ValidColumn,
 			albumcategory.Table:          albumcategory.ValidColumn,
 			article.Table:                article.ValidColumn,
 			articlehistory.Table:         articlehistory.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
+			blockrule.Table:              blockrule.ValidColumn,
 			comment.Table:                comment.ValidColumn,
+			commentthread.Table:          commentthread.ValidColumn,
 			directlink.Table:             directlink.ValidColumn,
 			docseries.Table:              docseries.ValidColumn,
 			entity.Table:                 entity.ValidColumn,
@@ -119,25 +136,32 @@ func checkColumn(table, column string) error {
 			file.Table:                   file.ValidColumn,
 			fileentity.Table:             fileentity.ValidColumn,
 			givemoney.Table:              givemoney.ValidColumn,
+			invitationcode.Table:         invitationcode.ValidColumn,
 			link.Table:                   link.ValidColumn,
 			linkcategory.Table:           linkcategory.ValidColumn,
 			linktag.Table:                linktag.ValidColumn,
 			metadata.Table:               metadata.ValidColumn,
 			notificationtype.Table:       notificationtype.ValidColumn,
 			page.Table:                   page.ValidColumn,
+			passkey.Table:                passkey.ValidColumn,
 			postcategory.Table:           postcategory.ValidColumn,
 			posttag.Table:                posttag.ValidColumn,
 			setting.Table:                setting.ValidColumn,
+			share.Table:                  share.ValidColumn,
 			storagepolicy.Table:          storagepolicy.ValidColumn,
 			subscriber.Table:             subscriber.ValidColumn,
 			tag.Table:                    tag.ValidColumn,
 			urlstat.Table:                urlstat.ValidColumn,
 			user.Table:                   user.ValidColumn,
 			usergroup.Table:              usergroup.ValidColumn,
+			useridentity.Table:           useridentity.ValidColumn,
 			userinstalledtheme.Table:     userinstalledtheme.ValidColumn,
 			usernotificationconfig.Table: usernotificationconfig.ValidColumn,
+			usersession.Table:            usersession.ValidColumn,
+			usertwofactor.Table:          usertwofactor.ValidColumn,
 			visitorlog.Table:             visitorlog.ValidColumn,
 			visitorstat.Table:            visitorstat.ValidColumn,
+			webmention.Table:             webmention.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
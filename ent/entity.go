@@ -44,6 +44,10 @@ type Entity struct {
 	Dimension *string `json:"dimension,omitempty"`
 	// 存储提供者特有的额外元数据
 	StorageMetadata model.JSONMap `json:"storage_metadata,omitempty"`
+	// 文件内容的 SHA-256 哈希，用于同一存储策略内的内容去重
+	ContentHash *string `json:"content_hash,omitempty"`
+	// 引用此实体的文件数量，最后一个引用释放时才删除物理文件
+	ReferenceCount int `json:"reference_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EntityQuery when eager-loading is set.
 	Edges        EntityEdges `json:"edges"`
@@ -75,9 +79,9 @@ func (*Entity) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case entity.FieldStorageMetadata:
 			values[i] = new(model.JSONMap)
-		case entity.FieldID, entity.FieldSize, entity.FieldPolicyID, entity.FieldCreatedBy:
+		case entity.FieldID, entity.FieldSize, entity.FieldPolicyID, entity.FieldCreatedBy, entity.FieldReferenceCount:
 			values[i] = new(sql.NullInt64)
-		case entity.FieldType, entity.FieldSource, entity.FieldUploadSessionID, entity.FieldRecycleOptions, entity.FieldEtag, entity.FieldMimeType, entity.FieldDimension:
+		case entity.FieldType, entity.FieldSource, entity.FieldUploadSessionID, entity.FieldRecycleOptions, entity.FieldEtag, entity.FieldMimeType, entity.FieldDimension, entity.FieldContentHash:
 			values[i] = new(sql.NullString)
 		case entity.FieldCreatedAt, entity.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -187,6 +191,19 @@ func (e *Entity) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				e.StorageMetadata = *value
 			}
+		case entity.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				e.ContentHash = new(string)
+				*e.ContentHash = value.String
+			}
+		case entity.FieldReferenceCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reference_count", values[i])
+			} else if value.Valid {
+				e.ReferenceCount = int(value.Int64)
+			}
 		default:
 			e.selectValues.Set(columns[i], values[i])
 		}
@@ -280,6 +297,14 @@ func (e *Entity) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("storage_metadata=")
 	builder.WriteString(fmt.Sprintf("%v", e.StorageMetadata))
+	builder.WriteString(", ")
+	if v := e.ContentHash; v != nil {
+		builder.WriteString("content_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reference_count=")
+	builder.WriteString(fmt.Sprintf("%v", e.ReferenceCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
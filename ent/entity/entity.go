@@ -40,6 +40,10 @@ const (
 	FieldDimension = "dimension"
 	// FieldStorageMetadata holds the string denoting the storage_metadata field in the database.
 	FieldStorageMetadata = "storage_metadata"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldReferenceCount holds the string denoting the reference_count field in the database.
+	FieldReferenceCount = "reference_count"
 	// EdgeFileVersions holds the string denoting the file_versions edge name in mutations.
 	EdgeFileVersions = "file_versions"
 	// Table holds the table name of the entity in the database.
@@ -69,6 +73,8 @@ var Columns = []string{
 	FieldMimeType,
 	FieldDimension,
 	FieldStorageMetadata,
+	FieldContentHash,
+	FieldReferenceCount,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -96,6 +102,10 @@ var (
 	MimeTypeValidator func(string) error
 	// DimensionValidator is a validator for the "dimension" field. It is called by the builders before save.
 	DimensionValidator func(string) error
+	// ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	ContentHashValidator func(string) error
+	// DefaultReferenceCount holds the default value on creation for the "reference_count" field.
+	DefaultReferenceCount int
 )
 
 // OrderOption defines the ordering options for the Entity queries.
@@ -171,6 +181,16 @@ func ByStorageMetadata(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStorageMetadata, opts...).ToFunc()
 }
 
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByReferenceCount orders the results by the reference_count field.
+func ByReferenceCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferenceCount, opts...).ToFunc()
+}
+
 // ByFileVersionsCount orders the results by file_versions count.
 func ByFileVersionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -174,6 +174,34 @@ func (ec *EntityCreate) SetStorageMetadata(mm model.JSONMap) *EntityCreate {
 	return ec
 }
 
+// SetContentHash sets the "content_hash" field.
+func (ec *EntityCreate) SetContentHash(s string) *EntityCreate {
+	ec.mutation.SetContentHash(s)
+	return ec
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (ec *EntityCreate) SetNillableContentHash(s *string) *EntityCreate {
+	if s != nil {
+		ec.SetContentHash(*s)
+	}
+	return ec
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (ec *EntityCreate) SetReferenceCount(i int) *EntityCreate {
+	ec.mutation.SetReferenceCount(i)
+	return ec
+}
+
+// SetNillableReferenceCount sets the "reference_count" field if the given value is not nil.
+func (ec *EntityCreate) SetNillableReferenceCount(i *int) *EntityCreate {
+	if i != nil {
+		ec.SetReferenceCount(*i)
+	}
+	return ec
+}
+
 // SetID sets the "id" field.
 func (ec *EntityCreate) SetID(u uint) *EntityCreate {
 	ec.mutation.SetID(u)
@@ -238,6 +266,10 @@ func (ec *EntityCreate) defaults() {
 		v := entity.DefaultUpdatedAt()
 		ec.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := ec.mutation.ReferenceCount(); !ok {
+		v := entity.DefaultReferenceCount
+		ec.mutation.SetReferenceCount(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -277,6 +309,14 @@ func (ec *EntityCreate) check() error {
 			return &ValidationError{Name: "dimension", err: fmt.Errorf(`ent: validator failed for field "Entity.dimension": %w`, err)}
 		}
 	}
+	if v, ok := ec.mutation.ContentHash(); ok {
+		if err := entity.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "Entity.content_hash": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.ReferenceCount(); !ok {
+		return &ValidationError{Name: "reference_count", err: errors.New(`ent: missing required field "Entity.reference_count"`)}
+	}
 	return nil
 }
 
@@ -362,6 +402,14 @@ func (ec *EntityCreate) createSpec() (*Entity, *sqlgraph.CreateSpec) {
 		_spec.SetField(entity.FieldStorageMetadata, field.TypeOther, value)
 		_node.StorageMetadata = value
 	}
+	if value, ok := ec.mutation.ContentHash(); ok {
+		_spec.SetField(entity.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = &value
+	}
+	if value, ok := ec.mutation.ReferenceCount(); ok {
+		_spec.SetField(entity.FieldReferenceCount, field.TypeInt, value)
+		_node.ReferenceCount = value
+	}
 	if nodes := ec.mutation.FileVersionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -640,6 +688,42 @@ func (u *EntityUpsert) ClearStorageMetadata() *EntityUpsert {
 	return u
 }
 
+// SetContentHash sets the "content_hash" field.
+func (u *EntityUpsert) SetContentHash(v string) *EntityUpsert {
+	u.Set(entity.FieldContentHash, v)
+	return u
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *EntityUpsert) UpdateContentHash() *EntityUpsert {
+	u.SetExcluded(entity.FieldContentHash)
+	return u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *EntityUpsert) ClearContentHash() *EntityUpsert {
+	u.SetNull(entity.FieldContentHash)
+	return u
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (u *EntityUpsert) SetReferenceCount(v int) *EntityUpsert {
+	u.Set(entity.FieldReferenceCount, v)
+	return u
+}
+
+// UpdateReferenceCount sets the "reference_count" field to the value that was provided on create.
+func (u *EntityUpsert) UpdateReferenceCount() *EntityUpsert {
+	u.SetExcluded(entity.FieldReferenceCount)
+	return u
+}
+
+// AddReferenceCount adds v to the "reference_count" field.
+func (u *EntityUpsert) AddReferenceCount(v int) *EntityUpsert {
+	u.Add(entity.FieldReferenceCount, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -936,6 +1020,48 @@ func (u *EntityUpsertOne) ClearStorageMetadata() *EntityUpsertOne {
 	})
 }
 
+// SetContentHash sets the "content_hash" field.
+func (u *EntityUpsertOne) SetContentHash(v string) *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *EntityUpsertOne) UpdateContentHash() *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *EntityUpsertOne) ClearContentHash() *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.ClearContentHash()
+	})
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (u *EntityUpsertOne) SetReferenceCount(v int) *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetReferenceCount(v)
+	})
+}
+
+// AddReferenceCount adds v to the "reference_count" field.
+func (u *EntityUpsertOne) AddReferenceCount(v int) *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.AddReferenceCount(v)
+	})
+}
+
+// UpdateReferenceCount sets the "reference_count" field to the value that was provided on create.
+func (u *EntityUpsertOne) UpdateReferenceCount() *EntityUpsertOne {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateReferenceCount()
+	})
+}
+
 // Exec executes the query.
 func (u *EntityUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1398,6 +1524,48 @@ func (u *EntityUpsertBulk) ClearStorageMetadata() *EntityUpsertBulk {
 	})
 }
 
+// SetContentHash sets the "content_hash" field.
+func (u *EntityUpsertBulk) SetContentHash(v string) *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetContentHash(v)
+	})
+}
+
+// UpdateContentHash sets the "content_hash" field to the value that was provided on create.
+func (u *EntityUpsertBulk) UpdateContentHash() *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateContentHash()
+	})
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (u *EntityUpsertBulk) ClearContentHash() *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.ClearContentHash()
+	})
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (u *EntityUpsertBulk) SetReferenceCount(v int) *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.SetReferenceCount(v)
+	})
+}
+
+// AddReferenceCount adds v to the "reference_count" field.
+func (u *EntityUpsertBulk) AddReferenceCount(v int) *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.AddReferenceCount(v)
+	})
+}
+
+// UpdateReferenceCount sets the "reference_count" field to the value that was provided on create.
+func (u *EntityUpsertBulk) UpdateReferenceCount() *EntityUpsertBulk {
+	return u.Update(func(s *EntityUpsert) {
+		s.UpdateReferenceCount()
+	})
+}
+
 // Exec executes the query.
 func (u *EntityUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
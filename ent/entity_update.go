@@ -252,6 +252,47 @@ func (eu *EntityUpdate) ClearStorageMetadata() *EntityUpdate {
 	return eu
 }
 
+// SetContentHash sets the "content_hash" field.
+func (eu *EntityUpdate) SetContentHash(s string) *EntityUpdate {
+	eu.mutation.SetContentHash(s)
+	return eu
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (eu *EntityUpdate) SetNillableContentHash(s *string) *EntityUpdate {
+	if s != nil {
+		eu.SetContentHash(*s)
+	}
+	return eu
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (eu *EntityUpdate) ClearContentHash() *EntityUpdate {
+	eu.mutation.ClearContentHash()
+	return eu
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (eu *EntityUpdate) SetReferenceCount(i int) *EntityUpdate {
+	eu.mutation.ResetReferenceCount()
+	eu.mutation.SetReferenceCount(i)
+	return eu
+}
+
+// SetNillableReferenceCount sets the "reference_count" field if the given value is not nil.
+func (eu *EntityUpdate) SetNillableReferenceCount(i *int) *EntityUpdate {
+	if i != nil {
+		eu.SetReferenceCount(*i)
+	}
+	return eu
+}
+
+// AddReferenceCount adds i to the "reference_count" field.
+func (eu *EntityUpdate) AddReferenceCount(i int) *EntityUpdate {
+	eu.mutation.AddReferenceCount(i)
+	return eu
+}
+
 // AddFileVersionIDs adds the "file_versions" edge to the FileEntity entity by IDs.
 func (eu *EntityUpdate) AddFileVersionIDs(ids ...uint) *EntityUpdate {
 	eu.mutation.AddFileVersionIDs(ids...)
@@ -351,6 +392,11 @@ func (eu *EntityUpdate) check() error {
 			return &ValidationError{Name: "dimension", err: fmt.Errorf(`ent: validator failed for field "Entity.dimension": %w`, err)}
 		}
 	}
+	if v, ok := eu.mutation.ContentHash(); ok {
+		if err := entity.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "Entity.content_hash": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -441,6 +487,18 @@ func (eu *EntityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if eu.mutation.StorageMetadataCleared() {
 		_spec.ClearField(entity.FieldStorageMetadata, field.TypeOther)
 	}
+	if value, ok := eu.mutation.ContentHash(); ok {
+		_spec.SetField(entity.FieldContentHash, field.TypeString, value)
+	}
+	if eu.mutation.ContentHashCleared() {
+		_spec.ClearField(entity.FieldContentHash, field.TypeString)
+	}
+	if value, ok := eu.mutation.ReferenceCount(); ok {
+		_spec.SetField(entity.FieldReferenceCount, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.AddedReferenceCount(); ok {
+		_spec.AddField(entity.FieldReferenceCount, field.TypeInt, value)
+	}
 	if eu.mutation.FileVersionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -729,6 +787,47 @@ func (euo *EntityUpdateOne) ClearStorageMetadata() *EntityUpdateOne {
 	return euo
 }
 
+// SetContentHash sets the "content_hash" field.
+func (euo *EntityUpdateOne) SetContentHash(s string) *EntityUpdateOne {
+	euo.mutation.SetContentHash(s)
+	return euo
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (euo *EntityUpdateOne) SetNillableContentHash(s *string) *EntityUpdateOne {
+	if s != nil {
+		euo.SetContentHash(*s)
+	}
+	return euo
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (euo *EntityUpdateOne) ClearContentHash() *EntityUpdateOne {
+	euo.mutation.ClearContentHash()
+	return euo
+}
+
+// SetReferenceCount sets the "reference_count" field.
+func (euo *EntityUpdateOne) SetReferenceCount(i int) *EntityUpdateOne {
+	euo.mutation.ResetReferenceCount()
+	euo.mutation.SetReferenceCount(i)
+	return euo
+}
+
+// SetNillableReferenceCount sets the "reference_count" field if the given value is not nil.
+func (euo *EntityUpdateOne) SetNillableReferenceCount(i *int) *EntityUpdateOne {
+	if i != nil {
+		euo.SetReferenceCount(*i)
+	}
+	return euo
+}
+
+// AddReferenceCount adds i to the "reference_count" field.
+func (euo *EntityUpdateOne) AddReferenceCount(i int) *EntityUpdateOne {
+	euo.mutation.AddReferenceCount(i)
+	return euo
+}
+
 // AddFileVersionIDs adds the "file_versions" edge to the FileEntity entity by IDs.
 func (euo *EntityUpdateOne) AddFileVersionIDs(ids ...uint) *EntityUpdateOne {
 	euo.mutation.AddFileVersionIDs(ids...)
@@ -841,6 +940,11 @@ func (euo *EntityUpdateOne) check() error {
 			return &ValidationError{Name: "dimension", err: fmt.Errorf(`ent: validator failed for field "Entity.dimension": %w`, err)}
 		}
 	}
+	if v, ok := euo.mutation.ContentHash(); ok {
+		if err := entity.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "Entity.content_hash": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -948,6 +1052,18 @@ func (euo *EntityUpdateOne) sqlSave(ctx context.Context) (_node *Entity, err err
 	if euo.mutation.StorageMetadataCleared() {
 		_spec.ClearField(entity.FieldStorageMetadata, field.TypeOther)
 	}
+	if value, ok := euo.mutation.ContentHash(); ok {
+		_spec.SetField(entity.FieldContentHash, field.TypeString, value)
+	}
+	if euo.mutation.ContentHashCleared() {
+		_spec.ClearField(entity.FieldContentHash, field.TypeString)
+	}
+	if value, ok := euo.mutation.ReferenceCount(); ok {
+		_spec.SetField(entity.FieldReferenceCount, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.AddedReferenceCount(); ok {
+		_spec.AddField(entity.FieldReferenceCount, field.TypeInt, value)
+	}
 	if euo.mutation.FileVersionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
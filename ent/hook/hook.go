@@ -9,6 +9,30 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent"
 )
 
+// The AccessTokenFunc type is an adapter to allow the use of ordinary
+// function as AccessToken mutator.
+type AccessTokenFunc func(context.Context, *ent.AccessTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccessTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccessTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccessTokenMutation", m)
+}
+
+// The AccountDeletionFunc type is an adapter to allow the use of ordinary
+// function as AccountDeletion mutator.
+type AccountDeletionFunc func(context.Context, *ent.AccountDeletionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccountDeletionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccountDeletionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccountDeletionMutation", m)
+}
+
 // The AlbumFunc type is an adapter to allow the use of ordinary
 // function as Album mutator.
 type AlbumFunc func(context.Context, *ent.AlbumMutation) (ent.Value, error)
@@ -57,6 +81,30 @@ func (f ArticleHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArticleHistoryMutation", m)
 }
 
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+}
+
+// The BlockRuleFunc type is an adapter to allow the use of ordinary
+// function as BlockRule mutator.
+type BlockRuleFunc func(context.Context, *ent.BlockRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlockRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BlockRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlockRuleMutation", m)
+}
+
 // The CommentFunc type is an adapter to allow the use of ordinary
 // function as Comment mutator.
 type CommentFunc func(context.Context, *ent.CommentMutation) (ent.Value, error)
@@ -69,6 +117,18 @@ func (f CommentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentMutation", m)
 }
 
+// The CommentThreadFunc type is an adapter to allow the use of ordinary
+// function as CommentThread mutator.
+type CommentThreadFunc func(context.Context, *ent.CommentThreadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommentThreadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommentThreadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentThreadMutation", m)
+}
+
 // The DirectLinkFunc type is an adapter to allow the use of ordinary
 // function as DirectLink mutator.
 type DirectLinkFunc func(context.Context, *ent.DirectLinkMutation) (ent.Value, error)
@@ -177,6 +237,18 @@ func (f GiveMoneyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GiveMoneyMutation", m)
 }
 
+// The InvitationCodeFunc type is an adapter to allow the use of ordinary
+// function as InvitationCode mutator.
+type InvitationCodeFunc func(context.Context, *ent.InvitationCodeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvitationCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvitationCodeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvitationCodeMutation", m)
+}
+
 // The LinkFunc type is an adapter to allow the use of ordinary
 // function as Link mutator.
 type LinkFunc func(context.Context, *ent.LinkMutation) (ent.Value, error)
@@ -249,6 +321,18 @@ func (f PageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PageMutation", m)
 }
 
+// The PasskeyFunc type is an adapter to allow the use of ordinary
+// function as Passkey mutator.
+type PasskeyFunc func(context.Context, *ent.PasskeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PasskeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PasskeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PasskeyMutation", m)
+}
+
 // The PostCategoryFunc type is an adapter to allow the use of ordinary
 // function as PostCategory mutator.
 type PostCategoryFunc func(context.Context, *ent.PostCategoryMutation) (ent.Value, error)
@@ -285,6 +369,18 @@ func (f SettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettingMutation", m)
 }
 
+// The ShareFunc type is an adapter to allow the use of ordinary
+// function as Share mutator.
+type ShareFunc func(context.Context, *ent.ShareMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShareMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareMutation", m)
+}
+
 // The StoragePolicyFunc type is an adapter to allow the use of ordinary
 // function as StoragePolicy mutator.
 type StoragePolicyFunc func(context.Context, *ent.StoragePolicyMutation) (ent.Value, error)
@@ -357,6 +453,18 @@ func (f UserGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserGroupMutation", m)
 }
 
+// The UserIdentityFunc type is an adapter to allow the use of ordinary
+// function as UserIdentity mutator.
+type UserIdentityFunc func(context.Context, *ent.UserIdentityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserIdentityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserIdentityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserIdentityMutation", m)
+}
+
 // The UserInstalledThemeFunc type is an adapter to allow the use of ordinary
 // function as UserInstalledTheme mutator.
 type UserInstalledThemeFunc func(context.Context, *ent.UserInstalledThemeMutation) (ent.Value, error)
@@ -381,6 +489,30 @@ func (f UserNotificationConfigFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserNotificationConfigMutation", m)
 }
 
+// The UserSessionFunc type is an adapter to allow the use of ordinary
+// function as UserSession mutator.
+type UserSessionFunc func(context.Context, *ent.UserSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserSessionMutation", m)
+}
+
+// The UserTwoFactorFunc type is an adapter to allow the use of ordinary
+// function as UserTwoFactor mutator.
+type UserTwoFactorFunc func(context.Context, *ent.UserTwoFactorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserTwoFactorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserTwoFactorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserTwoFactorMutation", m)
+}
+
 // The VisitorLogFunc type is an adapter to allow the use of ordinary
 // function as VisitorLog mutator.
 type VisitorLogFunc func(context.Context, *ent.VisitorLogMutation) (ent.Value, error)
@@ -405,6 +537,18 @@ func (f VisitorStatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VisitorStatMutation", m)
 }
 
+// The WebmentionFunc type is an adapter to allow the use of ordinary
+// function as Webmention mutator.
+type WebmentionFunc func(context.Context, *ent.WebmentionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebmentionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebmentionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebmentionMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
+)
+
+// 注册邀请码表
+type InvitationCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 邀请码
+	Code string `json:"code,omitempty"`
+	// 创建者用户ID
+	CreatorID uint `json:"creator_id,omitempty"`
+	// 使用该邀请码注册的用户所属的用户组ID
+	UserGroupID uint `json:"user_group_id,omitempty"`
+	// 最大使用次数，0 表示不限制
+	MaxUses int `json:"max_uses,omitempty"`
+	// 已使用次数
+	UsedCount int `json:"used_count,omitempty"`
+	// 限定使用的邮箱，为空表示任何邮箱都可以使用
+	Email string `json:"email,omitempty"`
+	// 备注
+	Note string `json:"note,omitempty"`
+	// 过期时间，为空表示永不过期
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// 撤销时间，不为空表示已撤销
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvitationCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invitationcode.FieldID, invitationcode.FieldCreatorID, invitationcode.FieldUserGroupID, invitationcode.FieldMaxUses, invitationcode.FieldUsedCount:
+			values[i] = new(sql.NullInt64)
+		case invitationcode.FieldCode, invitationcode.FieldEmail, invitationcode.FieldNote:
+			values[i] = new(sql.NullString)
+		case invitationcode.FieldCreatedAt, invitationcode.FieldUpdatedAt, invitationcode.FieldExpiresAt, invitationcode.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvitationCode fields.
+func (ic *InvitationCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invitationcode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ic.ID = uint(value.Int64)
+		case invitationcode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ic.CreatedAt = value.Time
+			}
+		case invitationcode.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ic.UpdatedAt = value.Time
+			}
+		case invitationcode.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				ic.Code = value.String
+			}
+		case invitationcode.FieldCreatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value.Valid {
+				ic.CreatorID = uint(value.Int64)
+			}
+		case invitationcode.FieldUserGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_group_id", values[i])
+			} else if value.Valid {
+				ic.UserGroupID = uint(value.Int64)
+			}
+		case invitationcode.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				ic.MaxUses = int(value.Int64)
+			}
+		case invitationcode.FieldUsedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field used_count", values[i])
+			} else if value.Valid {
+				ic.UsedCount = int(value.Int64)
+			}
+		case invitationcode.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ic.Email = value.String
+			}
+		case invitationcode.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				ic.Note = value.String
+			}
+		case invitationcode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ic.ExpiresAt = new(time.Time)
+				*ic.ExpiresAt = value.Time
+			}
+		case invitationcode.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				ic.RevokedAt = new(time.Time)
+				*ic.RevokedAt = value.Time
+			}
+		default:
+			ic.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvitationCode.
+// This includes values selected through modifiers, order, etc.
+func (ic *InvitationCode) Value(name string) (ent.Value, error) {
+	return ic.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InvitationCode.
+// Note that you need to call InvitationCode.Unwrap() before calling this method if this InvitationCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ic *InvitationCode) Update() *InvitationCodeUpdateOne {
+	return NewInvitationCodeClient(ic.config).UpdateOne(ic)
+}
+
+// Unwrap unwraps the InvitationCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ic *InvitationCode) Unwrap() *InvitationCode {
+	_tx, ok := ic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvitationCode is not a transactional entity")
+	}
+	ic.config.driver = _tx.drv
+	return ic
+}
+
+// String implements the fmt.Stringer.
+func (ic *InvitationCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvitationCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ic.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ic.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ic.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(ic.Code)
+	builder.WriteString(", ")
+	builder.WriteString("creator_id=")
+	builder.WriteString(fmt.Sprintf("%v", ic.CreatorID))
+	builder.WriteString(", ")
+	builder.WriteString("user_group_id=")
+	builder.WriteString(fmt.Sprintf("%v", ic.UserGroupID))
+	builder.WriteString(", ")
+	builder.WriteString("max_uses=")
+	builder.WriteString(fmt.Sprintf("%v", ic.MaxUses))
+	builder.WriteString(", ")
+	builder.WriteString("used_count=")
+	builder.WriteString(fmt.Sprintf("%v", ic.UsedCount))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(ic.Email)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(ic.Note)
+	builder.WriteString(", ")
+	if v := ic.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ic.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvitationCodes is a parsable slice of InvitationCode.
+type InvitationCodes []*InvitationCode
@@ -0,0 +1,1152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
+)
+
+// InvitationCodeCreate is the builder for creating a InvitationCode entity.
+type InvitationCodeCreate struct {
+	config
+	mutation *InvitationCodeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (icc *InvitationCodeCreate) SetCreatedAt(t time.Time) *InvitationCodeCreate {
+	icc.mutation.SetCreatedAt(t)
+	return icc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (icc *InvitationCodeCreate) SetNillableCreatedAt(t *time.Time) *InvitationCodeCreate {
+	if t != nil {
+		icc.SetCreatedAt(*t)
+	}
+	return icc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icc *InvitationCodeCreate) SetUpdatedAt(t time.Time) *InvitationCodeCreate {
+	icc.mutation.SetUpdatedAt(t)
+	return icc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (icc *InvitationCodeCreate) SetNillableUpdatedAt(t *time.Time) *InvitationCodeCreate {
+	if t != nil {
+		icc.SetUpdatedAt(*t)
+	}
+	return icc
+}
+
+// SetCode sets the "code" field.
+func (icc *InvitationCodeCreate) SetCode(s string) *InvitationCodeCreate {
+	icc.mutation.SetCode(s)
+	return icc
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (icc *InvitationCodeCreate) SetCreatorID(u uint) *InvitationCodeCreate {
+	icc.mutation.SetCreatorID(u)
+	return icc
+}
+
+// SetUserGroupID sets the "user_group_id" field.
+func (icc *InvitationCodeCreate) SetUserGroupID(u uint) *InvitationCodeCreate {
+	icc.mutation.SetUserGroupID(u)
+	return icc
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (icc *InvitationCodeCreate) SetMaxUses(i int) *InvitationCodeCreate {
+	icc.mutation.SetMaxUses(i)
+	return icc
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (icc *InvitationCodeCreate) SetNillableMaxUses(i *int) *InvitationCodeCreate {
+	if i != nil {
+		icc.SetMaxUses(*i)
+	}
+	return icc
+}
+
+// SetUsedCount sets the "used_count" field.
+func (icc *InvitationCodeCreate) SetUsedCount(i int) *InvitationCodeCreate {
+	icc.mutation.SetUsedCount(i)
+	return icc
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (icc *InvitationCodeCreate) SetNillableUsedCount(i *int) *InvitationCodeCreate {
+	if i != nil {
+		icc.SetUsedCount(*i)
+	}
+	return icc
+}
+
+// SetEmail sets the "email" field.
+func (icc *InvitationCodeCreate) SetEmail(s string) *InvitationCodeCreate {
+	icc.mutation.SetEmail(s)
+	return icc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (icc *InvitationCodeCreate) SetNillableEmail(s *string) *InvitationCodeCreate {
+	if s != nil {
+		icc.SetEmail(*s)
+	}
+	return icc
+}
+
+// SetNote sets the "note" field.
+func (icc *InvitationCodeCreate) SetNote(s string) *InvitationCodeCreate {
+	icc.mutation.SetNote(s)
+	return icc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (icc *InvitationCodeCreate) SetNillableNote(s *string) *InvitationCodeCreate {
+	if s != nil {
+		icc.SetNote(*s)
+	}
+	return icc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (icc *InvitationCodeCreate) SetExpiresAt(t time.Time) *InvitationCodeCreate {
+	icc.mutation.SetExpiresAt(t)
+	return icc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (icc *InvitationCodeCreate) SetNillableExpiresAt(t *time.Time) *InvitationCodeCreate {
+	if t != nil {
+		icc.SetExpiresAt(*t)
+	}
+	return icc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (icc *InvitationCodeCreate) SetRevokedAt(t time.Time) *InvitationCodeCreate {
+	icc.mutation.SetRevokedAt(t)
+	return icc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (icc *InvitationCodeCreate) SetNillableRevokedAt(t *time.Time) *InvitationCodeCreate {
+	if t != nil {
+		icc.SetRevokedAt(*t)
+	}
+	return icc
+}
+
+// SetID sets the "id" field.
+func (icc *InvitationCodeCreate) SetID(u uint) *InvitationCodeCreate {
+	icc.mutation.SetID(u)
+	return icc
+}
+
+// Mutation returns the InvitationCodeMutation object of the builder.
+func (icc *InvitationCodeCreate) Mutation() *InvitationCodeMutation {
+	return icc.mutation
+}
+
+// Save creates the InvitationCode in the database.
+func (icc *InvitationCodeCreate) Save(ctx context.Context) (*InvitationCode, error) {
+	icc.defaults()
+	return withHooks(ctx, icc.sqlSave, icc.mutation, icc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (icc *InvitationCodeCreate) SaveX(ctx context.Context) *InvitationCode {
+	v, err := icc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icc *InvitationCodeCreate) Exec(ctx context.Context) error {
+	_, err := icc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icc *InvitationCodeCreate) ExecX(ctx context.Context) {
+	if err := icc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icc *InvitationCodeCreate) defaults() {
+	if _, ok := icc.mutation.CreatedAt(); !ok {
+		v := invitationcode.DefaultCreatedAt()
+		icc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := icc.mutation.UpdatedAt(); !ok {
+		v := invitationcode.DefaultUpdatedAt()
+		icc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := icc.mutation.MaxUses(); !ok {
+		v := invitationcode.DefaultMaxUses
+		icc.mutation.SetMaxUses(v)
+	}
+	if _, ok := icc.mutation.UsedCount(); !ok {
+		v := invitationcode.DefaultUsedCount
+		icc.mutation.SetUsedCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icc *InvitationCodeCreate) check() error {
+	if _, ok := icc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvitationCode.created_at"`)}
+	}
+	if _, ok := icc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InvitationCode.updated_at"`)}
+	}
+	if _, ok := icc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "InvitationCode.code"`)}
+	}
+	if v, ok := icc.mutation.Code(); ok {
+		if err := invitationcode.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.code": %w`, err)}
+		}
+	}
+	if _, ok := icc.mutation.CreatorID(); !ok {
+		return &ValidationError{Name: "creator_id", err: errors.New(`ent: missing required field "InvitationCode.creator_id"`)}
+	}
+	if _, ok := icc.mutation.UserGroupID(); !ok {
+		return &ValidationError{Name: "user_group_id", err: errors.New(`ent: missing required field "InvitationCode.user_group_id"`)}
+	}
+	if _, ok := icc.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "InvitationCode.max_uses"`)}
+	}
+	if v, ok := icc.mutation.MaxUses(); ok {
+		if err := invitationcode.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := icc.mutation.UsedCount(); !ok {
+		return &ValidationError{Name: "used_count", err: errors.New(`ent: missing required field "InvitationCode.used_count"`)}
+	}
+	if v, ok := icc.mutation.UsedCount(); ok {
+		if err := invitationcode.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.used_count": %w`, err)}
+		}
+	}
+	if v, ok := icc.mutation.Email(); ok {
+		if err := invitationcode.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.email": %w`, err)}
+		}
+	}
+	if v, ok := icc.mutation.Note(); ok {
+		if err := invitationcode.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.note": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (icc *InvitationCodeCreate) sqlSave(ctx context.Context) (*InvitationCode, error) {
+	if err := icc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := icc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, icc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	icc.mutation.id = &_node.ID
+	icc.mutation.done = true
+	return _node, nil
+}
+
+func (icc *InvitationCodeCreate) createSpec() (*InvitationCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvitationCode{config: icc.config}
+		_spec = sqlgraph.NewCreateSpec(invitationcode.Table, sqlgraph.NewFieldSpec(invitationcode.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = icc.conflict
+	if id, ok := icc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := icc.mutation.CreatedAt(); ok {
+		_spec.SetField(invitationcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := icc.mutation.UpdatedAt(); ok {
+		_spec.SetField(invitationcode.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := icc.mutation.Code(); ok {
+		_spec.SetField(invitationcode.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := icc.mutation.CreatorID(); ok {
+		_spec.SetField(invitationcode.FieldCreatorID, field.TypeUint, value)
+		_node.CreatorID = value
+	}
+	if value, ok := icc.mutation.UserGroupID(); ok {
+		_spec.SetField(invitationcode.FieldUserGroupID, field.TypeUint, value)
+		_node.UserGroupID = value
+	}
+	if value, ok := icc.mutation.MaxUses(); ok {
+		_spec.SetField(invitationcode.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := icc.mutation.UsedCount(); ok {
+		_spec.SetField(invitationcode.FieldUsedCount, field.TypeInt, value)
+		_node.UsedCount = value
+	}
+	if value, ok := icc.mutation.Email(); ok {
+		_spec.SetField(invitationcode.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := icc.mutation.Note(); ok {
+		_spec.SetField(invitationcode.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := icc.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitationcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := icc.mutation.RevokedAt(); ok {
+		_spec.SetField(invitationcode.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvitationCode.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvitationCodeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (icc *InvitationCodeCreate) OnConflict(opts ...sql.ConflictOption) *InvitationCodeUpsertOne {
+	icc.conflict = opts
+	return &InvitationCodeUpsertOne{
+		create: icc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvitationCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icc *InvitationCodeCreate) OnConflictColumns(columns ...string) *InvitationCodeUpsertOne {
+	icc.conflict = append(icc.conflict, sql.ConflictColumns(columns...))
+	return &InvitationCodeUpsertOne{
+		create: icc,
+	}
+}
+
+type (
+	// InvitationCodeUpsertOne is the builder for "upsert"-ing
+	//  one InvitationCode node.
+	InvitationCodeUpsertOne struct {
+		create *InvitationCodeCreate
+	}
+
+	// InvitationCodeUpsert is the "OnConflict" setter.
+	InvitationCodeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvitationCodeUpsert) SetUpdatedAt(v time.Time) *InvitationCodeUpsert {
+	u.Set(invitationcode.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvitationCodeUpsert) UpdateUpdatedAt() *InvitationCodeUpsert {
+	u.SetExcluded(invitationcode.FieldUpdatedAt)
+	return u
+}
+
+// SetUserGroupID sets the "user_group_id" field.
+func (u *InvitationCodeUpsert) SetUserGroupID(v uint) *InvitationCodeUpsert {
+	u.Set(invitationcode.FieldUserGroupID, v)
+	return u
+}
+
+// UpdateUserGroupID sets the "user_group_id" field to the value that was provided on create.
+func (u *InvitationCodeUpsert) UpdateUserGroupID() *InvitationCodeUpsert {
+	u.SetExcluded(invitationcode.FieldUserGroupID)
+	return u
+}
+
+// AddUserGroupID adds v to the "user_group_id" field.
+func (u *InvitationCodeUpsert) AddUserGroupID(v uint) *InvitationCodeUpsert {
+	u.Add(invitationcode.FieldUserGroupID, v)
+	return u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InvitationCodeUpsert) SetMaxUses(v int) *InvitationCodeUpsert {
+	u.Set(invitationcode.FieldMaxUses, v)
+	return u
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InvitationCodeUpsert) UpdateMaxUses() *InvitationCodeUpsert {
+	u.SetExcluded(invitationcode.FieldMaxUses)
+	return u
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InvitationCodeUpsert) AddMaxUses(v int) *InvitationCodeUpsert {
+	u.Add(invitationcode.FieldMaxUses, v)
+	return u
+}
+
+// SetUsedCount sets the "used_count" field.
+func (u *InvitationCodeUpsert) SetUsedCount(v int) *InvitationCodeUpsert {
+	u.Set(invitationcode.FieldUsedCount, v)
+	return u
+}
+
+// UpdateUsedCount sets the "used_count" field to the value that was provided on create.
+func (u *InvitationCodeUpsert) UpdateUsedCount() *InvitationCodeUpsert {
+	u.SetExcluded(invitationcode.FieldUsedCount)
+	return u
+}
+
+// AddUsedCount adds v to the "used_count" field.
+func (u *InvitationCodeUpsert) AddUsedCount(v int) *InvitationCodeUpsert {
+	u.Add(invitationcode.FieldUsedCount, v)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *InvitationCodeUpsert) SetEmail(v string) *InvitationCodeUpsert {
+	u.Set(invitationcode.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *InvitationCodeUpsert) UpdateEmail() *InvitationCodeUpsert {
+	u.SetExcluded(invitationcode.FieldEmail)
+	return u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *InvitationCodeUpsert) ClearEmail() *InvitationCodeUpsert {
+	u.SetNull(invitationcode.FieldEmail)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *InvitationCodeUpsert) SetNote(v string) *InvitationCodeUpsert {
+	u.Set(invitationcode.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvitationCodeUpsert) UpdateNote() *InvitationCodeUpsert {
+	u.SetExcluded(invitationcode.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvitationCodeUpsert) ClearNote() *InvitationCodeUpsert {
+	u.SetNull(invitationcode.FieldNote)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationCodeUpsert) SetExpiresAt(v time.Time) *InvitationCodeUpsert {
+	u.Set(invitationcode.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationCodeUpsert) UpdateExpiresAt() *InvitationCodeUpsert {
+	u.SetExcluded(invitationcode.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InvitationCodeUpsert) ClearExpiresAt() *InvitationCodeUpsert {
+	u.SetNull(invitationcode.FieldExpiresAt)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationCodeUpsert) SetRevokedAt(v time.Time) *InvitationCodeUpsert {
+	u.Set(invitationcode.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationCodeUpsert) UpdateRevokedAt() *InvitationCodeUpsert {
+	u.SetExcluded(invitationcode.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationCodeUpsert) ClearRevokedAt() *InvitationCodeUpsert {
+	u.SetNull(invitationcode.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InvitationCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invitationcode.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvitationCodeUpsertOne) UpdateNewValues() *InvitationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invitationcode.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invitationcode.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Code(); exists {
+			s.SetIgnore(invitationcode.FieldCode)
+		}
+		if _, exists := u.create.mutation.CreatorID(); exists {
+			s.SetIgnore(invitationcode.FieldCreatorID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvitationCode.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvitationCodeUpsertOne) Ignore() *InvitationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvitationCodeUpsertOne) DoNothing() *InvitationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvitationCodeCreate.OnConflict
+// documentation for more info.
+func (u *InvitationCodeUpsertOne) Update(set func(*InvitationCodeUpsert)) *InvitationCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvitationCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvitationCodeUpsertOne) SetUpdatedAt(v time.Time) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvitationCodeUpsertOne) UpdateUpdatedAt() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserGroupID sets the "user_group_id" field.
+func (u *InvitationCodeUpsertOne) SetUserGroupID(v uint) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetUserGroupID(v)
+	})
+}
+
+// AddUserGroupID adds v to the "user_group_id" field.
+func (u *InvitationCodeUpsertOne) AddUserGroupID(v uint) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.AddUserGroupID(v)
+	})
+}
+
+// UpdateUserGroupID sets the "user_group_id" field to the value that was provided on create.
+func (u *InvitationCodeUpsertOne) UpdateUserGroupID() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateUserGroupID()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InvitationCodeUpsertOne) SetMaxUses(v int) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InvitationCodeUpsertOne) AddMaxUses(v int) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InvitationCodeUpsertOne) UpdateMaxUses() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetUsedCount sets the "used_count" field.
+func (u *InvitationCodeUpsertOne) SetUsedCount(v int) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetUsedCount(v)
+	})
+}
+
+// AddUsedCount adds v to the "used_count" field.
+func (u *InvitationCodeUpsertOne) AddUsedCount(v int) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.AddUsedCount(v)
+	})
+}
+
+// UpdateUsedCount sets the "used_count" field to the value that was provided on create.
+func (u *InvitationCodeUpsertOne) UpdateUsedCount() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateUsedCount()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *InvitationCodeUpsertOne) SetEmail(v string) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *InvitationCodeUpsertOne) UpdateEmail() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *InvitationCodeUpsertOne) ClearEmail() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *InvitationCodeUpsertOne) SetNote(v string) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvitationCodeUpsertOne) UpdateNote() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvitationCodeUpsertOne) ClearNote() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationCodeUpsertOne) SetExpiresAt(v time.Time) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationCodeUpsertOne) UpdateExpiresAt() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InvitationCodeUpsertOne) ClearExpiresAt() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationCodeUpsertOne) SetRevokedAt(v time.Time) *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationCodeUpsertOne) UpdateRevokedAt() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationCodeUpsertOne) ClearRevokedAt() *InvitationCodeUpsertOne {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvitationCodeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvitationCodeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvitationCodeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvitationCodeUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvitationCodeUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvitationCodeCreateBulk is the builder for creating many InvitationCode entities in bulk.
+type InvitationCodeCreateBulk struct {
+	config
+	err      error
+	builders []*InvitationCodeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InvitationCode entities in the database.
+func (iccb *InvitationCodeCreateBulk) Save(ctx context.Context) ([]*InvitationCode, error) {
+	if iccb.err != nil {
+		return nil, iccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iccb.builders))
+	nodes := make([]*InvitationCode, len(iccb.builders))
+	mutators := make([]Mutator, len(iccb.builders))
+	for i := range iccb.builders {
+		func(i int, root context.Context) {
+			builder := iccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvitationCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = iccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iccb *InvitationCodeCreateBulk) SaveX(ctx context.Context) []*InvitationCode {
+	v, err := iccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iccb *InvitationCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := iccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iccb *InvitationCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := iccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvitationCode.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvitationCodeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (iccb *InvitationCodeCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvitationCodeUpsertBulk {
+	iccb.conflict = opts
+	return &InvitationCodeUpsertBulk{
+		create: iccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvitationCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iccb *InvitationCodeCreateBulk) OnConflictColumns(columns ...string) *InvitationCodeUpsertBulk {
+	iccb.conflict = append(iccb.conflict, sql.ConflictColumns(columns...))
+	return &InvitationCodeUpsertBulk{
+		create: iccb,
+	}
+}
+
+// InvitationCodeUpsertBulk is the builder for "upsert"-ing
+// a bulk of InvitationCode nodes.
+type InvitationCodeUpsertBulk struct {
+	create *InvitationCodeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InvitationCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invitationcode.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvitationCodeUpsertBulk) UpdateNewValues() *InvitationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invitationcode.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invitationcode.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Code(); exists {
+				s.SetIgnore(invitationcode.FieldCode)
+			}
+			if _, exists := b.mutation.CreatorID(); exists {
+				s.SetIgnore(invitationcode.FieldCreatorID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvitationCode.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvitationCodeUpsertBulk) Ignore() *InvitationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvitationCodeUpsertBulk) DoNothing() *InvitationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvitationCodeCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvitationCodeUpsertBulk) Update(set func(*InvitationCodeUpsert)) *InvitationCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvitationCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvitationCodeUpsertBulk) SetUpdatedAt(v time.Time) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvitationCodeUpsertBulk) UpdateUpdatedAt() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserGroupID sets the "user_group_id" field.
+func (u *InvitationCodeUpsertBulk) SetUserGroupID(v uint) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetUserGroupID(v)
+	})
+}
+
+// AddUserGroupID adds v to the "user_group_id" field.
+func (u *InvitationCodeUpsertBulk) AddUserGroupID(v uint) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.AddUserGroupID(v)
+	})
+}
+
+// UpdateUserGroupID sets the "user_group_id" field to the value that was provided on create.
+func (u *InvitationCodeUpsertBulk) UpdateUserGroupID() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateUserGroupID()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InvitationCodeUpsertBulk) SetMaxUses(v int) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InvitationCodeUpsertBulk) AddMaxUses(v int) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InvitationCodeUpsertBulk) UpdateMaxUses() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetUsedCount sets the "used_count" field.
+func (u *InvitationCodeUpsertBulk) SetUsedCount(v int) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetUsedCount(v)
+	})
+}
+
+// AddUsedCount adds v to the "used_count" field.
+func (u *InvitationCodeUpsertBulk) AddUsedCount(v int) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.AddUsedCount(v)
+	})
+}
+
+// UpdateUsedCount sets the "used_count" field to the value that was provided on create.
+func (u *InvitationCodeUpsertBulk) UpdateUsedCount() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateUsedCount()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *InvitationCodeUpsertBulk) SetEmail(v string) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *InvitationCodeUpsertBulk) UpdateEmail() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *InvitationCodeUpsertBulk) ClearEmail() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *InvitationCodeUpsertBulk) SetNote(v string) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvitationCodeUpsertBulk) UpdateNote() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvitationCodeUpsertBulk) ClearNote() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationCodeUpsertBulk) SetExpiresAt(v time.Time) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationCodeUpsertBulk) UpdateExpiresAt() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InvitationCodeUpsertBulk) ClearExpiresAt() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationCodeUpsertBulk) SetRevokedAt(v time.Time) *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationCodeUpsertBulk) UpdateRevokedAt() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationCodeUpsertBulk) ClearRevokedAt() *InvitationCodeUpsertBulk {
+	return u.Update(func(s *InvitationCodeUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvitationCodeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvitationCodeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvitationCodeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvitationCodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
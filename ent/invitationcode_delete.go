@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// InvitationCodeDelete is the builder for deleting a InvitationCode entity.
+type InvitationCodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvitationCodeMutation
+}
+
+// Where appends a list predicates to the InvitationCodeDelete builder.
+func (icd *InvitationCodeDelete) Where(ps ...predicate.InvitationCode) *InvitationCodeDelete {
+	icd.mutation.Where(ps...)
+	return icd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (icd *InvitationCodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, icd.sqlExec, icd.mutation, icd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icd *InvitationCodeDelete) ExecX(ctx context.Context) int {
+	n, err := icd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (icd *InvitationCodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(invitationcode.Table, sqlgraph.NewFieldSpec(invitationcode.FieldID, field.TypeUint))
+	if ps := icd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, icd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	icd.mutation.done = true
+	return affected, err
+}
+
+// InvitationCodeDeleteOne is the builder for deleting a single InvitationCode entity.
+type InvitationCodeDeleteOne struct {
+	icd *InvitationCodeDelete
+}
+
+// Where appends a list predicates to the InvitationCodeDelete builder.
+func (icdo *InvitationCodeDeleteOne) Where(ps ...predicate.InvitationCode) *InvitationCodeDeleteOne {
+	icdo.icd.mutation.Where(ps...)
+	return icdo
+}
+
+// Exec executes the deletion query.
+func (icdo *InvitationCodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := icdo.icd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invitationcode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icdo *InvitationCodeDeleteOne) ExecX(ctx context.Context) {
+	if err := icdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// InvitationCodeQuery is the builder for querying InvitationCode entities.
+type InvitationCodeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []invitationcode.OrderOption
+	inters     []Interceptor
+	predicates []predicate.InvitationCode
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvitationCodeQuery builder.
+func (icq *InvitationCodeQuery) Where(ps ...predicate.InvitationCode) *InvitationCodeQuery {
+	icq.predicates = append(icq.predicates, ps...)
+	return icq
+}
+
+// Limit the number of records to be returned by this query.
+func (icq *InvitationCodeQuery) Limit(limit int) *InvitationCodeQuery {
+	icq.ctx.Limit = &limit
+	return icq
+}
+
+// Offset to start from.
+func (icq *InvitationCodeQuery) Offset(offset int) *InvitationCodeQuery {
+	icq.ctx.Offset = &offset
+	return icq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (icq *InvitationCodeQuery) Unique(unique bool) *InvitationCodeQuery {
+	icq.ctx.Unique = &unique
+	return icq
+}
+
+// Order specifies how the records should be ordered.
+func (icq *InvitationCodeQuery) Order(o ...invitationcode.OrderOption) *InvitationCodeQuery {
+	icq.order = append(icq.order, o...)
+	return icq
+}
+
+// First returns the first InvitationCode entity from the query.
+// Returns a *NotFoundError when no InvitationCode was found.
+func (icq *InvitationCodeQuery) First(ctx context.Context) (*InvitationCode, error) {
+	nodes, err := icq.Limit(1).All(setContextOp(ctx, icq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{invitationcode.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (icq *InvitationCodeQuery) FirstX(ctx context.Context) *InvitationCode {
+	node, err := icq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InvitationCode ID from the query.
+// Returns a *NotFoundError when no InvitationCode ID was found.
+func (icq *InvitationCodeQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = icq.Limit(1).IDs(setContextOp(ctx, icq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invitationcode.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (icq *InvitationCodeQuery) FirstIDX(ctx context.Context) uint {
+	id, err := icq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InvitationCode entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InvitationCode entity is found.
+// Returns a *NotFoundError when no InvitationCode entities are found.
+func (icq *InvitationCodeQuery) Only(ctx context.Context) (*InvitationCode, error) {
+	nodes, err := icq.Limit(2).All(setContextOp(ctx, icq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{invitationcode.Label}
+	default:
+		return nil, &NotSingularError{invitationcode.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (icq *InvitationCodeQuery) OnlyX(ctx context.Context) *InvitationCode {
+	node, err := icq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InvitationCode ID in the query.
+// Returns a *NotSingularError when more than one InvitationCode ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (icq *InvitationCodeQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = icq.Limit(2).IDs(setContextOp(ctx, icq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invitationcode.Label}
+	default:
+		err = &NotSingularError{invitationcode.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (icq *InvitationCodeQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := icq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InvitationCodes.
+func (icq *InvitationCodeQuery) All(ctx context.Context) ([]*InvitationCode, error) {
+	ctx = setContextOp(ctx, icq.ctx, ent.OpQueryAll)
+	if err := icq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InvitationCode, *InvitationCodeQuery]()
+	return withInterceptors[[]*InvitationCode](ctx, icq, qr, icq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (icq *InvitationCodeQuery) AllX(ctx context.Context) []*InvitationCode {
+	nodes, err := icq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InvitationCode IDs.
+func (icq *InvitationCodeQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if icq.ctx.Unique == nil && icq.path != nil {
+		icq.Unique(true)
+	}
+	ctx = setContextOp(ctx, icq.ctx, ent.OpQueryIDs)
+	if err = icq.Select(invitationcode.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (icq *InvitationCodeQuery) IDsX(ctx context.Context) []uint {
+	ids, err := icq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (icq *InvitationCodeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, icq.ctx, ent.OpQueryCount)
+	if err := icq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, icq, querierCount[*InvitationCodeQuery](), icq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (icq *InvitationCodeQuery) CountX(ctx context.Context) int {
+	count, err := icq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (icq *InvitationCodeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, icq.ctx, ent.OpQueryExist)
+	switch _, err := icq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (icq *InvitationCodeQuery) ExistX(ctx context.Context) bool {
+	exist, err := icq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvitationCodeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (icq *InvitationCodeQuery) Clone() *InvitationCodeQuery {
+	if icq == nil {
+		return nil
+	}
+	return &InvitationCodeQuery{
+		config:     icq.config,
+		ctx:        icq.ctx.Clone(),
+		order:      append([]invitationcode.OrderOption{}, icq.order...),
+		inters:     append([]Interceptor{}, icq.inters...),
+		predicates: append([]predicate.InvitationCode{}, icq.predicates...),
+		// clone intermediate query.
+		sql:       icq.sql.Clone(),
+		path:      icq.path,
+		modifiers: append([]func(*sql.Selector){}, icq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InvitationCode.Query().
+//		GroupBy(invitationcode.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (icq *InvitationCodeQuery) GroupBy(field string, fields ...string) *InvitationCodeGroupBy {
+	icq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InvitationCodeGroupBy{build: icq}
+	grbuild.flds = &icq.ctx.Fields
+	grbuild.label = invitationcode.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.InvitationCode.Query().
+//		Select(invitationcode.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (icq *InvitationCodeQuery) Select(fields ...string) *InvitationCodeSelect {
+	icq.ctx.Fields = append(icq.ctx.Fields, fields...)
+	sbuild := &InvitationCodeSelect{InvitationCodeQuery: icq}
+	sbuild.label = invitationcode.Label
+	sbuild.flds, sbuild.scan = &icq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InvitationCodeSelect configured with the given aggregations.
+func (icq *InvitationCodeQuery) Aggregate(fns ...AggregateFunc) *InvitationCodeSelect {
+	return icq.Select().Aggregate(fns...)
+}
+
+func (icq *InvitationCodeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range icq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, icq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range icq.ctx.Fields {
+		if !invitationcode.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if icq.path != nil {
+		prev, err := icq.path(ctx)
+		if err != nil {
+			return err
+		}
+		icq.sql = prev
+	}
+	return nil
+}
+
+func (icq *InvitationCodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InvitationCode, error) {
+	var (
+		nodes = []*InvitationCode{}
+		_spec = icq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InvitationCode).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InvitationCode{config: icq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(icq.modifiers) > 0 {
+		_spec.Modifiers = icq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, icq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (icq *InvitationCodeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := icq.querySpec()
+	if len(icq.modifiers) > 0 {
+		_spec.Modifiers = icq.modifiers
+	}
+	_spec.Node.Columns = icq.ctx.Fields
+	if len(icq.ctx.Fields) > 0 {
+		_spec.Unique = icq.ctx.Unique != nil && *icq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, icq.driver, _spec)
+}
+
+func (icq *InvitationCodeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(invitationcode.Table, invitationcode.Columns, sqlgraph.NewFieldSpec(invitationcode.FieldID, field.TypeUint))
+	_spec.From = icq.sql
+	if unique := icq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if icq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := icq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitationcode.FieldID)
+		for i := range fields {
+			if fields[i] != invitationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := icq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := icq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := icq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := icq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (icq *InvitationCodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(icq.driver.Dialect())
+	t1 := builder.Table(invitationcode.Table)
+	columns := icq.ctx.Fields
+	if len(columns) == 0 {
+		columns = invitationcode.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if icq.sql != nil {
+		selector = icq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if icq.ctx.Unique != nil && *icq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range icq.modifiers {
+		m(selector)
+	}
+	for _, p := range icq.predicates {
+		p(selector)
+	}
+	for _, p := range icq.order {
+		p(selector)
+	}
+	if offset := icq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := icq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (icq *InvitationCodeQuery) Modify(modifiers ...func(s *sql.Selector)) *InvitationCodeSelect {
+	icq.modifiers = append(icq.modifiers, modifiers...)
+	return icq.Select()
+}
+
+// InvitationCodeGroupBy is the group-by builder for InvitationCode entities.
+type InvitationCodeGroupBy struct {
+	selector
+	build *InvitationCodeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (icgb *InvitationCodeGroupBy) Aggregate(fns ...AggregateFunc) *InvitationCodeGroupBy {
+	icgb.fns = append(icgb.fns, fns...)
+	return icgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (icgb *InvitationCodeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, icgb.build.ctx, ent.OpQueryGroupBy)
+	if err := icgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InvitationCodeQuery, *InvitationCodeGroupBy](ctx, icgb.build, icgb, icgb.build.inters, v)
+}
+
+func (icgb *InvitationCodeGroupBy) sqlScan(ctx context.Context, root *InvitationCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(icgb.fns))
+	for _, fn := range icgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*icgb.flds)+len(icgb.fns))
+		for _, f := range *icgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*icgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := icgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InvitationCodeSelect is the builder for selecting fields of InvitationCode entities.
+type InvitationCodeSelect struct {
+	*InvitationCodeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ics *InvitationCodeSelect) Aggregate(fns ...AggregateFunc) *InvitationCodeSelect {
+	ics.fns = append(ics.fns, fns...)
+	return ics
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ics *InvitationCodeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ics.ctx, ent.OpQuerySelect)
+	if err := ics.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InvitationCodeQuery, *InvitationCodeSelect](ctx, ics.InvitationCodeQuery, ics, ics.inters, v)
+}
+
+func (ics *InvitationCodeSelect) sqlScan(ctx context.Context, root *InvitationCodeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ics.fns))
+	for _, fn := range ics.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ics.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ics.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ics *InvitationCodeSelect) Modify(modifiers ...func(s *sql.Selector)) *InvitationCodeSelect {
+	ics.modifiers = append(ics.modifiers, modifiers...)
+	return ics
+}
@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// InvitationCodeUpdate is the builder for updating InvitationCode entities.
+type InvitationCodeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *InvitationCodeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the InvitationCodeUpdate builder.
+func (icu *InvitationCodeUpdate) Where(ps ...predicate.InvitationCode) *InvitationCodeUpdate {
+	icu.mutation.Where(ps...)
+	return icu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icu *InvitationCodeUpdate) SetUpdatedAt(t time.Time) *InvitationCodeUpdate {
+	icu.mutation.SetUpdatedAt(t)
+	return icu
+}
+
+// SetUserGroupID sets the "user_group_id" field.
+func (icu *InvitationCodeUpdate) SetUserGroupID(u uint) *InvitationCodeUpdate {
+	icu.mutation.ResetUserGroupID()
+	icu.mutation.SetUserGroupID(u)
+	return icu
+}
+
+// SetNillableUserGroupID sets the "user_group_id" field if the given value is not nil.
+func (icu *InvitationCodeUpdate) SetNillableUserGroupID(u *uint) *InvitationCodeUpdate {
+	if u != nil {
+		icu.SetUserGroupID(*u)
+	}
+	return icu
+}
+
+// AddUserGroupID adds u to the "user_group_id" field.
+func (icu *InvitationCodeUpdate) AddUserGroupID(u int) *InvitationCodeUpdate {
+	icu.mutation.AddUserGroupID(u)
+	return icu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (icu *InvitationCodeUpdate) SetMaxUses(i int) *InvitationCodeUpdate {
+	icu.mutation.ResetMaxUses()
+	icu.mutation.SetMaxUses(i)
+	return icu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (icu *InvitationCodeUpdate) SetNillableMaxUses(i *int) *InvitationCodeUpdate {
+	if i != nil {
+		icu.SetMaxUses(*i)
+	}
+	return icu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (icu *InvitationCodeUpdate) AddMaxUses(i int) *InvitationCodeUpdate {
+	icu.mutation.AddMaxUses(i)
+	return icu
+}
+
+// SetUsedCount sets the "used_count" field.
+func (icu *InvitationCodeUpdate) SetUsedCount(i int) *InvitationCodeUpdate {
+	icu.mutation.ResetUsedCount()
+	icu.mutation.SetUsedCount(i)
+	return icu
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (icu *InvitationCodeUpdate) SetNillableUsedCount(i *int) *InvitationCodeUpdate {
+	if i != nil {
+		icu.SetUsedCount(*i)
+	}
+	return icu
+}
+
+// AddUsedCount adds i to the "used_count" field.
+func (icu *InvitationCodeUpdate) AddUsedCount(i int) *InvitationCodeUpdate {
+	icu.mutation.AddUsedCount(i)
+	return icu
+}
+
+// SetEmail sets the "email" field.
+func (icu *InvitationCodeUpdate) SetEmail(s string) *InvitationCodeUpdate {
+	icu.mutation.SetEmail(s)
+	return icu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (icu *InvitationCodeUpdate) SetNillableEmail(s *string) *InvitationCodeUpdate {
+	if s != nil {
+		icu.SetEmail(*s)
+	}
+	return icu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (icu *InvitationCodeUpdate) ClearEmail() *InvitationCodeUpdate {
+	icu.mutation.ClearEmail()
+	return icu
+}
+
+// SetNote sets the "note" field.
+func (icu *InvitationCodeUpdate) SetNote(s string) *InvitationCodeUpdate {
+	icu.mutation.SetNote(s)
+	return icu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (icu *InvitationCodeUpdate) SetNillableNote(s *string) *InvitationCodeUpdate {
+	if s != nil {
+		icu.SetNote(*s)
+	}
+	return icu
+}
+
+// ClearNote clears the value of the "note" field.
+func (icu *InvitationCodeUpdate) ClearNote() *InvitationCodeUpdate {
+	icu.mutation.ClearNote()
+	return icu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (icu *InvitationCodeUpdate) SetExpiresAt(t time.Time) *InvitationCodeUpdate {
+	icu.mutation.SetExpiresAt(t)
+	return icu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (icu *InvitationCodeUpdate) SetNillableExpiresAt(t *time.Time) *InvitationCodeUpdate {
+	if t != nil {
+		icu.SetExpiresAt(*t)
+	}
+	return icu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (icu *InvitationCodeUpdate) ClearExpiresAt() *InvitationCodeUpdate {
+	icu.mutation.ClearExpiresAt()
+	return icu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (icu *InvitationCodeUpdate) SetRevokedAt(t time.Time) *InvitationCodeUpdate {
+	icu.mutation.SetRevokedAt(t)
+	return icu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (icu *InvitationCodeUpdate) SetNillableRevokedAt(t *time.Time) *InvitationCodeUpdate {
+	if t != nil {
+		icu.SetRevokedAt(*t)
+	}
+	return icu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (icu *InvitationCodeUpdate) ClearRevokedAt() *InvitationCodeUpdate {
+	icu.mutation.ClearRevokedAt()
+	return icu
+}
+
+// Mutation returns the InvitationCodeMutation object of the builder.
+func (icu *InvitationCodeUpdate) Mutation() *InvitationCodeMutation {
+	return icu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (icu *InvitationCodeUpdate) Save(ctx context.Context) (int, error) {
+	icu.defaults()
+	return withHooks(ctx, icu.sqlSave, icu.mutation, icu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icu *InvitationCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := icu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (icu *InvitationCodeUpdate) Exec(ctx context.Context) error {
+	_, err := icu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icu *InvitationCodeUpdate) ExecX(ctx context.Context) {
+	if err := icu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icu *InvitationCodeUpdate) defaults() {
+	if _, ok := icu.mutation.UpdatedAt(); !ok {
+		v := invitationcode.UpdateDefaultUpdatedAt()
+		icu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icu *InvitationCodeUpdate) check() error {
+	if v, ok := icu.mutation.MaxUses(); ok {
+		if err := invitationcode.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := icu.mutation.UsedCount(); ok {
+		if err := invitationcode.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.used_count": %w`, err)}
+		}
+	}
+	if v, ok := icu.mutation.Email(); ok {
+		if err := invitationcode.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.email": %w`, err)}
+		}
+	}
+	if v, ok := icu.mutation.Note(); ok {
+		if err := invitationcode.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.note": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (icu *InvitationCodeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvitationCodeUpdate {
+	icu.modifiers = append(icu.modifiers, modifiers...)
+	return icu
+}
+
+func (icu *InvitationCodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := icu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitationcode.Table, invitationcode.Columns, sqlgraph.NewFieldSpec(invitationcode.FieldID, field.TypeUint))
+	if ps := icu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icu.mutation.UpdatedAt(); ok {
+		_spec.SetField(invitationcode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := icu.mutation.UserGroupID(); ok {
+		_spec.SetField(invitationcode.FieldUserGroupID, field.TypeUint, value)
+	}
+	if value, ok := icu.mutation.AddedUserGroupID(); ok {
+		_spec.AddField(invitationcode.FieldUserGroupID, field.TypeUint, value)
+	}
+	if value, ok := icu.mutation.MaxUses(); ok {
+		_spec.SetField(invitationcode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitationcode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.UsedCount(); ok {
+		_spec.SetField(invitationcode.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.AddedUsedCount(); ok {
+		_spec.AddField(invitationcode.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := icu.mutation.Email(); ok {
+		_spec.SetField(invitationcode.FieldEmail, field.TypeString, value)
+	}
+	if icu.mutation.EmailCleared() {
+		_spec.ClearField(invitationcode.FieldEmail, field.TypeString)
+	}
+	if value, ok := icu.mutation.Note(); ok {
+		_spec.SetField(invitationcode.FieldNote, field.TypeString, value)
+	}
+	if icu.mutation.NoteCleared() {
+		_spec.ClearField(invitationcode.FieldNote, field.TypeString)
+	}
+	if value, ok := icu.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if icu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invitationcode.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := icu.mutation.RevokedAt(); ok {
+		_spec.SetField(invitationcode.FieldRevokedAt, field.TypeTime, value)
+	}
+	if icu.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitationcode.FieldRevokedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(icu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, icu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	icu.mutation.done = true
+	return n, nil
+}
+
+// InvitationCodeUpdateOne is the builder for updating a single InvitationCode entity.
+type InvitationCodeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *InvitationCodeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (icuo *InvitationCodeUpdateOne) SetUpdatedAt(t time.Time) *InvitationCodeUpdateOne {
+	icuo.mutation.SetUpdatedAt(t)
+	return icuo
+}
+
+// SetUserGroupID sets the "user_group_id" field.
+func (icuo *InvitationCodeUpdateOne) SetUserGroupID(u uint) *InvitationCodeUpdateOne {
+	icuo.mutation.ResetUserGroupID()
+	icuo.mutation.SetUserGroupID(u)
+	return icuo
+}
+
+// SetNillableUserGroupID sets the "user_group_id" field if the given value is not nil.
+func (icuo *InvitationCodeUpdateOne) SetNillableUserGroupID(u *uint) *InvitationCodeUpdateOne {
+	if u != nil {
+		icuo.SetUserGroupID(*u)
+	}
+	return icuo
+}
+
+// AddUserGroupID adds u to the "user_group_id" field.
+func (icuo *InvitationCodeUpdateOne) AddUserGroupID(u int) *InvitationCodeUpdateOne {
+	icuo.mutation.AddUserGroupID(u)
+	return icuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (icuo *InvitationCodeUpdateOne) SetMaxUses(i int) *InvitationCodeUpdateOne {
+	icuo.mutation.ResetMaxUses()
+	icuo.mutation.SetMaxUses(i)
+	return icuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (icuo *InvitationCodeUpdateOne) SetNillableMaxUses(i *int) *InvitationCodeUpdateOne {
+	if i != nil {
+		icuo.SetMaxUses(*i)
+	}
+	return icuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (icuo *InvitationCodeUpdateOne) AddMaxUses(i int) *InvitationCodeUpdateOne {
+	icuo.mutation.AddMaxUses(i)
+	return icuo
+}
+
+// SetUsedCount sets the "used_count" field.
+func (icuo *InvitationCodeUpdateOne) SetUsedCount(i int) *InvitationCodeUpdateOne {
+	icuo.mutation.ResetUsedCount()
+	icuo.mutation.SetUsedCount(i)
+	return icuo
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (icuo *InvitationCodeUpdateOne) SetNillableUsedCount(i *int) *InvitationCodeUpdateOne {
+	if i != nil {
+		icuo.SetUsedCount(*i)
+	}
+	return icuo
+}
+
+// AddUsedCount adds i to the "used_count" field.
+func (icuo *InvitationCodeUpdateOne) AddUsedCount(i int) *InvitationCodeUpdateOne {
+	icuo.mutation.AddUsedCount(i)
+	return icuo
+}
+
+// SetEmail sets the "email" field.
+func (icuo *InvitationCodeUpdateOne) SetEmail(s string) *InvitationCodeUpdateOne {
+	icuo.mutation.SetEmail(s)
+	return icuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (icuo *InvitationCodeUpdateOne) SetNillableEmail(s *string) *InvitationCodeUpdateOne {
+	if s != nil {
+		icuo.SetEmail(*s)
+	}
+	return icuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (icuo *InvitationCodeUpdateOne) ClearEmail() *InvitationCodeUpdateOne {
+	icuo.mutation.ClearEmail()
+	return icuo
+}
+
+// SetNote sets the "note" field.
+func (icuo *InvitationCodeUpdateOne) SetNote(s string) *InvitationCodeUpdateOne {
+	icuo.mutation.SetNote(s)
+	return icuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (icuo *InvitationCodeUpdateOne) SetNillableNote(s *string) *InvitationCodeUpdateOne {
+	if s != nil {
+		icuo.SetNote(*s)
+	}
+	return icuo
+}
+
+// ClearNote clears the value of the "note" field.
+func (icuo *InvitationCodeUpdateOne) ClearNote() *InvitationCodeUpdateOne {
+	icuo.mutation.ClearNote()
+	return icuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (icuo *InvitationCodeUpdateOne) SetExpiresAt(t time.Time) *InvitationCodeUpdateOne {
+	icuo.mutation.SetExpiresAt(t)
+	return icuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (icuo *InvitationCodeUpdateOne) SetNillableExpiresAt(t *time.Time) *InvitationCodeUpdateOne {
+	if t != nil {
+		icuo.SetExpiresAt(*t)
+	}
+	return icuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (icuo *InvitationCodeUpdateOne) ClearExpiresAt() *InvitationCodeUpdateOne {
+	icuo.mutation.ClearExpiresAt()
+	return icuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (icuo *InvitationCodeUpdateOne) SetRevokedAt(t time.Time) *InvitationCodeUpdateOne {
+	icuo.mutation.SetRevokedAt(t)
+	return icuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (icuo *InvitationCodeUpdateOne) SetNillableRevokedAt(t *time.Time) *InvitationCodeUpdateOne {
+	if t != nil {
+		icuo.SetRevokedAt(*t)
+	}
+	return icuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (icuo *InvitationCodeUpdateOne) ClearRevokedAt() *InvitationCodeUpdateOne {
+	icuo.mutation.ClearRevokedAt()
+	return icuo
+}
+
+// Mutation returns the InvitationCodeMutation object of the builder.
+func (icuo *InvitationCodeUpdateOne) Mutation() *InvitationCodeMutation {
+	return icuo.mutation
+}
+
+// Where appends a list predicates to the InvitationCodeUpdate builder.
+func (icuo *InvitationCodeUpdateOne) Where(ps ...predicate.InvitationCode) *InvitationCodeUpdateOne {
+	icuo.mutation.Where(ps...)
+	return icuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (icuo *InvitationCodeUpdateOne) Select(field string, fields ...string) *InvitationCodeUpdateOne {
+	icuo.fields = append([]string{field}, fields...)
+	return icuo
+}
+
+// Save executes the query and returns the updated InvitationCode entity.
+func (icuo *InvitationCodeUpdateOne) Save(ctx context.Context) (*InvitationCode, error) {
+	icuo.defaults()
+	return withHooks(ctx, icuo.sqlSave, icuo.mutation, icuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icuo *InvitationCodeUpdateOne) SaveX(ctx context.Context) *InvitationCode {
+	node, err := icuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (icuo *InvitationCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := icuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icuo *InvitationCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := icuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icuo *InvitationCodeUpdateOne) defaults() {
+	if _, ok := icuo.mutation.UpdatedAt(); !ok {
+		v := invitationcode.UpdateDefaultUpdatedAt()
+		icuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icuo *InvitationCodeUpdateOne) check() error {
+	if v, ok := icuo.mutation.MaxUses(); ok {
+		if err := invitationcode.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := icuo.mutation.UsedCount(); ok {
+		if err := invitationcode.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.used_count": %w`, err)}
+		}
+	}
+	if v, ok := icuo.mutation.Email(); ok {
+		if err := invitationcode.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.email": %w`, err)}
+		}
+	}
+	if v, ok := icuo.mutation.Note(); ok {
+		if err := invitationcode.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "InvitationCode.note": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (icuo *InvitationCodeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvitationCodeUpdateOne {
+	icuo.modifiers = append(icuo.modifiers, modifiers...)
+	return icuo
+}
+
+func (icuo *InvitationCodeUpdateOne) sqlSave(ctx context.Context) (_node *InvitationCode, err error) {
+	if err := icuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitationcode.Table, invitationcode.Columns, sqlgraph.NewFieldSpec(invitationcode.FieldID, field.TypeUint))
+	id, ok := icuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvitationCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := icuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitationcode.FieldID)
+		for _, f := range fields {
+			if !invitationcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invitationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := icuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := icuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(invitationcode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := icuo.mutation.UserGroupID(); ok {
+		_spec.SetField(invitationcode.FieldUserGroupID, field.TypeUint, value)
+	}
+	if value, ok := icuo.mutation.AddedUserGroupID(); ok {
+		_spec.AddField(invitationcode.FieldUserGroupID, field.TypeUint, value)
+	}
+	if value, ok := icuo.mutation.MaxUses(); ok {
+		_spec.SetField(invitationcode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitationcode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.UsedCount(); ok {
+		_spec.SetField(invitationcode.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.AddedUsedCount(); ok {
+		_spec.AddField(invitationcode.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := icuo.mutation.Email(); ok {
+		_spec.SetField(invitationcode.FieldEmail, field.TypeString, value)
+	}
+	if icuo.mutation.EmailCleared() {
+		_spec.ClearField(invitationcode.FieldEmail, field.TypeString)
+	}
+	if value, ok := icuo.mutation.Note(); ok {
+		_spec.SetField(invitationcode.FieldNote, field.TypeString, value)
+	}
+	if icuo.mutation.NoteCleared() {
+		_spec.ClearField(invitationcode.FieldNote, field.TypeString)
+	}
+	if value, ok := icuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if icuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invitationcode.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := icuo.mutation.RevokedAt(); ok {
+		_spec.SetField(invitationcode.FieldRevokedAt, field.TypeTime, value)
+	}
+	if icuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitationcode.FieldRevokedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(icuo.modifiers...)
+	_node = &InvitationCode{config: icuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, icuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	icuo.mutation.done = true
+	return _node, nil
+}
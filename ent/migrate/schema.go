@@ -8,6 +8,66 @@ import (
 )
 
 var (
+	// AccessTokensColumns holds the columns for the "access_tokens" table.
+	AccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "所属用户ID"},
+		{Name: "name", Type: field.TypeString, Size: 64, Comment: "令牌名称，用于区分用途"},
+		{Name: "token_hash", Type: field.TypeString, Size: 64, Comment: "令牌的 SHA-256 哈希，明文只在创建时返回一次"},
+		{Name: "token_prefix", Type: field.TypeString, Size: 16, Comment: "令牌明文的前几位，用于在列表中辨认"},
+		{Name: "scopes", Type: field.TypeJSON, Comment: "授权范围"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空表示永不过期"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次使用时间"},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true, Size: 64, Comment: "最近一次使用的IP"},
+	}
+	// AccessTokensTable holds the schema information for the "access_tokens" table.
+	AccessTokensTable = &schema.Table{
+		Name:       "access_tokens",
+		Comment:    "个人访问令牌表",
+		Columns:    AccessTokensColumns,
+		PrimaryKey: []*schema.Column{AccessTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesstoken_token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{AccessTokensColumns[5]},
+			},
+			{
+				Name:    "accesstoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccessTokensColumns[3]},
+			},
+		},
+	}
+	// AccountDeletionsColumns holds the columns for the "account_deletions" table.
+	AccountDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "申请时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "申请注销的用户ID"},
+		{Name: "content_mode", Type: field.TypeEnum, Comment: "已发布内容的处理方式：anonymize-匿名化保留, delete-一并删除", Enums: []string{"anonymize", "delete"}, Default: "anonymize"},
+		{Name: "scheduled_at", Type: field.TypeTime, Comment: "计划执行注销的时间"},
+	}
+	// AccountDeletionsTable holds the schema information for the "account_deletions" table.
+	AccountDeletionsTable = &schema.Table{
+		Name:       "account_deletions",
+		Comment:    "账户注销申请表",
+		Columns:    AccountDeletionsColumns,
+		PrimaryKey: []*schema.Column{AccountDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accountdeletion_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccountDeletionsColumns[2]},
+			},
+			{
+				Name:    "accountdeletion_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{AccountDeletionsColumns[4]},
+			},
+		},
+	}
 	// AlbumsColumns holds the columns for the "albums" table.
 	AlbumsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -178,6 +238,78 @@ var (
 			},
 		},
 	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "操作时间"},
+		{Name: "actor_id", Type: field.TypeUint, Comment: "操作者用户ID，0 表示系统或未登录", Default: 0},
+		{Name: "actor_name", Type: field.TypeString, Nullable: true, Size: 100, Comment: "操作者名称快照，用户被删除后仍可追溯"},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64, Comment: "操作者IP"},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512, Comment: "操作者 User-Agent"},
+		{Name: "action", Type: field.TypeString, Size: 64, Comment: "操作类型，例如 setting.update、user.delete"},
+		{Name: "target_type", Type: field.TypeString, Nullable: true, Size: 32, Comment: "操作对象类型，例如 setting、user、article"},
+		{Name: "target_id", Type: field.TypeString, Nullable: true, Size: 255, Comment: "操作对象ID，可能是公共ID、键名或以逗号分隔的多个ID"},
+		{Name: "summary", Type: field.TypeString, Nullable: true, Size: 512, Comment: "操作摘要"},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true, Comment: "变更内容，格式为 {字段: {before, after}}，敏感字段已脱敏"},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Comment:    "审计日志表",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2]},
+			},
+			{
+				Name:    "auditlog_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[6]},
+			},
+			{
+				Name:    "auditlog_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[7], AuditLogsColumns[8]},
+			},
+		},
+	}
+	// BlockRulesColumns holds the columns for the "block_rules" table.
+	BlockRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "type", Type: field.TypeEnum, Comment: "规则类型: ip(IP或CIDR网段), email(邮箱), email_md5(邮箱MD5), user_agent(UA正则), user(用户公共ID)", Enums: []string{"ip", "email", "email_md5", "user_agent", "user"}},
+		{Name: "value", Type: field.TypeString, Size: 512, Comment: "规则内容"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 255, Comment: "封禁原因"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为NULL表示永久有效"},
+	}
+	// BlockRulesTable holds the schema information for the "block_rules" table.
+	BlockRulesTable = &schema.Table{
+		Name:       "block_rules",
+		Comment:    "黑名单规则表",
+		Columns:    BlockRulesColumns,
+		PrimaryKey: []*schema.Column{BlockRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blockrule_type_value",
+				Unique:  false,
+				Columns: []*schema.Column{BlockRulesColumns[3], BlockRulesColumns[4]},
+			},
+			{
+				Name:    "blockrule_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{BlockRulesColumns[6]},
+			},
+		},
+	}
 	// CommentsColumns holds the columns for the "comments" table.
 	CommentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -201,6 +333,8 @@ var (
 		{Name: "ip_location", Type: field.TypeString, Nullable: true, Size: 255, Comment: "IP地址归属地"},
 		{Name: "like_count", Type: field.TypeInt, Comment: "点赞数", Default: 0},
 		{Name: "pinned_at", Type: field.TypeTime, Nullable: true, Comment: "评论置顶时间，为NULL表示未置顶"},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true, Comment: "作者最后一次自助编辑的时间，为NULL表示未编辑过"},
+		{Name: "edit_history", Type: field.TypeJSON, Nullable: true, Comment: "作者自助编辑的历史记录 (仅管理员可见)"},
 		{Name: "article_comments", Type: field.TypeUint, Nullable: true},
 		{Name: "parent_id", Type: field.TypeUint, Nullable: true, Comment: "父评论ID (用于嵌套回复)"},
 		{Name: "user_id", Type: field.TypeUint, Nullable: true, Comment: "关联的用户ID (如果是登录用户)"},
@@ -214,19 +348,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "comments_articles_comments",
-				Columns:    []*schema.Column{CommentsColumns[21]},
+				Columns:    []*schema.Column{CommentsColumns[23]},
 				RefColumns: []*schema.Column{ArticlesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "comments_comments_parent",
-				Columns:    []*schema.Column{CommentsColumns[22]},
+				Columns:    []*schema.Column{CommentsColumns[24]},
 				RefColumns: []*schema.Column{CommentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "comments_users_comments",
-				Columns:    []*schema.Column{CommentsColumns[23]},
+				Columns:    []*schema.Column{CommentsColumns[25]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -240,12 +374,12 @@ var (
 			{
 				Name:    "comment_parent_id",
 				Unique:  false,
-				Columns: []*schema.Column{CommentsColumns[22]},
+				Columns: []*schema.Column{CommentsColumns[24]},
 			},
 			{
 				Name:    "comment_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{CommentsColumns[23]},
+				Columns: []*schema.Column{CommentsColumns[25]},
 			},
 			{
 				Name:    "comment_email",
@@ -254,6 +388,28 @@ var (
 			},
 		},
 	}
+	// CommentThreadsColumns holds the columns for the "comment_threads" table.
+	CommentThreadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "target_path", Type: field.TypeString, Size: 255, Comment: "评论区对应的目标路径，与评论的 target_path 一致"},
+		{Name: "state", Type: field.TypeEnum, Comment: "评论区状态: open(开放), closed(关闭并隐藏已有评论), readonly(只读，保留已有评论但不再接受新评论)", Enums: []string{"open", "closed", "readonly"}, Default: "open"},
+	}
+	// CommentThreadsTable holds the schema information for the "comment_threads" table.
+	CommentThreadsTable = &schema.Table{
+		Name:       "comment_threads",
+		Comment:    "评论区状态表",
+		Columns:    CommentThreadsColumns,
+		PrimaryKey: []*schema.Column{CommentThreadsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commentthread_target_path",
+				Unique:  true,
+				Columns: []*schema.Column{CommentThreadsColumns[3]},
+			},
+		},
+	}
 	// DirectLinksColumns holds the columns for the "direct_links" table.
 	DirectLinksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -314,6 +470,8 @@ var (
 		{Name: "mime_type", Type: field.TypeString, Nullable: true, Size: 100, Comment: "文件的MIME类型"},
 		{Name: "dimension", Type: field.TypeString, Nullable: true, Size: 50, Comment: "媒体文件尺寸 (如 '1920x1080'), 非媒体文件为空"},
 		{Name: "storage_metadata", Type: field.TypeOther, Nullable: true, Comment: "存储提供者特有的额外元数据", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb", "sqlite3": "text"}},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true, Size: 64, Comment: "文件内容的 SHA-256 哈希，用于同一存储策略内的内容去重"},
+		{Name: "reference_count", Type: field.TypeInt, Comment: "引用此实体的文件数量，最后一个引用释放时才删除物理文件", Default: 1},
 	}
 	// EntitiesTable holds the schema information for the "entities" table.
 	EntitiesTable = &schema.Table{
@@ -321,6 +479,13 @@ var (
 		Comment:    "存储实体表，存储物理文件信息",
 		Columns:    EntitiesColumns,
 		PrimaryKey: []*schema.Column{EntitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entity_policy_id_content_hash",
+				Unique:  false,
+				Columns: []*schema.Column{EntitiesColumns[8], EntitiesColumns[14]},
+			},
+		},
 	}
 	// EssaysColumns holds the columns for the "essays" table.
 	EssaysColumns = []*schema.Column{
@@ -474,6 +639,40 @@ var (
 		Columns:    GiveMoneysColumns,
 		PrimaryKey: []*schema.Column{GiveMoneysColumns[0]},
 	}
+	// InvitationCodesColumns holds the columns for the "invitation_codes" table.
+	InvitationCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "code", Type: field.TypeString, Size: 32, Comment: "邀请码"},
+		{Name: "creator_id", Type: field.TypeUint, Comment: "创建者用户ID"},
+		{Name: "user_group_id", Type: field.TypeUint, Comment: "使用该邀请码注册的用户所属的用户组ID"},
+		{Name: "max_uses", Type: field.TypeInt, Comment: "最大使用次数，0 表示不限制", Default: 1},
+		{Name: "used_count", Type: field.TypeInt, Comment: "已使用次数", Default: 0},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255, Comment: "限定使用的邮箱，为空表示任何邮箱都可以使用"},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 255, Comment: "备注"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空表示永不过期"},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true, Comment: "撤销时间，不为空表示已撤销"},
+	}
+	// InvitationCodesTable holds the schema information for the "invitation_codes" table.
+	InvitationCodesTable = &schema.Table{
+		Name:       "invitation_codes",
+		Comment:    "注册邀请码表",
+		Columns:    InvitationCodesColumns,
+		PrimaryKey: []*schema.Column{InvitationCodesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invitationcode_code",
+				Unique:  true,
+				Columns: []*schema.Column{InvitationCodesColumns[3]},
+			},
+			{
+				Name:    "invitationcode_creator_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvitationCodesColumns[4]},
+			},
+		},
+	}
 	// LinksColumns holds the columns for the "links" table.
 	LinksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -619,6 +818,40 @@ var (
 		Columns:    PagesColumns,
 		PrimaryKey: []*schema.Column{PagesColumns[0]},
 	}
+	// PasskeysColumns holds the columns for the "passkeys" table.
+	PasskeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "所属用户ID"},
+		{Name: "name", Type: field.TypeString, Size: 64, Comment: "用户为通行密钥设置的名称"},
+		{Name: "credential_id", Type: field.TypeString, Size: 1400, Comment: "凭证ID (base64url)"},
+		{Name: "credential_hash", Type: field.TypeString, Size: 64, Comment: "凭证ID的 SHA-256 哈希，凭证ID可能很长，用于建立唯一索引"},
+		{Name: "public_key", Type: field.TypeBytes, Comment: "COSE 编码的凭证公钥"},
+		{Name: "sign_count", Type: field.TypeUint32, Comment: "认证器签名计数，用于发现被克隆的认证器", Default: 0},
+		{Name: "aaguid", Type: field.TypeString, Nullable: true, Size: 36, Comment: "认证器型号标识"},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true, Comment: "认证器支持的传输方式"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次用于登录的时间"},
+	}
+	// PasskeysTable holds the schema information for the "passkeys" table.
+	PasskeysTable = &schema.Table{
+		Name:       "passkeys",
+		Comment:    "通行密钥表",
+		Columns:    PasskeysColumns,
+		PrimaryKey: []*schema.Column{PasskeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passkey_credential_hash",
+				Unique:  true,
+				Columns: []*schema.Column{PasskeysColumns[6]},
+			},
+			{
+				Name:    "passkey_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PasskeysColumns[3]},
+			},
+		},
+	}
 	// PostCategoriesColumns holds the columns for the "post_categories" table.
 	PostCategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -671,6 +904,39 @@ var (
 		Columns:    SettingsColumns,
 		PrimaryKey: []*schema.Column{SettingsColumns[0]},
 	}
+	// SharesColumns holds the columns for the "shares" table.
+	SharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "owner_id", Type: field.TypeUint, Comment: "分享者用户ID"},
+		{Name: "file_id", Type: field.TypeUint, Comment: "被分享的文件或文件夹ID"},
+		{Name: "password", Type: field.TypeString, Nullable: true, Size: 32, Comment: "访问密码，为空表示公开访问"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, Comment: "过期时间，为空表示永不过期"},
+		{Name: "download_limit", Type: field.TypeInt, Comment: "最大下载次数，0 表示不限制", Default: 0},
+		{Name: "downloads", Type: field.TypeInt, Comment: "已下载次数", Default: 0},
+		{Name: "views", Type: field.TypeInt, Comment: "浏览次数", Default: 0},
+		{Name: "preview_only", Type: field.TypeBool, Comment: "是否仅允许预览，不允许下载", Default: false},
+	}
+	// SharesTable holds the schema information for the "shares" table.
+	SharesTable = &schema.Table{
+		Name:       "shares",
+		Comment:    "文件分享表",
+		Columns:    SharesColumns,
+		PrimaryKey: []*schema.Column{SharesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "share_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{SharesColumns[3]},
+			},
+			{
+				Name:    "share_file_id",
+				Unique:  false,
+				Columns: []*schema.Column{SharesColumns[4]},
+			},
+		},
+	}
 	// StoragePoliciesColumns holds the columns for the "storage_policies" table.
 	StoragePoliciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -796,6 +1062,7 @@ var (
 		{Name: "website", Type: field.TypeString, Nullable: true, Size: 255, Comment: "用户个人网站"},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeInt, Comment: "用户状态 1:正常 2:未激活 3:已封禁", Default: 2},
+		{Name: "storage_used", Type: field.TypeInt64, Comment: "已使用的存储容量（字节），在文件创建和删除时增量维护", Default: 0},
 		{Name: "user_group_id", Type: field.TypeUint},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -807,7 +1074,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_user_groups_users",
-				Columns:    []*schema.Column{UsersColumns[12]},
+				Columns:    []*schema.Column{UsersColumns[13]},
 				RefColumns: []*schema.Column{UserGroupsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -823,7 +1090,7 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255, Comment: "用户组描述/角色描述"},
 		{Name: "permissions", Type: field.TypeOther, Comment: "权限集, Base64编码的字节", SchemaType: map[string]string{"mysql": "text", "postgres": "text", "sqlite3": "text"}},
 		{Name: "max_storage", Type: field.TypeInt64, Comment: "用户组的最大存储容量（字节）, 0为不限制", Default: 0},
-		{Name: "speed_limit", Type: field.TypeInt64, Comment: "用户组的最大上传速度（字节/秒）, 0为不限制", Default: 0},
+		{Name: "speed_limit", Type: field.TypeInt64, Comment: "用户组的下载限速（字节/秒）, 0为不限制", Default: 0},
 		{Name: "settings", Type: field.TypeOther, Comment: "用户组的特定JSON配置", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb", "sqlite3": "text"}},
 		{Name: "storage_policy_ids", Type: field.TypeJSON, Nullable: true, Comment: "该用户组可使用的存储策略ID列表"},
 	}
@@ -834,6 +1101,38 @@ var (
 		Columns:    UserGroupsColumns,
 		PrimaryKey: []*schema.Column{UserGroupsColumns[0]},
 	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "所属用户ID"},
+		{Name: "provider", Type: field.TypeString, Size: 50, Comment: "登录提供方标识，对应设置中配置的 provider id"},
+		{Name: "subject", Type: field.TypeString, Size: 255, Comment: "提供方返回的用户唯一标识"},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255, Comment: "提供方返回的邮箱"},
+		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 100, Comment: "提供方返回的昵称"},
+		{Name: "avatar_url", Type: field.TypeString, Nullable: true, Size: 512, Comment: "提供方返回的头像"},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次通过该账号登录的时间"},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Comment:    "第三方登录账号表",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[4], UserIdentitiesColumns[5]},
+			},
+			{
+				Name:    "useridentity_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserIdentitiesColumns[3]},
+			},
+		},
+	}
 	// UserInstalledThemesColumns holds the columns for the "user_installed_themes" table.
 	UserInstalledThemesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -930,6 +1229,64 @@ var (
 			},
 		},
 	}
+	// UserSessionsColumns holds the columns for the "user_sessions" table.
+	UserSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间，即登录时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "所属用户ID"},
+		{Name: "session_id", Type: field.TypeString, Size: 64, Comment: "会话标识，写入刷新令牌和访问令牌中"},
+		{Name: "device", Type: field.TypeString, Nullable: true, Size: 64, Comment: "根据 User-Agent 识别的设备描述"},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64, Comment: "最近一次使用的IP"},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512, Comment: "最近一次使用的 User-Agent"},
+		{Name: "last_seen_at", Type: field.TypeTime, Comment: "最近一次刷新令牌的时间"},
+		{Name: "expires_at", Type: field.TypeTime, Comment: "会话过期时间，与刷新令牌一致"},
+	}
+	// UserSessionsTable holds the schema information for the "user_sessions" table.
+	UserSessionsTable = &schema.Table{
+		Name:       "user_sessions",
+		Comment:    "登录会话表",
+		Columns:    UserSessionsColumns,
+		PrimaryKey: []*schema.Column{UserSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usersession_session_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserSessionsColumns[4]},
+			},
+			{
+				Name:    "usersession_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserSessionsColumns[3]},
+			},
+		},
+	}
+	// UserTwoFactorsColumns holds the columns for the "user_two_factors" table.
+	UserTwoFactorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "user_id", Type: field.TypeUint, Comment: "所属用户ID"},
+		{Name: "secret", Type: field.TypeString, Size: 64, Comment: "TOTP 密钥 (Base32)"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "是否已完成绑定并启用，未启用的记录表示绑定尚未确认", Default: false},
+		{Name: "recovery_codes", Type: field.TypeJSON, Nullable: true, Comment: "未使用的恢复码 (SHA-256 哈希)"},
+		{Name: "last_used_step", Type: field.TypeInt64, Comment: "最近一次成功使用的 TOTP 时间步，用于防止验证码重放", Default: 0},
+		{Name: "enabled_at", Type: field.TypeTime, Nullable: true, Comment: "启用时间"},
+	}
+	// UserTwoFactorsTable holds the schema information for the "user_two_factors" table.
+	UserTwoFactorsTable = &schema.Table{
+		Name:       "user_two_factors",
+		Comment:    "用户两步验证表",
+		Columns:    UserTwoFactorsColumns,
+		PrimaryKey: []*schema.Column{UserTwoFactorsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usertwofactor_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserTwoFactorsColumns[3]},
+			},
+		},
+	}
 	// VisitorLogsColumns holds the columns for the "visitor_logs" table.
 	VisitorLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
@@ -1013,6 +1370,42 @@ var (
 			},
 		},
 	}
+	// WebmentionsColumns holds the columns for the "webmentions" table.
+	WebmentionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Comment: "更新时间"},
+		{Name: "source", Type: field.TypeString, Size: 1024, Comment: "提及我们的来源页面URL"},
+		{Name: "target", Type: field.TypeString, Size: 1024, Comment: "被提及的本站文章URL"},
+		{Name: "target_path", Type: field.TypeString, Size: 255, Comment: "被提及文章的路径 (例如 /posts/some-slug)，与评论的 target_path 一致"},
+		{Name: "type", Type: field.TypeEnum, Comment: "互动类型: mention(提及), like(喜欢), repost(转发), reply(回复)", Enums: []string{"mention", "like", "repost", "reply"}, Default: "mention"},
+		{Name: "status", Type: field.TypeEnum, Comment: "校验状态: pending(待校验), verified(已校验), rejected(来源页未链接到目标)", Enums: []string{"pending", "verified", "rejected"}, Default: "pending"},
+		{Name: "author_name", Type: field.TypeString, Nullable: true, Size: 100, Comment: "来源页作者名称 (解析自 h-card)"},
+		{Name: "author_url", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "来源页作者主页"},
+		{Name: "author_photo", Type: field.TypeString, Nullable: true, Size: 1024, Comment: "来源页作者头像"},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "回复或提及的纯文本摘要"},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true, Comment: "来源页声明的发布时间"},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次校验通过的时间"},
+	}
+	// WebmentionsTable holds the schema information for the "webmentions" table.
+	WebmentionsTable = &schema.Table{
+		Name:       "webmentions",
+		Comment:    "Webmention 表",
+		Columns:    WebmentionsColumns,
+		PrimaryKey: []*schema.Column{WebmentionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webmention_source_target",
+				Unique:  true,
+				Columns: []*schema.Column{WebmentionsColumns[3], WebmentionsColumns[4]},
+			},
+			{
+				Name:    "webmention_target_path_status",
+				Unique:  false,
+				Columns: []*schema.Column{WebmentionsColumns[5], WebmentionsColumns[7]},
+			},
+		},
+	}
 	// ArticlePostTagsColumns holds the columns for the "article_post_tags" table.
 	ArticlePostTagsColumns = []*schema.Column{
 		{Name: "article_id", Type: field.TypeUint},
@@ -1090,11 +1483,16 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessTokensTable,
+		AccountDeletionsTable,
 		AlbumsTable,
 		AlbumCategoriesTable,
 		ArticlesTable,
 		ArticleHistoriesTable,
+		AuditLogsTable,
+		BlockRulesTable,
 		CommentsTable,
+		CommentThreadsTable,
 		DirectLinksTable,
 		DocSeriesTable,
 		EntitiesTable,
@@ -1104,25 +1502,32 @@ var (
 		FilesTable,
 		FileEntitiesTable,
 		GiveMoneysTable,
+		InvitationCodesTable,
 		LinksTable,
 		LinkCategoriesTable,
 		LinkTagsTable,
 		MetadataTable,
 		NotificationTypesTable,
 		PagesTable,
+		PasskeysTable,
 		PostCategoriesTable,
 		PostTagsTable,
 		SettingsTable,
+		SharesTable,
 		StoragePoliciesTable,
 		SubscribersTable,
 		TagsTable,
 		URLStatsTable,
 		UsersTable,
 		UserGroupsTable,
+		UserIdentitiesTable,
 		UserInstalledThemesTable,
 		UserNotificationConfigsTable,
+		UserSessionsTable,
+		UserTwoFactorsTable,
 		VisitorLogsTable,
 		VisitorStatsTable,
+		WebmentionsTable,
 		ArticlePostTagsTable,
 		ArticlePostCategoriesTable,
 		LinkTagPivotTable,
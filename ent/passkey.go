@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/passkey"
+)
+
+// 通行密钥表
+type Passkey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 所属用户ID
+	UserID uint `json:"user_id,omitempty"`
+	// 用户为通行密钥设置的名称
+	Name string `json:"name,omitempty"`
+	// 凭证ID (base64url)
+	CredentialID string `json:"credential_id,omitempty"`
+	// 凭证ID的 SHA-256 哈希，凭证ID可能很长，用于建立唯一索引
+	CredentialHash string `json:"credential_hash,omitempty"`
+	// COSE 编码的凭证公钥
+	PublicKey []byte `json:"public_key,omitempty"`
+	// 认证器签名计数，用于发现被克隆的认证器
+	SignCount uint32 `json:"sign_count,omitempty"`
+	// 认证器型号标识
+	Aaguid string `json:"aaguid,omitempty"`
+	// 认证器支持的传输方式
+	Transports []string `json:"transports,omitempty"`
+	// 最近一次用于登录的时间
+	LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Passkey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case passkey.FieldPublicKey, passkey.FieldTransports:
+			values[i] = new([]byte)
+		case passkey.FieldID, passkey.FieldUserID, passkey.FieldSignCount:
+			values[i] = new(sql.NullInt64)
+		case passkey.FieldName, passkey.FieldCredentialID, passkey.FieldCredentialHash, passkey.FieldAaguid:
+			values[i] = new(sql.NullString)
+		case passkey.FieldCreatedAt, passkey.FieldUpdatedAt, passkey.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Passkey fields.
+func (pa *Passkey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case passkey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pa.ID = uint(value.Int64)
+		case passkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pa.CreatedAt = value.Time
+			}
+		case passkey.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pa.UpdatedAt = value.Time
+			}
+		case passkey.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pa.UserID = uint(value.Int64)
+			}
+		case passkey.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pa.Name = value.String
+			}
+		case passkey.FieldCredentialID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_id", values[i])
+			} else if value.Valid {
+				pa.CredentialID = value.String
+			}
+		case passkey.FieldCredentialHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_hash", values[i])
+			} else if value.Valid {
+				pa.CredentialHash = value.String
+			}
+		case passkey.FieldPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value != nil {
+				pa.PublicKey = *value
+			}
+		case passkey.FieldSignCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sign_count", values[i])
+			} else if value.Valid {
+				pa.SignCount = uint32(value.Int64)
+			}
+		case passkey.FieldAaguid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field aaguid", values[i])
+			} else if value.Valid {
+				pa.Aaguid = value.String
+			}
+		case passkey.FieldTransports:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transports", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pa.Transports); err != nil {
+					return fmt.Errorf("unmarshal field transports: %w", err)
+				}
+			}
+		case passkey.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				pa.LastUsedAt = new(time.Time)
+				*pa.LastUsedAt = value.Time
+			}
+		default:
+			pa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Passkey.
+// This includes values selected through modifiers, order, etc.
+func (pa *Passkey) Value(name string) (ent.Value, error) {
+	return pa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Passkey.
+// Note that you need to call Passkey.Unwrap() before calling this method if this Passkey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *Passkey) Update() *PasskeyUpdateOne {
+	return NewPasskeyClient(pa.config).UpdateOne(pa)
+}
+
+// Unwrap unwraps the Passkey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pa *Passkey) Unwrap() *Passkey {
+	_tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Passkey is not a transactional entity")
+	}
+	pa.config.driver = _tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *Passkey) String() string {
+	var builder strings.Builder
+	builder.WriteString("Passkey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pa.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pa.Name)
+	builder.WriteString(", ")
+	builder.WriteString("credential_id=")
+	builder.WriteString(pa.CredentialID)
+	builder.WriteString(", ")
+	builder.WriteString("credential_hash=")
+	builder.WriteString(pa.CredentialHash)
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(fmt.Sprintf("%v", pa.PublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("sign_count=")
+	builder.WriteString(fmt.Sprintf("%v", pa.SignCount))
+	builder.WriteString(", ")
+	builder.WriteString("aaguid=")
+	builder.WriteString(pa.Aaguid)
+	builder.WriteString(", ")
+	builder.WriteString("transports=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Transports))
+	builder.WriteString(", ")
+	if v := pa.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Passkeys is a parsable slice of Passkey.
+type Passkeys []*Passkey
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/passkey"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// PasskeyDelete is the builder for deleting a Passkey entity.
+type PasskeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *PasskeyMutation
+}
+
+// Where appends a list predicates to the PasskeyDelete builder.
+func (pd *PasskeyDelete) Where(ps ...predicate.Passkey) *PasskeyDelete {
+	pd.mutation.Where(ps...)
+	return pd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pd *PasskeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pd.sqlExec, pd.mutation, pd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pd *PasskeyDelete) ExecX(ctx context.Context) int {
+	n, err := pd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pd *PasskeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(passkey.Table, sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeUint))
+	if ps := pd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pd.mutation.done = true
+	return affected, err
+}
+
+// PasskeyDeleteOne is the builder for deleting a single Passkey entity.
+type PasskeyDeleteOne struct {
+	pd *PasskeyDelete
+}
+
+// Where appends a list predicates to the PasskeyDelete builder.
+func (pdo *PasskeyDeleteOne) Where(ps ...predicate.Passkey) *PasskeyDeleteOne {
+	pdo.pd.mutation.Where(ps...)
+	return pdo
+}
+
+// Exec executes the deletion query.
+func (pdo *PasskeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := pdo.pd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{passkey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pdo *PasskeyDeleteOne) ExecX(ctx context.Context) {
+	if err := pdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/passkey"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// PasskeyQuery is the builder for querying Passkey entities.
+type PasskeyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []passkey.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Passkey
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PasskeyQuery builder.
+func (pq *PasskeyQuery) Where(ps ...predicate.Passkey) *PasskeyQuery {
+	pq.predicates = append(pq.predicates, ps...)
+	return pq
+}
+
+// Limit the number of records to be returned by this query.
+func (pq *PasskeyQuery) Limit(limit int) *PasskeyQuery {
+	pq.ctx.Limit = &limit
+	return pq
+}
+
+// Offset to start from.
+func (pq *PasskeyQuery) Offset(offset int) *PasskeyQuery {
+	pq.ctx.Offset = &offset
+	return pq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pq *PasskeyQuery) Unique(unique bool) *PasskeyQuery {
+	pq.ctx.Unique = &unique
+	return pq
+}
+
+// Order specifies how the records should be ordered.
+func (pq *PasskeyQuery) Order(o ...passkey.OrderOption) *PasskeyQuery {
+	pq.order = append(pq.order, o...)
+	return pq
+}
+
+// First returns the first Passkey entity from the query.
+// Returns a *NotFoundError when no Passkey was found.
+func (pq *PasskeyQuery) First(ctx context.Context) (*Passkey, error) {
+	nodes, err := pq.Limit(1).All(setContextOp(ctx, pq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{passkey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pq *PasskeyQuery) FirstX(ctx context.Context) *Passkey {
+	node, err := pq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Passkey ID from the query.
+// Returns a *NotFoundError when no Passkey ID was found.
+func (pq *PasskeyQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = pq.Limit(1).IDs(setContextOp(ctx, pq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{passkey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pq *PasskeyQuery) FirstIDX(ctx context.Context) uint {
+	id, err := pq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Passkey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Passkey entity is found.
+// Returns a *NotFoundError when no Passkey entities are found.
+func (pq *PasskeyQuery) Only(ctx context.Context) (*Passkey, error) {
+	nodes, err := pq.Limit(2).All(setContextOp(ctx, pq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{passkey.Label}
+	default:
+		return nil, &NotSingularError{passkey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pq *PasskeyQuery) OnlyX(ctx context.Context) *Passkey {
+	node, err := pq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Passkey ID in the query.
+// Returns a *NotSingularError when more than one Passkey ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pq *PasskeyQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = pq.Limit(2).IDs(setContextOp(ctx, pq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{passkey.Label}
+	default:
+		err = &NotSingularError{passkey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pq *PasskeyQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := pq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Passkeys.
+func (pq *PasskeyQuery) All(ctx context.Context) ([]*Passkey, error) {
+	ctx = setContextOp(ctx, pq.ctx, ent.OpQueryAll)
+	if err := pq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Passkey, *PasskeyQuery]()
+	return withInterceptors[[]*Passkey](ctx, pq, qr, pq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pq *PasskeyQuery) AllX(ctx context.Context) []*Passkey {
+	nodes, err := pq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Passkey IDs.
+func (pq *PasskeyQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if pq.ctx.Unique == nil && pq.path != nil {
+		pq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pq.ctx, ent.OpQueryIDs)
+	if err = pq.Select(passkey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pq *PasskeyQuery) IDsX(ctx context.Context) []uint {
+	ids, err := pq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pq *PasskeyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pq.ctx, ent.OpQueryCount)
+	if err := pq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pq, querierCount[*PasskeyQuery](), pq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pq *PasskeyQuery) CountX(ctx context.Context) int {
+	count, err := pq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pq *PasskeyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pq.ctx, ent.OpQueryExist)
+	switch _, err := pq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pq *PasskeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := pq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PasskeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pq *PasskeyQuery) Clone() *PasskeyQuery {
+	if pq == nil {
+		return nil
+	}
+	return &PasskeyQuery{
+		config:     pq.config,
+		ctx:        pq.ctx.Clone(),
+		order:      append([]passkey.OrderOption{}, pq.order...),
+		inters:     append([]Interceptor{}, pq.inters...),
+		predicates: append([]predicate.Passkey{}, pq.predicates...),
+		// clone intermediate query.
+		sql:       pq.sql.Clone(),
+		path:      pq.path,
+		modifiers: append([]func(*sql.Selector){}, pq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Passkey.Query().
+//		GroupBy(passkey.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pq *PasskeyQuery) GroupBy(field string, fields ...string) *PasskeyGroupBy {
+	pq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PasskeyGroupBy{build: pq}
+	grbuild.flds = &pq.ctx.Fields
+	grbuild.label = passkey.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.Passkey.Query().
+//		Select(passkey.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (pq *PasskeyQuery) Select(fields ...string) *PasskeySelect {
+	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
+	sbuild := &PasskeySelect{PasskeyQuery: pq}
+	sbuild.label = passkey.Label
+	sbuild.flds, sbuild.scan = &pq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PasskeySelect configured with the given aggregations.
+func (pq *PasskeyQuery) Aggregate(fns ...AggregateFunc) *PasskeySelect {
+	return pq.Select().Aggregate(fns...)
+}
+
+func (pq *PasskeyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pq.ctx.Fields {
+		if !passkey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pq.path != nil {
+		prev, err := pq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pq.sql = prev
+	}
+	return nil
+}
+
+func (pq *PasskeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Passkey, error) {
+	var (
+		nodes = []*Passkey{}
+		_spec = pq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Passkey).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Passkey{config: pq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pq *PasskeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pq.querySpec()
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
+	_spec.Node.Columns = pq.ctx.Fields
+	if len(pq.ctx.Fields) > 0 {
+		_spec.Unique = pq.ctx.Unique != nil && *pq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
+}
+
+func (pq *PasskeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(passkey.Table, passkey.Columns, sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeUint))
+	_spec.From = pq.sql
+	if unique := pq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passkey.FieldID)
+		for i := range fields {
+			if fields[i] != passkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pq *PasskeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pq.driver.Dialect())
+	t1 := builder.Table(passkey.Table)
+	columns := pq.ctx.Fields
+	if len(columns) == 0 {
+		columns = passkey.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pq.sql != nil {
+		selector = pq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pq.ctx.Unique != nil && *pq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
+	for _, p := range pq.predicates {
+		p(selector)
+	}
+	for _, p := range pq.order {
+		p(selector)
+	}
+	if offset := pq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pq *PasskeyQuery) Modify(modifiers ...func(s *sql.Selector)) *PasskeySelect {
+	pq.modifiers = append(pq.modifiers, modifiers...)
+	return pq.Select()
+}
+
+// PasskeyGroupBy is the group-by builder for Passkey entities.
+type PasskeyGroupBy struct {
+	selector
+	build *PasskeyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pgb *PasskeyGroupBy) Aggregate(fns ...AggregateFunc) *PasskeyGroupBy {
+	pgb.fns = append(pgb.fns, fns...)
+	return pgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pgb *PasskeyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PasskeyQuery, *PasskeyGroupBy](ctx, pgb.build, pgb, pgb.build.inters, v)
+}
+
+func (pgb *PasskeyGroupBy) sqlScan(ctx context.Context, root *PasskeyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pgb.fns))
+	for _, fn := range pgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pgb.flds)+len(pgb.fns))
+		for _, f := range *pgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PasskeySelect is the builder for selecting fields of Passkey entities.
+type PasskeySelect struct {
+	*PasskeyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ps *PasskeySelect) Aggregate(fns ...AggregateFunc) *PasskeySelect {
+	ps.fns = append(ps.fns, fns...)
+	return ps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ps *PasskeySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ps.ctx, ent.OpQuerySelect)
+	if err := ps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PasskeyQuery, *PasskeySelect](ctx, ps.PasskeyQuery, ps, ps.inters, v)
+}
+
+func (ps *PasskeySelect) sqlScan(ctx context.Context, root *PasskeyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ps.fns))
+	for _, fn := range ps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ps *PasskeySelect) Modify(modifiers ...func(s *sql.Selector)) *PasskeySelect {
+	ps.modifiers = append(ps.modifiers, modifiers...)
+	return ps
+}
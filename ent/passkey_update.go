@@ -0,0 +1,513 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/passkey"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// PasskeyUpdate is the builder for updating Passkey entities.
+type PasskeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PasskeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PasskeyUpdate builder.
+func (pu *PasskeyUpdate) Where(ps ...predicate.Passkey) *PasskeyUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PasskeyUpdate) SetUpdatedAt(t time.Time) *PasskeyUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetUserID sets the "user_id" field.
+func (pu *PasskeyUpdate) SetUserID(u uint) *PasskeyUpdate {
+	pu.mutation.ResetUserID()
+	pu.mutation.SetUserID(u)
+	return pu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pu *PasskeyUpdate) SetNillableUserID(u *uint) *PasskeyUpdate {
+	if u != nil {
+		pu.SetUserID(*u)
+	}
+	return pu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (pu *PasskeyUpdate) AddUserID(u int) *PasskeyUpdate {
+	pu.mutation.AddUserID(u)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PasskeyUpdate) SetName(s string) *PasskeyUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *PasskeyUpdate) SetNillableName(s *string) *PasskeyUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetSignCount sets the "sign_count" field.
+func (pu *PasskeyUpdate) SetSignCount(u uint32) *PasskeyUpdate {
+	pu.mutation.ResetSignCount()
+	pu.mutation.SetSignCount(u)
+	return pu
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (pu *PasskeyUpdate) SetNillableSignCount(u *uint32) *PasskeyUpdate {
+	if u != nil {
+		pu.SetSignCount(*u)
+	}
+	return pu
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (pu *PasskeyUpdate) AddSignCount(u int32) *PasskeyUpdate {
+	pu.mutation.AddSignCount(u)
+	return pu
+}
+
+// SetTransports sets the "transports" field.
+func (pu *PasskeyUpdate) SetTransports(s []string) *PasskeyUpdate {
+	pu.mutation.SetTransports(s)
+	return pu
+}
+
+// AppendTransports appends s to the "transports" field.
+func (pu *PasskeyUpdate) AppendTransports(s []string) *PasskeyUpdate {
+	pu.mutation.AppendTransports(s)
+	return pu
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (pu *PasskeyUpdate) ClearTransports() *PasskeyUpdate {
+	pu.mutation.ClearTransports()
+	return pu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (pu *PasskeyUpdate) SetLastUsedAt(t time.Time) *PasskeyUpdate {
+	pu.mutation.SetLastUsedAt(t)
+	return pu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (pu *PasskeyUpdate) SetNillableLastUsedAt(t *time.Time) *PasskeyUpdate {
+	if t != nil {
+		pu.SetLastUsedAt(*t)
+	}
+	return pu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (pu *PasskeyUpdate) ClearLastUsedAt() *PasskeyUpdate {
+	pu.mutation.ClearLastUsedAt()
+	return pu
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (pu *PasskeyUpdate) Mutation() *PasskeyMutation {
+	return pu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PasskeyUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PasskeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PasskeyUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PasskeyUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PasskeyUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := passkey.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PasskeyUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := passkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Passkey.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *PasskeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PasskeyUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
+func (pu *PasskeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passkey.Table, passkey.Columns, sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeUint))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pu.mutation.UserID(); ok {
+		_spec.SetField(passkey.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := pu.mutation.AddedUserID(); ok {
+		_spec.AddField(passkey.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(passkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.SignCount(); ok {
+		_spec.SetField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := pu.mutation.AddedSignCount(); ok {
+		_spec.AddField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if pu.mutation.AaguidCleared() {
+		_spec.ClearField(passkey.FieldAaguid, field.TypeString)
+	}
+	if value, ok := pu.mutation.Transports(); ok {
+		_spec.SetField(passkey.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, passkey.FieldTransports, value)
+		})
+	}
+	if pu.mutation.TransportsCleared() {
+		_spec.ClearField(passkey.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if pu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(passkey.FieldLastUsedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(pu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PasskeyUpdateOne is the builder for updating a single Passkey entity.
+type PasskeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PasskeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PasskeyUpdateOne) SetUpdatedAt(t time.Time) *PasskeyUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetUserID sets the "user_id" field.
+func (puo *PasskeyUpdateOne) SetUserID(u uint) *PasskeyUpdateOne {
+	puo.mutation.ResetUserID()
+	puo.mutation.SetUserID(u)
+	return puo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (puo *PasskeyUpdateOne) SetNillableUserID(u *uint) *PasskeyUpdateOne {
+	if u != nil {
+		puo.SetUserID(*u)
+	}
+	return puo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (puo *PasskeyUpdateOne) AddUserID(u int) *PasskeyUpdateOne {
+	puo.mutation.AddUserID(u)
+	return puo
+}
+
+// SetName sets the "name" field.
+func (puo *PasskeyUpdateOne) SetName(s string) *PasskeyUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *PasskeyUpdateOne) SetNillableName(s *string) *PasskeyUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetSignCount sets the "sign_count" field.
+func (puo *PasskeyUpdateOne) SetSignCount(u uint32) *PasskeyUpdateOne {
+	puo.mutation.ResetSignCount()
+	puo.mutation.SetSignCount(u)
+	return puo
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (puo *PasskeyUpdateOne) SetNillableSignCount(u *uint32) *PasskeyUpdateOne {
+	if u != nil {
+		puo.SetSignCount(*u)
+	}
+	return puo
+}
+
+// AddSignCount adds u to the "sign_count" field.
+func (puo *PasskeyUpdateOne) AddSignCount(u int32) *PasskeyUpdateOne {
+	puo.mutation.AddSignCount(u)
+	return puo
+}
+
+// SetTransports sets the "transports" field.
+func (puo *PasskeyUpdateOne) SetTransports(s []string) *PasskeyUpdateOne {
+	puo.mutation.SetTransports(s)
+	return puo
+}
+
+// AppendTransports appends s to the "transports" field.
+func (puo *PasskeyUpdateOne) AppendTransports(s []string) *PasskeyUpdateOne {
+	puo.mutation.AppendTransports(s)
+	return puo
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (puo *PasskeyUpdateOne) ClearTransports() *PasskeyUpdateOne {
+	puo.mutation.ClearTransports()
+	return puo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (puo *PasskeyUpdateOne) SetLastUsedAt(t time.Time) *PasskeyUpdateOne {
+	puo.mutation.SetLastUsedAt(t)
+	return puo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (puo *PasskeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *PasskeyUpdateOne {
+	if t != nil {
+		puo.SetLastUsedAt(*t)
+	}
+	return puo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (puo *PasskeyUpdateOne) ClearLastUsedAt() *PasskeyUpdateOne {
+	puo.mutation.ClearLastUsedAt()
+	return puo
+}
+
+// Mutation returns the PasskeyMutation object of the builder.
+func (puo *PasskeyUpdateOne) Mutation() *PasskeyMutation {
+	return puo.mutation
+}
+
+// Where appends a list predicates to the PasskeyUpdate builder.
+func (puo *PasskeyUpdateOne) Where(ps ...predicate.Passkey) *PasskeyUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PasskeyUpdateOne) Select(field string, fields ...string) *PasskeyUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Passkey entity.
+func (puo *PasskeyUpdateOne) Save(ctx context.Context) (*Passkey, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PasskeyUpdateOne) SaveX(ctx context.Context) *Passkey {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PasskeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PasskeyUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PasskeyUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := passkey.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PasskeyUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := passkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Passkey.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *PasskeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PasskeyUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
+func (puo *PasskeyUpdateOne) sqlSave(ctx context.Context) (_node *Passkey, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passkey.Table, passkey.Columns, sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeUint))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Passkey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passkey.FieldID)
+		for _, f := range fields {
+			if !passkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(passkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := puo.mutation.UserID(); ok {
+		_spec.SetField(passkey.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := puo.mutation.AddedUserID(); ok {
+		_spec.AddField(passkey.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(passkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.SignCount(); ok {
+		_spec.SetField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := puo.mutation.AddedSignCount(); ok {
+		_spec.AddField(passkey.FieldSignCount, field.TypeUint32, value)
+	}
+	if puo.mutation.AaguidCleared() {
+		_spec.ClearField(passkey.FieldAaguid, field.TypeString)
+	}
+	if value, ok := puo.mutation.Transports(); ok {
+		_spec.SetField(passkey.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, passkey.FieldTransports, value)
+		})
+	}
+	if puo.mutation.TransportsCleared() {
+		_spec.ClearField(passkey.FieldTransports, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.LastUsedAt(); ok {
+		_spec.SetField(passkey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if puo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(passkey.FieldLastUsedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(puo.modifiers...)
+	_node = &Passkey{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
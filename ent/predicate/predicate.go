@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessToken is the predicate function for accesstoken builders.
+type AccessToken func(*sql.Selector)
+
+// AccountDeletion is the predicate function for accountdeletion builders.
+type AccountDeletion func(*sql.Selector)
+
 // Album is the predicate function for album builders.
 type Album func(*sql.Selector)
 
@@ -18,9 +24,18 @@ type Article func(*sql.Selector)
 // ArticleHistory is the predicate function for articlehistory builders.
 type ArticleHistory func(*sql.Selector)
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// BlockRule is the predicate function for blockrule builders.
+type BlockRule func(*sql.Selector)
+
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
+// CommentThread is the predicate function for commentthread builders.
+type CommentThread func(*sql.Selector)
+
 // DirectLink is the predicate function for directlink builders.
 type DirectLink func(*sql.Selector)
 
@@ -48,6 +63,9 @@ type FileEntity func(*sql.Selector)
 // GiveMoney is the predicate function for givemoney builders.
 type GiveMoney func(*sql.Selector)
 
+// InvitationCode is the predicate function for invitationcode builders.
+type InvitationCode func(*sql.Selector)
+
 // Link is the predicate function for link builders.
 type Link func(*sql.Selector)
 
@@ -66,6 +84,9 @@ type NotificationType func(*sql.Selector)
 // Page is the predicate function for page builders.
 type Page func(*sql.Selector)
 
+// Passkey is the predicate function for passkey builders.
+type Passkey func(*sql.Selector)
+
 // PostCategory is the predicate function for postcategory builders.
 type PostCategory func(*sql.Selector)
 
@@ -75,6 +96,9 @@ type PostTag func(*sql.Selector)
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
+// Share is the predicate function for share builders.
+type Share func(*sql.Selector)
+
 // StoragePolicy is the predicate function for storagepolicy builders.
 type StoragePolicy func(*sql.Selector)
 
@@ -93,14 +117,26 @@ type User func(*sql.Selector)
 // UserGroup is the predicate function for usergroup builders.
 type UserGroup func(*sql.Selector)
 
+// UserIdentity is the predicate function for useridentity builders.
+type UserIdentity func(*sql.Selector)
+
 // UserInstalledTheme is the predicate function for userinstalledtheme builders.
 type UserInstalledTheme func(*sql.Selector)
 
 // UserNotificationConfig is the predicate function for usernotificationconfig builders.
 type UserNotificationConfig func(*sql.Selector)
 
+// UserSession is the predicate function for usersession builders.
+type UserSession func(*sql.Selector)
+
+// UserTwoFactor is the predicate function for usertwofactor builders.
+type UserTwoFactor func(*sql.Selector)
+
 // VisitorLog is the predicate function for visitorlog builders.
 type VisitorLog func(*sql.Selector)
 
 // VisitorStat is the predicate function for visitorstat builders.
 type VisitorStat func(*sql.Selector)
+
+// Webmention is the predicate function for webmention builders.
+type Webmention func(*sql.Selector)
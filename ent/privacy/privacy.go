@@ -110,6 +110,54 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The AccessTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AccessTokenQueryRuleFunc func(context.Context, *ent.AccessTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AccessTokenQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AccessTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AccessTokenQuery", q)
+}
+
+// The AccessTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AccessTokenMutationRuleFunc func(context.Context, *ent.AccessTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AccessTokenMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AccessTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AccessTokenMutation", m)
+}
+
+// The AccountDeletionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AccountDeletionQueryRuleFunc func(context.Context, *ent.AccountDeletionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AccountDeletionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AccountDeletionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AccountDeletionQuery", q)
+}
+
+// The AccountDeletionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AccountDeletionMutationRuleFunc func(context.Context, *ent.AccountDeletionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AccountDeletionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AccountDeletionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AccountDeletionMutation", m)
+}
+
 // The AlbumQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type AlbumQueryRuleFunc func(context.Context, *ent.AlbumQuery) error
@@ -206,6 +254,54 @@ func (f ArticleHistoryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ArticleHistoryMutation", m)
 }
 
+// The AuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuditLogQueryRuleFunc func(context.Context, *ent.AuditLogQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuditLogQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuditLogQuery", q)
+}
+
+// The AuditLogMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuditLogMutationRuleFunc func(context.Context, *ent.AuditLogMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuditLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuditLogMutation", m)
+}
+
+// The BlockRuleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type BlockRuleQueryRuleFunc func(context.Context, *ent.BlockRuleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f BlockRuleQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BlockRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.BlockRuleQuery", q)
+}
+
+// The BlockRuleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type BlockRuleMutationRuleFunc func(context.Context, *ent.BlockRuleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f BlockRuleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.BlockRuleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.BlockRuleMutation", m)
+}
+
 // The CommentQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type CommentQueryRuleFunc func(context.Context, *ent.CommentQuery) error
@@ -230,6 +326,30 @@ func (f CommentMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CommentMutation", m)
 }
 
+// The CommentThreadQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CommentThreadQueryRuleFunc func(context.Context, *ent.CommentThreadQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CommentThreadQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CommentThreadQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.CommentThreadQuery", q)
+}
+
+// The CommentThreadMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CommentThreadMutationRuleFunc func(context.Context, *ent.CommentThreadMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CommentThreadMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.CommentThreadMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.CommentThreadMutation", m)
+}
+
 // The DirectLinkQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type DirectLinkQueryRuleFunc func(context.Context, *ent.DirectLinkQuery) error
@@ -446,6 +566,30 @@ func (f GiveMoneyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutat
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.GiveMoneyMutation", m)
 }
 
+// The InvitationCodeQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type InvitationCodeQueryRuleFunc func(context.Context, *ent.InvitationCodeQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f InvitationCodeQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InvitationCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.InvitationCodeQuery", q)
+}
+
+// The InvitationCodeMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type InvitationCodeMutationRuleFunc func(context.Context, *ent.InvitationCodeMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f InvitationCodeMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.InvitationCodeMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InvitationCodeMutation", m)
+}
+
 // The LinkQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type LinkQueryRuleFunc func(context.Context, *ent.LinkQuery) error
@@ -590,6 +734,30 @@ func (f PageMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PageMutation", m)
 }
 
+// The PasskeyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PasskeyQueryRuleFunc func(context.Context, *ent.PasskeyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PasskeyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PasskeyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PasskeyQuery", q)
+}
+
+// The PasskeyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PasskeyMutationRuleFunc func(context.Context, *ent.PasskeyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PasskeyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PasskeyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PasskeyMutation", m)
+}
+
 // The PostCategoryQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type PostCategoryQueryRuleFunc func(context.Context, *ent.PostCategoryQuery) error
@@ -662,6 +830,30 @@ func (f SettingMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutatio
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SettingMutation", m)
 }
 
+// The ShareQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ShareQueryRuleFunc func(context.Context, *ent.ShareQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ShareQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ShareQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ShareQuery", q)
+}
+
+// The ShareMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ShareMutationRuleFunc func(context.Context, *ent.ShareMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ShareMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ShareMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ShareMutation", m)
+}
+
 // The StoragePolicyQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type StoragePolicyQueryRuleFunc func(context.Context, *ent.StoragePolicyQuery) error
@@ -806,6 +998,30 @@ func (f UserGroupMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutat
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserGroupMutation", m)
 }
 
+// The UserIdentityQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserIdentityQueryRuleFunc func(context.Context, *ent.UserIdentityQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserIdentityQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserIdentityQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserIdentityQuery", q)
+}
+
+// The UserIdentityMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserIdentityMutationRuleFunc func(context.Context, *ent.UserIdentityMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserIdentityMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserIdentityMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserIdentityMutation", m)
+}
+
 // The UserInstalledThemeQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserInstalledThemeQueryRuleFunc func(context.Context, *ent.UserInstalledThemeQuery) error
@@ -854,6 +1070,54 @@ func (f UserNotificationConfigMutationRuleFunc) EvalMutation(ctx context.Context
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserNotificationConfigMutation", m)
 }
 
+// The UserSessionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserSessionQueryRuleFunc func(context.Context, *ent.UserSessionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserSessionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserSessionQuery", q)
+}
+
+// The UserSessionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserSessionMutationRuleFunc func(context.Context, *ent.UserSessionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserSessionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserSessionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserSessionMutation", m)
+}
+
+// The UserTwoFactorQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserTwoFactorQueryRuleFunc func(context.Context, *ent.UserTwoFactorQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserTwoFactorQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserTwoFactorQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserTwoFactorQuery", q)
+}
+
+// The UserTwoFactorMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserTwoFactorMutationRuleFunc func(context.Context, *ent.UserTwoFactorMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserTwoFactorMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserTwoFactorMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserTwoFactorMutation", m)
+}
+
 // The VisitorLogQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type VisitorLogQueryRuleFunc func(context.Context, *ent.VisitorLogQuery) error
@@ -901,3 +1165,27 @@ func (f VisitorStatMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mut
 	}
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.VisitorStatMutation", m)
 }
+
+// The WebmentionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WebmentionQueryRuleFunc func(context.Context, *ent.WebmentionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WebmentionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WebmentionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.WebmentionQuery", q)
+}
+
+// The WebmentionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WebmentionMutationRuleFunc func(context.Context, *ent.WebmentionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WebmentionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.WebmentionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.WebmentionMutation", m)
+}
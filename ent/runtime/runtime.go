@@ -5,11 +5,16 @@ package runtime
 import (
 	"time"
 
+	"github.com/anzhiyu-c/anheyu-app/ent/accesstoken"
+	"github.com/anzhiyu-c/anheyu-app/ent/accountdeletion"
 	"github.com/anzhiyu-c/anheyu-app/ent/album"
 	"github.com/anzhiyu-c/anheyu-app/ent/albumcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/article"
 	"github.com/anzhiyu-c/anheyu-app/ent/articlehistory"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+	"github.com/anzhiyu-c/anheyu-app/ent/blockrule"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentthread"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
 	"github.com/anzhiyu-c/anheyu-app/ent/docseries"
 	"github.com/anzhiyu-c/anheyu-app/ent/entity"
@@ -19,26 +24,33 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
 	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/givemoney"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
 	"github.com/anzhiyu-c/anheyu-app/ent/link"
 	"github.com/anzhiyu-c/anheyu-app/ent/linkcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/linktag"
 	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
 	"github.com/anzhiyu-c/anheyu-app/ent/notificationtype"
 	"github.com/anzhiyu-c/anheyu-app/ent/page"
+	"github.com/anzhiyu-c/anheyu-app/ent/passkey"
 	"github.com/anzhiyu-c/anheyu-app/ent/postcategory"
 	"github.com/anzhiyu-c/anheyu-app/ent/posttag"
 	"github.com/anzhiyu-c/anheyu-app/ent/schema"
 	"github.com/anzhiyu-c/anheyu-app/ent/setting"
+	"github.com/anzhiyu-c/anheyu-app/ent/share"
 	"github.com/anzhiyu-c/anheyu-app/ent/storagepolicy"
 	"github.com/anzhiyu-c/anheyu-app/ent/subscriber"
 	"github.com/anzhiyu-c/anheyu-app/ent/tag"
 	"github.com/anzhiyu-c/anheyu-app/ent/urlstat"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
 	"github.com/anzhiyu-c/anheyu-app/ent/usernotificationconfig"
+	"github.com/anzhiyu-c/anheyu-app/ent/usersession"
+	"github.com/anzhiyu-c/anheyu-app/ent/usertwofactor"
 	"github.com/anzhiyu-c/anheyu-app/ent/visitorlog"
 	"github.com/anzhiyu-c/anheyu-app/ent/visitorstat"
+	"github.com/anzhiyu-c/anheyu-app/ent/webmention"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
 
@@ -46,6 +58,54 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescCreatedAt is the schema descriptor for created_at field.
+	accesstokenDescCreatedAt := accesstokenFields[1].Descriptor()
+	// accesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesstoken.DefaultCreatedAt = accesstokenDescCreatedAt.Default.(func() time.Time)
+	// accesstokenDescUpdatedAt is the schema descriptor for updated_at field.
+	accesstokenDescUpdatedAt := accesstokenFields[2].Descriptor()
+	// accesstoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accesstoken.DefaultUpdatedAt = accesstokenDescUpdatedAt.Default.(func() time.Time)
+	// accesstoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accesstoken.UpdateDefaultUpdatedAt = accesstokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accesstokenDescName is the schema descriptor for name field.
+	accesstokenDescName := accesstokenFields[4].Descriptor()
+	// accesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	accesstoken.NameValidator = accesstokenDescName.Validators[0].(func(string) error)
+	// accesstokenDescTokenHash is the schema descriptor for token_hash field.
+	accesstokenDescTokenHash := accesstokenFields[5].Descriptor()
+	// accesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	accesstoken.TokenHashValidator = func() func(string) error {
+		validators := accesstokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accesstokenDescTokenPrefix is the schema descriptor for token_prefix field.
+	accesstokenDescTokenPrefix := accesstokenFields[6].Descriptor()
+	// accesstoken.TokenPrefixValidator is a validator for the "token_prefix" field. It is called by the builders before save.
+	accesstoken.TokenPrefixValidator = accesstokenDescTokenPrefix.Validators[0].(func(string) error)
+	// accesstokenDescLastUsedIP is the schema descriptor for last_used_ip field.
+	accesstokenDescLastUsedIP := accesstokenFields[10].Descriptor()
+	// accesstoken.LastUsedIPValidator is a validator for the "last_used_ip" field. It is called by the builders before save.
+	accesstoken.LastUsedIPValidator = accesstokenDescLastUsedIP.Validators[0].(func(string) error)
+	accountdeletionFields := schema.AccountDeletion{}.Fields()
+	_ = accountdeletionFields
+	// accountdeletionDescCreatedAt is the schema descriptor for created_at field.
+	accountdeletionDescCreatedAt := accountdeletionFields[1].Descriptor()
+	// accountdeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountdeletion.DefaultCreatedAt = accountdeletionDescCreatedAt.Default.(func() time.Time)
 	albumMixin := schema.Album{}.Mixin()
 	albumMixinHooks0 := albumMixin[0].Hooks()
 	album.Hooks[0] = albumMixinHooks0[0]
@@ -270,6 +330,92 @@ func init() {
 	articlehistoryDescCreatedAt := articlehistoryFields[15].Descriptor()
 	// articlehistory.DefaultCreatedAt holds the default value on creation for the created_at field.
 	articlehistory.DefaultCreatedAt = articlehistoryDescCreatedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[1].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescActorID is the schema descriptor for actor_id field.
+	auditlogDescActorID := auditlogFields[2].Descriptor()
+	// auditlog.DefaultActorID holds the default value on creation for the actor_id field.
+	auditlog.DefaultActorID = auditlogDescActorID.Default.(uint)
+	// auditlogDescActorName is the schema descriptor for actor_name field.
+	auditlogDescActorName := auditlogFields[3].Descriptor()
+	// auditlog.ActorNameValidator is a validator for the "actor_name" field. It is called by the builders before save.
+	auditlog.ActorNameValidator = auditlogDescActorName.Validators[0].(func(string) error)
+	// auditlogDescIP is the schema descriptor for ip field.
+	auditlogDescIP := auditlogFields[4].Descriptor()
+	// auditlog.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	auditlog.IPValidator = auditlogDescIP.Validators[0].(func(string) error)
+	// auditlogDescUserAgent is the schema descriptor for user_agent field.
+	auditlogDescUserAgent := auditlogFields[5].Descriptor()
+	// auditlog.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	auditlog.UserAgentValidator = auditlogDescUserAgent.Validators[0].(func(string) error)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[6].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = func() func(string) error {
+		validators := auditlogDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// auditlogDescTargetType is the schema descriptor for target_type field.
+	auditlogDescTargetType := auditlogFields[7].Descriptor()
+	// auditlog.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	auditlog.TargetTypeValidator = auditlogDescTargetType.Validators[0].(func(string) error)
+	// auditlogDescTargetID is the schema descriptor for target_id field.
+	auditlogDescTargetID := auditlogFields[8].Descriptor()
+	// auditlog.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	auditlog.TargetIDValidator = auditlogDescTargetID.Validators[0].(func(string) error)
+	// auditlogDescSummary is the schema descriptor for summary field.
+	auditlogDescSummary := auditlogFields[9].Descriptor()
+	// auditlog.SummaryValidator is a validator for the "summary" field. It is called by the builders before save.
+	auditlog.SummaryValidator = auditlogDescSummary.Validators[0].(func(string) error)
+	blockruleFields := schema.BlockRule{}.Fields()
+	_ = blockruleFields
+	// blockruleDescCreatedAt is the schema descriptor for created_at field.
+	blockruleDescCreatedAt := blockruleFields[1].Descriptor()
+	// blockrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blockrule.DefaultCreatedAt = blockruleDescCreatedAt.Default.(func() time.Time)
+	// blockruleDescUpdatedAt is the schema descriptor for updated_at field.
+	blockruleDescUpdatedAt := blockruleFields[2].Descriptor()
+	// blockrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	blockrule.DefaultUpdatedAt = blockruleDescUpdatedAt.Default.(func() time.Time)
+	// blockrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	blockrule.UpdateDefaultUpdatedAt = blockruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// blockruleDescValue is the schema descriptor for value field.
+	blockruleDescValue := blockruleFields[4].Descriptor()
+	// blockrule.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	blockrule.ValueValidator = func() func(string) error {
+		validators := blockruleDescValue.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(value string) error {
+			for _, fn := range fns {
+				if err := fn(value); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// blockruleDescReason is the schema descriptor for reason field.
+	blockruleDescReason := blockruleFields[5].Descriptor()
+	// blockrule.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	blockrule.ReasonValidator = blockruleDescReason.Validators[0].(func(string) error)
 	commentMixin := schema.Comment{}.Mixin()
 	commentMixinHooks0 := commentMixin[0].Hooks()
 	comment.Hooks[0] = commentMixinHooks0[0]
@@ -361,6 +507,36 @@ func init() {
 	comment.DefaultLikeCount = commentDescLikeCount.Default.(int)
 	// comment.LikeCountValidator is a validator for the "like_count" field. It is called by the builders before save.
 	comment.LikeCountValidator = commentDescLikeCount.Validators[0].(func(int) error)
+	commentthreadFields := schema.CommentThread{}.Fields()
+	_ = commentthreadFields
+	// commentthreadDescCreatedAt is the schema descriptor for created_at field.
+	commentthreadDescCreatedAt := commentthreadFields[1].Descriptor()
+	// commentthread.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commentthread.DefaultCreatedAt = commentthreadDescCreatedAt.Default.(func() time.Time)
+	// commentthreadDescUpdatedAt is the schema descriptor for updated_at field.
+	commentthreadDescUpdatedAt := commentthreadFields[2].Descriptor()
+	// commentthread.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	commentthread.DefaultUpdatedAt = commentthreadDescUpdatedAt.Default.(func() time.Time)
+	// commentthread.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	commentthread.UpdateDefaultUpdatedAt = commentthreadDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commentthreadDescTargetPath is the schema descriptor for target_path field.
+	commentthreadDescTargetPath := commentthreadFields[3].Descriptor()
+	// commentthread.TargetPathValidator is a validator for the "target_path" field. It is called by the builders before save.
+	commentthread.TargetPathValidator = func() func(string) error {
+		validators := commentthreadDescTargetPath.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(target_path string) error {
+			for _, fn := range fns {
+				if err := fn(target_path); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	directlinkMixin := schema.DirectLink{}.Mixin()
 	directlinkMixinHooks0 := directlinkMixin[0].Hooks()
 	directlink.Hooks[0] = directlinkMixinHooks0[0]
@@ -458,6 +634,14 @@ func init() {
 	entityDescDimension := entityFields[12].Descriptor()
 	// entity.DimensionValidator is a validator for the "dimension" field. It is called by the builders before save.
 	entity.DimensionValidator = entityDescDimension.Validators[0].(func(string) error)
+	// entityDescContentHash is the schema descriptor for content_hash field.
+	entityDescContentHash := entityFields[14].Descriptor()
+	// entity.ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	entity.ContentHashValidator = entityDescContentHash.Validators[0].(func(string) error)
+	// entityDescReferenceCount is the schema descriptor for reference_count field.
+	entityDescReferenceCount := entityFields[15].Descriptor()
+	// entity.DefaultReferenceCount holds the default value on creation for the reference_count field.
+	entity.DefaultReferenceCount = entityDescReferenceCount.Default.(int)
 	essayMixin := schema.Essay{}.Mixin()
 	essayMixinHooks0 := essayMixin[0].Hooks()
 	essay.Hooks[0] = essayMixinHooks0[0]
@@ -604,6 +788,56 @@ func init() {
 			return nil
 		}
 	}()
+	invitationcodeFields := schema.InvitationCode{}.Fields()
+	_ = invitationcodeFields
+	// invitationcodeDescCreatedAt is the schema descriptor for created_at field.
+	invitationcodeDescCreatedAt := invitationcodeFields[1].Descriptor()
+	// invitationcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitationcode.DefaultCreatedAt = invitationcodeDescCreatedAt.Default.(func() time.Time)
+	// invitationcodeDescUpdatedAt is the schema descriptor for updated_at field.
+	invitationcodeDescUpdatedAt := invitationcodeFields[2].Descriptor()
+	// invitationcode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invitationcode.DefaultUpdatedAt = invitationcodeDescUpdatedAt.Default.(func() time.Time)
+	// invitationcode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invitationcode.UpdateDefaultUpdatedAt = invitationcodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invitationcodeDescCode is the schema descriptor for code field.
+	invitationcodeDescCode := invitationcodeFields[3].Descriptor()
+	// invitationcode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	invitationcode.CodeValidator = func() func(string) error {
+		validators := invitationcodeDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// invitationcodeDescMaxUses is the schema descriptor for max_uses field.
+	invitationcodeDescMaxUses := invitationcodeFields[6].Descriptor()
+	// invitationcode.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invitationcode.DefaultMaxUses = invitationcodeDescMaxUses.Default.(int)
+	// invitationcode.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	invitationcode.MaxUsesValidator = invitationcodeDescMaxUses.Validators[0].(func(int) error)
+	// invitationcodeDescUsedCount is the schema descriptor for used_count field.
+	invitationcodeDescUsedCount := invitationcodeFields[7].Descriptor()
+	// invitationcode.DefaultUsedCount holds the default value on creation for the used_count field.
+	invitationcode.DefaultUsedCount = invitationcodeDescUsedCount.Default.(int)
+	// invitationcode.UsedCountValidator is a validator for the "used_count" field. It is called by the builders before save.
+	invitationcode.UsedCountValidator = invitationcodeDescUsedCount.Validators[0].(func(int) error)
+	// invitationcodeDescEmail is the schema descriptor for email field.
+	invitationcodeDescEmail := invitationcodeFields[8].Descriptor()
+	// invitationcode.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	invitationcode.EmailValidator = invitationcodeDescEmail.Validators[0].(func(string) error)
+	// invitationcodeDescNote is the schema descriptor for note field.
+	invitationcodeDescNote := invitationcodeFields[9].Descriptor()
+	// invitationcode.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	invitationcode.NoteValidator = invitationcodeDescNote.Validators[0].(func(string) error)
 	linkFields := schema.Link{}.Fields()
 	_ = linkFields
 	// linkDescName is the schema descriptor for name field.
@@ -778,6 +1012,70 @@ func init() {
 	page.DefaultUpdatedAt = pageDescUpdatedAt.Default.(func() time.Time)
 	// page.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	page.UpdateDefaultUpdatedAt = pageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	passkeyFields := schema.Passkey{}.Fields()
+	_ = passkeyFields
+	// passkeyDescCreatedAt is the schema descriptor for created_at field.
+	passkeyDescCreatedAt := passkeyFields[1].Descriptor()
+	// passkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passkey.DefaultCreatedAt = passkeyDescCreatedAt.Default.(func() time.Time)
+	// passkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	passkeyDescUpdatedAt := passkeyFields[2].Descriptor()
+	// passkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passkey.DefaultUpdatedAt = passkeyDescUpdatedAt.Default.(func() time.Time)
+	// passkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passkey.UpdateDefaultUpdatedAt = passkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// passkeyDescName is the schema descriptor for name field.
+	passkeyDescName := passkeyFields[4].Descriptor()
+	// passkey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	passkey.NameValidator = passkeyDescName.Validators[0].(func(string) error)
+	// passkeyDescCredentialID is the schema descriptor for credential_id field.
+	passkeyDescCredentialID := passkeyFields[5].Descriptor()
+	// passkey.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	passkey.CredentialIDValidator = func() func(string) error {
+		validators := passkeyDescCredentialID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(credential_id string) error {
+			for _, fn := range fns {
+				if err := fn(credential_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// passkeyDescCredentialHash is the schema descriptor for credential_hash field.
+	passkeyDescCredentialHash := passkeyFields[6].Descriptor()
+	// passkey.CredentialHashValidator is a validator for the "credential_hash" field. It is called by the builders before save.
+	passkey.CredentialHashValidator = func() func(string) error {
+		validators := passkeyDescCredentialHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(credential_hash string) error {
+			for _, fn := range fns {
+				if err := fn(credential_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// passkeyDescPublicKey is the schema descriptor for public_key field.
+	passkeyDescPublicKey := passkeyFields[7].Descriptor()
+	// passkey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	passkey.PublicKeyValidator = passkeyDescPublicKey.Validators[0].(func([]byte) error)
+	// passkeyDescSignCount is the schema descriptor for sign_count field.
+	passkeyDescSignCount := passkeyFields[8].Descriptor()
+	// passkey.DefaultSignCount holds the default value on creation for the sign_count field.
+	passkey.DefaultSignCount = passkeyDescSignCount.Default.(uint32)
+	// passkeyDescAaguid is the schema descriptor for aaguid field.
+	passkeyDescAaguid := passkeyFields[9].Descriptor()
+	// passkey.AaguidValidator is a validator for the "aaguid" field. It is called by the builders before save.
+	passkey.AaguidValidator = passkeyDescAaguid.Validators[0].(func(string) error)
 	postcategoryMixin := schema.PostCategory{}.Mixin()
 	postcategoryMixinHooks0 := postcategoryMixin[0].Hooks()
 	postcategory.Hooks[0] = postcategoryMixinHooks0[0]
@@ -875,6 +1173,44 @@ func init() {
 	setting.DefaultUpdatedAt = settingDescUpdatedAt.Default.(func() time.Time)
 	// setting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	setting.UpdateDefaultUpdatedAt = settingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	shareFields := schema.Share{}.Fields()
+	_ = shareFields
+	// shareDescCreatedAt is the schema descriptor for created_at field.
+	shareDescCreatedAt := shareFields[1].Descriptor()
+	// share.DefaultCreatedAt holds the default value on creation for the created_at field.
+	share.DefaultCreatedAt = shareDescCreatedAt.Default.(func() time.Time)
+	// shareDescUpdatedAt is the schema descriptor for updated_at field.
+	shareDescUpdatedAt := shareFields[2].Descriptor()
+	// share.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	share.DefaultUpdatedAt = shareDescUpdatedAt.Default.(func() time.Time)
+	// share.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	share.UpdateDefaultUpdatedAt = shareDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// shareDescPassword is the schema descriptor for password field.
+	shareDescPassword := shareFields[5].Descriptor()
+	// share.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	share.PasswordValidator = shareDescPassword.Validators[0].(func(string) error)
+	// shareDescDownloadLimit is the schema descriptor for download_limit field.
+	shareDescDownloadLimit := shareFields[7].Descriptor()
+	// share.DefaultDownloadLimit holds the default value on creation for the download_limit field.
+	share.DefaultDownloadLimit = shareDescDownloadLimit.Default.(int)
+	// share.DownloadLimitValidator is a validator for the "download_limit" field. It is called by the builders before save.
+	share.DownloadLimitValidator = shareDescDownloadLimit.Validators[0].(func(int) error)
+	// shareDescDownloads is the schema descriptor for downloads field.
+	shareDescDownloads := shareFields[8].Descriptor()
+	// share.DefaultDownloads holds the default value on creation for the downloads field.
+	share.DefaultDownloads = shareDescDownloads.Default.(int)
+	// share.DownloadsValidator is a validator for the "downloads" field. It is called by the builders before save.
+	share.DownloadsValidator = shareDescDownloads.Validators[0].(func(int) error)
+	// shareDescViews is the schema descriptor for views field.
+	shareDescViews := shareFields[9].Descriptor()
+	// share.DefaultViews holds the default value on creation for the views field.
+	share.DefaultViews = shareDescViews.Default.(int)
+	// share.ViewsValidator is a validator for the "views" field. It is called by the builders before save.
+	share.ViewsValidator = shareDescViews.Validators[0].(func(int) error)
+	// shareDescPreviewOnly is the schema descriptor for preview_only field.
+	shareDescPreviewOnly := shareFields[10].Descriptor()
+	// share.DefaultPreviewOnly holds the default value on creation for the preview_only field.
+	share.DefaultPreviewOnly = shareDescPreviewOnly.Default.(bool)
 	storagepolicyMixin := schema.StoragePolicy{}.Mixin()
 	storagepolicyMixinHooks0 := storagepolicyMixin[0].Hooks()
 	storagepolicy.Hooks[0] = storagepolicyMixinHooks0[0]
@@ -1124,6 +1460,10 @@ func init() {
 	userDescStatus := userFields[10].Descriptor()
 	// user.DefaultStatus holds the default value on creation for the status field.
 	user.DefaultStatus = userDescStatus.Default.(int)
+	// userDescStorageUsed is the schema descriptor for storage_used field.
+	userDescStorageUsed := userFields[11].Descriptor()
+	// user.DefaultStorageUsed holds the default value on creation for the storage_used field.
+	user.DefaultStorageUsed = userDescStorageUsed.Default.(int64)
 	usergroupMixin := schema.UserGroup{}.Mixin()
 	usergroupMixinHooks0 := usergroupMixin[0].Hooks()
 	usergroup.Hooks[0] = usergroupMixinHooks0[0]
@@ -1173,6 +1513,66 @@ func init() {
 	usergroupDescSettings := usergroupFields[8].Descriptor()
 	// usergroup.DefaultSettings holds the default value on creation for the settings field.
 	usergroup.DefaultSettings = usergroupDescSettings.Default.(*model.GroupSettings)
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityFields[1].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
+	// useridentityDescUpdatedAt is the schema descriptor for updated_at field.
+	useridentityDescUpdatedAt := useridentityFields[2].Descriptor()
+	// useridentity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	useridentity.DefaultUpdatedAt = useridentityDescUpdatedAt.Default.(func() time.Time)
+	// useridentity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	useridentity.UpdateDefaultUpdatedAt = useridentityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// useridentityDescProvider is the schema descriptor for provider field.
+	useridentityDescProvider := useridentityFields[4].Descriptor()
+	// useridentity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	useridentity.ProviderValidator = func() func(string) error {
+		validators := useridentityDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[5].Descriptor()
+	// useridentity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	useridentity.SubjectValidator = func() func(string) error {
+		validators := useridentityDescSubject.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject string) error {
+			for _, fn := range fns {
+				if err := fn(subject); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// useridentityDescEmail is the schema descriptor for email field.
+	useridentityDescEmail := useridentityFields[6].Descriptor()
+	// useridentity.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	useridentity.EmailValidator = useridentityDescEmail.Validators[0].(func(string) error)
+	// useridentityDescDisplayName is the schema descriptor for display_name field.
+	useridentityDescDisplayName := useridentityFields[7].Descriptor()
+	// useridentity.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	useridentity.DisplayNameValidator = useridentityDescDisplayName.Validators[0].(func(string) error)
+	// useridentityDescAvatarURL is the schema descriptor for avatar_url field.
+	useridentityDescAvatarURL := useridentityFields[8].Descriptor()
+	// useridentity.AvatarURLValidator is a validator for the "avatar_url" field. It is called by the builders before save.
+	useridentity.AvatarURLValidator = useridentityDescAvatarURL.Validators[0].(func(string) error)
 	userinstalledthemeMixin := schema.UserInstalledTheme{}.Mixin()
 	userinstalledthemeMixinHooks0 := userinstalledthemeMixin[0].Hooks()
 	userinstalledtheme.Hooks[0] = userinstalledthemeMixinHooks0[0]
@@ -1238,6 +1638,90 @@ func init() {
 	usernotificationconfigDescNotificationEmail := usernotificationconfigFields[7].Descriptor()
 	// usernotificationconfig.NotificationEmailValidator is a validator for the "notification_email" field. It is called by the builders before save.
 	usernotificationconfig.NotificationEmailValidator = usernotificationconfigDescNotificationEmail.Validators[0].(func(string) error)
+	usersessionFields := schema.UserSession{}.Fields()
+	_ = usersessionFields
+	// usersessionDescCreatedAt is the schema descriptor for created_at field.
+	usersessionDescCreatedAt := usersessionFields[1].Descriptor()
+	// usersession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usersession.DefaultCreatedAt = usersessionDescCreatedAt.Default.(func() time.Time)
+	// usersessionDescUpdatedAt is the schema descriptor for updated_at field.
+	usersessionDescUpdatedAt := usersessionFields[2].Descriptor()
+	// usersession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usersession.DefaultUpdatedAt = usersessionDescUpdatedAt.Default.(func() time.Time)
+	// usersession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usersession.UpdateDefaultUpdatedAt = usersessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usersessionDescSessionID is the schema descriptor for session_id field.
+	usersessionDescSessionID := usersessionFields[4].Descriptor()
+	// usersession.SessionIDValidator is a validator for the "session_id" field. It is called by the builders before save.
+	usersession.SessionIDValidator = func() func(string) error {
+		validators := usersessionDescSessionID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(session_id string) error {
+			for _, fn := range fns {
+				if err := fn(session_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// usersessionDescDevice is the schema descriptor for device field.
+	usersessionDescDevice := usersessionFields[5].Descriptor()
+	// usersession.DeviceValidator is a validator for the "device" field. It is called by the builders before save.
+	usersession.DeviceValidator = usersessionDescDevice.Validators[0].(func(string) error)
+	// usersessionDescIP is the schema descriptor for ip field.
+	usersessionDescIP := usersessionFields[6].Descriptor()
+	// usersession.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	usersession.IPValidator = usersessionDescIP.Validators[0].(func(string) error)
+	// usersessionDescUserAgent is the schema descriptor for user_agent field.
+	usersessionDescUserAgent := usersessionFields[7].Descriptor()
+	// usersession.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	usersession.UserAgentValidator = usersessionDescUserAgent.Validators[0].(func(string) error)
+	// usersessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	usersessionDescLastSeenAt := usersessionFields[8].Descriptor()
+	// usersession.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	usersession.DefaultLastSeenAt = usersessionDescLastSeenAt.Default.(func() time.Time)
+	usertwofactorFields := schema.UserTwoFactor{}.Fields()
+	_ = usertwofactorFields
+	// usertwofactorDescCreatedAt is the schema descriptor for created_at field.
+	usertwofactorDescCreatedAt := usertwofactorFields[1].Descriptor()
+	// usertwofactor.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usertwofactor.DefaultCreatedAt = usertwofactorDescCreatedAt.Default.(func() time.Time)
+	// usertwofactorDescUpdatedAt is the schema descriptor for updated_at field.
+	usertwofactorDescUpdatedAt := usertwofactorFields[2].Descriptor()
+	// usertwofactor.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usertwofactor.DefaultUpdatedAt = usertwofactorDescUpdatedAt.Default.(func() time.Time)
+	// usertwofactor.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usertwofactor.UpdateDefaultUpdatedAt = usertwofactorDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usertwofactorDescSecret is the schema descriptor for secret field.
+	usertwofactorDescSecret := usertwofactorFields[4].Descriptor()
+	// usertwofactor.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	usertwofactor.SecretValidator = func() func(string) error {
+		validators := usertwofactorDescSecret.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(secret string) error {
+			for _, fn := range fns {
+				if err := fn(secret); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// usertwofactorDescEnabled is the schema descriptor for enabled field.
+	usertwofactorDescEnabled := usertwofactorFields[5].Descriptor()
+	// usertwofactor.DefaultEnabled holds the default value on creation for the enabled field.
+	usertwofactor.DefaultEnabled = usertwofactorDescEnabled.Default.(bool)
+	// usertwofactorDescLastUsedStep is the schema descriptor for last_used_step field.
+	usertwofactorDescLastUsedStep := usertwofactorFields[7].Descriptor()
+	// usertwofactor.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	usertwofactor.DefaultLastUsedStep = usertwofactorDescLastUsedStep.Default.(int64)
 	visitorlogFields := schema.VisitorLog{}.Fields()
 	_ = visitorlogFields
 	// visitorlogDescCreatedAt is the schema descriptor for created_at field.
@@ -1328,6 +1812,70 @@ func init() {
 	visitorstatDescBounceCount := visitorstatFields[7].Descriptor()
 	// visitorstat.DefaultBounceCount holds the default value on creation for the bounce_count field.
 	visitorstat.DefaultBounceCount = visitorstatDescBounceCount.Default.(int64)
+	webmentionFields := schema.Webmention{}.Fields()
+	_ = webmentionFields
+	// webmentionDescCreatedAt is the schema descriptor for created_at field.
+	webmentionDescCreatedAt := webmentionFields[1].Descriptor()
+	// webmention.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webmention.DefaultCreatedAt = webmentionDescCreatedAt.Default.(func() time.Time)
+	// webmentionDescUpdatedAt is the schema descriptor for updated_at field.
+	webmentionDescUpdatedAt := webmentionFields[2].Descriptor()
+	// webmention.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webmention.DefaultUpdatedAt = webmentionDescUpdatedAt.Default.(func() time.Time)
+	// webmention.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webmention.UpdateDefaultUpdatedAt = webmentionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webmentionDescSource is the schema descriptor for source field.
+	webmentionDescSource := webmentionFields[3].Descriptor()
+	// webmention.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	webmention.SourceValidator = func() func(string) error {
+		validators := webmentionDescSource.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(source string) error {
+			for _, fn := range fns {
+				if err := fn(source); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webmentionDescTarget is the schema descriptor for target field.
+	webmentionDescTarget := webmentionFields[4].Descriptor()
+	// webmention.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	webmention.TargetValidator = func() func(string) error {
+		validators := webmentionDescTarget.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(target string) error {
+			for _, fn := range fns {
+				if err := fn(target); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webmentionDescTargetPath is the schema descriptor for target_path field.
+	webmentionDescTargetPath := webmentionFields[5].Descriptor()
+	// webmention.TargetPathValidator is a validator for the "target_path" field. It is called by the builders before save.
+	webmention.TargetPathValidator = webmentionDescTargetPath.Validators[0].(func(string) error)
+	// webmentionDescAuthorName is the schema descriptor for author_name field.
+	webmentionDescAuthorName := webmentionFields[8].Descriptor()
+	// webmention.AuthorNameValidator is a validator for the "author_name" field. It is called by the builders before save.
+	webmention.AuthorNameValidator = webmentionDescAuthorName.Validators[0].(func(string) error)
+	// webmentionDescAuthorURL is the schema descriptor for author_url field.
+	webmentionDescAuthorURL := webmentionFields[9].Descriptor()
+	// webmention.AuthorURLValidator is a validator for the "author_url" field. It is called by the builders before save.
+	webmention.AuthorURLValidator = webmentionDescAuthorURL.Validators[0].(func(string) error)
+	// webmentionDescAuthorPhoto is the schema descriptor for author_photo field.
+	webmentionDescAuthorPhoto := webmentionFields[10].Descriptor()
+	// webmention.AuthorPhotoValidator is a validator for the "author_photo" field. It is called by the builders before save.
+	webmention.AuthorPhotoValidator = webmentionDescAuthorPhoto.Validators[0].(func(string) error)
 }
 
 const (
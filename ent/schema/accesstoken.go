@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccessToken 定义了用户创建的个人访问令牌，用于 CI 等自动化场景调用 API。
+type AccessToken struct {
+	ent.Schema
+}
+
+// Annotations of the AccessToken.
+func (AccessToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("个人访问令牌表"),
+	}
+}
+
+// Fields of the AccessToken.
+func (AccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+		field.Uint("user_id").
+			Comment("所属用户ID"),
+		field.String("name").
+			MaxLen(64).
+			Comment("令牌名称，用于区分用途"),
+		field.String("token_hash").
+			NotEmpty().
+			MaxLen(64).
+			Immutable().
+			Sensitive().
+			Comment("令牌的 SHA-256 哈希，明文只在创建时返回一次"),
+		field.String("token_prefix").
+			MaxLen(16).
+			Immutable().
+			Comment("令牌明文的前几位，用于在列表中辨认"),
+		field.JSON("scopes", []string{}).
+			Comment("授权范围"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("过期时间，为空表示永不过期"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("最近一次使用时间"),
+		field.String("last_used_ip").
+			Optional().
+			MaxLen(64).
+			Comment("最近一次使用的IP"),
+	}
+}
+
+// Edges of the AccessToken.
+func (AccessToken) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AccessToken.
+func (AccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token_hash").Unique(),
+		index.Fields("user_id"),
+	}
+}
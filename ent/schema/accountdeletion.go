@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccountDeletion 定义了用户发起的账户注销申请，宽限期结束后由定时任务执行注销。
+type AccountDeletion struct {
+	ent.Schema
+}
+
+// Annotations of the AccountDeletion.
+func (AccountDeletion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("账户注销申请表"),
+	}
+}
+
+// Fields of the AccountDeletion.
+func (AccountDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("申请时间"),
+		field.Uint("user_id").
+			Immutable().
+			Comment("申请注销的用户ID"),
+		field.Enum("content_mode").
+			Values("anonymize", "delete").
+			Default("anonymize").
+			Comment("已发布内容的处理方式：anonymize-匿名化保留, delete-一并删除"),
+		field.Time("scheduled_at").
+			Comment("计划执行注销的时间"),
+	}
+}
+
+// Edges of the AccountDeletion.
+func (AccountDeletion) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AccountDeletion.
+func (AccountDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").Unique(),
+		index.Fields("scheduled_at"),
+	}
+}
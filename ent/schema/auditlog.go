@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog 定义了后台敏感操作的审计日志，记录由谁、从哪里、对什么做了什么。
+type AuditLog struct {
+	ent.Schema
+}
+
+// Annotations of the AuditLog.
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("审计日志表"),
+	}
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("操作时间"),
+		field.Uint("actor_id").
+			Default(0).
+			Immutable().
+			Comment("操作者用户ID，0 表示系统或未登录"),
+		field.String("actor_name").
+			Optional().
+			MaxLen(100).
+			Immutable().
+			Comment("操作者名称快照，用户被删除后仍可追溯"),
+		field.String("ip").
+			Optional().
+			MaxLen(64).
+			Immutable().
+			Comment("操作者IP"),
+		field.String("user_agent").
+			Optional().
+			MaxLen(512).
+			Immutable().
+			Comment("操作者 User-Agent"),
+		field.String("action").
+			NotEmpty().
+			MaxLen(64).
+			Immutable().
+			Comment("操作类型，例如 setting.update、user.delete"),
+		field.String("target_type").
+			Optional().
+			MaxLen(32).
+			Immutable().
+			Comment("操作对象类型，例如 setting、user、article"),
+		field.String("target_id").
+			Optional().
+			MaxLen(255).
+			Immutable().
+			Comment("操作对象ID，可能是公共ID、键名或以逗号分隔的多个ID"),
+		field.String("summary").
+			Optional().
+			MaxLen(512).
+			Immutable().
+			Comment("操作摘要"),
+		field.JSON("changes", map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("变更内容，格式为 {字段: {before, after}}，敏感字段已脱敏"),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("actor_id"),
+		index.Fields("action"),
+		index.Fields("target_type", "target_id"),
+	}
+}
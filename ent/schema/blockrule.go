@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BlockRule 定义了黑名单规则实体，用于拦截恶意评论者、友链申请者和订阅者。
+type BlockRule struct {
+	ent.Schema
+}
+
+// Annotations of the BlockRule.
+func (BlockRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("黑名单规则表"),
+	}
+}
+
+// Fields of the BlockRule.
+func (BlockRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+		field.Enum("type").
+			Values("ip", "email", "email_md5", "user_agent", "user").
+			Comment("规则类型: ip(IP或CIDR网段), email(邮箱), email_md5(邮箱MD5), user_agent(UA正则), user(用户公共ID)"),
+		field.String("value").
+			NotEmpty().
+			MaxLen(512).
+			Comment("规则内容"),
+		field.String("reason").
+			Optional().
+			MaxLen(255).
+			Comment("封禁原因"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("过期时间，为NULL表示永久有效"),
+	}
+}
+
+// Edges of the BlockRule.
+func (BlockRule) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the BlockRule.
+func (BlockRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("type", "value"),
+		index.Fields("expires_at"),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/ent/schema/mixin"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
@@ -136,6 +137,15 @@ func (Comment) Fields() []ent.Field {
 			Comment("评论置顶时间，为NULL表示未置顶").
 			Optional().
 			Nillable(),
+
+		// --- 作者自助编辑 ---
+		field.Time("edited_at").
+			Optional().
+			Nillable().
+			Comment("作者最后一次自助编辑的时间，为NULL表示未编辑过"),
+		field.JSON("edit_history", []model.CommentEditRecord{}).
+			Optional().
+			Comment("作者自助编辑的历史记录 (仅管理员可见)"),
 	}
 }
 
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CommentThread 定义了评论区（按 target_path 区分）的开关状态。
+// 没有记录的路径使用默认规则（开放，或按全局设置自动关闭），有记录时以记录为准。
+type CommentThread struct {
+	ent.Schema
+}
+
+// Annotations of the CommentThread.
+func (CommentThread) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("评论区状态表"),
+	}
+}
+
+// Fields of the CommentThread.
+func (CommentThread) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+		field.String("target_path").
+			NotEmpty().
+			MaxLen(255).
+			Comment("评论区对应的目标路径，与评论的 target_path 一致"),
+		field.Enum("state").
+			Values("open", "closed", "readonly").
+			Default("open").
+			Comment("评论区状态: open(开放), closed(关闭并隐藏已有评论), readonly(只读，保留已有评论但不再接受新评论)"),
+	}
+}
+
+// Edges of the CommentThread.
+func (CommentThread) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the CommentThread.
+func (CommentThread) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_path").Unique(),
+	}
+}
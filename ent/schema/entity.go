@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Entity holds the schema definition for the Entity entity.
@@ -87,6 +88,21 @@ func (Entity) Fields() []ent.Field {
 			}).
 			Optional().
 			Comment("存储提供者特有的额外元数据"),
+		field.String("content_hash").
+			MaxLen(64).
+			Optional().
+			Nillable().
+			Comment("文件内容的 SHA-256 哈希，用于同一存储策略内的内容去重"),
+		field.Int("reference_count").
+			Default(1).
+			Comment("引用此实体的文件数量，最后一个引用释放时才删除物理文件"),
+	}
+}
+
+// Indexes of the Entity.
+func (Entity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("policy_id", "content_hash"),
 	}
 }
 
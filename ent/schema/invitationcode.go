@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InvitationCode 定义了注册邀请码，关闭公开注册时仍可以凭邀请码注册。
+type InvitationCode struct {
+	ent.Schema
+}
+
+// Annotations of the InvitationCode.
+func (InvitationCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("注册邀请码表"),
+	}
+}
+
+// Fields of the InvitationCode.
+func (InvitationCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+		field.String("code").
+			NotEmpty().
+			MaxLen(32).
+			Immutable().
+			Comment("邀请码"),
+		field.Uint("creator_id").
+			Immutable().
+			Comment("创建者用户ID"),
+		field.Uint("user_group_id").
+			Comment("使用该邀请码注册的用户所属的用户组ID"),
+		field.Int("max_uses").
+			Default(1).
+			NonNegative().
+			Comment("最大使用次数，0 表示不限制"),
+		field.Int("used_count").
+			Default(0).
+			NonNegative().
+			Comment("已使用次数"),
+		field.String("email").
+			Optional().
+			MaxLen(255).
+			Comment("限定使用的邮箱，为空表示任何邮箱都可以使用"),
+		field.String("note").
+			Optional().
+			MaxLen(255).
+			Comment("备注"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("过期时间，为空表示永不过期"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("撤销时间，不为空表示已撤销"),
+	}
+}
+
+// Edges of the InvitationCode.
+func (InvitationCode) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the InvitationCode.
+func (InvitationCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code").Unique(),
+		index.Fields("creator_id"),
+	}
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Passkey 定义了用户注册的 WebAuthn 通行密钥。
+type Passkey struct {
+	ent.Schema
+}
+
+// Annotations of the Passkey.
+func (Passkey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("通行密钥表"),
+	}
+}
+
+// Fields of the Passkey.
+func (Passkey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+		field.Uint("user_id").
+			Comment("所属用户ID"),
+		field.String("name").
+			MaxLen(64).
+			Comment("用户为通行密钥设置的名称"),
+		field.String("credential_id").
+			NotEmpty().
+			MaxLen(1400).
+			Immutable().
+			Comment("凭证ID (base64url)"),
+		field.String("credential_hash").
+			NotEmpty().
+			MaxLen(64).
+			Immutable().
+			Comment("凭证ID的 SHA-256 哈希，凭证ID可能很长，用于建立唯一索引"),
+		field.Bytes("public_key").
+			NotEmpty().
+			Immutable().
+			Comment("COSE 编码的凭证公钥"),
+		field.Uint32("sign_count").
+			Default(0).
+			Comment("认证器签名计数，用于发现被克隆的认证器"),
+		field.String("aaguid").
+			Optional().
+			MaxLen(36).
+			Immutable().
+			Comment("认证器型号标识"),
+		field.JSON("transports", []string{}).
+			Optional().
+			Comment("认证器支持的传输方式"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("最近一次用于登录的时间"),
+	}
+}
+
+// Edges of the Passkey.
+func (Passkey) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Passkey.
+func (Passkey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("credential_hash").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Share 定义了文件或文件夹的公开分享链接。
+type Share struct {
+	ent.Schema
+}
+
+// Annotations of the Share.
+func (Share) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("文件分享表"),
+	}
+}
+
+// Fields of the Share.
+func (Share) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+		field.Uint("owner_id").
+			Immutable().
+			Comment("分享者用户ID"),
+		field.Uint("file_id").
+			Immutable().
+			Comment("被分享的文件或文件夹ID"),
+		field.String("password").
+			Optional().
+			MaxLen(32).
+			Comment("访问密码，为空表示公开访问"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("过期时间，为空表示永不过期"),
+		field.Int("download_limit").
+			Default(0).
+			NonNegative().
+			Comment("最大下载次数，0 表示不限制"),
+		field.Int("downloads").
+			Default(0).
+			NonNegative().
+			Comment("已下载次数"),
+		field.Int("views").
+			Default(0).
+			NonNegative().
+			Comment("浏览次数"),
+		field.Bool("preview_only").
+			Default(false).
+			Comment("是否仅允许预览，不允许下载"),
+	}
+}
+
+// Edges of the Share.
+func (Share) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Share.
+func (Share) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id"),
+		index.Fields("file_id"),
+	}
+}
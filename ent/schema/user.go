@@ -81,6 +81,9 @@ func (User) Fields() []ent.Field {
 		field.Int("status").
 			Default(2).
 			Comment("用户状态 1:正常 2:未激活 3:已封禁"),
+		field.Int64("storage_used").
+			Default(0).
+			Comment("已使用的存储容量（字节），在文件创建和删除时增量维护"),
 	}
 }
 
@@ -64,7 +64,7 @@ func (UserGroup) Fields() []ent.Field {
 			Comment("用户组的最大存储容量（字节）, 0为不限制"),
 		field.Int64("speed_limit").
 			Default(0).
-			Comment("用户组的最大上传速度（字节/秒）, 0为不限制"),
+			Comment("用户组的下载限速（字节/秒）, 0为不限制"),
 		field.Other("settings", &model.GroupSettings{}).
 			Default(&model.GroupSettings{}).
 			SchemaType(map[string]string{
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserIdentity 定义了用户绑定的第三方登录账号 (OAuth2 / OIDC)。
+type UserIdentity struct {
+	ent.Schema
+}
+
+// Annotations of the UserIdentity.
+func (UserIdentity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("第三方登录账号表"),
+	}
+}
+
+// Fields of the UserIdentity.
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+		field.Uint("user_id").
+			Comment("所属用户ID"),
+		field.String("provider").
+			NotEmpty().
+			MaxLen(50).
+			Comment("登录提供方标识，对应设置中配置的 provider id"),
+		field.String("subject").
+			NotEmpty().
+			MaxLen(255).
+			Comment("提供方返回的用户唯一标识"),
+		field.String("email").
+			Optional().
+			MaxLen(255).
+			Comment("提供方返回的邮箱"),
+		field.String("display_name").
+			Optional().
+			MaxLen(100).
+			Comment("提供方返回的昵称"),
+		field.String("avatar_url").
+			Optional().
+			MaxLen(512).
+			Comment("提供方返回的头像"),
+		field.Time("last_login_at").
+			Optional().
+			Nillable().
+			Comment("最近一次通过该账号登录的时间"),
+	}
+}
+
+// Edges of the UserIdentity.
+func (UserIdentity) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserIdentity.
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserSession 定义了用户的登录会话，每个会话对应一个刷新令牌。
+type UserSession struct {
+	ent.Schema
+}
+
+// Annotations of the UserSession.
+func (UserSession) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("登录会话表"),
+	}
+}
+
+// Fields of the UserSession.
+func (UserSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间，即登录时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+		field.Uint("user_id").
+			Comment("所属用户ID"),
+		field.String("session_id").
+			NotEmpty().
+			MaxLen(64).
+			Immutable().
+			Comment("会话标识，写入刷新令牌和访问令牌中"),
+		field.String("device").
+			Optional().
+			MaxLen(64).
+			Comment("根据 User-Agent 识别的设备描述"),
+		field.String("ip").
+			Optional().
+			MaxLen(64).
+			Comment("最近一次使用的IP"),
+		field.String("user_agent").
+			Optional().
+			MaxLen(512).
+			Comment("最近一次使用的 User-Agent"),
+		field.Time("last_seen_at").
+			Default(time.Now).
+			Comment("最近一次刷新令牌的时间"),
+		field.Time("expires_at").
+			Comment("会话过期时间，与刷新令牌一致"),
+	}
+}
+
+// Edges of the UserSession.
+func (UserSession) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserSession.
+func (UserSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
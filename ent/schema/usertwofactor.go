@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserTwoFactor 定义了用户的 TOTP 两步验证配置。
+type UserTwoFactor struct {
+	ent.Schema
+}
+
+// Annotations of the UserTwoFactor.
+func (UserTwoFactor) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("用户两步验证表"),
+	}
+}
+
+// Fields of the UserTwoFactor.
+func (UserTwoFactor) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+		field.Uint("user_id").
+			Comment("所属用户ID"),
+		field.String("secret").
+			NotEmpty().
+			MaxLen(64).
+			Sensitive().
+			Comment("TOTP 密钥 (Base32)"),
+		field.Bool("enabled").
+			Default(false).
+			Comment("是否已完成绑定并启用，未启用的记录表示绑定尚未确认"),
+		field.JSON("recovery_codes", []string{}).
+			Optional().
+			Sensitive().
+			Comment("未使用的恢复码 (SHA-256 哈希)"),
+		field.Int64("last_used_step").
+			Default(0).
+			Comment("最近一次成功使用的 TOTP 时间步，用于防止验证码重放"),
+		field.Time("enabled_at").
+			Optional().
+			Nillable().
+			Comment("启用时间"),
+	}
+}
+
+// Edges of the UserTwoFactor.
+func (UserTwoFactor) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserTwoFactor.
+func (UserTwoFactor) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").Unique(),
+	}
+}
@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Webmention 定义了接收到的 Webmention 实体，作为类似评论的互动挂载在文章的 target_path 下。
+type Webmention struct {
+	ent.Schema
+}
+
+// Annotations of the Webmention.
+func (Webmention) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Webmention 表"),
+	}
+}
+
+// Fields of the Webmention.
+func (Webmention) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("id"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+		field.String("source").
+			NotEmpty().
+			MaxLen(1024).
+			Comment("提及我们的来源页面URL"),
+		field.String("target").
+			NotEmpty().
+			MaxLen(1024).
+			Comment("被提及的本站文章URL"),
+		field.String("target_path").
+			MaxLen(255).
+			Comment("被提及文章的路径 (例如 /posts/some-slug)，与评论的 target_path 一致"),
+		field.Enum("type").
+			Values("mention", "like", "repost", "reply").
+			Default("mention").
+			Comment("互动类型: mention(提及), like(喜欢), repost(转发), reply(回复)"),
+		field.Enum("status").
+			Values("pending", "verified", "rejected").
+			Default("pending").
+			Comment("校验状态: pending(待校验), verified(已校验), rejected(来源页未链接到目标)"),
+		field.String("author_name").
+			Optional().
+			MaxLen(100).
+			Comment("来源页作者名称 (解析自 h-card)"),
+		field.String("author_url").
+			Optional().
+			MaxLen(1024).
+			Comment("来源页作者主页"),
+		field.String("author_photo").
+			Optional().
+			MaxLen(1024).
+			Comment("来源页作者头像"),
+		field.Text("content").
+			Optional().
+			Comment("回复或提及的纯文本摘要"),
+		field.Time("published_at").
+			Optional().
+			Nillable().
+			Comment("来源页声明的发布时间"),
+		field.Time("verified_at").
+			Optional().
+			Nillable().
+			Comment("最近一次校验通过的时间"),
+	}
+}
+
+// Edges of the Webmention.
+func (Webmention) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Webmention.
+func (Webmention) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一来源对同一目标只保留一条记录，重复发送视为更新
+		index.Fields("source", "target").Unique(),
+		index.Fields("target_path", "status"),
+	}
+}
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/share"
+)
+
+// 文件分享表
+type Share struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 分享者用户ID
+	OwnerID uint `json:"owner_id,omitempty"`
+	// 被分享的文件或文件夹ID
+	FileID uint `json:"file_id,omitempty"`
+	// 访问密码，为空表示公开访问
+	Password string `json:"password,omitempty"`
+	// 过期时间，为空表示永不过期
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// 最大下载次数，0 表示不限制
+	DownloadLimit int `json:"download_limit,omitempty"`
+	// 已下载次数
+	Downloads int `json:"downloads,omitempty"`
+	// 浏览次数
+	Views int `json:"views,omitempty"`
+	// 是否仅允许预览，不允许下载
+	PreviewOnly  bool `json:"preview_only,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Share) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case share.FieldPreviewOnly:
+			values[i] = new(sql.NullBool)
+		case share.FieldID, share.FieldOwnerID, share.FieldFileID, share.FieldDownloadLimit, share.FieldDownloads, share.FieldViews:
+			values[i] = new(sql.NullInt64)
+		case share.FieldPassword:
+			values[i] = new(sql.NullString)
+		case share.FieldCreatedAt, share.FieldUpdatedAt, share.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Share fields.
+func (s *Share) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case share.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = uint(value.Int64)
+		case share.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case share.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case share.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				s.OwnerID = uint(value.Int64)
+			}
+		case share.FieldFileID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				s.FileID = uint(value.Int64)
+			}
+		case share.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				s.Password = value.String
+			}
+		case share.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				s.ExpiresAt = new(time.Time)
+				*s.ExpiresAt = value.Time
+			}
+		case share.FieldDownloadLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field download_limit", values[i])
+			} else if value.Valid {
+				s.DownloadLimit = int(value.Int64)
+			}
+		case share.FieldDownloads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field downloads", values[i])
+			} else if value.Valid {
+				s.Downloads = int(value.Int64)
+			}
+		case share.FieldViews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field views", values[i])
+			} else if value.Valid {
+				s.Views = int(value.Int64)
+			}
+		case share.FieldPreviewOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field preview_only", values[i])
+			} else if value.Valid {
+				s.PreviewOnly = value.Bool
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Share.
+// This includes values selected through modifiers, order, etc.
+func (s *Share) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Share.
+// Note that you need to call Share.Unwrap() before calling this method if this Share
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Share) Update() *ShareUpdateOne {
+	return NewShareClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Share entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Share) Unwrap() *Share {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Share is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Share) String() string {
+	var builder strings.Builder
+	builder.WriteString("Share(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("file_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.FileID))
+	builder.WriteString(", ")
+	builder.WriteString("password=")
+	builder.WriteString(s.Password)
+	builder.WriteString(", ")
+	if v := s.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("download_limit=")
+	builder.WriteString(fmt.Sprintf("%v", s.DownloadLimit))
+	builder.WriteString(", ")
+	builder.WriteString("downloads=")
+	builder.WriteString(fmt.Sprintf("%v", s.Downloads))
+	builder.WriteString(", ")
+	builder.WriteString("views=")
+	builder.WriteString(fmt.Sprintf("%v", s.Views))
+	builder.WriteString(", ")
+	builder.WriteString("preview_only=")
+	builder.WriteString(fmt.Sprintf("%v", s.PreviewOnly))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Shares is a parsable slice of Share.
+type Shares []*Share
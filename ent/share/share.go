@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package share
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the share type in the database.
+	Label = "share"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldFileID holds the string denoting the file_id field in the database.
+	FieldFileID = "file_id"
+	// FieldPassword holds the string denoting the password field in the database.
+	FieldPassword = "password"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldDownloadLimit holds the string denoting the download_limit field in the database.
+	FieldDownloadLimit = "download_limit"
+	// FieldDownloads holds the string denoting the downloads field in the database.
+	FieldDownloads = "downloads"
+	// FieldViews holds the string denoting the views field in the database.
+	FieldViews = "views"
+	// FieldPreviewOnly holds the string denoting the preview_only field in the database.
+	FieldPreviewOnly = "preview_only"
+	// Table holds the table name of the share in the database.
+	Table = "shares"
+)
+
+// Columns holds all SQL columns for share fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldOwnerID,
+	FieldFileID,
+	FieldPassword,
+	FieldExpiresAt,
+	FieldDownloadLimit,
+	FieldDownloads,
+	FieldViews,
+	FieldPreviewOnly,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	PasswordValidator func(string) error
+	// DefaultDownloadLimit holds the default value on creation for the "download_limit" field.
+	DefaultDownloadLimit int
+	// DownloadLimitValidator is a validator for the "download_limit" field. It is called by the builders before save.
+	DownloadLimitValidator func(int) error
+	// DefaultDownloads holds the default value on creation for the "downloads" field.
+	DefaultDownloads int
+	// DownloadsValidator is a validator for the "downloads" field. It is called by the builders before save.
+	DownloadsValidator func(int) error
+	// DefaultViews holds the default value on creation for the "views" field.
+	DefaultViews int
+	// ViewsValidator is a validator for the "views" field. It is called by the builders before save.
+	ViewsValidator func(int) error
+	// DefaultPreviewOnly holds the default value on creation for the "preview_only" field.
+	DefaultPreviewOnly bool
+)
+
+// OrderOption defines the ordering options for the Share queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByFileID orders the results by the file_id field.
+func ByFileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileID, opts...).ToFunc()
+}
+
+// ByPassword orders the results by the password field.
+func ByPassword(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassword, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByDownloadLimit orders the results by the download_limit field.
+func ByDownloadLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadLimit, opts...).ToFunc()
+}
+
+// ByDownloads orders the results by the downloads field.
+func ByDownloads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloads, opts...).ToFunc()
+}
+
+// ByViews orders the results by the views field.
+func ByViews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldViews, opts...).ToFunc()
+}
+
+// ByPreviewOnly orders the results by the preview_only field.
+func ByPreviewOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviewOnly, opts...).ToFunc()
+}
@@ -0,0 +1,1068 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/share"
+)
+
+// ShareCreate is the builder for creating a Share entity.
+type ShareCreate struct {
+	config
+	mutation *ShareMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ShareCreate) SetCreatedAt(t time.Time) *ShareCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableCreatedAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *ShareCreate) SetUpdatedAt(t time.Time) *ShareCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableUpdatedAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (sc *ShareCreate) SetOwnerID(u uint) *ShareCreate {
+	sc.mutation.SetOwnerID(u)
+	return sc
+}
+
+// SetFileID sets the "file_id" field.
+func (sc *ShareCreate) SetFileID(u uint) *ShareCreate {
+	sc.mutation.SetFileID(u)
+	return sc
+}
+
+// SetPassword sets the "password" field.
+func (sc *ShareCreate) SetPassword(s string) *ShareCreate {
+	sc.mutation.SetPassword(s)
+	return sc
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (sc *ShareCreate) SetNillablePassword(s *string) *ShareCreate {
+	if s != nil {
+		sc.SetPassword(*s)
+	}
+	return sc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sc *ShareCreate) SetExpiresAt(t time.Time) *ShareCreate {
+	sc.mutation.SetExpiresAt(t)
+	return sc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableExpiresAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetExpiresAt(*t)
+	}
+	return sc
+}
+
+// SetDownloadLimit sets the "download_limit" field.
+func (sc *ShareCreate) SetDownloadLimit(i int) *ShareCreate {
+	sc.mutation.SetDownloadLimit(i)
+	return sc
+}
+
+// SetNillableDownloadLimit sets the "download_limit" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableDownloadLimit(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetDownloadLimit(*i)
+	}
+	return sc
+}
+
+// SetDownloads sets the "downloads" field.
+func (sc *ShareCreate) SetDownloads(i int) *ShareCreate {
+	sc.mutation.SetDownloads(i)
+	return sc
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableDownloads(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetDownloads(*i)
+	}
+	return sc
+}
+
+// SetViews sets the "views" field.
+func (sc *ShareCreate) SetViews(i int) *ShareCreate {
+	sc.mutation.SetViews(i)
+	return sc
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableViews(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetViews(*i)
+	}
+	return sc
+}
+
+// SetPreviewOnly sets the "preview_only" field.
+func (sc *ShareCreate) SetPreviewOnly(b bool) *ShareCreate {
+	sc.mutation.SetPreviewOnly(b)
+	return sc
+}
+
+// SetNillablePreviewOnly sets the "preview_only" field if the given value is not nil.
+func (sc *ShareCreate) SetNillablePreviewOnly(b *bool) *ShareCreate {
+	if b != nil {
+		sc.SetPreviewOnly(*b)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *ShareCreate) SetID(u uint) *ShareCreate {
+	sc.mutation.SetID(u)
+	return sc
+}
+
+// Mutation returns the ShareMutation object of the builder.
+func (sc *ShareCreate) Mutation() *ShareMutation {
+	return sc.mutation
+}
+
+// Save creates the Share in the database.
+func (sc *ShareCreate) Save(ctx context.Context) (*Share, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ShareCreate) SaveX(ctx context.Context) *Share {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ShareCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ShareCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ShareCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := share.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := share.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.DownloadLimit(); !ok {
+		v := share.DefaultDownloadLimit
+		sc.mutation.SetDownloadLimit(v)
+	}
+	if _, ok := sc.mutation.Downloads(); !ok {
+		v := share.DefaultDownloads
+		sc.mutation.SetDownloads(v)
+	}
+	if _, ok := sc.mutation.Views(); !ok {
+		v := share.DefaultViews
+		sc.mutation.SetViews(v)
+	}
+	if _, ok := sc.mutation.PreviewOnly(); !ok {
+		v := share.DefaultPreviewOnly
+		sc.mutation.SetPreviewOnly(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ShareCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Share.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Share.updated_at"`)}
+	}
+	if _, ok := sc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Share.owner_id"`)}
+	}
+	if _, ok := sc.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "Share.file_id"`)}
+	}
+	if v, ok := sc.mutation.Password(); ok {
+		if err := share.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "Share.password": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.DownloadLimit(); !ok {
+		return &ValidationError{Name: "download_limit", err: errors.New(`ent: missing required field "Share.download_limit"`)}
+	}
+	if v, ok := sc.mutation.DownloadLimit(); ok {
+		if err := share.DownloadLimitValidator(v); err != nil {
+			return &ValidationError{Name: "download_limit", err: fmt.Errorf(`ent: validator failed for field "Share.download_limit": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Downloads(); !ok {
+		return &ValidationError{Name: "downloads", err: errors.New(`ent: missing required field "Share.downloads"`)}
+	}
+	if v, ok := sc.mutation.Downloads(); ok {
+		if err := share.DownloadsValidator(v); err != nil {
+			return &ValidationError{Name: "downloads", err: fmt.Errorf(`ent: validator failed for field "Share.downloads": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Views(); !ok {
+		return &ValidationError{Name: "views", err: errors.New(`ent: missing required field "Share.views"`)}
+	}
+	if v, ok := sc.mutation.Views(); ok {
+		if err := share.ViewsValidator(v); err != nil {
+			return &ValidationError{Name: "views", err: fmt.Errorf(`ent: validator failed for field "Share.views": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.PreviewOnly(); !ok {
+		return &ValidationError{Name: "preview_only", err: errors.New(`ent: missing required field "Share.preview_only"`)}
+	}
+	return nil
+}
+
+func (sc *ShareCreate) sqlSave(ctx context.Context) (*Share, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *ShareCreate) createSpec() (*Share, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Share{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(share.Table, sqlgraph.NewFieldSpec(share.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = sc.conflict
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(share.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(share.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.OwnerID(); ok {
+		_spec.SetField(share.FieldOwnerID, field.TypeUint, value)
+		_node.OwnerID = value
+	}
+	if value, ok := sc.mutation.FileID(); ok {
+		_spec.SetField(share.FieldFileID, field.TypeUint, value)
+		_node.FileID = value
+	}
+	if value, ok := sc.mutation.Password(); ok {
+		_spec.SetField(share.FieldPassword, field.TypeString, value)
+		_node.Password = value
+	}
+	if value, ok := sc.mutation.ExpiresAt(); ok {
+		_spec.SetField(share.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := sc.mutation.DownloadLimit(); ok {
+		_spec.SetField(share.FieldDownloadLimit, field.TypeInt, value)
+		_node.DownloadLimit = value
+	}
+	if value, ok := sc.mutation.Downloads(); ok {
+		_spec.SetField(share.FieldDownloads, field.TypeInt, value)
+		_node.Downloads = value
+	}
+	if value, ok := sc.mutation.Views(); ok {
+		_spec.SetField(share.FieldViews, field.TypeInt, value)
+		_node.Views = value
+	}
+	if value, ok := sc.mutation.PreviewOnly(); ok {
+		_spec.SetField(share.FieldPreviewOnly, field.TypeBool, value)
+		_node.PreviewOnly = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Share.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShareUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *ShareCreate) OnConflict(opts ...sql.ConflictOption) *ShareUpsertOne {
+	sc.conflict = opts
+	return &ShareUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *ShareCreate) OnConflictColumns(columns ...string) *ShareUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &ShareUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// ShareUpsertOne is the builder for "upsert"-ing
+	//  one Share node.
+	ShareUpsertOne struct {
+		create *ShareCreate
+	}
+
+	// ShareUpsert is the "OnConflict" setter.
+	ShareUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShareUpsert) SetUpdatedAt(v time.Time) *ShareUpsert {
+	u.Set(share.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateUpdatedAt() *ShareUpsert {
+	u.SetExcluded(share.FieldUpdatedAt)
+	return u
+}
+
+// SetPassword sets the "password" field.
+func (u *ShareUpsert) SetPassword(v string) *ShareUpsert {
+	u.Set(share.FieldPassword, v)
+	return u
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *ShareUpsert) UpdatePassword() *ShareUpsert {
+	u.SetExcluded(share.FieldPassword)
+	return u
+}
+
+// ClearPassword clears the value of the "password" field.
+func (u *ShareUpsert) ClearPassword() *ShareUpsert {
+	u.SetNull(share.FieldPassword)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ShareUpsert) SetExpiresAt(v time.Time) *ShareUpsert {
+	u.Set(share.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateExpiresAt() *ShareUpsert {
+	u.SetExcluded(share.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ShareUpsert) ClearExpiresAt() *ShareUpsert {
+	u.SetNull(share.FieldExpiresAt)
+	return u
+}
+
+// SetDownloadLimit sets the "download_limit" field.
+func (u *ShareUpsert) SetDownloadLimit(v int) *ShareUpsert {
+	u.Set(share.FieldDownloadLimit, v)
+	return u
+}
+
+// UpdateDownloadLimit sets the "download_limit" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateDownloadLimit() *ShareUpsert {
+	u.SetExcluded(share.FieldDownloadLimit)
+	return u
+}
+
+// AddDownloadLimit adds v to the "download_limit" field.
+func (u *ShareUpsert) AddDownloadLimit(v int) *ShareUpsert {
+	u.Add(share.FieldDownloadLimit, v)
+	return u
+}
+
+// SetDownloads sets the "downloads" field.
+func (u *ShareUpsert) SetDownloads(v int) *ShareUpsert {
+	u.Set(share.FieldDownloads, v)
+	return u
+}
+
+// UpdateDownloads sets the "downloads" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateDownloads() *ShareUpsert {
+	u.SetExcluded(share.FieldDownloads)
+	return u
+}
+
+// AddDownloads adds v to the "downloads" field.
+func (u *ShareUpsert) AddDownloads(v int) *ShareUpsert {
+	u.Add(share.FieldDownloads, v)
+	return u
+}
+
+// SetViews sets the "views" field.
+func (u *ShareUpsert) SetViews(v int) *ShareUpsert {
+	u.Set(share.FieldViews, v)
+	return u
+}
+
+// UpdateViews sets the "views" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateViews() *ShareUpsert {
+	u.SetExcluded(share.FieldViews)
+	return u
+}
+
+// AddViews adds v to the "views" field.
+func (u *ShareUpsert) AddViews(v int) *ShareUpsert {
+	u.Add(share.FieldViews, v)
+	return u
+}
+
+// SetPreviewOnly sets the "preview_only" field.
+func (u *ShareUpsert) SetPreviewOnly(v bool) *ShareUpsert {
+	u.Set(share.FieldPreviewOnly, v)
+	return u
+}
+
+// UpdatePreviewOnly sets the "preview_only" field to the value that was provided on create.
+func (u *ShareUpsert) UpdatePreviewOnly() *ShareUpsert {
+	u.SetExcluded(share.FieldPreviewOnly)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(share.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShareUpsertOne) UpdateNewValues() *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(share.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(share.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.OwnerID(); exists {
+			s.SetIgnore(share.FieldOwnerID)
+		}
+		if _, exists := u.create.mutation.FileID(); exists {
+			s.SetIgnore(share.FieldFileID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ShareUpsertOne) Ignore() *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShareUpsertOne) DoNothing() *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShareCreate.OnConflict
+// documentation for more info.
+func (u *ShareUpsertOne) Update(set func(*ShareUpsert)) *ShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShareUpsertOne) SetUpdatedAt(v time.Time) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateUpdatedAt() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPassword sets the "password" field.
+func (u *ShareUpsertOne) SetPassword(v string) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdatePassword() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// ClearPassword clears the value of the "password" field.
+func (u *ShareUpsertOne) ClearPassword() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearPassword()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ShareUpsertOne) SetExpiresAt(v time.Time) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateExpiresAt() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ShareUpsertOne) ClearExpiresAt() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetDownloadLimit sets the "download_limit" field.
+func (u *ShareUpsertOne) SetDownloadLimit(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetDownloadLimit(v)
+	})
+}
+
+// AddDownloadLimit adds v to the "download_limit" field.
+func (u *ShareUpsertOne) AddDownloadLimit(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddDownloadLimit(v)
+	})
+}
+
+// UpdateDownloadLimit sets the "download_limit" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateDownloadLimit() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateDownloadLimit()
+	})
+}
+
+// SetDownloads sets the "downloads" field.
+func (u *ShareUpsertOne) SetDownloads(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetDownloads(v)
+	})
+}
+
+// AddDownloads adds v to the "downloads" field.
+func (u *ShareUpsertOne) AddDownloads(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddDownloads(v)
+	})
+}
+
+// UpdateDownloads sets the "downloads" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateDownloads() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateDownloads()
+	})
+}
+
+// SetViews sets the "views" field.
+func (u *ShareUpsertOne) SetViews(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetViews(v)
+	})
+}
+
+// AddViews adds v to the "views" field.
+func (u *ShareUpsertOne) AddViews(v int) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddViews(v)
+	})
+}
+
+// UpdateViews sets the "views" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateViews() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateViews()
+	})
+}
+
+// SetPreviewOnly sets the "preview_only" field.
+func (u *ShareUpsertOne) SetPreviewOnly(v bool) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetPreviewOnly(v)
+	})
+}
+
+// UpdatePreviewOnly sets the "preview_only" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdatePreviewOnly() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdatePreviewOnly()
+	})
+}
+
+// Exec executes the query.
+func (u *ShareUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShareCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShareUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ShareUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ShareUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ShareCreateBulk is the builder for creating many Share entities in bulk.
+type ShareCreateBulk struct {
+	config
+	err      error
+	builders []*ShareCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Share entities in the database.
+func (scb *ShareCreateBulk) Save(ctx context.Context) ([]*Share, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Share, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ShareCreateBulk) SaveX(ctx context.Context) []*Share {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ShareCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Share.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ShareUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *ShareCreateBulk) OnConflict(opts ...sql.ConflictOption) *ShareUpsertBulk {
+	scb.conflict = opts
+	return &ShareUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *ShareCreateBulk) OnConflictColumns(columns ...string) *ShareUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &ShareUpsertBulk{
+		create: scb,
+	}
+}
+
+// ShareUpsertBulk is the builder for "upsert"-ing
+// a bulk of Share nodes.
+type ShareUpsertBulk struct {
+	create *ShareCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(share.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ShareUpsertBulk) UpdateNewValues() *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(share.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(share.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.OwnerID(); exists {
+				s.SetIgnore(share.FieldOwnerID)
+			}
+			if _, exists := b.mutation.FileID(); exists {
+				s.SetIgnore(share.FieldFileID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Share.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ShareUpsertBulk) Ignore() *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ShareUpsertBulk) DoNothing() *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ShareCreateBulk.OnConflict
+// documentation for more info.
+func (u *ShareUpsertBulk) Update(set func(*ShareUpsert)) *ShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ShareUpsertBulk) SetUpdatedAt(v time.Time) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateUpdatedAt() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPassword sets the "password" field.
+func (u *ShareUpsertBulk) SetPassword(v string) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdatePassword() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// ClearPassword clears the value of the "password" field.
+func (u *ShareUpsertBulk) ClearPassword() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearPassword()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ShareUpsertBulk) SetExpiresAt(v time.Time) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateExpiresAt() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ShareUpsertBulk) ClearExpiresAt() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetDownloadLimit sets the "download_limit" field.
+func (u *ShareUpsertBulk) SetDownloadLimit(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetDownloadLimit(v)
+	})
+}
+
+// AddDownloadLimit adds v to the "download_limit" field.
+func (u *ShareUpsertBulk) AddDownloadLimit(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddDownloadLimit(v)
+	})
+}
+
+// UpdateDownloadLimit sets the "download_limit" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateDownloadLimit() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateDownloadLimit()
+	})
+}
+
+// SetDownloads sets the "downloads" field.
+func (u *ShareUpsertBulk) SetDownloads(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetDownloads(v)
+	})
+}
+
+// AddDownloads adds v to the "downloads" field.
+func (u *ShareUpsertBulk) AddDownloads(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddDownloads(v)
+	})
+}
+
+// UpdateDownloads sets the "downloads" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateDownloads() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateDownloads()
+	})
+}
+
+// SetViews sets the "views" field.
+func (u *ShareUpsertBulk) SetViews(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetViews(v)
+	})
+}
+
+// AddViews adds v to the "views" field.
+func (u *ShareUpsertBulk) AddViews(v int) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.AddViews(v)
+	})
+}
+
+// UpdateViews sets the "views" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateViews() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateViews()
+	})
+}
+
+// SetPreviewOnly sets the "preview_only" field.
+func (u *ShareUpsertBulk) SetPreviewOnly(v bool) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetPreviewOnly(v)
+	})
+}
+
+// UpdatePreviewOnly sets the "preview_only" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdatePreviewOnly() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdatePreviewOnly()
+	})
+}
+
+// Exec executes the query.
+func (u *ShareUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ShareCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ShareCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ShareUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,584 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/share"
+)
+
+// ShareUpdate is the builder for updating Share entities.
+type ShareUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ShareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ShareUpdate builder.
+func (su *ShareUpdate) Where(ps ...predicate.Share) *ShareUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *ShareUpdate) SetUpdatedAt(t time.Time) *ShareUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetPassword sets the "password" field.
+func (su *ShareUpdate) SetPassword(s string) *ShareUpdate {
+	su.mutation.SetPassword(s)
+	return su
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (su *ShareUpdate) SetNillablePassword(s *string) *ShareUpdate {
+	if s != nil {
+		su.SetPassword(*s)
+	}
+	return su
+}
+
+// ClearPassword clears the value of the "password" field.
+func (su *ShareUpdate) ClearPassword() *ShareUpdate {
+	su.mutation.ClearPassword()
+	return su
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (su *ShareUpdate) SetExpiresAt(t time.Time) *ShareUpdate {
+	su.mutation.SetExpiresAt(t)
+	return su
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableExpiresAt(t *time.Time) *ShareUpdate {
+	if t != nil {
+		su.SetExpiresAt(*t)
+	}
+	return su
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (su *ShareUpdate) ClearExpiresAt() *ShareUpdate {
+	su.mutation.ClearExpiresAt()
+	return su
+}
+
+// SetDownloadLimit sets the "download_limit" field.
+func (su *ShareUpdate) SetDownloadLimit(i int) *ShareUpdate {
+	su.mutation.ResetDownloadLimit()
+	su.mutation.SetDownloadLimit(i)
+	return su
+}
+
+// SetNillableDownloadLimit sets the "download_limit" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableDownloadLimit(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetDownloadLimit(*i)
+	}
+	return su
+}
+
+// AddDownloadLimit adds i to the "download_limit" field.
+func (su *ShareUpdate) AddDownloadLimit(i int) *ShareUpdate {
+	su.mutation.AddDownloadLimit(i)
+	return su
+}
+
+// SetDownloads sets the "downloads" field.
+func (su *ShareUpdate) SetDownloads(i int) *ShareUpdate {
+	su.mutation.ResetDownloads()
+	su.mutation.SetDownloads(i)
+	return su
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableDownloads(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetDownloads(*i)
+	}
+	return su
+}
+
+// AddDownloads adds i to the "downloads" field.
+func (su *ShareUpdate) AddDownloads(i int) *ShareUpdate {
+	su.mutation.AddDownloads(i)
+	return su
+}
+
+// SetViews sets the "views" field.
+func (su *ShareUpdate) SetViews(i int) *ShareUpdate {
+	su.mutation.ResetViews()
+	su.mutation.SetViews(i)
+	return su
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableViews(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetViews(*i)
+	}
+	return su
+}
+
+// AddViews adds i to the "views" field.
+func (su *ShareUpdate) AddViews(i int) *ShareUpdate {
+	su.mutation.AddViews(i)
+	return su
+}
+
+// SetPreviewOnly sets the "preview_only" field.
+func (su *ShareUpdate) SetPreviewOnly(b bool) *ShareUpdate {
+	su.mutation.SetPreviewOnly(b)
+	return su
+}
+
+// SetNillablePreviewOnly sets the "preview_only" field if the given value is not nil.
+func (su *ShareUpdate) SetNillablePreviewOnly(b *bool) *ShareUpdate {
+	if b != nil {
+		su.SetPreviewOnly(*b)
+	}
+	return su
+}
+
+// Mutation returns the ShareMutation object of the builder.
+func (su *ShareUpdate) Mutation() *ShareMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ShareUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ShareUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ShareUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *ShareUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := share.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ShareUpdate) check() error {
+	if v, ok := su.mutation.Password(); ok {
+		if err := share.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "Share.password": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.DownloadLimit(); ok {
+		if err := share.DownloadLimitValidator(v); err != nil {
+			return &ValidationError{Name: "download_limit", err: fmt.Errorf(`ent: validator failed for field "Share.download_limit": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Downloads(); ok {
+		if err := share.DownloadsValidator(v); err != nil {
+			return &ValidationError{Name: "downloads", err: fmt.Errorf(`ent: validator failed for field "Share.downloads": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Views(); ok {
+		if err := share.ViewsValidator(v); err != nil {
+			return &ValidationError{Name: "views", err: fmt.Errorf(`ent: validator failed for field "Share.views": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (su *ShareUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ShareUpdate {
+	su.modifiers = append(su.modifiers, modifiers...)
+	return su
+}
+
+func (su *ShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(share.Table, share.Columns, sqlgraph.NewFieldSpec(share.FieldID, field.TypeUint))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(share.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.Password(); ok {
+		_spec.SetField(share.FieldPassword, field.TypeString, value)
+	}
+	if su.mutation.PasswordCleared() {
+		_spec.ClearField(share.FieldPassword, field.TypeString)
+	}
+	if value, ok := su.mutation.ExpiresAt(); ok {
+		_spec.SetField(share.FieldExpiresAt, field.TypeTime, value)
+	}
+	if su.mutation.ExpiresAtCleared() {
+		_spec.ClearField(share.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.DownloadLimit(); ok {
+		_spec.SetField(share.FieldDownloadLimit, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedDownloadLimit(); ok {
+		_spec.AddField(share.FieldDownloadLimit, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Downloads(); ok {
+		_spec.SetField(share.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedDownloads(); ok {
+		_spec.AddField(share.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Views(); ok {
+		_spec.SetField(share.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedViews(); ok {
+		_spec.AddField(share.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.PreviewOnly(); ok {
+		_spec.SetField(share.FieldPreviewOnly, field.TypeBool, value)
+	}
+	_spec.AddModifiers(su.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{share.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ShareUpdateOne is the builder for updating a single Share entity.
+type ShareUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ShareMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *ShareUpdateOne) SetUpdatedAt(t time.Time) *ShareUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetPassword sets the "password" field.
+func (suo *ShareUpdateOne) SetPassword(s string) *ShareUpdateOne {
+	suo.mutation.SetPassword(s)
+	return suo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillablePassword(s *string) *ShareUpdateOne {
+	if s != nil {
+		suo.SetPassword(*s)
+	}
+	return suo
+}
+
+// ClearPassword clears the value of the "password" field.
+func (suo *ShareUpdateOne) ClearPassword() *ShareUpdateOne {
+	suo.mutation.ClearPassword()
+	return suo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (suo *ShareUpdateOne) SetExpiresAt(t time.Time) *ShareUpdateOne {
+	suo.mutation.SetExpiresAt(t)
+	return suo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableExpiresAt(t *time.Time) *ShareUpdateOne {
+	if t != nil {
+		suo.SetExpiresAt(*t)
+	}
+	return suo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (suo *ShareUpdateOne) ClearExpiresAt() *ShareUpdateOne {
+	suo.mutation.ClearExpiresAt()
+	return suo
+}
+
+// SetDownloadLimit sets the "download_limit" field.
+func (suo *ShareUpdateOne) SetDownloadLimit(i int) *ShareUpdateOne {
+	suo.mutation.ResetDownloadLimit()
+	suo.mutation.SetDownloadLimit(i)
+	return suo
+}
+
+// SetNillableDownloadLimit sets the "download_limit" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableDownloadLimit(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetDownloadLimit(*i)
+	}
+	return suo
+}
+
+// AddDownloadLimit adds i to the "download_limit" field.
+func (suo *ShareUpdateOne) AddDownloadLimit(i int) *ShareUpdateOne {
+	suo.mutation.AddDownloadLimit(i)
+	return suo
+}
+
+// SetDownloads sets the "downloads" field.
+func (suo *ShareUpdateOne) SetDownloads(i int) *ShareUpdateOne {
+	suo.mutation.ResetDownloads()
+	suo.mutation.SetDownloads(i)
+	return suo
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableDownloads(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetDownloads(*i)
+	}
+	return suo
+}
+
+// AddDownloads adds i to the "downloads" field.
+func (suo *ShareUpdateOne) AddDownloads(i int) *ShareUpdateOne {
+	suo.mutation.AddDownloads(i)
+	return suo
+}
+
+// SetViews sets the "views" field.
+func (suo *ShareUpdateOne) SetViews(i int) *ShareUpdateOne {
+	suo.mutation.ResetViews()
+	suo.mutation.SetViews(i)
+	return suo
+}
+
+// SetNillableViews sets the "views" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableViews(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetViews(*i)
+	}
+	return suo
+}
+
+// AddViews adds i to the "views" field.
+func (suo *ShareUpdateOne) AddViews(i int) *ShareUpdateOne {
+	suo.mutation.AddViews(i)
+	return suo
+}
+
+// SetPreviewOnly sets the "preview_only" field.
+func (suo *ShareUpdateOne) SetPreviewOnly(b bool) *ShareUpdateOne {
+	suo.mutation.SetPreviewOnly(b)
+	return suo
+}
+
+// SetNillablePreviewOnly sets the "preview_only" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillablePreviewOnly(b *bool) *ShareUpdateOne {
+	if b != nil {
+		suo.SetPreviewOnly(*b)
+	}
+	return suo
+}
+
+// Mutation returns the ShareMutation object of the builder.
+func (suo *ShareUpdateOne) Mutation() *ShareMutation {
+	return suo.mutation
+}
+
+// Where appends a list predicates to the ShareUpdate builder.
+func (suo *ShareUpdateOne) Where(ps ...predicate.Share) *ShareUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ShareUpdateOne) Select(field string, fields ...string) *ShareUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Share entity.
+func (suo *ShareUpdateOne) Save(ctx context.Context) (*Share, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ShareUpdateOne) SaveX(ctx context.Context) *Share {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ShareUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *ShareUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := share.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ShareUpdateOne) check() error {
+	if v, ok := suo.mutation.Password(); ok {
+		if err := share.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "Share.password": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.DownloadLimit(); ok {
+		if err := share.DownloadLimitValidator(v); err != nil {
+			return &ValidationError{Name: "download_limit", err: fmt.Errorf(`ent: validator failed for field "Share.download_limit": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Downloads(); ok {
+		if err := share.DownloadsValidator(v); err != nil {
+			return &ValidationError{Name: "downloads", err: fmt.Errorf(`ent: validator failed for field "Share.downloads": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Views(); ok {
+		if err := share.ViewsValidator(v); err != nil {
+			return &ValidationError{Name: "views", err: fmt.Errorf(`ent: validator failed for field "Share.views": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (suo *ShareUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ShareUpdateOne {
+	suo.modifiers = append(suo.modifiers, modifiers...)
+	return suo
+}
+
+func (suo *ShareUpdateOne) sqlSave(ctx context.Context) (_node *Share, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(share.Table, share.Columns, sqlgraph.NewFieldSpec(share.FieldID, field.TypeUint))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Share.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, share.FieldID)
+		for _, f := range fields {
+			if !share.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != share.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(share.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.Password(); ok {
+		_spec.SetField(share.FieldPassword, field.TypeString, value)
+	}
+	if suo.mutation.PasswordCleared() {
+		_spec.ClearField(share.FieldPassword, field.TypeString)
+	}
+	if value, ok := suo.mutation.ExpiresAt(); ok {
+		_spec.SetField(share.FieldExpiresAt, field.TypeTime, value)
+	}
+	if suo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(share.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.DownloadLimit(); ok {
+		_spec.SetField(share.FieldDownloadLimit, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDownloadLimit(); ok {
+		_spec.AddField(share.FieldDownloadLimit, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Downloads(); ok {
+		_spec.SetField(share.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDownloads(); ok {
+		_spec.AddField(share.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Views(); ok {
+		_spec.SetField(share.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedViews(); ok {
+		_spec.AddField(share.FieldViews, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.PreviewOnly(); ok {
+		_spec.SetField(share.FieldPreviewOnly, field.TypeBool, value)
+	}
+	_spec.AddModifiers(suo.modifiers...)
+	_node = &Share{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{share.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -12,6 +12,10 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
+	// AccountDeletion is the client for interacting with the AccountDeletion builders.
+	AccountDeletion *AccountDeletionClient
 	// Album is the client for interacting with the Album builders.
 	Album *AlbumClient
 	// AlbumCategory is the client for interacting with the AlbumCategory builders.
@@ -20,8 +24,14 @@ type Tx struct {
 	Article *ArticleClient
 	// ArticleHistory is the client for interacting with the ArticleHistory builders.
 	ArticleHistory *ArticleHistoryClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// BlockRule is the client for interacting with the BlockRule builders.
+	BlockRule *BlockRuleClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// CommentThread is the client for interacting with the CommentThread builders.
+	CommentThread *CommentThreadClient
 	// DirectLink is the client for interacting with the DirectLink builders.
 	DirectLink *DirectLinkClient
 	// DocSeries is the client for interacting with the DocSeries builders.
@@ -40,6 +50,8 @@ type Tx struct {
 	FileEntity *FileEntityClient
 	// GiveMoney is the client for interacting with the GiveMoney builders.
 	GiveMoney *GiveMoneyClient
+	// InvitationCode is the client for interacting with the InvitationCode builders.
+	InvitationCode *InvitationCodeClient
 	// Link is the client for interacting with the Link builders.
 	Link *LinkClient
 	// LinkCategory is the client for interacting with the LinkCategory builders.
@@ -52,12 +64,16 @@ type Tx struct {
 	NotificationType *NotificationTypeClient
 	// Page is the client for interacting with the Page builders.
 	Page *PageClient
+	// Passkey is the client for interacting with the Passkey builders.
+	Passkey *PasskeyClient
 	// PostCategory is the client for interacting with the PostCategory builders.
 	PostCategory *PostCategoryClient
 	// PostTag is the client for interacting with the PostTag builders.
 	PostTag *PostTagClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// Share is the client for interacting with the Share builders.
+	Share *ShareClient
 	// StoragePolicy is the client for interacting with the StoragePolicy builders.
 	StoragePolicy *StoragePolicyClient
 	// Subscriber is the client for interacting with the Subscriber builders.
@@ -70,14 +86,22 @@ type Tx struct {
 	User *UserClient
 	// UserGroup is the client for interacting with the UserGroup builders.
 	UserGroup *UserGroupClient
+	// UserIdentity is the client for interacting with the UserIdentity builders.
+	UserIdentity *UserIdentityClient
 	// UserInstalledTheme is the client for interacting with the UserInstalledTheme builders.
 	UserInstalledTheme *UserInstalledThemeClient
 	// UserNotificationConfig is the client for interacting with the UserNotificationConfig builders.
 	UserNotificationConfig *UserNotificationConfigClient
+	// UserSession is the client for interacting with the UserSession builders.
+	UserSession *UserSessionClient
+	// UserTwoFactor is the client for interacting with the UserTwoFactor builders.
+	UserTwoFactor *UserTwoFactorClient
 	// VisitorLog is the client for interacting with the VisitorLog builders.
 	VisitorLog *VisitorLogClient
 	// VisitorStat is the client for interacting with the VisitorStat builders.
 	VisitorStat *VisitorStatClient
+	// Webmention is the client for interacting with the Webmention builders.
+	Webmention *WebmentionClient
 
 	// lazily loaded.
 	client     *Client
@@ -209,11 +233,16 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessToken = NewAccessTokenClient(tx.config)
+	tx.AccountDeletion = NewAccountDeletionClient(tx.config)
 	tx.Album = NewAlbumClient(tx.config)
 	tx.AlbumCategory = NewAlbumCategoryClient(tx.config)
 	tx.Article = NewArticleClient(tx.config)
 	tx.ArticleHistory = NewArticleHistoryClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.BlockRule = NewBlockRuleClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
+	tx.CommentThread = NewCommentThreadClient(tx.config)
 	tx.DirectLink = NewDirectLinkClient(tx.config)
 	tx.DocSeries = NewDocSeriesClient(tx.config)
 	tx.Entity = NewEntityClient(tx.config)
@@ -223,25 +252,32 @@ func (tx *Tx) init() {
 	tx.File = NewFileClient(tx.config)
 	tx.FileEntity = NewFileEntityClient(tx.config)
 	tx.GiveMoney = NewGiveMoneyClient(tx.config)
+	tx.InvitationCode = NewInvitationCodeClient(tx.config)
 	tx.Link = NewLinkClient(tx.config)
 	tx.LinkCategory = NewLinkCategoryClient(tx.config)
 	tx.LinkTag = NewLinkTagClient(tx.config)
 	tx.Metadata = NewMetadataClient(tx.config)
 	tx.NotificationType = NewNotificationTypeClient(tx.config)
 	tx.Page = NewPageClient(tx.config)
+	tx.Passkey = NewPasskeyClient(tx.config)
 	tx.PostCategory = NewPostCategoryClient(tx.config)
 	tx.PostTag = NewPostTagClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
+	tx.Share = NewShareClient(tx.config)
 	tx.StoragePolicy = NewStoragePolicyClient(tx.config)
 	tx.Subscriber = NewSubscriberClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
 	tx.URLStat = NewURLStatClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserGroup = NewUserGroupClient(tx.config)
+	tx.UserIdentity = NewUserIdentityClient(tx.config)
 	tx.UserInstalledTheme = NewUserInstalledThemeClient(tx.config)
 	tx.UserNotificationConfig = NewUserNotificationConfigClient(tx.config)
+	tx.UserSession = NewUserSessionClient(tx.config)
+	tx.UserTwoFactor = NewUserTwoFactorClient(tx.config)
 	tx.VisitorLog = NewVisitorLogClient(tx.config)
 	tx.VisitorStat = NewVisitorStatClient(tx.config)
+	tx.Webmention = NewWebmentionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -251,7 +287,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Album.QueryXXX(), the query will be executed
+// applies a query, for example: AccessToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
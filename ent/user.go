@@ -40,6 +40,8 @@ type User struct {
 	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
 	// 用户状态 1:正常 2:未激活 3:已封禁
 	Status int `json:"status,omitempty"`
+	// 已使用的存储容量（字节），在文件创建和删除时增量维护
+	StorageUsed int64 `json:"storage_used,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges         UserEdges `json:"edges"`
@@ -116,7 +118,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID, user.FieldStatus:
+		case user.FieldID, user.FieldStatus, user.FieldStorageUsed:
 			values[i] = new(sql.NullInt64)
 		case user.FieldUsername, user.FieldPasswordHash, user.FieldNickname, user.FieldAvatar, user.FieldEmail, user.FieldWebsite:
 			values[i] = new(sql.NullString)
@@ -213,6 +215,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Status = int(value.Int64)
 			}
+		case user.FieldStorageUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_used", values[i])
+			} else if value.Valid {
+				u.StorageUsed = value.Int64
+			}
 		case user.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_group_id", value)
@@ -316,6 +324,9 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", u.Status))
+	builder.WriteString(", ")
+	builder.WriteString("storage_used=")
+	builder.WriteString(fmt.Sprintf("%v", u.StorageUsed))
 	builder.WriteByte(')')
 	return builder.String()
 }
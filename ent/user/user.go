@@ -37,6 +37,8 @@ const (
 	FieldLastLoginAt = "last_login_at"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldStorageUsed holds the string denoting the storage_used field in the database.
+	FieldStorageUsed = "storage_used"
 	// EdgeUserGroup holds the string denoting the user_group edge name in mutations.
 	EdgeUserGroup = "user_group"
 	// EdgeFiles holds the string denoting the files edge name in mutations.
@@ -100,6 +102,7 @@ var Columns = []string{
 	FieldWebsite,
 	FieldLastLoginAt,
 	FieldStatus,
+	FieldStorageUsed,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "users"
@@ -150,6 +153,8 @@ var (
 	WebsiteValidator func(string) error
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus int
+	// DefaultStorageUsed holds the default value on creation for the "storage_used" field.
+	DefaultStorageUsed int64
 )
 
 // OrderOption defines the ordering options for the User queries.
@@ -215,6 +220,11 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByStorageUsed orders the results by the storage_used field.
+func ByStorageUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageUsed, opts...).ToFunc()
+}
+
 // ByUserGroupField orders the results by user_group field.
 func ByUserGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
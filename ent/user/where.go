@@ -110,6 +110,11 @@ func Status(v int) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldStatus, v))
 }
 
+// StorageUsed applies equality check predicate on the "storage_used" field. It's identical to StorageUsedEQ.
+func StorageUsed(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStorageUsed, v))
+}
+
 // DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
 func DeletedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldDeletedAt, v))
@@ -760,6 +765,46 @@ func StatusLTE(v int) predicate.User {
 	return predicate.User(sql.FieldLTE(FieldStatus, v))
 }
 
+// StorageUsedEQ applies the EQ predicate on the "storage_used" field.
+func StorageUsedEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStorageUsed, v))
+}
+
+// StorageUsedNEQ applies the NEQ predicate on the "storage_used" field.
+func StorageUsedNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStorageUsed, v))
+}
+
+// StorageUsedIn applies the In predicate on the "storage_used" field.
+func StorageUsedIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStorageUsed, vs...))
+}
+
+// StorageUsedNotIn applies the NotIn predicate on the "storage_used" field.
+func StorageUsedNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStorageUsed, vs...))
+}
+
+// StorageUsedGT applies the GT predicate on the "storage_used" field.
+func StorageUsedGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStorageUsed, v))
+}
+
+// StorageUsedGTE applies the GTE predicate on the "storage_used" field.
+func StorageUsedGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStorageUsed, v))
+}
+
+// StorageUsedLT applies the LT predicate on the "storage_used" field.
+func StorageUsedLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStorageUsed, v))
+}
+
+// StorageUsedLTE applies the LTE predicate on the "storage_used" field.
+func StorageUsedLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStorageUsed, v))
+}
+
 // HasUserGroup applies the HasEdge predicate on the "user_group" edge.
 func HasUserGroup() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
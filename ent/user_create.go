@@ -165,6 +165,20 @@ func (uc *UserCreate) SetNillableStatus(i *int) *UserCreate {
 	return uc
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (uc *UserCreate) SetStorageUsed(i int64) *UserCreate {
+	uc.mutation.SetStorageUsed(i)
+	return uc
+}
+
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (uc *UserCreate) SetNillableStorageUsed(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetStorageUsed(*i)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(u uint) *UserCreate {
 	uc.mutation.SetID(u)
@@ -297,6 +311,10 @@ func (uc *UserCreate) defaults() error {
 		v := user.DefaultStatus
 		uc.mutation.SetStatus(v)
 	}
+	if _, ok := uc.mutation.StorageUsed(); !ok {
+		v := user.DefaultStorageUsed
+		uc.mutation.SetStorageUsed(v)
+	}
 	return nil
 }
 
@@ -347,6 +365,9 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "User.status"`)}
 	}
+	if _, ok := uc.mutation.StorageUsed(); !ok {
+		return &ValidationError{Name: "storage_used", err: errors.New(`ent: missing required field "User.storage_used"`)}
+	}
 	if len(uc.mutation.UserGroupIDs()) == 0 {
 		return &ValidationError{Name: "user_group", err: errors.New(`ent: missing required edge "User.user_group"`)}
 	}
@@ -427,6 +448,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldStatus, field.TypeInt, value)
 		_node.Status = value
 	}
+	if value, ok := uc.mutation.StorageUsed(); ok {
+		_spec.SetField(user.FieldStorageUsed, field.TypeInt64, value)
+		_node.StorageUsed = value
+	}
 	if nodes := uc.mutation.UserGroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -722,6 +747,24 @@ func (u *UserUpsert) AddStatus(v int) *UserUpsert {
 	return u
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (u *UserUpsert) SetStorageUsed(v int64) *UserUpsert {
+	u.Set(user.FieldStorageUsed, v)
+	return u
+}
+
+// UpdateStorageUsed sets the "storage_used" field to the value that was provided on create.
+func (u *UserUpsert) UpdateStorageUsed() *UserUpsert {
+	u.SetExcluded(user.FieldStorageUsed)
+	return u
+}
+
+// AddStorageUsed adds v to the "storage_used" field.
+func (u *UserUpsert) AddStorageUsed(v int64) *UserUpsert {
+	u.Add(user.FieldStorageUsed, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -962,6 +1005,27 @@ func (u *UserUpsertOne) UpdateStatus() *UserUpsertOne {
 	})
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (u *UserUpsertOne) SetStorageUsed(v int64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStorageUsed(v)
+	})
+}
+
+// AddStorageUsed adds v to the "storage_used" field.
+func (u *UserUpsertOne) AddStorageUsed(v int64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddStorageUsed(v)
+	})
+}
+
+// UpdateStorageUsed sets the "storage_used" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateStorageUsed() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStorageUsed()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1368,6 +1432,27 @@ func (u *UserUpsertBulk) UpdateStatus() *UserUpsertBulk {
 	})
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (u *UserUpsertBulk) SetStorageUsed(v int64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStorageUsed(v)
+	})
+}
+
+// AddStorageUsed adds v to the "storage_used" field.
+func (u *UserUpsertBulk) AddStorageUsed(v int64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddStorageUsed(v)
+	})
+}
+
+// UpdateStorageUsed sets the "storage_used" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateStorageUsed() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStorageUsed()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
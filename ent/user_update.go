@@ -209,6 +209,27 @@ func (uu *UserUpdate) AddStatus(i int) *UserUpdate {
 	return uu
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (uu *UserUpdate) SetStorageUsed(i int64) *UserUpdate {
+	uu.mutation.ResetStorageUsed()
+	uu.mutation.SetStorageUsed(i)
+	return uu
+}
+
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableStorageUsed(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetStorageUsed(*i)
+	}
+	return uu
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (uu *UserUpdate) AddStorageUsed(i int64) *UserUpdate {
+	uu.mutation.AddStorageUsed(i)
+	return uu
+}
+
 // SetUserGroupID sets the "user_group" edge to the UserGroup entity by ID.
 func (uu *UserUpdate) SetUserGroupID(id uint) *UserUpdate {
 	uu.mutation.SetUserGroupID(id)
@@ -524,6 +545,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.AddedStatus(); ok {
 		_spec.AddField(user.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := uu.mutation.StorageUsed(); ok {
+		_spec.SetField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedStorageUsed(); ok {
+		_spec.AddField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
 	if uu.mutation.UserGroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -930,6 +957,27 @@ func (uuo *UserUpdateOne) AddStatus(i int) *UserUpdateOne {
 	return uuo
 }
 
+// SetStorageUsed sets the "storage_used" field.
+func (uuo *UserUpdateOne) SetStorageUsed(i int64) *UserUpdateOne {
+	uuo.mutation.ResetStorageUsed()
+	uuo.mutation.SetStorageUsed(i)
+	return uuo
+}
+
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStorageUsed(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetStorageUsed(*i)
+	}
+	return uuo
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (uuo *UserUpdateOne) AddStorageUsed(i int64) *UserUpdateOne {
+	uuo.mutation.AddStorageUsed(i)
+	return uuo
+}
+
 // SetUserGroupID sets the "user_group" edge to the UserGroup entity by ID.
 func (uuo *UserUpdateOne) SetUserGroupID(id uint) *UserUpdateOne {
 	uuo.mutation.SetUserGroupID(id)
@@ -1275,6 +1323,12 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.AddedStatus(); ok {
 		_spec.AddField(user.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := uuo.mutation.StorageUsed(); ok {
+		_spec.SetField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedStorageUsed(); ok {
+		_spec.AddField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
 	if uuo.mutation.UserGroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
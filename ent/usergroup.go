@@ -33,7 +33,7 @@ type UserGroup struct {
 	Permissions model.Boolset `json:"permissions,omitempty"`
 	// 用户组的最大存储容量（字节）, 0为不限制
 	MaxStorage int64 `json:"max_storage,omitempty"`
-	// 用户组的最大上传速度（字节/秒）, 0为不限制
+	// 用户组的下载限速（字节/秒）, 0为不限制
 	SpeedLimit int64 `json:"speed_limit,omitempty"`
 	// 用户组的特定JSON配置
 	Settings *model.GroupSettings `json:"settings,omitempty"`
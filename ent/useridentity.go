@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
+)
+
+// 第三方登录账号表
+type UserIdentity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 所属用户ID
+	UserID uint `json:"user_id,omitempty"`
+	// 登录提供方标识，对应设置中配置的 provider id
+	Provider string `json:"provider,omitempty"`
+	// 提供方返回的用户唯一标识
+	Subject string `json:"subject,omitempty"`
+	// 提供方返回的邮箱
+	Email string `json:"email,omitempty"`
+	// 提供方返回的昵称
+	DisplayName string `json:"display_name,omitempty"`
+	// 提供方返回的头像
+	AvatarURL string `json:"avatar_url,omitempty"`
+	// 最近一次通过该账号登录的时间
+	LastLoginAt  *time.Time `json:"last_login_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserIdentity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case useridentity.FieldID, useridentity.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case useridentity.FieldProvider, useridentity.FieldSubject, useridentity.FieldEmail, useridentity.FieldDisplayName, useridentity.FieldAvatarURL:
+			values[i] = new(sql.NullString)
+		case useridentity.FieldCreatedAt, useridentity.FieldUpdatedAt, useridentity.FieldLastLoginAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserIdentity fields.
+func (ui *UserIdentity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case useridentity.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ui.ID = uint(value.Int64)
+		case useridentity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ui.CreatedAt = value.Time
+			}
+		case useridentity.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ui.UpdatedAt = value.Time
+			}
+		case useridentity.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ui.UserID = uint(value.Int64)
+			}
+		case useridentity.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				ui.Provider = value.String
+			}
+		case useridentity.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				ui.Subject = value.String
+			}
+		case useridentity.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ui.Email = value.String
+			}
+		case useridentity.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				ui.DisplayName = value.String
+			}
+		case useridentity.FieldAvatarURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_url", values[i])
+			} else if value.Valid {
+				ui.AvatarURL = value.String
+			}
+		case useridentity.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				ui.LastLoginAt = new(time.Time)
+				*ui.LastLoginAt = value.Time
+			}
+		default:
+			ui.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserIdentity.
+// This includes values selected through modifiers, order, etc.
+func (ui *UserIdentity) Value(name string) (ent.Value, error) {
+	return ui.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserIdentity.
+// Note that you need to call UserIdentity.Unwrap() before calling this method if this UserIdentity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ui *UserIdentity) Update() *UserIdentityUpdateOne {
+	return NewUserIdentityClient(ui.config).UpdateOne(ui)
+}
+
+// Unwrap unwraps the UserIdentity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ui *UserIdentity) Unwrap() *UserIdentity {
+	_tx, ok := ui.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserIdentity is not a transactional entity")
+	}
+	ui.config.driver = _tx.drv
+	return ui
+}
+
+// String implements the fmt.Stringer.
+func (ui *UserIdentity) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserIdentity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ui.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ui.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ui.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ui.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(ui.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(ui.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(ui.Email)
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(ui.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("avatar_url=")
+	builder.WriteString(ui.AvatarURL)
+	builder.WriteString(", ")
+	if v := ui.LastLoginAt; v != nil {
+		builder.WriteString("last_login_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserIdentities is a parsable slice of UserIdentity.
+type UserIdentities []*UserIdentity
@@ -0,0 +1,1050 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
+)
+
+// UserIdentityCreate is the builder for creating a UserIdentity entity.
+type UserIdentityCreate struct {
+	config
+	mutation *UserIdentityMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uic *UserIdentityCreate) SetCreatedAt(t time.Time) *UserIdentityCreate {
+	uic.mutation.SetCreatedAt(t)
+	return uic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableCreatedAt(t *time.Time) *UserIdentityCreate {
+	if t != nil {
+		uic.SetCreatedAt(*t)
+	}
+	return uic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uic *UserIdentityCreate) SetUpdatedAt(t time.Time) *UserIdentityCreate {
+	uic.mutation.SetUpdatedAt(t)
+	return uic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableUpdatedAt(t *time.Time) *UserIdentityCreate {
+	if t != nil {
+		uic.SetUpdatedAt(*t)
+	}
+	return uic
+}
+
+// SetUserID sets the "user_id" field.
+func (uic *UserIdentityCreate) SetUserID(u uint) *UserIdentityCreate {
+	uic.mutation.SetUserID(u)
+	return uic
+}
+
+// SetProvider sets the "provider" field.
+func (uic *UserIdentityCreate) SetProvider(s string) *UserIdentityCreate {
+	uic.mutation.SetProvider(s)
+	return uic
+}
+
+// SetSubject sets the "subject" field.
+func (uic *UserIdentityCreate) SetSubject(s string) *UserIdentityCreate {
+	uic.mutation.SetSubject(s)
+	return uic
+}
+
+// SetEmail sets the "email" field.
+func (uic *UserIdentityCreate) SetEmail(s string) *UserIdentityCreate {
+	uic.mutation.SetEmail(s)
+	return uic
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableEmail(s *string) *UserIdentityCreate {
+	if s != nil {
+		uic.SetEmail(*s)
+	}
+	return uic
+}
+
+// SetDisplayName sets the "display_name" field.
+func (uic *UserIdentityCreate) SetDisplayName(s string) *UserIdentityCreate {
+	uic.mutation.SetDisplayName(s)
+	return uic
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableDisplayName(s *string) *UserIdentityCreate {
+	if s != nil {
+		uic.SetDisplayName(*s)
+	}
+	return uic
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (uic *UserIdentityCreate) SetAvatarURL(s string) *UserIdentityCreate {
+	uic.mutation.SetAvatarURL(s)
+	return uic
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableAvatarURL(s *string) *UserIdentityCreate {
+	if s != nil {
+		uic.SetAvatarURL(*s)
+	}
+	return uic
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (uic *UserIdentityCreate) SetLastLoginAt(t time.Time) *UserIdentityCreate {
+	uic.mutation.SetLastLoginAt(t)
+	return uic
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableLastLoginAt(t *time.Time) *UserIdentityCreate {
+	if t != nil {
+		uic.SetLastLoginAt(*t)
+	}
+	return uic
+}
+
+// SetID sets the "id" field.
+func (uic *UserIdentityCreate) SetID(u uint) *UserIdentityCreate {
+	uic.mutation.SetID(u)
+	return uic
+}
+
+// Mutation returns the UserIdentityMutation object of the builder.
+func (uic *UserIdentityCreate) Mutation() *UserIdentityMutation {
+	return uic.mutation
+}
+
+// Save creates the UserIdentity in the database.
+func (uic *UserIdentityCreate) Save(ctx context.Context) (*UserIdentity, error) {
+	uic.defaults()
+	return withHooks(ctx, uic.sqlSave, uic.mutation, uic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uic *UserIdentityCreate) SaveX(ctx context.Context) *UserIdentity {
+	v, err := uic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uic *UserIdentityCreate) Exec(ctx context.Context) error {
+	_, err := uic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uic *UserIdentityCreate) ExecX(ctx context.Context) {
+	if err := uic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uic *UserIdentityCreate) defaults() {
+	if _, ok := uic.mutation.CreatedAt(); !ok {
+		v := useridentity.DefaultCreatedAt()
+		uic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := uic.mutation.UpdatedAt(); !ok {
+		v := useridentity.DefaultUpdatedAt()
+		uic.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uic *UserIdentityCreate) check() error {
+	if _, ok := uic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserIdentity.created_at"`)}
+	}
+	if _, ok := uic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserIdentity.updated_at"`)}
+	}
+	if _, ok := uic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserIdentity.user_id"`)}
+	}
+	if _, ok := uic.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "UserIdentity.provider"`)}
+	}
+	if v, ok := uic.mutation.Provider(); ok {
+		if err := useridentity.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.provider": %w`, err)}
+		}
+	}
+	if _, ok := uic.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "UserIdentity.subject"`)}
+	}
+	if v, ok := uic.mutation.Subject(); ok {
+		if err := useridentity.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.subject": %w`, err)}
+		}
+	}
+	if v, ok := uic.mutation.Email(); ok {
+		if err := useridentity.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.email": %w`, err)}
+		}
+	}
+	if v, ok := uic.mutation.DisplayName(); ok {
+		if err := useridentity.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.display_name": %w`, err)}
+		}
+	}
+	if v, ok := uic.mutation.AvatarURL(); ok {
+		if err := useridentity.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.avatar_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uic *UserIdentityCreate) sqlSave(ctx context.Context) (*UserIdentity, error) {
+	if err := uic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	uic.mutation.id = &_node.ID
+	uic.mutation.done = true
+	return _node, nil
+}
+
+func (uic *UserIdentityCreate) createSpec() (*UserIdentity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserIdentity{config: uic.config}
+		_spec = sqlgraph.NewCreateSpec(useridentity.Table, sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = uic.conflict
+	if id, ok := uic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := uic.mutation.CreatedAt(); ok {
+		_spec.SetField(useridentity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := uic.mutation.UpdatedAt(); ok {
+		_spec.SetField(useridentity.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := uic.mutation.UserID(); ok {
+		_spec.SetField(useridentity.FieldUserID, field.TypeUint, value)
+		_node.UserID = value
+	}
+	if value, ok := uic.mutation.Provider(); ok {
+		_spec.SetField(useridentity.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := uic.mutation.Subject(); ok {
+		_spec.SetField(useridentity.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := uic.mutation.Email(); ok {
+		_spec.SetField(useridentity.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := uic.mutation.DisplayName(); ok {
+		_spec.SetField(useridentity.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := uic.mutation.AvatarURL(); ok {
+		_spec.SetField(useridentity.FieldAvatarURL, field.TypeString, value)
+		_node.AvatarURL = value
+	}
+	if value, ok := uic.mutation.LastLoginAt(); ok {
+		_spec.SetField(useridentity.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserIdentity.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserIdentityUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (uic *UserIdentityCreate) OnConflict(opts ...sql.ConflictOption) *UserIdentityUpsertOne {
+	uic.conflict = opts
+	return &UserIdentityUpsertOne{
+		create: uic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserIdentity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uic *UserIdentityCreate) OnConflictColumns(columns ...string) *UserIdentityUpsertOne {
+	uic.conflict = append(uic.conflict, sql.ConflictColumns(columns...))
+	return &UserIdentityUpsertOne{
+		create: uic,
+	}
+}
+
+type (
+	// UserIdentityUpsertOne is the builder for "upsert"-ing
+	//  one UserIdentity node.
+	UserIdentityUpsertOne struct {
+		create *UserIdentityCreate
+	}
+
+	// UserIdentityUpsert is the "OnConflict" setter.
+	UserIdentityUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserIdentityUpsert) SetUpdatedAt(v time.Time) *UserIdentityUpsert {
+	u.Set(useridentity.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserIdentityUpsert) UpdateUpdatedAt() *UserIdentityUpsert {
+	u.SetExcluded(useridentity.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserIdentityUpsert) SetUserID(v uint) *UserIdentityUpsert {
+	u.Set(useridentity.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserIdentityUpsert) UpdateUserID() *UserIdentityUpsert {
+	u.SetExcluded(useridentity.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserIdentityUpsert) AddUserID(v uint) *UserIdentityUpsert {
+	u.Add(useridentity.FieldUserID, v)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *UserIdentityUpsert) SetProvider(v string) *UserIdentityUpsert {
+	u.Set(useridentity.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *UserIdentityUpsert) UpdateProvider() *UserIdentityUpsert {
+	u.SetExcluded(useridentity.FieldProvider)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *UserIdentityUpsert) SetSubject(v string) *UserIdentityUpsert {
+	u.Set(useridentity.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *UserIdentityUpsert) UpdateSubject() *UserIdentityUpsert {
+	u.SetExcluded(useridentity.FieldSubject)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *UserIdentityUpsert) SetEmail(v string) *UserIdentityUpsert {
+	u.Set(useridentity.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UserIdentityUpsert) UpdateEmail() *UserIdentityUpsert {
+	u.SetExcluded(useridentity.FieldEmail)
+	return u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *UserIdentityUpsert) ClearEmail() *UserIdentityUpsert {
+	u.SetNull(useridentity.FieldEmail)
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *UserIdentityUpsert) SetDisplayName(v string) *UserIdentityUpsert {
+	u.Set(useridentity.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *UserIdentityUpsert) UpdateDisplayName() *UserIdentityUpsert {
+	u.SetExcluded(useridentity.FieldDisplayName)
+	return u
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *UserIdentityUpsert) ClearDisplayName() *UserIdentityUpsert {
+	u.SetNull(useridentity.FieldDisplayName)
+	return u
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (u *UserIdentityUpsert) SetAvatarURL(v string) *UserIdentityUpsert {
+	u.Set(useridentity.FieldAvatarURL, v)
+	return u
+}
+
+// UpdateAvatarURL sets the "avatar_url" field to the value that was provided on create.
+func (u *UserIdentityUpsert) UpdateAvatarURL() *UserIdentityUpsert {
+	u.SetExcluded(useridentity.FieldAvatarURL)
+	return u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (u *UserIdentityUpsert) ClearAvatarURL() *UserIdentityUpsert {
+	u.SetNull(useridentity.FieldAvatarURL)
+	return u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (u *UserIdentityUpsert) SetLastLoginAt(v time.Time) *UserIdentityUpsert {
+	u.Set(useridentity.FieldLastLoginAt, v)
+	return u
+}
+
+// UpdateLastLoginAt sets the "last_login_at" field to the value that was provided on create.
+func (u *UserIdentityUpsert) UpdateLastLoginAt() *UserIdentityUpsert {
+	u.SetExcluded(useridentity.FieldLastLoginAt)
+	return u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (u *UserIdentityUpsert) ClearLastLoginAt() *UserIdentityUpsert {
+	u.SetNull(useridentity.FieldLastLoginAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserIdentity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(useridentity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserIdentityUpsertOne) UpdateNewValues() *UserIdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(useridentity.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(useridentity.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserIdentity.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserIdentityUpsertOne) Ignore() *UserIdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserIdentityUpsertOne) DoNothing() *UserIdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserIdentityCreate.OnConflict
+// documentation for more info.
+func (u *UserIdentityUpsertOne) Update(set func(*UserIdentityUpsert)) *UserIdentityUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserIdentityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserIdentityUpsertOne) SetUpdatedAt(v time.Time) *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserIdentityUpsertOne) UpdateUpdatedAt() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserIdentityUpsertOne) SetUserID(v uint) *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserIdentityUpsertOne) AddUserID(v uint) *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserIdentityUpsertOne) UpdateUserID() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *UserIdentityUpsertOne) SetProvider(v string) *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *UserIdentityUpsertOne) UpdateProvider() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *UserIdentityUpsertOne) SetSubject(v string) *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *UserIdentityUpsertOne) UpdateSubject() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *UserIdentityUpsertOne) SetEmail(v string) *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UserIdentityUpsertOne) UpdateEmail() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *UserIdentityUpsertOne) ClearEmail() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *UserIdentityUpsertOne) SetDisplayName(v string) *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *UserIdentityUpsertOne) UpdateDisplayName() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *UserIdentityUpsertOne) ClearDisplayName() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (u *UserIdentityUpsertOne) SetAvatarURL(v string) *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetAvatarURL(v)
+	})
+}
+
+// UpdateAvatarURL sets the "avatar_url" field to the value that was provided on create.
+func (u *UserIdentityUpsertOne) UpdateAvatarURL() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateAvatarURL()
+	})
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (u *UserIdentityUpsertOne) ClearAvatarURL() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.ClearAvatarURL()
+	})
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (u *UserIdentityUpsertOne) SetLastLoginAt(v time.Time) *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetLastLoginAt(v)
+	})
+}
+
+// UpdateLastLoginAt sets the "last_login_at" field to the value that was provided on create.
+func (u *UserIdentityUpsertOne) UpdateLastLoginAt() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateLastLoginAt()
+	})
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (u *UserIdentityUpsertOne) ClearLastLoginAt() *UserIdentityUpsertOne {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.ClearLastLoginAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserIdentityUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserIdentityCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserIdentityUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserIdentityUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserIdentityUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserIdentityCreateBulk is the builder for creating many UserIdentity entities in bulk.
+type UserIdentityCreateBulk struct {
+	config
+	err      error
+	builders []*UserIdentityCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserIdentity entities in the database.
+func (uicb *UserIdentityCreateBulk) Save(ctx context.Context) ([]*UserIdentity, error) {
+	if uicb.err != nil {
+		return nil, uicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uicb.builders))
+	nodes := make([]*UserIdentity, len(uicb.builders))
+	mutators := make([]Mutator, len(uicb.builders))
+	for i := range uicb.builders {
+		func(i int, root context.Context) {
+			builder := uicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserIdentityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = uicb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uicb *UserIdentityCreateBulk) SaveX(ctx context.Context) []*UserIdentity {
+	v, err := uicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uicb *UserIdentityCreateBulk) Exec(ctx context.Context) error {
+	_, err := uicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uicb *UserIdentityCreateBulk) ExecX(ctx context.Context) {
+	if err := uicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserIdentity.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserIdentityUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (uicb *UserIdentityCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserIdentityUpsertBulk {
+	uicb.conflict = opts
+	return &UserIdentityUpsertBulk{
+		create: uicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserIdentity.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uicb *UserIdentityCreateBulk) OnConflictColumns(columns ...string) *UserIdentityUpsertBulk {
+	uicb.conflict = append(uicb.conflict, sql.ConflictColumns(columns...))
+	return &UserIdentityUpsertBulk{
+		create: uicb,
+	}
+}
+
+// UserIdentityUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserIdentity nodes.
+type UserIdentityUpsertBulk struct {
+	create *UserIdentityCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserIdentity.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(useridentity.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserIdentityUpsertBulk) UpdateNewValues() *UserIdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(useridentity.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(useridentity.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserIdentity.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserIdentityUpsertBulk) Ignore() *UserIdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserIdentityUpsertBulk) DoNothing() *UserIdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserIdentityCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserIdentityUpsertBulk) Update(set func(*UserIdentityUpsert)) *UserIdentityUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserIdentityUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserIdentityUpsertBulk) SetUpdatedAt(v time.Time) *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserIdentityUpsertBulk) UpdateUpdatedAt() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserIdentityUpsertBulk) SetUserID(v uint) *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserIdentityUpsertBulk) AddUserID(v uint) *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserIdentityUpsertBulk) UpdateUserID() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *UserIdentityUpsertBulk) SetProvider(v string) *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *UserIdentityUpsertBulk) UpdateProvider() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *UserIdentityUpsertBulk) SetSubject(v string) *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *UserIdentityUpsertBulk) UpdateSubject() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *UserIdentityUpsertBulk) SetEmail(v string) *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UserIdentityUpsertBulk) UpdateEmail() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *UserIdentityUpsertBulk) ClearEmail() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *UserIdentityUpsertBulk) SetDisplayName(v string) *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *UserIdentityUpsertBulk) UpdateDisplayName() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (u *UserIdentityUpsertBulk) ClearDisplayName() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.ClearDisplayName()
+	})
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (u *UserIdentityUpsertBulk) SetAvatarURL(v string) *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetAvatarURL(v)
+	})
+}
+
+// UpdateAvatarURL sets the "avatar_url" field to the value that was provided on create.
+func (u *UserIdentityUpsertBulk) UpdateAvatarURL() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateAvatarURL()
+	})
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (u *UserIdentityUpsertBulk) ClearAvatarURL() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.ClearAvatarURL()
+	})
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (u *UserIdentityUpsertBulk) SetLastLoginAt(v time.Time) *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.SetLastLoginAt(v)
+	})
+}
+
+// UpdateLastLoginAt sets the "last_login_at" field to the value that was provided on create.
+func (u *UserIdentityUpsertBulk) UpdateLastLoginAt() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.UpdateLastLoginAt()
+	})
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (u *UserIdentityUpsertBulk) ClearLastLoginAt() *UserIdentityUpsertBulk {
+	return u.Update(func(s *UserIdentityUpsert) {
+		s.ClearLastLoginAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserIdentityUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserIdentityCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserIdentityCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserIdentityUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
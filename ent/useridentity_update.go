@@ -0,0 +1,624 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
+)
+
+// UserIdentityUpdate is the builder for updating UserIdentity entities.
+type UserIdentityUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserIdentityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserIdentityUpdate builder.
+func (uiu *UserIdentityUpdate) Where(ps ...predicate.UserIdentity) *UserIdentityUpdate {
+	uiu.mutation.Where(ps...)
+	return uiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uiu *UserIdentityUpdate) SetUpdatedAt(t time.Time) *UserIdentityUpdate {
+	uiu.mutation.SetUpdatedAt(t)
+	return uiu
+}
+
+// SetUserID sets the "user_id" field.
+func (uiu *UserIdentityUpdate) SetUserID(u uint) *UserIdentityUpdate {
+	uiu.mutation.ResetUserID()
+	uiu.mutation.SetUserID(u)
+	return uiu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableUserID(u *uint) *UserIdentityUpdate {
+	if u != nil {
+		uiu.SetUserID(*u)
+	}
+	return uiu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (uiu *UserIdentityUpdate) AddUserID(u int) *UserIdentityUpdate {
+	uiu.mutation.AddUserID(u)
+	return uiu
+}
+
+// SetProvider sets the "provider" field.
+func (uiu *UserIdentityUpdate) SetProvider(s string) *UserIdentityUpdate {
+	uiu.mutation.SetProvider(s)
+	return uiu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableProvider(s *string) *UserIdentityUpdate {
+	if s != nil {
+		uiu.SetProvider(*s)
+	}
+	return uiu
+}
+
+// SetSubject sets the "subject" field.
+func (uiu *UserIdentityUpdate) SetSubject(s string) *UserIdentityUpdate {
+	uiu.mutation.SetSubject(s)
+	return uiu
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableSubject(s *string) *UserIdentityUpdate {
+	if s != nil {
+		uiu.SetSubject(*s)
+	}
+	return uiu
+}
+
+// SetEmail sets the "email" field.
+func (uiu *UserIdentityUpdate) SetEmail(s string) *UserIdentityUpdate {
+	uiu.mutation.SetEmail(s)
+	return uiu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableEmail(s *string) *UserIdentityUpdate {
+	if s != nil {
+		uiu.SetEmail(*s)
+	}
+	return uiu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (uiu *UserIdentityUpdate) ClearEmail() *UserIdentityUpdate {
+	uiu.mutation.ClearEmail()
+	return uiu
+}
+
+// SetDisplayName sets the "display_name" field.
+func (uiu *UserIdentityUpdate) SetDisplayName(s string) *UserIdentityUpdate {
+	uiu.mutation.SetDisplayName(s)
+	return uiu
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableDisplayName(s *string) *UserIdentityUpdate {
+	if s != nil {
+		uiu.SetDisplayName(*s)
+	}
+	return uiu
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (uiu *UserIdentityUpdate) ClearDisplayName() *UserIdentityUpdate {
+	uiu.mutation.ClearDisplayName()
+	return uiu
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (uiu *UserIdentityUpdate) SetAvatarURL(s string) *UserIdentityUpdate {
+	uiu.mutation.SetAvatarURL(s)
+	return uiu
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableAvatarURL(s *string) *UserIdentityUpdate {
+	if s != nil {
+		uiu.SetAvatarURL(*s)
+	}
+	return uiu
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (uiu *UserIdentityUpdate) ClearAvatarURL() *UserIdentityUpdate {
+	uiu.mutation.ClearAvatarURL()
+	return uiu
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (uiu *UserIdentityUpdate) SetLastLoginAt(t time.Time) *UserIdentityUpdate {
+	uiu.mutation.SetLastLoginAt(t)
+	return uiu
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableLastLoginAt(t *time.Time) *UserIdentityUpdate {
+	if t != nil {
+		uiu.SetLastLoginAt(*t)
+	}
+	return uiu
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (uiu *UserIdentityUpdate) ClearLastLoginAt() *UserIdentityUpdate {
+	uiu.mutation.ClearLastLoginAt()
+	return uiu
+}
+
+// Mutation returns the UserIdentityMutation object of the builder.
+func (uiu *UserIdentityUpdate) Mutation() *UserIdentityMutation {
+	return uiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uiu *UserIdentityUpdate) Save(ctx context.Context) (int, error) {
+	uiu.defaults()
+	return withHooks(ctx, uiu.sqlSave, uiu.mutation, uiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uiu *UserIdentityUpdate) SaveX(ctx context.Context) int {
+	affected, err := uiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uiu *UserIdentityUpdate) Exec(ctx context.Context) error {
+	_, err := uiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uiu *UserIdentityUpdate) ExecX(ctx context.Context) {
+	if err := uiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uiu *UserIdentityUpdate) defaults() {
+	if _, ok := uiu.mutation.UpdatedAt(); !ok {
+		v := useridentity.UpdateDefaultUpdatedAt()
+		uiu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uiu *UserIdentityUpdate) check() error {
+	if v, ok := uiu.mutation.Provider(); ok {
+		if err := useridentity.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.provider": %w`, err)}
+		}
+	}
+	if v, ok := uiu.mutation.Subject(); ok {
+		if err := useridentity.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.subject": %w`, err)}
+		}
+	}
+	if v, ok := uiu.mutation.Email(); ok {
+		if err := useridentity.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.email": %w`, err)}
+		}
+	}
+	if v, ok := uiu.mutation.DisplayName(); ok {
+		if err := useridentity.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.display_name": %w`, err)}
+		}
+	}
+	if v, ok := uiu.mutation.AvatarURL(); ok {
+		if err := useridentity.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.avatar_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uiu *UserIdentityUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserIdentityUpdate {
+	uiu.modifiers = append(uiu.modifiers, modifiers...)
+	return uiu
+}
+
+func (uiu *UserIdentityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(useridentity.Table, useridentity.Columns, sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeUint))
+	if ps := uiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(useridentity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uiu.mutation.UserID(); ok {
+		_spec.SetField(useridentity.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := uiu.mutation.AddedUserID(); ok {
+		_spec.AddField(useridentity.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := uiu.mutation.Provider(); ok {
+		_spec.SetField(useridentity.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := uiu.mutation.Subject(); ok {
+		_spec.SetField(useridentity.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := uiu.mutation.Email(); ok {
+		_spec.SetField(useridentity.FieldEmail, field.TypeString, value)
+	}
+	if uiu.mutation.EmailCleared() {
+		_spec.ClearField(useridentity.FieldEmail, field.TypeString)
+	}
+	if value, ok := uiu.mutation.DisplayName(); ok {
+		_spec.SetField(useridentity.FieldDisplayName, field.TypeString, value)
+	}
+	if uiu.mutation.DisplayNameCleared() {
+		_spec.ClearField(useridentity.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := uiu.mutation.AvatarURL(); ok {
+		_spec.SetField(useridentity.FieldAvatarURL, field.TypeString, value)
+	}
+	if uiu.mutation.AvatarURLCleared() {
+		_spec.ClearField(useridentity.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := uiu.mutation.LastLoginAt(); ok {
+		_spec.SetField(useridentity.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if uiu.mutation.LastLoginAtCleared() {
+		_spec.ClearField(useridentity.FieldLastLoginAt, field.TypeTime)
+	}
+	_spec.AddModifiers(uiu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, uiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{useridentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uiu.mutation.done = true
+	return n, nil
+}
+
+// UserIdentityUpdateOne is the builder for updating a single UserIdentity entity.
+type UserIdentityUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserIdentityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uiuo *UserIdentityUpdateOne) SetUpdatedAt(t time.Time) *UserIdentityUpdateOne {
+	uiuo.mutation.SetUpdatedAt(t)
+	return uiuo
+}
+
+// SetUserID sets the "user_id" field.
+func (uiuo *UserIdentityUpdateOne) SetUserID(u uint) *UserIdentityUpdateOne {
+	uiuo.mutation.ResetUserID()
+	uiuo.mutation.SetUserID(u)
+	return uiuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableUserID(u *uint) *UserIdentityUpdateOne {
+	if u != nil {
+		uiuo.SetUserID(*u)
+	}
+	return uiuo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (uiuo *UserIdentityUpdateOne) AddUserID(u int) *UserIdentityUpdateOne {
+	uiuo.mutation.AddUserID(u)
+	return uiuo
+}
+
+// SetProvider sets the "provider" field.
+func (uiuo *UserIdentityUpdateOne) SetProvider(s string) *UserIdentityUpdateOne {
+	uiuo.mutation.SetProvider(s)
+	return uiuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableProvider(s *string) *UserIdentityUpdateOne {
+	if s != nil {
+		uiuo.SetProvider(*s)
+	}
+	return uiuo
+}
+
+// SetSubject sets the "subject" field.
+func (uiuo *UserIdentityUpdateOne) SetSubject(s string) *UserIdentityUpdateOne {
+	uiuo.mutation.SetSubject(s)
+	return uiuo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableSubject(s *string) *UserIdentityUpdateOne {
+	if s != nil {
+		uiuo.SetSubject(*s)
+	}
+	return uiuo
+}
+
+// SetEmail sets the "email" field.
+func (uiuo *UserIdentityUpdateOne) SetEmail(s string) *UserIdentityUpdateOne {
+	uiuo.mutation.SetEmail(s)
+	return uiuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableEmail(s *string) *UserIdentityUpdateOne {
+	if s != nil {
+		uiuo.SetEmail(*s)
+	}
+	return uiuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (uiuo *UserIdentityUpdateOne) ClearEmail() *UserIdentityUpdateOne {
+	uiuo.mutation.ClearEmail()
+	return uiuo
+}
+
+// SetDisplayName sets the "display_name" field.
+func (uiuo *UserIdentityUpdateOne) SetDisplayName(s string) *UserIdentityUpdateOne {
+	uiuo.mutation.SetDisplayName(s)
+	return uiuo
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableDisplayName(s *string) *UserIdentityUpdateOne {
+	if s != nil {
+		uiuo.SetDisplayName(*s)
+	}
+	return uiuo
+}
+
+// ClearDisplayName clears the value of the "display_name" field.
+func (uiuo *UserIdentityUpdateOne) ClearDisplayName() *UserIdentityUpdateOne {
+	uiuo.mutation.ClearDisplayName()
+	return uiuo
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (uiuo *UserIdentityUpdateOne) SetAvatarURL(s string) *UserIdentityUpdateOne {
+	uiuo.mutation.SetAvatarURL(s)
+	return uiuo
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableAvatarURL(s *string) *UserIdentityUpdateOne {
+	if s != nil {
+		uiuo.SetAvatarURL(*s)
+	}
+	return uiuo
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (uiuo *UserIdentityUpdateOne) ClearAvatarURL() *UserIdentityUpdateOne {
+	uiuo.mutation.ClearAvatarURL()
+	return uiuo
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (uiuo *UserIdentityUpdateOne) SetLastLoginAt(t time.Time) *UserIdentityUpdateOne {
+	uiuo.mutation.SetLastLoginAt(t)
+	return uiuo
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableLastLoginAt(t *time.Time) *UserIdentityUpdateOne {
+	if t != nil {
+		uiuo.SetLastLoginAt(*t)
+	}
+	return uiuo
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (uiuo *UserIdentityUpdateOne) ClearLastLoginAt() *UserIdentityUpdateOne {
+	uiuo.mutation.ClearLastLoginAt()
+	return uiuo
+}
+
+// Mutation returns the UserIdentityMutation object of the builder.
+func (uiuo *UserIdentityUpdateOne) Mutation() *UserIdentityMutation {
+	return uiuo.mutation
+}
+
+// Where appends a list predicates to the UserIdentityUpdate builder.
+func (uiuo *UserIdentityUpdateOne) Where(ps ...predicate.UserIdentity) *UserIdentityUpdateOne {
+	uiuo.mutation.Where(ps...)
+	return uiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uiuo *UserIdentityUpdateOne) Select(field string, fields ...string) *UserIdentityUpdateOne {
+	uiuo.fields = append([]string{field}, fields...)
+	return uiuo
+}
+
+// Save executes the query and returns the updated UserIdentity entity.
+func (uiuo *UserIdentityUpdateOne) Save(ctx context.Context) (*UserIdentity, error) {
+	uiuo.defaults()
+	return withHooks(ctx, uiuo.sqlSave, uiuo.mutation, uiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uiuo *UserIdentityUpdateOne) SaveX(ctx context.Context) *UserIdentity {
+	node, err := uiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uiuo *UserIdentityUpdateOne) Exec(ctx context.Context) error {
+	_, err := uiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uiuo *UserIdentityUpdateOne) ExecX(ctx context.Context) {
+	if err := uiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uiuo *UserIdentityUpdateOne) defaults() {
+	if _, ok := uiuo.mutation.UpdatedAt(); !ok {
+		v := useridentity.UpdateDefaultUpdatedAt()
+		uiuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uiuo *UserIdentityUpdateOne) check() error {
+	if v, ok := uiuo.mutation.Provider(); ok {
+		if err := useridentity.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.provider": %w`, err)}
+		}
+	}
+	if v, ok := uiuo.mutation.Subject(); ok {
+		if err := useridentity.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.subject": %w`, err)}
+		}
+	}
+	if v, ok := uiuo.mutation.Email(); ok {
+		if err := useridentity.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.email": %w`, err)}
+		}
+	}
+	if v, ok := uiuo.mutation.DisplayName(); ok {
+		if err := useridentity.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.display_name": %w`, err)}
+		}
+	}
+	if v, ok := uiuo.mutation.AvatarURL(); ok {
+		if err := useridentity.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "UserIdentity.avatar_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uiuo *UserIdentityUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserIdentityUpdateOne {
+	uiuo.modifiers = append(uiuo.modifiers, modifiers...)
+	return uiuo
+}
+
+func (uiuo *UserIdentityUpdateOne) sqlSave(ctx context.Context) (_node *UserIdentity, err error) {
+	if err := uiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(useridentity.Table, useridentity.Columns, sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeUint))
+	id, ok := uiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserIdentity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, useridentity.FieldID)
+		for _, f := range fields {
+			if !useridentity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != useridentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(useridentity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uiuo.mutation.UserID(); ok {
+		_spec.SetField(useridentity.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := uiuo.mutation.AddedUserID(); ok {
+		_spec.AddField(useridentity.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := uiuo.mutation.Provider(); ok {
+		_spec.SetField(useridentity.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := uiuo.mutation.Subject(); ok {
+		_spec.SetField(useridentity.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := uiuo.mutation.Email(); ok {
+		_spec.SetField(useridentity.FieldEmail, field.TypeString, value)
+	}
+	if uiuo.mutation.EmailCleared() {
+		_spec.ClearField(useridentity.FieldEmail, field.TypeString)
+	}
+	if value, ok := uiuo.mutation.DisplayName(); ok {
+		_spec.SetField(useridentity.FieldDisplayName, field.TypeString, value)
+	}
+	if uiuo.mutation.DisplayNameCleared() {
+		_spec.ClearField(useridentity.FieldDisplayName, field.TypeString)
+	}
+	if value, ok := uiuo.mutation.AvatarURL(); ok {
+		_spec.SetField(useridentity.FieldAvatarURL, field.TypeString, value)
+	}
+	if uiuo.mutation.AvatarURLCleared() {
+		_spec.ClearField(useridentity.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := uiuo.mutation.LastLoginAt(); ok {
+		_spec.SetField(useridentity.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if uiuo.mutation.LastLoginAtCleared() {
+		_spec.ClearField(useridentity.FieldLastLoginAt, field.TypeTime)
+	}
+	_spec.AddModifiers(uiuo.modifiers...)
+	_node = &UserIdentity{config: uiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{useridentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uiuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/usersession"
+)
+
+// 登录会话表
+type UserSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 创建时间，即登录时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 所属用户ID
+	UserID uint `json:"user_id,omitempty"`
+	// 会话标识，写入刷新令牌和访问令牌中
+	SessionID string `json:"session_id,omitempty"`
+	// 根据 User-Agent 识别的设备描述
+	Device string `json:"device,omitempty"`
+	// 最近一次使用的IP
+	IP string `json:"ip,omitempty"`
+	// 最近一次使用的 User-Agent
+	UserAgent string `json:"user_agent,omitempty"`
+	// 最近一次刷新令牌的时间
+	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// 会话过期时间，与刷新令牌一致
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usersession.FieldID, usersession.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case usersession.FieldSessionID, usersession.FieldDevice, usersession.FieldIP, usersession.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case usersession.FieldCreatedAt, usersession.FieldUpdatedAt, usersession.FieldLastSeenAt, usersession.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserSession fields.
+func (us *UserSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usersession.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			us.ID = uint(value.Int64)
+		case usersession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				us.CreatedAt = value.Time
+			}
+		case usersession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				us.UpdatedAt = value.Time
+			}
+		case usersession.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				us.UserID = uint(value.Int64)
+			}
+		case usersession.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				us.SessionID = value.String
+			}
+		case usersession.FieldDevice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device", values[i])
+			} else if value.Valid {
+				us.Device = value.String
+			}
+		case usersession.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				us.IP = value.String
+			}
+		case usersession.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				us.UserAgent = value.String
+			}
+		case usersession.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				us.LastSeenAt = value.Time
+			}
+		case usersession.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				us.ExpiresAt = value.Time
+			}
+		default:
+			us.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserSession.
+// This includes values selected through modifiers, order, etc.
+func (us *UserSession) Value(name string) (ent.Value, error) {
+	return us.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserSession.
+// Note that you need to call UserSession.Unwrap() before calling this method if this UserSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (us *UserSession) Update() *UserSessionUpdateOne {
+	return NewUserSessionClient(us.config).UpdateOne(us)
+}
+
+// Unwrap unwraps the UserSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (us *UserSession) Unwrap() *UserSession {
+	_tx, ok := us.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserSession is not a transactional entity")
+	}
+	us.config.driver = _tx.drv
+	return us
+}
+
+// String implements the fmt.Stringer.
+func (us *UserSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", us.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(us.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(us.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", us.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(us.SessionID)
+	builder.WriteString(", ")
+	builder.WriteString("device=")
+	builder.WriteString(us.Device)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(us.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(us.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(us.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(us.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserSessions is a parsable slice of UserSession.
+type UserSessions []*UserSession
@@ -0,0 +1,998 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/usersession"
+)
+
+// UserSessionCreate is the builder for creating a UserSession entity.
+type UserSessionCreate struct {
+	config
+	mutation *UserSessionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (usc *UserSessionCreate) SetCreatedAt(t time.Time) *UserSessionCreate {
+	usc.mutation.SetCreatedAt(t)
+	return usc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (usc *UserSessionCreate) SetNillableCreatedAt(t *time.Time) *UserSessionCreate {
+	if t != nil {
+		usc.SetCreatedAt(*t)
+	}
+	return usc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (usc *UserSessionCreate) SetUpdatedAt(t time.Time) *UserSessionCreate {
+	usc.mutation.SetUpdatedAt(t)
+	return usc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (usc *UserSessionCreate) SetNillableUpdatedAt(t *time.Time) *UserSessionCreate {
+	if t != nil {
+		usc.SetUpdatedAt(*t)
+	}
+	return usc
+}
+
+// SetUserID sets the "user_id" field.
+func (usc *UserSessionCreate) SetUserID(u uint) *UserSessionCreate {
+	usc.mutation.SetUserID(u)
+	return usc
+}
+
+// SetSessionID sets the "session_id" field.
+func (usc *UserSessionCreate) SetSessionID(s string) *UserSessionCreate {
+	usc.mutation.SetSessionID(s)
+	return usc
+}
+
+// SetDevice sets the "device" field.
+func (usc *UserSessionCreate) SetDevice(s string) *UserSessionCreate {
+	usc.mutation.SetDevice(s)
+	return usc
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (usc *UserSessionCreate) SetNillableDevice(s *string) *UserSessionCreate {
+	if s != nil {
+		usc.SetDevice(*s)
+	}
+	return usc
+}
+
+// SetIP sets the "ip" field.
+func (usc *UserSessionCreate) SetIP(s string) *UserSessionCreate {
+	usc.mutation.SetIP(s)
+	return usc
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (usc *UserSessionCreate) SetNillableIP(s *string) *UserSessionCreate {
+	if s != nil {
+		usc.SetIP(*s)
+	}
+	return usc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (usc *UserSessionCreate) SetUserAgent(s string) *UserSessionCreate {
+	usc.mutation.SetUserAgent(s)
+	return usc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (usc *UserSessionCreate) SetNillableUserAgent(s *string) *UserSessionCreate {
+	if s != nil {
+		usc.SetUserAgent(*s)
+	}
+	return usc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (usc *UserSessionCreate) SetLastSeenAt(t time.Time) *UserSessionCreate {
+	usc.mutation.SetLastSeenAt(t)
+	return usc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (usc *UserSessionCreate) SetNillableLastSeenAt(t *time.Time) *UserSessionCreate {
+	if t != nil {
+		usc.SetLastSeenAt(*t)
+	}
+	return usc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (usc *UserSessionCreate) SetExpiresAt(t time.Time) *UserSessionCreate {
+	usc.mutation.SetExpiresAt(t)
+	return usc
+}
+
+// SetID sets the "id" field.
+func (usc *UserSessionCreate) SetID(u uint) *UserSessionCreate {
+	usc.mutation.SetID(u)
+	return usc
+}
+
+// Mutation returns the UserSessionMutation object of the builder.
+func (usc *UserSessionCreate) Mutation() *UserSessionMutation {
+	return usc.mutation
+}
+
+// Save creates the UserSession in the database.
+func (usc *UserSessionCreate) Save(ctx context.Context) (*UserSession, error) {
+	usc.defaults()
+	return withHooks(ctx, usc.sqlSave, usc.mutation, usc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (usc *UserSessionCreate) SaveX(ctx context.Context) *UserSession {
+	v, err := usc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (usc *UserSessionCreate) Exec(ctx context.Context) error {
+	_, err := usc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usc *UserSessionCreate) ExecX(ctx context.Context) {
+	if err := usc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (usc *UserSessionCreate) defaults() {
+	if _, ok := usc.mutation.CreatedAt(); !ok {
+		v := usersession.DefaultCreatedAt()
+		usc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := usc.mutation.UpdatedAt(); !ok {
+		v := usersession.DefaultUpdatedAt()
+		usc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := usc.mutation.LastSeenAt(); !ok {
+		v := usersession.DefaultLastSeenAt()
+		usc.mutation.SetLastSeenAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (usc *UserSessionCreate) check() error {
+	if _, ok := usc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserSession.created_at"`)}
+	}
+	if _, ok := usc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserSession.updated_at"`)}
+	}
+	if _, ok := usc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserSession.user_id"`)}
+	}
+	if _, ok := usc.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "UserSession.session_id"`)}
+	}
+	if v, ok := usc.mutation.SessionID(); ok {
+		if err := usersession.SessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "session_id", err: fmt.Errorf(`ent: validator failed for field "UserSession.session_id": %w`, err)}
+		}
+	}
+	if v, ok := usc.mutation.Device(); ok {
+		if err := usersession.DeviceValidator(v); err != nil {
+			return &ValidationError{Name: "device", err: fmt.Errorf(`ent: validator failed for field "UserSession.device": %w`, err)}
+		}
+	}
+	if v, ok := usc.mutation.IP(); ok {
+		if err := usersession.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "UserSession.ip": %w`, err)}
+		}
+	}
+	if v, ok := usc.mutation.UserAgent(); ok {
+		if err := usersession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "UserSession.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := usc.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`ent: missing required field "UserSession.last_seen_at"`)}
+	}
+	if _, ok := usc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "UserSession.expires_at"`)}
+	}
+	return nil
+}
+
+func (usc *UserSessionCreate) sqlSave(ctx context.Context) (*UserSession, error) {
+	if err := usc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := usc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, usc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	usc.mutation.id = &_node.ID
+	usc.mutation.done = true
+	return _node, nil
+}
+
+func (usc *UserSessionCreate) createSpec() (*UserSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserSession{config: usc.config}
+		_spec = sqlgraph.NewCreateSpec(usersession.Table, sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = usc.conflict
+	if id, ok := usc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := usc.mutation.CreatedAt(); ok {
+		_spec.SetField(usersession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := usc.mutation.UpdatedAt(); ok {
+		_spec.SetField(usersession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := usc.mutation.UserID(); ok {
+		_spec.SetField(usersession.FieldUserID, field.TypeUint, value)
+		_node.UserID = value
+	}
+	if value, ok := usc.mutation.SessionID(); ok {
+		_spec.SetField(usersession.FieldSessionID, field.TypeString, value)
+		_node.SessionID = value
+	}
+	if value, ok := usc.mutation.Device(); ok {
+		_spec.SetField(usersession.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
+	if value, ok := usc.mutation.IP(); ok {
+		_spec.SetField(usersession.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := usc.mutation.UserAgent(); ok {
+		_spec.SetField(usersession.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := usc.mutation.LastSeenAt(); ok {
+		_spec.SetField(usersession.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if value, ok := usc.mutation.ExpiresAt(); ok {
+		_spec.SetField(usersession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserSession.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserSessionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (usc *UserSessionCreate) OnConflict(opts ...sql.ConflictOption) *UserSessionUpsertOne {
+	usc.conflict = opts
+	return &UserSessionUpsertOne{
+		create: usc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (usc *UserSessionCreate) OnConflictColumns(columns ...string) *UserSessionUpsertOne {
+	usc.conflict = append(usc.conflict, sql.ConflictColumns(columns...))
+	return &UserSessionUpsertOne{
+		create: usc,
+	}
+}
+
+type (
+	// UserSessionUpsertOne is the builder for "upsert"-ing
+	//  one UserSession node.
+	UserSessionUpsertOne struct {
+		create *UserSessionCreate
+	}
+
+	// UserSessionUpsert is the "OnConflict" setter.
+	UserSessionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserSessionUpsert) SetUpdatedAt(v time.Time) *UserSessionUpsert {
+	u.Set(usersession.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserSessionUpsert) UpdateUpdatedAt() *UserSessionUpsert {
+	u.SetExcluded(usersession.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserSessionUpsert) SetUserID(v uint) *UserSessionUpsert {
+	u.Set(usersession.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserSessionUpsert) UpdateUserID() *UserSessionUpsert {
+	u.SetExcluded(usersession.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserSessionUpsert) AddUserID(v uint) *UserSessionUpsert {
+	u.Add(usersession.FieldUserID, v)
+	return u
+}
+
+// SetDevice sets the "device" field.
+func (u *UserSessionUpsert) SetDevice(v string) *UserSessionUpsert {
+	u.Set(usersession.FieldDevice, v)
+	return u
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *UserSessionUpsert) UpdateDevice() *UserSessionUpsert {
+	u.SetExcluded(usersession.FieldDevice)
+	return u
+}
+
+// ClearDevice clears the value of the "device" field.
+func (u *UserSessionUpsert) ClearDevice() *UserSessionUpsert {
+	u.SetNull(usersession.FieldDevice)
+	return u
+}
+
+// SetIP sets the "ip" field.
+func (u *UserSessionUpsert) SetIP(v string) *UserSessionUpsert {
+	u.Set(usersession.FieldIP, v)
+	return u
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *UserSessionUpsert) UpdateIP() *UserSessionUpsert {
+	u.SetExcluded(usersession.FieldIP)
+	return u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *UserSessionUpsert) ClearIP() *UserSessionUpsert {
+	u.SetNull(usersession.FieldIP)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *UserSessionUpsert) SetUserAgent(v string) *UserSessionUpsert {
+	u.Set(usersession.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *UserSessionUpsert) UpdateUserAgent() *UserSessionUpsert {
+	u.SetExcluded(usersession.FieldUserAgent)
+	return u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *UserSessionUpsert) ClearUserAgent() *UserSessionUpsert {
+	u.SetNull(usersession.FieldUserAgent)
+	return u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserSessionUpsert) SetLastSeenAt(v time.Time) *UserSessionUpsert {
+	u.Set(usersession.FieldLastSeenAt, v)
+	return u
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserSessionUpsert) UpdateLastSeenAt() *UserSessionUpsert {
+	u.SetExcluded(usersession.FieldLastSeenAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UserSessionUpsert) SetExpiresAt(v time.Time) *UserSessionUpsert {
+	u.Set(usersession.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UserSessionUpsert) UpdateExpiresAt() *UserSessionUpsert {
+	u.SetExcluded(usersession.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usersession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserSessionUpsertOne) UpdateNewValues() *UserSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(usersession.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usersession.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.SessionID(); exists {
+			s.SetIgnore(usersession.FieldSessionID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserSession.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserSessionUpsertOne) Ignore() *UserSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserSessionUpsertOne) DoNothing() *UserSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserSessionCreate.OnConflict
+// documentation for more info.
+func (u *UserSessionUpsertOne) Update(set func(*UserSessionUpsert)) *UserSessionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserSessionUpsertOne) SetUpdatedAt(v time.Time) *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserSessionUpsertOne) UpdateUpdatedAt() *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserSessionUpsertOne) SetUserID(v uint) *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserSessionUpsertOne) AddUserID(v uint) *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserSessionUpsertOne) UpdateUserID() *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDevice sets the "device" field.
+func (u *UserSessionUpsertOne) SetDevice(v string) *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetDevice(v)
+	})
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *UserSessionUpsertOne) UpdateDevice() *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateDevice()
+	})
+}
+
+// ClearDevice clears the value of the "device" field.
+func (u *UserSessionUpsertOne) ClearDevice() *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.ClearDevice()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *UserSessionUpsertOne) SetIP(v string) *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *UserSessionUpsertOne) UpdateIP() *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *UserSessionUpsertOne) ClearIP() *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *UserSessionUpsertOne) SetUserAgent(v string) *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *UserSessionUpsertOne) UpdateUserAgent() *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *UserSessionUpsertOne) ClearUserAgent() *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserSessionUpsertOne) SetLastSeenAt(v time.Time) *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserSessionUpsertOne) UpdateLastSeenAt() *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UserSessionUpsertOne) SetExpiresAt(v time.Time) *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UserSessionUpsertOne) UpdateExpiresAt() *UserSessionUpsertOne {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserSessionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserSessionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserSessionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserSessionUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserSessionUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserSessionCreateBulk is the builder for creating many UserSession entities in bulk.
+type UserSessionCreateBulk struct {
+	config
+	err      error
+	builders []*UserSessionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserSession entities in the database.
+func (uscb *UserSessionCreateBulk) Save(ctx context.Context) ([]*UserSession, error) {
+	if uscb.err != nil {
+		return nil, uscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uscb.builders))
+	nodes := make([]*UserSession, len(uscb.builders))
+	mutators := make([]Mutator, len(uscb.builders))
+	for i := range uscb.builders {
+		func(i int, root context.Context) {
+			builder := uscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = uscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uscb *UserSessionCreateBulk) SaveX(ctx context.Context) []*UserSession {
+	v, err := uscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uscb *UserSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := uscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uscb *UserSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := uscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserSession.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserSessionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (uscb *UserSessionCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserSessionUpsertBulk {
+	uscb.conflict = opts
+	return &UserSessionUpsertBulk{
+		create: uscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserSession.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uscb *UserSessionCreateBulk) OnConflictColumns(columns ...string) *UserSessionUpsertBulk {
+	uscb.conflict = append(uscb.conflict, sql.ConflictColumns(columns...))
+	return &UserSessionUpsertBulk{
+		create: uscb,
+	}
+}
+
+// UserSessionUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserSession nodes.
+type UserSessionUpsertBulk struct {
+	create *UserSessionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserSession.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usersession.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserSessionUpsertBulk) UpdateNewValues() *UserSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(usersession.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usersession.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.SessionID(); exists {
+				s.SetIgnore(usersession.FieldSessionID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserSession.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserSessionUpsertBulk) Ignore() *UserSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserSessionUpsertBulk) DoNothing() *UserSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserSessionCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserSessionUpsertBulk) Update(set func(*UserSessionUpsert)) *UserSessionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserSessionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserSessionUpsertBulk) SetUpdatedAt(v time.Time) *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserSessionUpsertBulk) UpdateUpdatedAt() *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserSessionUpsertBulk) SetUserID(v uint) *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserSessionUpsertBulk) AddUserID(v uint) *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserSessionUpsertBulk) UpdateUserID() *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDevice sets the "device" field.
+func (u *UserSessionUpsertBulk) SetDevice(v string) *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetDevice(v)
+	})
+}
+
+// UpdateDevice sets the "device" field to the value that was provided on create.
+func (u *UserSessionUpsertBulk) UpdateDevice() *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateDevice()
+	})
+}
+
+// ClearDevice clears the value of the "device" field.
+func (u *UserSessionUpsertBulk) ClearDevice() *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.ClearDevice()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *UserSessionUpsertBulk) SetIP(v string) *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *UserSessionUpsertBulk) UpdateIP() *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// ClearIP clears the value of the "ip" field.
+func (u *UserSessionUpsertBulk) ClearIP() *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.ClearIP()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *UserSessionUpsertBulk) SetUserAgent(v string) *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *UserSessionUpsertBulk) UpdateUserAgent() *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *UserSessionUpsertBulk) ClearUserAgent() *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *UserSessionUpsertBulk) SetLastSeenAt(v time.Time) *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *UserSessionUpsertBulk) UpdateLastSeenAt() *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UserSessionUpsertBulk) SetExpiresAt(v time.Time) *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UserSessionUpsertBulk) UpdateExpiresAt() *UserSessionUpsertBulk {
+	return u.Update(func(s *UserSessionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserSessionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserSessionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserSessionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserSessionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/usersession"
+)
+
+// UserSessionUpdate is the builder for updating UserSession entities.
+type UserSessionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserSessionUpdate builder.
+func (usu *UserSessionUpdate) Where(ps ...predicate.UserSession) *UserSessionUpdate {
+	usu.mutation.Where(ps...)
+	return usu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (usu *UserSessionUpdate) SetUpdatedAt(t time.Time) *UserSessionUpdate {
+	usu.mutation.SetUpdatedAt(t)
+	return usu
+}
+
+// SetUserID sets the "user_id" field.
+func (usu *UserSessionUpdate) SetUserID(u uint) *UserSessionUpdate {
+	usu.mutation.ResetUserID()
+	usu.mutation.SetUserID(u)
+	return usu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (usu *UserSessionUpdate) SetNillableUserID(u *uint) *UserSessionUpdate {
+	if u != nil {
+		usu.SetUserID(*u)
+	}
+	return usu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (usu *UserSessionUpdate) AddUserID(u int) *UserSessionUpdate {
+	usu.mutation.AddUserID(u)
+	return usu
+}
+
+// SetDevice sets the "device" field.
+func (usu *UserSessionUpdate) SetDevice(s string) *UserSessionUpdate {
+	usu.mutation.SetDevice(s)
+	return usu
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (usu *UserSessionUpdate) SetNillableDevice(s *string) *UserSessionUpdate {
+	if s != nil {
+		usu.SetDevice(*s)
+	}
+	return usu
+}
+
+// ClearDevice clears the value of the "device" field.
+func (usu *UserSessionUpdate) ClearDevice() *UserSessionUpdate {
+	usu.mutation.ClearDevice()
+	return usu
+}
+
+// SetIP sets the "ip" field.
+func (usu *UserSessionUpdate) SetIP(s string) *UserSessionUpdate {
+	usu.mutation.SetIP(s)
+	return usu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (usu *UserSessionUpdate) SetNillableIP(s *string) *UserSessionUpdate {
+	if s != nil {
+		usu.SetIP(*s)
+	}
+	return usu
+}
+
+// ClearIP clears the value of the "ip" field.
+func (usu *UserSessionUpdate) ClearIP() *UserSessionUpdate {
+	usu.mutation.ClearIP()
+	return usu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (usu *UserSessionUpdate) SetUserAgent(s string) *UserSessionUpdate {
+	usu.mutation.SetUserAgent(s)
+	return usu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (usu *UserSessionUpdate) SetNillableUserAgent(s *string) *UserSessionUpdate {
+	if s != nil {
+		usu.SetUserAgent(*s)
+	}
+	return usu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (usu *UserSessionUpdate) ClearUserAgent() *UserSessionUpdate {
+	usu.mutation.ClearUserAgent()
+	return usu
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (usu *UserSessionUpdate) SetLastSeenAt(t time.Time) *UserSessionUpdate {
+	usu.mutation.SetLastSeenAt(t)
+	return usu
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (usu *UserSessionUpdate) SetNillableLastSeenAt(t *time.Time) *UserSessionUpdate {
+	if t != nil {
+		usu.SetLastSeenAt(*t)
+	}
+	return usu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (usu *UserSessionUpdate) SetExpiresAt(t time.Time) *UserSessionUpdate {
+	usu.mutation.SetExpiresAt(t)
+	return usu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (usu *UserSessionUpdate) SetNillableExpiresAt(t *time.Time) *UserSessionUpdate {
+	if t != nil {
+		usu.SetExpiresAt(*t)
+	}
+	return usu
+}
+
+// Mutation returns the UserSessionMutation object of the builder.
+func (usu *UserSessionUpdate) Mutation() *UserSessionMutation {
+	return usu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (usu *UserSessionUpdate) Save(ctx context.Context) (int, error) {
+	usu.defaults()
+	return withHooks(ctx, usu.sqlSave, usu.mutation, usu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (usu *UserSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := usu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (usu *UserSessionUpdate) Exec(ctx context.Context) error {
+	_, err := usu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usu *UserSessionUpdate) ExecX(ctx context.Context) {
+	if err := usu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (usu *UserSessionUpdate) defaults() {
+	if _, ok := usu.mutation.UpdatedAt(); !ok {
+		v := usersession.UpdateDefaultUpdatedAt()
+		usu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (usu *UserSessionUpdate) check() error {
+	if v, ok := usu.mutation.Device(); ok {
+		if err := usersession.DeviceValidator(v); err != nil {
+			return &ValidationError{Name: "device", err: fmt.Errorf(`ent: validator failed for field "UserSession.device": %w`, err)}
+		}
+	}
+	if v, ok := usu.mutation.IP(); ok {
+		if err := usersession.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "UserSession.ip": %w`, err)}
+		}
+	}
+	if v, ok := usu.mutation.UserAgent(); ok {
+		if err := usersession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "UserSession.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (usu *UserSessionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserSessionUpdate {
+	usu.modifiers = append(usu.modifiers, modifiers...)
+	return usu
+}
+
+func (usu *UserSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := usu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usersession.Table, usersession.Columns, sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeUint))
+	if ps := usu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := usu.mutation.UpdatedAt(); ok {
+		_spec.SetField(usersession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := usu.mutation.UserID(); ok {
+		_spec.SetField(usersession.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := usu.mutation.AddedUserID(); ok {
+		_spec.AddField(usersession.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := usu.mutation.Device(); ok {
+		_spec.SetField(usersession.FieldDevice, field.TypeString, value)
+	}
+	if usu.mutation.DeviceCleared() {
+		_spec.ClearField(usersession.FieldDevice, field.TypeString)
+	}
+	if value, ok := usu.mutation.IP(); ok {
+		_spec.SetField(usersession.FieldIP, field.TypeString, value)
+	}
+	if usu.mutation.IPCleared() {
+		_spec.ClearField(usersession.FieldIP, field.TypeString)
+	}
+	if value, ok := usu.mutation.UserAgent(); ok {
+		_spec.SetField(usersession.FieldUserAgent, field.TypeString, value)
+	}
+	if usu.mutation.UserAgentCleared() {
+		_spec.ClearField(usersession.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := usu.mutation.LastSeenAt(); ok {
+		_spec.SetField(usersession.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := usu.mutation.ExpiresAt(); ok {
+		_spec.SetField(usersession.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(usu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, usu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usersession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	usu.mutation.done = true
+	return n, nil
+}
+
+// UserSessionUpdateOne is the builder for updating a single UserSession entity.
+type UserSessionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (usuo *UserSessionUpdateOne) SetUpdatedAt(t time.Time) *UserSessionUpdateOne {
+	usuo.mutation.SetUpdatedAt(t)
+	return usuo
+}
+
+// SetUserID sets the "user_id" field.
+func (usuo *UserSessionUpdateOne) SetUserID(u uint) *UserSessionUpdateOne {
+	usuo.mutation.ResetUserID()
+	usuo.mutation.SetUserID(u)
+	return usuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (usuo *UserSessionUpdateOne) SetNillableUserID(u *uint) *UserSessionUpdateOne {
+	if u != nil {
+		usuo.SetUserID(*u)
+	}
+	return usuo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (usuo *UserSessionUpdateOne) AddUserID(u int) *UserSessionUpdateOne {
+	usuo.mutation.AddUserID(u)
+	return usuo
+}
+
+// SetDevice sets the "device" field.
+func (usuo *UserSessionUpdateOne) SetDevice(s string) *UserSessionUpdateOne {
+	usuo.mutation.SetDevice(s)
+	return usuo
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (usuo *UserSessionUpdateOne) SetNillableDevice(s *string) *UserSessionUpdateOne {
+	if s != nil {
+		usuo.SetDevice(*s)
+	}
+	return usuo
+}
+
+// ClearDevice clears the value of the "device" field.
+func (usuo *UserSessionUpdateOne) ClearDevice() *UserSessionUpdateOne {
+	usuo.mutation.ClearDevice()
+	return usuo
+}
+
+// SetIP sets the "ip" field.
+func (usuo *UserSessionUpdateOne) SetIP(s string) *UserSessionUpdateOne {
+	usuo.mutation.SetIP(s)
+	return usuo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (usuo *UserSessionUpdateOne) SetNillableIP(s *string) *UserSessionUpdateOne {
+	if s != nil {
+		usuo.SetIP(*s)
+	}
+	return usuo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (usuo *UserSessionUpdateOne) ClearIP() *UserSessionUpdateOne {
+	usuo.mutation.ClearIP()
+	return usuo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (usuo *UserSessionUpdateOne) SetUserAgent(s string) *UserSessionUpdateOne {
+	usuo.mutation.SetUserAgent(s)
+	return usuo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (usuo *UserSessionUpdateOne) SetNillableUserAgent(s *string) *UserSessionUpdateOne {
+	if s != nil {
+		usuo.SetUserAgent(*s)
+	}
+	return usuo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (usuo *UserSessionUpdateOne) ClearUserAgent() *UserSessionUpdateOne {
+	usuo.mutation.ClearUserAgent()
+	return usuo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (usuo *UserSessionUpdateOne) SetLastSeenAt(t time.Time) *UserSessionUpdateOne {
+	usuo.mutation.SetLastSeenAt(t)
+	return usuo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (usuo *UserSessionUpdateOne) SetNillableLastSeenAt(t *time.Time) *UserSessionUpdateOne {
+	if t != nil {
+		usuo.SetLastSeenAt(*t)
+	}
+	return usuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (usuo *UserSessionUpdateOne) SetExpiresAt(t time.Time) *UserSessionUpdateOne {
+	usuo.mutation.SetExpiresAt(t)
+	return usuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (usuo *UserSessionUpdateOne) SetNillableExpiresAt(t *time.Time) *UserSessionUpdateOne {
+	if t != nil {
+		usuo.SetExpiresAt(*t)
+	}
+	return usuo
+}
+
+// Mutation returns the UserSessionMutation object of the builder.
+func (usuo *UserSessionUpdateOne) Mutation() *UserSessionMutation {
+	return usuo.mutation
+}
+
+// Where appends a list predicates to the UserSessionUpdate builder.
+func (usuo *UserSessionUpdateOne) Where(ps ...predicate.UserSession) *UserSessionUpdateOne {
+	usuo.mutation.Where(ps...)
+	return usuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (usuo *UserSessionUpdateOne) Select(field string, fields ...string) *UserSessionUpdateOne {
+	usuo.fields = append([]string{field}, fields...)
+	return usuo
+}
+
+// Save executes the query and returns the updated UserSession entity.
+func (usuo *UserSessionUpdateOne) Save(ctx context.Context) (*UserSession, error) {
+	usuo.defaults()
+	return withHooks(ctx, usuo.sqlSave, usuo.mutation, usuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (usuo *UserSessionUpdateOne) SaveX(ctx context.Context) *UserSession {
+	node, err := usuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (usuo *UserSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := usuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (usuo *UserSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := usuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (usuo *UserSessionUpdateOne) defaults() {
+	if _, ok := usuo.mutation.UpdatedAt(); !ok {
+		v := usersession.UpdateDefaultUpdatedAt()
+		usuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (usuo *UserSessionUpdateOne) check() error {
+	if v, ok := usuo.mutation.Device(); ok {
+		if err := usersession.DeviceValidator(v); err != nil {
+			return &ValidationError{Name: "device", err: fmt.Errorf(`ent: validator failed for field "UserSession.device": %w`, err)}
+		}
+	}
+	if v, ok := usuo.mutation.IP(); ok {
+		if err := usersession.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "UserSession.ip": %w`, err)}
+		}
+	}
+	if v, ok := usuo.mutation.UserAgent(); ok {
+		if err := usersession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "UserSession.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (usuo *UserSessionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserSessionUpdateOne {
+	usuo.modifiers = append(usuo.modifiers, modifiers...)
+	return usuo
+}
+
+func (usuo *UserSessionUpdateOne) sqlSave(ctx context.Context) (_node *UserSession, err error) {
+	if err := usuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usersession.Table, usersession.Columns, sqlgraph.NewFieldSpec(usersession.FieldID, field.TypeUint))
+	id, ok := usuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := usuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usersession.FieldID)
+		for _, f := range fields {
+			if !usersession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usersession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := usuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := usuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(usersession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := usuo.mutation.UserID(); ok {
+		_spec.SetField(usersession.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := usuo.mutation.AddedUserID(); ok {
+		_spec.AddField(usersession.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := usuo.mutation.Device(); ok {
+		_spec.SetField(usersession.FieldDevice, field.TypeString, value)
+	}
+	if usuo.mutation.DeviceCleared() {
+		_spec.ClearField(usersession.FieldDevice, field.TypeString)
+	}
+	if value, ok := usuo.mutation.IP(); ok {
+		_spec.SetField(usersession.FieldIP, field.TypeString, value)
+	}
+	if usuo.mutation.IPCleared() {
+		_spec.ClearField(usersession.FieldIP, field.TypeString)
+	}
+	if value, ok := usuo.mutation.UserAgent(); ok {
+		_spec.SetField(usersession.FieldUserAgent, field.TypeString, value)
+	}
+	if usuo.mutation.UserAgentCleared() {
+		_spec.ClearField(usersession.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := usuo.mutation.LastSeenAt(); ok {
+		_spec.SetField(usersession.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if value, ok := usuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(usersession.FieldExpiresAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(usuo.modifiers...)
+	_node = &UserSession{config: usuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, usuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usersession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	usuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/usertwofactor"
+)
+
+// 用户两步验证表
+type UserTwoFactor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 所属用户ID
+	UserID uint `json:"user_id,omitempty"`
+	// TOTP 密钥 (Base32)
+	Secret string `json:"-"`
+	// 是否已完成绑定并启用，未启用的记录表示绑定尚未确认
+	Enabled bool `json:"enabled,omitempty"`
+	// 未使用的恢复码 (SHA-256 哈希)
+	RecoveryCodes []string `json:"-"`
+	// 最近一次成功使用的 TOTP 时间步，用于防止验证码重放
+	LastUsedStep int64 `json:"last_used_step,omitempty"`
+	// 启用时间
+	EnabledAt    *time.Time `json:"enabled_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserTwoFactor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usertwofactor.FieldRecoveryCodes:
+			values[i] = new([]byte)
+		case usertwofactor.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case usertwofactor.FieldID, usertwofactor.FieldUserID, usertwofactor.FieldLastUsedStep:
+			values[i] = new(sql.NullInt64)
+		case usertwofactor.FieldSecret:
+			values[i] = new(sql.NullString)
+		case usertwofactor.FieldCreatedAt, usertwofactor.FieldUpdatedAt, usertwofactor.FieldEnabledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserTwoFactor fields.
+func (utf *UserTwoFactor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usertwofactor.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			utf.ID = uint(value.Int64)
+		case usertwofactor.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				utf.CreatedAt = value.Time
+			}
+		case usertwofactor.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				utf.UpdatedAt = value.Time
+			}
+		case usertwofactor.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				utf.UserID = uint(value.Int64)
+			}
+		case usertwofactor.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				utf.Secret = value.String
+			}
+		case usertwofactor.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				utf.Enabled = value.Bool
+			}
+		case usertwofactor.FieldRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &utf.RecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field recovery_codes: %w", err)
+				}
+			}
+		case usertwofactor.FieldLastUsedStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_step", values[i])
+			} else if value.Valid {
+				utf.LastUsedStep = value.Int64
+			}
+		case usertwofactor.FieldEnabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled_at", values[i])
+			} else if value.Valid {
+				utf.EnabledAt = new(time.Time)
+				*utf.EnabledAt = value.Time
+			}
+		default:
+			utf.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserTwoFactor.
+// This includes values selected through modifiers, order, etc.
+func (utf *UserTwoFactor) Value(name string) (ent.Value, error) {
+	return utf.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserTwoFactor.
+// Note that you need to call UserTwoFactor.Unwrap() before calling this method if this UserTwoFactor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (utf *UserTwoFactor) Update() *UserTwoFactorUpdateOne {
+	return NewUserTwoFactorClient(utf.config).UpdateOne(utf)
+}
+
+// Unwrap unwraps the UserTwoFactor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (utf *UserTwoFactor) Unwrap() *UserTwoFactor {
+	_tx, ok := utf.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserTwoFactor is not a transactional entity")
+	}
+	utf.config.driver = _tx.drv
+	return utf
+}
+
+// String implements the fmt.Stringer.
+func (utf *UserTwoFactor) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserTwoFactor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", utf.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(utf.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(utf.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", utf.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", utf.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("recovery_codes=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("last_used_step=")
+	builder.WriteString(fmt.Sprintf("%v", utf.LastUsedStep))
+	builder.WriteString(", ")
+	if v := utf.EnabledAt; v != nil {
+		builder.WriteString("enabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserTwoFactors is a parsable slice of UserTwoFactor.
+type UserTwoFactors []*UserTwoFactor
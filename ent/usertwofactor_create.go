@@ -0,0 +1,963 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/usertwofactor"
+)
+
+// UserTwoFactorCreate is the builder for creating a UserTwoFactor entity.
+type UserTwoFactorCreate struct {
+	config
+	mutation *UserTwoFactorMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (utfc *UserTwoFactorCreate) SetCreatedAt(t time.Time) *UserTwoFactorCreate {
+	utfc.mutation.SetCreatedAt(t)
+	return utfc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (utfc *UserTwoFactorCreate) SetNillableCreatedAt(t *time.Time) *UserTwoFactorCreate {
+	if t != nil {
+		utfc.SetCreatedAt(*t)
+	}
+	return utfc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utfc *UserTwoFactorCreate) SetUpdatedAt(t time.Time) *UserTwoFactorCreate {
+	utfc.mutation.SetUpdatedAt(t)
+	return utfc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (utfc *UserTwoFactorCreate) SetNillableUpdatedAt(t *time.Time) *UserTwoFactorCreate {
+	if t != nil {
+		utfc.SetUpdatedAt(*t)
+	}
+	return utfc
+}
+
+// SetUserID sets the "user_id" field.
+func (utfc *UserTwoFactorCreate) SetUserID(u uint) *UserTwoFactorCreate {
+	utfc.mutation.SetUserID(u)
+	return utfc
+}
+
+// SetSecret sets the "secret" field.
+func (utfc *UserTwoFactorCreate) SetSecret(s string) *UserTwoFactorCreate {
+	utfc.mutation.SetSecret(s)
+	return utfc
+}
+
+// SetEnabled sets the "enabled" field.
+func (utfc *UserTwoFactorCreate) SetEnabled(b bool) *UserTwoFactorCreate {
+	utfc.mutation.SetEnabled(b)
+	return utfc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (utfc *UserTwoFactorCreate) SetNillableEnabled(b *bool) *UserTwoFactorCreate {
+	if b != nil {
+		utfc.SetEnabled(*b)
+	}
+	return utfc
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (utfc *UserTwoFactorCreate) SetRecoveryCodes(s []string) *UserTwoFactorCreate {
+	utfc.mutation.SetRecoveryCodes(s)
+	return utfc
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (utfc *UserTwoFactorCreate) SetLastUsedStep(i int64) *UserTwoFactorCreate {
+	utfc.mutation.SetLastUsedStep(i)
+	return utfc
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (utfc *UserTwoFactorCreate) SetNillableLastUsedStep(i *int64) *UserTwoFactorCreate {
+	if i != nil {
+		utfc.SetLastUsedStep(*i)
+	}
+	return utfc
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (utfc *UserTwoFactorCreate) SetEnabledAt(t time.Time) *UserTwoFactorCreate {
+	utfc.mutation.SetEnabledAt(t)
+	return utfc
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (utfc *UserTwoFactorCreate) SetNillableEnabledAt(t *time.Time) *UserTwoFactorCreate {
+	if t != nil {
+		utfc.SetEnabledAt(*t)
+	}
+	return utfc
+}
+
+// SetID sets the "id" field.
+func (utfc *UserTwoFactorCreate) SetID(u uint) *UserTwoFactorCreate {
+	utfc.mutation.SetID(u)
+	return utfc
+}
+
+// Mutation returns the UserTwoFactorMutation object of the builder.
+func (utfc *UserTwoFactorCreate) Mutation() *UserTwoFactorMutation {
+	return utfc.mutation
+}
+
+// Save creates the UserTwoFactor in the database.
+func (utfc *UserTwoFactorCreate) Save(ctx context.Context) (*UserTwoFactor, error) {
+	utfc.defaults()
+	return withHooks(ctx, utfc.sqlSave, utfc.mutation, utfc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (utfc *UserTwoFactorCreate) SaveX(ctx context.Context) *UserTwoFactor {
+	v, err := utfc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (utfc *UserTwoFactorCreate) Exec(ctx context.Context) error {
+	_, err := utfc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utfc *UserTwoFactorCreate) ExecX(ctx context.Context) {
+	if err := utfc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utfc *UserTwoFactorCreate) defaults() {
+	if _, ok := utfc.mutation.CreatedAt(); !ok {
+		v := usertwofactor.DefaultCreatedAt()
+		utfc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := utfc.mutation.UpdatedAt(); !ok {
+		v := usertwofactor.DefaultUpdatedAt()
+		utfc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := utfc.mutation.Enabled(); !ok {
+		v := usertwofactor.DefaultEnabled
+		utfc.mutation.SetEnabled(v)
+	}
+	if _, ok := utfc.mutation.LastUsedStep(); !ok {
+		v := usertwofactor.DefaultLastUsedStep
+		utfc.mutation.SetLastUsedStep(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utfc *UserTwoFactorCreate) check() error {
+	if _, ok := utfc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserTwoFactor.created_at"`)}
+	}
+	if _, ok := utfc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserTwoFactor.updated_at"`)}
+	}
+	if _, ok := utfc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserTwoFactor.user_id"`)}
+	}
+	if _, ok := utfc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "UserTwoFactor.secret"`)}
+	}
+	if v, ok := utfc.mutation.Secret(); ok {
+		if err := usertwofactor.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "UserTwoFactor.secret": %w`, err)}
+		}
+	}
+	if _, ok := utfc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "UserTwoFactor.enabled"`)}
+	}
+	if _, ok := utfc.mutation.LastUsedStep(); !ok {
+		return &ValidationError{Name: "last_used_step", err: errors.New(`ent: missing required field "UserTwoFactor.last_used_step"`)}
+	}
+	return nil
+}
+
+func (utfc *UserTwoFactorCreate) sqlSave(ctx context.Context) (*UserTwoFactor, error) {
+	if err := utfc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := utfc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, utfc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	utfc.mutation.id = &_node.ID
+	utfc.mutation.done = true
+	return _node, nil
+}
+
+func (utfc *UserTwoFactorCreate) createSpec() (*UserTwoFactor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserTwoFactor{config: utfc.config}
+		_spec = sqlgraph.NewCreateSpec(usertwofactor.Table, sqlgraph.NewFieldSpec(usertwofactor.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = utfc.conflict
+	if id, ok := utfc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := utfc.mutation.CreatedAt(); ok {
+		_spec.SetField(usertwofactor.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := utfc.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertwofactor.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := utfc.mutation.UserID(); ok {
+		_spec.SetField(usertwofactor.FieldUserID, field.TypeUint, value)
+		_node.UserID = value
+	}
+	if value, ok := utfc.mutation.Secret(); ok {
+		_spec.SetField(usertwofactor.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := utfc.mutation.Enabled(); ok {
+		_spec.SetField(usertwofactor.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := utfc.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usertwofactor.FieldRecoveryCodes, field.TypeJSON, value)
+		_node.RecoveryCodes = value
+	}
+	if value, ok := utfc.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertwofactor.FieldLastUsedStep, field.TypeInt64, value)
+		_node.LastUsedStep = value
+	}
+	if value, ok := utfc.mutation.EnabledAt(); ok {
+		_spec.SetField(usertwofactor.FieldEnabledAt, field.TypeTime, value)
+		_node.EnabledAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserTwoFactor.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserTwoFactorUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (utfc *UserTwoFactorCreate) OnConflict(opts ...sql.ConflictOption) *UserTwoFactorUpsertOne {
+	utfc.conflict = opts
+	return &UserTwoFactorUpsertOne{
+		create: utfc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserTwoFactor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (utfc *UserTwoFactorCreate) OnConflictColumns(columns ...string) *UserTwoFactorUpsertOne {
+	utfc.conflict = append(utfc.conflict, sql.ConflictColumns(columns...))
+	return &UserTwoFactorUpsertOne{
+		create: utfc,
+	}
+}
+
+type (
+	// UserTwoFactorUpsertOne is the builder for "upsert"-ing
+	//  one UserTwoFactor node.
+	UserTwoFactorUpsertOne struct {
+		create *UserTwoFactorCreate
+	}
+
+	// UserTwoFactorUpsert is the "OnConflict" setter.
+	UserTwoFactorUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserTwoFactorUpsert) SetUpdatedAt(v time.Time) *UserTwoFactorUpsert {
+	u.Set(usertwofactor.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserTwoFactorUpsert) UpdateUpdatedAt() *UserTwoFactorUpsert {
+	u.SetExcluded(usertwofactor.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserTwoFactorUpsert) SetUserID(v uint) *UserTwoFactorUpsert {
+	u.Set(usertwofactor.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserTwoFactorUpsert) UpdateUserID() *UserTwoFactorUpsert {
+	u.SetExcluded(usertwofactor.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserTwoFactorUpsert) AddUserID(v uint) *UserTwoFactorUpsert {
+	u.Add(usertwofactor.FieldUserID, v)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *UserTwoFactorUpsert) SetSecret(v string) *UserTwoFactorUpsert {
+	u.Set(usertwofactor.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *UserTwoFactorUpsert) UpdateSecret() *UserTwoFactorUpsert {
+	u.SetExcluded(usertwofactor.FieldSecret)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *UserTwoFactorUpsert) SetEnabled(v bool) *UserTwoFactorUpsert {
+	u.Set(usertwofactor.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *UserTwoFactorUpsert) UpdateEnabled() *UserTwoFactorUpsert {
+	u.SetExcluded(usertwofactor.FieldEnabled)
+	return u
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (u *UserTwoFactorUpsert) SetRecoveryCodes(v []string) *UserTwoFactorUpsert {
+	u.Set(usertwofactor.FieldRecoveryCodes, v)
+	return u
+}
+
+// UpdateRecoveryCodes sets the "recovery_codes" field to the value that was provided on create.
+func (u *UserTwoFactorUpsert) UpdateRecoveryCodes() *UserTwoFactorUpsert {
+	u.SetExcluded(usertwofactor.FieldRecoveryCodes)
+	return u
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (u *UserTwoFactorUpsert) ClearRecoveryCodes() *UserTwoFactorUpsert {
+	u.SetNull(usertwofactor.FieldRecoveryCodes)
+	return u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (u *UserTwoFactorUpsert) SetLastUsedStep(v int64) *UserTwoFactorUpsert {
+	u.Set(usertwofactor.FieldLastUsedStep, v)
+	return u
+}
+
+// UpdateLastUsedStep sets the "last_used_step" field to the value that was provided on create.
+func (u *UserTwoFactorUpsert) UpdateLastUsedStep() *UserTwoFactorUpsert {
+	u.SetExcluded(usertwofactor.FieldLastUsedStep)
+	return u
+}
+
+// AddLastUsedStep adds v to the "last_used_step" field.
+func (u *UserTwoFactorUpsert) AddLastUsedStep(v int64) *UserTwoFactorUpsert {
+	u.Add(usertwofactor.FieldLastUsedStep, v)
+	return u
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (u *UserTwoFactorUpsert) SetEnabledAt(v time.Time) *UserTwoFactorUpsert {
+	u.Set(usertwofactor.FieldEnabledAt, v)
+	return u
+}
+
+// UpdateEnabledAt sets the "enabled_at" field to the value that was provided on create.
+func (u *UserTwoFactorUpsert) UpdateEnabledAt() *UserTwoFactorUpsert {
+	u.SetExcluded(usertwofactor.FieldEnabledAt)
+	return u
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (u *UserTwoFactorUpsert) ClearEnabledAt() *UserTwoFactorUpsert {
+	u.SetNull(usertwofactor.FieldEnabledAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserTwoFactor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usertwofactor.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserTwoFactorUpsertOne) UpdateNewValues() *UserTwoFactorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(usertwofactor.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usertwofactor.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserTwoFactor.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserTwoFactorUpsertOne) Ignore() *UserTwoFactorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserTwoFactorUpsertOne) DoNothing() *UserTwoFactorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserTwoFactorCreate.OnConflict
+// documentation for more info.
+func (u *UserTwoFactorUpsertOne) Update(set func(*UserTwoFactorUpsert)) *UserTwoFactorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserTwoFactorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserTwoFactorUpsertOne) SetUpdatedAt(v time.Time) *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertOne) UpdateUpdatedAt() *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserTwoFactorUpsertOne) SetUserID(v uint) *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserTwoFactorUpsertOne) AddUserID(v uint) *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertOne) UpdateUserID() *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *UserTwoFactorUpsertOne) SetSecret(v string) *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertOne) UpdateSecret() *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *UserTwoFactorUpsertOne) SetEnabled(v bool) *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertOne) UpdateEnabled() *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (u *UserTwoFactorUpsertOne) SetRecoveryCodes(v []string) *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetRecoveryCodes(v)
+	})
+}
+
+// UpdateRecoveryCodes sets the "recovery_codes" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertOne) UpdateRecoveryCodes() *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateRecoveryCodes()
+	})
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (u *UserTwoFactorUpsertOne) ClearRecoveryCodes() *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.ClearRecoveryCodes()
+	})
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (u *UserTwoFactorUpsertOne) SetLastUsedStep(v int64) *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetLastUsedStep(v)
+	})
+}
+
+// AddLastUsedStep adds v to the "last_used_step" field.
+func (u *UserTwoFactorUpsertOne) AddLastUsedStep(v int64) *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.AddLastUsedStep(v)
+	})
+}
+
+// UpdateLastUsedStep sets the "last_used_step" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertOne) UpdateLastUsedStep() *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateLastUsedStep()
+	})
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (u *UserTwoFactorUpsertOne) SetEnabledAt(v time.Time) *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetEnabledAt(v)
+	})
+}
+
+// UpdateEnabledAt sets the "enabled_at" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertOne) UpdateEnabledAt() *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateEnabledAt()
+	})
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (u *UserTwoFactorUpsertOne) ClearEnabledAt() *UserTwoFactorUpsertOne {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.ClearEnabledAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserTwoFactorUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserTwoFactorCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserTwoFactorUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserTwoFactorUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserTwoFactorUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserTwoFactorCreateBulk is the builder for creating many UserTwoFactor entities in bulk.
+type UserTwoFactorCreateBulk struct {
+	config
+	err      error
+	builders []*UserTwoFactorCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserTwoFactor entities in the database.
+func (utfcb *UserTwoFactorCreateBulk) Save(ctx context.Context) ([]*UserTwoFactor, error) {
+	if utfcb.err != nil {
+		return nil, utfcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(utfcb.builders))
+	nodes := make([]*UserTwoFactor, len(utfcb.builders))
+	mutators := make([]Mutator, len(utfcb.builders))
+	for i := range utfcb.builders {
+		func(i int, root context.Context) {
+			builder := utfcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserTwoFactorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, utfcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = utfcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, utfcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, utfcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utfcb *UserTwoFactorCreateBulk) SaveX(ctx context.Context) []*UserTwoFactor {
+	v, err := utfcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (utfcb *UserTwoFactorCreateBulk) Exec(ctx context.Context) error {
+	_, err := utfcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utfcb *UserTwoFactorCreateBulk) ExecX(ctx context.Context) {
+	if err := utfcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserTwoFactor.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserTwoFactorUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (utfcb *UserTwoFactorCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserTwoFactorUpsertBulk {
+	utfcb.conflict = opts
+	return &UserTwoFactorUpsertBulk{
+		create: utfcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserTwoFactor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (utfcb *UserTwoFactorCreateBulk) OnConflictColumns(columns ...string) *UserTwoFactorUpsertBulk {
+	utfcb.conflict = append(utfcb.conflict, sql.ConflictColumns(columns...))
+	return &UserTwoFactorUpsertBulk{
+		create: utfcb,
+	}
+}
+
+// UserTwoFactorUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserTwoFactor nodes.
+type UserTwoFactorUpsertBulk struct {
+	create *UserTwoFactorCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserTwoFactor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usertwofactor.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserTwoFactorUpsertBulk) UpdateNewValues() *UserTwoFactorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(usertwofactor.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usertwofactor.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserTwoFactor.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserTwoFactorUpsertBulk) Ignore() *UserTwoFactorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserTwoFactorUpsertBulk) DoNothing() *UserTwoFactorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserTwoFactorCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserTwoFactorUpsertBulk) Update(set func(*UserTwoFactorUpsert)) *UserTwoFactorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserTwoFactorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserTwoFactorUpsertBulk) SetUpdatedAt(v time.Time) *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertBulk) UpdateUpdatedAt() *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserTwoFactorUpsertBulk) SetUserID(v uint) *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserTwoFactorUpsertBulk) AddUserID(v uint) *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertBulk) UpdateUserID() *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *UserTwoFactorUpsertBulk) SetSecret(v string) *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertBulk) UpdateSecret() *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *UserTwoFactorUpsertBulk) SetEnabled(v bool) *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertBulk) UpdateEnabled() *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (u *UserTwoFactorUpsertBulk) SetRecoveryCodes(v []string) *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetRecoveryCodes(v)
+	})
+}
+
+// UpdateRecoveryCodes sets the "recovery_codes" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertBulk) UpdateRecoveryCodes() *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateRecoveryCodes()
+	})
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (u *UserTwoFactorUpsertBulk) ClearRecoveryCodes() *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.ClearRecoveryCodes()
+	})
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (u *UserTwoFactorUpsertBulk) SetLastUsedStep(v int64) *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetLastUsedStep(v)
+	})
+}
+
+// AddLastUsedStep adds v to the "last_used_step" field.
+func (u *UserTwoFactorUpsertBulk) AddLastUsedStep(v int64) *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.AddLastUsedStep(v)
+	})
+}
+
+// UpdateLastUsedStep sets the "last_used_step" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertBulk) UpdateLastUsedStep() *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateLastUsedStep()
+	})
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (u *UserTwoFactorUpsertBulk) SetEnabledAt(v time.Time) *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.SetEnabledAt(v)
+	})
+}
+
+// UpdateEnabledAt sets the "enabled_at" field to the value that was provided on create.
+func (u *UserTwoFactorUpsertBulk) UpdateEnabledAt() *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.UpdateEnabledAt()
+	})
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (u *UserTwoFactorUpsertBulk) ClearEnabledAt() *UserTwoFactorUpsertBulk {
+	return u.Update(func(s *UserTwoFactorUpsert) {
+		s.ClearEnabledAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserTwoFactorUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserTwoFactorCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserTwoFactorCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserTwoFactorUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
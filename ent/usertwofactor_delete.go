@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/usertwofactor"
+)
+
+// UserTwoFactorDelete is the builder for deleting a UserTwoFactor entity.
+type UserTwoFactorDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserTwoFactorMutation
+}
+
+// Where appends a list predicates to the UserTwoFactorDelete builder.
+func (utfd *UserTwoFactorDelete) Where(ps ...predicate.UserTwoFactor) *UserTwoFactorDelete {
+	utfd.mutation.Where(ps...)
+	return utfd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (utfd *UserTwoFactorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, utfd.sqlExec, utfd.mutation, utfd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utfd *UserTwoFactorDelete) ExecX(ctx context.Context) int {
+	n, err := utfd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (utfd *UserTwoFactorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usertwofactor.Table, sqlgraph.NewFieldSpec(usertwofactor.FieldID, field.TypeUint))
+	if ps := utfd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, utfd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	utfd.mutation.done = true
+	return affected, err
+}
+
+// UserTwoFactorDeleteOne is the builder for deleting a single UserTwoFactor entity.
+type UserTwoFactorDeleteOne struct {
+	utfd *UserTwoFactorDelete
+}
+
+// Where appends a list predicates to the UserTwoFactorDelete builder.
+func (utfdo *UserTwoFactorDeleteOne) Where(ps ...predicate.UserTwoFactor) *UserTwoFactorDeleteOne {
+	utfdo.utfd.mutation.Where(ps...)
+	return utfdo
+}
+
+// Exec executes the deletion query.
+func (utfdo *UserTwoFactorDeleteOne) Exec(ctx context.Context) error {
+	n, err := utfdo.utfd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usertwofactor.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utfdo *UserTwoFactorDeleteOne) ExecX(ctx context.Context) {
+	if err := utfdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/usertwofactor"
+)
+
+// UserTwoFactorQuery is the builder for querying UserTwoFactor entities.
+type UserTwoFactorQuery struct {
+	config
+	ctx        *QueryContext
+	order      []usertwofactor.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserTwoFactor
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserTwoFactorQuery builder.
+func (utfq *UserTwoFactorQuery) Where(ps ...predicate.UserTwoFactor) *UserTwoFactorQuery {
+	utfq.predicates = append(utfq.predicates, ps...)
+	return utfq
+}
+
+// Limit the number of records to be returned by this query.
+func (utfq *UserTwoFactorQuery) Limit(limit int) *UserTwoFactorQuery {
+	utfq.ctx.Limit = &limit
+	return utfq
+}
+
+// Offset to start from.
+func (utfq *UserTwoFactorQuery) Offset(offset int) *UserTwoFactorQuery {
+	utfq.ctx.Offset = &offset
+	return utfq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (utfq *UserTwoFactorQuery) Unique(unique bool) *UserTwoFactorQuery {
+	utfq.ctx.Unique = &unique
+	return utfq
+}
+
+// Order specifies how the records should be ordered.
+func (utfq *UserTwoFactorQuery) Order(o ...usertwofactor.OrderOption) *UserTwoFactorQuery {
+	utfq.order = append(utfq.order, o...)
+	return utfq
+}
+
+// First returns the first UserTwoFactor entity from the query.
+// Returns a *NotFoundError when no UserTwoFactor was found.
+func (utfq *UserTwoFactorQuery) First(ctx context.Context) (*UserTwoFactor, error) {
+	nodes, err := utfq.Limit(1).All(setContextOp(ctx, utfq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{usertwofactor.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (utfq *UserTwoFactorQuery) FirstX(ctx context.Context) *UserTwoFactor {
+	node, err := utfq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserTwoFactor ID from the query.
+// Returns a *NotFoundError when no UserTwoFactor ID was found.
+func (utfq *UserTwoFactorQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = utfq.Limit(1).IDs(setContextOp(ctx, utfq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{usertwofactor.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (utfq *UserTwoFactorQuery) FirstIDX(ctx context.Context) uint {
+	id, err := utfq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserTwoFactor entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserTwoFactor entity is found.
+// Returns a *NotFoundError when no UserTwoFactor entities are found.
+func (utfq *UserTwoFactorQuery) Only(ctx context.Context) (*UserTwoFactor, error) {
+	nodes, err := utfq.Limit(2).All(setContextOp(ctx, utfq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{usertwofactor.Label}
+	default:
+		return nil, &NotSingularError{usertwofactor.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (utfq *UserTwoFactorQuery) OnlyX(ctx context.Context) *UserTwoFactor {
+	node, err := utfq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserTwoFactor ID in the query.
+// Returns a *NotSingularError when more than one UserTwoFactor ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (utfq *UserTwoFactorQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = utfq.Limit(2).IDs(setContextOp(ctx, utfq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{usertwofactor.Label}
+	default:
+		err = &NotSingularError{usertwofactor.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (utfq *UserTwoFactorQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := utfq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserTwoFactors.
+func (utfq *UserTwoFactorQuery) All(ctx context.Context) ([]*UserTwoFactor, error) {
+	ctx = setContextOp(ctx, utfq.ctx, ent.OpQueryAll)
+	if err := utfq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserTwoFactor, *UserTwoFactorQuery]()
+	return withInterceptors[[]*UserTwoFactor](ctx, utfq, qr, utfq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (utfq *UserTwoFactorQuery) AllX(ctx context.Context) []*UserTwoFactor {
+	nodes, err := utfq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserTwoFactor IDs.
+func (utfq *UserTwoFactorQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if utfq.ctx.Unique == nil && utfq.path != nil {
+		utfq.Unique(true)
+	}
+	ctx = setContextOp(ctx, utfq.ctx, ent.OpQueryIDs)
+	if err = utfq.Select(usertwofactor.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (utfq *UserTwoFactorQuery) IDsX(ctx context.Context) []uint {
+	ids, err := utfq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (utfq *UserTwoFactorQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, utfq.ctx, ent.OpQueryCount)
+	if err := utfq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, utfq, querierCount[*UserTwoFactorQuery](), utfq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (utfq *UserTwoFactorQuery) CountX(ctx context.Context) int {
+	count, err := utfq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (utfq *UserTwoFactorQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, utfq.ctx, ent.OpQueryExist)
+	switch _, err := utfq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (utfq *UserTwoFactorQuery) ExistX(ctx context.Context) bool {
+	exist, err := utfq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserTwoFactorQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (utfq *UserTwoFactorQuery) Clone() *UserTwoFactorQuery {
+	if utfq == nil {
+		return nil
+	}
+	return &UserTwoFactorQuery{
+		config:     utfq.config,
+		ctx:        utfq.ctx.Clone(),
+		order:      append([]usertwofactor.OrderOption{}, utfq.order...),
+		inters:     append([]Interceptor{}, utfq.inters...),
+		predicates: append([]predicate.UserTwoFactor{}, utfq.predicates...),
+		// clone intermediate query.
+		sql:       utfq.sql.Clone(),
+		path:      utfq.path,
+		modifiers: append([]func(*sql.Selector){}, utfq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserTwoFactor.Query().
+//		GroupBy(usertwofactor.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (utfq *UserTwoFactorQuery) GroupBy(field string, fields ...string) *UserTwoFactorGroupBy {
+	utfq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserTwoFactorGroupBy{build: utfq}
+	grbuild.flds = &utfq.ctx.Fields
+	grbuild.label = usertwofactor.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.UserTwoFactor.Query().
+//		Select(usertwofactor.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (utfq *UserTwoFactorQuery) Select(fields ...string) *UserTwoFactorSelect {
+	utfq.ctx.Fields = append(utfq.ctx.Fields, fields...)
+	sbuild := &UserTwoFactorSelect{UserTwoFactorQuery: utfq}
+	sbuild.label = usertwofactor.Label
+	sbuild.flds, sbuild.scan = &utfq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserTwoFactorSelect configured with the given aggregations.
+func (utfq *UserTwoFactorQuery) Aggregate(fns ...AggregateFunc) *UserTwoFactorSelect {
+	return utfq.Select().Aggregate(fns...)
+}
+
+func (utfq *UserTwoFactorQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range utfq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, utfq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range utfq.ctx.Fields {
+		if !usertwofactor.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if utfq.path != nil {
+		prev, err := utfq.path(ctx)
+		if err != nil {
+			return err
+		}
+		utfq.sql = prev
+	}
+	return nil
+}
+
+func (utfq *UserTwoFactorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserTwoFactor, error) {
+	var (
+		nodes = []*UserTwoFactor{}
+		_spec = utfq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserTwoFactor).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserTwoFactor{config: utfq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(utfq.modifiers) > 0 {
+		_spec.Modifiers = utfq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, utfq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (utfq *UserTwoFactorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := utfq.querySpec()
+	if len(utfq.modifiers) > 0 {
+		_spec.Modifiers = utfq.modifiers
+	}
+	_spec.Node.Columns = utfq.ctx.Fields
+	if len(utfq.ctx.Fields) > 0 {
+		_spec.Unique = utfq.ctx.Unique != nil && *utfq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, utfq.driver, _spec)
+}
+
+func (utfq *UserTwoFactorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(usertwofactor.Table, usertwofactor.Columns, sqlgraph.NewFieldSpec(usertwofactor.FieldID, field.TypeUint))
+	_spec.From = utfq.sql
+	if unique := utfq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if utfq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := utfq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usertwofactor.FieldID)
+		for i := range fields {
+			if fields[i] != usertwofactor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := utfq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := utfq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := utfq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := utfq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (utfq *UserTwoFactorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(utfq.driver.Dialect())
+	t1 := builder.Table(usertwofactor.Table)
+	columns := utfq.ctx.Fields
+	if len(columns) == 0 {
+		columns = usertwofactor.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if utfq.sql != nil {
+		selector = utfq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if utfq.ctx.Unique != nil && *utfq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range utfq.modifiers {
+		m(selector)
+	}
+	for _, p := range utfq.predicates {
+		p(selector)
+	}
+	for _, p := range utfq.order {
+		p(selector)
+	}
+	if offset := utfq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := utfq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (utfq *UserTwoFactorQuery) Modify(modifiers ...func(s *sql.Selector)) *UserTwoFactorSelect {
+	utfq.modifiers = append(utfq.modifiers, modifiers...)
+	return utfq.Select()
+}
+
+// UserTwoFactorGroupBy is the group-by builder for UserTwoFactor entities.
+type UserTwoFactorGroupBy struct {
+	selector
+	build *UserTwoFactorQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (utfgb *UserTwoFactorGroupBy) Aggregate(fns ...AggregateFunc) *UserTwoFactorGroupBy {
+	utfgb.fns = append(utfgb.fns, fns...)
+	return utfgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (utfgb *UserTwoFactorGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, utfgb.build.ctx, ent.OpQueryGroupBy)
+	if err := utfgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserTwoFactorQuery, *UserTwoFactorGroupBy](ctx, utfgb.build, utfgb, utfgb.build.inters, v)
+}
+
+func (utfgb *UserTwoFactorGroupBy) sqlScan(ctx context.Context, root *UserTwoFactorQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(utfgb.fns))
+	for _, fn := range utfgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*utfgb.flds)+len(utfgb.fns))
+		for _, f := range *utfgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*utfgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := utfgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserTwoFactorSelect is the builder for selecting fields of UserTwoFactor entities.
+type UserTwoFactorSelect struct {
+	*UserTwoFactorQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (utfs *UserTwoFactorSelect) Aggregate(fns ...AggregateFunc) *UserTwoFactorSelect {
+	utfs.fns = append(utfs.fns, fns...)
+	return utfs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (utfs *UserTwoFactorSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, utfs.ctx, ent.OpQuerySelect)
+	if err := utfs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserTwoFactorQuery, *UserTwoFactorSelect](ctx, utfs.UserTwoFactorQuery, utfs, utfs.inters, v)
+}
+
+func (utfs *UserTwoFactorSelect) sqlScan(ctx context.Context, root *UserTwoFactorQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(utfs.fns))
+	for _, fn := range utfs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*utfs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := utfs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (utfs *UserTwoFactorSelect) Modify(modifiers ...func(s *sql.Selector)) *UserTwoFactorSelect {
+	utfs.modifiers = append(utfs.modifiers, modifiers...)
+	return utfs
+}
@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/usertwofactor"
+)
+
+// UserTwoFactorUpdate is the builder for updating UserTwoFactor entities.
+type UserTwoFactorUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserTwoFactorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserTwoFactorUpdate builder.
+func (utfu *UserTwoFactorUpdate) Where(ps ...predicate.UserTwoFactor) *UserTwoFactorUpdate {
+	utfu.mutation.Where(ps...)
+	return utfu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utfu *UserTwoFactorUpdate) SetUpdatedAt(t time.Time) *UserTwoFactorUpdate {
+	utfu.mutation.SetUpdatedAt(t)
+	return utfu
+}
+
+// SetUserID sets the "user_id" field.
+func (utfu *UserTwoFactorUpdate) SetUserID(u uint) *UserTwoFactorUpdate {
+	utfu.mutation.ResetUserID()
+	utfu.mutation.SetUserID(u)
+	return utfu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (utfu *UserTwoFactorUpdate) SetNillableUserID(u *uint) *UserTwoFactorUpdate {
+	if u != nil {
+		utfu.SetUserID(*u)
+	}
+	return utfu
+}
+
+// AddUserID adds u to the "user_id" field.
+func (utfu *UserTwoFactorUpdate) AddUserID(u int) *UserTwoFactorUpdate {
+	utfu.mutation.AddUserID(u)
+	return utfu
+}
+
+// SetSecret sets the "secret" field.
+func (utfu *UserTwoFactorUpdate) SetSecret(s string) *UserTwoFactorUpdate {
+	utfu.mutation.SetSecret(s)
+	return utfu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (utfu *UserTwoFactorUpdate) SetNillableSecret(s *string) *UserTwoFactorUpdate {
+	if s != nil {
+		utfu.SetSecret(*s)
+	}
+	return utfu
+}
+
+// SetEnabled sets the "enabled" field.
+func (utfu *UserTwoFactorUpdate) SetEnabled(b bool) *UserTwoFactorUpdate {
+	utfu.mutation.SetEnabled(b)
+	return utfu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (utfu *UserTwoFactorUpdate) SetNillableEnabled(b *bool) *UserTwoFactorUpdate {
+	if b != nil {
+		utfu.SetEnabled(*b)
+	}
+	return utfu
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (utfu *UserTwoFactorUpdate) SetRecoveryCodes(s []string) *UserTwoFactorUpdate {
+	utfu.mutation.SetRecoveryCodes(s)
+	return utfu
+}
+
+// AppendRecoveryCodes appends s to the "recovery_codes" field.
+func (utfu *UserTwoFactorUpdate) AppendRecoveryCodes(s []string) *UserTwoFactorUpdate {
+	utfu.mutation.AppendRecoveryCodes(s)
+	return utfu
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (utfu *UserTwoFactorUpdate) ClearRecoveryCodes() *UserTwoFactorUpdate {
+	utfu.mutation.ClearRecoveryCodes()
+	return utfu
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (utfu *UserTwoFactorUpdate) SetLastUsedStep(i int64) *UserTwoFactorUpdate {
+	utfu.mutation.ResetLastUsedStep()
+	utfu.mutation.SetLastUsedStep(i)
+	return utfu
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (utfu *UserTwoFactorUpdate) SetNillableLastUsedStep(i *int64) *UserTwoFactorUpdate {
+	if i != nil {
+		utfu.SetLastUsedStep(*i)
+	}
+	return utfu
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (utfu *UserTwoFactorUpdate) AddLastUsedStep(i int64) *UserTwoFactorUpdate {
+	utfu.mutation.AddLastUsedStep(i)
+	return utfu
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (utfu *UserTwoFactorUpdate) SetEnabledAt(t time.Time) *UserTwoFactorUpdate {
+	utfu.mutation.SetEnabledAt(t)
+	return utfu
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (utfu *UserTwoFactorUpdate) SetNillableEnabledAt(t *time.Time) *UserTwoFactorUpdate {
+	if t != nil {
+		utfu.SetEnabledAt(*t)
+	}
+	return utfu
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (utfu *UserTwoFactorUpdate) ClearEnabledAt() *UserTwoFactorUpdate {
+	utfu.mutation.ClearEnabledAt()
+	return utfu
+}
+
+// Mutation returns the UserTwoFactorMutation object of the builder.
+func (utfu *UserTwoFactorUpdate) Mutation() *UserTwoFactorMutation {
+	return utfu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (utfu *UserTwoFactorUpdate) Save(ctx context.Context) (int, error) {
+	utfu.defaults()
+	return withHooks(ctx, utfu.sqlSave, utfu.mutation, utfu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utfu *UserTwoFactorUpdate) SaveX(ctx context.Context) int {
+	affected, err := utfu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (utfu *UserTwoFactorUpdate) Exec(ctx context.Context) error {
+	_, err := utfu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utfu *UserTwoFactorUpdate) ExecX(ctx context.Context) {
+	if err := utfu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utfu *UserTwoFactorUpdate) defaults() {
+	if _, ok := utfu.mutation.UpdatedAt(); !ok {
+		v := usertwofactor.UpdateDefaultUpdatedAt()
+		utfu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utfu *UserTwoFactorUpdate) check() error {
+	if v, ok := utfu.mutation.Secret(); ok {
+		if err := usertwofactor.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "UserTwoFactor.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (utfu *UserTwoFactorUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserTwoFactorUpdate {
+	utfu.modifiers = append(utfu.modifiers, modifiers...)
+	return utfu
+}
+
+func (utfu *UserTwoFactorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := utfu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertwofactor.Table, usertwofactor.Columns, sqlgraph.NewFieldSpec(usertwofactor.FieldID, field.TypeUint))
+	if ps := utfu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := utfu.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertwofactor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := utfu.mutation.UserID(); ok {
+		_spec.SetField(usertwofactor.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := utfu.mutation.AddedUserID(); ok {
+		_spec.AddField(usertwofactor.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := utfu.mutation.Secret(); ok {
+		_spec.SetField(usertwofactor.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := utfu.mutation.Enabled(); ok {
+		_spec.SetField(usertwofactor.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := utfu.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usertwofactor.FieldRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := utfu.mutation.AppendedRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usertwofactor.FieldRecoveryCodes, value)
+		})
+	}
+	if utfu.mutation.RecoveryCodesCleared() {
+		_spec.ClearField(usertwofactor.FieldRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := utfu.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertwofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := utfu.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(usertwofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := utfu.mutation.EnabledAt(); ok {
+		_spec.SetField(usertwofactor.FieldEnabledAt, field.TypeTime, value)
+	}
+	if utfu.mutation.EnabledAtCleared() {
+		_spec.ClearField(usertwofactor.FieldEnabledAt, field.TypeTime)
+	}
+	_spec.AddModifiers(utfu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, utfu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertwofactor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	utfu.mutation.done = true
+	return n, nil
+}
+
+// UserTwoFactorUpdateOne is the builder for updating a single UserTwoFactor entity.
+type UserTwoFactorUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserTwoFactorMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utfuo *UserTwoFactorUpdateOne) SetUpdatedAt(t time.Time) *UserTwoFactorUpdateOne {
+	utfuo.mutation.SetUpdatedAt(t)
+	return utfuo
+}
+
+// SetUserID sets the "user_id" field.
+func (utfuo *UserTwoFactorUpdateOne) SetUserID(u uint) *UserTwoFactorUpdateOne {
+	utfuo.mutation.ResetUserID()
+	utfuo.mutation.SetUserID(u)
+	return utfuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (utfuo *UserTwoFactorUpdateOne) SetNillableUserID(u *uint) *UserTwoFactorUpdateOne {
+	if u != nil {
+		utfuo.SetUserID(*u)
+	}
+	return utfuo
+}
+
+// AddUserID adds u to the "user_id" field.
+func (utfuo *UserTwoFactorUpdateOne) AddUserID(u int) *UserTwoFactorUpdateOne {
+	utfuo.mutation.AddUserID(u)
+	return utfuo
+}
+
+// SetSecret sets the "secret" field.
+func (utfuo *UserTwoFactorUpdateOne) SetSecret(s string) *UserTwoFactorUpdateOne {
+	utfuo.mutation.SetSecret(s)
+	return utfuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (utfuo *UserTwoFactorUpdateOne) SetNillableSecret(s *string) *UserTwoFactorUpdateOne {
+	if s != nil {
+		utfuo.SetSecret(*s)
+	}
+	return utfuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (utfuo *UserTwoFactorUpdateOne) SetEnabled(b bool) *UserTwoFactorUpdateOne {
+	utfuo.mutation.SetEnabled(b)
+	return utfuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (utfuo *UserTwoFactorUpdateOne) SetNillableEnabled(b *bool) *UserTwoFactorUpdateOne {
+	if b != nil {
+		utfuo.SetEnabled(*b)
+	}
+	return utfuo
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (utfuo *UserTwoFactorUpdateOne) SetRecoveryCodes(s []string) *UserTwoFactorUpdateOne {
+	utfuo.mutation.SetRecoveryCodes(s)
+	return utfuo
+}
+
+// AppendRecoveryCodes appends s to the "recovery_codes" field.
+func (utfuo *UserTwoFactorUpdateOne) AppendRecoveryCodes(s []string) *UserTwoFactorUpdateOne {
+	utfuo.mutation.AppendRecoveryCodes(s)
+	return utfuo
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (utfuo *UserTwoFactorUpdateOne) ClearRecoveryCodes() *UserTwoFactorUpdateOne {
+	utfuo.mutation.ClearRecoveryCodes()
+	return utfuo
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (utfuo *UserTwoFactorUpdateOne) SetLastUsedStep(i int64) *UserTwoFactorUpdateOne {
+	utfuo.mutation.ResetLastUsedStep()
+	utfuo.mutation.SetLastUsedStep(i)
+	return utfuo
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (utfuo *UserTwoFactorUpdateOne) SetNillableLastUsedStep(i *int64) *UserTwoFactorUpdateOne {
+	if i != nil {
+		utfuo.SetLastUsedStep(*i)
+	}
+	return utfuo
+}
+
+// AddLastUsedStep adds i to the "last_used_step" field.
+func (utfuo *UserTwoFactorUpdateOne) AddLastUsedStep(i int64) *UserTwoFactorUpdateOne {
+	utfuo.mutation.AddLastUsedStep(i)
+	return utfuo
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (utfuo *UserTwoFactorUpdateOne) SetEnabledAt(t time.Time) *UserTwoFactorUpdateOne {
+	utfuo.mutation.SetEnabledAt(t)
+	return utfuo
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (utfuo *UserTwoFactorUpdateOne) SetNillableEnabledAt(t *time.Time) *UserTwoFactorUpdateOne {
+	if t != nil {
+		utfuo.SetEnabledAt(*t)
+	}
+	return utfuo
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (utfuo *UserTwoFactorUpdateOne) ClearEnabledAt() *UserTwoFactorUpdateOne {
+	utfuo.mutation.ClearEnabledAt()
+	return utfuo
+}
+
+// Mutation returns the UserTwoFactorMutation object of the builder.
+func (utfuo *UserTwoFactorUpdateOne) Mutation() *UserTwoFactorMutation {
+	return utfuo.mutation
+}
+
+// Where appends a list predicates to the UserTwoFactorUpdate builder.
+func (utfuo *UserTwoFactorUpdateOne) Where(ps ...predicate.UserTwoFactor) *UserTwoFactorUpdateOne {
+	utfuo.mutation.Where(ps...)
+	return utfuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (utfuo *UserTwoFactorUpdateOne) Select(field string, fields ...string) *UserTwoFactorUpdateOne {
+	utfuo.fields = append([]string{field}, fields...)
+	return utfuo
+}
+
+// Save executes the query and returns the updated UserTwoFactor entity.
+func (utfuo *UserTwoFactorUpdateOne) Save(ctx context.Context) (*UserTwoFactor, error) {
+	utfuo.defaults()
+	return withHooks(ctx, utfuo.sqlSave, utfuo.mutation, utfuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utfuo *UserTwoFactorUpdateOne) SaveX(ctx context.Context) *UserTwoFactor {
+	node, err := utfuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (utfuo *UserTwoFactorUpdateOne) Exec(ctx context.Context) error {
+	_, err := utfuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utfuo *UserTwoFactorUpdateOne) ExecX(ctx context.Context) {
+	if err := utfuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utfuo *UserTwoFactorUpdateOne) defaults() {
+	if _, ok := utfuo.mutation.UpdatedAt(); !ok {
+		v := usertwofactor.UpdateDefaultUpdatedAt()
+		utfuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utfuo *UserTwoFactorUpdateOne) check() error {
+	if v, ok := utfuo.mutation.Secret(); ok {
+		if err := usertwofactor.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "UserTwoFactor.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (utfuo *UserTwoFactorUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserTwoFactorUpdateOne {
+	utfuo.modifiers = append(utfuo.modifiers, modifiers...)
+	return utfuo
+}
+
+func (utfuo *UserTwoFactorUpdateOne) sqlSave(ctx context.Context) (_node *UserTwoFactor, err error) {
+	if err := utfuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usertwofactor.Table, usertwofactor.Columns, sqlgraph.NewFieldSpec(usertwofactor.FieldID, field.TypeUint))
+	id, ok := utfuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserTwoFactor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := utfuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usertwofactor.FieldID)
+		for _, f := range fields {
+			if !usertwofactor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usertwofactor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := utfuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := utfuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertwofactor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := utfuo.mutation.UserID(); ok {
+		_spec.SetField(usertwofactor.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := utfuo.mutation.AddedUserID(); ok {
+		_spec.AddField(usertwofactor.FieldUserID, field.TypeUint, value)
+	}
+	if value, ok := utfuo.mutation.Secret(); ok {
+		_spec.SetField(usertwofactor.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := utfuo.mutation.Enabled(); ok {
+		_spec.SetField(usertwofactor.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := utfuo.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usertwofactor.FieldRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := utfuo.mutation.AppendedRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usertwofactor.FieldRecoveryCodes, value)
+		})
+	}
+	if utfuo.mutation.RecoveryCodesCleared() {
+		_spec.ClearField(usertwofactor.FieldRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := utfuo.mutation.LastUsedStep(); ok {
+		_spec.SetField(usertwofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := utfuo.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(usertwofactor.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := utfuo.mutation.EnabledAt(); ok {
+		_spec.SetField(usertwofactor.FieldEnabledAt, field.TypeTime, value)
+	}
+	if utfuo.mutation.EnabledAtCleared() {
+		_spec.ClearField(usertwofactor.FieldEnabledAt, field.TypeTime)
+	}
+	_spec.AddModifiers(utfuo.modifiers...)
+	_node = &UserTwoFactor{config: utfuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, utfuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertwofactor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	utfuo.mutation.done = true
+	return _node, nil
+}
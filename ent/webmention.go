@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/webmention"
+)
+
+// Webmention 表
+type Webmention struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uint `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 提及我们的来源页面URL
+	Source string `json:"source,omitempty"`
+	// 被提及的本站文章URL
+	Target string `json:"target,omitempty"`
+	// 被提及文章的路径 (例如 /posts/some-slug)，与评论的 target_path 一致
+	TargetPath string `json:"target_path,omitempty"`
+	// 互动类型: mention(提及), like(喜欢), repost(转发), reply(回复)
+	Type webmention.Type `json:"type,omitempty"`
+	// 校验状态: pending(待校验), verified(已校验), rejected(来源页未链接到目标)
+	Status webmention.Status `json:"status,omitempty"`
+	// 来源页作者名称 (解析自 h-card)
+	AuthorName string `json:"author_name,omitempty"`
+	// 来源页作者主页
+	AuthorURL string `json:"author_url,omitempty"`
+	// 来源页作者头像
+	AuthorPhoto string `json:"author_photo,omitempty"`
+	// 回复或提及的纯文本摘要
+	Content string `json:"content,omitempty"`
+	// 来源页声明的发布时间
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// 最近一次校验通过的时间
+	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Webmention) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webmention.FieldID:
+			values[i] = new(sql.NullInt64)
+		case webmention.FieldSource, webmention.FieldTarget, webmention.FieldTargetPath, webmention.FieldType, webmention.FieldStatus, webmention.FieldAuthorName, webmention.FieldAuthorURL, webmention.FieldAuthorPhoto, webmention.FieldContent:
+			values[i] = new(sql.NullString)
+		case webmention.FieldCreatedAt, webmention.FieldUpdatedAt, webmention.FieldPublishedAt, webmention.FieldVerifiedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Webmention fields.
+func (w *Webmention) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webmention.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			w.ID = uint(value.Int64)
+		case webmention.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				w.CreatedAt = value.Time
+			}
+		case webmention.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				w.UpdatedAt = value.Time
+			}
+		case webmention.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				w.Source = value.String
+			}
+		case webmention.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				w.Target = value.String
+			}
+		case webmention.FieldTargetPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_path", values[i])
+			} else if value.Valid {
+				w.TargetPath = value.String
+			}
+		case webmention.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				w.Type = webmention.Type(value.String)
+			}
+		case webmention.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				w.Status = webmention.Status(value.String)
+			}
+		case webmention.FieldAuthorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_name", values[i])
+			} else if value.Valid {
+				w.AuthorName = value.String
+			}
+		case webmention.FieldAuthorURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_url", values[i])
+			} else if value.Valid {
+				w.AuthorURL = value.String
+			}
+		case webmention.FieldAuthorPhoto:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_photo", values[i])
+			} else if value.Valid {
+				w.AuthorPhoto = value.String
+			}
+		case webmention.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				w.Content = value.String
+			}
+		case webmention.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				w.PublishedAt = new(time.Time)
+				*w.PublishedAt = value.Time
+			}
+		case webmention.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				w.VerifiedAt = new(time.Time)
+				*w.VerifiedAt = value.Time
+			}
+		default:
+			w.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Webmention.
+// This includes values selected through modifiers, order, etc.
+func (w *Webmention) Value(name string) (ent.Value, error) {
+	return w.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Webmention.
+// Note that you need to call Webmention.Unwrap() before calling this method if this Webmention
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Webmention) Update() *WebmentionUpdateOne {
+	return NewWebmentionClient(w.config).UpdateOne(w)
+}
+
+// Unwrap unwraps the Webmention entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (w *Webmention) Unwrap() *Webmention {
+	_tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Webmention is not a transactional entity")
+	}
+	w.config.driver = _tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Webmention) String() string {
+	var builder strings.Builder
+	builder.WriteString("Webmention(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", w.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(w.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(w.Source)
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(w.Target)
+	builder.WriteString(", ")
+	builder.WriteString("target_path=")
+	builder.WriteString(w.TargetPath)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", w.Type))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", w.Status))
+	builder.WriteString(", ")
+	builder.WriteString("author_name=")
+	builder.WriteString(w.AuthorName)
+	builder.WriteString(", ")
+	builder.WriteString("author_url=")
+	builder.WriteString(w.AuthorURL)
+	builder.WriteString(", ")
+	builder.WriteString("author_photo=")
+	builder.WriteString(w.AuthorPhoto)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(w.Content)
+	builder.WriteString(", ")
+	if v := w.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := w.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Webmentions is a parsable slice of Webmention.
+type Webmentions []*Webmention
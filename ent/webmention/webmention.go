@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webmention
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the webmention type in the database.
+	Label = "webmention"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldTarget holds the string denoting the target field in the database.
+	FieldTarget = "target"
+	// FieldTargetPath holds the string denoting the target_path field in the database.
+	FieldTargetPath = "target_path"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAuthorName holds the string denoting the author_name field in the database.
+	FieldAuthorName = "author_name"
+	// FieldAuthorURL holds the string denoting the author_url field in the database.
+	FieldAuthorURL = "author_url"
+	// FieldAuthorPhoto holds the string denoting the author_photo field in the database.
+	FieldAuthorPhoto = "author_photo"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldPublishedAt holds the string denoting the published_at field in the database.
+	FieldPublishedAt = "published_at"
+	// FieldVerifiedAt holds the string denoting the verified_at field in the database.
+	FieldVerifiedAt = "verified_at"
+	// Table holds the table name of the webmention in the database.
+	Table = "webmentions"
+)
+
+// Columns holds all SQL columns for webmention fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldSource,
+	FieldTarget,
+	FieldTargetPath,
+	FieldType,
+	FieldStatus,
+	FieldAuthorName,
+	FieldAuthorURL,
+	FieldAuthorPhoto,
+	FieldContent,
+	FieldPublishedAt,
+	FieldVerifiedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	TargetValidator func(string) error
+	// TargetPathValidator is a validator for the "target_path" field. It is called by the builders before save.
+	TargetPathValidator func(string) error
+	// AuthorNameValidator is a validator for the "author_name" field. It is called by the builders before save.
+	AuthorNameValidator func(string) error
+	// AuthorURLValidator is a validator for the "author_url" field. It is called by the builders before save.
+	AuthorURLValidator func(string) error
+	// AuthorPhotoValidator is a validator for the "author_photo" field. It is called by the builders before save.
+	AuthorPhotoValidator func(string) error
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// TypeMention is the default value of the Type enum.
+const DefaultType = TypeMention
+
+// Type values.
+const (
+	TypeMention Type = "mention"
+	TypeLike    Type = "like"
+	TypeRepost  Type = "repost"
+	TypeReply   Type = "reply"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeMention, TypeLike, TypeRepost, TypeReply:
+		return nil
+	default:
+		return fmt.Errorf("webmention: invalid enum value for type field: %q", _type)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusVerified Status = "verified"
+	StatusRejected Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusVerified, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("webmention: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Webmention queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByTarget orders the results by the target field.
+func ByTarget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTarget, opts...).ToFunc()
+}
+
+// ByTargetPath orders the results by the target_path field.
+func ByTargetPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetPath, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAuthorName orders the results by the author_name field.
+func ByAuthorName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorName, opts...).ToFunc()
+}
+
+// ByAuthorURL orders the results by the author_url field.
+func ByAuthorURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorURL, opts...).ToFunc()
+}
+
+// ByAuthorPhoto orders the results by the author_photo field.
+func ByAuthorPhoto(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorPhoto, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByPublishedAt orders the results by the published_at field.
+func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
+}
+
+// ByVerifiedAt orders the results by the verified_at field.
+func ByVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedAt, opts...).ToFunc()
+}
@@ -0,0 +1,840 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webmention
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldSource, v))
+}
+
+// Target applies equality check predicate on the "target" field. It's identical to TargetEQ.
+func Target(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldTarget, v))
+}
+
+// TargetPath applies equality check predicate on the "target_path" field. It's identical to TargetPathEQ.
+func TargetPath(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldTargetPath, v))
+}
+
+// AuthorName applies equality check predicate on the "author_name" field. It's identical to AuthorNameEQ.
+func AuthorName(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldAuthorName, v))
+}
+
+// AuthorURL applies equality check predicate on the "author_url" field. It's identical to AuthorURLEQ.
+func AuthorURL(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldAuthorURL, v))
+}
+
+// AuthorPhoto applies equality check predicate on the "author_photo" field. It's identical to AuthorPhotoEQ.
+func AuthorPhoto(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldAuthorPhoto, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldContent, v))
+}
+
+// PublishedAt applies equality check predicate on the "published_at" field. It's identical to PublishedAtEQ.
+func PublishedAt(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verified_at" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContainsFold(FieldSource, v))
+}
+
+// TargetEQ applies the EQ predicate on the "target" field.
+func TargetEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldTarget, v))
+}
+
+// TargetNEQ applies the NEQ predicate on the "target" field.
+func TargetNEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldTarget, v))
+}
+
+// TargetIn applies the In predicate on the "target" field.
+func TargetIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldTarget, vs...))
+}
+
+// TargetNotIn applies the NotIn predicate on the "target" field.
+func TargetNotIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldTarget, vs...))
+}
+
+// TargetGT applies the GT predicate on the "target" field.
+func TargetGT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldTarget, v))
+}
+
+// TargetGTE applies the GTE predicate on the "target" field.
+func TargetGTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldTarget, v))
+}
+
+// TargetLT applies the LT predicate on the "target" field.
+func TargetLT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldTarget, v))
+}
+
+// TargetLTE applies the LTE predicate on the "target" field.
+func TargetLTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldTarget, v))
+}
+
+// TargetContains applies the Contains predicate on the "target" field.
+func TargetContains(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContains(FieldTarget, v))
+}
+
+// TargetHasPrefix applies the HasPrefix predicate on the "target" field.
+func TargetHasPrefix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasPrefix(FieldTarget, v))
+}
+
+// TargetHasSuffix applies the HasSuffix predicate on the "target" field.
+func TargetHasSuffix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasSuffix(FieldTarget, v))
+}
+
+// TargetEqualFold applies the EqualFold predicate on the "target" field.
+func TargetEqualFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEqualFold(FieldTarget, v))
+}
+
+// TargetContainsFold applies the ContainsFold predicate on the "target" field.
+func TargetContainsFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContainsFold(FieldTarget, v))
+}
+
+// TargetPathEQ applies the EQ predicate on the "target_path" field.
+func TargetPathEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldTargetPath, v))
+}
+
+// TargetPathNEQ applies the NEQ predicate on the "target_path" field.
+func TargetPathNEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldTargetPath, v))
+}
+
+// TargetPathIn applies the In predicate on the "target_path" field.
+func TargetPathIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldTargetPath, vs...))
+}
+
+// TargetPathNotIn applies the NotIn predicate on the "target_path" field.
+func TargetPathNotIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldTargetPath, vs...))
+}
+
+// TargetPathGT applies the GT predicate on the "target_path" field.
+func TargetPathGT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldTargetPath, v))
+}
+
+// TargetPathGTE applies the GTE predicate on the "target_path" field.
+func TargetPathGTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldTargetPath, v))
+}
+
+// TargetPathLT applies the LT predicate on the "target_path" field.
+func TargetPathLT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldTargetPath, v))
+}
+
+// TargetPathLTE applies the LTE predicate on the "target_path" field.
+func TargetPathLTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldTargetPath, v))
+}
+
+// TargetPathContains applies the Contains predicate on the "target_path" field.
+func TargetPathContains(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContains(FieldTargetPath, v))
+}
+
+// TargetPathHasPrefix applies the HasPrefix predicate on the "target_path" field.
+func TargetPathHasPrefix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasPrefix(FieldTargetPath, v))
+}
+
+// TargetPathHasSuffix applies the HasSuffix predicate on the "target_path" field.
+func TargetPathHasSuffix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasSuffix(FieldTargetPath, v))
+}
+
+// TargetPathEqualFold applies the EqualFold predicate on the "target_path" field.
+func TargetPathEqualFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEqualFold(FieldTargetPath, v))
+}
+
+// TargetPathContainsFold applies the ContainsFold predicate on the "target_path" field.
+func TargetPathContainsFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContainsFold(FieldTargetPath, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldType, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AuthorNameEQ applies the EQ predicate on the "author_name" field.
+func AuthorNameEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldAuthorName, v))
+}
+
+// AuthorNameNEQ applies the NEQ predicate on the "author_name" field.
+func AuthorNameNEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldAuthorName, v))
+}
+
+// AuthorNameIn applies the In predicate on the "author_name" field.
+func AuthorNameIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldAuthorName, vs...))
+}
+
+// AuthorNameNotIn applies the NotIn predicate on the "author_name" field.
+func AuthorNameNotIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldAuthorName, vs...))
+}
+
+// AuthorNameGT applies the GT predicate on the "author_name" field.
+func AuthorNameGT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldAuthorName, v))
+}
+
+// AuthorNameGTE applies the GTE predicate on the "author_name" field.
+func AuthorNameGTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldAuthorName, v))
+}
+
+// AuthorNameLT applies the LT predicate on the "author_name" field.
+func AuthorNameLT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldAuthorName, v))
+}
+
+// AuthorNameLTE applies the LTE predicate on the "author_name" field.
+func AuthorNameLTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldAuthorName, v))
+}
+
+// AuthorNameContains applies the Contains predicate on the "author_name" field.
+func AuthorNameContains(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContains(FieldAuthorName, v))
+}
+
+// AuthorNameHasPrefix applies the HasPrefix predicate on the "author_name" field.
+func AuthorNameHasPrefix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasPrefix(FieldAuthorName, v))
+}
+
+// AuthorNameHasSuffix applies the HasSuffix predicate on the "author_name" field.
+func AuthorNameHasSuffix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasSuffix(FieldAuthorName, v))
+}
+
+// AuthorNameIsNil applies the IsNil predicate on the "author_name" field.
+func AuthorNameIsNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldIsNull(FieldAuthorName))
+}
+
+// AuthorNameNotNil applies the NotNil predicate on the "author_name" field.
+func AuthorNameNotNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotNull(FieldAuthorName))
+}
+
+// AuthorNameEqualFold applies the EqualFold predicate on the "author_name" field.
+func AuthorNameEqualFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEqualFold(FieldAuthorName, v))
+}
+
+// AuthorNameContainsFold applies the ContainsFold predicate on the "author_name" field.
+func AuthorNameContainsFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContainsFold(FieldAuthorName, v))
+}
+
+// AuthorURLEQ applies the EQ predicate on the "author_url" field.
+func AuthorURLEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldAuthorURL, v))
+}
+
+// AuthorURLNEQ applies the NEQ predicate on the "author_url" field.
+func AuthorURLNEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldAuthorURL, v))
+}
+
+// AuthorURLIn applies the In predicate on the "author_url" field.
+func AuthorURLIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldAuthorURL, vs...))
+}
+
+// AuthorURLNotIn applies the NotIn predicate on the "author_url" field.
+func AuthorURLNotIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldAuthorURL, vs...))
+}
+
+// AuthorURLGT applies the GT predicate on the "author_url" field.
+func AuthorURLGT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldAuthorURL, v))
+}
+
+// AuthorURLGTE applies the GTE predicate on the "author_url" field.
+func AuthorURLGTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldAuthorURL, v))
+}
+
+// AuthorURLLT applies the LT predicate on the "author_url" field.
+func AuthorURLLT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldAuthorURL, v))
+}
+
+// AuthorURLLTE applies the LTE predicate on the "author_url" field.
+func AuthorURLLTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldAuthorURL, v))
+}
+
+// AuthorURLContains applies the Contains predicate on the "author_url" field.
+func AuthorURLContains(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContains(FieldAuthorURL, v))
+}
+
+// AuthorURLHasPrefix applies the HasPrefix predicate on the "author_url" field.
+func AuthorURLHasPrefix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasPrefix(FieldAuthorURL, v))
+}
+
+// AuthorURLHasSuffix applies the HasSuffix predicate on the "author_url" field.
+func AuthorURLHasSuffix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasSuffix(FieldAuthorURL, v))
+}
+
+// AuthorURLIsNil applies the IsNil predicate on the "author_url" field.
+func AuthorURLIsNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldIsNull(FieldAuthorURL))
+}
+
+// AuthorURLNotNil applies the NotNil predicate on the "author_url" field.
+func AuthorURLNotNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotNull(FieldAuthorURL))
+}
+
+// AuthorURLEqualFold applies the EqualFold predicate on the "author_url" field.
+func AuthorURLEqualFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEqualFold(FieldAuthorURL, v))
+}
+
+// AuthorURLContainsFold applies the ContainsFold predicate on the "author_url" field.
+func AuthorURLContainsFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContainsFold(FieldAuthorURL, v))
+}
+
+// AuthorPhotoEQ applies the EQ predicate on the "author_photo" field.
+func AuthorPhotoEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldAuthorPhoto, v))
+}
+
+// AuthorPhotoNEQ applies the NEQ predicate on the "author_photo" field.
+func AuthorPhotoNEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldAuthorPhoto, v))
+}
+
+// AuthorPhotoIn applies the In predicate on the "author_photo" field.
+func AuthorPhotoIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldAuthorPhoto, vs...))
+}
+
+// AuthorPhotoNotIn applies the NotIn predicate on the "author_photo" field.
+func AuthorPhotoNotIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldAuthorPhoto, vs...))
+}
+
+// AuthorPhotoGT applies the GT predicate on the "author_photo" field.
+func AuthorPhotoGT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldAuthorPhoto, v))
+}
+
+// AuthorPhotoGTE applies the GTE predicate on the "author_photo" field.
+func AuthorPhotoGTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldAuthorPhoto, v))
+}
+
+// AuthorPhotoLT applies the LT predicate on the "author_photo" field.
+func AuthorPhotoLT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldAuthorPhoto, v))
+}
+
+// AuthorPhotoLTE applies the LTE predicate on the "author_photo" field.
+func AuthorPhotoLTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldAuthorPhoto, v))
+}
+
+// AuthorPhotoContains applies the Contains predicate on the "author_photo" field.
+func AuthorPhotoContains(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContains(FieldAuthorPhoto, v))
+}
+
+// AuthorPhotoHasPrefix applies the HasPrefix predicate on the "author_photo" field.
+func AuthorPhotoHasPrefix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasPrefix(FieldAuthorPhoto, v))
+}
+
+// AuthorPhotoHasSuffix applies the HasSuffix predicate on the "author_photo" field.
+func AuthorPhotoHasSuffix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasSuffix(FieldAuthorPhoto, v))
+}
+
+// AuthorPhotoIsNil applies the IsNil predicate on the "author_photo" field.
+func AuthorPhotoIsNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldIsNull(FieldAuthorPhoto))
+}
+
+// AuthorPhotoNotNil applies the NotNil predicate on the "author_photo" field.
+func AuthorPhotoNotNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotNull(FieldAuthorPhoto))
+}
+
+// AuthorPhotoEqualFold applies the EqualFold predicate on the "author_photo" field.
+func AuthorPhotoEqualFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEqualFold(FieldAuthorPhoto, v))
+}
+
+// AuthorPhotoContainsFold applies the ContainsFold predicate on the "author_photo" field.
+func AuthorPhotoContainsFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContainsFold(FieldAuthorPhoto, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.Webmention {
+	return predicate.Webmention(sql.FieldContainsFold(FieldContent, v))
+}
+
+// PublishedAtEQ applies the EQ predicate on the "published_at" field.
+func PublishedAtEQ(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtNEQ applies the NEQ predicate on the "published_at" field.
+func PublishedAtNEQ(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldPublishedAt, v))
+}
+
+// PublishedAtIn applies the In predicate on the "published_at" field.
+func PublishedAtIn(vs ...time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtNotIn applies the NotIn predicate on the "published_at" field.
+func PublishedAtNotIn(vs ...time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldPublishedAt, vs...))
+}
+
+// PublishedAtGT applies the GT predicate on the "published_at" field.
+func PublishedAtGT(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldPublishedAt, v))
+}
+
+// PublishedAtGTE applies the GTE predicate on the "published_at" field.
+func PublishedAtGTE(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldPublishedAt, v))
+}
+
+// PublishedAtLT applies the LT predicate on the "published_at" field.
+func PublishedAtLT(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldPublishedAt, v))
+}
+
+// PublishedAtLTE applies the LTE predicate on the "published_at" field.
+func PublishedAtLTE(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldPublishedAt, v))
+}
+
+// PublishedAtIsNil applies the IsNil predicate on the "published_at" field.
+func PublishedAtIsNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldIsNull(FieldPublishedAt))
+}
+
+// PublishedAtNotNil applies the NotNil predicate on the "published_at" field.
+func PublishedAtNotNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotNull(FieldPublishedAt))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verified_at" field.
+func VerifiedAtEQ(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verified_at" field.
+func VerifiedAtNEQ(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verified_at" field.
+func VerifiedAtIn(vs ...time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verified_at" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verified_at" field.
+func VerifiedAtGT(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verified_at" field.
+func VerifiedAtGTE(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verified_at" field.
+func VerifiedAtLT(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verified_at" field.
+func VerifiedAtLTE(v time.Time) predicate.Webmention {
+	return predicate.Webmention(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verified_at" field.
+func VerifiedAtIsNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verified_at" field.
+func VerifiedAtNotNil() predicate.Webmention {
+	return predicate.Webmention(sql.FieldNotNull(FieldVerifiedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Webmention) predicate.Webmention {
+	return predicate.Webmention(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Webmention) predicate.Webmention {
+	return predicate.Webmention(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Webmention) predicate.Webmention {
+	return predicate.Webmention(sql.NotPredicates(p))
+}
@@ -0,0 +1,1331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/webmention"
+)
+
+// WebmentionCreate is the builder for creating a Webmention entity.
+type WebmentionCreate struct {
+	config
+	mutation *WebmentionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wc *WebmentionCreate) SetCreatedAt(t time.Time) *WebmentionCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WebmentionCreate) SetNillableCreatedAt(t *time.Time) *WebmentionCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wc *WebmentionCreate) SetUpdatedAt(t time.Time) *WebmentionCreate {
+	wc.mutation.SetUpdatedAt(t)
+	return wc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wc *WebmentionCreate) SetNillableUpdatedAt(t *time.Time) *WebmentionCreate {
+	if t != nil {
+		wc.SetUpdatedAt(*t)
+	}
+	return wc
+}
+
+// SetSource sets the "source" field.
+func (wc *WebmentionCreate) SetSource(s string) *WebmentionCreate {
+	wc.mutation.SetSource(s)
+	return wc
+}
+
+// SetTarget sets the "target" field.
+func (wc *WebmentionCreate) SetTarget(s string) *WebmentionCreate {
+	wc.mutation.SetTarget(s)
+	return wc
+}
+
+// SetTargetPath sets the "target_path" field.
+func (wc *WebmentionCreate) SetTargetPath(s string) *WebmentionCreate {
+	wc.mutation.SetTargetPath(s)
+	return wc
+}
+
+// SetType sets the "type" field.
+func (wc *WebmentionCreate) SetType(w webmention.Type) *WebmentionCreate {
+	wc.mutation.SetType(w)
+	return wc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (wc *WebmentionCreate) SetNillableType(w *webmention.Type) *WebmentionCreate {
+	if w != nil {
+		wc.SetType(*w)
+	}
+	return wc
+}
+
+// SetStatus sets the "status" field.
+func (wc *WebmentionCreate) SetStatus(w webmention.Status) *WebmentionCreate {
+	wc.mutation.SetStatus(w)
+	return wc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wc *WebmentionCreate) SetNillableStatus(w *webmention.Status) *WebmentionCreate {
+	if w != nil {
+		wc.SetStatus(*w)
+	}
+	return wc
+}
+
+// SetAuthorName sets the "author_name" field.
+func (wc *WebmentionCreate) SetAuthorName(s string) *WebmentionCreate {
+	wc.mutation.SetAuthorName(s)
+	return wc
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (wc *WebmentionCreate) SetNillableAuthorName(s *string) *WebmentionCreate {
+	if s != nil {
+		wc.SetAuthorName(*s)
+	}
+	return wc
+}
+
+// SetAuthorURL sets the "author_url" field.
+func (wc *WebmentionCreate) SetAuthorURL(s string) *WebmentionCreate {
+	wc.mutation.SetAuthorURL(s)
+	return wc
+}
+
+// SetNillableAuthorURL sets the "author_url" field if the given value is not nil.
+func (wc *WebmentionCreate) SetNillableAuthorURL(s *string) *WebmentionCreate {
+	if s != nil {
+		wc.SetAuthorURL(*s)
+	}
+	return wc
+}
+
+// SetAuthorPhoto sets the "author_photo" field.
+func (wc *WebmentionCreate) SetAuthorPhoto(s string) *WebmentionCreate {
+	wc.mutation.SetAuthorPhoto(s)
+	return wc
+}
+
+// SetNillableAuthorPhoto sets the "author_photo" field if the given value is not nil.
+func (wc *WebmentionCreate) SetNillableAuthorPhoto(s *string) *WebmentionCreate {
+	if s != nil {
+		wc.SetAuthorPhoto(*s)
+	}
+	return wc
+}
+
+// SetContent sets the "content" field.
+func (wc *WebmentionCreate) SetContent(s string) *WebmentionCreate {
+	wc.mutation.SetContent(s)
+	return wc
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (wc *WebmentionCreate) SetNillableContent(s *string) *WebmentionCreate {
+	if s != nil {
+		wc.SetContent(*s)
+	}
+	return wc
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (wc *WebmentionCreate) SetPublishedAt(t time.Time) *WebmentionCreate {
+	wc.mutation.SetPublishedAt(t)
+	return wc
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (wc *WebmentionCreate) SetNillablePublishedAt(t *time.Time) *WebmentionCreate {
+	if t != nil {
+		wc.SetPublishedAt(*t)
+	}
+	return wc
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (wc *WebmentionCreate) SetVerifiedAt(t time.Time) *WebmentionCreate {
+	wc.mutation.SetVerifiedAt(t)
+	return wc
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (wc *WebmentionCreate) SetNillableVerifiedAt(t *time.Time) *WebmentionCreate {
+	if t != nil {
+		wc.SetVerifiedAt(*t)
+	}
+	return wc
+}
+
+// SetID sets the "id" field.
+func (wc *WebmentionCreate) SetID(u uint) *WebmentionCreate {
+	wc.mutation.SetID(u)
+	return wc
+}
+
+// Mutation returns the WebmentionMutation object of the builder.
+func (wc *WebmentionCreate) Mutation() *WebmentionMutation {
+	return wc.mutation
+}
+
+// Save creates the Webmention in the database.
+func (wc *WebmentionCreate) Save(ctx context.Context) (*Webmention, error) {
+	wc.defaults()
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WebmentionCreate) SaveX(ctx context.Context) *Webmention {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WebmentionCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WebmentionCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WebmentionCreate) defaults() {
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := webmention.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		v := webmention.DefaultUpdatedAt()
+		wc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wc.mutation.GetType(); !ok {
+		v := webmention.DefaultType
+		wc.mutation.SetType(v)
+	}
+	if _, ok := wc.mutation.Status(); !ok {
+		v := webmention.DefaultStatus
+		wc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WebmentionCreate) check() error {
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Webmention.created_at"`)}
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Webmention.updated_at"`)}
+	}
+	if _, ok := wc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Webmention.source"`)}
+	}
+	if v, ok := wc.mutation.Source(); ok {
+		if err := webmention.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Webmention.source": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "Webmention.target"`)}
+	}
+	if v, ok := wc.mutation.Target(); ok {
+		if err := webmention.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Webmention.target": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.TargetPath(); !ok {
+		return &ValidationError{Name: "target_path", err: errors.New(`ent: missing required field "Webmention.target_path"`)}
+	}
+	if v, ok := wc.mutation.TargetPath(); ok {
+		if err := webmention.TargetPathValidator(v); err != nil {
+			return &ValidationError{Name: "target_path", err: fmt.Errorf(`ent: validator failed for field "Webmention.target_path": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Webmention.type"`)}
+	}
+	if v, ok := wc.mutation.GetType(); ok {
+		if err := webmention.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Webmention.type": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Webmention.status"`)}
+	}
+	if v, ok := wc.mutation.Status(); ok {
+		if err := webmention.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Webmention.status": %w`, err)}
+		}
+	}
+	if v, ok := wc.mutation.AuthorName(); ok {
+		if err := webmention.AuthorNameValidator(v); err != nil {
+			return &ValidationError{Name: "author_name", err: fmt.Errorf(`ent: validator failed for field "Webmention.author_name": %w`, err)}
+		}
+	}
+	if v, ok := wc.mutation.AuthorURL(); ok {
+		if err := webmention.AuthorURLValidator(v); err != nil {
+			return &ValidationError{Name: "author_url", err: fmt.Errorf(`ent: validator failed for field "Webmention.author_url": %w`, err)}
+		}
+	}
+	if v, ok := wc.mutation.AuthorPhoto(); ok {
+		if err := webmention.AuthorPhotoValidator(v); err != nil {
+			return &ValidationError{Name: "author_photo", err: fmt.Errorf(`ent: validator failed for field "Webmention.author_photo": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wc *WebmentionCreate) sqlSave(ctx context.Context) (*Webmention, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint(id)
+	}
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WebmentionCreate) createSpec() (*Webmention, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Webmention{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(webmention.Table, sqlgraph.NewFieldSpec(webmention.FieldID, field.TypeUint))
+	)
+	_spec.OnConflict = wc.conflict
+	if id, ok := wc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.SetField(webmention.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webmention.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wc.mutation.Source(); ok {
+		_spec.SetField(webmention.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := wc.mutation.Target(); ok {
+		_spec.SetField(webmention.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if value, ok := wc.mutation.TargetPath(); ok {
+		_spec.SetField(webmention.FieldTargetPath, field.TypeString, value)
+		_node.TargetPath = value
+	}
+	if value, ok := wc.mutation.GetType(); ok {
+		_spec.SetField(webmention.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := wc.mutation.Status(); ok {
+		_spec.SetField(webmention.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wc.mutation.AuthorName(); ok {
+		_spec.SetField(webmention.FieldAuthorName, field.TypeString, value)
+		_node.AuthorName = value
+	}
+	if value, ok := wc.mutation.AuthorURL(); ok {
+		_spec.SetField(webmention.FieldAuthorURL, field.TypeString, value)
+		_node.AuthorURL = value
+	}
+	if value, ok := wc.mutation.AuthorPhoto(); ok {
+		_spec.SetField(webmention.FieldAuthorPhoto, field.TypeString, value)
+		_node.AuthorPhoto = value
+	}
+	if value, ok := wc.mutation.Content(); ok {
+		_spec.SetField(webmention.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := wc.mutation.PublishedAt(); ok {
+		_spec.SetField(webmention.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := wc.mutation.VerifiedAt(); ok {
+		_spec.SetField(webmention.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Webmention.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebmentionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wc *WebmentionCreate) OnConflict(opts ...sql.ConflictOption) *WebmentionUpsertOne {
+	wc.conflict = opts
+	return &WebmentionUpsertOne{
+		create: wc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Webmention.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wc *WebmentionCreate) OnConflictColumns(columns ...string) *WebmentionUpsertOne {
+	wc.conflict = append(wc.conflict, sql.ConflictColumns(columns...))
+	return &WebmentionUpsertOne{
+		create: wc,
+	}
+}
+
+type (
+	// WebmentionUpsertOne is the builder for "upsert"-ing
+	//  one Webmention node.
+	WebmentionUpsertOne struct {
+		create *WebmentionCreate
+	}
+
+	// WebmentionUpsert is the "OnConflict" setter.
+	WebmentionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebmentionUpsert) SetUpdatedAt(v time.Time) *WebmentionUpsert {
+	u.Set(webmention.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateUpdatedAt() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldUpdatedAt)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *WebmentionUpsert) SetSource(v string) *WebmentionUpsert {
+	u.Set(webmention.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateSource() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldSource)
+	return u
+}
+
+// SetTarget sets the "target" field.
+func (u *WebmentionUpsert) SetTarget(v string) *WebmentionUpsert {
+	u.Set(webmention.FieldTarget, v)
+	return u
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateTarget() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldTarget)
+	return u
+}
+
+// SetTargetPath sets the "target_path" field.
+func (u *WebmentionUpsert) SetTargetPath(v string) *WebmentionUpsert {
+	u.Set(webmention.FieldTargetPath, v)
+	return u
+}
+
+// UpdateTargetPath sets the "target_path" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateTargetPath() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldTargetPath)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *WebmentionUpsert) SetType(v webmention.Type) *WebmentionUpsert {
+	u.Set(webmention.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateType() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WebmentionUpsert) SetStatus(v webmention.Status) *WebmentionUpsert {
+	u.Set(webmention.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateStatus() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldStatus)
+	return u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *WebmentionUpsert) SetAuthorName(v string) *WebmentionUpsert {
+	u.Set(webmention.FieldAuthorName, v)
+	return u
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateAuthorName() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldAuthorName)
+	return u
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (u *WebmentionUpsert) ClearAuthorName() *WebmentionUpsert {
+	u.SetNull(webmention.FieldAuthorName)
+	return u
+}
+
+// SetAuthorURL sets the "author_url" field.
+func (u *WebmentionUpsert) SetAuthorURL(v string) *WebmentionUpsert {
+	u.Set(webmention.FieldAuthorURL, v)
+	return u
+}
+
+// UpdateAuthorURL sets the "author_url" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateAuthorURL() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldAuthorURL)
+	return u
+}
+
+// ClearAuthorURL clears the value of the "author_url" field.
+func (u *WebmentionUpsert) ClearAuthorURL() *WebmentionUpsert {
+	u.SetNull(webmention.FieldAuthorURL)
+	return u
+}
+
+// SetAuthorPhoto sets the "author_photo" field.
+func (u *WebmentionUpsert) SetAuthorPhoto(v string) *WebmentionUpsert {
+	u.Set(webmention.FieldAuthorPhoto, v)
+	return u
+}
+
+// UpdateAuthorPhoto sets the "author_photo" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateAuthorPhoto() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldAuthorPhoto)
+	return u
+}
+
+// ClearAuthorPhoto clears the value of the "author_photo" field.
+func (u *WebmentionUpsert) ClearAuthorPhoto() *WebmentionUpsert {
+	u.SetNull(webmention.FieldAuthorPhoto)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *WebmentionUpsert) SetContent(v string) *WebmentionUpsert {
+	u.Set(webmention.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateContent() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *WebmentionUpsert) ClearContent() *WebmentionUpsert {
+	u.SetNull(webmention.FieldContent)
+	return u
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *WebmentionUpsert) SetPublishedAt(v time.Time) *WebmentionUpsert {
+	u.Set(webmention.FieldPublishedAt, v)
+	return u
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdatePublishedAt() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldPublishedAt)
+	return u
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *WebmentionUpsert) ClearPublishedAt() *WebmentionUpsert {
+	u.SetNull(webmention.FieldPublishedAt)
+	return u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *WebmentionUpsert) SetVerifiedAt(v time.Time) *WebmentionUpsert {
+	u.Set(webmention.FieldVerifiedAt, v)
+	return u
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *WebmentionUpsert) UpdateVerifiedAt() *WebmentionUpsert {
+	u.SetExcluded(webmention.FieldVerifiedAt)
+	return u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *WebmentionUpsert) ClearVerifiedAt() *WebmentionUpsert {
+	u.SetNull(webmention.FieldVerifiedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Webmention.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webmention.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebmentionUpsertOne) UpdateNewValues() *WebmentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webmention.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(webmention.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Webmention.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebmentionUpsertOne) Ignore() *WebmentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebmentionUpsertOne) DoNothing() *WebmentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebmentionCreate.OnConflict
+// documentation for more info.
+func (u *WebmentionUpsertOne) Update(set func(*WebmentionUpsert)) *WebmentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebmentionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebmentionUpsertOne) SetUpdatedAt(v time.Time) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateUpdatedAt() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *WebmentionUpsertOne) SetSource(v string) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateSource() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetTarget sets the "target" field.
+func (u *WebmentionUpsertOne) SetTarget(v string) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetTarget(v)
+	})
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateTarget() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateTarget()
+	})
+}
+
+// SetTargetPath sets the "target_path" field.
+func (u *WebmentionUpsertOne) SetTargetPath(v string) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetTargetPath(v)
+	})
+}
+
+// UpdateTargetPath sets the "target_path" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateTargetPath() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateTargetPath()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *WebmentionUpsertOne) SetType(v webmention.Type) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateType() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WebmentionUpsertOne) SetStatus(v webmention.Status) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateStatus() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *WebmentionUpsertOne) SetAuthorName(v string) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetAuthorName(v)
+	})
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateAuthorName() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateAuthorName()
+	})
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (u *WebmentionUpsertOne) ClearAuthorName() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearAuthorName()
+	})
+}
+
+// SetAuthorURL sets the "author_url" field.
+func (u *WebmentionUpsertOne) SetAuthorURL(v string) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetAuthorURL(v)
+	})
+}
+
+// UpdateAuthorURL sets the "author_url" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateAuthorURL() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateAuthorURL()
+	})
+}
+
+// ClearAuthorURL clears the value of the "author_url" field.
+func (u *WebmentionUpsertOne) ClearAuthorURL() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearAuthorURL()
+	})
+}
+
+// SetAuthorPhoto sets the "author_photo" field.
+func (u *WebmentionUpsertOne) SetAuthorPhoto(v string) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetAuthorPhoto(v)
+	})
+}
+
+// UpdateAuthorPhoto sets the "author_photo" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateAuthorPhoto() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateAuthorPhoto()
+	})
+}
+
+// ClearAuthorPhoto clears the value of the "author_photo" field.
+func (u *WebmentionUpsertOne) ClearAuthorPhoto() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearAuthorPhoto()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *WebmentionUpsertOne) SetContent(v string) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateContent() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *WebmentionUpsertOne) ClearContent() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *WebmentionUpsertOne) SetPublishedAt(v time.Time) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdatePublishedAt() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *WebmentionUpsertOne) ClearPublishedAt() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *WebmentionUpsertOne) SetVerifiedAt(v time.Time) *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *WebmentionUpsertOne) UpdateVerifiedAt() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *WebmentionUpsertOne) ClearVerifiedAt() *WebmentionUpsertOne {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebmentionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebmentionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebmentionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebmentionUpsertOne) ID(ctx context.Context) (id uint, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebmentionUpsertOne) IDX(ctx context.Context) uint {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebmentionCreateBulk is the builder for creating many Webmention entities in bulk.
+type WebmentionCreateBulk struct {
+	config
+	err      error
+	builders []*WebmentionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Webmention entities in the database.
+func (wcb *WebmentionCreateBulk) Save(ctx context.Context) ([]*Webmention, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Webmention, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebmentionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WebmentionCreateBulk) SaveX(ctx context.Context) []*Webmention {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WebmentionCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WebmentionCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Webmention.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebmentionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wcb *WebmentionCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebmentionUpsertBulk {
+	wcb.conflict = opts
+	return &WebmentionUpsertBulk{
+		create: wcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Webmention.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wcb *WebmentionCreateBulk) OnConflictColumns(columns ...string) *WebmentionUpsertBulk {
+	wcb.conflict = append(wcb.conflict, sql.ConflictColumns(columns...))
+	return &WebmentionUpsertBulk{
+		create: wcb,
+	}
+}
+
+// WebmentionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Webmention nodes.
+type WebmentionUpsertBulk struct {
+	create *WebmentionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Webmention.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webmention.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebmentionUpsertBulk) UpdateNewValues() *WebmentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webmention.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(webmention.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Webmention.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebmentionUpsertBulk) Ignore() *WebmentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebmentionUpsertBulk) DoNothing() *WebmentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebmentionCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebmentionUpsertBulk) Update(set func(*WebmentionUpsert)) *WebmentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebmentionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebmentionUpsertBulk) SetUpdatedAt(v time.Time) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateUpdatedAt() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *WebmentionUpsertBulk) SetSource(v string) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateSource() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetTarget sets the "target" field.
+func (u *WebmentionUpsertBulk) SetTarget(v string) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetTarget(v)
+	})
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateTarget() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateTarget()
+	})
+}
+
+// SetTargetPath sets the "target_path" field.
+func (u *WebmentionUpsertBulk) SetTargetPath(v string) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetTargetPath(v)
+	})
+}
+
+// UpdateTargetPath sets the "target_path" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateTargetPath() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateTargetPath()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *WebmentionUpsertBulk) SetType(v webmention.Type) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateType() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WebmentionUpsertBulk) SetStatus(v webmention.Status) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateStatus() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *WebmentionUpsertBulk) SetAuthorName(v string) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetAuthorName(v)
+	})
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateAuthorName() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateAuthorName()
+	})
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (u *WebmentionUpsertBulk) ClearAuthorName() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearAuthorName()
+	})
+}
+
+// SetAuthorURL sets the "author_url" field.
+func (u *WebmentionUpsertBulk) SetAuthorURL(v string) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetAuthorURL(v)
+	})
+}
+
+// UpdateAuthorURL sets the "author_url" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateAuthorURL() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateAuthorURL()
+	})
+}
+
+// ClearAuthorURL clears the value of the "author_url" field.
+func (u *WebmentionUpsertBulk) ClearAuthorURL() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearAuthorURL()
+	})
+}
+
+// SetAuthorPhoto sets the "author_photo" field.
+func (u *WebmentionUpsertBulk) SetAuthorPhoto(v string) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetAuthorPhoto(v)
+	})
+}
+
+// UpdateAuthorPhoto sets the "author_photo" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateAuthorPhoto() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateAuthorPhoto()
+	})
+}
+
+// ClearAuthorPhoto clears the value of the "author_photo" field.
+func (u *WebmentionUpsertBulk) ClearAuthorPhoto() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearAuthorPhoto()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *WebmentionUpsertBulk) SetContent(v string) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateContent() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *WebmentionUpsertBulk) ClearContent() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (u *WebmentionUpsertBulk) SetPublishedAt(v time.Time) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetPublishedAt(v)
+	})
+}
+
+// UpdatePublishedAt sets the "published_at" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdatePublishedAt() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdatePublishedAt()
+	})
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (u *WebmentionUpsertBulk) ClearPublishedAt() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearPublishedAt()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *WebmentionUpsertBulk) SetVerifiedAt(v time.Time) *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *WebmentionUpsertBulk) UpdateVerifiedAt() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *WebmentionUpsertBulk) ClearVerifiedAt() *WebmentionUpsertBulk {
+	return u.Update(func(s *WebmentionUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebmentionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WebmentionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebmentionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebmentionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/webmention"
+)
+
+// WebmentionDelete is the builder for deleting a Webmention entity.
+type WebmentionDelete struct {
+	config
+	hooks    []Hook
+	mutation *WebmentionMutation
+}
+
+// Where appends a list predicates to the WebmentionDelete builder.
+func (wd *WebmentionDelete) Where(ps ...predicate.Webmention) *WebmentionDelete {
+	wd.mutation.Where(ps...)
+	return wd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wd *WebmentionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wd.sqlExec, wd.mutation, wd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wd *WebmentionDelete) ExecX(ctx context.Context) int {
+	n, err := wd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wd *WebmentionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(webmention.Table, sqlgraph.NewFieldSpec(webmention.FieldID, field.TypeUint))
+	if ps := wd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wd.mutation.done = true
+	return affected, err
+}
+
+// WebmentionDeleteOne is the builder for deleting a single Webmention entity.
+type WebmentionDeleteOne struct {
+	wd *WebmentionDelete
+}
+
+// Where appends a list predicates to the WebmentionDelete builder.
+func (wdo *WebmentionDeleteOne) Where(ps ...predicate.Webmention) *WebmentionDeleteOne {
+	wdo.wd.mutation.Where(ps...)
+	return wdo
+}
+
+// Exec executes the deletion query.
+func (wdo *WebmentionDeleteOne) Exec(ctx context.Context) error {
+	n, err := wdo.wd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webmention.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdo *WebmentionDeleteOne) ExecX(ctx context.Context) {
+	if err := wdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/webmention"
+)
+
+// WebmentionQuery is the builder for querying Webmention entities.
+type WebmentionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []webmention.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Webmention
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebmentionQuery builder.
+func (wq *WebmentionQuery) Where(ps ...predicate.Webmention) *WebmentionQuery {
+	wq.predicates = append(wq.predicates, ps...)
+	return wq
+}
+
+// Limit the number of records to be returned by this query.
+func (wq *WebmentionQuery) Limit(limit int) *WebmentionQuery {
+	wq.ctx.Limit = &limit
+	return wq
+}
+
+// Offset to start from.
+func (wq *WebmentionQuery) Offset(offset int) *WebmentionQuery {
+	wq.ctx.Offset = &offset
+	return wq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wq *WebmentionQuery) Unique(unique bool) *WebmentionQuery {
+	wq.ctx.Unique = &unique
+	return wq
+}
+
+// Order specifies how the records should be ordered.
+func (wq *WebmentionQuery) Order(o ...webmention.OrderOption) *WebmentionQuery {
+	wq.order = append(wq.order, o...)
+	return wq
+}
+
+// First returns the first Webmention entity from the query.
+// Returns a *NotFoundError when no Webmention was found.
+func (wq *WebmentionQuery) First(ctx context.Context) (*Webmention, error) {
+	nodes, err := wq.Limit(1).All(setContextOp(ctx, wq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webmention.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wq *WebmentionQuery) FirstX(ctx context.Context) *Webmention {
+	node, err := wq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Webmention ID from the query.
+// Returns a *NotFoundError when no Webmention ID was found.
+func (wq *WebmentionQuery) FirstID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = wq.Limit(1).IDs(setContextOp(ctx, wq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webmention.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wq *WebmentionQuery) FirstIDX(ctx context.Context) uint {
+	id, err := wq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Webmention entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Webmention entity is found.
+// Returns a *NotFoundError when no Webmention entities are found.
+func (wq *WebmentionQuery) Only(ctx context.Context) (*Webmention, error) {
+	nodes, err := wq.Limit(2).All(setContextOp(ctx, wq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webmention.Label}
+	default:
+		return nil, &NotSingularError{webmention.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wq *WebmentionQuery) OnlyX(ctx context.Context) *Webmention {
+	node, err := wq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Webmention ID in the query.
+// Returns a *NotSingularError when more than one Webmention ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wq *WebmentionQuery) OnlyID(ctx context.Context) (id uint, err error) {
+	var ids []uint
+	if ids, err = wq.Limit(2).IDs(setContextOp(ctx, wq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webmention.Label}
+	default:
+		err = &NotSingularError{webmention.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wq *WebmentionQuery) OnlyIDX(ctx context.Context) uint {
+	id, err := wq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Webmentions.
+func (wq *WebmentionQuery) All(ctx context.Context) ([]*Webmention, error) {
+	ctx = setContextOp(ctx, wq.ctx, ent.OpQueryAll)
+	if err := wq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Webmention, *WebmentionQuery]()
+	return withInterceptors[[]*Webmention](ctx, wq, qr, wq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wq *WebmentionQuery) AllX(ctx context.Context) []*Webmention {
+	nodes, err := wq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Webmention IDs.
+func (wq *WebmentionQuery) IDs(ctx context.Context) (ids []uint, err error) {
+	if wq.ctx.Unique == nil && wq.path != nil {
+		wq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wq.ctx, ent.OpQueryIDs)
+	if err = wq.Select(webmention.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wq *WebmentionQuery) IDsX(ctx context.Context) []uint {
+	ids, err := wq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wq *WebmentionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wq.ctx, ent.OpQueryCount)
+	if err := wq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wq, querierCount[*WebmentionQuery](), wq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wq *WebmentionQuery) CountX(ctx context.Context) int {
+	count, err := wq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wq *WebmentionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wq.ctx, ent.OpQueryExist)
+	switch _, err := wq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wq *WebmentionQuery) ExistX(ctx context.Context) bool {
+	exist, err := wq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebmentionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wq *WebmentionQuery) Clone() *WebmentionQuery {
+	if wq == nil {
+		return nil
+	}
+	return &WebmentionQuery{
+		config:     wq.config,
+		ctx:        wq.ctx.Clone(),
+		order:      append([]webmention.OrderOption{}, wq.order...),
+		inters:     append([]Interceptor{}, wq.inters...),
+		predicates: append([]predicate.Webmention{}, wq.predicates...),
+		// clone intermediate query.
+		sql:       wq.sql.Clone(),
+		path:      wq.path,
+		modifiers: append([]func(*sql.Selector){}, wq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Webmention.Query().
+//		GroupBy(webmention.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wq *WebmentionQuery) GroupBy(field string, fields ...string) *WebmentionGroupBy {
+	wq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WebmentionGroupBy{build: wq}
+	grbuild.flds = &wq.ctx.Fields
+	grbuild.label = webmention.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.Webmention.Query().
+//		Select(webmention.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (wq *WebmentionQuery) Select(fields ...string) *WebmentionSelect {
+	wq.ctx.Fields = append(wq.ctx.Fields, fields...)
+	sbuild := &WebmentionSelect{WebmentionQuery: wq}
+	sbuild.label = webmention.Label
+	sbuild.flds, sbuild.scan = &wq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WebmentionSelect configured with the given aggregations.
+func (wq *WebmentionQuery) Aggregate(fns ...AggregateFunc) *WebmentionSelect {
+	return wq.Select().Aggregate(fns...)
+}
+
+func (wq *WebmentionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wq.ctx.Fields {
+		if !webmention.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wq.path != nil {
+		prev, err := wq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wq.sql = prev
+	}
+	return nil
+}
+
+func (wq *WebmentionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Webmention, error) {
+	var (
+		nodes = []*Webmention{}
+		_spec = wq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Webmention).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Webmention{config: wq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(wq.modifiers) > 0 {
+		_spec.Modifiers = wq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wq *WebmentionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wq.querySpec()
+	if len(wq.modifiers) > 0 {
+		_spec.Modifiers = wq.modifiers
+	}
+	_spec.Node.Columns = wq.ctx.Fields
+	if len(wq.ctx.Fields) > 0 {
+		_spec.Unique = wq.ctx.Unique != nil && *wq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wq.driver, _spec)
+}
+
+func (wq *WebmentionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(webmention.Table, webmention.Columns, sqlgraph.NewFieldSpec(webmention.FieldID, field.TypeUint))
+	_spec.From = wq.sql
+	if unique := wq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webmention.FieldID)
+		for i := range fields {
+			if fields[i] != webmention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wq *WebmentionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wq.driver.Dialect())
+	t1 := builder.Table(webmention.Table)
+	columns := wq.ctx.Fields
+	if len(columns) == 0 {
+		columns = webmention.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wq.sql != nil {
+		selector = wq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wq.ctx.Unique != nil && *wq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range wq.modifiers {
+		m(selector)
+	}
+	for _, p := range wq.predicates {
+		p(selector)
+	}
+	for _, p := range wq.order {
+		p(selector)
+	}
+	if offset := wq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wq *WebmentionQuery) Modify(modifiers ...func(s *sql.Selector)) *WebmentionSelect {
+	wq.modifiers = append(wq.modifiers, modifiers...)
+	return wq.Select()
+}
+
+// WebmentionGroupBy is the group-by builder for Webmention entities.
+type WebmentionGroupBy struct {
+	selector
+	build *WebmentionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wgb *WebmentionGroupBy) Aggregate(fns ...AggregateFunc) *WebmentionGroupBy {
+	wgb.fns = append(wgb.fns, fns...)
+	return wgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wgb *WebmentionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebmentionQuery, *WebmentionGroupBy](ctx, wgb.build, wgb, wgb.build.inters, v)
+}
+
+func (wgb *WebmentionGroupBy) sqlScan(ctx context.Context, root *WebmentionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wgb.fns))
+	for _, fn := range wgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wgb.flds)+len(wgb.fns))
+		for _, f := range *wgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WebmentionSelect is the builder for selecting fields of Webmention entities.
+type WebmentionSelect struct {
+	*WebmentionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ws *WebmentionSelect) Aggregate(fns ...AggregateFunc) *WebmentionSelect {
+	ws.fns = append(ws.fns, fns...)
+	return ws
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ws *WebmentionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ws.ctx, ent.OpQuerySelect)
+	if err := ws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WebmentionQuery, *WebmentionSelect](ctx, ws.WebmentionQuery, ws, ws.inters, v)
+}
+
+func (ws *WebmentionSelect) sqlScan(ctx context.Context, root *WebmentionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ws.fns))
+	for _, fn := range ws.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ws.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ws *WebmentionSelect) Modify(modifiers ...func(s *sql.Selector)) *WebmentionSelect {
+	ws.modifiers = append(ws.modifiers, modifiers...)
+	return ws
+}
@@ -0,0 +1,806 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/webmention"
+)
+
+// WebmentionUpdate is the builder for updating Webmention entities.
+type WebmentionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebmentionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebmentionUpdate builder.
+func (wu *WebmentionUpdate) Where(ps ...predicate.Webmention) *WebmentionUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wu *WebmentionUpdate) SetUpdatedAt(t time.Time) *WebmentionUpdate {
+	wu.mutation.SetUpdatedAt(t)
+	return wu
+}
+
+// SetSource sets the "source" field.
+func (wu *WebmentionUpdate) SetSource(s string) *WebmentionUpdate {
+	wu.mutation.SetSource(s)
+	return wu
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillableSource(s *string) *WebmentionUpdate {
+	if s != nil {
+		wu.SetSource(*s)
+	}
+	return wu
+}
+
+// SetTarget sets the "target" field.
+func (wu *WebmentionUpdate) SetTarget(s string) *WebmentionUpdate {
+	wu.mutation.SetTarget(s)
+	return wu
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillableTarget(s *string) *WebmentionUpdate {
+	if s != nil {
+		wu.SetTarget(*s)
+	}
+	return wu
+}
+
+// SetTargetPath sets the "target_path" field.
+func (wu *WebmentionUpdate) SetTargetPath(s string) *WebmentionUpdate {
+	wu.mutation.SetTargetPath(s)
+	return wu
+}
+
+// SetNillableTargetPath sets the "target_path" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillableTargetPath(s *string) *WebmentionUpdate {
+	if s != nil {
+		wu.SetTargetPath(*s)
+	}
+	return wu
+}
+
+// SetType sets the "type" field.
+func (wu *WebmentionUpdate) SetType(w webmention.Type) *WebmentionUpdate {
+	wu.mutation.SetType(w)
+	return wu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillableType(w *webmention.Type) *WebmentionUpdate {
+	if w != nil {
+		wu.SetType(*w)
+	}
+	return wu
+}
+
+// SetStatus sets the "status" field.
+func (wu *WebmentionUpdate) SetStatus(w webmention.Status) *WebmentionUpdate {
+	wu.mutation.SetStatus(w)
+	return wu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillableStatus(w *webmention.Status) *WebmentionUpdate {
+	if w != nil {
+		wu.SetStatus(*w)
+	}
+	return wu
+}
+
+// SetAuthorName sets the "author_name" field.
+func (wu *WebmentionUpdate) SetAuthorName(s string) *WebmentionUpdate {
+	wu.mutation.SetAuthorName(s)
+	return wu
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillableAuthorName(s *string) *WebmentionUpdate {
+	if s != nil {
+		wu.SetAuthorName(*s)
+	}
+	return wu
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (wu *WebmentionUpdate) ClearAuthorName() *WebmentionUpdate {
+	wu.mutation.ClearAuthorName()
+	return wu
+}
+
+// SetAuthorURL sets the "author_url" field.
+func (wu *WebmentionUpdate) SetAuthorURL(s string) *WebmentionUpdate {
+	wu.mutation.SetAuthorURL(s)
+	return wu
+}
+
+// SetNillableAuthorURL sets the "author_url" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillableAuthorURL(s *string) *WebmentionUpdate {
+	if s != nil {
+		wu.SetAuthorURL(*s)
+	}
+	return wu
+}
+
+// ClearAuthorURL clears the value of the "author_url" field.
+func (wu *WebmentionUpdate) ClearAuthorURL() *WebmentionUpdate {
+	wu.mutation.ClearAuthorURL()
+	return wu
+}
+
+// SetAuthorPhoto sets the "author_photo" field.
+func (wu *WebmentionUpdate) SetAuthorPhoto(s string) *WebmentionUpdate {
+	wu.mutation.SetAuthorPhoto(s)
+	return wu
+}
+
+// SetNillableAuthorPhoto sets the "author_photo" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillableAuthorPhoto(s *string) *WebmentionUpdate {
+	if s != nil {
+		wu.SetAuthorPhoto(*s)
+	}
+	return wu
+}
+
+// ClearAuthorPhoto clears the value of the "author_photo" field.
+func (wu *WebmentionUpdate) ClearAuthorPhoto() *WebmentionUpdate {
+	wu.mutation.ClearAuthorPhoto()
+	return wu
+}
+
+// SetContent sets the "content" field.
+func (wu *WebmentionUpdate) SetContent(s string) *WebmentionUpdate {
+	wu.mutation.SetContent(s)
+	return wu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillableContent(s *string) *WebmentionUpdate {
+	if s != nil {
+		wu.SetContent(*s)
+	}
+	return wu
+}
+
+// ClearContent clears the value of the "content" field.
+func (wu *WebmentionUpdate) ClearContent() *WebmentionUpdate {
+	wu.mutation.ClearContent()
+	return wu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (wu *WebmentionUpdate) SetPublishedAt(t time.Time) *WebmentionUpdate {
+	wu.mutation.SetPublishedAt(t)
+	return wu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillablePublishedAt(t *time.Time) *WebmentionUpdate {
+	if t != nil {
+		wu.SetPublishedAt(*t)
+	}
+	return wu
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (wu *WebmentionUpdate) ClearPublishedAt() *WebmentionUpdate {
+	wu.mutation.ClearPublishedAt()
+	return wu
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (wu *WebmentionUpdate) SetVerifiedAt(t time.Time) *WebmentionUpdate {
+	wu.mutation.SetVerifiedAt(t)
+	return wu
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (wu *WebmentionUpdate) SetNillableVerifiedAt(t *time.Time) *WebmentionUpdate {
+	if t != nil {
+		wu.SetVerifiedAt(*t)
+	}
+	return wu
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (wu *WebmentionUpdate) ClearVerifiedAt() *WebmentionUpdate {
+	wu.mutation.ClearVerifiedAt()
+	return wu
+}
+
+// Mutation returns the WebmentionMutation object of the builder.
+func (wu *WebmentionUpdate) Mutation() *WebmentionMutation {
+	return wu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WebmentionUpdate) Save(ctx context.Context) (int, error) {
+	wu.defaults()
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WebmentionUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WebmentionUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WebmentionUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WebmentionUpdate) defaults() {
+	if _, ok := wu.mutation.UpdatedAt(); !ok {
+		v := webmention.UpdateDefaultUpdatedAt()
+		wu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WebmentionUpdate) check() error {
+	if v, ok := wu.mutation.Source(); ok {
+		if err := webmention.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Webmention.source": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.Target(); ok {
+		if err := webmention.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Webmention.target": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.TargetPath(); ok {
+		if err := webmention.TargetPathValidator(v); err != nil {
+			return &ValidationError{Name: "target_path", err: fmt.Errorf(`ent: validator failed for field "Webmention.target_path": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.GetType(); ok {
+		if err := webmention.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Webmention.type": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.Status(); ok {
+		if err := webmention.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Webmention.status": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.AuthorName(); ok {
+		if err := webmention.AuthorNameValidator(v); err != nil {
+			return &ValidationError{Name: "author_name", err: fmt.Errorf(`ent: validator failed for field "Webmention.author_name": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.AuthorURL(); ok {
+		if err := webmention.AuthorURLValidator(v); err != nil {
+			return &ValidationError{Name: "author_url", err: fmt.Errorf(`ent: validator failed for field "Webmention.author_url": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.AuthorPhoto(); ok {
+		if err := webmention.AuthorPhotoValidator(v); err != nil {
+			return &ValidationError{Name: "author_photo", err: fmt.Errorf(`ent: validator failed for field "Webmention.author_photo": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wu *WebmentionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebmentionUpdate {
+	wu.modifiers = append(wu.modifiers, modifiers...)
+	return wu
+}
+
+func (wu *WebmentionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webmention.Table, webmention.Columns, sqlgraph.NewFieldSpec(webmention.FieldID, field.TypeUint))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webmention.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.Source(); ok {
+		_spec.SetField(webmention.FieldSource, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Target(); ok {
+		_spec.SetField(webmention.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.TargetPath(); ok {
+		_spec.SetField(webmention.FieldTargetPath, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.GetType(); ok {
+		_spec.SetField(webmention.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := wu.mutation.Status(); ok {
+		_spec.SetField(webmention.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wu.mutation.AuthorName(); ok {
+		_spec.SetField(webmention.FieldAuthorName, field.TypeString, value)
+	}
+	if wu.mutation.AuthorNameCleared() {
+		_spec.ClearField(webmention.FieldAuthorName, field.TypeString)
+	}
+	if value, ok := wu.mutation.AuthorURL(); ok {
+		_spec.SetField(webmention.FieldAuthorURL, field.TypeString, value)
+	}
+	if wu.mutation.AuthorURLCleared() {
+		_spec.ClearField(webmention.FieldAuthorURL, field.TypeString)
+	}
+	if value, ok := wu.mutation.AuthorPhoto(); ok {
+		_spec.SetField(webmention.FieldAuthorPhoto, field.TypeString, value)
+	}
+	if wu.mutation.AuthorPhotoCleared() {
+		_spec.ClearField(webmention.FieldAuthorPhoto, field.TypeString)
+	}
+	if value, ok := wu.mutation.Content(); ok {
+		_spec.SetField(webmention.FieldContent, field.TypeString, value)
+	}
+	if wu.mutation.ContentCleared() {
+		_spec.ClearField(webmention.FieldContent, field.TypeString)
+	}
+	if value, ok := wu.mutation.PublishedAt(); ok {
+		_spec.SetField(webmention.FieldPublishedAt, field.TypeTime, value)
+	}
+	if wu.mutation.PublishedAtCleared() {
+		_spec.ClearField(webmention.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := wu.mutation.VerifiedAt(); ok {
+		_spec.SetField(webmention.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if wu.mutation.VerifiedAtCleared() {
+		_spec.ClearField(webmention.FieldVerifiedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(wu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webmention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WebmentionUpdateOne is the builder for updating a single Webmention entity.
+type WebmentionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebmentionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wuo *WebmentionUpdateOne) SetUpdatedAt(t time.Time) *WebmentionUpdateOne {
+	wuo.mutation.SetUpdatedAt(t)
+	return wuo
+}
+
+// SetSource sets the "source" field.
+func (wuo *WebmentionUpdateOne) SetSource(s string) *WebmentionUpdateOne {
+	wuo.mutation.SetSource(s)
+	return wuo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillableSource(s *string) *WebmentionUpdateOne {
+	if s != nil {
+		wuo.SetSource(*s)
+	}
+	return wuo
+}
+
+// SetTarget sets the "target" field.
+func (wuo *WebmentionUpdateOne) SetTarget(s string) *WebmentionUpdateOne {
+	wuo.mutation.SetTarget(s)
+	return wuo
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillableTarget(s *string) *WebmentionUpdateOne {
+	if s != nil {
+		wuo.SetTarget(*s)
+	}
+	return wuo
+}
+
+// SetTargetPath sets the "target_path" field.
+func (wuo *WebmentionUpdateOne) SetTargetPath(s string) *WebmentionUpdateOne {
+	wuo.mutation.SetTargetPath(s)
+	return wuo
+}
+
+// SetNillableTargetPath sets the "target_path" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillableTargetPath(s *string) *WebmentionUpdateOne {
+	if s != nil {
+		wuo.SetTargetPath(*s)
+	}
+	return wuo
+}
+
+// SetType sets the "type" field.
+func (wuo *WebmentionUpdateOne) SetType(w webmention.Type) *WebmentionUpdateOne {
+	wuo.mutation.SetType(w)
+	return wuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillableType(w *webmention.Type) *WebmentionUpdateOne {
+	if w != nil {
+		wuo.SetType(*w)
+	}
+	return wuo
+}
+
+// SetStatus sets the "status" field.
+func (wuo *WebmentionUpdateOne) SetStatus(w webmention.Status) *WebmentionUpdateOne {
+	wuo.mutation.SetStatus(w)
+	return wuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillableStatus(w *webmention.Status) *WebmentionUpdateOne {
+	if w != nil {
+		wuo.SetStatus(*w)
+	}
+	return wuo
+}
+
+// SetAuthorName sets the "author_name" field.
+func (wuo *WebmentionUpdateOne) SetAuthorName(s string) *WebmentionUpdateOne {
+	wuo.mutation.SetAuthorName(s)
+	return wuo
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillableAuthorName(s *string) *WebmentionUpdateOne {
+	if s != nil {
+		wuo.SetAuthorName(*s)
+	}
+	return wuo
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (wuo *WebmentionUpdateOne) ClearAuthorName() *WebmentionUpdateOne {
+	wuo.mutation.ClearAuthorName()
+	return wuo
+}
+
+// SetAuthorURL sets the "author_url" field.
+func (wuo *WebmentionUpdateOne) SetAuthorURL(s string) *WebmentionUpdateOne {
+	wuo.mutation.SetAuthorURL(s)
+	return wuo
+}
+
+// SetNillableAuthorURL sets the "author_url" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillableAuthorURL(s *string) *WebmentionUpdateOne {
+	if s != nil {
+		wuo.SetAuthorURL(*s)
+	}
+	return wuo
+}
+
+// ClearAuthorURL clears the value of the "author_url" field.
+func (wuo *WebmentionUpdateOne) ClearAuthorURL() *WebmentionUpdateOne {
+	wuo.mutation.ClearAuthorURL()
+	return wuo
+}
+
+// SetAuthorPhoto sets the "author_photo" field.
+func (wuo *WebmentionUpdateOne) SetAuthorPhoto(s string) *WebmentionUpdateOne {
+	wuo.mutation.SetAuthorPhoto(s)
+	return wuo
+}
+
+// SetNillableAuthorPhoto sets the "author_photo" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillableAuthorPhoto(s *string) *WebmentionUpdateOne {
+	if s != nil {
+		wuo.SetAuthorPhoto(*s)
+	}
+	return wuo
+}
+
+// ClearAuthorPhoto clears the value of the "author_photo" field.
+func (wuo *WebmentionUpdateOne) ClearAuthorPhoto() *WebmentionUpdateOne {
+	wuo.mutation.ClearAuthorPhoto()
+	return wuo
+}
+
+// SetContent sets the "content" field.
+func (wuo *WebmentionUpdateOne) SetContent(s string) *WebmentionUpdateOne {
+	wuo.mutation.SetContent(s)
+	return wuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillableContent(s *string) *WebmentionUpdateOne {
+	if s != nil {
+		wuo.SetContent(*s)
+	}
+	return wuo
+}
+
+// ClearContent clears the value of the "content" field.
+func (wuo *WebmentionUpdateOne) ClearContent() *WebmentionUpdateOne {
+	wuo.mutation.ClearContent()
+	return wuo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (wuo *WebmentionUpdateOne) SetPublishedAt(t time.Time) *WebmentionUpdateOne {
+	wuo.mutation.SetPublishedAt(t)
+	return wuo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillablePublishedAt(t *time.Time) *WebmentionUpdateOne {
+	if t != nil {
+		wuo.SetPublishedAt(*t)
+	}
+	return wuo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (wuo *WebmentionUpdateOne) ClearPublishedAt() *WebmentionUpdateOne {
+	wuo.mutation.ClearPublishedAt()
+	return wuo
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (wuo *WebmentionUpdateOne) SetVerifiedAt(t time.Time) *WebmentionUpdateOne {
+	wuo.mutation.SetVerifiedAt(t)
+	return wuo
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (wuo *WebmentionUpdateOne) SetNillableVerifiedAt(t *time.Time) *WebmentionUpdateOne {
+	if t != nil {
+		wuo.SetVerifiedAt(*t)
+	}
+	return wuo
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (wuo *WebmentionUpdateOne) ClearVerifiedAt() *WebmentionUpdateOne {
+	wuo.mutation.ClearVerifiedAt()
+	return wuo
+}
+
+// Mutation returns the WebmentionMutation object of the builder.
+func (wuo *WebmentionUpdateOne) Mutation() *WebmentionMutation {
+	return wuo.mutation
+}
+
+// Where appends a list predicates to the WebmentionUpdate builder.
+func (wuo *WebmentionUpdateOne) Where(ps ...predicate.Webmention) *WebmentionUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WebmentionUpdateOne) Select(field string, fields ...string) *WebmentionUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Webmention entity.
+func (wuo *WebmentionUpdateOne) Save(ctx context.Context) (*Webmention, error) {
+	wuo.defaults()
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WebmentionUpdateOne) SaveX(ctx context.Context) *Webmention {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WebmentionUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebmentionUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WebmentionUpdateOne) defaults() {
+	if _, ok := wuo.mutation.UpdatedAt(); !ok {
+		v := webmention.UpdateDefaultUpdatedAt()
+		wuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WebmentionUpdateOne) check() error {
+	if v, ok := wuo.mutation.Source(); ok {
+		if err := webmention.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "Webmention.source": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.Target(); ok {
+		if err := webmention.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "Webmention.target": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.TargetPath(); ok {
+		if err := webmention.TargetPathValidator(v); err != nil {
+			return &ValidationError{Name: "target_path", err: fmt.Errorf(`ent: validator failed for field "Webmention.target_path": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.GetType(); ok {
+		if err := webmention.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Webmention.type": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.Status(); ok {
+		if err := webmention.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Webmention.status": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.AuthorName(); ok {
+		if err := webmention.AuthorNameValidator(v); err != nil {
+			return &ValidationError{Name: "author_name", err: fmt.Errorf(`ent: validator failed for field "Webmention.author_name": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.AuthorURL(); ok {
+		if err := webmention.AuthorURLValidator(v); err != nil {
+			return &ValidationError{Name: "author_url", err: fmt.Errorf(`ent: validator failed for field "Webmention.author_url": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.AuthorPhoto(); ok {
+		if err := webmention.AuthorPhotoValidator(v); err != nil {
+			return &ValidationError{Name: "author_photo", err: fmt.Errorf(`ent: validator failed for field "Webmention.author_photo": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wuo *WebmentionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebmentionUpdateOne {
+	wuo.modifiers = append(wuo.modifiers, modifiers...)
+	return wuo
+}
+
+func (wuo *WebmentionUpdateOne) sqlSave(ctx context.Context) (_node *Webmention, err error) {
+	if err := wuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webmention.Table, webmention.Columns, sqlgraph.NewFieldSpec(webmention.FieldID, field.TypeUint))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Webmention.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webmention.FieldID)
+		for _, f := range fields {
+			if !webmention.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webmention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webmention.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.Source(); ok {
+		_spec.SetField(webmention.FieldSource, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Target(); ok {
+		_spec.SetField(webmention.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.TargetPath(); ok {
+		_spec.SetField(webmention.FieldTargetPath, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.GetType(); ok {
+		_spec.SetField(webmention.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := wuo.mutation.Status(); ok {
+		_spec.SetField(webmention.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wuo.mutation.AuthorName(); ok {
+		_spec.SetField(webmention.FieldAuthorName, field.TypeString, value)
+	}
+	if wuo.mutation.AuthorNameCleared() {
+		_spec.ClearField(webmention.FieldAuthorName, field.TypeString)
+	}
+	if value, ok := wuo.mutation.AuthorURL(); ok {
+		_spec.SetField(webmention.FieldAuthorURL, field.TypeString, value)
+	}
+	if wuo.mutation.AuthorURLCleared() {
+		_spec.ClearField(webmention.FieldAuthorURL, field.TypeString)
+	}
+	if value, ok := wuo.mutation.AuthorPhoto(); ok {
+		_spec.SetField(webmention.FieldAuthorPhoto, field.TypeString, value)
+	}
+	if wuo.mutation.AuthorPhotoCleared() {
+		_spec.ClearField(webmention.FieldAuthorPhoto, field.TypeString)
+	}
+	if value, ok := wuo.mutation.Content(); ok {
+		_spec.SetField(webmention.FieldContent, field.TypeString, value)
+	}
+	if wuo.mutation.ContentCleared() {
+		_spec.ClearField(webmention.FieldContent, field.TypeString)
+	}
+	if value, ok := wuo.mutation.PublishedAt(); ok {
+		_spec.SetField(webmention.FieldPublishedAt, field.TypeTime, value)
+	}
+	if wuo.mutation.PublishedAtCleared() {
+		_spec.ClearField(webmention.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := wuo.mutation.VerifiedAt(); ok {
+		_spec.SetField(webmention.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if wuo.mutation.VerifiedAtCleared() {
+		_spec.ClearField(webmention.FieldVerifiedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(wuo.modifiers...)
+	_node = &Webmention{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webmention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
@@ -128,11 +128,47 @@ func (b *Bootstrapper) initUserGroups() {
 			if createErr != nil {
 				log.Printf("⚠️ 失败: 创建默认用户组 '%s' (ID: %d) 失败: %v", groupData.Name, groupData.ID, createErr)
 			}
+			continue
 		}
+		b.upgradeUserGroupPermissions(ctx, groupData.ID)
 	}
 	log.Println("--- 默认用户组 (UserGroup 表) 初始化完成。---")
 }
 
+// upgradeUserGroupPermissions 为旧版本创建的内置用户组补充新增的默认权限位。
+// 每个权限版本只会应用一次，管理员之后手动取消的权限不会被重新授予。
+func (b *Bootstrapper) upgradeUserGroupPermissions(ctx context.Context, groupID uint) {
+	group, err := b.entClient.UserGroup.Get(ctx, groupID)
+	if err != nil {
+		log.Printf("⚠️ 失败: 查询用户组 ID: %d 失败: %v", groupID, err)
+		return
+	}
+	settings := model.GroupSettings{}
+	if group.Settings != nil {
+		settings = *group.Settings
+	}
+	if settings.PermissionVersion >= configdef.UserGroupPermissionVersion {
+		return
+	}
+
+	permissions := append(model.Boolset{}, group.Permissions...)
+	for version := settings.PermissionVersion + 1; version <= configdef.UserGroupPermissionVersion; version++ {
+		for _, p := range configdef.UserGroupPermissionUpgrades[version][groupID] {
+			permissions.Set(p, true)
+		}
+	}
+	settings.PermissionVersion = configdef.UserGroupPermissionVersion
+
+	if err := b.entClient.UserGroup.UpdateOneID(groupID).
+		SetPermissions(permissions).
+		SetSettings(&settings).
+		Exec(ctx); err != nil {
+		log.Printf("⚠️ 失败: 升级用户组 '%s' (ID: %d) 的权限失败: %v", group.Name, groupID, err)
+		return
+	}
+	log.Printf("    -已将用户组 '%s' (ID: %d) 的权限升级到版本 %d。", group.Name, groupID, settings.PermissionVersion)
+}
+
 func (b *Bootstrapper) initStoragePolicies() {
 	log.Println("--- 开始初始化默认存储策略 (StoragePolicy 表) ---")
 	ctx := context.Background()
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+
+	"github.com/gin-gonic/gin"
+)
+
+// setClaims 保存认证信息，并把操作者写入请求上下文，供审计日志等下游服务使用
+func setClaims(c *gin.Context, claims *auth.CustomClaims) {
+	c.Set(auth.ClaimsKey, claims)
+
+	// 审计日志的来源IP只信任已配置的代理转发的请求头，不能由客户端伪造
+	actor := audit.Actor{IP: c.ClientIP(), UserAgent: c.Request.UserAgent()}
+	if userID, entityType, err := idgen.DecodePublicID(claims.UserID); err == nil && entityType == idgen.EntityTypeUser {
+		actor.UserID = userID
+	}
+	c.Request = c.Request.WithContext(audit.WithActor(c.Request.Context(), actor))
+}
+
+// Audit 是一个审计日志中间件，需要放在 JWTAuth 之后。
+// 请求成功 (状态码小于 400) 后记录一条日志，对象ID默认取路由参数 id；
+// 处理函数可以通过 audit.SetChange/SetTarget/Skip 补充或取消记录。
+func (m *Middleware) Audit(action, targetType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if m.auditSvc == nil {
+			c.Next()
+			return
+		}
+		c.Request = c.Request.WithContext(audit.Begin(c.Request.Context()))
+		c.Next()
+
+		if c.IsAborted() || c.Writer.Status() >= http.StatusBadRequest {
+			return
+		}
+		m.auditSvc.Record(c.Request.Context(), audit.Entry{
+			Action:     action,
+			TargetType: targetType,
+			TargetID:   c.Param("id"),
+		})
+	}
+}
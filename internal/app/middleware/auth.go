@@ -4,11 +4,13 @@ package middleware
 import (
 	"log"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	service_auth "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
 
 	"github.com/gin-gonic/gin"
@@ -23,15 +25,19 @@ func min(a, b int) int {
 }
 
 type Middleware struct {
-	tokenSvc service_auth.TokenService
+	tokenSvc       service_auth.TokenService
+	accessTokenSvc service_auth.AccessTokenService
+	auditSvc       audit.Service
 }
 
-func NewMiddleware(tokenSvc service_auth.TokenService) *Middleware {
-	return &Middleware{tokenSvc: tokenSvc}
+func NewMiddleware(tokenSvc service_auth.TokenService, accessTokenSvc service_auth.AccessTokenService, auditSvc audit.Service) *Middleware {
+	return &Middleware{tokenSvc: tokenSvc, accessTokenSvc: accessTokenSvc, auditSvc: auditSvc}
 }
 
 // JWTAuth 是一个强制性的JWT认证中间件
-func (m *Middleware) JWTAuth() gin.HandlerFunc {
+// 同时接受个人访问令牌：scopes 列出该路由组接受的授权范围，令牌至少需要拥有其中一个；
+// 不传 scopes 的路由组只接受会话令牌。
+func (m *Middleware) JWTAuth(scopes ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.Request.Header.Get("Authorization")
 		if authHeader == "" {
@@ -48,6 +54,11 @@ func (m *Middleware) JWTAuth() gin.HandlerFunc {
 		}
 
 		tokenString := parts[1]
+		if service_auth.IsAccessToken(tokenString) {
+			m.accessTokenAuth(c, tokenString, scopes)
+			return
+		}
+
 		log.Printf("[JWTAuth] 开始解析JWT token: %s", tokenString[:min(20, len(tokenString))]+"...")
 		claims, err := m.tokenSvc.ParseAccessToken(c.Request.Context(), tokenString)
 		if err != nil {
@@ -58,11 +69,52 @@ func (m *Middleware) JWTAuth() gin.HandlerFunc {
 		}
 
 		log.Printf("[JWTAuth] JWT token解析成功，设置ClaimsKey: %s", auth.ClaimsKey)
-		c.Set(auth.ClaimsKey, claims)
+		setClaims(c, claims)
 		c.Next()
 	}
 }
 
+// accessTokenAuth 校验个人访问令牌及其授权范围，通过后写入与会话令牌相同结构的 Claims
+func (m *Middleware) accessTokenAuth(c *gin.Context, tokenString string, scopes []string) {
+	if len(scopes) == 0 {
+		response.Fail(c, http.StatusForbidden, "该接口不支持使用访问令牌")
+		c.Abort()
+		return
+	}
+
+	claims, err := m.accessTokenSvc.Authenticate(c.Request.Context(), tokenString, c.ClientIP())
+	if err != nil {
+		log.Printf("[JWTAuth] 访问令牌校验失败: %v", err)
+		response.Fail(c, http.StatusUnauthorized, "无效或过期的访问令牌")
+		c.Abort()
+		return
+	}
+	if !scopesAllow(claims.Scopes, scopes, c.Request.Method) {
+		response.Fail(c, http.StatusForbidden, "访问令牌缺少所需的授权范围: "+strings.Join(scopes, " 或 "))
+		c.Abort()
+		return
+	}
+
+	setClaims(c, claims)
+	c.Next()
+}
+
+// scopesAllow 判断令牌拥有的授权范围是否满足路由接受的任意一个范围。
+// :read 范围只适用于安全方法 (GET/HEAD)，拥有 :write 时视为同时拥有对应的 :read。
+func scopesAllow(granted, accepted []string, method string) bool {
+	safe := method == http.MethodGet || method == http.MethodHead
+	for _, scope := range accepted {
+		resource, isRead := strings.CutSuffix(scope, ":read")
+		if isRead && !safe {
+			continue
+		}
+		if slices.Contains(granted, scope) || (isRead && slices.Contains(granted, resource+":write")) {
+			return true
+		}
+	}
+	return false
+}
+
 // JWTAuthOptional 是一个可选的JWT认证中间件
 // 如果没有Token，允许游客访问；如果有Token但过期，返回401触发自动刷新
 func (m *Middleware) JWTAuthOptional() gin.HandlerFunc {
@@ -80,6 +132,11 @@ func (m *Middleware) JWTAuthOptional() gin.HandlerFunc {
 		}
 
 		tokenString := parts[1]
+		if service_auth.IsAccessToken(tokenString) {
+			response.Fail(c, http.StatusForbidden, "该接口不支持使用访问令牌")
+			c.Abort()
+			return
+		}
 		claims, err := m.tokenSvc.ParseAccessToken(c.Request.Context(), tokenString)
 		if err != nil {
 			// Token无效或过期，返回401触发前端自动刷新token
@@ -90,7 +147,7 @@ func (m *Middleware) JWTAuthOptional() gin.HandlerFunc {
 		}
 
 		// Token有效，将用户信息存入context
-		c.Set(auth.ClaimsKey, claims)
+		setClaims(c, claims)
 		c.Next()
 	}
 }
@@ -149,3 +206,30 @@ func (m *Middleware) AdminAuth() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// RequirePermission 是一个用户组权限验证中间件，需要放在 JWTAuth 之后。
+// 用户拥有 perms 中任一权限即可通过；管理员组和拥有管理员权限位的用户组始终通过。
+func (m *Middleware) RequirePermission(perms ...uint) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claimsValue, exists := c.Get(auth.ClaimsKey)
+		if !exists {
+			response.Fail(c, http.StatusForbidden, "权限信息获取失败")
+			c.Abort()
+			return
+		}
+		claims, ok := claimsValue.(*auth.CustomClaims)
+		if !ok {
+			response.Fail(c, http.StatusForbidden, "权限信息格式不正确")
+			c.Abort()
+			return
+		}
+
+		if !claims.HasPermission(perms...) {
+			log.Printf("[RequirePermission] 权限不足: 用户 %s 缺少权限 %v (%s %s)", claims.UserID, perms, c.Request.Method, c.Request.URL.Path)
+			response.Fail(c, http.StatusForbidden, "权限不足：您所在的用户组没有此操作的权限")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
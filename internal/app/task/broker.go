@@ -7,12 +7,14 @@ import (
 	"os"
 	"runtime"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	article_history_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article_history"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/cleanup"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/file"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
@@ -42,8 +44,16 @@ type Broker struct {
 	settingSvc        setting.SettingService
 	statService       statistics.VisitorStatService
 	articleHistorySvc article_history_service.Service
+	auditSvc          audit.Service
+	trashPurger       TrashPurger
+	fileDeduplicator  FileDeduplicator
+	accountDeletion   AccountDeletionProcessor
 	db                *ent.Client
 	redis             *redis.Client
+
+	retryMu     sync.Mutex               // 保护 stopped 和 retryTimers，保证 Stop 之后不会再有重试任务入队
+	retryTimers map[*time.Timer]struct{} // 等待中的延迟重试，Stop 时统一取消
+	stopped     bool                     // Stop 之后置为 true，阻止任务再次入队
 }
 
 // NewBroker 是 Broker 的构造函数。
@@ -61,6 +71,9 @@ func NewBroker(
 	settingSvc setting.SettingService,
 	statService statistics.VisitorStatService,
 	articleHistorySvc article_history_service.Service,
+	auditSvc audit.Service,
+	trashPurger TrashPurger,
+	fileDeduplicator FileDeduplicator,
 	db *ent.Client,
 	redis *redis.Client,
 ) *Broker {
@@ -86,6 +99,7 @@ func NewBroker(
 		thumbnailSvc:      thumbnailSvc,
 		cleanupSvc:        cleanupSvc,
 		jobQueue:          jobQueue,
+		retryTimers:       make(map[*time.Timer]struct{}),
 		articleRepo:       articleRepo,
 		commentRepo:       commentRepo,
 		emailSvc:          emailSvc,
@@ -96,6 +110,9 @@ func NewBroker(
 		settingSvc:        settingSvc,
 		statService:       statService,
 		articleHistorySvc: articleHistorySvc,
+		auditSvc:          auditSvc,
+		trashPurger:       trashPurger,
+		fileDeduplicator:  fileDeduplicator,
 		db:                db,
 		redis:             redis,
 	}
@@ -204,6 +221,50 @@ func (b *Broker) RegisterCronJobs() {
 		b.logger.Info("-> Successfully registered 'ArticleHistoryCleanupJob'", "schedule", "every day at 3:30:00 AM")
 	}
 
+	// 添加审计日志清理任务 - 每天凌晨3:45执行
+	if b.auditSvc != nil {
+		auditLogCleanupJob := NewAuditLogCleanupJob(b.auditSvc)
+		_, err = b.cron.AddJob("0 45 3 * * *", auditLogCleanupJob) // 每天凌晨3:45执行
+		if err != nil {
+			b.logger.Error("Failed to add 'AuditLogCleanupJob'", slog.Any("error", err))
+			os.Exit(1)
+		}
+		b.logger.Info("-> Successfully registered 'AuditLogCleanupJob'", "schedule", "every day at 3:45:00 AM")
+	}
+
+	// 添加回收站过期项目清理任务 - 每天凌晨4点执行
+	if b.trashPurger != nil {
+		trashPurgeJob := NewTrashPurgeJob(b.trashPurger)
+		_, err = b.cron.AddJob("0 0 4 * * *", trashPurgeJob) // 每天凌晨4点执行
+		if err != nil {
+			b.logger.Error("Failed to add 'TrashPurgeJob'", slog.Any("error", err))
+			os.Exit(1)
+		}
+		b.logger.Info("-> Successfully registered 'TrashPurgeJob'", "schedule", "every day at 4:00:00 AM")
+	}
+
+	// 添加文件内容去重任务 - 每天凌晨4点30分执行
+	if b.fileDeduplicator != nil {
+		fileDedupJob := NewFileDedupJob(b.fileDeduplicator)
+		_, err = b.cron.AddJob("0 30 4 * * *", fileDedupJob) // 每天凌晨4点30分执行
+		if err != nil {
+			b.logger.Error("Failed to add 'FileDedupJob'", slog.Any("error", err))
+			os.Exit(1)
+		}
+		b.logger.Info("-> Successfully registered 'FileDedupJob'", "schedule", "every day at 4:30:00 AM")
+	}
+
+	// 添加到期账户注销任务 - 每小时第15分钟执行
+	if b.accountDeletion != nil {
+		accountDeletionJob := NewAccountDeletionJob(b.accountDeletion)
+		_, err = b.cron.AddJob("0 15 * * * *", accountDeletionJob) // 每小时第15分钟执行
+		if err != nil {
+			b.logger.Error("Failed to add 'AccountDeletionJob'", slog.Any("error", err))
+			os.Exit(1)
+		}
+		b.logger.Info("-> Successfully registered 'AccountDeletionJob'", "schedule", "every hour at minute 15")
+	}
+
 	// 添加朋友圈爬取任务 - 每6小时执行一次
 	fcircleCrawlJob := NewFCircleCrawlJob(b.logger, b.db, b.linkRepo, b.redis)
 	_, err = b.cron.AddJob("0 0 */6 * * *", fcircleCrawlJob) // 每6小时执行一次
@@ -216,6 +277,12 @@ func (b *Broker) RegisterCronJobs() {
 	b.logger.Info("All periodic jobs registered.")
 }
 
+// SetAccountDeletionProcessor 注入执行到期账户注销的服务。
+// 该服务依赖的文章和评论服务需要先创建 Broker，因此无法通过构造函数传入，需要在 RegisterCronJobs 之前调用。
+func (b *Broker) SetAccountDeletionProcessor(p AccountDeletionProcessor) {
+	b.accountDeletion = p
+}
+
 // Dispatch 将任务发送到队列中。
 func (b *Broker) Dispatch(job Job) {
 	b.jobQueue <- job
@@ -267,6 +334,7 @@ func (b *Broker) Stop() {
 	b.logger.Info("Stopping task broker...")
 	ctx := b.cron.Stop()
 	<-ctx.Done()
+	b.stopRetries()
 	close(b.jobQueue)
 	b.logger.Info("Task broker gracefully stopped.")
 }
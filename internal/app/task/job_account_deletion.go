@@ -0,0 +1,39 @@
+package task
+
+import (
+	"context"
+	"log"
+)
+
+// AccountDeletionProcessor 是执行到期账户注销的服务，由 account.Service 实现。
+// 在这里单独定义接口，避免 task 包依赖 account 包而产生循环引用。
+type AccountDeletionProcessor interface {
+	ProcessDueDeletions(ctx context.Context) (int, error)
+}
+
+// AccountDeletionJob 负责执行宽限期已满的账户注销申请
+type AccountDeletionJob struct {
+	processor AccountDeletionProcessor
+}
+
+// NewAccountDeletionJob 是任务的构造函数
+func NewAccountDeletionJob(processor AccountDeletionProcessor) *AccountDeletionJob {
+	return &AccountDeletionJob{
+		processor: processor,
+	}
+}
+
+// Run 是 Job 接口要求实现的方法
+func (j *AccountDeletionJob) Run() {
+	deleted, err := j.processor.ProcessDueDeletions(context.Background())
+	if err != nil {
+		log.Printf("任务 '%s' 在执行业务逻辑时捕获到错误: %v", j.Name(), err)
+	} else if deleted > 0 {
+		log.Printf("任务 '%s' 业务逻辑执行完毕，共注销了 %d 个账户。", j.Name(), deleted)
+	}
+}
+
+// Name 方法让日志包装器可以打印出更有意义的任务名
+func (j *AccountDeletionJob) Name() string {
+	return "AccountDeletionJob"
+}
@@ -0,0 +1,35 @@
+package task
+
+import (
+	"context"
+	"log"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+)
+
+// AuditLogCleanupJob 负责按保留天数设置定期清理过期的审计日志
+type AuditLogCleanupJob struct {
+	auditSvc audit.Service
+}
+
+// NewAuditLogCleanupJob 是任务的构造函数
+func NewAuditLogCleanupJob(auditSvc audit.Service) *AuditLogCleanupJob {
+	return &AuditLogCleanupJob{
+		auditSvc: auditSvc,
+	}
+}
+
+// Run 是 Job 接口要求实现的方法
+func (j *AuditLogCleanupJob) Run() {
+	deleted, err := j.auditSvc.Cleanup(context.Background())
+	if err != nil {
+		log.Printf("任务 '%s' 在执行业务逻辑时捕获到错误: %v", j.Name(), err)
+	} else {
+		log.Printf("任务 '%s' 业务逻辑执行完毕，共清理了 %d 条过期的审计日志。", j.Name(), deleted)
+	}
+}
+
+// Name 方法让日志包装器可以打印出更有意义的任务名
+func (j *AuditLogCleanupJob) Name() string {
+	return "AuditLogCleanupJob"
+}
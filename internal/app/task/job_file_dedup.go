@@ -0,0 +1,40 @@
+package task
+
+import (
+	"context"
+	"log"
+)
+
+// FileDeduplicator 是合并内容相同的物理实体的服务，由 file.FileService 实现。
+// 在这里单独定义接口，使任务只依赖它需要的方法。
+type FileDeduplicator interface {
+	DeduplicateExistingFiles(ctx context.Context) (int, error)
+}
+
+// FileDedupJob 负责为已有文件补充内容哈希，并合并内容相同的物理实体
+type FileDedupJob struct {
+	deduplicator FileDeduplicator
+}
+
+// NewFileDedupJob 是任务的构造函数
+func NewFileDedupJob(deduplicator FileDeduplicator) *FileDedupJob {
+	return &FileDedupJob{
+		deduplicator: deduplicator,
+	}
+}
+
+// Run 是 Job 接口要求实现的方法
+func (j *FileDedupJob) Run() {
+	merged, err := j.deduplicator.DeduplicateExistingFiles(context.Background())
+	if err != nil {
+		log.Printf("任务 '%s' 在执行业务逻辑时捕获到错误: %v", j.Name(), err)
+	}
+	if merged > 0 {
+		log.Printf("任务 '%s' 业务逻辑执行完毕，共合并了 %d 个重复的物理实体。", j.Name(), merged)
+	}
+}
+
+// Name 方法让日志包装器可以打印出更有意义的任务名
+func (j *FileDedupJob) Name() string {
+	return "FileDedupJob"
+}
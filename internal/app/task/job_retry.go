@@ -0,0 +1,141 @@
+// internal/app/task/job_retry.go
+package task
+
+import (
+	"log/slog"
+	"time"
+)
+
+const (
+	// retryBaseDelay 第一次重试前的等待时间，之后每次重试翻倍
+	retryBaseDelay = 30 * time.Second
+	// retryMaxDelay 两次重试之间的最长等待时间
+	retryMaxDelay = 30 * time.Minute
+	// retryQueueFullDelay 任务队列已满时再次尝试入队前的等待时间
+	retryQueueFullDelay = 5 * time.Second
+)
+
+// RetryJob 包装一个可能失败的函数，失败后按指数退避重新放回任务队列，直到成功或达到最大尝试次数。
+type RetryJob struct {
+	broker      *Broker
+	name        string
+	fn          func() error
+	attempt     int
+	maxAttempts int
+}
+
+// NewRetryJob 是任务的构造函数
+func NewRetryJob(broker *Broker, name string, maxAttempts int, fn func() error) *RetryJob {
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	return &RetryJob{
+		broker:      broker,
+		name:        name,
+		fn:          fn,
+		attempt:     1,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Run 执行任务，失败时安排下一次重试。
+func (j *RetryJob) Run() {
+	err := j.fn()
+	if err == nil {
+		return
+	}
+
+	if j.attempt >= j.maxAttempts {
+		j.broker.logger.Error("Job failed after all retries",
+			"job_name", j.name, "attempts", j.attempt, slog.Any("error", err))
+		return
+	}
+
+	delay := retryDelay(j.attempt)
+	j.broker.logger.Warn("Job failed, scheduling retry",
+		"job_name", j.name, "attempt", j.attempt, "max_attempts", j.maxAttempts,
+		"retry_in", delay.String(), slog.Any("error", err))
+
+	next := *j
+	next.attempt++
+	j.broker.scheduleRetry(delay, &next)
+}
+
+// Name 返回任务名称
+func (j *RetryJob) Name() string {
+	return j.name
+}
+
+// retryDelay 计算第 attempt 次失败后的等待时间
+func retryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
+
+// DispatchWithRetry 派发一个失败后会自动重试的任务，fn 返回 error 即视为失败。
+func (b *Broker) DispatchWithRetry(name string, maxAttempts int, fn func() error) {
+	b.retryMu.Lock()
+	defer b.retryMu.Unlock()
+	if b.stopped {
+		b.logger.Warn("Task broker stopped, job not queued", "job_name", name)
+		return
+	}
+	b.enqueueLocked(NewRetryJob(b, name, maxAttempts, fn))
+}
+
+// scheduleRetry 在 delay 之后重新派发任务。定时器由 broker 记录，Stop 时会被取消
+func (b *Broker) scheduleRetry(delay time.Duration, job *RetryJob) {
+	b.retryMu.Lock()
+	defer b.retryMu.Unlock()
+	if b.stopped {
+		b.logger.Warn("Task broker stopped, dropping retry", "job_name", job.name)
+		return
+	}
+	b.startRetryTimerLocked(delay, job)
+}
+
+// startRetryTimerLocked 启动在 delay 之后派发任务的定时器，调用方须持有 retryMu
+func (b *Broker) startRetryTimerLocked(delay time.Duration, job *RetryJob) {
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		// 与 stopRetries 持有同一把锁，检查 stopped 和入队之间任务队列不会被关闭
+		b.retryMu.Lock()
+		defer b.retryMu.Unlock()
+		delete(b.retryTimers, timer)
+		if b.stopped {
+			b.logger.Warn("Task broker stopped, dropping retry", "job_name", job.name)
+			return
+		}
+		b.enqueueLocked(job)
+	})
+	b.retryTimers[timer] = struct{}{}
+}
+
+// enqueueLocked 不阻塞地把任务放入队列，队列已满时稍后再试，调用方须持有 retryMu。
+// 持有锁时不能阻塞在队列上：worker 中失败的任务会在 scheduleRetry 中等待同一把锁，队列将无人消费
+func (b *Broker) enqueueLocked(job *RetryJob) {
+	select {
+	case b.jobQueue <- job:
+	default:
+		b.logger.Warn("Task queue full, delaying job",
+			"job_name", job.name, "retry_in", retryQueueFullDelay.String())
+		b.startRetryTimerLocked(retryQueueFullDelay, job)
+	}
+}
+
+// stopRetries 标记 broker 已停止并取消所有等待中的重试，须在关闭任务队列之前调用
+func (b *Broker) stopRetries() {
+	b.retryMu.Lock()
+	defer b.retryMu.Unlock()
+	b.stopped = true
+	for timer := range b.retryTimers {
+		timer.Stop()
+	}
+	b.retryTimers = make(map[*time.Timer]struct{})
+}
@@ -0,0 +1,40 @@
+package task
+
+import (
+	"context"
+	"log"
+)
+
+// TrashPurger 是永久删除过期回收站项目的服务，由 file.FileService 实现。
+// 在这里单独定义接口，使任务只依赖它需要的方法。
+type TrashPurger interface {
+	PurgeExpiredTrash(ctx context.Context) (int, error)
+}
+
+// TrashPurgeJob 负责永久删除在回收站中超过保留天数的项目
+type TrashPurgeJob struct {
+	purger TrashPurger
+}
+
+// NewTrashPurgeJob 是任务的构造函数
+func NewTrashPurgeJob(purger TrashPurger) *TrashPurgeJob {
+	return &TrashPurgeJob{
+		purger: purger,
+	}
+}
+
+// Run 是 Job 接口要求实现的方法
+func (j *TrashPurgeJob) Run() {
+	purged, err := j.purger.PurgeExpiredTrash(context.Background())
+	if err != nil {
+		log.Printf("任务 '%s' 在执行业务逻辑时捕获到错误: %v", j.Name(), err)
+	}
+	if purged > 0 {
+		log.Printf("任务 '%s' 业务逻辑执行完毕，共永久删除了 %d 个回收站项目。", j.Name(), purged)
+	}
+}
+
+// Name 方法让日志包装器可以打印出更有意义的任务名
+func (j *TrashPurgeJob) Name() string {
+	return "TrashPurgeJob"
+}
@@ -237,6 +237,10 @@ var AllSettings = []Definition{
 	{Key: constant.KeyCommentQQAPIKey, Value: "", Comment: "QQ信息查询API密钥", IsPublic: false},
 	{Key: constant.KeyCommentNotifyAdmin, Value: "false", Comment: "是否在收到评论时邮件通知博主", IsPublic: false},
 	{Key: constant.KeyCommentNotifyReply, Value: "true", Comment: "是否开启评论回复邮件通知功能", IsPublic: false},
+	{Key: constant.KeyCommentAuthorEditWindow, Value: "10", Comment: "评论者发布评论后可自助编辑或删除的时间窗口（分钟），0 表示关闭", IsPublic: true},
+	{Key: constant.KeyCommentAutoCloseDays, Value: "0", Comment: "发布超过指定天数的文章、页面的评论区自动变为只读（保留已有评论，不再接受新评论），0 表示不自动关闭", IsPublic: false},
+	{Key: constant.KeyWebmentionReceiveEnable, Value: "true", Comment: "是否接收其它站点发来的 Webmention (需在页面中声明 rel=\"webmention\" 端点)", IsPublic: true},
+	{Key: constant.KeyWebmentionSendEnable, Value: "false", Comment: "文章发布时是否向文中外链所在站点发送 Webmention", IsPublic: false},
 	{Key: constant.KeyPushooChannel, Value: "", Comment: "即时消息推送平台名称，支持：bark, webhook", IsPublic: false},
 	{Key: constant.KeyPushooURL, Value: "", Comment: "即时消息推送URL地址 (支持模板变量)", IsPublic: false},
 	{Key: constant.KeyWebhookRequestBody, Value: `{"title":"#{TITLE}","content":"#{BODY}","site_name":"#{SITE_NAME}","comment_author":"#{NICK}","comment_content":"#{COMMENT}","parent_author":"#{PARENT_NICK}","parent_content":"#{PARENT_COMMENT}","post_url":"#{POST_URL}","author_email":"#{MAIL}","author_ip":"#{IP}","time":"#{TIME}"}`, Comment: "Webhook自定义请求体模板，支持变量替换：#{TITLE}, #{BODY}, #{SITE_NAME}, #{NICK}, #{COMMENT}, #{PARENT_NICK}, #{PARENT_COMMENT}, #{POST_URL}, #{MAIL}, #{IP}, #{TIME}", IsPublic: false},
@@ -407,7 +411,12 @@ var AllSettings = []Definition{
 	{Key: constant.KeyActivateAccountSubject, Value: "【{{.AppName}}】激活您的账户", Comment: "用户激活邮件主题模板", IsPublic: false},
 	{Key: constant.KeyActivateAccountTemplate, Value: `<!DOCTYPE html><html><head><title>激活您的账户</title></head><body><p>您好, {{.Nickname}}！</p><p>欢迎注册 <strong>{{.AppName}}</strong>！</p><p>请点击以下链接以激活您的账户（此链接24小时内有效）：</p><p><a href="{{.ActivateLink}}">激活我的账户</a></p><p>如果链接无法点击，请将其复制到浏览器地址栏中打开。</p><p>如果您并未注册，请忽略此邮件。</p><br/><p>感谢, <br/>{{.AppName}} 团队</p></body></html>`, Comment: "用户激活邮件HTML模板", IsPublic: false},
 	{Key: constant.KeyEnableUserActivation, Value: "false", Comment: "是否开启新用户邮箱激活功能 (true/false)", IsPublic: false},
-	{Key: constant.KeyEnableRegistration, Value: "true", Comment: "是否开启用户注册功能 (true/false)", IsPublic: true},
+	{Key: constant.KeyEnableRegistration, Value: "true", Comment: "是否开启用户注册功能 (true/false)，关闭后仍可以凭邀请码注册", IsPublic: true},
+	{Key: constant.KeyInviteSkipActivation, Value: "false", Comment: "凭邀请码注册的用户是否跳过邮箱激活 (true/false)，仅在开启邮箱激活时生效", IsPublic: false},
+	{Key: constant.KeyAccountDeleteGraceDays, Value: "7", Comment: "用户申请注销账户后的宽限天数，宽限期内可以撤销申请，期满后自动执行注销", IsPublic: true},
+	{Key: constant.KeyOAuthProviders, Value: "[]", Comment: `第三方登录提供方配置 (JSON数组)，每项包含 id、name、type (github/google/gitee/qq/oidc/oauth2)、client_id、client_secret，oidc 类型需填写 issuer，oauth2 类型需填写 auth_url、token_url、userinfo_url，可选 scopes、icon、enabled`, IsPublic: false},
+	{Key: constant.KeyAuditLogRetentionDays, Value: "180", Comment: "审计日志保留天数，超过的日志会在每日凌晨自动清理，0 表示永久保留", IsPublic: false},
+	{Key: constant.KeyTrashRetentionDays, Value: "30", Comment: "回收站项目保留天数，超过的项目会在每日凌晨自动永久删除，0 表示不自动清理", IsPublic: true},
 	{Key: constant.KeySmtpHost, Value: "smtp.qq.com", Comment: "SMTP 服务器地址", IsPublic: false},
 	{Key: constant.KeySmtpPort, Value: "587", Comment: "SMTP 服务器端口 (587 for STARTTLS, 465 for SSL)", IsPublic: false},
 	{Key: constant.KeySmtpUsername, Value: "user@example.com", Comment: "SMTP 登录用户名", IsPublic: false},
@@ -511,25 +520,45 @@ var AllSettings = []Definition{
 	{Key: constant.KeyImageCaptchaExpire, Value: "300", Comment: "图形验证码过期时间（秒，默认300秒/5分钟）", IsPublic: true},
 }
 
+// UserGroupPermissionVersion 是当前内置用户组权限位的版本。
+// 新增权限位且需要授予已有的内置用户组时，递增该版本并在 UserGroupPermissionUpgrades 中登记。
+const UserGroupPermissionVersion = 2
+
+// UserGroupPermissionUpgrades 记录每个权限版本需要为已有内置用户组补充的权限位，结构为 版本 -> 用户组ID -> 权限位
+var UserGroupPermissionUpgrades = map[int]map[uint][]uint{
+	1: {
+		1: {model.PermissionArticleOwn, model.PermissionArticleAny, model.PermissionCommentModerate, model.PermissionLinkManage, model.PermissionAlbumManage, model.PermissionSettingManage, model.PermissionThemeManage, model.PermissionStatisticsView},
+		2: {model.PermissionArticleOwn}, // 保持多人共创功能中普通用户撰写文章的能力
+	},
+	2: {
+		1: {model.PermissionWebDAV},
+		2: {model.PermissionWebDAV},
+		4: {model.PermissionWebDAV},
+		5: {model.PermissionWebDAV},
+	},
+}
+
 // AllUserGroups 是所有默认用户组的"单一事实来源"
 var AllUserGroups = []UserGroupDefinition{
 	{
 		ID:          1,
 		Name:        "管理员",
 		Description: "拥有所有权限的系统管理员",
-		Permissions: model.NewBoolset(model.PermissionAdmin, model.PermissionCreateShare, model.PermissionAccessShare, model.PermissionUploadFile, model.PermissionDeleteFile),
-		MaxStorage:  0, // 0 代表无限容量
-		SpeedLimit:  0,
-		Settings:    model.GroupSettings{SourceBatch: 100, PolicyOrdering: []uint{1}, RedirectedSource: true},
+		Permissions: model.NewBoolset(model.PermissionAdmin, model.PermissionCreateShare, model.PermissionAccessShare, model.PermissionUploadFile, model.PermissionDeleteFile,
+			model.PermissionArticleOwn, model.PermissionArticleAny, model.PermissionCommentModerate, model.PermissionLinkManage, model.PermissionAlbumManage,
+			model.PermissionSettingManage, model.PermissionThemeManage, model.PermissionStatisticsView, model.PermissionWebDAV),
+		MaxStorage: 0, // 0 代表无限容量
+		SpeedLimit: 0,
+		Settings:   model.GroupSettings{SourceBatch: 100, PolicyOrdering: []uint{1}, RedirectedSource: true, PermissionVersion: UserGroupPermissionVersion},
 	},
 	{
 		ID:          2,
 		Name:        "普通用户",
 		Description: "标准用户组，拥有基本上传和分享权限",
-		Permissions: model.NewBoolset(model.PermissionCreateShare, model.PermissionAccessShare, model.PermissionUploadFile),
+		Permissions: model.NewBoolset(model.PermissionCreateShare, model.PermissionAccessShare, model.PermissionUploadFile, model.PermissionArticleOwn, model.PermissionWebDAV),
 		MaxStorage:  5 * 1024 * 1024 * 1024, // 默认 5 GB
 		SpeedLimit:  0,
-		Settings:    model.GroupSettings{SourceBatch: 10, PolicyOrdering: []uint{1}, RedirectedSource: true},
+		Settings:    model.GroupSettings{SourceBatch: 10, PolicyOrdering: []uint{1}, RedirectedSource: true, PermissionVersion: UserGroupPermissionVersion},
 	},
 	{
 		ID:          3,
@@ -538,6 +567,43 @@ var AllUserGroups = []UserGroupDefinition{
 		Permissions: model.NewBoolset(model.PermissionAccessShare),
 		MaxStorage:  0,
 		SpeedLimit:  0,
-		Settings:    model.GroupSettings{SourceBatch: 0, PolicyOrdering: []uint{}, RedirectedSource: false},
+		Settings:    model.GroupSettings{SourceBatch: 0, PolicyOrdering: []uint{}, RedirectedSource: false, PermissionVersion: UserGroupPermissionVersion},
+	},
+	{
+		ID:          4,
+		Name:        "编辑",
+		Description: "管理全部文章、评论、友链和相册，可查看访问统计",
+		Permissions: model.NewBoolset(model.PermissionCreateShare, model.PermissionAccessShare, model.PermissionUploadFile, model.PermissionDeleteFile,
+			model.PermissionArticleOwn, model.PermissionArticleAny, model.PermissionCommentModerate, model.PermissionLinkManage, model.PermissionAlbumManage, model.PermissionStatisticsView, model.PermissionWebDAV),
+		MaxStorage: 10 * 1024 * 1024 * 1024, // 默认 10 GB
+		SpeedLimit: 0,
+		Settings:   model.GroupSettings{SourceBatch: 10, PolicyOrdering: []uint{1}, RedirectedSource: true, PermissionVersion: UserGroupPermissionVersion},
+	},
+	{
+		ID:          5,
+		Name:        "作者",
+		Description: "撰写和管理自己的文章，可查看访问统计",
+		Permissions: model.NewBoolset(model.PermissionCreateShare, model.PermissionAccessShare, model.PermissionUploadFile, model.PermissionArticleOwn, model.PermissionStatisticsView, model.PermissionWebDAV),
+		MaxStorage:  5 * 1024 * 1024 * 1024, // 默认 5 GB
+		SpeedLimit:  0,
+		Settings:    model.GroupSettings{SourceBatch: 10, PolicyOrdering: []uint{1}, RedirectedSource: true, PermissionVersion: UserGroupPermissionVersion},
+	},
+	{
+		ID:          6,
+		Name:        "审核员",
+		Description: "审核评论和友链",
+		Permissions: model.NewBoolset(model.PermissionAccessShare, model.PermissionCommentModerate, model.PermissionLinkManage),
+		MaxStorage:  0,
+		SpeedLimit:  0,
+		Settings:    model.GroupSettings{SourceBatch: 0, PolicyOrdering: []uint{1}, RedirectedSource: false, PermissionVersion: UserGroupPermissionVersion},
+	},
+	{
+		ID:          7,
+		Name:        "访客",
+		Description: "只读账户，可查看访问统计，不能修改任何内容",
+		Permissions: model.NewBoolset(model.PermissionAccessShare, model.PermissionStatisticsView),
+		MaxStorage:  0,
+		SpeedLimit:  0,
+		Settings:    model.GroupSettings{SourceBatch: 0, PolicyOrdering: []uint{1}, RedirectedSource: false, PermissionVersion: UserGroupPermissionVersion},
 	},
 }
@@ -0,0 +1,119 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/accesstoken"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type accessTokenRepo struct {
+	client *ent.Client
+}
+
+// NewAccessTokenRepo 创建个人访问令牌仓储
+func NewAccessTokenRepo(client *ent.Client) repository.AccessTokenRepository {
+	return &accessTokenRepo{client: client}
+}
+
+func toDomainAccessToken(t *ent.AccessToken) *model.AccessToken {
+	if t == nil {
+		return nil
+	}
+	return &model.AccessToken{
+		ID:          t.ID,
+		UserID:      t.UserID,
+		Name:        t.Name,
+		TokenHash:   t.TokenHash,
+		TokenPrefix: t.TokenPrefix,
+		Scopes:      t.Scopes,
+		ExpiresAt:   t.ExpiresAt,
+		LastUsedAt:  t.LastUsedAt,
+		LastUsedIP:  t.LastUsedIP,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+func (r *accessTokenRepo) Create(ctx context.Context, t *model.AccessToken) error {
+	created, err := r.client.AccessToken.Create().
+		SetUserID(t.UserID).
+		SetName(t.Name).
+		SetTokenHash(t.TokenHash).
+		SetTokenPrefix(t.TokenPrefix).
+		SetScopes(t.Scopes).
+		SetNillableExpiresAt(t.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	t.ID = created.ID
+	t.CreatedAt = created.CreatedAt
+	t.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *accessTokenRepo) FindByHash(ctx context.Context, tokenHash string) (*model.AccessToken, error) {
+	t, err := r.client.AccessToken.Query().
+		Where(accesstoken.TokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainAccessToken(t), nil
+}
+
+func (r *accessTokenRepo) FindByID(ctx context.Context, id uint) (*model.AccessToken, error) {
+	t, err := r.client.AccessToken.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainAccessToken(t), nil
+}
+
+func (r *accessTokenRepo) ListByUserID(ctx context.Context, userID uint) ([]*model.AccessToken, error) {
+	items, err := r.client.AccessToken.Query().
+		Where(accesstoken.UserIDEQ(userID)).
+		Order(ent.Desc(accesstoken.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.AccessToken, 0, len(items))
+	for _, t := range items {
+		result = append(result, toDomainAccessToken(t))
+	}
+	return result, nil
+}
+
+func (r *accessTokenRepo) CountByUserID(ctx context.Context, userID uint) (int, error) {
+	return r.client.AccessToken.Query().
+		Where(accesstoken.UserIDEQ(userID)).
+		Count(ctx)
+}
+
+func (r *accessTokenRepo) UpdateUsage(ctx context.Context, id uint, ip string) error {
+	return r.client.AccessToken.UpdateOneID(id).
+		SetLastUsedAt(time.Now()).
+		SetLastUsedIP(ip).
+		Exec(ctx)
+}
+
+func (r *accessTokenRepo) Delete(ctx context.Context, id uint) error {
+	return r.client.AccessToken.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *accessTokenRepo) DeleteByUserID(ctx context.Context, userID uint) (int, error) {
+	return r.client.AccessToken.Delete().
+		Where(accesstoken.UserIDEQ(userID)).
+		Exec(ctx)
+}
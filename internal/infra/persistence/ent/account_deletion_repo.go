@@ -0,0 +1,82 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/accountdeletion"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type accountDeletionRepo struct {
+	client *ent.Client
+}
+
+// NewAccountDeletionRepo 创建账户注销申请仓储
+func NewAccountDeletionRepo(client *ent.Client) repository.AccountDeletionRepository {
+	return &accountDeletionRepo{client: client}
+}
+
+func toDomainAccountDeletion(d *ent.AccountDeletion) *model.AccountDeletion {
+	if d == nil {
+		return nil
+	}
+	return &model.AccountDeletion{
+		ID:          d.ID,
+		UserID:      d.UserID,
+		ContentMode: string(d.ContentMode),
+		ScheduledAt: d.ScheduledAt,
+		CreatedAt:   d.CreatedAt,
+	}
+}
+
+func (r *accountDeletionRepo) Create(ctx context.Context, d *model.AccountDeletion) error {
+	created, err := r.client.AccountDeletion.Create().
+		SetUserID(d.UserID).
+		SetContentMode(accountdeletion.ContentMode(d.ContentMode)).
+		SetScheduledAt(d.ScheduledAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	d.ID = created.ID
+	d.CreatedAt = created.CreatedAt
+	return nil
+}
+
+func (r *accountDeletionRepo) FindByUserID(ctx context.Context, userID uint) (*model.AccountDeletion, error) {
+	d, err := r.client.AccountDeletion.Query().
+		Where(accountdeletion.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainAccountDeletion(d), nil
+}
+
+func (r *accountDeletionRepo) ListDue(ctx context.Context, before time.Time) ([]*model.AccountDeletion, error) {
+	items, err := r.client.AccountDeletion.Query().
+		Where(accountdeletion.ScheduledAtLTE(before)).
+		Order(ent.Asc(accountdeletion.FieldScheduledAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.AccountDeletion, 0, len(items))
+	for _, d := range items {
+		result = append(result, toDomainAccountDeletion(d))
+	}
+	return result, nil
+}
+
+func (r *accountDeletionRepo) DeleteByUserID(ctx context.Context, userID uint) error {
+	_, err := r.client.AccountDeletion.Delete().
+		Where(accountdeletion.UserID(userID)).
+		Exec(ctx)
+	return err
+}
@@ -1106,3 +1106,11 @@ func (r *articleRepo) ExistsByTitle(ctx context.Context, title string, excludeDB
 
 	return exists, nil
 }
+
+// TransferOwnership 将一个用户名下的全部文章转给另一个用户
+func (r *articleRepo) TransferOwnership(ctx context.Context, fromOwnerID, toOwnerID uint) (int, error) {
+	return r.db.Article.Update().
+		Where(article.OwnerID(fromOwnerID)).
+		SetOwnerID(toOwnerID).
+		Save(ctx)
+}
@@ -0,0 +1,131 @@
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/auditlog"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type auditLogRepo struct {
+	client *ent.Client
+}
+
+// NewAuditLogRepo 创建审计日志仓储
+func NewAuditLogRepo(client *ent.Client) repository.AuditLogRepository {
+	return &auditLogRepo{client: client}
+}
+
+func toDomainAuditLog(l *ent.AuditLog) *model.AuditLog {
+	if l == nil {
+		return nil
+	}
+	result := &model.AuditLog{
+		ID:         l.ID,
+		ActorID:    l.ActorID,
+		ActorName:  l.ActorName,
+		IP:         l.IP,
+		UserAgent:  l.UserAgent,
+		Action:     l.Action,
+		TargetType: l.TargetType,
+		TargetID:   l.TargetID,
+		Summary:    l.Summary,
+		CreatedAt:  l.CreatedAt,
+	}
+	if len(l.Changes) > 0 {
+		// changes 以通用 JSON 存储，借助一次编解码还原为强类型结构
+		if raw, err := json.Marshal(l.Changes); err == nil {
+			_ = json.Unmarshal(raw, &result.Changes)
+		}
+	}
+	return result
+}
+
+func (r *auditLogRepo) Create(ctx context.Context, l *model.AuditLog) error {
+	create := r.client.AuditLog.Create().
+		SetActorID(l.ActorID).
+		SetActorName(l.ActorName).
+		SetIP(l.IP).
+		SetUserAgent(l.UserAgent).
+		SetAction(l.Action).
+		SetTargetType(l.TargetType).
+		SetTargetID(l.TargetID).
+		SetSummary(l.Summary)
+	if len(l.Changes) > 0 {
+		changes := make(map[string]interface{}, len(l.Changes))
+		for k, v := range l.Changes {
+			changes[k] = map[string]interface{}{"before": v.Before, "after": v.After}
+		}
+		create.SetChanges(changes)
+	}
+	created, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+	l.ID = created.ID
+	l.CreatedAt = created.CreatedAt
+	return nil
+}
+
+func (r *auditLogRepo) List(ctx context.Context, opts *model.ListAuditLogsOptions) ([]*model.AuditLog, int, error) {
+	query := r.client.AuditLog.Query()
+	if opts != nil {
+		if opts.ActorID != nil {
+			query = query.Where(auditlog.ActorIDEQ(*opts.ActorID))
+		}
+		if opts.Action != "" {
+			if strings.HasSuffix(opts.Action, ".") {
+				query = query.Where(auditlog.ActionHasPrefix(opts.Action))
+			} else {
+				query = query.Where(auditlog.ActionEQ(opts.Action))
+			}
+		}
+		if opts.TargetType != "" {
+			query = query.Where(auditlog.TargetTypeEQ(opts.TargetType))
+		}
+		if opts.TargetID != "" {
+			query = query.Where(auditlog.TargetIDContains(opts.TargetID))
+		}
+		if opts.StartTime != nil {
+			query = query.Where(auditlog.CreatedAtGTE(*opts.StartTime))
+		}
+		if opts.EndTime != nil {
+			query = query.Where(auditlog.CreatedAtLTE(*opts.EndTime))
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID))
+	if opts != nil && opts.PageSize > 0 {
+		offset := (opts.Page - 1) * opts.PageSize
+		if offset < 0 {
+			offset = 0
+		}
+		query = query.Offset(offset).Limit(opts.PageSize)
+	}
+
+	items, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	result := make([]*model.AuditLog, 0, len(items))
+	for _, l := range items {
+		result = append(result, toDomainAuditLog(l))
+	}
+	return result, total, nil
+}
+
+func (r *auditLogRepo) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.client.AuditLog.Delete().
+		Where(auditlog.CreatedAtLT(before)).
+		Exec(ctx)
+}
@@ -0,0 +1,129 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/blockrule"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type blockRuleRepo struct {
+	client *ent.Client
+}
+
+// NewBlockRuleRepo 创建黑名单规则仓储
+func NewBlockRuleRepo(client *ent.Client) repository.BlockRuleRepository {
+	return &blockRuleRepo{client: client}
+}
+
+func toDomainBlockRule(r *ent.BlockRule) *model.BlockRule {
+	if r == nil {
+		return nil
+	}
+	return &model.BlockRule{
+		ID:        r.ID,
+		Type:      model.BlockRuleType(r.Type),
+		Value:     r.Value,
+		Reason:    r.Reason,
+		ExpiresAt: r.ExpiresAt,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func (r *blockRuleRepo) FindByID(ctx context.Context, id uint) (*model.BlockRule, error) {
+	rule, err := r.client.BlockRule.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainBlockRule(rule), nil
+}
+
+func (r *blockRuleRepo) Create(ctx context.Context, entity *model.BlockRule) error {
+	created, err := r.client.BlockRule.Create().
+		SetType(blockrule.Type(entity.Type)).
+		SetValue(entity.Value).
+		SetReason(entity.Reason).
+		SetNillableExpiresAt(entity.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	entity.ID = created.ID
+	entity.CreatedAt = created.CreatedAt
+	entity.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *blockRuleRepo) Update(ctx context.Context, entity *model.BlockRule) error {
+	updater := r.client.BlockRule.UpdateOneID(entity.ID).
+		SetType(blockrule.Type(entity.Type)).
+		SetValue(entity.Value).
+		SetReason(entity.Reason)
+	if entity.ExpiresAt != nil {
+		updater.SetExpiresAt(*entity.ExpiresAt)
+	} else {
+		updater.ClearExpiresAt()
+	}
+	updated, err := updater.Save(ctx)
+	if err != nil {
+		return err
+	}
+	entity.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *blockRuleRepo) Delete(ctx context.Context, id uint) error {
+	return r.client.BlockRule.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *blockRuleRepo) List(ctx context.Context, query *model.BlockRuleListQuery) (*repository.PageResult[model.BlockRule], error) {
+	q := r.client.BlockRule.Query()
+	if query.Type != "" {
+		q = q.Where(blockrule.TypeEQ(blockrule.Type(query.Type)))
+	}
+	if query.Value != "" {
+		q = q.Where(blockrule.ValueContains(query.Value))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := q.
+		Order(ent.Desc(blockrule.FieldCreatedAt)).
+		Offset((query.Page - 1) * query.PageSize).
+		Limit(query.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.BlockRule, len(rules))
+	for i, rule := range rules {
+		items[i] = toDomainBlockRule(rule)
+	}
+	return &repository.PageResult[model.BlockRule]{Items: items, Total: int64(total)}, nil
+}
+
+func (r *blockRuleRepo) FindActive(ctx context.Context, now time.Time) ([]*model.BlockRule, error) {
+	rules, err := r.client.BlockRule.Query().
+		Where(blockrule.Or(
+			blockrule.ExpiresAtIsNil(),
+			blockrule.ExpiresAtGT(now),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.BlockRule, len(rules))
+	for i, rule := range rules {
+		result[i] = toDomainBlockRule(rule)
+	}
+	return result, nil
+}
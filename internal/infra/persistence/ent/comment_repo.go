@@ -9,6 +9,7 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	entcomment "github.com/anzhiyu-c/anheyu-app/ent/comment"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 
@@ -63,6 +64,8 @@ func toDomain(c *ent.Comment) *model.Comment {
 		CreatedAt:     c.CreatedAt,
 		UpdatedAt:     c.UpdatedAt,
 		PinnedAt:      c.PinnedAt,
+		EditedAt:      c.EditedAt,
+		EditHistory:   c.EditHistory,
 	}
 	return domainComment
 }
@@ -382,6 +385,22 @@ func (r *commentRepo) UpdateCommentInfo(ctx context.Context, id uint, params *re
 	}
 	return r.FindByID(ctx, id)
 }
+
+// UpdateByAuthor 保存评论作者的自助编辑，并将编辑前的版本追加到编辑历史中
+func (r *commentRepo) UpdateByAuthor(ctx context.Context, id uint, params *repository.AuthorUpdateCommentParams) (*model.Comment, error) {
+	_, err := r.db.Comment.UpdateOneID(id).
+		SetContent(params.Content).
+		SetContentHTML(params.ContentHTML).
+		SetStatus(params.Status).
+		SetEditedAt(params.History.EditedAt).
+		AppendEditHistory([]model.CommentEditRecord{params.History}).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.FindByID(ctx, id)
+}
+
 func (r *commentRepo) UpdatePath(ctx context.Context, oldPath, newPath string) (int, error) {
 	info, err := r.db.Comment.Update().
 		Where(entcomment.TargetPath(oldPath)).
@@ -465,3 +484,99 @@ func (r *commentRepo) CountByTargetPaths(ctx context.Context, targetPaths []stri
 	log.Printf("[DEBUG] CountByTargetPaths: 统计结果: %+v", countMap)
 	return countMap, nil
 }
+
+// FindEarliestCreatedAtByPath 查找指定路径下最早一条评论的创建时间
+func (r *commentRepo) FindEarliestCreatedAtByPath(ctx context.Context, path string) (*time.Time, error) {
+	first, err := r.db.Comment.Query().
+		Where(
+			entcomment.TargetPath(path),
+			entcomment.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(entcomment.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &first.CreatedAt, nil
+}
+
+// authorPredicate 将作者条件转换为查询谓词
+func authorPredicate(filter repository.CommentAuthorFilter) (predicate.Comment, error) {
+	var preds []predicate.Comment
+	if filter.UserID != nil {
+		preds = append(preds, entcomment.UserID(*filter.UserID))
+	}
+	if filter.Email != "" {
+		preds = append(preds, entcomment.EmailEqualFold(filter.Email))
+	}
+	if filter.EmailMD5 != "" {
+		preds = append(preds, entcomment.EmailMd5(filter.EmailMD5))
+	}
+	if len(preds) == 0 {
+		return nil, fmt.Errorf("必须提供至少一个作者条件")
+	}
+	return entcomment.Or(preds...), nil
+}
+
+// FindByAuthor 查找符合作者条件的全部评论
+func (r *commentRepo) FindByAuthor(ctx context.Context, filter repository.CommentAuthorFilter) ([]*model.Comment, error) {
+	pred, err := authorPredicate(filter)
+	if err != nil {
+		return nil, err
+	}
+	entComments, err := r.db.Comment.Query().
+		Where(pred, entcomment.DeletedAtIsNil()).
+		Order(ent.Asc(entcomment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	domainComments := make([]*model.Comment, len(entComments))
+	for i, c := range entComments {
+		domainComments[i] = toDomain(c)
+	}
+	return domainComments, nil
+}
+
+// AnonymizeByUser 解除评论与用户的关联并清除作者的个人信息
+func (r *commentRepo) AnonymizeByUser(ctx context.Context, userID uint, nickname string) (int, error) {
+	return r.db.Comment.Update().
+		Where(entcomment.UserID(userID)).
+		ClearUserID().
+		SetNickname(nickname).
+		ClearEmail().
+		SetEmailMd5("").
+		ClearWebsite().
+		SetIPAddress("").
+		ClearIPLocation().
+		ClearUserAgent().
+		ClearEditHistory().
+		Save(ctx)
+}
+
+// EraseByAuthor 清除评论的内容和个人信息后将其删除。
+// 评论表使用软删除，因此先覆盖掉全部个人数据，保证删除后数据库中不再保留这些信息。
+func (r *commentRepo) EraseByAuthor(ctx context.Context, filter repository.CommentAuthorFilter) (int, error) {
+	pred, err := authorPredicate(filter)
+	if err != nil {
+		return 0, err
+	}
+	return r.db.Comment.Update().
+		Where(pred).
+		ClearUserID().
+		SetNickname("已删除").
+		ClearEmail().
+		SetEmailMd5("").
+		ClearWebsite().
+		SetContent("该评论已被删除").
+		SetContentHTML("<p>该评论已被删除</p>").
+		SetIPAddress("").
+		ClearIPLocation().
+		ClearUserAgent().
+		ClearEditHistory().
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+}
@@ -0,0 +1,107 @@
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/commentthread"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type commentThreadRepo struct {
+	client *ent.Client
+}
+
+// NewCommentThreadRepo 创建评论区状态仓储
+func NewCommentThreadRepo(client *ent.Client) repository.CommentThreadRepository {
+	return &commentThreadRepo{client: client}
+}
+
+func toDomainCommentThread(t *ent.CommentThread) *model.CommentThread {
+	if t == nil {
+		return nil
+	}
+	return &model.CommentThread{
+		ID:         t.ID,
+		TargetPath: t.TargetPath,
+		State:      model.CommentThreadState(t.State),
+		CreatedAt:  t.CreatedAt,
+		UpdatedAt:  t.UpdatedAt,
+	}
+}
+
+func (r *commentThreadRepo) FindByPath(ctx context.Context, targetPath string) (*model.CommentThread, error) {
+	t, err := r.client.CommentThread.Query().
+		Where(commentthread.TargetPathEQ(targetPath)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainCommentThread(t), nil
+}
+
+func (r *commentThreadRepo) Upsert(ctx context.Context, targetPath string, state model.CommentThreadState) (*model.CommentThread, error) {
+	existing, err := r.client.CommentThread.Query().
+		Where(commentthread.TargetPathEQ(targetPath)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	var saved *ent.CommentThread
+	if existing != nil {
+		saved, err = existing.Update().
+			SetState(commentthread.State(state)).
+			Save(ctx)
+	} else {
+		saved, err = r.client.CommentThread.Create().
+			SetTargetPath(targetPath).
+			SetState(commentthread.State(state)).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainCommentThread(saved), nil
+}
+
+func (r *commentThreadRepo) DeleteByPath(ctx context.Context, targetPath string) error {
+	_, err := r.client.CommentThread.Delete().
+		Where(commentthread.TargetPathEQ(targetPath)).
+		Exec(ctx)
+	return err
+}
+
+func (r *commentThreadRepo) List(ctx context.Context, query *model.CommentThreadListQuery) (*repository.PageResult[model.CommentThread], error) {
+	q := r.client.CommentThread.Query()
+	if query.TargetPath != "" {
+		q = q.Where(commentthread.TargetPathContains(query.TargetPath))
+	}
+	if query.State != "" {
+		q = q.Where(commentthread.StateEQ(commentthread.State(query.State)))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	threads, err := q.
+		Order(ent.Desc(commentthread.FieldUpdatedAt)).
+		Offset((query.Page - 1) * query.PageSize).
+		Limit(query.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.CommentThread, len(threads))
+	for i, t := range threads {
+		items[i] = toDomainCommentThread(t)
+	}
+	return &repository.PageResult[model.CommentThread]{Items: items, Total: int64(total)}, nil
+}
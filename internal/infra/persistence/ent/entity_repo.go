@@ -87,6 +87,12 @@ func (r *entEntityRepository) Create(ctx context.Context, domainEntity *model.Fi
 	if domainEntity.Dimension.Valid {
 		createBuilder.SetDimension(domainEntity.Dimension.String)
 	}
+	if domainEntity.ContentHash.Valid {
+		createBuilder.SetContentHash(domainEntity.ContentHash.String)
+	}
+	if domainEntity.ReferenceCount > 0 {
+		createBuilder.SetReferenceCount(domainEntity.ReferenceCount)
+	}
 
 	created, err := createBuilder.Save(ctx)
 	if err != nil {
@@ -95,6 +101,7 @@ func (r *entEntityRepository) Create(ctx context.Context, domainEntity *model.Fi
 	domainEntity.ID = created.ID
 	domainEntity.CreatedAt = created.CreatedAt
 	domainEntity.UpdatedAt = created.UpdatedAt
+	domainEntity.ReferenceCount = created.ReferenceCount
 	return nil
 }
 
@@ -160,6 +167,10 @@ func (r *entEntityRepository) Update(ctx context.Context, domainEntity *model.Fi
 	if domainEntity.Dimension.Valid {
 		updateBuilder.SetDimension(domainEntity.Dimension.String)
 	}
+	// 引用计数只通过 AddReference 和 ReleaseReference 原子地修改，避免覆盖并发的引用变化
+	if domainEntity.ContentHash.Valid {
+		updateBuilder.SetContentHash(domainEntity.ContentHash.String)
+	}
 
 	_, err := updateBuilder.Save(ctx)
 	return err
@@ -304,6 +315,103 @@ func (r *entEntityRepository) DeleteByStoragePolicyID(ctx context.Context, polic
 	return nil
 }
 
+// FindByContentHash 查找指定存储策略下内容哈希相同且已完成上传的实体。
+func (r *entEntityRepository) FindByContentHash(ctx context.Context, policyID uint, contentHash string) ([]*model.FileStorageEntity, error) {
+	entEntities, err := r.client.Entity.Query().
+		Where(
+			entity.PolicyID(policyID),
+			entity.ContentHash(contentHash),
+			entity.Or(entity.UploadSessionIDIsNil(), entity.UploadSessionID("")),
+		).
+		Order(ent.Asc(entity.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("按内容哈希查找实体失败: %w", err)
+	}
+	domainEntities := make([]*model.FileStorageEntity, len(entEntities))
+	for i, e := range entEntities {
+		domainEntities[i] = toDomainEntity(e)
+	}
+	return domainEntities, nil
+}
+
+// FindWithoutContentHash 分批查找尚未计算内容哈希的实体。
+func (r *entEntityRepository) FindWithoutContentHash(ctx context.Context, afterID uint, limit int) ([]*model.FileStorageEntity, error) {
+	entEntities, err := r.client.Entity.Query().
+		Where(
+			entity.IDGT(afterID),
+			entity.ContentHashIsNil(),
+			entity.SourceNotNil(),
+			entity.Or(entity.UploadSessionIDIsNil(), entity.UploadSessionID("")),
+		).
+		Order(ent.Asc(entity.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查找未计算内容哈希的实体失败: %w", err)
+	}
+	domainEntities := make([]*model.FileStorageEntity, len(entEntities))
+	for i, e := range entEntities {
+		domainEntities[i] = toDomainEntity(e)
+	}
+	return domainEntities, nil
+}
+
+// FindMigratableByStoragePolicyID 分批查找指定存储策略下已完成上传的实体。
+func (r *entEntityRepository) FindMigratableByStoragePolicyID(ctx context.Context, policyID uint, afterID uint, limit int) ([]*model.FileStorageEntity, error) {
+	entEntities, err := r.client.Entity.Query().
+		Where(
+			entity.PolicyID(policyID),
+			entity.IDGT(afterID),
+			entity.SourceNotNil(),
+			entity.Or(entity.UploadSessionIDIsNil(), entity.UploadSessionID("")),
+		).
+		Order(ent.Asc(entity.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查找存储策略下待迁移的实体失败: %w", err)
+	}
+	domainEntities := make([]*model.FileStorageEntity, len(entEntities))
+	for i, e := range entEntities {
+		domainEntities[i] = toDomainEntity(e)
+	}
+	return domainEntities, nil
+}
+
+// IsSourceUsedByEntities 检查指定存储策略下是否还有实体使用给定的存储位置。
+func (r *entEntityRepository) IsSourceUsedByEntities(ctx context.Context, policyID uint, source string) (bool, error) {
+	exists, err := r.client.Entity.Query().
+		Where(entity.PolicyID(policyID), entity.Source(source)).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("检查存储位置使用情况失败: %w", err)
+	}
+	return exists, nil
+}
+
+// SetContentHash 只更新实体的内容哈希，不影响其他字段。
+func (r *entEntityRepository) SetContentHash(ctx context.Context, id uint, contentHash string) error {
+	return r.client.Entity.UpdateOneID(id).SetContentHash(contentHash).Exec(ctx)
+}
+
+// AddReference 为实体增加 delta 个文件引用。
+func (r *entEntityRepository) AddReference(ctx context.Context, id uint, delta int) error {
+	return r.client.Entity.UpdateOneID(id).AddReferenceCount(delta).Exec(ctx)
+}
+
+// ReleaseReference 释放实体的一个文件引用，只在还有其他引用时才递减计数。
+func (r *entEntityRepository) ReleaseReference(ctx context.Context, id uint) (bool, error) {
+	affected, err := r.client.Entity.Update().
+		Where(entity.ID(id), entity.ReferenceCountGT(1)).
+		AddReferenceCount(-1).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("释放实体 %d 的引用失败: %w", id, err)
+	}
+	return affected == 0, nil
+}
+
 // --- 数据转换辅助函数 ---
 
 // toDomainEntity 将 ent 生成的实体对象转换为自定义的领域模型对象。
@@ -318,6 +426,8 @@ func toDomainEntity(e *ent.Entity) *model.FileStorageEntity {
 		Type:      model.EntityType(e.Type),
 		Size:      e.Size,
 		PolicyID:  e.PolicyID,
+
+		ReferenceCount: e.ReferenceCount,
 	}
 
 	if e.Source != nil {
@@ -347,6 +457,10 @@ func toDomainEntity(e *ent.Entity) *model.FileStorageEntity {
 		domain.Dimension.String = *e.Dimension
 		domain.Dimension.Valid = true
 	}
+	if e.ContentHash != nil {
+		domain.ContentHash.String = *e.ContentHash
+		domain.ContentHash.Valid = true
+	}
 	if e.StorageMetadata != nil {
 		domain.StorageMetadata = e.StorageMetadata
 	} else {
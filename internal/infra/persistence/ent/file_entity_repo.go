@@ -93,6 +93,26 @@ func (r *entFileEntityRepository) FindCurrentByFileID(ctx context.Context, fileI
 	return toDomainFileStorageVersion(entVersion), nil
 }
 
+// ListByFileID 按创建时间倒序列出某个逻辑文件的全部版本关联。
+func (r *entFileEntityRepository) ListByFileID(ctx context.Context, fileID uint) ([]*model.FileStorageVersion, error) {
+	entVersions, err := r.client.FileEntity.Query().
+		Where(
+			fileentity.FileID(fileID),
+			fileentity.DeletedAtIsNil(),
+		).
+		Order(ent.Desc(fileentity.FieldCreatedAt), ent.Desc(fileentity.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询文件版本关联记录失败: %w", err)
+	}
+
+	domainVersions := make([]*model.FileStorageVersion, len(entVersions))
+	for i, v := range entVersions {
+		domainVersions[i] = toDomainFileStorageVersion(v)
+	}
+	return domainVersions, nil
+}
+
 func (r *entFileEntityRepository) MarkOldVersionsAsNotCurrent(ctx context.Context, fileID uint, excludeVersionID uint) error {
 	query := r.client.FileEntity.Update().
 		Where(
@@ -218,3 +238,26 @@ func toDomainFileStorageVersion(v *ent.FileEntity) *model.FileStorageVersion {
 
 	return domain
 }
+
+// DeleteByFileAndEntityID 删除某个逻辑文件与某个物理实体之间的全部关联记录。
+func (r *entFileEntityRepository) DeleteByFileAndEntityID(ctx context.Context, fileID, entityID uint) error {
+	_, err := r.client.FileEntity.Delete().
+		Where(fileentity.FileID(fileID), fileentity.EntityID(entityID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("删除文件 %d 与实体 %d 的关联记录失败: %w", fileID, entityID, err)
+	}
+	return nil
+}
+
+// ReplaceEntityID 将所有指向 oldEntityID 的关联记录改为指向 newEntityID。
+func (r *entFileEntityRepository) ReplaceEntityID(ctx context.Context, oldEntityID, newEntityID uint) error {
+	_, err := r.client.FileEntity.Update().
+		Where(fileentity.EntityID(oldEntityID)).
+		SetEntityID(newEntityID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("替换文件关联记录的实体失败: %w", err)
+	}
+	return nil
+}
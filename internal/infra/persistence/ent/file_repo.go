@@ -15,6 +15,8 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
+	"github.com/anzhiyu-c/anheyu-app/ent/metadata"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
 	"github.com/anzhiyu-c/anheyu-app/ent/privacy"
 )
 
@@ -158,6 +160,26 @@ func (r *entFileRepository) FindByParentIDAndName(ctx context.Context, parentID
 	return toDomainFile(entFile), nil
 }
 
+// FindByParentIDAndNameUnscoped 与 FindByParentIDAndName 相同，但同时会查到已软删除的记录
+func (r *entFileRepository) FindByParentIDAndNameUnscoped(ctx context.Context, parentID uint, name string) (*model.File, error) {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+	query := r.client.File.Query().Where(file.Name(name))
+	if parentID == 0 {
+		query = query.Where(file.ParentIDIsNil())
+	} else {
+		query = query.Where(file.ParentID(parentID))
+	}
+
+	entFile, err := query.Only(allowCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, constant.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainFile(entFile), nil
+}
+
 // --- 复杂查询和操作 ---
 
 func (r *entFileRepository) FindByPath(ctx context.Context, ownerID uint, path string) (*model.File, error) {
@@ -426,6 +448,7 @@ func toDomainFile(f *ent.File) *model.File {
 		Size:          f.Size,
 		Type:          model.FileType(f.Type),
 		ChildrenCount: f.ChildrenCount,
+		DeletedAt:     f.DeletedAt,
 		Metas:         make(map[string]string),
 	}
 
@@ -649,3 +672,98 @@ func (r *entFileRepository) SoftDeleteByOwnerID(ctx context.Context, ownerID uin
 		Save(ctx)
 	return err
 }
+
+// ListByOwnerID 获取指定用户未删除的全部文件和目录
+func (r *entFileRepository) ListByOwnerID(ctx context.Context, ownerID uint) ([]*model.File, error) {
+	entFiles, err := r.client.File.Query().
+		Where(file.OwnerID(ownerID), file.DeletedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	domainFiles := make([]*model.File, len(entFiles))
+	for i, f := range entFiles {
+		domainFiles[i] = toDomainFile(f)
+	}
+	return domainFiles, nil
+}
+
+// SoftDeleteTree 逐层收集未删除的后代，并以同一个删除时间将它们和项目本身一起软删除
+func (r *entFileRepository) SoftDeleteTree(ctx context.Context, id uint, deletedAt time.Time) error {
+	ids := []uint{id}
+	level := []uint{id}
+	for len(level) > 0 {
+		children, err := r.client.File.Query().
+			Where(file.ParentIDIn(level...), file.DeletedAtIsNil()).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, children...)
+		level = children
+	}
+	return r.client.File.Update().
+		Where(file.IDIn(ids...), file.DeletedAtIsNil()).
+		SetDeletedAt(deletedAt).
+		Exec(ctx)
+}
+
+// RestoreTree 只沿着删除时间与 deletedAt 相同的记录向下还原
+func (r *entFileRepository) RestoreTree(ctx context.Context, id uint, deletedAt time.Time) error {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+	ids := []uint{id}
+	level := []uint{id}
+	for len(level) > 0 {
+		children, err := r.client.File.Query().
+			Where(file.ParentIDIn(level...), file.DeletedAt(deletedAt)).
+			IDs(allowCtx)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, children...)
+		level = children
+	}
+	return r.client.File.Update().
+		Where(file.IDIn(ids...)).
+		ClearDeletedAt().
+		Exec(allowCtx)
+}
+
+// ListTrashed 回收站中的顶层项目是带有还原位置元数据的已删除记录
+func (r *entFileRepository) ListTrashed(ctx context.Context, ownerID uint) ([]*model.File, error) {
+	return r.listTrashed(ctx, file.OwnerID(ownerID))
+}
+
+func (r *entFileRepository) ListTrashedBefore(ctx context.Context, before time.Time) ([]*model.File, error) {
+	return r.listTrashed(ctx, file.DeletedAtLT(before))
+}
+
+func (r *entFileRepository) listTrashed(ctx context.Context, ps ...predicate.File) ([]*model.File, error) {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+	entFiles, err := r.client.File.Query().
+		Where(
+			file.DeletedAtNotNil(),
+			file.HasMetadataWith(metadata.Name(model.MetaKeyRestoreURI), metadata.DeletedAtIsNil()),
+		).
+		Where(ps...).
+		WithPrimaryEntity().
+		Order(ent.Desc(file.FieldDeletedAt), ent.Desc(file.FieldID)).
+		All(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+	domainFiles := make([]*model.File, len(entFiles))
+	for i, f := range entFiles {
+		domainFiles[i] = toDomainFile(f)
+	}
+	return domainFiles, nil
+}
+
+// ReplacePrimaryEntity 将所有以 oldEntityID 为当前实体的文件改为指向 newEntityID
+func (r *entFileRepository) ReplacePrimaryEntity(ctx context.Context, oldEntityID, newEntityID uint) (int, error) {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+	return r.client.File.Update().
+		Where(file.PrimaryEntityID(oldEntityID)).
+		SetPrimaryEntityID(newEntityID).
+		Save(allowCtx)
+}
@@ -0,0 +1,147 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/invitationcode"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type invitationCodeRepo struct {
+	client *ent.Client
+}
+
+// NewInvitationCodeRepo 创建注册邀请码仓储
+func NewInvitationCodeRepo(client *ent.Client) repository.InvitationCodeRepository {
+	return &invitationCodeRepo{client: client}
+}
+
+func toDomainInvitationCode(c *ent.InvitationCode) *model.InvitationCode {
+	if c == nil {
+		return nil
+	}
+	return &model.InvitationCode{
+		ID:          c.ID,
+		Code:        c.Code,
+		CreatorID:   c.CreatorID,
+		UserGroupID: c.UserGroupID,
+		MaxUses:     c.MaxUses,
+		UsedCount:   c.UsedCount,
+		Email:       c.Email,
+		Note:        c.Note,
+		ExpiresAt:   c.ExpiresAt,
+		RevokedAt:   c.RevokedAt,
+		CreatedAt:   c.CreatedAt,
+	}
+}
+
+func (r *invitationCodeRepo) Create(ctx context.Context, c *model.InvitationCode) error {
+	created, err := r.client.InvitationCode.Create().
+		SetCode(c.Code).
+		SetCreatorID(c.CreatorID).
+		SetUserGroupID(c.UserGroupID).
+		SetMaxUses(c.MaxUses).
+		SetEmail(c.Email).
+		SetNote(c.Note).
+		SetNillableExpiresAt(c.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	c.ID = created.ID
+	c.CreatedAt = created.CreatedAt
+	return nil
+}
+
+func (r *invitationCodeRepo) FindByCode(ctx context.Context, code string) (*model.InvitationCode, error) {
+	c, err := r.client.InvitationCode.Query().
+		Where(invitationcode.CodeEQ(code)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainInvitationCode(c), nil
+}
+
+func (r *invitationCodeRepo) FindByID(ctx context.Context, id uint) (*model.InvitationCode, error) {
+	c, err := r.client.InvitationCode.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainInvitationCode(c), nil
+}
+
+func (r *invitationCodeRepo) List(ctx context.Context, page, pageSize int) ([]*model.InvitationCode, int, error) {
+	query := r.client.InvitationCode.Query()
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+	items, err := query.
+		Order(ent.Desc(invitationcode.FieldCreatedAt), ent.Desc(invitationcode.FieldID)).
+		Offset(offset).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	result := make([]*model.InvitationCode, 0, len(items))
+	for _, c := range items {
+		result = append(result, toDomainInvitationCode(c))
+	}
+	return result, total, nil
+}
+
+func (r *invitationCodeRepo) IncrementUsage(ctx context.Context, id uint) (bool, error) {
+	now := time.Now()
+	affected, err := r.client.InvitationCode.Update().
+		Where(
+			invitationcode.IDEQ(id),
+			invitationcode.RevokedAtIsNil(),
+			invitationcode.Or(invitationcode.ExpiresAtIsNil(), invitationcode.ExpiresAtGT(now)),
+			invitationcode.Or(invitationcode.MaxUsesEQ(0), usedBelowMax()),
+		).
+		AddUsedCount(1).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// usedBelowMax 比较同一行的两个字段：used_count < max_uses
+func usedBelowMax() predicate.InvitationCode {
+	return predicate.InvitationCode(func(s *sql.Selector) {
+		s.Where(sql.ColumnsLT(s.C(invitationcode.FieldUsedCount), s.C(invitationcode.FieldMaxUses)))
+	})
+}
+
+func (r *invitationCodeRepo) DecrementUsage(ctx context.Context, id uint) error {
+	return r.client.InvitationCode.Update().
+		Where(invitationcode.IDEQ(id), invitationcode.UsedCountGT(0)).
+		AddUsedCount(-1).
+		Exec(ctx)
+}
+
+func (r *invitationCodeRepo) Revoke(ctx context.Context, id uint) error {
+	return r.client.InvitationCode.Update().
+		Where(invitationcode.IDEQ(id), invitationcode.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+}
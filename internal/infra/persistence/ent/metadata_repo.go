@@ -61,6 +61,7 @@ func (r *entMetadataRepo) Set(ctx context.Context, meta *model.Metadata) error {
 			sql.ConflictColumns(metadata.FieldFileID, metadata.FieldName),
 		).
 		UpdateValue().
+		ClearDeletedAt(). // 重新设置已删除的元数据时一并恢复
 		Exec(ctx)
 }
 
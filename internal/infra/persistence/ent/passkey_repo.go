@@ -0,0 +1,121 @@
+package ent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/passkey"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type passkeyRepo struct {
+	client *ent.Client
+}
+
+// NewPasskeyRepo 创建通行密钥仓储
+func NewPasskeyRepo(client *ent.Client) repository.PasskeyRepository {
+	return &passkeyRepo{client: client}
+}
+
+func toDomainPasskey(p *ent.Passkey) *model.Passkey {
+	if p == nil {
+		return nil
+	}
+	return &model.Passkey{
+		ID:           p.ID,
+		UserID:       p.UserID,
+		Name:         p.Name,
+		CredentialID: p.CredentialID,
+		PublicKey:    p.PublicKey,
+		SignCount:    p.SignCount,
+		AAGUID:       p.Aaguid,
+		Transports:   p.Transports,
+		LastUsedAt:   p.LastUsedAt,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+// credentialHash 计算凭证ID的哈希，用于唯一索引和查询
+func credentialHash(credentialID string) string {
+	sum := sha256.Sum256([]byte(credentialID))
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *passkeyRepo) Create(ctx context.Context, p *model.Passkey) error {
+	created, err := r.client.Passkey.Create().
+		SetUserID(p.UserID).
+		SetName(p.Name).
+		SetCredentialID(p.CredentialID).
+		SetCredentialHash(credentialHash(p.CredentialID)).
+		SetPublicKey(p.PublicKey).
+		SetSignCount(p.SignCount).
+		SetAaguid(p.AAGUID).
+		SetTransports(p.Transports).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	p.ID = created.ID
+	p.CreatedAt = created.CreatedAt
+	p.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *passkeyRepo) FindByCredentialID(ctx context.Context, credentialID string) (*model.Passkey, error) {
+	p, err := r.client.Passkey.Query().
+		Where(passkey.CredentialHashEQ(credentialHash(credentialID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainPasskey(p), nil
+}
+
+func (r *passkeyRepo) FindByID(ctx context.Context, id uint) (*model.Passkey, error) {
+	p, err := r.client.Passkey.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainPasskey(p), nil
+}
+
+func (r *passkeyRepo) ListByUserID(ctx context.Context, userID uint) ([]*model.Passkey, error) {
+	items, err := r.client.Passkey.Query().
+		Where(passkey.UserIDEQ(userID)).
+		Order(ent.Desc(passkey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Passkey, 0, len(items))
+	for _, p := range items {
+		result = append(result, toDomainPasskey(p))
+	}
+	return result, nil
+}
+
+func (r *passkeyRepo) UpdateName(ctx context.Context, id uint, name string) error {
+	return r.client.Passkey.UpdateOneID(id).SetName(name).Exec(ctx)
+}
+
+func (r *passkeyRepo) UpdateUsage(ctx context.Context, id uint, signCount uint32) error {
+	return r.client.Passkey.UpdateOneID(id).
+		SetSignCount(signCount).
+		SetLastUsedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *passkeyRepo) Delete(ctx context.Context, id uint) error {
+	return r.client.Passkey.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,142 @@
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/predicate"
+	"github.com/anzhiyu-c/anheyu-app/ent/share"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type shareRepo struct {
+	client *ent.Client
+}
+
+// NewShareRepo 创建文件分享仓储
+func NewShareRepo(client *ent.Client) repository.ShareRepository {
+	return &shareRepo{client: client}
+}
+
+func toDomainShare(s *ent.Share) *model.Share {
+	if s == nil {
+		return nil
+	}
+	return &model.Share{
+		ID:            s.ID,
+		OwnerID:       s.OwnerID,
+		FileID:        s.FileID,
+		Password:      s.Password,
+		ExpiresAt:     s.ExpiresAt,
+		DownloadLimit: s.DownloadLimit,
+		Downloads:     s.Downloads,
+		Views:         s.Views,
+		PreviewOnly:   s.PreviewOnly,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}
+}
+
+func (r *shareRepo) Create(ctx context.Context, s *model.Share) error {
+	created, err := r.client.Share.Create().
+		SetOwnerID(s.OwnerID).
+		SetFileID(s.FileID).
+		SetPassword(s.Password).
+		SetNillableExpiresAt(s.ExpiresAt).
+		SetDownloadLimit(s.DownloadLimit).
+		SetPreviewOnly(s.PreviewOnly).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	s.ID = created.ID
+	s.CreatedAt = created.CreatedAt
+	s.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *shareRepo) FindByID(ctx context.Context, id uint) (*model.Share, error) {
+	s, err := r.client.Share.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainShare(s), nil
+}
+
+func (r *shareRepo) ListByOwner(ctx context.Context, ownerID uint, page, pageSize int) ([]*model.Share, int, error) {
+	query := r.client.Share.Query().Where(share.OwnerIDEQ(ownerID))
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+	items, err := query.
+		Order(ent.Desc(share.FieldCreatedAt), ent.Desc(share.FieldID)).
+		Offset(offset).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	result := make([]*model.Share, 0, len(items))
+	for _, s := range items {
+		result = append(result, toDomainShare(s))
+	}
+	return result, total, nil
+}
+
+func (r *shareRepo) Update(ctx context.Context, s *model.Share) error {
+	update := r.client.Share.UpdateOneID(s.ID).
+		SetPassword(s.Password).
+		SetDownloadLimit(s.DownloadLimit).
+		SetPreviewOnly(s.PreviewOnly)
+	if s.ExpiresAt != nil {
+		update.SetExpiresAt(*s.ExpiresAt)
+	} else {
+		update.ClearExpiresAt()
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	s.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *shareRepo) Delete(ctx context.Context, id uint) error {
+	return r.client.Share.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *shareRepo) IncrementViews(ctx context.Context, id uint) error {
+	return r.client.Share.UpdateOneID(id).AddViews(1).Exec(ctx)
+}
+
+func (r *shareRepo) IncrementDownloads(ctx context.Context, id uint) (bool, error) {
+	affected, err := r.client.Share.Update().
+		Where(
+			share.IDEQ(id),
+			share.Or(share.DownloadLimitEQ(0), downloadsBelowLimit()),
+		).
+		AddDownloads(1).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// downloadsBelowLimit 比较同一行的两个字段：downloads < download_limit
+func downloadsBelowLimit() predicate.Share {
+	return predicate.Share(func(s *sql.Selector) {
+		s.Where(sql.ColumnsLT(s.C(share.FieldDownloads), s.C(share.FieldDownloadLimit)))
+	})
+}
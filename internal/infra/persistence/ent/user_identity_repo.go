@@ -0,0 +1,112 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/useridentity"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type userIdentityRepo struct {
+	client *ent.Client
+}
+
+// NewUserIdentityRepo 创建第三方登录账号仓储
+func NewUserIdentityRepo(client *ent.Client) repository.UserIdentityRepository {
+	return &userIdentityRepo{client: client}
+}
+
+func toDomainUserIdentity(i *ent.UserIdentity) *model.UserIdentity {
+	if i == nil {
+		return nil
+	}
+	return &model.UserIdentity{
+		ID:          i.ID,
+		UserID:      i.UserID,
+		Provider:    i.Provider,
+		Subject:     i.Subject,
+		Email:       i.Email,
+		DisplayName: i.DisplayName,
+		AvatarURL:   i.AvatarURL,
+		LastLoginAt: i.LastLoginAt,
+		CreatedAt:   i.CreatedAt,
+		UpdatedAt:   i.UpdatedAt,
+	}
+}
+
+func (r *userIdentityRepo) FindByProviderSubject(ctx context.Context, provider, subject string) (*model.UserIdentity, error) {
+	i, err := r.client.UserIdentity.Query().
+		Where(
+			useridentity.ProviderEQ(provider),
+			useridentity.SubjectEQ(subject),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainUserIdentity(i), nil
+}
+
+func (r *userIdentityRepo) FindByID(ctx context.Context, id uint) (*model.UserIdentity, error) {
+	i, err := r.client.UserIdentity.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainUserIdentity(i), nil
+}
+
+func (r *userIdentityRepo) ListByUserID(ctx context.Context, userID uint) ([]*model.UserIdentity, error) {
+	items, err := r.client.UserIdentity.Query().
+		Where(useridentity.UserIDEQ(userID)).
+		Order(ent.Asc(useridentity.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.UserIdentity, 0, len(items))
+	for _, i := range items {
+		result = append(result, toDomainUserIdentity(i))
+	}
+	return result, nil
+}
+
+func (r *userIdentityRepo) Create(ctx context.Context, identity *model.UserIdentity) error {
+	created, err := r.client.UserIdentity.Create().
+		SetUserID(identity.UserID).
+		SetProvider(identity.Provider).
+		SetSubject(identity.Subject).
+		SetEmail(identity.Email).
+		SetDisplayName(identity.DisplayName).
+		SetAvatarURL(identity.AvatarURL).
+		SetNillableLastLoginAt(identity.LastLoginAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	identity.ID = created.ID
+	identity.CreatedAt = created.CreatedAt
+	identity.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *userIdentityRepo) UpdateProfile(ctx context.Context, identity *model.UserIdentity) error {
+	return r.client.UserIdentity.UpdateOneID(identity.ID).
+		SetEmail(identity.Email).
+		SetDisplayName(identity.DisplayName).
+		SetAvatarURL(identity.AvatarURL).
+		SetLastLoginAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *userIdentityRepo) Delete(ctx context.Context, id uint) error {
+	return r.client.UserIdentity.DeleteOneID(id).Exec(ctx)
+}
@@ -2,6 +2,7 @@ package ent
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 
@@ -11,7 +12,10 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/ent/comment"
 	"github.com/anzhiyu-c/anheyu-app/ent/directlink"
+	"github.com/anzhiyu-c/anheyu-app/ent/entity"
 	"github.com/anzhiyu-c/anheyu-app/ent/file"
+	"github.com/anzhiyu-c/anheyu-app/ent/fileentity"
+	"github.com/anzhiyu-c/anheyu-app/ent/privacy"
 	"github.com/anzhiyu-c/anheyu-app/ent/user"
 	"github.com/anzhiyu-c/anheyu-app/ent/usergroup"
 	"github.com/anzhiyu-c/anheyu-app/ent/userinstalledtheme"
@@ -312,6 +316,72 @@ func (r *entUserRepository) Delete(ctx context.Context, id uint) error {
 	return tx.Commit()
 }
 
+// AdjustStorageUsed 增量调整用户已使用的存储容量
+func (r *entUserRepository) AdjustStorageUsed(ctx context.Context, userID uint, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+	if err := r.client.User.Update().Where(user.ID(userID)).AddStorageUsed(delta).Exec(ctx); err != nil {
+		return err
+	}
+	if delta < 0 {
+		// 功能上线前已存在的文件没有计入用量，释放时可能减成负数
+		return r.client.User.Update().
+			Where(user.ID(userID), user.StorageUsedLT(0)).
+			SetStorageUsed(0).
+			Exec(ctx)
+	}
+	return nil
+}
+
+// RecalculateStorageUsed 统计用户名下全部文件（包括回收站中尚未永久删除的）及其历史版本的大小并写回
+func (r *entUserRepository) RecalculateStorageUsed(ctx context.Context, userID uint) (int64, error) {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+	var v []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	err := r.client.File.Query().
+		Where(
+			file.OwnerID(userID),
+			file.Type(int(model.FileTypeFile)),
+			// 回收站中的文件仍然保留物理实体并占用空间，永久删除后实体关联会被清空
+			file.Or(file.DeletedAtIsNil(), file.PrimaryEntityIDNotNil()),
+		).
+		Aggregate(ent.Sum(file.FieldSize)).
+		Scan(allowCtx, &v)
+	if err != nil {
+		return 0, fmt.Errorf("统计用户文件大小失败: %w", err)
+	}
+	var used int64
+	if len(v) > 0 && v[0].Sum.Valid {
+		used = v[0].Sum.Int64
+	}
+
+	// 保留的历史版本同样占用空间，这些实体只通过非当前的版本关联挂在文件上
+	var versions []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	err = r.client.Entity.Query().
+		Where(entity.HasFileVersionsWith(
+			fileentity.IsCurrent(false),
+			fileentity.DeletedAtIsNil(),
+			fileentity.HasFileWith(file.OwnerID(userID)),
+		)).
+		Aggregate(ent.Sum(entity.FieldSize)).
+		Scan(allowCtx, &versions)
+	if err != nil {
+		return 0, fmt.Errorf("统计用户历史版本大小失败: %w", err)
+	}
+	if len(versions) > 0 && versions[0].Sum.Valid {
+		used += versions[0].Sum.Int64
+	}
+
+	if err := r.client.User.UpdateOneID(userID).SetStorageUsed(used).Exec(ctx); err != nil {
+		return 0, err
+	}
+	return used, nil
+}
+
 // List 分页查询用户列表，支持搜索关键词、用户组筛选和状态筛选
 func (r *entUserRepository) List(ctx context.Context, page, pageSize int, keyword string, groupID *uint, status *int) ([]*model.User, int64, error) {
 	// 构建基础查询
@@ -383,6 +453,7 @@ func toDomainUser(u *ent.User) *model.User {
 		Email:        u.Email,
 		LastLoginAt:  u.LastLoginAt,
 		Status:       u.Status,
+		StorageUsed:  u.StorageUsed,
 	}
 	// Edges 是 Ent 用于存储关联模型的地方
 	if u.Edges.UserGroup != nil {
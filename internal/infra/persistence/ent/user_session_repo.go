@@ -0,0 +1,126 @@
+package ent
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/usersession"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type userSessionRepo struct {
+	client *ent.Client
+}
+
+// NewUserSessionRepo 创建登录会话仓储
+func NewUserSessionRepo(client *ent.Client) repository.UserSessionRepository {
+	return &userSessionRepo{client: client}
+}
+
+func toDomainUserSession(s *ent.UserSession) *model.UserSession {
+	if s == nil {
+		return nil
+	}
+	return &model.UserSession{
+		ID:         s.ID,
+		UserID:     s.UserID,
+		SessionID:  s.SessionID,
+		Device:     s.Device,
+		IP:         s.IP,
+		UserAgent:  s.UserAgent,
+		LastSeenAt: s.LastSeenAt,
+		ExpiresAt:  s.ExpiresAt,
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+func (r *userSessionRepo) Create(ctx context.Context, s *model.UserSession) error {
+	created, err := r.client.UserSession.Create().
+		SetUserID(s.UserID).
+		SetSessionID(s.SessionID).
+		SetDevice(s.Device).
+		SetIP(s.IP).
+		SetUserAgent(s.UserAgent).
+		SetExpiresAt(s.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	s.ID = created.ID
+	s.LastSeenAt = created.LastSeenAt
+	s.CreatedAt = created.CreatedAt
+	return nil
+}
+
+func (r *userSessionRepo) FindBySessionID(ctx context.Context, sessionID string) (*model.UserSession, error) {
+	s, err := r.client.UserSession.Query().
+		Where(usersession.SessionIDEQ(sessionID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainUserSession(s), nil
+}
+
+func (r *userSessionRepo) FindByID(ctx context.Context, id uint) (*model.UserSession, error) {
+	s, err := r.client.UserSession.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainUserSession(s), nil
+}
+
+func (r *userSessionRepo) ListByUserID(ctx context.Context, userID uint) ([]*model.UserSession, error) {
+	items, err := r.client.UserSession.Query().
+		Where(
+			usersession.UserIDEQ(userID),
+			usersession.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(usersession.FieldLastSeenAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.UserSession, 0, len(items))
+	for _, s := range items {
+		result = append(result, toDomainUserSession(s))
+	}
+	return result, nil
+}
+
+func (r *userSessionRepo) Touch(ctx context.Context, id uint, ip, userAgent string) error {
+	return r.client.UserSession.UpdateOneID(id).
+		SetLastSeenAt(time.Now()).
+		SetIP(ip).
+		SetUserAgent(userAgent).
+		Exec(ctx)
+}
+
+func (r *userSessionRepo) Delete(ctx context.Context, id uint) error {
+	return r.client.UserSession.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *userSessionRepo) DeleteByUserID(ctx context.Context, userID uint) error {
+	_, err := r.client.UserSession.Delete().
+		Where(usersession.UserIDEQ(userID)).
+		Exec(ctx)
+	return err
+}
+
+func (r *userSessionRepo) DeleteExpired(ctx context.Context, userID uint) error {
+	_, err := r.client.UserSession.Delete().
+		Where(
+			usersession.UserIDEQ(userID),
+			usersession.ExpiresAtLTE(time.Now()),
+		).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,93 @@
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/usertwofactor"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type userTwoFactorRepo struct {
+	client *ent.Client
+}
+
+// NewUserTwoFactorRepo 创建用户两步验证配置仓储
+func NewUserTwoFactorRepo(client *ent.Client) repository.UserTwoFactorRepository {
+	return &userTwoFactorRepo{client: client}
+}
+
+func toDomainUserTwoFactor(t *ent.UserTwoFactor) *model.UserTwoFactor {
+	if t == nil {
+		return nil
+	}
+	return &model.UserTwoFactor{
+		ID:            t.ID,
+		UserID:        t.UserID,
+		Secret:        t.Secret,
+		Enabled:       t.Enabled,
+		RecoveryCodes: t.RecoveryCodes,
+		LastUsedStep:  t.LastUsedStep,
+		EnabledAt:     t.EnabledAt,
+		CreatedAt:     t.CreatedAt,
+		UpdatedAt:     t.UpdatedAt,
+	}
+}
+
+func (r *userTwoFactorRepo) FindByUserID(ctx context.Context, userID uint) (*model.UserTwoFactor, error) {
+	t, err := r.client.UserTwoFactor.Query().
+		Where(usertwofactor.UserIDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainUserTwoFactor(t), nil
+}
+
+func (r *userTwoFactorRepo) Save(ctx context.Context, tf *model.UserTwoFactor) error {
+	if tf.ID == 0 {
+		created, err := r.client.UserTwoFactor.Create().
+			SetUserID(tf.UserID).
+			SetSecret(tf.Secret).
+			SetEnabled(tf.Enabled).
+			SetRecoveryCodes(tf.RecoveryCodes).
+			SetLastUsedStep(tf.LastUsedStep).
+			SetNillableEnabledAt(tf.EnabledAt).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		tf.ID = created.ID
+		tf.CreatedAt = created.CreatedAt
+		tf.UpdatedAt = created.UpdatedAt
+		return nil
+	}
+
+	updater := r.client.UserTwoFactor.UpdateOneID(tf.ID).
+		SetSecret(tf.Secret).
+		SetEnabled(tf.Enabled).
+		SetRecoveryCodes(tf.RecoveryCodes).
+		SetLastUsedStep(tf.LastUsedStep)
+	if tf.EnabledAt != nil {
+		updater.SetEnabledAt(*tf.EnabledAt)
+	} else {
+		updater.ClearEnabledAt()
+	}
+	updated, err := updater.Save(ctx)
+	if err != nil {
+		return err
+	}
+	tf.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *userTwoFactorRepo) DeleteByUserID(ctx context.Context, userID uint) error {
+	_, err := r.client.UserTwoFactor.Delete().
+		Where(usertwofactor.UserIDEQ(userID)).
+		Exec(ctx)
+	return err
+}
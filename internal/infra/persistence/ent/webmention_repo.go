@@ -0,0 +1,168 @@
+package ent
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/ent/webmention"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+type webmentionRepo struct {
+	client *ent.Client
+}
+
+// NewWebmentionRepo 创建 Webmention 仓储
+func NewWebmentionRepo(client *ent.Client) repository.WebmentionRepository {
+	return &webmentionRepo{client: client}
+}
+
+func toDomainWebmention(w *ent.Webmention) *model.Webmention {
+	if w == nil {
+		return nil
+	}
+	return &model.Webmention{
+		ID:          w.ID,
+		Source:      w.Source,
+		Target:      w.Target,
+		TargetPath:  w.TargetPath,
+		Type:        model.WebmentionType(w.Type),
+		Status:      model.WebmentionStatus(w.Status),
+		AuthorName:  w.AuthorName,
+		AuthorURL:   w.AuthorURL,
+		AuthorPhoto: w.AuthorPhoto,
+		Content:     w.Content,
+		PublishedAt: w.PublishedAt,
+		VerifiedAt:  w.VerifiedAt,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+}
+
+func (r *webmentionRepo) FindByID(ctx context.Context, id uint) (*model.Webmention, error) {
+	w, err := r.client.Webmention.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainWebmention(w), nil
+}
+
+func (r *webmentionRepo) Create(ctx context.Context, entity *model.Webmention) error {
+	created, err := r.client.Webmention.Create().
+		SetSource(entity.Source).
+		SetTarget(entity.Target).
+		SetTargetPath(entity.TargetPath).
+		SetType(webmention.Type(entity.Type)).
+		SetStatus(webmention.Status(entity.Status)).
+		SetAuthorName(entity.AuthorName).
+		SetAuthorURL(entity.AuthorURL).
+		SetAuthorPhoto(entity.AuthorPhoto).
+		SetContent(entity.Content).
+		SetNillablePublishedAt(entity.PublishedAt).
+		SetNillableVerifiedAt(entity.VerifiedAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	entity.ID = created.ID
+	entity.CreatedAt = created.CreatedAt
+	entity.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *webmentionRepo) Update(ctx context.Context, entity *model.Webmention) error {
+	updater := r.client.Webmention.UpdateOneID(entity.ID).
+		SetTargetPath(entity.TargetPath).
+		SetType(webmention.Type(entity.Type)).
+		SetStatus(webmention.Status(entity.Status)).
+		SetAuthorName(entity.AuthorName).
+		SetAuthorURL(entity.AuthorURL).
+		SetAuthorPhoto(entity.AuthorPhoto).
+		SetContent(entity.Content)
+	if entity.PublishedAt != nil {
+		updater.SetPublishedAt(*entity.PublishedAt)
+	} else {
+		updater.ClearPublishedAt()
+	}
+	if entity.VerifiedAt != nil {
+		updater.SetVerifiedAt(*entity.VerifiedAt)
+	} else {
+		updater.ClearVerifiedAt()
+	}
+	updated, err := updater.Save(ctx)
+	if err != nil {
+		return err
+	}
+	entity.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+func (r *webmentionRepo) Delete(ctx context.Context, id uint) error {
+	return r.client.Webmention.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *webmentionRepo) FindBySourceAndTarget(ctx context.Context, source, target string) (*model.Webmention, error) {
+	w, err := r.client.Webmention.Query().
+		Where(webmention.SourceEQ(source), webmention.TargetEQ(target)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toDomainWebmention(w), nil
+}
+
+func (r *webmentionRepo) List(ctx context.Context, query *model.WebmentionListQuery) (*repository.PageResult[model.Webmention], error) {
+	q := r.client.Webmention.Query()
+	if query.TargetPath != "" {
+		q = q.Where(webmention.TargetPathEQ(query.TargetPath))
+	}
+	if query.Status != "" {
+		q = q.Where(webmention.StatusEQ(webmention.Status(query.Status)))
+	}
+	if query.Type != "" {
+		q = q.Where(webmention.TypeEQ(webmention.Type(query.Type)))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, err := q.
+		Order(ent.Desc(webmention.FieldCreatedAt)).
+		Offset((query.Page - 1) * query.PageSize).
+		Limit(query.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Webmention, len(mentions))
+	for i, w := range mentions {
+		items[i] = toDomainWebmention(w)
+	}
+	return &repository.PageResult[model.Webmention]{Items: items, Total: int64(total)}, nil
+}
+
+func (r *webmentionRepo) FindVerifiedByPath(ctx context.Context, targetPath string) ([]*model.Webmention, error) {
+	mentions, err := r.client.Webmention.Query().
+		Where(
+			webmention.TargetPathEQ(targetPath),
+			webmention.StatusEQ(webmention.StatusVerified),
+		).
+		Order(ent.Asc(webmention.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Webmention, len(mentions))
+	for i, w := range mentions {
+		result[i] = toDomainWebmention(w)
+	}
+	return result, nil
+}
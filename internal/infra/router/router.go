@@ -12,11 +12,15 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/app/middleware"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	account_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/account"
 	album_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album"
 	album_category_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/album_category"
 	article_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article"
 	article_history_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/article_history"
+	audit_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/audit"
 	auth_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/auth"
+	blocklist_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/blocklist"
 	captcha_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/captcha"
 	comment_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/comment"
 	config_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/config"
@@ -36,6 +40,7 @@ import (
 	public_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/public"
 	search_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/search"
 	setting_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/setting"
+	share_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/share"
 	sitemap_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/sitemap"
 	statistics_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/statistics"
 	storage_policy_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/storage_policy"
@@ -44,6 +49,8 @@ import (
 	thumbnail_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/thumbnail"
 	user_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/user"
 	version_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/version"
+	webdav_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/webdav"
+	webmention_handler "github.com/anzhiyu-c/anheyu-app/pkg/handler/webmention"
 )
 
 // NoCacheMiddleware 全局反缓存中间件，确保所有API响应都不会被CDN缓存
@@ -98,6 +105,12 @@ type Router struct {
 	subscriberHandler         *subscriber_handler.Handler
 	captchaHandler            *captcha_handler.Handler
 	fcircleHandler            *fcircle_handler.Handler
+	webmentionHandler         *webmention_handler.Handler
+	blocklistHandler          *blocklist_handler.Handler
+	auditHandler              *audit_handler.Handler
+	accountHandler            *account_handler.Handler
+	webdavHandler             *webdav_handler.Handler
+	shareHandler              *share_handler.Handler
 }
 
 // NewRouter 是 Router 的构造函数，通过依赖注入接收所有处理器。
@@ -136,6 +149,12 @@ func NewRouter(
 	subscriberHandler *subscriber_handler.Handler,
 	captchaHandler *captcha_handler.Handler,
 	fcircleHandler *fcircle_handler.Handler,
+	blocklistHandler *blocklist_handler.Handler,
+	webmentionHandler *webmention_handler.Handler,
+	auditHandler *audit_handler.Handler,
+	accountHandler *account_handler.Handler,
+	webdavHandler *webdav_handler.Handler,
+	shareHandler *share_handler.Handler,
 ) *Router {
 	return &Router{
 		authHandler:               authHandler,
@@ -172,6 +191,12 @@ func NewRouter(
 		subscriberHandler:         subscriberHandler,
 		captchaHandler:            captchaHandler,
 		fcircleHandler:            fcircleHandler,
+		blocklistHandler:          blocklistHandler,
+		webmentionHandler:         webmentionHandler,
+		auditHandler:              auditHandler,
+		accountHandler:            accountHandler,
+		webdavHandler:             webdavHandler,
+		shareHandler:              shareHandler,
 	}
 }
 
@@ -208,6 +233,7 @@ func (r *Router) Setup(engine *gin.Engine) {
 	r.registerStoragePolicyRoutes(apiGroup)
 	r.registerFileRoutes(apiGroup)
 	r.registerDirectLinkRoutes(apiGroup)
+	r.registerShareRoutes(apiGroup)
 	r.registerThumbnailRoutes(apiGroup)
 	r.registerArticleRoutes(apiGroup)
 	r.registerPostTagRoutes(apiGroup)
@@ -226,7 +252,11 @@ func (r *Router) Setup(engine *gin.Engine) {
 	r.registerGiveMoneyRoutes(apiGroup)
 	r.registerEssayRoutes(apiGroup)
 	r.registerFCircleRoutes(apiGroup)
+	r.registerBlocklistRoutes(apiGroup)
+	r.registerWebmentionRoutes(apiGroup)
+	r.registerAuditRoutes(apiGroup)
 	r.registerSitemapRoutes(engine) // 直接注册到engine，不使用/api前缀
+	r.registerWebDAVRoutes(engine)  // WebDAV 客户端不经过 /api，也不使用 JSON 响应
 }
 
 func (r *Router) registerCommentRoutes(api *gin.RouterGroup) {
@@ -236,6 +266,7 @@ func (r *Router) registerCommentRoutes(api *gin.RouterGroup) {
 		commentsPublic.GET("", r.commentHandler.ListByPath)
 
 		commentsPublic.GET("/latest", r.commentHandler.ListLatest)
+		commentsPublic.GET("/stream", r.commentHandler.Stream) // 指定路径的实时评论流 (SSE)
 
 		commentsPublic.GET("/:id/children", r.commentHandler.ListChildren)
 
@@ -246,19 +277,30 @@ func (r *Router) registerCommentRoutes(api *gin.RouterGroup) {
 		commentsPublic.POST("/upload", r.mw.JWTAuthOptional(), r.commentHandler.UploadCommentImage)
 		commentsPublic.POST("/:id/like", r.commentHandler.LikeComment)
 		commentsPublic.POST("/:id/unlike", r.commentHandler.UnlikeComment)
+
+		// 评论作者凭编辑令牌自助修改/删除评论
+		commentsPublic.PUT("/:id", r.commentHandler.AuthorUpdate)
+		commentsPublic.DELETE("/:id", r.commentHandler.AuthorDelete)
 	}
 
 	// 管理员专属的评论接口
-	commentsAdmin := api.Group("/comments").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	commentsAdmin := api.Group("/comments").Use(r.mw.JWTAuth(model.ScopeCommentsModerate), r.mw.RequirePermission(model.PermissionCommentModerate))
 	{
 		commentsAdmin.GET("", r.commentHandler.AdminList)
-		commentsAdmin.DELETE("", r.commentHandler.Delete)
-		commentsAdmin.PUT("/:id", r.commentHandler.UpdateContent)
-		commentsAdmin.PUT("/:id/info", r.commentHandler.UpdateCommentInfo)
-		commentsAdmin.PUT("/:id/status", r.commentHandler.UpdateStatus)
-		commentsAdmin.PUT("/:id/pin", r.commentHandler.SetPin)
+		commentsAdmin.GET("/stream", r.commentHandler.AdminStream) // 全站评论审核流 (SSE)
+		commentsAdmin.GET("/threads", r.commentHandler.ListThreads)
+		commentsAdmin.PUT("/threads", r.mw.Audit("comment.thread_state", "comment_thread"), r.commentHandler.SetThreadState)
+		commentsAdmin.DELETE("/threads", r.mw.Audit("comment.thread_reset", "comment_thread"), r.commentHandler.ResetThreadState)
+		commentsAdmin.DELETE("", r.mw.Audit("comment.delete", "comment"), r.commentHandler.Delete)
+		commentsAdmin.PUT("/:id", r.mw.Audit("comment.update", "comment"), r.commentHandler.UpdateContent)
+		commentsAdmin.PUT("/:id/info", r.mw.Audit("comment.update_info", "comment"), r.commentHandler.UpdateCommentInfo)
+		commentsAdmin.PUT("/:id/status", r.mw.Audit("comment.update_status", "comment"), r.commentHandler.UpdateStatus)
+		commentsAdmin.PUT("/:id/pin", r.mw.Audit("comment.pin", "comment"), r.commentHandler.SetPin)
+		commentsAdmin.POST("/:id/block", r.mw.Audit("comment.block_author", "comment"), r.commentHandler.BlockAuthor)
 		commentsAdmin.POST("/export", r.commentHandler.ExportComments)
-		commentsAdmin.POST("/import", r.commentHandler.ImportComments)
+		commentsAdmin.POST("/import", r.mw.Audit("comment.import", "comment"), r.commentHandler.ImportComments)
+		commentsAdmin.POST("/by-email/export", r.commentHandler.ExportCommentsByEmail)
+		commentsAdmin.POST("/by-email/erase", r.mw.Audit("comment.erase_by_email", "comment_author"), r.commentHandler.EraseCommentsByEmail)
 	}
 }
 
@@ -270,8 +312,8 @@ func (r *Router) registerPostTagRoutes(api *gin.RouterGroup) {
 		// postTagsPublic.GET("/:id", r.postTagHandler.Get)
 	}
 
-	// 创建、更新、删除通常需要管理员权限
-	postTagsAdmin := api.Group("/post-tags").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	// 创建、更新、删除需要管理全部文章的权限
+	postTagsAdmin := api.Group("/post-tags").Use(r.mw.JWTAuth(model.ScopeArticlesWrite), r.mw.RequirePermission(model.PermissionArticleAny))
 	{
 		postTagsAdmin.POST("", r.postTagHandler.Create)
 		postTagsAdmin.PUT("/:id", r.postTagHandler.Update)
@@ -286,7 +328,7 @@ func (r *Router) registerPostCategoryRoutes(api *gin.RouterGroup) {
 		// postCategoriesPublic.GET("/:id", r.postCategoryHandler.Get)
 	}
 
-	postCategoriesAdmin := api.Group("/post-categories").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	postCategoriesAdmin := api.Group("/post-categories").Use(r.mw.JWTAuth(model.ScopeArticlesWrite), r.mw.RequirePermission(model.PermissionArticleAny))
 	{
 		postCategoriesAdmin.POST("", r.postCategoryHandler.Create)
 		postCategoriesAdmin.PUT("/:id", r.postCategoryHandler.Update)
@@ -303,8 +345,8 @@ func (r *Router) registerDocSeriesRoutes(api *gin.RouterGroup) {
 		docSeriesPublic.GET("/:id/articles", r.docSeriesHandler.GetWithArticles)
 	}
 
-	// 管理员接口：创建、更新、删除文档系列
-	docSeriesAdmin := api.Group("/doc-series").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	// 管理接口：创建、更新、删除文档系列，需要管理全部文章的权限
+	docSeriesAdmin := api.Group("/doc-series").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionArticleAny))
 	{
 		docSeriesAdmin.GET("", r.docSeriesHandler.List)
 		docSeriesAdmin.GET("/:id", r.docSeriesHandler.Get)
@@ -316,7 +358,7 @@ func (r *Router) registerDocSeriesRoutes(api *gin.RouterGroup) {
 
 func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
 	// 文章列表和创建接口：支持多人共创功能，普通用户也可以访问
-	articlesUser := api.Group("/articles").Use(r.mw.JWTAuth())
+	articlesUser := api.Group("/articles").Use(r.mw.JWTAuth(model.ScopeArticlesRead, model.ScopeArticlesWrite), r.mw.RequirePermission(model.PermissionArticleOwn, model.PermissionArticleAny))
 	{
 		// 文章列表（普通用户只能查看自己的文章）
 		articlesUser.GET("", r.articleHandler.List)
@@ -324,32 +366,32 @@ func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
 		articlesUser.POST("", r.articleHandler.Create)
 		// 上传文章图片（支持普通用户，用于多人共创场景）
 		articlesUser.POST("/upload", r.articleHandler.UploadImage)
-		// 更新文章（普通用户只能更新自己的文章，权限在handler层校验）
-		articlesUser.PUT("/:id", r.articleHandler.Update)
-		// 删除文章（普通用户只能删除自己的文章，权限在handler层校验）
-		articlesUser.DELETE("/:id", r.articleHandler.Delete)
-		// 获取文章（普通用户只能获取自己的文章，权限在handler层校验）
-		articlesUser.GET("/:id", r.articleHandler.Get)
+		// 更新文章（没有管理全部文章权限的用户只能更新自己的文章）
+		articlesUser.PUT("/:id", r.articleHandler.RequireOwnership, r.articleHandler.Update)
+		// 删除文章（没有管理全部文章权限的用户只能删除自己的文章）
+		articlesUser.DELETE("/:id", r.articleHandler.RequireOwnership, r.mw.Audit("article.delete", "article"), r.articleHandler.Delete)
+		// 获取文章（没有管理全部文章权限的用户只能获取自己的文章）
+		articlesUser.GET("/:id", r.articleHandler.RequireOwnership, r.articleHandler.Get)
 
 		// 文章历史版本相关路由（需要登录）
 		if r.articleHistoryHandler != nil {
-			articlesUser.GET("/:id/history", r.articleHistoryHandler.ListHistory)
-			articlesUser.GET("/:id/history/count", r.articleHistoryHandler.GetHistoryCount)
-			articlesUser.GET("/:id/history/compare", r.articleHistoryHandler.CompareVersions)
-			articlesUser.GET("/:id/history/:version", r.articleHistoryHandler.GetVersion)
-			articlesUser.POST("/:id/history/:version/restore", r.articleHistoryHandler.RestoreVersion)
+			articlesUser.GET("/:id/history", r.articleHandler.RequireOwnership, r.articleHistoryHandler.ListHistory)
+			articlesUser.GET("/:id/history/count", r.articleHandler.RequireOwnership, r.articleHistoryHandler.GetHistoryCount)
+			articlesUser.GET("/:id/history/compare", r.articleHandler.RequireOwnership, r.articleHistoryHandler.CompareVersions)
+			articlesUser.GET("/:id/history/:version", r.articleHandler.RequireOwnership, r.articleHistoryHandler.GetVersion)
+			articlesUser.POST("/:id/history/:version/restore", r.articleHandler.RequireOwnership, r.mw.Audit("article.restore", "article"), r.articleHistoryHandler.RestoreVersion)
 		}
 	}
 
-	// 后台管理接口，需要认证和管理员权限（保留用于向后兼容）
-	articlesAdmin := api.Group("/articles").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	// 后台管理接口，需要管理全部文章的权限
+	articlesAdmin := api.Group("/articles").Use(r.mw.JWTAuth(model.ScopeArticlesWrite), r.mw.RequirePermission(model.PermissionArticleAny))
 	{
 		articlesAdmin.POST("/primary-color", r.articleHandler.GetPrimaryColor)
 		// 文章导入导出功能（仅管理员可用）
 		articlesAdmin.POST("/export", r.articleHandler.ExportArticles)
 		articlesAdmin.POST("/import", r.articleHandler.ImportArticles)
 		// 批量删除文章（仅管理员可用）
-		articlesAdmin.DELETE("/batch", r.articleHandler.BatchDelete)
+		articlesAdmin.DELETE("/batch", r.mw.Audit("article.batch_delete", "article"), r.articleHandler.BatchDelete)
 	}
 
 	articlesPublic := api.Group("/public/articles")
@@ -366,7 +408,7 @@ func (r *Router) registerArticleRoutes(api *gin.RouterGroup) {
 
 func (r *Router) registerThumbnailRoutes(api *gin.RouterGroup) {
 	// 预览/缩略图的获取需要登录，以保护私有文件
-	thumbnail := api.Group("/thumbnail").Use(r.mw.JWTAuth())
+	thumbnail := api.Group("/thumbnail").Use(r.mw.JWTAuth(model.ScopeFilesRead, model.ScopeFilesWrite))
 	{
 
 		// 手动重新生成缩略图的接口
@@ -391,12 +433,41 @@ func (r *Router) registerAuthRoutes(api *gin.RouterGroup) {
 		auth.POST("/forgot-password", r.authHandler.ForgotPasswordRequest)
 		auth.POST("/reset-password", r.authHandler.ResetPassword)
 		auth.GET("/check-email", r.authHandler.CheckEmail)
+		// 两步验证登录
+		auth.POST("/2fa/setup", r.authHandler.TwoFactorSetup)
+		auth.POST("/2fa/login", r.authHandler.TwoFactorLogin)
+		// 通行密钥登录
+		auth.POST("/passkey/login/begin", r.authHandler.PasskeyLoginBegin)
+		auth.POST("/passkey/login/finish", r.authHandler.PasskeyLoginFinish)
+		// 第三方登录
+		auth.GET("/oauth/providers", r.authHandler.ListOAuthProviders)
+		auth.POST("/oauth/exchange", r.authHandler.OAuthExchange)
+		auth.POST("/oauth/:provider/authorize", r.authHandler.OAuthAuthorize)
+		auth.GET("/oauth/:provider/callback", r.authHandler.OAuthCallback)
+	}
+
+	// 通行密钥管理（需要登录）
+	passkeys := api.Group("/user/passkeys").Use(r.mw.JWTAuth())
+	{
+		passkeys.GET("", r.authHandler.ListPasskeys)
+		passkeys.POST("/register/begin", r.authHandler.PasskeyRegisterBegin)
+		passkeys.POST("/register/finish", r.authHandler.PasskeyRegisterFinish)
+		passkeys.PUT("/:id", r.authHandler.RenamePasskey)
+		passkeys.DELETE("/:id", r.authHandler.DeletePasskey)
+	}
+
+	// 第三方账号绑定（需要登录）
+	oauthIdentities := api.Group("/user/oauth").Use(r.mw.JWTAuth())
+	{
+		oauthIdentities.POST("/:provider/link", r.authHandler.LinkOAuthIdentity)
+		oauthIdentities.GET("/identities", r.authHandler.ListOAuthIdentities)
+		oauthIdentities.DELETE("/identities/:id", r.authHandler.UnlinkOAuthIdentity)
 	}
 }
 
 // registerAlbumRoutes 注册相册相关的路由 (后台管理)
 func (r *Router) registerAlbumRoutes(api *gin.RouterGroup) {
-	albums := api.Group("/albums").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	albums := api.Group("/albums").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionAlbumManage))
 	{
 		albums.GET("/get", r.albumHandler.GetAlbums)
 		albums.POST("/add", r.albumHandler.AddAlbum)
@@ -412,7 +483,7 @@ func (r *Router) registerAlbumRoutes(api *gin.RouterGroup) {
 
 // registerAlbumCategoryRoutes 注册相册分类相关的路由
 func (r *Router) registerAlbumCategoryRoutes(api *gin.RouterGroup) {
-	albumCategories := api.Group("/album-categories").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	albumCategories := api.Group("/album-categories").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionAlbumManage))
 	{
 		albumCategories.POST("", r.albumCategoryHandler.CreateCategory)       // POST /api/album-categories
 		albumCategories.GET("", r.albumCategoryHandler.ListCategories)        // GET /api/album-categories
@@ -429,10 +500,10 @@ func (r *Router) registerSettingRoutes(api *gin.RouterGroup) {
 	{
 		settings.POST("/get-by-keys", r.settingHandler.GetSettingsByKeys)
 	}
-	// 更新配置和测试邮件需要管理员权限
-	settingsAdmin := api.Group("/settings").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	// 更新配置和测试邮件需要站点配置权限
+	settingsAdmin := api.Group("/settings").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionSettingManage))
 	{
-		settingsAdmin.POST("/update", r.settingHandler.UpdateSettings)
+		settingsAdmin.POST("/update", r.mw.Audit("setting.update", "setting"), r.settingHandler.UpdateSettings)
 		settingsAdmin.POST("/test-email", r.settingHandler.TestEmail)
 	}
 }
@@ -446,6 +517,27 @@ func (r *Router) registerUserRoutes(api *gin.RouterGroup) {
 		user.POST("/update-password", r.userHandler.UpdateUserPassword)
 		user.PUT("/profile", r.userHandler.UpdateUserProfile)
 		user.POST("/avatar", r.userHandler.UploadAvatar)
+		// 两步验证
+		user.GET("/2fa", r.userHandler.GetTwoFactorStatus)
+		user.POST("/2fa/setup", r.userHandler.SetupTwoFactor)
+		user.POST("/2fa/enable", r.userHandler.EnableTwoFactor)
+		user.POST("/2fa/disable", r.userHandler.DisableTwoFactor)
+		user.POST("/2fa/recovery-codes", r.userHandler.RegenerateRecoveryCodes)
+		// 个人访问令牌（只能使用会话令牌管理，访问令牌不能创建新的令牌）
+		user.GET("/access-tokens", r.userHandler.ListAccessTokens)
+		user.GET("/access-tokens/scopes", r.userHandler.ListAccessTokenScopes)
+		user.POST("/access-tokens", r.userHandler.CreateAccessToken)
+		user.DELETE("/access-tokens/:id", r.userHandler.RevokeAccessToken)
+		// 登录会话管理
+		user.GET("/sessions", r.userHandler.ListSessions)
+		user.DELETE("/sessions", r.userHandler.RevokeOtherSessions)
+		user.DELETE("/sessions/:id", r.userHandler.RevokeSession)
+		user.POST("/logout", r.userHandler.Logout)
+		// 个人数据导出和账户注销
+		user.GET("/data-export", r.accountHandler.Export)
+		user.GET("/deletion", r.accountHandler.GetDeletion)
+		user.POST("/deletion", r.accountHandler.RequestDeletion)
+		user.DELETE("/deletion", r.accountHandler.CancelDeletion)
 	}
 
 	// 管理员用户管理路由（需要登录且为管理员）
@@ -454,15 +546,21 @@ func (r *Router) registerUserRoutes(api *gin.RouterGroup) {
 		// 用户列表
 		adminUsers.GET("", r.userHandler.AdminListUsers)
 		// 创建用户
-		adminUsers.POST("", r.userHandler.AdminCreateUser)
+		adminUsers.POST("", r.mw.Audit("user.create", "user"), r.userHandler.AdminCreateUser)
 		// 更新用户
-		adminUsers.PUT("/:id", r.userHandler.AdminUpdateUser)
+		adminUsers.PUT("/:id", r.mw.Audit("user.update", "user"), r.userHandler.AdminUpdateUser)
 		// 删除用户
-		adminUsers.DELETE("/:id", r.userHandler.AdminDeleteUser)
+		adminUsers.DELETE("/:id", r.mw.Audit("user.delete", "user"), r.userHandler.AdminDeleteUser)
 		// 重置密码
-		adminUsers.POST("/:id/reset-password", r.userHandler.AdminResetPassword)
+		adminUsers.POST("/:id/reset-password", r.mw.Audit("user.reset_password", "user"), r.userHandler.AdminResetPassword)
 		// 更新用户状态
-		adminUsers.PUT("/:id/status", r.userHandler.AdminUpdateUserStatus)
+		adminUsers.PUT("/:id/status", r.mw.Audit("user.update_status", "user"), r.userHandler.AdminUpdateUserStatus)
+		// 重置两步验证
+		adminUsers.DELETE("/:id/two-factor", r.mw.Audit("user.reset_two_factor", "user"), r.userHandler.AdminResetTwoFactor)
+		// 撤销全部个人访问令牌
+		adminUsers.DELETE("/:id/access-tokens", r.mw.Audit("user.revoke_access_tokens", "user"), r.userHandler.AdminRevokeAccessTokens)
+		// 强制下线
+		adminUsers.DELETE("/:id/sessions", r.mw.Audit("user.revoke_sessions", "user"), r.userHandler.AdminRevokeSessions)
 	}
 
 	// 用户组管理路由（需要登录且为管理员）
@@ -470,6 +568,28 @@ func (r *Router) registerUserRoutes(api *gin.RouterGroup) {
 	{
 		// 获取用户组列表
 		adminUserGroups.GET("", r.userHandler.GetUserGroups)
+		// 获取可授予的权限位
+		adminUserGroups.GET("/permissions", r.userHandler.ListPermissions)
+		// 编辑用户组
+		adminUserGroups.PUT("/:id", r.mw.Audit("user_group.update", "user_group"), r.userHandler.AdminUpdateUserGroup)
+		// 设置是否强制两步验证
+		adminUserGroups.PUT("/:id/two-factor", r.mw.Audit("user_group.update_two_factor", "user_group"), r.userHandler.AdminSetGroupTwoFactor)
+	}
+
+	// 注册邀请码管理路由（需要登录且为管理员）
+	adminInvitations := api.Group("/admin/invitations").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		adminInvitations.GET("", r.userHandler.AdminListInvitations)
+		adminInvitations.POST("", r.mw.Audit("invitation.create", "invitation"), r.userHandler.AdminCreateInvitation)
+		adminInvitations.POST("/email", r.mw.Audit("invitation.email", "invitation"), r.userHandler.AdminSendInvitation)
+		adminInvitations.DELETE("/:id", r.mw.Audit("invitation.revoke", "invitation"), r.userHandler.AdminRevokeInvitation)
+	}
+
+	// 登录锁定管理路由（需要登录且为管理员）
+	adminLockouts := api.Group("/admin/lockouts").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		adminLockouts.GET("", r.authHandler.AdminListLockouts)
+		adminLockouts.DELETE("", r.mw.Audit("lockout.clear", "lockout"), r.authHandler.AdminClearLockout)
 	}
 }
 
@@ -498,13 +618,15 @@ func (r *Router) registerPublicRoutes(api *gin.RouterGroup) {
 func (r *Router) registerStoragePolicyRoutes(api *gin.RouterGroup) {
 	policies := api.Group("/policies").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
 	{
-		policies.POST("", r.storagePolicyHandler.Create)
+		policies.POST("", r.mw.Audit("storage_policy.create", "storage_policy"), r.storagePolicyHandler.Create)
 		policies.GET("", r.storagePolicyHandler.List)
 		policies.GET("/connect/onedrive/:id", r.storagePolicyHandler.ConnectOneDrive)
-		policies.POST("/authorize/onedrive", r.storagePolicyHandler.AuthorizeOneDrive)
+		policies.POST("/authorize/onedrive", r.mw.Audit("storage_policy.authorize", "storage_policy"), r.storagePolicyHandler.AuthorizeOneDrive)
 		policies.GET("/:id", r.storagePolicyHandler.Get)
-		policies.PUT("/:id", r.storagePolicyHandler.Update)
-		policies.DELETE("/:id", r.storagePolicyHandler.Delete)
+		policies.PUT("/:id", r.mw.Audit("storage_policy.update", "storage_policy"), r.storagePolicyHandler.Update)
+		policies.DELETE("/:id", r.mw.Audit("storage_policy.delete", "storage_policy"), r.storagePolicyHandler.Delete)
+		policies.POST("/:id/migrate", r.mw.Audit("storage_policy.migrate", "storage_policy"), r.storagePolicyHandler.Migrate)
+		policies.GET("/:id/migration", r.storagePolicyHandler.GetMigrationStatus)
 	}
 }
 
@@ -518,7 +640,7 @@ func (r *Router) registerFileRoutes(api *gin.RouterGroup) {
 	// 获取文件内容
 	filesGroup.GET("/content", r.fileHandler.ServeSignedContent)
 
-	filesGroup.Use(r.mw.JWTAuth())
+	filesGroup.Use(r.mw.JWTAuth(model.ScopeFilesRead, model.ScopeFilesWrite))
 	{
 		// 获取文件列表
 		filesGroup.GET("", r.fileHandler.GetFilesByPath)
@@ -531,18 +653,36 @@ func (r *Router) registerFileRoutes(api *gin.RouterGroup) {
 		filesGroup.PUT("/content/:publicID", r.fileHandler.UpdateFileContentByID)
 		// Delete /api/file/?ids=...
 		filesGroup.DELETE("", r.fileHandler.DeleteItems)
+		// 回收站：列表通过 GET /api/file?uri=anzhiyu://trash/ 获取
+		filesGroup.POST("/trash/restore", r.fileHandler.RestoreItems)
+		filesGroup.DELETE("/trash", r.fileHandler.EmptyTrash)
 		// PUT /api/file/rename
 		filesGroup.PUT("/rename", r.fileHandler.RenameItem)
 
 		// 获取文件夹的预览图像URL
 		// 这个接口用于获取文件夹内所有图片的预览图像URL
 		filesGroup.GET("/preview-urls", r.fileHandler.GetPreviewURLs)
+
+		// 文件历史版本
+		filesGroup.GET("/versions/:id", r.fileHandler.ListFileVersions)
+		filesGroup.GET("/versions/:id/:versionID/download", r.fileHandler.DownloadFileVersion)
+		filesGroup.POST("/versions/:id/:versionID/restore", r.fileHandler.RestoreFileVersion)
+		filesGroup.DELETE("/versions/:id/:versionID", r.fileHandler.DeleteFileVersion)
+
+		// 存储用量
+		filesGroup.GET("/storage", r.fileHandler.GetStorageUsage)
+		filesGroup.POST("/storage/recalculate", r.fileHandler.RecalculateStorageUsage)
+
+		// 打包下载与服务端解压
+		filesGroup.POST("/archive/download", r.fileHandler.DownloadZip)
+		filesGroup.POST("/archive/extract", r.fileHandler.ExtractArchive)
+		filesGroup.GET("/archive/extract/:jobID", r.fileHandler.GetExtractStatus)
 	}
 
 	// --- 文件上传路由 ---
 	// 上传相关操作也只需要JWT认证，具体权限由Handler处理
 	uploadGroup := filesGroup.Group("/upload")
-	uploadGroup.Use(r.mw.JWTAuth())
+	uploadGroup.Use(r.mw.JWTAuth(model.ScopeFilesRead, model.ScopeFilesWrite))
 	{
 		// 创建上传会话
 		// PUT /api/file/upload
@@ -567,7 +707,7 @@ func (r *Router) registerFileRoutes(api *gin.RouterGroup) {
 
 	// --- 文件夹专属路由组 ---
 	folderGroup := api.Group("/folder")
-	folderGroup.Use(r.mw.JWTAuth())
+	folderGroup.Use(r.mw.JWTAuth(model.ScopeFilesRead, model.ScopeFilesWrite))
 	{
 		folderGroup.PUT("/view", r.fileHandler.UpdateFolderView)
 		folderGroup.GET("/tree/:id", r.fileHandler.GetFolderTree)
@@ -579,7 +719,7 @@ func (r *Router) registerFileRoutes(api *gin.RouterGroup) {
 
 func (r *Router) registerDirectLinkRoutes(api *gin.RouterGroup) {
 	// 这些操作需要用户登录，所以使用JWTAuth中间件
-	directLinks := api.Group("/direct-links").Use(r.mw.JWTAuth())
+	directLinks := api.Group("/direct-links").Use(r.mw.JWTAuth(model.ScopeFilesWrite))
 	{
 		// 注册创建直链的接口： POST /api/direct-links
 		directLinks.POST("", r.directLinkHandler.GetOrCreateDirectLinks)
@@ -616,7 +756,7 @@ func (r *Router) registerLinkRoutes(api *gin.RouterGroup) {
 	}
 
 	// --- 后台管理接口 ---
-	linksAdmin := api.Group("/links").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	linksAdmin := api.Group("/links").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionLinkManage))
 	{
 		// 友链管理
 		linksAdmin.POST("", r.linkHandler.AdminCreateLink)                         // POST /api/links
@@ -656,7 +796,7 @@ func (r *Router) registerStatisticsRoutes(api *gin.RouterGroup) {
 	}
 
 	// --- 后台管理接口 ---
-	statisticsAdmin := api.Group("/statistics").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	statisticsAdmin := api.Group("/statistics").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionStatisticsView))
 	{
 		// 获取访客分析数据: GET /api/statistics/analytics
 		statisticsAdmin.GET("/analytics", r.statisticsHandler.GetVisitorAnalytics)
@@ -719,32 +859,36 @@ func (r *Router) registerThemeRoutes(api *gin.RouterGroup) {
 		themePublic.GET("/static-mode", r.themeHandler.CheckStaticMode)
 	}
 
-	// 需要登录的主题管理接口
+	// 需要登录的主题查询接口
 	themeAuth := api.Group("/theme").Use(r.mw.JWTAuth())
 	{
 		// 获取当前主题: GET /api/theme/current
 		themeAuth.GET("/current", r.themeHandler.GetCurrentTheme)
+	}
 
+	// 主题管理接口，需要主题管理权限
+	themeManage := api.Group("/theme").Use(r.mw.JWTAuth(), r.mw.RequirePermission(model.PermissionThemeManage))
+	{
 		// 获取已安装主题列表: GET /api/theme/installed
-		themeAuth.GET("/installed", r.themeHandler.GetInstalledThemes)
+		themeManage.GET("/installed", r.themeHandler.GetInstalledThemes)
 
 		// 安装主题: POST /api/theme/install
-		themeAuth.POST("/install", r.themeHandler.InstallTheme)
+		themeManage.POST("/install", r.mw.Audit("theme.install", "theme"), r.themeHandler.InstallTheme)
 
 		// 上传主题: POST /api/theme/upload
-		themeAuth.POST("/upload", r.themeHandler.UploadTheme)
+		themeManage.POST("/upload", r.themeHandler.UploadTheme)
 
 		// 验证主题: POST /api/theme/validate
-		themeAuth.POST("/validate", r.themeHandler.ValidateTheme)
+		themeManage.POST("/validate", r.themeHandler.ValidateTheme)
 
 		// 切换主题: POST /api/theme/switch
-		themeAuth.POST("/switch", r.themeHandler.SwitchTheme)
+		themeManage.POST("/switch", r.mw.Audit("theme.switch", "theme"), r.themeHandler.SwitchTheme)
 
 		// 切换到官方主题: POST /api/theme/official
-		themeAuth.POST("/official", r.themeHandler.SwitchToOfficial)
+		themeManage.POST("/official", r.mw.Audit("theme.switch", "theme"), r.themeHandler.SwitchToOfficial)
 
 		// 卸载主题: POST /api/theme/uninstall
-		themeAuth.POST("/uninstall", r.themeHandler.UninstallTheme)
+		themeManage.POST("/uninstall", r.mw.Audit("theme.uninstall", "theme"), r.themeHandler.UninstallTheme)
 	}
 }
 
@@ -779,6 +923,36 @@ func (r *Router) registerSitemapRoutes(engine *gin.Engine) {
 	engine.GET("/robots.txt", r.sitemapHandler.GetRobots)
 }
 
+// registerShareRoutes 注册文件分享相关路由
+func (r *Router) registerShareRoutes(api *gin.RouterGroup) {
+	// 分享者管理自己的分享链接
+	shares := api.Group("/shares").Use(r.mw.JWTAuth(model.ScopeFilesWrite), r.mw.RequirePermission(model.PermissionCreateShare))
+	{
+		shares.GET("", r.shareHandler.ListShares)
+		shares.POST("", r.shareHandler.CreateShare)
+		shares.PUT("/:id", r.shareHandler.UpdateShare)
+		shares.DELETE("/:id", r.shareHandler.DeleteShare)
+	}
+
+	// 访问者打开分享链接，未登录时按匿名用户组的权限判断
+	sharePublic := api.Group("/share").Use(r.mw.JWTAuthOptional())
+	{
+		sharePublic.GET("/:id", r.shareHandler.GetShareInfo)
+		sharePublic.GET("/:id/files", r.shareHandler.ListShareFiles)
+		sharePublic.GET("/:id/download", r.shareHandler.DownloadShareFile)
+		sharePublic.GET("/:id/preview", r.shareHandler.PreviewShareFile)
+	}
+}
+
+// registerWebDAVRoutes 注册 WebDAV 端点，认证由处理器通过 Basic Auth 完成
+func (r *Router) registerWebDAVRoutes(engine *gin.Engine) {
+	for _, method := range webdav_handler.Methods {
+		// /dav 和 /dav/* - 挂载用户的文件根目录
+		engine.Handle(method, webdav_handler.Prefix, r.webdavHandler.ServeDAV)
+		engine.Handle(method, webdav_handler.Prefix+"/*path", r.webdavHandler.ServeDAV)
+	}
+}
+
 // registerVersionRoutes 注册版本信息相关路由
 func (r *Router) registerVersionRoutes(api *gin.RouterGroup) {
 	// 版本信息路由 - 公开接口，不需要认证
@@ -824,7 +998,7 @@ func (r *Router) registerConfigBackupRoutes(api *gin.RouterGroup) {
 		configBackupGroup.GET("/list", r.configBackupHandler.ListBackups)
 
 		// 恢复备份
-		configBackupGroup.POST("/restore", r.configBackupHandler.RestoreBackup)
+		configBackupGroup.POST("/restore", r.mw.Audit("config.restore", "config"), r.configBackupHandler.RestoreBackup)
 
 		// 删除备份
 		configBackupGroup.POST("/delete", r.configBackupHandler.DeleteBackup)
@@ -840,7 +1014,7 @@ func (r *Router) registerConfigBackupRoutes(api *gin.RouterGroup) {
 		configGroup.GET("/export", r.configImportExportHandler.ExportConfig)
 
 		// 导入配置
-		configGroup.POST("/import", r.configImportExportHandler.ImportConfig)
+		configGroup.POST("/import", r.mw.Audit("config.import", "config"), r.configImportExportHandler.ImportConfig)
 	}
 }
 
@@ -905,3 +1079,38 @@ func (r *Router) registerFCircleRoutes(api *gin.RouterGroup) {
 		fcircle.GET("/post", r.fcircleHandler.GetFriendPosts)
 	}
 }
+
+// registerBlocklistRoutes 注册黑名单管理相关的路由
+func (r *Router) registerBlocklistRoutes(api *gin.RouterGroup) {
+	blocklistAdmin := api.Group("/blocklist").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		blocklistAdmin.GET("", r.blocklistHandler.List)
+		blocklistAdmin.POST("", r.blocklistHandler.Create)
+		blocklistAdmin.PUT("/:id", r.blocklistHandler.Update)
+		blocklistAdmin.DELETE("/:id", r.blocklistHandler.Delete)
+	}
+}
+
+// registerWebmentionRoutes 注册 Webmention 相关的路由
+func (r *Router) registerWebmentionRoutes(api *gin.RouterGroup) {
+	webmentionPublic := api.Group("/public")
+	{
+		webmentionPublic.POST("/webmention", r.webmentionHandler.Receive)    // W3C Webmention 接收端点
+		webmentionPublic.GET("/webmentions", r.webmentionHandler.ListByPath) // 指定文章下已校验的 Webmention
+	}
+
+	webmentionAdmin := api.Group("/webmentions").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		webmentionAdmin.GET("", r.webmentionHandler.List)
+		webmentionAdmin.DELETE("/:id", r.webmentionHandler.Delete)
+	}
+}
+
+// registerAuditRoutes 注册审计日志相关的路由
+func (r *Router) registerAuditRoutes(api *gin.RouterGroup) {
+	auditAdmin := api.Group("/admin/audit-logs").Use(r.mw.JWTAuth(), r.mw.AdminAuth())
+	{
+		auditAdmin.GET("", r.auditHandler.List)
+		auditAdmin.GET("/export", r.auditHandler.Export)
+	}
+}
@@ -0,0 +1,70 @@
+// internal/infra/storage/proxied.go
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// sniffSize 是上传时为检测 MIME 类型和图片尺寸缓存的文件头大小
+const sniffSize = 64 * 1024
+
+// signedProxyDownloadURL 生成一个指向服务端签名下载接口的临时链接。
+// 用于 SFTP、WebDAV 这类需要凭据才能访问的存储，文件内容由服务端读取后中转给客户端。
+func signedProxyDownloadURL(signingSecret string, options DownloadURLOptions) (string, error) {
+	if options.PublicID == "" {
+		return "", errors.New("生成中转下载链接需要文件公共ID")
+	}
+	if signingSecret == "" {
+		return "", errors.New("签名密钥未提供给存储提供者")
+	}
+	expiresIn := options.ExpiresIn
+	if expiresIn <= 0 {
+		expiresIn = 3600 // 默认1小时
+	}
+	expires := time.Now().Add(time.Duration(expiresIn) * time.Second).Unix()
+	stringToSign := fmt.Sprintf("%s:%d", options.PublicID, expires)
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	mac.Write([]byte(stringToSign))
+	signature := base64.URLEncoding.EncodeToString(mac.Sum(nil))
+	downloadURL := fmt.Sprintf(
+		"/needcache/download/%s?expires=%d&sign=%s",
+		url.PathEscape(options.PublicID),
+		expires,
+		url.QueryEscape(signature),
+	)
+	return downloadURL, nil
+}
+
+// sniffUpload 在不落盘的前提下读取文件头，检测 MIME 类型和图片尺寸。
+// 返回的 reader 仍包含完整的文件内容，调用方应继续从它读取。
+func sniffUpload(file io.Reader, virtualPath string) (reader *bufio.Reader, mimeType string, dimension string, err error) {
+	reader = bufio.NewReaderSize(file, sniffSize)
+	header, err := reader.Peek(sniffSize)
+	if err != nil && err != io.EOF && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, "", "", fmt.Errorf("读取文件头以检测MIME类型失败: %w", err)
+	}
+	header = bytes.Clone(header)
+
+	mimeType = http.DetectContentType(header)
+	// http.DetectContentType 会将 SVG 识别为 text/plain 或 text/xml，需要根据扩展名修正
+	if strings.ToLower(path.Ext(virtualPath)) == ".svg" {
+		mimeType = "image/svg+xml"
+	}
+	if imgConfig, _, err := image.DecodeConfig(bytes.NewReader(header)); err == nil {
+		dimension = fmt.Sprintf("%dx%d", imgConfig.Width, imgConfig.Height)
+	}
+	return reader, mimeType, dimension, nil
+}
@@ -0,0 +1,617 @@
+// internal/infra/storage/sftp.go
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// sftpDefaultPort 是 Server 字段未指定端口时使用的 SSH 端口
+	sftpDefaultPort = "22"
+	// sftpDefaultMaxConnections 是每个策略默认的最大连接数
+	sftpDefaultMaxConnections = 4
+	// sftpDialTimeout 是建立 SSH 连接和完成握手的超时时间
+	sftpDialTimeout = 15 * time.Second
+)
+
+// SFTPProvider 实现了 IStorageProvider 接口，通过 SFTP 将文件保存到远程服务器。
+// 每个存储策略维护一个独立的连接池，空闲连接会被复用，断开的连接会被自动丢弃。
+type SFTPProvider struct {
+	signingSecret string
+
+	mu    sync.Mutex
+	pools map[uint]*sftpPool
+}
+
+// NewSFTPProvider 是 SFTPProvider 的构造函数，接收一个用于下载链接签名的密钥。
+func NewSFTPProvider(signingSecret string) IStorageProvider {
+	return &SFTPProvider{
+		signingSecret: signingSecret,
+		pools:         make(map[uint]*sftpPool),
+	}
+}
+
+// SFTPRemotePath 将虚拟路径转换为远程服务器上的路径，即 SFTP 策略中文件 Source 字段的值。
+func SFTPRemotePath(policy *model.StoragePolicy, virtualPath string) string {
+	relativePath := strings.TrimPrefix(virtualPath, policy.VirtualPath)
+	return path.Join(policy.BasePath, relativePath)
+}
+
+// sftpConn 是连接池中的一条连接，users 和 removed 由所属连接池的 mu 保护
+type sftpConn struct {
+	ssh    *ssh.Client
+	client *sftp.Client
+	closed atomic.Bool
+
+	users   int  // 正在使用该连接的操作数，包括尚未关闭的读取器
+	removed bool // 已从连接池中移除，最后一个使用者归还后关闭
+}
+
+func (c *sftpConn) close() {
+	c.closed.Store(true)
+	c.client.Close()
+	c.ssh.Close()
+}
+
+// sftpPool 是单个存储策略的连接池，最多建立 maxConns 条连接。
+// sftp.Client 可以被并发使用，连接在操作之间共享而不是被独占，
+// 所以持有读取器的调用方再发起上传等操作时不会因为等待连接而阻塞
+type sftpPool struct {
+	key      string
+	addr     string
+	config   *ssh.ClientConfig
+	maxConns int
+
+	mu       sync.Mutex
+	conns    []*sftpConn
+	dialing  int           // 正在建立的连接数
+	dialDone chan struct{} // 每次建立连接结束时关闭并替换，用于等待正在建立的连接
+	retired  bool
+}
+
+// acquire 取出一条连接供一次操作使用，用完后必须调用 release。
+// 优先使用空闲连接，全部连接都在使用中且未达到上限时新建连接，否则与其他操作共享最空闲的连接
+func (p *sftpPool) acquire(ctx context.Context) (*sftpConn, error) {
+	for {
+		p.mu.Lock()
+		p.pruneLocked()
+		full := len(p.conns)+p.dialing >= p.maxConns
+		if conn := p.leastBusyLocked(); conn != nil && (conn.users == 0 || full) {
+			conn.users++
+			p.mu.Unlock()
+			return conn, nil
+		}
+		if !full {
+			p.dialing++
+			p.mu.Unlock()
+			break
+		}
+		// 连接数已达上限但都还在建立中，等待其中一条建立完成
+		wait := p.dialDone
+		p.mu.Unlock()
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	conn, err := p.dial(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.dialing--
+	close(p.dialDone)
+	p.dialDone = make(chan struct{})
+	if err != nil {
+		// 已有其他可用连接时改为共享，避免因为额外的连接建立失败而中断操作
+		if shared := p.leastBusyLocked(); shared != nil {
+			shared.users++
+			return shared, nil
+		}
+		return nil, err
+	}
+	conn.users = 1
+	// 已被淘汰的连接池不再保留新连接，本次操作结束后关闭
+	conn.removed = p.retired
+	if !conn.removed {
+		p.conns = append(p.conns, conn)
+	}
+	return conn, nil
+}
+
+// release 归还连接；操作出错且连接已断开时把该连接移出连接池，最后一个使用者归还后关闭
+func (p *sftpPool) release(conn *sftpConn, opErr error) {
+	broken := conn.closed.Load() || errors.Is(opErr, sftp.ErrSSHFxConnectionLost) || errors.Is(opErr, io.ErrUnexpectedEOF)
+	p.mu.Lock()
+	conn.users--
+	if broken && !conn.removed {
+		p.removeLocked(conn)
+	}
+	closeNow := conn.removed && conn.users == 0
+	p.mu.Unlock()
+	if closeNow {
+		conn.close()
+	}
+}
+
+// retire 淘汰连接池，未被使用的连接立即关闭，使用中的连接在归还时关闭，用于策略配置变更后替换旧连接池
+func (p *sftpPool) retire() {
+	p.mu.Lock()
+	p.retired = true
+	var idle []*sftpConn
+	for _, conn := range p.conns {
+		conn.removed = true
+		if conn.users == 0 {
+			idle = append(idle, conn)
+		}
+	}
+	p.conns = nil
+	p.mu.Unlock()
+	for _, conn := range idle {
+		conn.close()
+	}
+}
+
+// pruneLocked 移除已被服务器关闭或网络中断的连接，调用方须持有 mu
+func (p *sftpPool) pruneLocked() {
+	for _, conn := range append([]*sftpConn(nil), p.conns...) {
+		if conn.closed.Load() {
+			p.removeLocked(conn)
+			if conn.users == 0 {
+				go conn.close()
+			}
+		}
+	}
+}
+
+// removeLocked 把连接移出连接池，之后不会再被取出，调用方须持有 mu
+func (p *sftpPool) removeLocked(conn *sftpConn) {
+	conn.removed = true
+	for i, c := range p.conns {
+		if c == conn {
+			p.conns = append(p.conns[:i], p.conns[i+1:]...)
+			return
+		}
+	}
+}
+
+// leastBusyLocked 返回使用者最少的连接，没有连接时返回 nil，调用方须持有 mu
+func (p *sftpPool) leastBusyLocked() *sftpConn {
+	var best *sftpConn
+	for _, conn := range p.conns {
+		if best == nil || conn.users < best.users {
+			best = conn
+		}
+	}
+	return best
+}
+
+func (p *sftpPool) dial(ctx context.Context) (*sftpConn, error) {
+	dialer := net.Dialer{Timeout: sftpDialTimeout}
+	netConn, err := dialer.DialContext(ctx, "tcp", p.addr)
+	if err != nil {
+		return nil, fmt.Errorf("无法连接 SFTP 服务器 '%s': %w", p.addr, err)
+	}
+	netConn.SetDeadline(time.Now().Add(sftpDialTimeout))
+	sshConn, chans, reqs, err := ssh.NewClientConn(netConn, p.addr, p.config)
+	if err != nil {
+		netConn.Close()
+		return nil, fmt.Errorf("SSH 握手失败 '%s': %w", p.addr, err)
+	}
+	netConn.SetDeadline(time.Time{})
+
+	sshClient := ssh.NewClient(sshConn, chans, reqs)
+	sftpClient, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+		return nil, fmt.Errorf("无法在 '%s' 上启动 SFTP 子系统: %w", p.addr, err)
+	}
+
+	conn := &sftpConn{ssh: sshClient, client: sftpClient}
+	go func() {
+		// 连接被服务器关闭或网络中断时标记为不可用，避免再被取出使用
+		sshClient.Wait()
+		conn.closed.Store(true)
+	}()
+	return conn, nil
+}
+
+// sftpPoolKey 汇总了影响连接的全部配置，配置变化时连接池需要重建
+func sftpPoolKey(policy *model.StoragePolicy) string {
+	h := sha256.New()
+	for _, part := range []string{
+		policy.Server,
+		policy.AccessKey,
+		policy.SecretKey,
+		policy.Settings.GetString(constant.SFTPPrivateKeySettingKey, ""),
+		policy.Settings.GetString(constant.SFTPPrivateKeyPassphraseSettingKey, ""),
+		policy.Settings.GetString(constant.SFTPHostKeyFingerprintSettingKey, ""),
+		fmt.Sprint(policy.Settings.GetInt(constant.SFTPMaxConnectionsSettingKey, sftpDefaultMaxConnections)),
+	} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// newSFTPPool 根据存储策略构建连接池：Server 为 host[:port]，AccessKey 为用户名，
+// SecretKey 为密码，settings 中可配置私钥、主机公钥指纹和最大连接数
+func newSFTPPool(policy *model.StoragePolicy, key string) (*sftpPool, error) {
+	if policy.Server == "" || policy.AccessKey == "" {
+		return nil, errors.New("SFTP 策略缺少服务器地址或用户名")
+	}
+	addr := policy.Server
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, sftpDefaultPort)
+	}
+
+	var auths []ssh.AuthMethod
+	if pemKey := policy.Settings.GetString(constant.SFTPPrivateKeySettingKey, ""); pemKey != "" {
+		signer, err := parseSFTPPrivateKey(pemKey, policy.Settings.GetString(constant.SFTPPrivateKeyPassphraseSettingKey, ""))
+		if err != nil {
+			return nil, err
+		}
+		auths = append(auths, ssh.PublicKeys(signer))
+	}
+	if policy.SecretKey != "" {
+		auths = append(auths, ssh.Password(policy.SecretKey))
+	}
+	if len(auths) == 0 {
+		return nil, errors.New("SFTP 策略需要配置密码或私钥")
+	}
+
+	// 不信任任何未经确认的主机公钥，未配置指纹时拒绝连接，并在错误中给出服务器实际的指纹供管理员核对后填写
+	fingerprint := policy.Settings.GetString(constant.SFTPHostKeyFingerprintSettingKey, "")
+	hostKeyCallback := func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		actual := ssh.FingerprintSHA256(key)
+		if fingerprint == "" {
+			return fmt.Errorf("SFTP 策略 '%s' 未配置主机公钥指纹，服务器 %s 提供的指纹为 %s，请核对后填入策略设置", policy.Name, hostname, actual)
+		}
+		if actual != fingerprint {
+			return fmt.Errorf("SFTP 服务器 %s 的主机公钥指纹 %s 与配置的 %s 不一致", hostname, actual, fingerprint)
+		}
+		return nil
+	}
+
+	maxConns := policy.Settings.GetInt(constant.SFTPMaxConnectionsSettingKey, sftpDefaultMaxConnections)
+	if maxConns <= 0 {
+		maxConns = sftpDefaultMaxConnections
+	}
+
+	return &sftpPool{
+		key:  key,
+		addr: addr,
+		config: &ssh.ClientConfig{
+			User:            policy.AccessKey,
+			Auth:            auths,
+			HostKeyCallback: hostKeyCallback,
+			Timeout:         sftpDialTimeout,
+		},
+		maxConns: maxConns,
+		dialDone: make(chan struct{}),
+	}, nil
+}
+
+// parseSFTPPrivateKey 解析 PEM 格式的 SSH 私钥，passphrase 为空时按未加密私钥解析
+func parseSFTPPrivateKey(pemKey, passphrase string) (ssh.Signer, error) {
+	var signer ssh.Signer
+	var err error
+	if passphrase != "" {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(pemKey), []byte(passphrase))
+	} else {
+		signer, err = ssh.ParsePrivateKey([]byte(pemKey))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("无法解析 SFTP 私钥: %w", err)
+	}
+	return signer, nil
+}
+
+// getPool 返回策略对应的连接池，策略的连接配置变化后会关闭旧连接池的空闲连接并重建
+func (p *SFTPProvider) getPool(policy *model.StoragePolicy) (*sftpPool, error) {
+	key := sftpPoolKey(policy)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pool, ok := p.pools[policy.ID]; ok {
+		if pool.key == key {
+			return pool, nil
+		}
+		pool.retire()
+		delete(p.pools, policy.ID)
+	}
+	pool, err := newSFTPPool(policy, key)
+	if err != nil {
+		return nil, err
+	}
+	p.pools[policy.ID] = pool
+	return pool, nil
+}
+
+// withClient 从连接池取出一条连接执行 fn，执行完毕后归还
+func (p *SFTPProvider) withClient(ctx context.Context, policy *model.StoragePolicy, fn func(client *sftp.Client) error) error {
+	pool, err := p.getPool(policy)
+	if err != nil {
+		return err
+	}
+	conn, err := pool.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	err = fn(conn.client)
+	pool.release(conn, err)
+	return err
+}
+
+// List 列出远程目录的内容，目录不存在时返回空列表。
+func (p *SFTPProvider) List(ctx context.Context, policy *model.StoragePolicy, virtualPath string) ([]FileInfo, error) {
+	remotePath := SFTPRemotePath(policy, virtualPath)
+	var result []FileInfo
+	err := p.withClient(ctx, policy, func(client *sftp.Client) error {
+		entries, err := client.ReadDirContext(ctx, remotePath)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				result = []FileInfo{}
+				return nil
+			}
+			return fmt.Errorf("无法读取 SFTP 目录 '%s': %w", remotePath, err)
+		}
+		result = make([]FileInfo, 0, len(entries))
+		for _, entry := range entries {
+			result = append(result, FileInfo{
+				Name:    entry.Name(),
+				Size:    entry.Size(),
+				IsDir:   entry.IsDir(),
+				ModTime: entry.ModTime(),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// sftpReadCloser 在关闭远程文件的同时归还连接
+type sftpReadCloser struct {
+	*sftp.File
+	once    sync.Once
+	release func()
+}
+
+func (r *sftpReadCloser) Close() error {
+	err := r.File.Close()
+	r.once.Do(r.release)
+	return err
+}
+
+// Get 打开远程文件，读取期间连接仍可被其他操作共享，调用方关闭返回的读取器后连接才会归还连接池。
+func (p *SFTPProvider) Get(ctx context.Context, policy *model.StoragePolicy, source string) (io.ReadCloser, error) {
+	pool, err := p.getPool(policy)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := pool.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	file, err := conn.client.Open(source)
+	if err != nil {
+		pool.release(conn, err)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("远程文件不存在: %s", source)
+		}
+		return nil, fmt.Errorf("无法打开远程文件 '%s': %w", source, err)
+	}
+	return &sftpReadCloser{
+		File:    file,
+		release: func() { pool.release(conn, nil) },
+	}, nil
+}
+
+// GetDownloadURL 生成一个指向服务端签名下载接口的临时链接，文件内容由服务端从 SFTP 读取后中转。
+func (p *SFTPProvider) GetDownloadURL(ctx context.Context, policy *model.StoragePolicy, source string, options DownloadURLOptions) (string, error) {
+	return signedProxyDownloadURL(p.signingSecret, options)
+}
+
+// Stream 将远程文件的内容写入给定的 io.Writer。
+func (p *SFTPProvider) Stream(ctx context.Context, policy *model.StoragePolicy, source string, writer io.Writer) error {
+	return p.withClient(ctx, policy, func(client *sftp.Client) error {
+		file, err := client.Open(source)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("远程文件不存在: %s", source)
+			}
+			return fmt.Errorf("无法打开远程文件 '%s': %w", source, err)
+		}
+		defer file.Close()
+		if _, err := io.Copy(writer, file); err != nil {
+			return fmt.Errorf("流式传输文件内容时发生错误: %w", err)
+		}
+		return nil
+	})
+}
+
+// CreateDirectory 在远程服务器上创建目录。
+func (p *SFTPProvider) CreateDirectory(ctx context.Context, policy *model.StoragePolicy, virtualPath string) error {
+	remotePath := SFTPRemotePath(policy, virtualPath)
+	return p.withClient(ctx, policy, func(client *sftp.Client) error {
+		if err := client.MkdirAll(remotePath); err != nil {
+			return fmt.Errorf("无法创建 SFTP 目录 '%s': %w", remotePath, err)
+		}
+		return nil
+	})
+}
+
+// Upload 将文件流写入远程服务器。内容先写到同目录下的隐藏临时文件，写完后再重命名为目标文件，
+// 避免覆盖上传中断时留下不完整的文件。
+func (p *SFTPProvider) Upload(ctx context.Context, file io.Reader, policy *model.StoragePolicy, virtualPath string) (*UploadResult, error) {
+	finalPath := SFTPRemotePath(policy, virtualPath)
+	finalDir := path.Dir(finalPath)
+
+	reader, mimeType, dimension, err := sniffUpload(file, virtualPath)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix := make([]byte, 8)
+	rand.Read(suffix)
+	tempPath := path.Join(finalDir, fmt.Sprintf(".%s.%s.uploading", path.Base(finalPath), hex.EncodeToString(suffix)))
+
+	var size int64
+	err = p.withClient(ctx, policy, func(client *sftp.Client) error {
+		if err := client.MkdirAll(finalDir); err != nil {
+			return fmt.Errorf("无法创建 SFTP 目录 '%s': %w", finalDir, err)
+		}
+		remoteFile, err := client.Create(tempPath)
+		if err != nil {
+			return fmt.Errorf("无法创建远程临时文件 '%s': %w", tempPath, err)
+		}
+		size, err = io.Copy(remoteFile, reader)
+		if closeErr := remoteFile.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			client.Remove(tempPath)
+			return fmt.Errorf("写入远程文件失败: %w", err)
+		}
+		if err := sftpReplace(client, tempPath, finalPath); err != nil {
+			client.Remove(tempPath)
+			return fmt.Errorf("无法将临时文件移动到 '%s': %w", finalPath, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UploadResult{
+		Source:    finalPath,
+		Size:      size,
+		MimeType:  mimeType,
+		Dimension: dimension,
+	}, nil
+}
+
+// sftpReplace 将 oldPath 移动到 newPath 并覆盖已存在的目标。
+// 优先使用 OpenSSH 的 posix-rename 扩展，服务器不支持时先删除目标再重命名。
+func sftpReplace(client *sftp.Client, oldPath, newPath string) error {
+	if _, ok := client.HasExtension("posix-rename@openssh.com"); ok {
+		return client.PosixRename(oldPath, newPath)
+	}
+	if err := client.Remove(newPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return client.Rename(oldPath, newPath)
+}
+
+// Delete 删除远程服务器上的一个或多个文件或目录，目录会连同其内容一起删除。
+func (p *SFTPProvider) Delete(ctx context.Context, policy *model.StoragePolicy, sources []string) error {
+	return p.withClient(ctx, policy, func(client *sftp.Client) error {
+		for _, source := range sources {
+			info, err := client.Lstat(source)
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					// 文件或目录已经不存在，静默处理
+					continue
+				}
+				if errors.Is(err, sftp.ErrSSHFxConnectionLost) {
+					return err
+				}
+				log.Printf("警告: 无法获取远程资源 '%s' 的信息，跳过删除: %v\n", source, err)
+				continue
+			}
+
+			var removeErr error
+			if info.IsDir() {
+				removeErr = client.RemoveAll(source)
+			} else {
+				removeErr = client.Remove(source)
+			}
+			if removeErr != nil {
+				// 只记录错误，不中断整个批量删除过程
+				log.Printf("警告: 删除远程资源 '%s' 失败: %v\n", source, removeErr)
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteDirectory 删除远程服务器上的一个空目录。
+func (p *SFTPProvider) DeleteDirectory(ctx context.Context, policy *model.StoragePolicy, virtualPath string) error {
+	remotePath := SFTPRemotePath(policy, virtualPath)
+	return p.withClient(ctx, policy, func(client *sftp.Client) error {
+		err := client.RemoveDirectory(remotePath)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	})
+}
+
+// Rename 重命名或移动远程服务器上的文件或目录。
+func (p *SFTPProvider) Rename(ctx context.Context, policy *model.StoragePolicy, oldVirtualPath, newVirtualPath string) error {
+	oldPath := SFTPRemotePath(policy, oldVirtualPath)
+	newPath := SFTPRemotePath(policy, newVirtualPath)
+	return p.withClient(ctx, policy, func(client *sftp.Client) error {
+		if _, err := client.Lstat(oldPath); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("%w: %s", constant.ErrNotFound, oldPath)
+			}
+			return err
+		}
+		destDir := path.Dir(newPath)
+		if err := client.MkdirAll(destDir); err != nil {
+			return fmt.Errorf("无法创建目标目录 '%s': %w", destDir, err)
+		}
+		if err := sftpReplace(client, oldPath, newPath); err != nil {
+			return fmt.Errorf("从 '%s' 重命名到 '%s' 失败: %w", oldPath, newPath, err)
+		}
+		return nil
+	})
+}
+
+// IsExist 检查远程服务器上指定路径的文件或目录是否存在。
+func (p *SFTPProvider) IsExist(ctx context.Context, policy *model.StoragePolicy, source string) (bool, error) {
+	exists := false
+	err := p.withClient(ctx, policy, func(client *sftp.Client) error {
+		_, err := client.Stat(source)
+		if err == nil {
+			exists = true
+			return nil
+		}
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	})
+	return exists, err
+}
+
+// GetThumbnail 实现了 IStorageProvider 接口。
+// SFTP 服务器没有缩略图能力，因此总是返回 ErrFeatureNotSupported。
+func (p *SFTPProvider) GetThumbnail(ctx context.Context, policy *model.StoragePolicy, source string, size string) (*ThumbnailResult, error) {
+	return nil, ErrFeatureNotSupported
+}
@@ -0,0 +1,326 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// testSFTPServer 是一个只接受密码 "secret" 登录的进程内 SFTP 服务器
+type testSFTPServer struct {
+	addr        string
+	fingerprint string
+	conns       atomic.Int32
+}
+
+func startTestSFTPServer(t *testing.T) *testSFTPServer {
+	t.Helper()
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(meta ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if meta.User() == "anheyu" && string(password) == "secret" {
+				return nil, nil
+			}
+			return nil, errors.New("密码错误")
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := &testSFTPServer{addr: listener.Addr().String(), fingerprint: ssh.FingerprintSHA256(signer.PublicKey())}
+
+	// 连接池会一直持有空闲连接，测试结束时由服务端主动断开
+	var mu sync.Mutex
+	var accepted []net.Conn
+	t.Cleanup(func() {
+		listener.Close()
+		mu.Lock()
+		defer mu.Unlock()
+		for _, conn := range accepted {
+			conn.Close()
+		}
+	})
+	go func() {
+		for {
+			netConn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			accepted = append(accepted, netConn)
+			mu.Unlock()
+			go srv.serve(netConn, config)
+		}
+	}()
+	return srv
+}
+
+func (s *testSFTPServer) serve(netConn net.Conn, config *ssh.ServerConfig) {
+	defer netConn.Close()
+	sshConn, chans, reqs, err := ssh.NewServerConn(netConn, config)
+	if err != nil {
+		return
+	}
+	defer sshConn.Close()
+	s.conns.Add(1)
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unsupported")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range requests {
+				// payload 是长度前缀的子系统名称
+				req.Reply(req.Type == "subsystem" && string(req.Payload[4:]) == "sftp", nil)
+			}
+		}()
+		server, err := sftp.NewServer(channel)
+		if err != nil {
+			return
+		}
+		server.Serve()
+		server.Close()
+	}
+}
+
+func newTestSFTPPolicy(srv *testSFTPServer, basePath string) *model.StoragePolicy {
+	return &model.StoragePolicy{
+		ID:          1,
+		Name:        "vps",
+		Type:        constant.PolicyTypeSFTP,
+		Server:      srv.addr,
+		AccessKey:   "anheyu",
+		SecretKey:   "secret",
+		BasePath:    basePath,
+		VirtualPath: "/vps",
+		Settings: model.StoragePolicySettings{
+			constant.SFTPHostKeyFingerprintSettingKey: srv.fingerprint,
+			constant.SFTPMaxConnectionsSettingKey:     float64(2),
+		},
+	}
+}
+
+func TestSFTPProviderFileOperations(t *testing.T) {
+	srv := startTestSFTPServer(t)
+	root := t.TempDir()
+	policy := newTestSFTPPolicy(srv, root)
+	provider := NewSFTPProvider("signing-secret")
+	ctx := context.Background()
+
+	img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	img.Set(0, 0, color.White)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	result, err := provider.Upload(ctx, bytes.NewReader(buf.Bytes()), policy, "/vps/photos/a.png")
+	if err != nil {
+		t.Fatalf("上传失败: %v", err)
+	}
+	wantSource := filepath.Join(root, "photos", "a.png")
+	if result.Source != wantSource || result.Size != int64(buf.Len()) || result.MimeType != "image/png" || result.Dimension != "3x2" {
+		t.Fatalf("上传结果错误: %+v", result)
+	}
+	if result.Source != SFTPRemotePath(policy, "/vps/photos/a.png") {
+		t.Fatalf("Source 应与 SFTPRemotePath 一致: %s", result.Source)
+	}
+
+	// 覆盖上传不会留下临时文件
+	if _, err := provider.Upload(ctx, bytes.NewReader([]byte("hello")), policy, "/vps/photos/a.png"); err != nil {
+		t.Fatalf("覆盖上传失败: %v", err)
+	}
+	if err := provider.CreateDirectory(ctx, policy, "/vps/photos/empty"); err != nil {
+		t.Fatalf("创建目录失败: %v", err)
+	}
+	items, err := provider.List(ctx, policy, "/vps/photos")
+	if err != nil {
+		t.Fatalf("列出目录失败: %v", err)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+	if len(items) != 2 || items[0].Name != "a.png" || items[0].Size != 5 || items[0].IsDir || !items[1].IsDir {
+		t.Fatalf("目录内容错误: %+v", items)
+	}
+	if missing, err := provider.List(ctx, policy, "/vps/missing"); err != nil || len(missing) != 0 {
+		t.Fatalf("不存在的目录应返回空列表: %v, %v", missing, err)
+	}
+
+	reader, err := provider.Get(ctx, policy, result.Source)
+	if err != nil {
+		t.Fatalf("读取文件失败: %v", err)
+	}
+	content, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil || string(content) != "hello" {
+		t.Fatalf("文件内容错误: %q, %v", content, err)
+	}
+	var streamed bytes.Buffer
+	if err := provider.Stream(ctx, policy, result.Source, &streamed); err != nil || streamed.String() != "hello" {
+		t.Fatalf("流式读取错误: %q, %v", streamed.String(), err)
+	}
+
+	if err := provider.Rename(ctx, policy, "/vps/photos/a.png", "/vps/archive/b.png"); err != nil {
+		t.Fatalf("重命名失败: %v", err)
+	}
+	if err := provider.Rename(ctx, policy, "/vps/photos/a.png", "/vps/archive/c.png"); !errors.Is(err, constant.ErrNotFound) {
+		t.Fatalf("源文件不存在时应返回 ErrNotFound: %v", err)
+	}
+	renamed := filepath.Join(root, "archive", "b.png")
+	if ok, err := provider.IsExist(ctx, policy, renamed); err != nil || !ok {
+		t.Fatalf("重命名后的文件应存在: %v, %v", ok, err)
+	}
+	if ok, err := provider.IsExist(ctx, policy, result.Source); err != nil || ok {
+		t.Fatalf("重命名前的文件不应存在: %v, %v", ok, err)
+	}
+
+	if err := provider.DeleteDirectory(ctx, policy, "/vps/photos/empty"); err != nil {
+		t.Fatalf("删除空目录失败: %v", err)
+	}
+	if err := provider.Delete(ctx, policy, []string{renamed, filepath.Join(root, "photos"), filepath.Join(root, "nope")}); err != nil {
+		t.Fatalf("删除失败: %v", err)
+	}
+	entries, _ := os.ReadDir(root)
+	if len(entries) != 1 || entries[0].Name() != "archive" {
+		t.Fatalf("删除后的目录内容错误: %v", entries)
+	}
+	if archived, _ := os.ReadDir(filepath.Join(root, "archive")); len(archived) != 0 {
+		t.Fatalf("文件应已被删除: %v", archived)
+	}
+
+	// 全部操作都复用连接池中的同一条连接
+	if n := srv.conns.Load(); n != 1 {
+		t.Fatalf("串行操作应复用连接，实际建立了 %d 条连接", n)
+	}
+}
+
+func TestSFTPProviderPoolLimitAndAuth(t *testing.T) {
+	srv := startTestSFTPServer(t)
+	root := t.TempDir()
+	policy := newTestSFTPPolicy(srv, root)
+	provider := NewSFTPProvider("signing-secret")
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := provider.Upload(ctx, bytes.NewReader([]byte("x")), policy, "/vps/f"+string(rune('a'+i))); err != nil {
+				t.Errorf("并发上传失败: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := srv.conns.Load(); n < 1 || n > 2 {
+		t.Fatalf("连接数应受 max_connections 限制，实际建立了 %d 条连接", n)
+	}
+
+	wrongHost := newTestSFTPPolicy(srv, root)
+	wrongHost.ID = 2
+	wrongHost.Settings[constant.SFTPHostKeyFingerprintSettingKey] = "SHA256:bogus"
+	if _, err := provider.List(ctx, wrongHost, "/vps"); err == nil {
+		t.Fatal("主机公钥指纹不一致时应拒绝连接")
+	}
+
+	noFingerprint := newTestSFTPPolicy(srv, root)
+	noFingerprint.ID = 3
+	delete(noFingerprint.Settings, constant.SFTPHostKeyFingerprintSettingKey)
+	if _, err := provider.List(ctx, noFingerprint, "/vps"); err == nil || !strings.Contains(err.Error(), srv.fingerprint) {
+		t.Fatalf("未配置主机公钥指纹时应拒绝连接并给出服务器的指纹，实际: %v", err)
+	}
+
+	wrongPassword := newTestSFTPPolicy(srv, root)
+	wrongPassword.ID = 4
+	wrongPassword.SecretKey = "wrong"
+	if _, err := provider.List(ctx, wrongPassword, "/vps"); err == nil {
+		t.Fatal("密码错误时应连接失败")
+	}
+
+	// 修改连接配置后会重建连接池
+	policy.SecretKey = "wrong"
+	if _, err := provider.List(ctx, policy, "/vps"); err == nil {
+		t.Fatal("策略密码变更后应使用新的配置连接")
+	}
+}
+
+func TestSFTPProviderUploadWhileReading(t *testing.T) {
+	srv := startTestSFTPServer(t)
+	root := t.TempDir()
+	policy := newTestSFTPPolicy(srv, root)
+	policy.Settings[constant.SFTPMaxConnectionsSettingKey] = float64(1)
+	provider := NewSFTPProvider("signing-secret")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := provider.Upload(ctx, bytes.NewReader([]byte("v1")), policy, "/vps/a.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 保留历史版本时先打开旧内容，再在同一策略上写入副本，读取器关闭前连接不能被独占
+	reader, err := provider.Get(ctx, policy, result.Source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	copied, err := provider.Upload(ctx, reader, policy, "/vps/.versions/a.txt")
+	if err != nil {
+		t.Fatalf("持有读取器时上传失败: %v", err)
+	}
+	if content, err := os.ReadFile(copied.Source); err != nil || string(content) != "v1" {
+		t.Fatalf("副本内容错误: %q, %v", content, err)
+	}
+	if n := srv.conns.Load(); n != 1 {
+		t.Fatalf("max_connections 为 1 时应共享同一条连接，实际建立了 %d 条连接", n)
+	}
+}
+
+func TestSFTPProviderDownloadURL(t *testing.T) {
+	provider := NewSFTPProvider("signing-secret")
+	policy := &model.StoragePolicy{Type: constant.PolicyTypeSFTP}
+	if _, err := provider.GetDownloadURL(context.Background(), policy, "/data/a.txt", DownloadURLOptions{}); err == nil {
+		t.Fatal("缺少文件公共ID时应返回错误")
+	}
+	downloadURL, err := provider.GetDownloadURL(context.Background(), policy, "/data/a.txt", DownloadURLOptions{PublicID: "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix([]byte(downloadURL), []byte("/needcache/download/abc?expires=")) {
+		t.Fatalf("下载链接应指向服务端签名下载接口: %s", downloadURL)
+	}
+}
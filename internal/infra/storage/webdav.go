@@ -0,0 +1,441 @@
+// internal/infra/storage/webdav.go
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+const (
+	// webdavResponseHeaderTimeout 是发出请求（含请求体）后等待服务器响应头的超时时间
+	webdavResponseHeaderTimeout = 60 * time.Second
+	// webdavPropfindBody 是 PROPFIND 请求只查询列表所需属性的请求体
+	webdavPropfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:"><d:prop><d:resourcetype/><d:getcontentlength/><d:getlastmodified/></d:prop></d:propfind>`
+)
+
+// WebDAVProvider 实现了 IStorageProvider 接口，将文件保存到远程 WebDAV 服务器（Nextcloud、Alist、NAS 等）。
+// 策略的 Server 为 WebDAV 根地址，AccessKey/SecretKey 为 Basic 认证的用户名和密码，BasePath 为远程存储目录。
+type WebDAVProvider struct {
+	signingSecret string
+	client        *http.Client
+}
+
+// NewWebDAVProvider 是 WebDAVProvider 的构造函数，接收一个用于下载链接签名的密钥。
+func NewWebDAVProvider(signingSecret string) IStorageProvider {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = webdavResponseHeaderTimeout
+	return &WebDAVProvider{
+		signingSecret: signingSecret,
+		client:        &http.Client{Transport: transport},
+	}
+}
+
+// WebDAVRemotePath 将虚拟路径转换为 WebDAV 服务器上的路径，即 WebDAV 策略中文件 Source 字段的值。
+func WebDAVRemotePath(policy *model.StoragePolicy, virtualPath string) string {
+	relativePath := strings.TrimPrefix(virtualPath, policy.VirtualPath)
+	return path.Join("/", policy.BasePath, relativePath)
+}
+
+// webdavMultistatus 是 PROPFIND 返回的 207 Multi-Status 响应体
+type webdavMultistatus struct {
+	Responses []struct {
+		Href     string `xml:"DAV: href"`
+		Propstat []struct {
+			Prop struct {
+				ResourceType struct {
+					Collection *struct{} `xml:"DAV: collection"`
+				} `xml:"DAV: resourcetype"`
+				ContentLength string `xml:"DAV: getcontentlength"`
+				LastModified  string `xml:"DAV: getlastmodified"`
+			} `xml:"DAV: prop"`
+			Status string `xml:"DAV: status"`
+		} `xml:"DAV: propstat"`
+	} `xml:"DAV: response"`
+}
+
+// webdavStatusError 在响应状态码不符合预期时构造错误，附带响应体开头便于排查
+func webdavStatusError(method, remotePath string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", constant.ErrNotFound, remotePath)
+	}
+	return fmt.Errorf("WebDAV %s '%s' 失败: %s %s", method, remotePath, resp.Status, strings.TrimSpace(string(body)))
+}
+
+// endpoint 返回远程路径对应的完整请求地址，collection 为 true 时以 / 结尾
+func (p *WebDAVProvider) endpoint(policy *model.StoragePolicy, remotePath string, collection bool) (string, error) {
+	base, err := url.Parse(policy.Server)
+	if err != nil || base.Host == "" || (base.Scheme != "http" && base.Scheme != "https") {
+		return "", fmt.Errorf("WebDAV 策略的服务器地址无效: %s", policy.Server)
+	}
+	u := *base
+	u.RawPath = ""
+	u.Path = path.Join("/", base.Path, remotePath)
+	if collection && !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String(), nil
+}
+
+// do 向远程路径发送一个 WebDAV 请求，调用方负责关闭响应体
+func (p *WebDAVProvider) do(ctx context.Context, policy *model.StoragePolicy, method, remotePath string, collection bool, body io.Reader, header http.Header) (*http.Response, error) {
+	target, err := p.endpoint(policy, remotePath, collection)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	if policy.AccessKey != "" {
+		req.SetBasicAuth(policy.AccessKey, policy.SecretKey)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("WebDAV %s '%s' 请求失败: %w", method, remotePath, err)
+	}
+	return resp, nil
+}
+
+// propfind 查询远程路径的属性，depth 为 "0" 时只返回自身，为 "1" 时同时返回直接子项。
+// 路径不存在时返回包装了 constant.ErrNotFound 的错误。
+func (p *WebDAVProvider) propfind(ctx context.Context, policy *model.StoragePolicy, remotePath, depth string) (*webdavMultistatus, error) {
+	header := http.Header{}
+	header.Set("Depth", depth)
+	header.Set("Content-Type", "application/xml; charset=utf-8")
+	resp, err := p.do(ctx, policy, "PROPFIND", remotePath, depth != "0", strings.NewReader(webdavPropfindBody), header)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, webdavStatusError("PROPFIND", remotePath, resp)
+	}
+	var ms webdavMultistatus
+	if err := xml.NewDecoder(resp.Body).Decode(&ms); err != nil {
+		return nil, fmt.Errorf("无法解析 WebDAV PROPFIND '%s' 的响应: %w", remotePath, err)
+	}
+	return &ms, nil
+}
+
+// mkdirAll 逐级创建远程目录，已存在的目录会被跳过
+func (p *WebDAVProvider) mkdirAll(ctx context.Context, policy *model.StoragePolicy, remotePath string) error {
+	if remotePath == "/" || remotePath == "." {
+		return nil
+	}
+	if _, err := p.propfind(ctx, policy, remotePath, "0"); err == nil {
+		return nil
+	} else if !errors.Is(err, constant.ErrNotFound) {
+		return err
+	}
+	if err := p.mkdirAll(ctx, policy, path.Dir(remotePath)); err != nil {
+		return err
+	}
+	resp, err := p.do(ctx, policy, "MKCOL", remotePath, true, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// 405 表示目录已存在，可能是并发上传时被其他请求创建
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusMethodNotAllowed {
+		return webdavStatusError("MKCOL", remotePath, resp)
+	}
+	return nil
+}
+
+// List 使用 Depth 为 1 的 PROPFIND 列出远程目录的内容，目录不存在时返回空列表。
+func (p *WebDAVProvider) List(ctx context.Context, policy *model.StoragePolicy, virtualPath string) ([]FileInfo, error) {
+	remotePath := WebDAVRemotePath(policy, virtualPath)
+	ms, err := p.propfind(ctx, policy, remotePath, "1")
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return []FileInfo{}, nil
+		}
+		return nil, err
+	}
+
+	selfPath := strings.TrimSuffix(p.requestPath(policy, remotePath), "/")
+	result := make([]FileInfo, 0, len(ms.Responses))
+	for _, item := range ms.Responses {
+		href, err := url.Parse(item.Href)
+		if err != nil {
+			continue
+		}
+		hrefPath := strings.TrimSuffix(href.Path, "/")
+		// 响应中包含被查询的目录本身，需要跳过
+		if hrefPath == selfPath {
+			continue
+		}
+		info := FileInfo{Name: path.Base(hrefPath)}
+		for _, propstat := range item.Propstat {
+			if !strings.Contains(propstat.Status, " 200 ") {
+				continue
+			}
+			prop := propstat.Prop
+			if prop.ResourceType.Collection != nil {
+				info.IsDir = true
+			}
+			if size, err := strconv.ParseInt(prop.ContentLength, 10, 64); err == nil {
+				info.Size = size
+			}
+			if modTime, err := http.ParseTime(prop.LastModified); err == nil {
+				info.ModTime = modTime
+			}
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+// requestPath 返回远程路径在请求地址中的路径部分，用于和 PROPFIND 响应中的 href 比较
+func (p *WebDAVProvider) requestPath(policy *model.StoragePolicy, remotePath string) string {
+	base, err := url.Parse(policy.Server)
+	if err != nil {
+		return remotePath
+	}
+	return path.Join("/", base.Path, remotePath)
+}
+
+// Get 打开远程文件，调用方需要关闭返回的读取器。
+func (p *WebDAVProvider) Get(ctx context.Context, policy *model.StoragePolicy, source string) (io.ReadCloser, error) {
+	resp, err := p.do(ctx, policy, http.MethodGet, source, false, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("远程文件不存在: %s", source)
+		}
+		return nil, webdavStatusError("GET", source, resp)
+	}
+	return resp.Body, nil
+}
+
+// GetDownloadURL 生成一个指向服务端签名下载接口的临时链接，文件内容由服务端从 WebDAV 读取后中转，
+// 避免把 WebDAV 账号密码暴露给客户端。
+func (p *WebDAVProvider) GetDownloadURL(ctx context.Context, policy *model.StoragePolicy, source string, options DownloadURLOptions) (string, error) {
+	return signedProxyDownloadURL(p.signingSecret, options)
+}
+
+// Stream 将远程文件的内容写入给定的 io.Writer。
+func (p *WebDAVProvider) Stream(ctx context.Context, policy *model.StoragePolicy, source string, writer io.Writer) error {
+	reader, err := p.Get(ctx, policy, source)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	if _, err := io.Copy(writer, reader); err != nil {
+		return fmt.Errorf("流式传输文件内容时发生错误: %w", err)
+	}
+	return nil
+}
+
+// CreateDirectory 在 WebDAV 服务器上创建目录，缺失的上级目录会一并创建。
+func (p *WebDAVProvider) CreateDirectory(ctx context.Context, policy *model.StoragePolicy, virtualPath string) error {
+	remotePath := WebDAVRemotePath(policy, virtualPath)
+	if err := p.mkdirAll(ctx, policy, remotePath); err != nil {
+		return fmt.Errorf("无法创建 WebDAV 目录 '%s': %w", remotePath, err)
+	}
+	return nil
+}
+
+// webdavCountingReader 统计上传过程中实际读取的字节数
+type webdavCountingReader struct {
+	reader io.Reader
+	n      int64
+}
+
+func (r *webdavCountingReader) Read(b []byte) (int, error) {
+	n, err := r.reader.Read(b)
+	r.n += int64(n)
+	return n, err
+}
+
+// Upload 使用 PUT 将文件流写入 WebDAV 服务器。默认以分块传输编码边读边传，不在本地缓存整个文件；
+// 关闭 chunked_upload 后会先写入本地临时文件，再带 Content-Length 上传，以兼容不支持分块传输编码的服务器。
+func (p *WebDAVProvider) Upload(ctx context.Context, file io.Reader, policy *model.StoragePolicy, virtualPath string) (*UploadResult, error) {
+	remotePath := WebDAVRemotePath(policy, virtualPath)
+
+	reader, mimeType, dimension, err := sniffUpload(file, virtualPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.mkdirAll(ctx, policy, path.Dir(remotePath)); err != nil {
+		return nil, fmt.Errorf("无法创建 WebDAV 目录 '%s': %w", path.Dir(remotePath), err)
+	}
+
+	chunked := true
+	if val, ok := policy.Settings[constant.WebDAVChunkedUploadSettingKey].(bool); ok {
+		chunked = val
+	}
+
+	var body io.Reader
+	var size int64
+	counter := &webdavCountingReader{reader: reader}
+	if chunked {
+		// 不设置 Content-Length，请求体会以分块传输编码发送
+		body = io.NopCloser(counter)
+		size = -1
+	} else {
+		tempFile, err := os.CreateTemp("", "anheyu-webdav-*")
+		if err != nil {
+			return nil, fmt.Errorf("无法创建本地临时文件: %w", err)
+		}
+		defer os.Remove(tempFile.Name())
+		defer tempFile.Close()
+		if size, err = io.Copy(tempFile, reader); err != nil {
+			return nil, fmt.Errorf("缓存上传文件失败: %w", err)
+		}
+		if _, err := tempFile.Seek(0, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("缓存上传文件失败: %w", err)
+		}
+		body = tempFile
+	}
+
+	target, err := p.endpoint(policy, remotePath, false)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, target, body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", mimeType)
+	if policy.AccessKey != "" {
+		req.SetBasicAuth(policy.AccessKey, policy.SecretKey)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("上传文件到 WebDAV '%s' 失败: %w", remotePath, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return nil, webdavStatusError("PUT", remotePath, resp)
+	}
+	if chunked {
+		size = counter.n
+	}
+
+	return &UploadResult{
+		Source:    remotePath,
+		Size:      size,
+		MimeType:  mimeType,
+		Dimension: dimension,
+	}, nil
+}
+
+// Delete 删除 WebDAV 服务器上的一个或多个文件或目录，目录会连同其内容一起删除。
+func (p *WebDAVProvider) Delete(ctx context.Context, policy *model.StoragePolicy, sources []string) error {
+	for _, source := range sources {
+		resp, err := p.do(ctx, policy, http.MethodDelete, source, false, nil, nil)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			// 文件或目录已经不存在，静默处理
+			resp.Body.Close()
+			continue
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			// 只记录错误，不中断整个批量删除过程
+			log.Printf("警告: 删除远程资源 '%s' 失败: %v\n", source, webdavStatusError("DELETE", source, resp))
+		}
+		resp.Body.Close()
+	}
+	return nil
+}
+
+// DeleteDirectory 删除 WebDAV 服务器上的一个空目录。WebDAV 的 DELETE 会递归删除目录，
+// 因此先确认目录为空，目录非空时返回错误。
+func (p *WebDAVProvider) DeleteDirectory(ctx context.Context, policy *model.StoragePolicy, virtualPath string) error {
+	remotePath := WebDAVRemotePath(policy, virtualPath)
+	ms, err := p.propfind(ctx, policy, remotePath, "1")
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if len(ms.Responses) > 1 {
+		return fmt.Errorf("WebDAV 目录 '%s' 非空，无法删除", remotePath)
+	}
+	resp, err := p.do(ctx, policy, http.MethodDelete, remotePath, true, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound || (resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return nil
+	}
+	return webdavStatusError("DELETE", remotePath, resp)
+}
+
+// Rename 使用 MOVE 重命名或移动 WebDAV 服务器上的文件或目录，目标已存在时会被覆盖。
+func (p *WebDAVProvider) Rename(ctx context.Context, policy *model.StoragePolicy, oldVirtualPath, newVirtualPath string) error {
+	oldPath := WebDAVRemotePath(policy, oldVirtualPath)
+	newPath := WebDAVRemotePath(policy, newVirtualPath)
+	if _, err := p.propfind(ctx, policy, oldPath, "0"); err != nil {
+		return err
+	}
+	destDir := path.Dir(newPath)
+	if err := p.mkdirAll(ctx, policy, destDir); err != nil {
+		return fmt.Errorf("无法创建目标目录 '%s': %w", destDir, err)
+	}
+
+	destination, err := p.endpoint(policy, newPath, false)
+	if err != nil {
+		return err
+	}
+	header := http.Header{}
+	header.Set("Destination", destination)
+	header.Set("Overwrite", "T")
+	resp, err := p.do(ctx, policy, "MOVE", oldPath, false, nil, header)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("从 '%s' 重命名到 '%s' 失败: %w", oldPath, newPath, webdavStatusError("MOVE", oldPath, resp))
+	}
+	return nil
+}
+
+// IsExist 使用 Depth 为 0 的 PROPFIND 检查 WebDAV 服务器上指定路径的文件或目录是否存在。
+func (p *WebDAVProvider) IsExist(ctx context.Context, policy *model.StoragePolicy, source string) (bool, error) {
+	_, err := p.propfind(ctx, policy, source, "0")
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, constant.ErrNotFound) {
+		return false, nil
+	}
+	return false, err
+}
+
+// GetThumbnail 实现了 IStorageProvider 接口。
+// WebDAV 协议没有缩略图能力，因此总是返回 ErrFeatureNotSupported。
+func (p *WebDAVProvider) GetThumbnail(ctx context.Context, policy *model.StoragePolicy, source string, size string) (*ThumbnailResult, error) {
+	return nil, ErrFeatureNotSupported
+}
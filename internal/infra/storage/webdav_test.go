@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+
+	"golang.org/x/net/webdav"
+)
+
+// startTestWebDAVServer 启动一个只接受 anheyu/secret 登录的进程内 WebDAV 服务器，
+// 服务挂载在 /dav 前缀下，模拟 Nextcloud 这类带路径前缀的地址。chunked 统计收到的分块上传次数。
+func startTestWebDAVServer(t *testing.T, root string, chunked *atomic.Int32) *httptest.Server {
+	t.Helper()
+	handler := &webdav.Handler{
+		Prefix:     "/dav",
+		FileSystem: webdav.Dir(root),
+		LockSystem: webdav.NewMemLS(),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "anheyu" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Method == http.MethodPut && len(r.TransferEncoding) > 0 && r.TransferEncoding[0] == "chunked" {
+			chunked.Add(1)
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestWebDAVPolicy(srv *httptest.Server) *model.StoragePolicy {
+	return &model.StoragePolicy{
+		ID:          1,
+		Name:        "nas",
+		Type:        constant.PolicyTypeWebDAV,
+		Server:      srv.URL + "/dav",
+		AccessKey:   "anheyu",
+		SecretKey:   "secret",
+		BasePath:    "/anheyu",
+		VirtualPath: "/nas",
+		Settings:    model.StoragePolicySettings{},
+	}
+}
+
+func TestWebDAVProviderFileOperations(t *testing.T) {
+	root := t.TempDir()
+	var chunked atomic.Int32
+	srv := startTestWebDAVServer(t, root, &chunked)
+	policy := newTestWebDAVPolicy(srv)
+	provider := NewWebDAVProvider("signing-secret")
+	ctx := context.Background()
+
+	img := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	img.Set(0, 0, color.White)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	// 上级目录不存在时会逐级创建
+	result, err := provider.Upload(ctx, bytes.NewReader(buf.Bytes()), policy, "/nas/相册/a b.png")
+	if err != nil {
+		t.Fatalf("上传失败: %v", err)
+	}
+	if result.Source != "/anheyu/相册/a b.png" || result.Size != int64(buf.Len()) || result.MimeType != "image/png" || result.Dimension != "3x2" {
+		t.Fatalf("上传结果错误: %+v", result)
+	}
+	if result.Source != WebDAVRemotePath(policy, "/nas/相册/a b.png") {
+		t.Fatalf("Source 应与 WebDAVRemotePath 一致: %s", result.Source)
+	}
+	if chunked.Load() != 1 {
+		t.Fatalf("默认应使用分块传输编码上传，实际分块上传次数: %d", chunked.Load())
+	}
+
+	// 关闭分块上传后带 Content-Length 覆盖上传
+	policy.Settings[constant.WebDAVChunkedUploadSettingKey] = false
+	if result, err = provider.Upload(ctx, bytes.NewReader([]byte("hello")), policy, "/nas/相册/a b.png"); err != nil || result.Size != 5 {
+		t.Fatalf("覆盖上传失败: %+v, %v", result, err)
+	}
+	if chunked.Load() != 1 {
+		t.Fatal("关闭 chunked_upload 后不应使用分块传输编码")
+	}
+	if err := provider.CreateDirectory(ctx, policy, "/nas/相册/empty"); err != nil {
+		t.Fatalf("创建目录失败: %v", err)
+	}
+	items, err := provider.List(ctx, policy, "/nas/相册")
+	if err != nil {
+		t.Fatalf("列出目录失败: %v", err)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+	if len(items) != 2 || items[0].Name != "a b.png" || items[0].Size != 5 || items[0].IsDir || items[0].ModTime.IsZero() || items[1].Name != "empty" || !items[1].IsDir {
+		t.Fatalf("目录内容错误: %+v", items)
+	}
+	if missing, err := provider.List(ctx, policy, "/nas/missing"); err != nil || len(missing) != 0 {
+		t.Fatalf("不存在的目录应返回空列表: %v, %v", missing, err)
+	}
+
+	reader, err := provider.Get(ctx, policy, result.Source)
+	if err != nil {
+		t.Fatalf("读取文件失败: %v", err)
+	}
+	content, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil || string(content) != "hello" {
+		t.Fatalf("文件内容错误: %q, %v", content, err)
+	}
+	var streamed bytes.Buffer
+	if err := provider.Stream(ctx, policy, result.Source, &streamed); err != nil || streamed.String() != "hello" {
+		t.Fatalf("流式读取错误: %q, %v", streamed.String(), err)
+	}
+
+	if err := provider.Rename(ctx, policy, "/nas/相册/a b.png", "/nas/archive/b.png"); err != nil {
+		t.Fatalf("重命名失败: %v", err)
+	}
+	if err := provider.Rename(ctx, policy, "/nas/相册/a b.png", "/nas/archive/c.png"); !errors.Is(err, constant.ErrNotFound) {
+		t.Fatalf("源文件不存在时应返回 ErrNotFound: %v", err)
+	}
+	renamed := "/anheyu/archive/b.png"
+	if ok, err := provider.IsExist(ctx, policy, renamed); err != nil || !ok {
+		t.Fatalf("重命名后的文件应存在: %v, %v", ok, err)
+	}
+	if ok, err := provider.IsExist(ctx, policy, result.Source); err != nil || ok {
+		t.Fatalf("重命名前的文件不应存在: %v, %v", ok, err)
+	}
+
+	if err := provider.DeleteDirectory(ctx, policy, "/nas/archive"); err == nil {
+		t.Fatal("删除非空目录应返回错误")
+	}
+	if err := provider.DeleteDirectory(ctx, policy, "/nas/相册/empty"); err != nil {
+		t.Fatalf("删除空目录失败: %v", err)
+	}
+	if err := provider.Delete(ctx, policy, []string{renamed, "/anheyu/相册", "/anheyu/nope"}); err != nil {
+		t.Fatalf("删除失败: %v", err)
+	}
+	entries, _ := os.ReadDir(filepath.Join(root, "anheyu"))
+	if len(entries) != 1 || entries[0].Name() != "archive" {
+		t.Fatalf("删除后的目录内容错误: %v", entries)
+	}
+	if archived, _ := os.ReadDir(filepath.Join(root, "anheyu", "archive")); len(archived) != 0 {
+		t.Fatalf("文件应已被删除: %v", archived)
+	}
+}
+
+func TestWebDAVProviderAuthAndDownloadURL(t *testing.T) {
+	var chunked atomic.Int32
+	srv := startTestWebDAVServer(t, t.TempDir(), &chunked)
+	policy := newTestWebDAVPolicy(srv)
+	policy.SecretKey = "wrong"
+	provider := NewWebDAVProvider("signing-secret")
+	ctx := context.Background()
+
+	if _, err := provider.List(ctx, policy, "/nas"); err == nil {
+		t.Fatal("密码错误时应返回错误")
+	}
+	if _, err := provider.IsExist(ctx, policy, "/anheyu"); err == nil {
+		t.Fatal("密码错误时不应把路径当作不存在")
+	}
+
+	downloadURL, err := provider.GetDownloadURL(ctx, policy, "/anheyu/a.txt", DownloadURLOptions{PublicID: "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix([]byte(downloadURL), []byte("/needcache/download/abc?expires=")) {
+		t.Fatalf("下载链接应指向服务端签名下载接口: %s", downloadURL)
+	}
+}
@@ -18,13 +18,19 @@ import (
 
 // ClaimsKey 和 CustomClaims 已移至 types.go
 
-// GenerateToken 生成一个新的 JWT Access Token
-func GenerateToken(userID uint, permissions []byte, userGroupID uint, secretKey []byte) (string, error) {
+// AccessTokenTTL 和 RefreshTokenTTL 分别是访问令牌和刷新令牌的有效期
+const (
+	AccessTokenTTL  = time.Minute * 15
+	RefreshTokenTTL = time.Hour * 24 * 30
+)
+
+// GenerateToken 生成一个新的 JWT Access Token，sessionID 为签发该令牌的登录会话
+func GenerateToken(userID uint, permissions []byte, userGroupID uint, sessionID string, secretKey []byte) (string, error) {
 	if len(secretKey) == 0 {
 		return "", fmt.Errorf("JWT Secret 不能为空")
 	}
 
-	accessTokenExpires := time.Now().Add(time.Minute * 15)
+	accessTokenExpires := time.Now().Add(AccessTokenTTL)
 
 	publicUserID, err := idgen.GeneratePublicID(userID, idgen.EntityTypeUser)
 	if err != nil {
@@ -40,6 +46,7 @@ func GenerateToken(userID uint, permissions []byte, userGroupID uint, secretKey
 		UserID:      publicUserID,
 		UserGroupID: publicUserGroupID,
 		Permissions: permissions,
+		SessionID:   sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(accessTokenExpires),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -52,13 +59,13 @@ func GenerateToken(userID uint, permissions []byte, userGroupID uint, secretKey
 	return token.SignedString(secretKey)
 }
 
-// GenerateRefreshToken 生成一个新的 JWT Refresh Token
-func GenerateRefreshToken(userID uint, secretKey []byte) (string, error) {
+// GenerateRefreshToken 生成一个新的 JWT Refresh Token，会话标识写入 jti
+func GenerateRefreshToken(userID uint, sessionID string, secretKey []byte) (string, error) {
 	if len(secretKey) == 0 {
 		return "", fmt.Errorf("JWT Secret 不能为空")
 	}
 
-	refreshTokenExpires := time.Now().Add(time.Hour * 24 * 30)
+	refreshTokenExpires := time.Now().Add(RefreshTokenTTL)
 
 	publicUserID, err := idgen.GeneratePublicID(userID, idgen.EntityTypeUser)
 	if err != nil {
@@ -68,6 +75,7 @@ func GenerateRefreshToken(userID uint, secretKey []byte) (string, error) {
 	claims := CustomClaims{ // 此处 CustomClaims 来自同包下的 types.go
 		UserID: publicUserID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        sessionID,
 			ExpiresAt: jwt.NewNumericDate(refreshTokenExpires),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
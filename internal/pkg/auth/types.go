@@ -7,7 +7,15 @@
  */
 package auth
 
-import "github.com/golang-jwt/jwt/v5"
+import (
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// AdminGroupID 是内置管理员组的ID，该组始终拥有全部权限
+const AdminGroupID uint = 1
 
 // ClaimsKey 是用于在 gin.Context 中存储和检索整个用户信息结构体的键。
 const ClaimsKey = "user_claims"
@@ -18,5 +26,19 @@ type CustomClaims struct {
 	UserID      string `json:"user_id"`       // 用户公共ID
 	UserGroupID string `json:"user_group_id"` // 用户组公共ID
 	Permissions []byte `json:"permissions"`   // 用户的权限信息
+	// SessionID 是签发该访问令牌的登录会话，用于服务端撤销
+	SessionID string `json:"sid,omitempty"`
+	// Scopes 仅在使用个人访问令牌认证时非空，表示令牌的授权范围；会话令牌为 nil，不受范围限制
+	Scopes []string `json:"scopes,omitempty"`
 	jwt.RegisteredClaims
 }
+
+// HasPermission 判断令牌持有者是否拥有任一指定权限。
+// 权限位在签发令牌时写入，修改用户组权限后需等待访问令牌刷新才会生效。
+func (c *CustomClaims) HasPermission(perms ...uint) bool {
+	if groupID, entityType, err := idgen.DecodePublicID(c.UserGroupID); err == nil &&
+		entityType == idgen.EntityTypeUserGroup && groupID == AdminGroupID {
+		return true
+	}
+	return model.Boolset(c.Permissions).HasAny(perms...)
+}
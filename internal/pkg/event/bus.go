@@ -21,6 +21,9 @@ const (
 	LinkCreated Topic = "link:created"
 	LinkUpdated Topic = "link:updated"
 	LinkDeleted Topic = "link:deleted"
+	// 评论事件
+	CommentCreated       Topic = "comment:created"
+	CommentStatusChanged Topic = "comment:status_changed"
 )
 
 // 事件处理器函数类型
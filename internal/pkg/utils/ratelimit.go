@@ -38,16 +38,24 @@ func NewThrottledWriter(w io.Writer, limit int64, ctx context.Context) io.Writer
 
 // Write 实现 io.Writer 接口
 func (t *ThrottledWriter) Write(p []byte) (n int, err error) {
-	// WaitN 方法可能会因为上下文取消而提前返回错误，所以先写入再等待
-	// 这样可以确保即使等待失败，数据也已经写入了缓冲区
-	n, err = t.w.Write(p)
-	if err != nil {
-		return n, err
+	// WaitN 一次申请的令牌数不能超过桶大小，因此按桶大小分块写入
+	burst := t.limiter.Burst()
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > burst {
+			chunk = chunk[:burst]
+		}
+		// WaitN 方法可能会因为上下文取消而提前返回错误，所以先写入再等待
+		// 这样可以确保即使等待失败，数据也已经写入了缓冲区
+		written, err := t.w.Write(chunk)
+		n += written
+		if err != nil {
+			return n, err
+		}
+		if err := t.limiter.WaitN(t.ctx, written); err != nil {
+			return n, err
+		}
+		p = p[written:]
 	}
-
-	if t.limiter != nil {
-		err = t.limiter.WaitN(t.ctx, n)
-	}
-
-	return n, err
+	return n, nil
 }
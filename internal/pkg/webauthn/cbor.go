@@ -0,0 +1,144 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// 本文件实现了一个仅覆盖 WebAuthn 所需子集的 CBOR (RFC 8949) 解码器：
+// 无符号/负整数、字节串、文本串、数组、映射以及 true/false/null。
+// 不支持不定长编码、标签和浮点数，遇到时直接返回错误。
+
+// maxCBORDepth 限制嵌套深度，避免恶意构造的数据耗尽栈空间
+const maxCBORDepth = 16
+
+var errCBORTruncated = errors.New("CBOR 数据不完整")
+
+// decodeCBOR 解码 data 开头的一个 CBOR 数据项，返回解码结果和消耗的字节数。
+// 映射被解码为 map[any]any，整数键统一为 int64，文本键为 string。
+func decodeCBOR(data []byte) (any, int, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (any, int, error) {
+	if depth > maxCBORDepth {
+		return nil, 0, errors.New("CBOR 嵌套层级过深")
+	}
+	if len(data) == 0 {
+		return nil, 0, errCBORTruncated
+	}
+
+	major := data[0] >> 5
+	info := data[0] & 0x1f
+
+	if major == 7 {
+		switch info {
+		case 20:
+			return false, 1, nil
+		case 21:
+			return true, 1, nil
+		case 22:
+			return nil, 1, nil
+		default:
+			return nil, 0, fmt.Errorf("不支持的 CBOR 简单值: %d", info)
+		}
+	}
+
+	arg, n, err := readCBORArgument(data, info)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch major {
+	case 0: // 无符号整数
+		if arg > 1<<63-1 {
+			return nil, 0, errors.New("CBOR 整数溢出")
+		}
+		return int64(arg), n, nil
+	case 1: // 负整数
+		if arg > 1<<63-1 {
+			return nil, 0, errors.New("CBOR 整数溢出")
+		}
+		return -1 - int64(arg), n, nil
+	case 2, 3: // 字节串、文本串
+		if arg > uint64(len(data)-n) {
+			return nil, 0, errCBORTruncated
+		}
+		end := n + int(arg)
+		if major == 2 {
+			return append([]byte{}, data[n:end]...), end, nil
+		}
+		return string(data[n:end]), end, nil
+	case 4: // 数组
+		if arg > uint64(len(data)) {
+			return nil, 0, errCBORTruncated
+		}
+		items := make([]any, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			item, used, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			items = append(items, item)
+			n += used
+		}
+		return items, n, nil
+	case 5: // 映射
+		if arg > uint64(len(data)) {
+			return nil, 0, errCBORTruncated
+		}
+		m := make(map[any]any, arg)
+		for i := uint64(0); i < arg; i++ {
+			key, used, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			n += used
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, 0, errors.New("不支持的 CBOR 映射键类型")
+			}
+			value, used, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			n += used
+			m[key] = value
+		}
+		return m, n, nil
+	default:
+		return nil, 0, fmt.Errorf("不支持的 CBOR 主类型: %d", major)
+	}
+}
+
+// readCBORArgument 读取数据项头部携带的参数，返回参数值和头部长度
+func readCBORArgument(data []byte, info byte) (uint64, int, error) {
+	switch {
+	case info < 24:
+		return uint64(info), 1, nil
+	case info == 24:
+		if len(data) < 2 {
+			return 0, 0, errCBORTruncated
+		}
+		return uint64(data[1]), 2, nil
+	case info == 25:
+		if len(data) < 3 {
+			return 0, 0, errCBORTruncated
+		}
+		return uint64(binary.BigEndian.Uint16(data[1:3])), 3, nil
+	case info == 26:
+		if len(data) < 5 {
+			return 0, 0, errCBORTruncated
+		}
+		return uint64(binary.BigEndian.Uint32(data[1:5])), 5, nil
+	case info == 27:
+		if len(data) < 9 {
+			return 0, 0, errCBORTruncated
+		}
+		return binary.BigEndian.Uint64(data[1:9]), 9, nil
+	default:
+		return 0, 0, errors.New("不支持的 CBOR 不定长编码")
+	}
+}
@@ -0,0 +1,97 @@
+package webauthn
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestDecodeCBORKnownAnswers 使用 RFC 8949 附录 A 中的示例
+func TestDecodeCBORKnownAnswers(t *testing.T) {
+	tests := []struct {
+		hex  string
+		want any
+	}{
+		{"00", int64(0)},
+		{"17", int64(23)},
+		{"1818", int64(24)},
+		{"1903e8", int64(1000)},
+		{"1a000f4240", int64(1000000)},
+		{"1b000000e8d4a51000", int64(1000000000000)},
+		{"20", int64(-1)},
+		{"3863", int64(-100)},
+		{"3903e7", int64(-1000)},
+		{"f4", false},
+		{"f5", true},
+		{"f6", nil},
+		{"40", []byte{}},
+		{"4401020304", []byte{1, 2, 3, 4}},
+		{"60", ""},
+		{"6449455446", "IETF"},
+		{"62c3bc", "ü"},
+		{"80", []any{}},
+		{"83010203", []any{int64(1), int64(2), int64(3)}},
+		{"8301820203820405", []any{int64(1), []any{int64(2), int64(3)}, []any{int64(4), int64(5)}}},
+		{"a0", map[any]any{}},
+		{"a201020304", map[any]any{int64(1): int64(2), int64(3): int64(4)}},
+		{"a26161016162820203", map[any]any{"a": int64(1), "b": []any{int64(2), int64(3)}}},
+	}
+	for _, tt := range tests {
+		data, _ := hex.DecodeString(tt.hex)
+		got, n, err := decodeCBOR(data)
+		if err != nil {
+			t.Errorf("decodeCBOR(%s) 返回错误: %v", tt.hex, err)
+			continue
+		}
+		if n != len(data) {
+			t.Errorf("decodeCBOR(%s) 消耗了 %d 字节，期望 %d", tt.hex, n, len(data))
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("decodeCBOR(%s) = %#v，期望 %#v", tt.hex, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeCBORStopsAfterFirstItem(t *testing.T) {
+	got, n, err := decodeCBOR([]byte{0x01, 0x02})
+	if err != nil || got != int64(1) || n != 1 {
+		t.Fatalf("decodeCBOR = (%v, %d, %v)，期望 (1, 1, nil)", got, n, err)
+	}
+}
+
+func TestDecodeCBORRejectsMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+	}{
+		{"空数据", ""},
+		{"整数参数缺失", "18"},
+		{"四字节参数不完整", "1a0001"},
+		{"字节串内容不足", "4401"},
+		{"文本串内容不足", "6449"},
+		{"数组元素不足", "830102"},
+		{"映射缺少值", "a101"},
+		{"超大字节串长度", "5bffffffffffffffff"},
+		{"超大数组长度", "9bffffffffffffffff"},
+		{"超大映射长度", "bbffffffffffffffff"},
+		{"正整数溢出", "1bffffffffffffffff"},
+		{"负整数溢出", "3bffffffffffffffff"},
+		{"不定长字节串", "5f"},
+		{"标签", "c11a514b67b0"},
+		{"浮点数", "f93c00"},
+		{"字节串作为映射键", "a1410000"},
+		{"嵌套过深", strings.Repeat("81", maxCBORDepth+2) + "00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.hex)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, _, err := decodeCBOR(data); err == nil {
+				t.Fatalf("decodeCBOR(%s) = %#v，期望返回错误", tt.hex, got)
+			}
+		})
+	}
+}
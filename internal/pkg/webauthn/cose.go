@@ -0,0 +1,111 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// COSE 算法标识 (RFC 8152)，按优先级排列，用于注册时的 pubKeyCredParams
+const (
+	AlgES256 int64 = -7
+	AlgEdDSA int64 = -8
+	AlgRS256 int64 = -257
+)
+
+// SupportedAlgorithms 是服务端支持的公钥算法
+var SupportedAlgorithms = []int64{AlgES256, AlgEdDSA, AlgRS256}
+
+// COSE 密钥参数标签
+const (
+	coseKeyKty = 1
+	coseKeyAlg = 3
+	coseCrv    = -1 // EC2/OKP: 曲线；RSA: n
+	coseX      = -2 // EC2/OKP: x；RSA: e
+	coseY      = -3 // EC2: y
+
+	coseKtyOKP = 1
+	coseKtyEC2 = 2
+	coseKtyRSA = 3
+
+	coseCrvP256    = 1
+	coseCrvEd25519 = 6
+)
+
+// publicKey 是从 COSE_Key 解析出的公钥
+type publicKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+// parseCOSEKey 解析 COSE_Key 编码的凭证公钥
+func parseCOSEKey(data []byte) (*publicKey, error) {
+	raw, _, err := decodeCBOR(data)
+	if err != nil {
+		return nil, fmt.Errorf("解析凭证公钥失败: %w", err)
+	}
+	m, ok := raw.(map[any]any)
+	if !ok {
+		return nil, errors.New("凭证公钥格式无效")
+	}
+
+	kty, _ := m[int64(coseKeyKty)].(int64)
+	alg, _ := m[int64(coseKeyAlg)].(int64)
+
+	switch {
+	case kty == coseKtyEC2 && alg == AlgES256:
+		crv, _ := m[int64(coseCrv)].(int64)
+		x, _ := m[int64(coseX)].([]byte)
+		y, _ := m[int64(coseY)].([]byte)
+		if crv != coseCrvP256 || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("ES256 公钥参数无效")
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, errors.New("ES256 公钥不在曲线上")
+		}
+		return &publicKey{alg: alg, key: pub}, nil
+	case kty == coseKtyOKP && alg == AlgEdDSA:
+		crv, _ := m[int64(coseCrv)].(int64)
+		x, _ := m[int64(coseX)].([]byte)
+		if crv != coseCrvEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("EdDSA 公钥参数无效")
+		}
+		return &publicKey{alg: alg, key: ed25519.PublicKey(x)}, nil
+	case kty == coseKtyRSA && alg == AlgRS256:
+		n, _ := m[int64(coseCrv)].([]byte)
+		e, _ := m[int64(coseX)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("RS256 公钥参数无效")
+		}
+		exp := 0
+		for _, b := range e {
+			exp = exp<<8 | int(b)
+		}
+		return &publicKey{alg: alg, key: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exp}}, nil
+	default:
+		return nil, fmt.Errorf("不支持的公钥算法: kty=%d alg=%d", kty, alg)
+	}
+}
+
+// verify 校验 signature 是否为公钥对 data 的有效签名
+func (k *publicKey) verify(data, signature []byte) bool {
+	switch k.alg {
+	case AlgES256:
+		digest := sha256.Sum256(data)
+		return ecdsa.VerifyASN1(k.key.(*ecdsa.PublicKey), digest[:], signature)
+	case AlgEdDSA:
+		return ed25519.Verify(k.key.(ed25519.PublicKey), data, signature)
+	case AlgRS256:
+		digest := sha256.Sum256(data)
+		return rsa.VerifyPKCS1v15(k.key.(*rsa.PublicKey), crypto.SHA256, digest[:], signature) == nil
+	default:
+		return false
+	}
+}
@@ -0,0 +1,387 @@
+// Package webauthn 实现了 WebAuthn Level 2 注册与认证流程中服务端（依赖方）的校验逻辑。
+// 只支持 attestation 为 "none" 的场景：不校验认证器的证明声明，仅信任凭证公钥本身。
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// 认证器数据中的标志位
+const (
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagAttestedCredentialData = 0x40
+	flagExtensionData          = 0x80
+)
+
+// ErrVerification 表示客户端提交的 WebAuthn 响应未通过校验
+var ErrVerification = errors.New("通行密钥验证失败")
+
+// RelyingParty 描述了依赖方（即本站）的身份信息
+type RelyingParty struct {
+	ID     string // 通常为站点域名，例如 example.com
+	Name   string
+	Origin string // 例如 https://example.com
+}
+
+// Credential 是注册成功后需要保存的凭证信息
+type Credential struct {
+	ID        []byte
+	PublicKey []byte // COSE_Key 编码的公钥
+	SignCount uint32
+	AAGUID    []byte
+}
+
+// CredentialDescriptor 对应 PublicKeyCredentialDescriptor
+type CredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"` // base64url
+	Transports []string `json:"transports,omitempty"`
+}
+
+// CreationOptions 对应 PublicKeyCredentialCreationOptionsJSON，前端可直接传给 PublicKeyCredential.parseCreationOptionsFromJSON
+type CreationOptions struct {
+	RP                     rpEntity               `json:"rp"`
+	User                   userEntity             `json:"user"`
+	Challenge              string                 `json:"challenge"`
+	PubKeyCredParams       []credentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection authenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// RequestOptions 对应 PublicKeyCredentialRequestOptionsJSON，前端可直接传给 PublicKeyCredential.parseRequestOptionsFromJSON
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	RPID             string                 `json:"rpId"`
+	Timeout          int64                  `json:"timeout"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+type rpEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type userEntity struct {
+	ID          string `json:"id"` // base64url
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type credentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+type authenticatorSelection struct {
+	ResidentKey        string `json:"residentKey"`
+	RequireResidentKey bool   `json:"requireResidentKey"`
+	UserVerification   string `json:"userVerification"`
+}
+
+// RegistrationResponse 对应浏览器 PublicKeyCredential.toJSON() 的注册结果
+type RegistrationResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string   `json:"clientDataJSON"`
+		AttestationObject string   `json:"attestationObject"`
+		Transports        []string `json:"transports"`
+	} `json:"response"`
+}
+
+// AssertionResponse 对应浏览器 PublicKeyCredential.toJSON() 的认证结果
+type AssertionResponse struct {
+	ID       string `json:"id"`
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+		UserHandle        string `json:"userHandle"`
+	} `json:"response"`
+}
+
+// CredentialID 解码 rawId（缺省时退回 id）
+func (r *AssertionResponse) CredentialID() ([]byte, error) {
+	raw := r.RawID
+	if raw == "" {
+		raw = r.ID
+	}
+	return DecodeBase64URL(raw)
+}
+
+// NewChallenge 生成 32 字节的随机挑战，返回 base64url 编码
+func NewChallenge() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return EncodeBase64URL(buf), nil
+}
+
+// NewCreationOptions 构建注册仪式的参数。要求认证器创建可发现凭证并进行用户验证，attestation 固定为 "none"。
+func NewCreationOptions(rp RelyingParty, userHandle []byte, userName, displayName, challenge string, exclude []CredentialDescriptor, timeoutMillis int64) *CreationOptions {
+	params := make([]credentialParameter, len(SupportedAlgorithms))
+	for i, alg := range SupportedAlgorithms {
+		params[i] = credentialParameter{Type: "public-key", Alg: alg}
+	}
+	if exclude == nil {
+		exclude = []CredentialDescriptor{}
+	}
+	return &CreationOptions{
+		RP:                 rpEntity{ID: rp.ID, Name: rp.Name},
+		User:               userEntity{ID: EncodeBase64URL(userHandle), Name: userName, DisplayName: displayName},
+		Challenge:          challenge,
+		PubKeyCredParams:   params,
+		Timeout:            timeoutMillis,
+		ExcludeCredentials: exclude,
+		AuthenticatorSelection: authenticatorSelection{
+			ResidentKey:        "required",
+			RequireResidentKey: true,
+			UserVerification:   "required",
+		},
+		Attestation: "none",
+	}
+}
+
+// NewRequestOptions 构建认证仪式的参数，allow 为空时由认证器提供可发现凭证
+func NewRequestOptions(rp RelyingParty, challenge string, allow []CredentialDescriptor, timeoutMillis int64) *RequestOptions {
+	if allow == nil {
+		allow = []CredentialDescriptor{}
+	}
+	return &RequestOptions{
+		Challenge:        challenge,
+		RPID:             rp.ID,
+		Timeout:          timeoutMillis,
+		AllowCredentials: allow,
+		UserVerification: "required",
+	}
+}
+
+// VerifyRegistration 校验注册仪式的结果，通过后返回需要保存的凭证
+func VerifyRegistration(rp RelyingParty, challenge string, resp *RegistrationResponse) (*Credential, error) {
+	if resp.Type != "public-key" {
+		return nil, fmt.Errorf("%w: 凭证类型无效", ErrVerification)
+	}
+	if _, err := verifyClientData(rp, "webauthn.create", challenge, resp.Response.ClientDataJSON); err != nil {
+		return nil, err
+	}
+
+	attObj, err := DecodeBase64URL(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: attestationObject 编码无效", ErrVerification)
+	}
+	raw, _, err := decodeCBOR(attObj)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrVerification, err)
+	}
+	m, ok := raw.(map[any]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: attestationObject 格式无效", ErrVerification)
+	}
+	authDataBytes, ok := m["authData"].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("%w: 缺少 authData", ErrVerification)
+	}
+	// 注册参数中 attestation 为 "none"，即使认证器仍然返回了其他格式的证明也不予信任，只使用其中的公钥
+
+	authData, err := parseAuthenticatorData(authDataBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := authData.check(rp); err != nil {
+		return nil, err
+	}
+	if authData.flags&flagAttestedCredentialData == 0 || authData.credentialID == nil {
+		return nil, fmt.Errorf("%w: 缺少凭证数据", ErrVerification)
+	}
+	if _, err := parseCOSEKey(authData.credentialPublicKey); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrVerification, err)
+	}
+
+	return &Credential{
+		ID:        authData.credentialID,
+		PublicKey: authData.credentialPublicKey,
+		SignCount: authData.signCount,
+		AAGUID:    authData.aaguid,
+	}, nil
+}
+
+// VerifyAssertion 使用已保存的公钥校验认证仪式的结果，返回认证器最新的签名计数。
+// 调用方负责比较签名计数以发现可能被克隆的认证器。
+func VerifyAssertion(rp RelyingParty, challenge string, credentialPublicKey []byte, resp *AssertionResponse) (uint32, error) {
+	if resp.Type != "public-key" {
+		return 0, fmt.Errorf("%w: 凭证类型无效", ErrVerification)
+	}
+	clientDataJSON, err := verifyClientData(rp, "webauthn.get", challenge, resp.Response.ClientDataJSON)
+	if err != nil {
+		return 0, err
+	}
+
+	authDataBytes, err := DecodeBase64URL(resp.Response.AuthenticatorData)
+	if err != nil {
+		return 0, fmt.Errorf("%w: authenticatorData 编码无效", ErrVerification)
+	}
+	authData, err := parseAuthenticatorData(authDataBytes)
+	if err != nil {
+		return 0, err
+	}
+	if err := authData.check(rp); err != nil {
+		return 0, err
+	}
+
+	signature, err := DecodeBase64URL(resp.Response.Signature)
+	if err != nil {
+		return 0, fmt.Errorf("%w: signature 编码无效", ErrVerification)
+	}
+	key, err := parseCOSEKey(credentialPublicKey)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrVerification, err)
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(append([]byte(nil), authDataBytes...), clientDataHash[:]...)
+	if !key.verify(signed, signature) {
+		return 0, fmt.Errorf("%w: 签名无效", ErrVerification)
+	}
+	return authData.signCount, nil
+}
+
+// SignCountValid 判断认证器返回的签名计数相对已保存的计数是否有效。
+// 签名计数未递增说明认证器可能被克隆；始终返回 0 的认证器（多数同步型通行密钥）不做此检查
+func SignCountValid(stored, received uint32) bool {
+	if stored == 0 && received == 0 {
+		return true
+	}
+	return received > stored
+}
+
+// collectedClientData 对应 CollectedClientData
+type collectedClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// verifyClientData 校验 clientDataJSON 的类型、挑战和来源，返回解码后的原始字节
+func verifyClientData(rp RelyingParty, ceremony, challenge, encoded string) ([]byte, error) {
+	raw, err := DecodeBase64URL(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: clientDataJSON 编码无效", ErrVerification)
+	}
+	var cd collectedClientData
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return nil, fmt.Errorf("%w: clientDataJSON 格式无效", ErrVerification)
+	}
+	if cd.Type != ceremony {
+		return nil, fmt.Errorf("%w: 仪式类型不匹配", ErrVerification)
+	}
+	if subtle.ConstantTimeCompare([]byte(cd.Challenge), []byte(challenge)) != 1 {
+		return nil, fmt.Errorf("%w: 挑战不匹配", ErrVerification)
+	}
+	if cd.Origin != rp.Origin {
+		return nil, fmt.Errorf("%w: 来源 %s 不匹配", ErrVerification, cd.Origin)
+	}
+	return raw, nil
+}
+
+// authenticatorData 是解析后的认证器数据
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+
+	aaguid              []byte
+	credentialID        []byte
+	credentialPublicKey []byte
+}
+
+// parseAuthenticatorData 按 WebAuthn §6.1 的布局解析认证器数据
+func parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, fmt.Errorf("%w: authenticatorData 长度不足", ErrVerification)
+	}
+	ad := &authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	rest := data[37:]
+
+	if ad.flags&flagAttestedCredentialData != 0 {
+		if len(rest) < 18 {
+			return nil, fmt.Errorf("%w: 凭证数据长度不足", ErrVerification)
+		}
+		ad.aaguid = rest[:16]
+		idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if idLen == 0 || idLen > 1023 || len(rest) < idLen {
+			return nil, fmt.Errorf("%w: 凭证 ID 长度无效", ErrVerification)
+		}
+		ad.credentialID = rest[:idLen]
+		rest = rest[idLen:]
+
+		_, keyLen, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("%w: 凭证公钥格式无效", ErrVerification)
+		}
+		ad.credentialPublicKey = rest[:keyLen]
+		rest = rest[keyLen:]
+	}
+	if ad.flags&flagExtensionData != 0 {
+		_, extLen, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("%w: 扩展数据格式无效", ErrVerification)
+		}
+		rest = rest[extLen:]
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: authenticatorData 含有多余数据", ErrVerification)
+	}
+	return ad, nil
+}
+
+// check 校验 RP ID 哈希以及用户在场、用户验证标志
+func (ad *authenticatorData) check(rp RelyingParty) error {
+	expected := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(ad.rpIDHash, expected[:]) {
+		return fmt.Errorf("%w: RP ID 不匹配", ErrVerification)
+	}
+	if ad.flags&flagUserPresent == 0 {
+		return fmt.Errorf("%w: 用户不在场", ErrVerification)
+	}
+	if ad.flags&flagUserVerified == 0 {
+		return fmt.Errorf("%w: 认证器未进行用户验证", ErrVerification)
+	}
+	return nil
+}
+
+// EncodeBase64URL 使用 WebAuthn 约定的无填充 base64url 编码
+func EncodeBase64URL(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeBase64URL 解码 base64url 字符串，兼容带填充的写法
+func DecodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(stripPadding(s))
+}
+
+func stripPadding(s string) string {
+	for len(s) > 0 && s[len(s)-1] == '=' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
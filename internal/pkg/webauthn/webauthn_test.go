@@ -0,0 +1,270 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// 以下测试向量由种子为 0x01..0x20 的 Ed25519 密钥生成，Ed25519 签名是确定性的，可用任意实现复现
+const (
+	testChallenge = "dGVzdC1jaGFsbGVuZ2U" // base64url("test-challenge")
+	testCOSEKey   = "a401010327200621582079b5562e8fe654f94078b112e8a98ba7901f853ae695bed7e0e3910bad049664"
+
+	// 注册：attestation 为 none，标志位 UP|UV|AT，签名计数 0，凭证 ID 为 "test-credential-id"
+	testAttestationObject = "o2NmbXRkbm9uZWdhdHRTdG10oGhhdXRoRGF0YVhzo3mm9u6vuaVeN4wRgDTidR5oL6ufLTCrE9ISVYbOGUdFAAAAAAAAAAAAAAAAAAAAAAAAAAAAEnRlc3QtY3JlZGVudGlhbC1pZKQBAQMnIAYhWCB5tVYuj-ZU-UB4sRLoqYunkB-FOuaVvtfg45ELrQSWZA"
+	// 认证：标志位 UP|UV，签名计数 7
+	testAuthenticatorData = "o3mm9u6vuaVeN4wRgDTidR5oL6ufLTCrE9ISVYbOGUcFAAAABw"
+	testSignature         = "wH_fGcCju748WKoF93GLuCP8c1aSjPz3WV_jekGRVa07e_5TvYjVOIkHoLtWeuCLQc3AmzF7DoZsO2KAJAYyDw"
+)
+
+var testRP = RelyingParty{ID: "example.com", Name: "Example", Origin: "https://example.com"}
+
+func clientDataJSON(ceremony, challenge, origin string) string {
+	return EncodeBase64URL([]byte(`{"type":"` + ceremony + `","challenge":"` + challenge + `","origin":"` + origin + `"}`))
+}
+
+func testEd25519Key() ed25519.PrivateKey {
+	seed := make([]byte, ed25519.SeedSize)
+	for i := range seed {
+		seed[i] = byte(i + 1)
+	}
+	return ed25519.NewKeyFromSeed(seed)
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func newRegistration(attestationObject, clientData string) *RegistrationResponse {
+	resp := &RegistrationResponse{Type: "public-key"}
+	resp.Response.AttestationObject = attestationObject
+	resp.Response.ClientDataJSON = clientData
+	return resp
+}
+
+func newAssertion(authData []byte, clientData string, signature []byte) *AssertionResponse {
+	resp := &AssertionResponse{Type: "public-key"}
+	resp.Response.AuthenticatorData = EncodeBase64URL(authData)
+	resp.Response.ClientDataJSON = clientData
+	resp.Response.Signature = EncodeBase64URL(signature)
+	return resp
+}
+
+// assertionAuthData 构造不含凭证数据的认证器数据
+func assertionAuthData(rpID string, flags byte, signCount uint32) []byte {
+	rpHash := sha256.Sum256([]byte(rpID))
+	return append(rpHash[:], flags, byte(signCount>>24), byte(signCount>>16), byte(signCount>>8), byte(signCount))
+}
+
+// signAssertion 按 WebAuthn 的规则对 authData || sha256(clientDataJSON) 签名
+func signAssertion(t *testing.T, key ed25519.PrivateKey, authData []byte, clientData string) []byte {
+	t.Helper()
+	raw, err := DecodeBase64URL(clientData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256(raw)
+	return ed25519.Sign(key, append(append([]byte(nil), authData...), hash[:]...))
+}
+
+func TestVerifyRegistrationKnownAnswer(t *testing.T) {
+	resp := newRegistration(testAttestationObject, clientDataJSON("webauthn.create", testChallenge, testRP.Origin))
+	cred, err := VerifyRegistration(testRP, testChallenge, resp)
+	if err != nil {
+		t.Fatalf("VerifyRegistration 失败: %v", err)
+	}
+	if string(cred.ID) != "test-credential-id" {
+		t.Errorf("凭证 ID = %q", cred.ID)
+	}
+	if hex.EncodeToString(cred.PublicKey) != testCOSEKey {
+		t.Errorf("凭证公钥 = %x", cred.PublicKey)
+	}
+	if cred.SignCount != 0 || len(cred.AAGUID) != 16 {
+		t.Errorf("签名计数 = %d，AAGUID 长度 = %d", cred.SignCount, len(cred.AAGUID))
+	}
+}
+
+func TestVerifyRegistrationRejects(t *testing.T) {
+	attObj, _ := DecodeBase64URL(testAttestationObject)
+	authDataOffset := len(attObj) - 0x73 // authData 长度为 0x73 字节，位于 attestationObject 末尾
+
+	withFlags := func(flags byte) string {
+		b := append([]byte(nil), attObj...)
+		b[authDataOffset+32] = flags
+		return EncodeBase64URL(b)
+	}
+	validClientData := clientDataJSON("webauthn.create", testChallenge, testRP.Origin)
+
+	tests := []struct {
+		name       string
+		rp         RelyingParty
+		attObj     string
+		clientData string
+	}{
+		{"RP ID 不匹配", RelyingParty{ID: "evil.com", Origin: testRP.Origin}, testAttestationObject, validClientData},
+		{"仪式类型错误", testRP, testAttestationObject, clientDataJSON("webauthn.get", testChallenge, testRP.Origin)},
+		{"挑战不匹配", testRP, testAttestationObject, clientDataJSON("webauthn.create", "b3RoZXI", testRP.Origin)},
+		{"来源不匹配", testRP, testAttestationObject, clientDataJSON("webauthn.create", testChallenge, "https://evil.com")},
+		{"缺少凭证数据标志", testRP, withFlags(flagUserPresent | flagUserVerified), validClientData},
+		{"用户不在场", testRP, withFlags(flagUserVerified | flagAttestedCredentialData), validClientData},
+		{"未进行用户验证", testRP, withFlags(flagUserPresent | flagAttestedCredentialData), validClientData},
+		{"attestationObject 被截断", testRP, EncodeBase64URL(attObj[:len(attObj)-10]), validClientData},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := VerifyRegistration(tt.rp, testChallenge, newRegistration(tt.attObj, tt.clientData))
+			if !errors.Is(err, ErrVerification) {
+				t.Fatalf("期望 ErrVerification，实际 %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyAssertionKnownAnswer(t *testing.T) {
+	authData, _ := DecodeBase64URL(testAuthenticatorData)
+	signature, _ := DecodeBase64URL(testSignature)
+	resp := newAssertion(authData, clientDataJSON("webauthn.get", testChallenge, testRP.Origin), signature)
+
+	signCount, err := VerifyAssertion(testRP, testChallenge, mustHex(t, testCOSEKey), resp)
+	if err != nil {
+		t.Fatalf("VerifyAssertion 失败: %v", err)
+	}
+	if signCount != 7 {
+		t.Errorf("签名计数 = %d，期望 7", signCount)
+	}
+}
+
+func TestVerifyAssertionRejects(t *testing.T) {
+	key := testEd25519Key()
+	publicKey := mustHex(t, testCOSEKey)
+	validClientData := clientDataJSON("webauthn.get", testChallenge, testRP.Origin)
+	validAuthData := assertionAuthData(testRP.ID, flagUserPresent|flagUserVerified, 8)
+
+	// signed 返回一个签名正确的响应，用于验证除签名外的其他检查
+	signed := func(authData []byte, clientData string) *AssertionResponse {
+		return newAssertion(authData, clientData, signAssertion(t, key, authData, clientData))
+	}
+	badSignature := signAssertion(t, key, validAuthData, validClientData)
+	badSignature[0] ^= 0xff
+	otherKey := append([]byte{0xa4, 0x01, 0x01, 0x03, 0x27, 0x20, 0x06, 0x21, 0x58, 0x20}, make([]byte, 32)...)
+	otherKey[len(otherKey)-1] = 1
+
+	tests := []struct {
+		name      string
+		publicKey []byte
+		resp      *AssertionResponse
+	}{
+		{"RP ID 哈希不匹配", publicKey, signed(assertionAuthData("evil.com", flagUserPresent|flagUserVerified, 8), validClientData)},
+		{"签名无效", publicKey, newAssertion(validAuthData, validClientData, badSignature)},
+		{"公钥不匹配", otherKey, signed(validAuthData, validClientData)},
+		{"用户不在场", publicKey, signed(assertionAuthData(testRP.ID, flagUserVerified, 8), validClientData)},
+		{"未进行用户验证", publicKey, signed(assertionAuthData(testRP.ID, flagUserPresent, 8), validClientData)},
+		{"仪式类型错误", publicKey, signed(validAuthData, clientDataJSON("webauthn.create", testChallenge, testRP.Origin))},
+		{"挑战不匹配", publicKey, signed(validAuthData, clientDataJSON("webauthn.get", "b3RoZXI", testRP.Origin))},
+		{"来源不匹配", publicKey, signed(validAuthData, clientDataJSON("webauthn.get", testChallenge, "https://evil.com"))},
+		{"authenticatorData 含有多余数据", publicKey, signed(append(append([]byte(nil), validAuthData...), 0x00), validClientData)},
+		{"authenticatorData 被截断", publicKey, signed(validAuthData[:36], validClientData)},
+		{"扩展数据不是合法 CBOR", publicKey, signed(assertionAuthData(testRP.ID, flagUserPresent|flagUserVerified|flagExtensionData, 8), validClientData)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := VerifyAssertion(testRP, testChallenge, tt.publicKey, tt.resp); !errors.Is(err, ErrVerification) {
+				t.Fatalf("期望 ErrVerification，实际 %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyAssertionES256(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cose := []byte{0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21, 0x58, 0x20}
+	cose = append(cose, priv.X.FillBytes(make([]byte, 32))...)
+	cose = append(cose, 0x22, 0x58, 0x20)
+	cose = append(cose, priv.Y.FillBytes(make([]byte, 32))...)
+
+	authData := assertionAuthData(testRP.ID, flagUserPresent|flagUserVerified, 3)
+	clientData := clientDataJSON("webauthn.get", testChallenge, testRP.Origin)
+	raw, _ := DecodeBase64URL(clientData)
+	clientHash := sha256.Sum256(raw)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, priv, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signCount, err := VerifyAssertion(testRP, testChallenge, cose, newAssertion(authData, clientData, signature))
+	if err != nil || signCount != 3 {
+		t.Fatalf("VerifyAssertion = (%d, %v)，期望 (3, nil)", signCount, err)
+	}
+
+	signature[len(signature)-1] ^= 0x01
+	if _, err := VerifyAssertion(testRP, testChallenge, cose, newAssertion(authData, clientData, signature)); !errors.Is(err, ErrVerification) {
+		t.Fatalf("篡改后的 ES256 签名应被拒绝，实际 %v", err)
+	}
+}
+
+func TestParseCOSEKeyRejects(t *testing.T) {
+	notOnCurve := append([]byte{0xa5, 0x01, 0x02, 0x03, 0x26, 0x20, 0x01, 0x21, 0x58, 0x20}, make([]byte, 32)...)
+	notOnCurve = append(append(notOnCurve, 0x22, 0x58, 0x20), make([]byte, 32)...)
+	notOnCurve[len(notOnCurve)-1] = 1
+
+	tests := []struct {
+		name string
+		hex  string
+		raw  []byte
+	}{
+		{"不是映射", "01", nil},
+		{"算法与密钥类型不一致", "a4010203272006215820" + hex.EncodeToString(make([]byte, 32)), nil},
+		{"Ed25519 曲线错误", "a4010103272001215820" + hex.EncodeToString(make([]byte, 32)), nil},
+		{"Ed25519 公钥长度错误", "a40101032720062141" + "00", nil},
+		{"RSA 模数过短", "a4010303390100204201002143010001", nil},
+		{"ES256 公钥不在曲线上", "", notOnCurve},
+		{"被截断", testCOSEKey[:len(testCOSEKey)-2], nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.raw
+			if data == nil {
+				data = mustHex(t, tt.hex)
+			}
+			if _, err := parseCOSEKey(data); err == nil {
+				t.Fatal("期望返回错误")
+			}
+		})
+	}
+}
+
+func TestSignCountValid(t *testing.T) {
+	tests := []struct {
+		stored, received uint32
+		want             bool
+	}{
+		{0, 0, true},   // 不支持计数的认证器
+		{0, 1, true},   // 首次使用
+		{5, 6, true},   // 正常递增
+		{5, 5, false},  // 未递增
+		{5, 4, false},  // 计数回退
+		{5, 0, false},  // 计数被重置
+		{0, 10, true},  // 注册后开始计数
+		{9, 100, true}, // 跳跃递增
+	}
+	for _, tt := range tests {
+		if got := SignCountValid(tt.stored, tt.received); got != tt.want {
+			t.Errorf("SignCountValid(%d, %d) = %v，期望 %v", tt.stored, tt.received, got, tt.want)
+		}
+	}
+}
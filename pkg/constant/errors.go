@@ -73,4 +73,124 @@ var (
 
 	// ErrAdminEmailUsedByGuest 表示匿名用户尝试使用管理员邮箱发表评论
 	ErrAdminEmailUsedByGuest = errors.New("此邮箱为管理员专属，请登录后发表评论")
+
+	// ErrCommentAuthorEditDisabled 表示站点未开启评论者自助编辑功能，可以由 Handler 转换为 403
+	ErrCommentAuthorEditDisabled = errors.New("评论自助编辑功能未开启")
+
+	// ErrCommentEditTokenInvalid 表示评论编辑令牌无效或已过期，可以由 Handler 转换为 403
+	ErrCommentEditTokenInvalid = errors.New("评论编辑令牌无效或已过期")
+
+	// ErrCommentEditWindowExpired 表示已超出评论可自助编辑的时间窗口，可以由 Handler 转换为 403
+	ErrCommentEditWindowExpired = errors.New("已超出评论可编辑的时间范围")
+
+	// ErrCommentThreadClosed 表示目标评论区已关闭或只读，可以由 Handler 转换为 403
+	ErrCommentThreadClosed = errors.New("该页面的评论区已关闭")
+
+	// ErrBlocked 表示访问者命中了黑名单，可以由 Handler 转换为 403
+	ErrBlocked = errors.New("您已被禁止执行此操作，如有疑问请联系站长")
+
+	// ErrWebmentionDisabled 表示站点未开启 Webmention 接收，可以由 Handler 转换为 403
+	ErrWebmentionDisabled = errors.New("本站未开启 Webmention 接收")
+
+	// ErrTwoFactorCodeInvalid 表示两步验证码或恢复码错误，可以由 Handler 转换为 401
+	ErrTwoFactorCodeInvalid = errors.New("验证码错误或已被使用")
+
+	// ErrTwoFactorChallengeInvalid 表示登录挑战令牌无效或已过期，可以由 Handler 转换为 401
+	ErrTwoFactorChallengeInvalid = errors.New("登录验证已过期，请重新登录")
+
+	// ErrTwoFactorTooManyAttempts 表示两步验证失败次数过多，可以由 Handler 转换为 429
+	ErrTwoFactorTooManyAttempts = errors.New("验证失败次数过多，请稍后再试")
+
+	// ErrTwoFactorAlreadyEnabled 表示用户已启用两步验证，可以由 Handler 转换为 409
+	ErrTwoFactorAlreadyEnabled = errors.New("两步验证已启用")
+
+	// ErrTwoFactorNotEnabled 表示用户尚未启用两步验证，可以由 Handler 转换为 400
+	ErrTwoFactorNotEnabled = errors.New("两步验证未启用")
+
+	// ErrTwoFactorEnforced 表示用户所在的用户组强制要求两步验证，不能关闭，可以由 Handler 转换为 403
+	ErrTwoFactorEnforced = errors.New("您所在的用户组要求启用两步验证，无法关闭")
+
+	// ErrPasskeyChallengeInvalid 表示通行密钥的挑战不存在或已过期，可以由 Handler 转换为 400
+	ErrPasskeyChallengeInvalid = errors.New("通行密钥请求已过期，请重试")
+
+	// ErrPasskeyVerificationFailed 表示通行密钥校验未通过，可以由 Handler 转换为 401
+	ErrPasskeyVerificationFailed = errors.New("通行密钥验证失败")
+
+	// ErrPasskeyNotFound 表示通行密钥不存在或不属于当前用户，可以由 Handler 转换为 404
+	ErrPasskeyNotFound = errors.New("通行密钥不存在")
+
+	// ErrOAuthProviderNotFound 表示第三方登录提供方不存在或未启用，可以由 Handler 转换为 404
+	ErrOAuthProviderNotFound = errors.New("第三方登录方式不存在或未启用")
+
+	// ErrOAuthStateInvalid 表示授权请求的 state 或登录凭据不存在或已过期，可以由 Handler 转换为 400
+	ErrOAuthStateInvalid = errors.New("第三方登录请求已过期，请重试")
+
+	// ErrOAuthIdentityConflict 表示第三方账号已绑定到其他用户，可以由 Handler 转换为 409
+	ErrOAuthIdentityConflict = errors.New("该第三方账号已绑定到其他用户")
+
+	// ErrOAuthEmailUnverified 表示提供方未返回已验证的邮箱，无法关联或注册账户，可以由 Handler 转换为 403
+	ErrOAuthEmailUnverified = errors.New("该第三方账号未提供已验证的邮箱，请先使用邮箱注册并登录，再在个人设置中绑定")
+
+	// ErrOAuthIdentityNotFound 表示绑定关系不存在或不属于当前用户，可以由 Handler 转换为 404
+	ErrOAuthIdentityNotFound = errors.New("第三方账号绑定不存在")
+
+	// ErrAccessTokenInvalid 表示个人访问令牌不存在、已撤销或已过期，可以由 Handler 转换为 401
+	ErrAccessTokenInvalid = errors.New("访问令牌无效或已过期")
+
+	// ErrAccessTokenNotFound 表示个人访问令牌不存在或不属于当前用户，可以由 Handler 转换为 404
+	ErrAccessTokenNotFound = errors.New("访问令牌不存在")
+
+	// ErrAccessTokenScopeInvalid 表示创建令牌时指定了不存在的授权范围，可以由 Handler 转换为 400
+	ErrAccessTokenScopeInvalid = errors.New("访问令牌的授权范围无效")
+
+	// ErrAccessTokenLimitReached 表示用户的令牌数量已达上限，可以由 Handler 转换为 400
+	ErrAccessTokenLimitReached = errors.New("访问令牌数量已达上限，请先撤销不再使用的令牌")
+
+	// ErrSessionNotFound 表示登录会话不存在或不属于当前用户，可以由 Handler 转换为 404
+	ErrSessionNotFound = errors.New("登录会话不存在")
+
+	// ErrTooManyAttempts 表示账户或IP的失败尝试次数过多，已被临时锁定，可以由 Handler 转换为 429
+	ErrTooManyAttempts = errors.New("尝试次数过多，请稍后再试")
+
+	// ErrRegistrationClosed 表示本站未开放注册且没有提供邀请码，可以由 Handler 转换为 403
+	ErrRegistrationClosed = errors.New("本站未开放注册，请使用邀请码注册")
+
+	// ErrInvitationInvalid 表示邀请码不存在、已撤销、已过期、已用完或不适用于当前邮箱，可以由 Handler 转换为 400
+	ErrInvitationInvalid = errors.New("邀请码无效或已失效")
+
+	// ErrInvitationNotFound 表示要操作的邀请码不存在，可以由 Handler 转换为 404
+	ErrInvitationNotFound = errors.New("邀请码不存在")
+
+	// ErrAccountDeletionPending 表示用户已经提交过注销申请，可以由 Handler 转换为 409
+	ErrAccountDeletionPending = errors.New("已存在进行中的注销申请")
+
+	// ErrAccountDeletionNotFound 表示用户没有进行中的注销申请，可以由 Handler 转换为 404
+	ErrAccountDeletionNotFound = errors.New("没有进行中的注销申请")
+
+	// ErrStorageQuotaExceeded 表示本次写入会使用户的存储用量超出用户组的容量上限，可以由 Handler 转换为 413
+	ErrStorageQuotaExceeded = errors.New("存储空间不足，已超出用户组的容量上限")
+
+	// ErrWebDAVAppPasswordRequired 表示账户启用了两步验证，WebDAV 客户端只能使用访问令牌代替密码，可以由 Handler 转换为 401
+	ErrWebDAVAppPasswordRequired = errors.New("账户已启用两步验证，请使用访问令牌作为 WebDAV 密码")
+
+	// ErrShareNotFound 表示分享链接不存在或被分享的文件已被删除，可以由 Handler 转换为 404
+	ErrShareNotFound = errors.New("分享不存在或已被取消")
+
+	// ErrShareUnavailable 表示分享链接已过期或下载次数已用完，可以由 Handler 转换为 410
+	ErrShareUnavailable = errors.New("分享已过期或下载次数已用完")
+
+	// ErrSharePasswordRequired 表示访问该分享需要提供密码，可以由 Handler 转换为 403
+	ErrSharePasswordRequired = errors.New("该分享需要输入访问密码")
+
+	// ErrSharePasswordInvalid 表示提供的分享密码错误，可以由 Handler 转换为 403
+	ErrSharePasswordInvalid = errors.New("分享密码错误")
+
+	// ErrSharePreviewOnly 表示该分享仅允许在线预览，不允许下载，可以由 Handler 转换为 403
+	ErrSharePreviewOnly = errors.New("该分享仅允许预览，不允许下载")
+
+	// ErrSharePreviewUnsupported 表示该文件类型不支持在分享中在线预览，只能下载，可以由 Handler 转换为 415
+	ErrSharePreviewUnsupported = errors.New("该文件类型不支持在线预览")
+
+	// ErrVersionNotFound 表示文件的历史版本不存在或已被删除，可以由 Handler 转换为 404
+	ErrVersionNotFound = errors.New("文件版本不存在")
 )
@@ -20,4 +20,7 @@ const (
 	EventLinkCreated EventTopic = event.LinkCreated
 	EventLinkUpdated EventTopic = event.LinkUpdated
 	EventLinkDeleted EventTopic = event.LinkDeleted
+	// 评论事件
+	EventCommentCreated       EventTopic = event.CommentCreated
+	EventCommentStatusChanged EventTopic = event.CommentStatusChanged
 )
@@ -253,6 +253,10 @@ const (
 	KeyCommentQQAPIKey          SettingKey = "comment.qq_api_key"
 	KeyCommentNotifyAdmin       SettingKey = "comment.notify_admin"
 	KeyCommentNotifyReply       SettingKey = "comment.notify_reply"
+	KeyCommentAuthorEditWindow  SettingKey = "comment.author_edit_window" // 评论者自助编辑/删除评论的时间窗口（分钟），0 表示关闭
+	KeyCommentAutoCloseDays     SettingKey = "comment.auto_close_days"    // 发布超过 N 天的文章/页面自动变为只读评论区，0 表示不自动关闭
+	KeyWebmentionReceiveEnable  SettingKey = "webmention.receive_enable"  // 是否接收其它站点发来的 Webmention
+	KeyWebmentionSendEnable     SettingKey = "webmention.send_enable"     // 文章发布时是否向文中链接的站点发送 Webmention
 	KeyPushooChannel            SettingKey = "pushoo.channel"
 	KeyPushooURL                SettingKey = "pushoo.url"
 	KeyWebhookRequestBody       SettingKey = "webhook.request_body"
@@ -299,6 +303,8 @@ const (
 	KeyActivateAccountTemplate SettingKey = "DEFAULT_ACTIVATE_ACCOUNT_TEMPLATE"
 	KeyEnableUserActivation    SettingKey = "ENABLE_USER_ACTIVATION"
 	KeyEnableRegistration      SettingKey = "ENABLE_REGISTRATION"
+	KeyInviteSkipActivation    SettingKey = "INVITE_SKIP_ACTIVATION"      // 凭邀请码注册的用户是否跳过邮箱激活
+	KeyAccountDeleteGraceDays  SettingKey = "ACCOUNT_DELETION_GRACE_DAYS" // 账户注销申请的宽限天数
 	KeySmtpHost                SettingKey = "SMTP_HOST"
 	KeySmtpPort                SettingKey = "SMTP_PORT"
 	KeySmtpUsername            SettingKey = "SMTP_USERNAME"
@@ -310,6 +316,9 @@ const (
 	KeyLocalFileSigningSecret  SettingKey = "LOCAL_FILE_SIGNING_SECRET"
 	KeyIPAPI                   SettingKey = "IP_API"
 	KeyIPAPIToKen              SettingKey = "IP_API_TOKEN"
+	KeyOAuthProviders          SettingKey = "OAUTH_PROVIDERS"          // 第三方登录提供方配置 (JSON 数组)，包含 client_secret，不可公开
+	KeyAuditLogRetentionDays   SettingKey = "AUDIT_LOG_RETENTION_DAYS" // 审计日志保留天数，0 表示永久保留
+	KeyTrashRetentionDays      SettingKey = "TRASH_RETENTION_DAYS"     // 回收站项目保留天数，0 表示不自动清理
 
 	// --- 关于页面配置 ---
 	KeyAboutPageName                 SettingKey = "about.page.name"
@@ -18,6 +18,8 @@ const (
 	PolicyTypeAliOSS     StoragePolicyType = "aliyun_oss"
 	PolicyTypeS3         StoragePolicyType = "aws_s3"
 	PolicyTypeQiniu      StoragePolicyType = "qiniu_kodo"
+	PolicyTypeSFTP       StoragePolicyType = "sftp"
+	PolicyTypeWebDAV     StoragePolicyType = "webdav"
 
 	// UploadMethodSettingKey 是存储策略中定义上传方式的键
 	UploadMethodSettingKey = "upload_method"
@@ -27,6 +29,22 @@ const (
 	AllowedExtensionsSettingKey = "allowed_extensions"
 	// StyleSeparatorSettingKey 是存储策略中定义样式分隔符的键（用于腾讯云COS和阿里云OSS的图片处理参数）
 	StyleSeparatorSettingKey = "style_separator"
+	// MaxVersionsSettingKey 是存储策略中定义每个文件最多保留的历史版本数的键，0 表示不保留历史版本
+	MaxVersionsSettingKey = "max_versions"
+	// SFTPPrivateKeySettingKey 是 SFTP 策略中定义 PEM 格式登录私钥的键，未配置时使用 SecretKey 作为密码登录
+	SFTPPrivateKeySettingKey = "private_key"
+	// SFTPPrivateKeyPassphraseSettingKey 是 SFTP 策略中定义私钥口令的键
+	SFTPPrivateKeyPassphraseSettingKey = "private_key_passphrase"
+	// SFTPHostKeyFingerprintSettingKey 是 SFTP 策略中定义服务器主机公钥 SHA256 指纹的键，如 "SHA256:xxxx"
+	SFTPHostKeyFingerprintSettingKey = "host_key_fingerprint"
+	// SFTPMaxConnectionsSettingKey 是 SFTP 策略中定义连接池最大连接数的键
+	SFTPMaxConnectionsSettingKey = "max_connections"
+	// WebDAVChunkedUploadSettingKey 是 WebDAV 策略中定义是否以分块传输编码流式上传的键，默认开启；
+	// 不支持分块传输编码的服务器可以关闭，此时文件会先缓存到本地临时文件再带 Content-Length 上传
+	WebDAVChunkedUploadSettingKey = "chunked_upload"
+
+	// ContentStoreDirName 是存储策略根目录下存放去重后共享内容的隐藏目录，文件按内容哈希命名
+	ContentStoreDirName = ".objects"
 
 	// UploadMethodServer 代表服务端中转上传
 	UploadMethodServer = "server"
@@ -54,9 +72,14 @@ const (
 // IsValid 检查给定的类型是否是受支持的存储策略类型
 func (t StoragePolicyType) IsValid() bool {
 	switch t {
-	case PolicyTypeLocal, PolicyTypeOneDrive, PolicyTypeTencentCOS, PolicyTypeAliOSS, PolicyTypeS3, PolicyTypeQiniu:
+	case PolicyTypeLocal, PolicyTypeOneDrive, PolicyTypeTencentCOS, PolicyTypeAliOSS, PolicyTypeS3, PolicyTypeQiniu, PolicyTypeSFTP, PolicyTypeWebDAV:
 		return true
 	default:
 		return false
 	}
 }
+
+// ProxiesDownloads 表示该类型的文件无法直接从存储端下载，需要由服务端读取后流式传输给客户端
+func (t StoragePolicyType) ProxiesDownloads() bool {
+	return t == PolicyTypeLocal || t == PolicyTypeSFTP || t == PolicyTypeWebDAV
+}
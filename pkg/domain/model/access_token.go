@@ -0,0 +1,49 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// 个人访问令牌的授权范围。:read 只允许安全方法 (GET/HEAD)，:write 同时包含对应的 :read
+const (
+	ScopeArticlesRead     = "articles:read"
+	ScopeArticlesWrite    = "articles:write"
+	ScopeFilesRead        = "files:read"
+	ScopeFilesWrite       = "files:write"
+	ScopeCommentsModerate = "comments:moderate"
+)
+
+// AccessTokenScopes 是全部可用的授权范围
+var AccessTokenScopes = []string{
+	ScopeArticlesRead,
+	ScopeArticlesWrite,
+	ScopeFilesRead,
+	ScopeFilesWrite,
+	ScopeCommentsModerate,
+}
+
+// IsValidAccessTokenScope 判断授权范围是否存在
+func IsValidAccessTokenScope(scope string) bool {
+	return slices.Contains(AccessTokenScopes, scope)
+}
+
+// AccessToken 是个人访问令牌的领域模型
+type AccessToken struct {
+	ID          uint
+	UserID      uint
+	Name        string
+	TokenHash   string // 令牌明文的 SHA-256 哈希
+	TokenPrefix string // 令牌明文的前几位，用于辨认
+	Scopes      []string
+	ExpiresAt   *time.Time // 为空表示永不过期
+	LastUsedAt  *time.Time
+	LastUsedIP  string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// IsExpired 判断令牌是否已过期
+func (t *AccessToken) IsExpired() bool {
+	return t.ExpiresAt != nil && time.Now().After(*t.ExpiresAt)
+}
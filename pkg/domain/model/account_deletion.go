@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// 账户注销时已发布内容（文章、评论）的处理方式
+const (
+	AccountContentAnonymize = "anonymize" // 保留内容，移除与账户的关联和个人信息
+	AccountContentDelete    = "delete"    // 一并删除
+)
+
+// AccountDeletion 是账户注销申请的领域模型
+type AccountDeletion struct {
+	ID          uint
+	UserID      uint
+	ContentMode string
+	ScheduledAt time.Time
+	CreatedAt   time.Time
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// ZipDownloadRequest 是把多个文件和文件夹打包为 zip 下载的请求
+type ZipDownloadRequest struct {
+	IDs []string `json:"ids" binding:"required,min=1"` // 待打包的文件或文件夹的公共ID
+}
+
+// ArchiveExtractRequest 是在服务端解压压缩包的请求，支持 zip 和 tar.gz
+type ArchiveExtractRequest struct {
+	ArchiveID string `json:"archive_id" binding:"required"` // 压缩包文件的公共ID
+	TargetID  string `json:"target_id" binding:"required"`  // 解压到的目标文件夹的公共ID
+	Overwrite bool   `json:"overwrite"`                     // 目标位置已有同名文件时是否覆盖
+}
+
+// ArchiveExtractStatus 是后台解压任务的进度，保存在缓存中供前端轮询
+type ArchiveExtractStatus struct {
+	JobID         string     `json:"job_id"`
+	ArchiveID     string     `json:"archive_id"`
+	TargetID      string     `json:"target_id"`
+	IsRunning     bool       `json:"is_running"`
+	Total         int64      `json:"total"`          // 压缩包中的文件总数
+	Extracted     int64      `json:"extracted"`      // 已解压的文件数
+	Skipped       int64      `json:"skipped"`        // 因类型不允许或路径不安全而跳过的文件数
+	Failed        int64      `json:"failed"`         // 解压失败的文件数
+	TotalSize     int64      `json:"total_size"`     // 解压后的总字节数
+	ExtractedSize int64      `json:"extracted_size"` // 已解压的字节数
+	StartTime     *time.Time `json:"start_time,omitempty"`
+	EndTime       *time.Time `json:"end_time,omitempty"`
+	Error         string     `json:"error,omitempty"`
+}
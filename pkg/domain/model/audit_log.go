@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// AuditChange 描述单个字段在操作前后的值
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// AuditLog 是审计日志的领域模型
+type AuditLog struct {
+	ID         uint
+	ActorID    uint   // 0 表示系统或未登录
+	ActorName  string // 操作时的昵称或用户名快照
+	IP         string
+	UserAgent  string
+	Action     string // 例如 setting.update、user.delete
+	TargetType string // 例如 setting、user、article
+	TargetID   string
+	Summary    string
+	Changes    map[string]AuditChange
+	CreatedAt  time.Time
+}
+
+// ListAuditLogsOptions 定义了查询审计日志的过滤和分页条件
+type ListAuditLogsOptions struct {
+	Page       int
+	PageSize   int
+	ActorID    *uint
+	Action     string // 精确匹配；以 "." 结尾时按前缀匹配，例如 "user."
+	TargetType string
+	TargetID   string
+	StartTime  *time.Time
+	EndTime    *time.Time
+}
@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+// BlockRuleType 定义了黑名单规则的匹配类型
+type BlockRuleType string
+
+const (
+	BlockRuleTypeIP        BlockRuleType = "ip"         // IP 地址或 CIDR 网段
+	BlockRuleTypeEmail     BlockRuleType = "email"      // 邮箱地址（忽略大小写）
+	BlockRuleTypeEmailMD5  BlockRuleType = "email_md5"  // 邮箱的 MD5 哈希，可用于封禁只知道头像哈希的评论者
+	BlockRuleTypeUserAgent BlockRuleType = "user_agent" // User Agent 正则表达式
+	BlockRuleTypeUser      BlockRuleType = "user"       // 已登录用户的公共ID，换用其他邮箱或IP也无法绕过
+)
+
+// BlockRule 是黑名单规则的领域模型
+type BlockRule struct {
+	ID        uint          `json:"id"`
+	Type      BlockRuleType `json:"type"`
+	Value     string        `json:"value"`
+	Reason    string        `json:"reason"`
+	ExpiresAt *time.Time    `json:"expires_at"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// IsExpired 判断规则在指定时间是否已过期
+func (r *BlockRule) IsExpired(now time.Time) bool {
+	return r.ExpiresAt != nil && !now.Before(*r.ExpiresAt)
+}
+
+// BlockRuleListQuery 是后台查询黑名单规则列表的参数
+type BlockRuleListQuery struct {
+	Page     int           `form:"page"`
+	PageSize int           `form:"pageSize"`
+	Type     BlockRuleType `form:"type"`
+	Value    string        `form:"value"` // 模糊匹配规则内容
+}
+
+// SaveBlockRuleRequest 是后台创建或更新黑名单规则的请求体
+type SaveBlockRuleRequest struct {
+	Type      BlockRuleType `json:"type" binding:"required,oneof=ip email email_md5 user_agent user"`
+	Value     string        `json:"value" binding:"required,max=512"`
+	Reason    string        `json:"reason" binding:"max=255"`
+	ExpiresAt *time.Time    `json:"expires_at"` // 为空表示永久有效
+}
@@ -48,6 +48,18 @@ type Comment struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	PinnedAt      *time.Time
+
+	// --- 作者自助编辑 ---
+	EditedAt    *time.Time          // 作者最后一次自助编辑的时间
+	EditHistory []CommentEditRecord // 编辑前的历史版本，仅管理员可见
+}
+
+// CommentEditRecord 记录了作者自助编辑前的一个历史版本。
+type CommentEditRecord struct {
+	Content   string    `json:"content"`    // 编辑前的 Markdown 原文
+	EditedAt  time.Time `json:"edited_at"`  // 本次编辑发生的时间
+	IP        string    `json:"ip"`         // 编辑者的IP地址
+	UserAgent string    `json:"user_agent"` // 编辑者的 User Agent
 }
 
 // Author 代表了评论的作者信息
@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// CommentThreadState 定义了评论区的状态
+type CommentThreadState string
+
+const (
+	CommentThreadOpen     CommentThreadState = "open"     // 开放，可以发表新评论
+	CommentThreadClosed   CommentThreadState = "closed"   // 关闭，不接受新评论且隐藏已有评论
+	CommentThreadReadonly CommentThreadState = "readonly" // 只读，保留已有评论但不接受新评论
+)
+
+// AcceptsComments 判断该状态下是否允许发表新评论
+func (s CommentThreadState) AcceptsComments() bool {
+	return s == CommentThreadOpen
+}
+
+// CommentThread 是评论区状态的领域模型，按 TargetPath 区分文章、页面和即刻等不同评论区
+type CommentThread struct {
+	ID         uint               `json:"id"`
+	TargetPath string             `json:"target_path"`
+	State      CommentThreadState `json:"state"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+// CommentThreadListQuery 是后台查询评论区状态列表的参数
+type CommentThreadListQuery struct {
+	Page       int                `form:"page"`
+	PageSize   int                `form:"pageSize"`
+	TargetPath string             `form:"target_path"` // 模糊匹配路径
+	State      CommentThreadState `form:"state"`
+}
+
+// SetCommentThreadStateRequest 是后台设置评论区状态的请求体
+type SetCommentThreadStateRequest struct {
+	TargetPath string             `json:"target_path" binding:"required,max=255"`
+	State      CommentThreadState `json:"state" binding:"required,oneof=open closed readonly"`
+}
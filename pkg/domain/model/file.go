@@ -72,6 +72,7 @@ type File struct {
 	Name      string        // 文件/目录名称
 	Size      int64         // 文件大小 (字节)，目录通常为0
 	Type      FileType      // 类型：文件或目录
+	DeletedAt *time.Time    // 软删除时间，未删除时为 nil
 
 	// PrimaryEntityID 关联到 FileStorageEntity，表示当前文件指向的物理存储实体ID。
 	// 使用 NullUint64 是因为目录或空文件可能没有对应的物理存储实体。
@@ -212,6 +213,18 @@ type CreateFileRequest struct {
 // DeleteItemsRequest 对应删除文件/文件夹的请求体
 type DeleteItemsRequest struct {
 	IDs []string `json:"ids" binding:"required,min=1"`
+	// Permanent 为 true 时跳过回收站直接永久删除，也用于删除回收站中的项目
+	Permanent bool `json:"permanent"`
+}
+
+// RestoreItemsRequest 对应从回收站还原文件/文件夹的请求体
+type RestoreItemsRequest struct {
+	IDs []string `json:"ids" binding:"required,min=1"`
+}
+
+// EmptyTrashResponse 对应清空回收站的响应体
+type EmptyTrashResponse struct {
+	Purged int `json:"purged"` // 永久删除的项目数
 }
 
 // RenameItemRequest 对应重命名文件或文件夹的请求体
@@ -9,8 +9,12 @@ package model
 
 import (
 	"database/sql" // 导入 database/sql 用于 NullString
-	"github.com/anzhiyu-c/anheyu-app/internal/pkg/types"
+	"path/filepath"
+	"strings"
 	"time" // 导入 time 包
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/types"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 )
 
 // StorageProviderType 定义存储提供者类型
@@ -51,6 +55,19 @@ type FileStorageEntity struct {
 	Dimension sql.NullString `json:"dimension,omitempty"` // 媒体文件尺寸
 
 	StorageMetadata map[string]interface{} `json:"storage_metadata,omitempty"` // 存储提供者特有的额外元数据 (非结构化)
+
+	ContentHash    sql.NullString `json:"content_hash,omitempty"` // 文件内容的 SHA-256 哈希，用于内容去重
+	ReferenceCount int            `json:"reference_count"`        // 引用此实体的文件数量
+}
+
+// IsContentAddressed 判断实体的内容是否存放在存储策略的内容寻址目录中。
+// 被多个文件共享的内容会移动到这里，不再位于任何一个文件自身的路径上
+func (e *FileStorageEntity) IsContentAddressed() bool {
+	if !e.Source.Valid {
+		return false
+	}
+	source := filepath.ToSlash(e.Source.String)
+	return strings.HasPrefix(source, constant.ContentStoreDirName+"/") || strings.Contains(source, "/"+constant.ContentStoreDirName+"/")
 }
 
 // EntityType 在领域模型中重新定义，以避免循环依赖或命名冲突。
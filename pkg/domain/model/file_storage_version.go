@@ -35,3 +35,12 @@ type FileStorageVersion struct {
 
 	UploadedByUserID types.NullUint64 `json:"uploaded_by_user_id,omitempty"`
 }
+
+// FileVersionItem 是返回给前端的文件版本信息
+type FileVersionItem struct {
+	ID         string    `json:"id"`                    // 版本的公共ID
+	Size       int64     `json:"size"`                  // 该版本内容的大小
+	IsCurrent  bool      `json:"is_current"`            // 是否为文件当前的内容
+	CreatedAt  time.Time `json:"created_at"`            // 该版本内容的写入时间
+	UploadedBy string    `json:"uploaded_by,omitempty"` // 写入该版本的用户公共ID
+}
@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// InvitationCode 是注册邀请码的领域模型
+type InvitationCode struct {
+	ID          uint
+	Code        string
+	CreatorID   uint
+	UserGroupID uint // 使用该邀请码注册的用户所属的用户组
+	MaxUses     int  // 0 表示不限制
+	UsedCount   int
+	Email       string // 限定使用的邮箱，为空表示不限
+	Note        string
+	ExpiresAt   *time.Time // 为空表示永不过期
+	RevokedAt   *time.Time // 不为空表示已撤销
+	CreatedAt   time.Time
+}
+
+// 邀请码的状态
+const (
+	InvitationStatusActive    = "active"
+	InvitationStatusExhausted = "exhausted"
+	InvitationStatusExpired   = "expired"
+	InvitationStatusRevoked   = "revoked"
+)
+
+// Status 返回邀请码在 now 时刻的状态
+func (c *InvitationCode) Status(now time.Time) string {
+	switch {
+	case c.RevokedAt != nil:
+		return InvitationStatusRevoked
+	case c.ExpiresAt != nil && !now.Before(*c.ExpiresAt):
+		return InvitationStatusExpired
+	case c.MaxUses > 0 && c.UsedCount >= c.MaxUses:
+		return InvitationStatusExhausted
+	default:
+		return InvitationStatusActive
+	}
+}
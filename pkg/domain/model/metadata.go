@@ -33,6 +33,7 @@ const (
 	MetaKeyDuration        = "duration"          // 视频时长
 	MetaKeyWidth           = "width"             // 图片/视频宽度
 	MetaKeyHeight          = "height"            // 图片/视频高度
+	MetaKeyRestoreURI      = "restore_uri"       // 回收站中的项目删除前所在位置的 URI
 
 	// --- EXIF 元数据键 ---
 	MetaKeyExifMake         = "exif_make"          // 相机制造商
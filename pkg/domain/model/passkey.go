@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// Passkey 是用户 WebAuthn 通行密钥的领域模型
+type Passkey struct {
+	ID           uint
+	UserID       uint
+	Name         string
+	CredentialID string // base64url 编码的凭证ID
+	PublicKey    []byte // COSE 编码的公钥
+	SignCount    uint32
+	AAGUID       string
+	Transports   []string
+	LastUsedAt   *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// PolicyMigrationRequest 是将存储策略中的文件迁移到另一个存储策略的请求
+type PolicyMigrationRequest struct {
+	TargetPolicyID string `json:"target_policy_id" binding:"required"` // 目标存储策略的公共ID
+	DeleteSource   bool   `json:"delete_source"`                       // 迁移并校验成功后是否删除源存储中的原文件
+}
+
+// PolicyMigrationStatus 是存储策略迁移任务的进度。
+// 进度保存在缓存中，中断后重新发起同一目标的迁移会从剩余的实体继续，并累计已完成的数量
+type PolicyMigrationStatus struct {
+	SourcePolicyID string     `json:"source_policy_id"`
+	TargetPolicyID string     `json:"target_policy_id"`
+	DeleteSource   bool       `json:"delete_source"`
+	IsRunning      bool       `json:"is_running"`
+	Total          int64      `json:"total"`         // 需要迁移的实体总数，包括此前已迁移的
+	Migrated       int64      `json:"migrated"`      // 已迁移的实体数
+	Failed         int64      `json:"failed"`        // 本轮迁移失败的实体数，重新发起迁移时会再次尝试
+	TotalSize      int64      `json:"total_size"`    // 需要迁移的总字节数
+	MigratedSize   int64      `json:"migrated_size"` // 已迁移的字节数
+	LastEntityID   uint       `json:"last_entity_id"`
+	StartTime      *time.Time `json:"start_time,omitempty"`
+	EndTime        *time.Time `json:"end_time,omitempty"`
+	Error          string     `json:"error,omitempty"`
+}
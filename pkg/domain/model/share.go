@@ -0,0 +1,99 @@
+package model
+
+import (
+	"crypto/subtle"
+	"time"
+)
+
+// Share 是文件分享链接的领域模型
+type Share struct {
+	ID            uint
+	OwnerID       uint
+	FileID        uint
+	Password      string     // 为空表示公开访问
+	ExpiresAt     *time.Time // 为空表示永不过期
+	DownloadLimit int        // 0 表示不限制
+	Downloads     int
+	Views         int
+	PreviewOnly   bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// 分享链接的状态
+const (
+	ShareStatusActive    = "active"
+	ShareStatusExhausted = "exhausted"
+	ShareStatusExpired   = "expired"
+	ShareStatusInvalid   = "invalid" // 被分享的项目已被删除
+)
+
+// Status 返回分享链接在 now 时刻的状态
+func (s *Share) Status(now time.Time) string {
+	switch {
+	case s.ExpiresAt != nil && !now.Before(*s.ExpiresAt):
+		return ShareStatusExpired
+	case s.DownloadLimit > 0 && s.Downloads >= s.DownloadLimit:
+		return ShareStatusExhausted
+	default:
+		return ShareStatusActive
+	}
+}
+
+// CheckPassword 校验访问密码，未设置密码的分享始终通过
+func (s *Share) CheckPassword(password string) bool {
+	if s.Password == "" {
+		return true
+	}
+	return subtle.ConstantTimeCompare([]byte(s.Password), []byte(password)) == 1
+}
+
+// CreateShareRequest 是创建分享链接的请求体
+type CreateShareRequest struct {
+	FileID        string     `json:"file_id" binding:"required"`
+	Password      string     `json:"password" binding:"omitempty,max=32"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	DownloadLimit int        `json:"download_limit" binding:"min=0"`
+	PreviewOnly   bool       `json:"preview_only"`
+}
+
+// UpdateShareRequest 是修改分享链接的请求体，为空的字段保持不变
+type UpdateShareRequest struct {
+	Password      *string    `json:"password" binding:"omitempty,max=32"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	NeverExpire   bool       `json:"never_expire"` // 为 true 时清除过期时间
+	DownloadLimit *int       `json:"download_limit" binding:"omitempty,min=0"`
+	PreviewOnly   *bool      `json:"preview_only"`
+}
+
+// ShareItem 是分享者管理分享链接时看到的信息
+type ShareItem struct {
+	ID            string     `json:"id"`
+	URL           string     `json:"url"`
+	URI           string     `json:"uri"`
+	FileID        string     `json:"file_id"`
+	FileName      string     `json:"file_name"`
+	FileType      int        `json:"file_type"`
+	Password      string     `json:"password"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	DownloadLimit int        `json:"download_limit"`
+	Downloads     int        `json:"downloads"`
+	Views         int        `json:"views"`
+	PreviewOnly   bool       `json:"preview_only"`
+	Status        string     `json:"status"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// ShareInfo 是访问者打开分享链接时看到的信息
+type ShareInfo struct {
+	ID            string     `json:"id"`
+	URI           string     `json:"uri"`
+	Owner         string     `json:"owner"`
+	File          *FileItem  `json:"file"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	DownloadLimit int        `json:"download_limit"`
+	Downloads     int        `json:"downloads"`
+	Views         int        `json:"views"`
+	PreviewOnly   bool       `json:"preview_only"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
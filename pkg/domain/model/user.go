@@ -18,8 +18,53 @@ const (
 	PermissionAccessShare uint = 2
 	PermissionUploadFile  uint = 3
 	PermissionDeleteFile  uint = 4
+
+	PermissionArticleOwn      uint = 5  // 创建文章并管理自己的文章
+	PermissionArticleAny      uint = 6  // 管理所有人的文章，以及分类、标签和文档系列
+	PermissionCommentModerate uint = 7  // 审核和管理评论
+	PermissionLinkManage      uint = 8  // 管理友链
+	PermissionAlbumManage     uint = 9  // 管理相册
+	PermissionSettingManage   uint = 10 // 查看和修改站点配置
+	PermissionThemeManage     uint = 11 // 安装、切换和卸载主题
+	PermissionStatisticsView  uint = 12 // 查看访问统计
+	PermissionWebDAV          uint = 13 // 通过 WebDAV 挂载个人文件
 )
 
+// PermissionInfo 描述了一个权限位，供用户组编辑界面展示
+type PermissionInfo struct {
+	ID          uint   `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// AllPermissions 列出了全部权限位
+var AllPermissions = []PermissionInfo{
+	{ID: PermissionAdmin, Name: "管理员", Description: "拥有全部权限，包括用户、用户组和存储策略管理"},
+	{ID: PermissionCreateShare, Name: "创建分享", Description: "创建文件分享链接"},
+	{ID: PermissionAccessShare, Name: "访问分享", Description: "访问他人的文件分享"},
+	{ID: PermissionUploadFile, Name: "上传文件", Description: "上传文件"},
+	{ID: PermissionDeleteFile, Name: "删除文件", Description: "删除文件"},
+	{ID: PermissionArticleOwn, Name: "撰写文章", Description: "创建文章并管理自己的文章"},
+	{ID: PermissionArticleAny, Name: "管理全部文章", Description: "管理所有人的文章，以及分类、标签和文档系列"},
+	{ID: PermissionCommentModerate, Name: "评论审核", Description: "审核、置顶、删除评论"},
+	{ID: PermissionLinkManage, Name: "友链管理", Description: "审核和管理友链"},
+	{ID: PermissionAlbumManage, Name: "相册管理", Description: "管理相册和相册分类"},
+	{ID: PermissionSettingManage, Name: "站点配置", Description: "查看和修改站点配置"},
+	{ID: PermissionThemeManage, Name: "主题管理", Description: "安装、切换和卸载主题"},
+	{ID: PermissionStatisticsView, Name: "查看统计", Description: "查看访问统计和访客日志"},
+	{ID: PermissionWebDAV, Name: "WebDAV", Description: "通过 WebDAV 客户端挂载个人文件，写入和删除仍受上传、删除文件权限约束"},
+}
+
+// IsValidPermission 判断权限位是否存在
+func IsValidPermission(n uint) bool {
+	for _, p := range AllPermissions {
+		if p.ID == n {
+			return true
+		}
+	}
+	return false
+}
+
 // 用户状态常量定义了用户的几种不同状态
 const (
 	UserStatusActive   = 1
@@ -43,12 +88,16 @@ type User struct {
 	UserGroupID  uint       `json:"userGroupID"`
 	UserGroup    UserGroup  `json:"userGroup"`
 	Status       int        `json:"status"`
+	StorageUsed  int64      `json:"storageUsed"` // 已使用的存储容量（字节）
 }
 
 type GroupSettings struct {
 	SourceBatch      int    `json:"source_batch"`
 	PolicyOrdering   []uint `json:"policy_ordering"`
 	RedirectedSource bool   `json:"redirected_source"`
+	RequireTwoFactor bool   `json:"require_two_factor"` // 是否强制该组用户启用两步验证
+	// PermissionVersion 记录用户组权限位的版本，启动时据此为内置用户组补充新增的默认权限
+	PermissionVersion int `json:"permission_version,omitempty"`
 }
 
 func (s GroupSettings) Value() (driver.Value, error) {
@@ -123,6 +172,19 @@ func (bs *Boolset) Set(n uint, value bool) {
 	}
 }
 
+// HasAny 判断是否拥有任一指定权限，拥有管理员权限位时视为拥有全部权限
+func (bs Boolset) HasAny(perms ...uint) bool {
+	if bs.Enabled(PermissionAdmin) {
+		return true
+	}
+	for _, p := range perms {
+		if bs.Enabled(p) {
+			return true
+		}
+	}
+	return false
+}
+
 // NewBoolset 创建一个新的 Boolset，初始化指定的索引为 true
 func NewBoolset(indices ...uint) Boolset {
 	bs := Boolset{}
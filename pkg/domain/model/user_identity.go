@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// UserIdentity 是用户绑定的第三方登录账号的领域模型
+type UserIdentity struct {
+	ID          uint
+	UserID      uint
+	Provider    string // 对应设置中配置的 provider id
+	Subject     string // 提供方返回的用户唯一标识
+	Email       string
+	DisplayName string
+	AvatarURL   string
+	LastLoginAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// UserSession 是用户登录会话的领域模型，每个会话对应一个刷新令牌
+type UserSession struct {
+	ID         uint
+	UserID     uint
+	SessionID  string // 写入令牌中的会话标识
+	Device     string
+	IP         string
+	UserAgent  string
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// UserTwoFactor 是用户 TOTP 两步验证配置的领域模型
+type UserTwoFactor struct {
+	ID            uint
+	UserID        uint
+	Secret        string   // TOTP 密钥 (Base32)
+	Enabled       bool     // 是否已确认绑定
+	RecoveryCodes []string // 未使用的恢复码哈希
+	LastUsedStep  int64    // 最近一次成功使用的 TOTP 时间步
+	EnabledAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+// WebmentionType 定义了 Webmention 的互动类型
+type WebmentionType string
+
+const (
+	WebmentionTypeMention WebmentionType = "mention" // 普通提及
+	WebmentionTypeLike    WebmentionType = "like"    // 喜欢 (u-like-of)
+	WebmentionTypeRepost  WebmentionType = "repost"  // 转发 (u-repost-of)
+	WebmentionTypeReply   WebmentionType = "reply"   // 回复 (u-in-reply-to)
+)
+
+// WebmentionStatus 定义了 Webmention 的校验状态
+type WebmentionStatus string
+
+const (
+	WebmentionStatusPending  WebmentionStatus = "pending"  // 已接收，等待后台校验
+	WebmentionStatusVerified WebmentionStatus = "verified" // 来源页确实链接到了目标文章
+	WebmentionStatusRejected WebmentionStatus = "rejected" // 来源页未链接到目标文章
+)
+
+// Webmention 是接收到的 Webmention 的领域模型，按 TargetPath 与文章评论关联
+type Webmention struct {
+	ID          uint             `json:"id"`
+	Source      string           `json:"source"`
+	Target      string           `json:"target"`
+	TargetPath  string           `json:"target_path"`
+	Type        WebmentionType   `json:"type"`
+	Status      WebmentionStatus `json:"status"`
+	AuthorName  string           `json:"author_name"`
+	AuthorURL   string           `json:"author_url"`
+	AuthorPhoto string           `json:"author_photo"`
+	Content     string           `json:"content"`
+	PublishedAt *time.Time       `json:"published_at"`
+	VerifiedAt  *time.Time       `json:"verified_at"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// WebmentionListQuery 是后台查询 Webmention 列表的参数
+type WebmentionListQuery struct {
+	Page       int              `form:"page"`
+	PageSize   int              `form:"pageSize"`
+	TargetPath string           `form:"target_path"`
+	Status     WebmentionStatus `form:"status"`
+	Type       WebmentionType   `form:"type"`
+}
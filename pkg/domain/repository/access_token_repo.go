@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// AccessTokenRepository 定义了个人访问令牌的持久化操作接口
+type AccessTokenRepository interface {
+	// Create 保存新创建的令牌
+	Create(ctx context.Context, token *model.AccessToken) error
+
+	// FindByHash 根据令牌哈希查找令牌，不存在时返回 nil, nil
+	FindByHash(ctx context.Context, tokenHash string) (*model.AccessToken, error)
+
+	// FindByID 根据ID查找令牌，不存在时返回 nil, nil
+	FindByID(ctx context.Context, id uint) (*model.AccessToken, error)
+
+	// ListByUserID 获取用户的全部令牌，按创建时间倒序
+	ListByUserID(ctx context.Context, userID uint) ([]*model.AccessToken, error)
+
+	// CountByUserID 统计用户的令牌数量
+	CountByUserID(ctx context.Context, userID uint) (int, error)
+
+	// UpdateUsage 记录令牌的最近使用时间和IP
+	UpdateUsage(ctx context.Context, id uint, ip string) error
+
+	// Delete 撤销（删除）令牌
+	Delete(ctx context.Context, id uint) error
+
+	// DeleteByUserID 撤销用户的全部令牌，返回撤销的数量
+	DeleteByUserID(ctx context.Context, userID uint) (int, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// AccountDeletionRepository 定义了账户注销申请的持久化操作接口
+type AccountDeletionRepository interface {
+	// Create 保存注销申请，每个用户同时只能有一个申请
+	Create(ctx context.Context, deletion *model.AccountDeletion) error
+
+	// FindByUserID 查找用户的注销申请，不存在时返回 nil, nil
+	FindByUserID(ctx context.Context, userID uint) (*model.AccountDeletion, error)
+
+	// ListDue 获取计划时间不晚于 before 的注销申请
+	ListDue(ctx context.Context, before time.Time) ([]*model.AccountDeletion, error)
+
+	// DeleteByUserID 删除用户的注销申请，用于撤销申请或注销完成后清理
+	DeleteByUserID(ctx context.Context, userID uint) error
+}
@@ -94,4 +94,7 @@ type ArticleRepository interface {
 	// ExistsByTitle 检查标题是否已被其他文章使用
 	// excludeDBID 为 0 时检查所有文章，否则排除指定 ID 的文章
 	ExistsByTitle(ctx context.Context, title string, excludeDBID uint) (bool, error)
+
+	// TransferOwnership 将 fromOwnerID 名下的全部文章转给 toOwnerID，返回转移的数量
+	TransferOwnership(ctx context.Context, fromOwnerID, toOwnerID uint) (int, error)
 }
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// AuditLogRepository 定义了审计日志的持久化操作接口
+type AuditLogRepository interface {
+	// Create 保存一条审计日志
+	Create(ctx context.Context, log *model.AuditLog) error
+
+	// List 按条件分页查询审计日志，按时间倒序。PageSize 为 0 时返回全部匹配的记录
+	List(ctx context.Context, opts *model.ListAuditLogsOptions) ([]*model.AuditLog, int, error)
+
+	// DeleteBefore 删除指定时间之前的审计日志，返回删除的条数
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// BlockRuleRepository 定义了黑名单规则的持久化操作接口
+type BlockRuleRepository interface {
+	BaseRepository[model.BlockRule]
+
+	// List 按条件分页查询黑名单规则
+	List(ctx context.Context, query *model.BlockRuleListQuery) (*PageResult[model.BlockRule], error)
+
+	// FindActive 查询在指定时间仍然有效（未过期）的全部规则
+	FindActive(ctx context.Context, now time.Time) ([]*model.BlockRule, error)
+}
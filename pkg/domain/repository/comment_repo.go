@@ -59,6 +59,26 @@ type UpdateCommentInfoParams struct {
 	Website     *string // 用户网站
 }
 
+// AuthorUpdateCommentParams 定义了评论作者自助编辑评论的参数
+type AuthorUpdateCommentParams struct {
+	Content     string                  // 更新后的 Markdown 内容
+	ContentHTML string                  // 更新后的 HTML 内容
+	Status      int                     // 重新审核后的评论状态
+	History     model.CommentEditRecord // 编辑前的版本，将被追加到编辑历史中
+}
+
+// CommentAuthorFilter 定义了按作者查找评论的条件，各条件之间为"或"的关系，至少需要提供一个
+type CommentAuthorFilter struct {
+	UserID   *uint  // 登录用户发表的评论
+	Email    string // 评论时填写的邮箱，忽略大小写
+	EmailMD5 string // 邮箱的 MD5 哈希
+}
+
+// IsEmpty 判断是否没有提供任何条件
+func (f CommentAuthorFilter) IsEmpty() bool {
+	return f.UserID == nil && f.Email == "" && f.EmailMD5 == ""
+}
+
 // CommentRepository 定义了评论数据的持久化操作接口。
 type CommentRepository interface {
 	// 创建一条新评论
@@ -99,6 +119,9 @@ type CommentRepository interface {
 	// 更新评论的用户信息和内容（仅限管理员）
 	UpdateCommentInfo(ctx context.Context, id uint, params *UpdateCommentInfoParams) (*model.Comment, error)
 
+	// 保存评论作者的自助编辑，并追加编辑历史
+	UpdateByAuthor(ctx context.Context, id uint, params *AuthorUpdateCommentParams) (*model.Comment, error)
+
 	// 更新评论的路径（用于处理文章或页面slug变更的情况）
 	UpdatePath(ctx context.Context, oldPath, newPath string) (int, error)
 
@@ -110,4 +133,18 @@ type CommentRepository interface {
 
 	// 批量统计多个文章的评论数量
 	CountByTargetPaths(ctx context.Context, targetPaths []string) (map[string]int, error)
+
+	// 查找指定路径下最早一条评论的创建时间，没有评论时返回 nil
+	FindEarliestCreatedAtByPath(ctx context.Context, path string) (*time.Time, error)
+
+	// --- 个人数据相关 ---
+
+	// 查找符合作者条件的全部评论（包括待审核的），按创建时间升序
+	FindByAuthor(ctx context.Context, filter CommentAuthorFilter) ([]*model.Comment, error)
+
+	// 解除评论与用户的关联并清除作者的个人信息，评论内容保留，昵称替换为 nickname
+	AnonymizeByUser(ctx context.Context, userID uint, nickname string) (int, error)
+
+	// 清除符合作者条件的评论的内容和个人信息，并将其删除
+	EraseByAuthor(ctx context.Context, filter CommentAuthorFilter) (int, error)
 }
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// CommentThreadRepository 定义了评论区状态的持久化操作接口
+type CommentThreadRepository interface {
+	// FindByPath 查找指定路径的评论区状态，没有单独设置时返回 nil, nil
+	FindByPath(ctx context.Context, targetPath string) (*model.CommentThread, error)
+
+	// Upsert 设置指定路径的评论区状态，不存在时创建
+	Upsert(ctx context.Context, targetPath string, state model.CommentThreadState) (*model.CommentThread, error)
+
+	// DeleteByPath 删除指定路径的单独设置，使其恢复为默认规则
+	DeleteByPath(ctx context.Context, targetPath string) error
+
+	// List 按条件分页查询已单独设置的评论区
+	List(ctx context.Context, query *model.CommentThreadListQuery) (*PageResult[model.CommentThread], error)
+}
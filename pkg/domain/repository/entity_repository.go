@@ -50,4 +50,26 @@ type EntityRepository interface {
 
 	// DeleteByStoragePolicyID 删除指定存储策略下的所有实体记录。
 	DeleteByStoragePolicyID(ctx context.Context, policyID uint) error
+
+	// FindByContentHash 查找指定存储策略下内容哈希相同且已完成上传的实体，按 ID 升序返回。
+	FindByContentHash(ctx context.Context, policyID uint, contentHash string) ([]*model.FileStorageEntity, error)
+
+	// FindWithoutContentHash 按 ID 升序查找 ID 大于 afterID、尚未计算内容哈希且已完成上传的实体，最多返回 limit 个。
+	FindWithoutContentHash(ctx context.Context, afterID uint, limit int) ([]*model.FileStorageEntity, error)
+
+	// FindMigratableByStoragePolicyID 按 ID 升序查找指定存储策略下 ID 大于 afterID、已完成上传的实体，最多返回 limit 个。
+	FindMigratableByStoragePolicyID(ctx context.Context, policyID uint, afterID uint, limit int) ([]*model.FileStorageEntity, error)
+
+	// IsSourceUsedByEntities 检查指定存储策略下是否还有实体使用给定的存储位置。
+	IsSourceUsedByEntities(ctx context.Context, policyID uint, source string) (bool, error)
+
+	// SetContentHash 只更新实体的内容哈希，不影响其他字段。
+	SetContentHash(ctx context.Context, id uint, contentHash string) error
+
+	// AddReference 为实体增加 delta 个文件引用。
+	AddReference(ctx context.Context, id uint, delta int) error
+
+	// ReleaseReference 释放实体的一个文件引用。返回 true 表示这是最后一个引用，
+	// 此时引用计数保持不变，由调用方删除实体记录和物理文件。
+	ReleaseReference(ctx context.Context, id uint) (bool, error)
 }
@@ -22,6 +22,9 @@ type FileEntityRepository interface {
 	// FindCurrentByFileID 根据逻辑文件 ID 查找其当前关联的物理实体版本。
 	FindCurrentByFileID(ctx context.Context, fileID uint) (*model.FileStorageVersion, error)
 
+	// ListByFileID 按创建时间倒序列出某个逻辑文件的全部版本关联。
+	ListByFileID(ctx context.Context, fileID uint) ([]*model.FileStorageVersion, error)
+
 	// MarkOldVersionsAsNotCurrent 将某个逻辑文件的所有旧版本标记为非当前版本。
 	MarkOldVersionsAsNotCurrent(ctx context.Context, fileID uint, excludeVersionID uint) error
 
@@ -42,4 +45,10 @@ type FileEntityRepository interface {
 
 	// DeleteByEntityIDs 根据实体ID列表删除所有相关的文件实体关联记录。
 	DeleteByEntityIDs(ctx context.Context, entityIDs []uint) error
+
+	// DeleteByFileAndEntityID 删除某个逻辑文件与某个物理实体之间的全部关联记录，不影响共享该实体的其他文件。
+	DeleteByFileAndEntityID(ctx context.Context, fileID, entityID uint) error
+
+	// ReplaceEntityID 将所有指向 oldEntityID 的关联记录改为指向 newEntityID。
+	ReplaceEntityID(ctx context.Context, oldEntityID, newEntityID uint) error
 }
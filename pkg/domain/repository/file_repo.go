@@ -9,6 +9,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 )
@@ -70,6 +71,9 @@ type FileRepository interface {
 	IsDescendant(ctx context.Context, ancestorID uint, potentialDescendantID uint) (bool, error)
 
 	// FindByParentIDAndNameUnscoped 在指定父目录下查找文件或目录，不考虑软删除。
+	FindByParentIDAndNameUnscoped(ctx context.Context, parentID uint, name string) (*model.File, error)
+
+	// CreateOrUpdate 创建文件，同名文件已存在时更新，已软删除时恢复并更新。
 	CreateOrUpdate(ctx context.Context, file *model.File) (finalFile *model.File, status CreationStatus, err error)
 
 	// 查询所有子项，包括软删除，并返回 SyncItem
@@ -118,4 +122,23 @@ type FileRepository interface {
 
 	// SoftDeleteByOwnerID 软删除指定用户的所有文件
 	SoftDeleteByOwnerID(ctx context.Context, ownerID uint) error
+
+	// ListByOwnerID 获取指定用户未删除的全部文件和目录
+	ListByOwnerID(ctx context.Context, ownerID uint) ([]*model.File, error)
+
+	// SoftDeleteTree 以同一个删除时间软删除指定项目及其所有未删除的后代，用于放入回收站。
+	SoftDeleteTree(ctx context.Context, id uint, deletedAt time.Time) error
+
+	// RestoreTree 还原指定项目及其删除时间与 deletedAt 相同的后代。
+	// 在此之前已被单独删除的后代保持删除状态。
+	RestoreTree(ctx context.Context, id uint, deletedAt time.Time) error
+
+	// ListTrashed 列出指定用户回收站中的顶层项目，按删除时间倒序排列。
+	ListTrashed(ctx context.Context, ownerID uint) ([]*model.File, error)
+
+	// ListTrashedBefore 列出所有用户在 before 之前放入回收站的顶层项目。
+	ListTrashedBefore(ctx context.Context, before time.Time) ([]*model.File, error)
+
+	// ReplacePrimaryEntity 将所有以 oldEntityID 为当前实体的文件（包括回收站中的）改为指向 newEntityID，返回修改的文件数。
+	ReplacePrimaryEntity(ctx context.Context, oldEntityID, newEntityID uint) (int, error)
 }
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// InvitationCodeRepository 定义了注册邀请码的持久化操作接口
+type InvitationCodeRepository interface {
+	// Create 保存新的邀请码
+	Create(ctx context.Context, code *model.InvitationCode) error
+
+	// FindByCode 根据邀请码查找，不存在时返回 nil, nil
+	FindByCode(ctx context.Context, code string) (*model.InvitationCode, error)
+
+	// FindByID 根据ID查找邀请码，不存在时返回 nil, nil
+	FindByID(ctx context.Context, id uint) (*model.InvitationCode, error)
+
+	// List 分页获取邀请码，按创建时间倒序
+	List(ctx context.Context, page, pageSize int) ([]*model.InvitationCode, int, error)
+
+	// IncrementUsage 在邀请码仍然可用时将使用次数加一，返回是否成功。
+	// 判断和更新在同一条语句中完成，并发注册时不会超出最大使用次数。
+	IncrementUsage(ctx context.Context, id uint) (bool, error)
+
+	// DecrementUsage 将使用次数减一，用于注册失败时归还名额
+	DecrementUsage(ctx context.Context, id uint) error
+
+	// Revoke 撤销邀请码
+	Revoke(ctx context.Context, id uint) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// PasskeyRepository 定义了通行密钥的持久化操作接口
+type PasskeyRepository interface {
+	// Create 保存新注册的通行密钥
+	Create(ctx context.Context, passkey *model.Passkey) error
+
+	// FindByCredentialID 根据凭证ID (base64url) 查找通行密钥，不存在时返回 nil, nil
+	FindByCredentialID(ctx context.Context, credentialID string) (*model.Passkey, error)
+
+	// FindByID 根据ID查找通行密钥，不存在时返回 nil, nil
+	FindByID(ctx context.Context, id uint) (*model.Passkey, error)
+
+	// ListByUserID 获取用户的全部通行密钥，按创建时间倒序
+	ListByUserID(ctx context.Context, userID uint) ([]*model.Passkey, error)
+
+	// UpdateName 修改通行密钥名称
+	UpdateName(ctx context.Context, id uint, name string) error
+
+	// UpdateUsage 记录一次成功的登录，更新签名计数和最近使用时间
+	UpdateUsage(ctx context.Context, id uint, signCount uint32) error
+
+	// Delete 删除通行密钥
+	Delete(ctx context.Context, id uint) error
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// ShareRepository 定义了文件分享链接的持久化操作接口
+type ShareRepository interface {
+	// Create 保存新的分享链接
+	Create(ctx context.Context, share *model.Share) error
+
+	// FindByID 根据ID查找分享链接，不存在时返回 nil, nil
+	FindByID(ctx context.Context, id uint) (*model.Share, error)
+
+	// ListByOwner 分页获取用户创建的分享链接，按创建时间倒序
+	ListByOwner(ctx context.Context, ownerID uint, page, pageSize int) ([]*model.Share, int, error)
+
+	// Update 保存分享链接的密码、过期时间、下载次数上限和预览模式
+	Update(ctx context.Context, share *model.Share) error
+
+	// Delete 删除分享链接
+	Delete(ctx context.Context, id uint) error
+
+	// IncrementViews 将浏览次数加一
+	IncrementViews(ctx context.Context, id uint) error
+
+	// IncrementDownloads 在未达到下载次数上限时将下载次数加一，返回是否成功。
+	// 判断和更新在同一条语句中完成，并发下载时不会超出上限。
+	IncrementDownloads(ctx context.Context, id uint) (bool, error)
+}
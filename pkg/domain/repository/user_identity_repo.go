@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// UserIdentityRepository 定义了第三方登录账号的持久化操作接口
+type UserIdentityRepository interface {
+	// FindByProviderSubject 根据提供方和用户标识查找绑定关系，不存在时返回 nil, nil
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*model.UserIdentity, error)
+
+	// FindByID 根据ID查找绑定关系，不存在时返回 nil, nil
+	FindByID(ctx context.Context, id uint) (*model.UserIdentity, error)
+
+	// ListByUserID 获取用户绑定的全部第三方账号
+	ListByUserID(ctx context.Context, userID uint) ([]*model.UserIdentity, error)
+
+	// Create 创建绑定关系
+	Create(ctx context.Context, identity *model.UserIdentity) error
+
+	// UpdateProfile 登录成功后同步提供方返回的资料并记录登录时间
+	UpdateProfile(ctx context.Context, identity *model.UserIdentity) error
+
+	// Delete 解除绑定
+	Delete(ctx context.Context, id uint) error
+}
@@ -33,6 +33,12 @@ type UserRepository interface {
 	// List 分页查询用户列表，支持搜索关键词、用户组筛选和状态筛选
 	List(ctx context.Context, page, pageSize int, keyword string, groupID *uint, status *int) ([]*model.User, int64, error)
 
+	// AdjustStorageUsed 增量调整用户已使用的存储容量，delta 为负数表示释放，结果不会小于 0
+	AdjustStorageUsed(ctx context.Context, userID uint, delta int64) error
+
+	// RecalculateStorageUsed 根据用户名下的文件重新统计已使用的存储容量，返回统计结果
+	RecalculateStorageUsed(ctx context.Context, userID uint) (int64, error)
+
 	// Count 统计用户总数
 	Count(ctx context.Context) (int64, error)
 
@@ -47,4 +53,7 @@ type UserGroupRepository interface {
 
 	// FindAll 获取所有用户组
 	FindAll(ctx context.Context) ([]*model.UserGroup, error)
+
+	// Save 创建或更新用户组
+	Save(ctx context.Context, group *model.UserGroup) error
 }
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// UserSessionRepository 定义了登录会话的持久化操作接口
+type UserSessionRepository interface {
+	// Create 保存新的会话
+	Create(ctx context.Context, session *model.UserSession) error
+
+	// FindBySessionID 根据会话标识查找会话，不存在时返回 nil, nil
+	FindBySessionID(ctx context.Context, sessionID string) (*model.UserSession, error)
+
+	// FindByID 根据ID查找会话，不存在时返回 nil, nil
+	FindByID(ctx context.Context, id uint) (*model.UserSession, error)
+
+	// ListByUserID 获取用户未过期的全部会话，按最近使用时间倒序
+	ListByUserID(ctx context.Context, userID uint) ([]*model.UserSession, error)
+
+	// Touch 刷新令牌时记录最近使用的时间、IP 和 User-Agent
+	Touch(ctx context.Context, id uint, ip, userAgent string) error
+
+	// Delete 删除会话
+	Delete(ctx context.Context, id uint) error
+
+	// DeleteByUserID 删除用户的全部会话
+	DeleteByUserID(ctx context.Context, userID uint) error
+
+	// DeleteExpired 删除用户已过期的会话
+	DeleteExpired(ctx context.Context, userID uint) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// UserTwoFactorRepository 定义了用户两步验证配置的持久化操作接口
+type UserTwoFactorRepository interface {
+	// FindByUserID 查找用户的两步验证配置，不存在时返回 nil, nil
+	FindByUserID(ctx context.Context, userID uint) (*model.UserTwoFactor, error)
+
+	// Save 创建或更新两步验证配置（ID 为 0 时创建）
+	Save(ctx context.Context, tf *model.UserTwoFactor) error
+
+	// DeleteByUserID 删除用户的两步验证配置
+	DeleteByUserID(ctx context.Context, userID uint) error
+}
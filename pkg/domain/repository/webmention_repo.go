@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// WebmentionRepository 定义了 Webmention 的持久化操作接口
+type WebmentionRepository interface {
+	BaseRepository[model.Webmention]
+
+	// FindBySourceAndTarget 根据来源和目标查找 Webmention，不存在时返回 nil, nil
+	FindBySourceAndTarget(ctx context.Context, source, target string) (*model.Webmention, error)
+
+	// List 按条件分页查询 Webmention
+	List(ctx context.Context, query *model.WebmentionListQuery) (*PageResult[model.Webmention], error)
+
+	// FindVerifiedByPath 查询指定路径下所有已校验通过的 Webmention，按创建时间升序
+	FindVerifiedByPath(ctx context.Context, targetPath string) ([]*model.Webmention, error)
+}
@@ -0,0 +1,175 @@
+// pkg/handler/account/handler.go
+package account
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/account"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 负责处理个人数据导出和账户注销相关的 API 请求
+type Handler struct {
+	svc account.Service
+}
+
+// NewHandler 是 Handler 的构造函数
+func NewHandler(svc account.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// RequestDeletionRequest 定义了提交注销申请的请求结构
+type RequestDeletionRequest struct {
+	Password    string `json:"password" binding:"required"`
+	ContentMode string `json:"content_mode" binding:"omitempty,oneof=anonymize delete"` // anonymize-匿名化保留（默认）, delete-一并删除
+}
+
+// DeletionResponse 定义了注销申请的响应结构
+type DeletionResponse struct {
+	ContentMode string    `json:"content_mode"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Export 导出个人数据
+// @Summary      导出个人数据
+// @Description  将账户资料、文章（Markdown）、评论、文件清单和通知设置打包为 ZIP 下载
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      application/zip
+// @Success      200  {file}    file  "ZIP文件下载"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      500  {object}  response.Response  "导出失败"
+// @Router       /user/data-export [get]
+func (h *Handler) Export(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	data, err := h.svc.Export(c.Request.Context(), userID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "导出个人数据失败: "+err.Error())
+		return
+	}
+	filename := fmt.Sprintf("personal_data_%s.zip", time.Now().Format("20060102_150405"))
+	c.Header("Content-Disposition", "attachment; filename="+filename)
+	c.Header("Content-Length", strconv.Itoa(len(data)))
+	c.Data(http.StatusOK, "application/zip", data)
+}
+
+// GetDeletion 获取进行中的注销申请
+// @Summary      获取注销申请
+// @Description  没有进行中的注销申请时 data 为 null
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=DeletionResponse}  "获取成功"
+// @Router       /user/deletion [get]
+func (h *Handler) GetDeletion(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	deletion, err := h.svc.GetDeletion(c.Request.Context(), userID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if deletion == nil {
+		response.Success(c, nil, "获取成功")
+		return
+	}
+	response.Success(c, toDeletionResponse(deletion), "获取成功")
+}
+
+// RequestDeletion 申请注销账户
+// @Summary      申请注销账户
+// @Description  需要验证当前密码。申请后进入宽限期，宽限期内可以撤销，期满后账户及个人信息将被删除，已发布的文章和评论按 content_mode 匿名化保留或一并删除
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      RequestDeletionRequest  true  "注销申请"
+// @Success      200   {object}  response.Response{data=DeletionResponse}  "已提交注销申请"
+// @Failure      400   {object}  response.Response  "参数错误或密码错误"
+// @Failure      409   {object}  response.Response  "已存在进行中的注销申请"
+// @Router       /user/deletion [post]
+func (h *Handler) RequestDeletion(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	var req RequestDeletionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+	deletion, err := h.svc.RequestDeletion(c.Request.Context(), userID, req.Password, req.ContentMode)
+	if err != nil {
+		if errors.Is(err, constant.ErrAccountDeletionPending) {
+			response.Fail(c, http.StatusConflict, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, toDeletionResponse(deletion), "已提交注销申请")
+}
+
+// CancelDeletion 撤销注销申请
+// @Summary      撤销注销申请
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response  "已撤销注销申请"
+// @Failure      404  {object}  response.Response  "没有进行中的注销申请"
+// @Router       /user/deletion [delete]
+func (h *Handler) CancelDeletion(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	if err := h.svc.CancelDeletion(c.Request.Context(), userID); err != nil {
+		if errors.Is(err, constant.ErrAccountDeletionNotFound) {
+			response.Fail(c, http.StatusNotFound, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "已撤销注销申请")
+}
+
+// currentUserID 从登录信息中解析当前用户的数据库ID，失败时已写入响应
+func currentUserID(c *gin.Context) (uint, bool) {
+	claimsValue, exists := c.Get(auth.ClaimsKey)
+	claims, ok := claimsValue.(*auth.CustomClaims)
+	if !exists || !ok {
+		response.Fail(c, http.StatusUnauthorized, "无法获取用户信息，请确认是否已登录")
+		return 0, false
+	}
+	userID, entityType, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusUnauthorized, "用户ID无效")
+		return 0, false
+	}
+	return userID, true
+}
+
+func toDeletionResponse(d *model.AccountDeletion) DeletionResponse {
+	return DeletionResponse{
+		ContentMode: d.ContentMode,
+		ScheduledAt: d.ScheduledAt,
+		CreatedAt:   d.CreatedAt,
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	articleSvc "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 
 	"github.com/gin-gonic/gin"
 )
@@ -239,6 +240,21 @@ func (h *Handler) Create(c *gin.Context) {
 		log.Printf("[Handler.Create] CustomUpdatedAt 值: %s", *req.CustomUpdatedAt)
 	}
 
+	// 只能以自己的身份发布文章，拥有管理全部文章权限的用户可以指定作者
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+	if req.OwnerID == 0 || !claims.HasPermission(model.PermissionArticleAny) {
+		currentUserID, _, err := idgen.DecodePublicID(claims.UserID)
+		if err != nil {
+			response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+			return
+		}
+		req.OwnerID = currentUserID
+	}
+
 	// 使用改进的IP获取方法，优先检查代理头部
 	clientIP := util.GetRealClientIP(c)
 	// 获取客户端 Referer，用于 NSUUU API 白名单验证
@@ -429,12 +445,8 @@ func (h *Handler) List(c *gin.Context) {
 		return
 	}
 
-	// 检查是否为管理员（通过 UserGroupID 判断，管理员组ID为 1）
-	var isAdmin bool
-	userGroupID, entityType, err := idgen.DecodePublicID(claims.UserGroupID)
-	if err == nil && entityType == idgen.EntityTypeUserGroup && userGroupID == 1 {
-		isAdmin = true
-	}
+	// 拥有管理全部文章权限的用户可以查看所有人的文章
+	canManageAny := claims.HasPermission(model.PermissionArticleAny)
 
 	// 解码当前用户的数据库ID
 	currentUserDBID, _, err := idgen.DecodePublicID(claims.UserID)
@@ -454,8 +466,8 @@ func (h *Handler) List(c *gin.Context) {
 		}
 	}
 
-	// 如果是普通用户，必须传递 author_id 且必须与当前用户ID匹配
-	if !isAdmin {
+	// 否则必须传递 author_id 且必须与当前用户ID匹配
+	if !canManageAny {
 		if authorID == nil {
 			response.Fail(c, http.StatusForbidden, "普通用户必须指定 author_id 参数")
 			return
@@ -485,6 +497,40 @@ func (h *Handler) List(c *gin.Context) {
 	response.Success(c, result, "获取列表成功")
 }
 
+// RequireOwnership 校验当前用户是否可以操作路径参数 id 指定的文章。
+// 拥有管理全部文章权限的用户不受限制，其余用户只能操作自己的文章。
+func (h *Handler) RequireOwnership(c *gin.Context) {
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		c.Abort()
+		return
+	}
+	if claims.HasPermission(model.PermissionArticleAny) {
+		c.Next()
+		return
+	}
+
+	currentUserID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		c.Abort()
+		return
+	}
+	ownerID, err := h.svc.GetArticleOwnerID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		response.Fail(c, http.StatusNotFound, "文章未找到")
+		c.Abort()
+		return
+	}
+	if ownerID != currentUserID {
+		response.Fail(c, http.StatusForbidden, "您只能操作自己的文章")
+		c.Abort()
+		return
+	}
+	c.Next()
+}
+
 // getClaims 从 gin.Context 中安全地提取 JWT Claims
 func getClaims(c *gin.Context) (*auth.CustomClaims, error) {
 	claimsValue, exists := c.Get(auth.ClaimsKey)
@@ -680,6 +726,7 @@ func (h *Handler) BatchDelete(c *gin.Context) {
 	}
 
 	log.Printf("[Handler.BatchDelete] 批量删除完成 - 成功: %d, 失败: %d", result.SuccessCount, result.FailedCount)
+	audit.SetTarget(c.Request.Context(), strings.Join(req.IDs, ","), fmt.Sprintf("批量删除文章，成功 %d 篇，失败 %d 篇", result.SuccessCount, result.FailedCount))
 	response.Success(c, result, "批量删除完成")
 }
 
@@ -17,6 +17,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	historySvc "github.com/anzhiyu-c/anheyu-app/pkg/service/article_history"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 )
 
 // Handler 封装了所有与文章历史版本相关的 HTTP 处理器
@@ -212,6 +213,7 @@ func (h *Handler) RestoreVersion(c *gin.Context) {
 		response.Fail(c, http.StatusNotFound, "获取历史版本失败: "+err.Error())
 		return
 	}
+	audit.SetTarget(c.Request.Context(), "", fmt.Sprintf("恢复文章到历史版本 %d", version))
 
 	response.Success(c, history, "获取历史版本成功，请使用返回的数据调用更新文章接口完成恢复")
 }
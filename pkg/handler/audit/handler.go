@@ -0,0 +1,213 @@
+// pkg/handler/audit/handler.go
+package audit
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 负责处理审计日志相关的 API 请求
+type Handler struct {
+	svc audit.Service
+}
+
+// NewHandler 是 Handler 的构造函数
+func NewHandler(svc audit.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// ListQuery 定义了查询审计日志的参数
+type ListQuery struct {
+	Page       int    `form:"page,default=1" binding:"min=1"`
+	PageSize   int    `form:"pageSize,default=20" binding:"min=1,max=100"`
+	ActorID    string `form:"actor_id"`    // 操作者公共ID
+	Action     string `form:"action"`      // 操作类型，以 "." 结尾时按前缀匹配，例如 user.
+	TargetType string `form:"target_type"` // 对象类型
+	TargetID   string `form:"target_id"`   // 对象ID (模糊匹配)
+	Start      string `form:"start"`       // 起始时间，RFC3339 或 2006-01-02
+	End        string `form:"end"`         // 结束时间，RFC3339 或 2006-01-02 (包含当天)
+}
+
+// AuditLogDTO 是审计日志的响应结构
+type AuditLogDTO struct {
+	ID         uint                         `json:"id"`
+	ActorID    string                       `json:"actor_id"`
+	ActorName  string                       `json:"actor_name"`
+	IP         string                       `json:"ip"`
+	UserAgent  string                       `json:"user_agent"`
+	Action     string                       `json:"action"`
+	TargetType string                       `json:"target_type"`
+	TargetID   string                       `json:"target_id"`
+	Summary    string                       `json:"summary"`
+	Changes    map[string]model.AuditChange `json:"changes,omitempty"`
+	CreatedAt  time.Time                    `json:"created_at"`
+}
+
+// ListResponse 是审计日志列表的响应结构
+type ListResponse struct {
+	List     []AuditLogDTO `json:"list"`
+	Total    int           `json:"total"`
+	Page     int           `json:"page"`
+	PageSize int           `json:"pageSize"`
+}
+
+// List
+// @Summary      获取审计日志
+// @Description  分页获取后台操作的审计日志，可按操作者、操作类型、对象和时间范围筛选
+// @Tags         审计日志
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量" default(20)
+// @Param        actor_id query string false "操作者公共ID"
+// @Param        action query string false "操作类型，以 . 结尾时按前缀匹配，例如 user."
+// @Param        target_type query string false "对象类型"
+// @Param        target_id query string false "对象ID"
+// @Param        start query string false "起始时间 (RFC3339 或 YYYY-MM-DD)"
+// @Param        end query string false "结束时间 (RFC3339 或 YYYY-MM-DD)"
+// @Success      200 {object} response.Response{data=ListResponse} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/audit-logs [get]
+func (h *Handler) List(c *gin.Context) {
+	var query ListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	opts, err := query.toOptions()
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	opts.Page, opts.PageSize = query.Page, query.PageSize
+
+	items, total, err := h.svc.List(c.Request.Context(), opts)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取审计日志失败: "+err.Error())
+		return
+	}
+
+	list := make([]AuditLogDTO, 0, len(items))
+	for _, item := range items {
+		list = append(list, toAuditLogDTO(item))
+	}
+	response.Success(c, ListResponse{
+		List:     list,
+		Total:    total,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	}, "获取审计日志成功")
+}
+
+// Export
+// @Summary      导出审计日志
+// @Description  按筛选条件导出审计日志为 CSV 文件，最多导出 50000 条
+// @Tags         审计日志
+// @Security     BearerAuth
+// @Produce      text/csv
+// @Param        actor_id query string false "操作者公共ID"
+// @Param        action query string false "操作类型，以 . 结尾时按前缀匹配，例如 user."
+// @Param        target_type query string false "对象类型"
+// @Param        target_id query string false "对象ID"
+// @Param        start query string false "起始时间 (RFC3339 或 YYYY-MM-DD)"
+// @Param        end query string false "结束时间 (RFC3339 或 YYYY-MM-DD)"
+// @Success      200 {file} file "CSV 文件"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /admin/audit-logs/export [get]
+func (h *Handler) Export(c *gin.Context) {
+	var query ListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	opts, err := query.toOptions()
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 先写入缓冲区，导出失败时仍可以返回 JSON 错误
+	var buf bytes.Buffer
+	if err := h.svc.ExportCSV(c.Request.Context(), opts, &buf); err != nil {
+		response.Fail(c, http.StatusInternalServerError, "导出审计日志失败: "+err.Error())
+		return
+	}
+
+	filename := fmt.Sprintf("audit-logs-%s.csv", time.Now().Format("20060102150405"))
+	c.Header("Content-Disposition", "attachment; filename="+filename)
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// toOptions 把查询参数转换为仓储层的过滤条件，不包含分页
+func (q *ListQuery) toOptions() (*model.ListAuditLogsOptions, error) {
+	opts := &model.ListAuditLogsOptions{
+		Action:     q.Action,
+		TargetType: q.TargetType,
+		TargetID:   q.TargetID,
+	}
+	if q.ActorID != "" {
+		actorID, entityType, err := idgen.DecodePublicID(q.ActorID)
+		if err != nil || entityType != idgen.EntityTypeUser {
+			return nil, fmt.Errorf("无效的操作者ID")
+		}
+		opts.ActorID = &actorID
+	}
+	if q.Start != "" {
+		start, _, err := parseTime(q.Start)
+		if err != nil {
+			return nil, fmt.Errorf("起始时间格式无效")
+		}
+		opts.StartTime = &start
+	}
+	if q.End != "" {
+		end, dateOnly, err := parseTime(q.End)
+		if err != nil {
+			return nil, fmt.Errorf("结束时间格式无效")
+		}
+		if dateOnly {
+			// 只有日期时包含当天的全部记录
+			end = end.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		opts.EndTime = &end
+	}
+	return opts, nil
+}
+
+// parseTime 解析 RFC3339 或 YYYY-MM-DD (按服务器本地时区) 格式的时间，第二个返回值表示是否只有日期
+func parseTime(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	return t, true, err
+}
+
+func toAuditLogDTO(item *model.AuditLog) AuditLogDTO {
+	dto := AuditLogDTO{
+		ID:         item.ID,
+		ActorName:  item.ActorName,
+		IP:         item.IP,
+		UserAgent:  item.UserAgent,
+		Action:     item.Action,
+		TargetType: item.TargetType,
+		TargetID:   item.TargetID,
+		Summary:    item.Summary,
+		Changes:    item.Changes,
+		CreatedAt:  item.CreatedAt,
+	}
+	if item.ActorID > 0 {
+		dto.ActorID, _ = idgen.GeneratePublicID(item.ActorID, idgen.EntityTypeUser)
+	}
+	return dto
+}
@@ -1,16 +1,20 @@
 package auth_handler
 
 import (
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/captcha"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/oauth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 
 	"github.com/gin-gonic/gin"
@@ -18,19 +22,27 @@ import (
 
 // AuthHandler 封装了所有认证相关的控制器方法
 type AuthHandler struct {
-	authSvc    auth.AuthService
-	tokenSvc   auth.TokenService
-	settingSvc setting.SettingService
-	captchaSvc captcha.CaptchaService
+	authSvc      auth.AuthService
+	tokenSvc     auth.TokenService
+	settingSvc   setting.SettingService
+	captchaSvc   captcha.CaptchaService
+	twoFactorSvc auth.TwoFactorService
+	passkeySvc   auth.PasskeyService
+	oauthSvc     oauth.Service
+	guardSvc     auth.LoginGuardService
 }
 
 // NewAuthHandler 是 AuthHandler 的构造函数，用于依赖注入
-func NewAuthHandler(authSvc auth.AuthService, tokenSvc auth.TokenService, settingSvc setting.SettingService, captchaSvc captcha.CaptchaService) *AuthHandler {
+func NewAuthHandler(authSvc auth.AuthService, tokenSvc auth.TokenService, settingSvc setting.SettingService, captchaSvc captcha.CaptchaService, twoFactorSvc auth.TwoFactorService, passkeySvc auth.PasskeyService, oauthSvc oauth.Service, guardSvc auth.LoginGuardService) *AuthHandler {
 	return &AuthHandler{
-		authSvc:    authSvc,
-		tokenSvc:   tokenSvc,
-		settingSvc: settingSvc,
-		captchaSvc: captchaSvc,
+		authSvc:      authSvc,
+		tokenSvc:     tokenSvc,
+		settingSvc:   settingSvc,
+		captchaSvc:   captchaSvc,
+		twoFactorSvc: twoFactorSvc,
+		passkeySvc:   passkeySvc,
+		oauthSvc:     oauthSvc,
+		guardSvc:     guardSvc,
 	}
 }
 
@@ -61,6 +73,7 @@ type RegisterRequest struct {
 	Nickname       string `json:"nickname" binding:"required"`
 	Password       string `json:"password" binding:"required,min=6"`
 	RepeatPassword string `json:"repeat_password" binding:"required"`
+	InviteCode     string `json:"invite_code"` // 邀请码，未开放注册时必填
 	CaptchaParams
 }
 
@@ -122,6 +135,7 @@ type LoginUserInfoResponse struct {
 // @Success      200   {object}  response.Response{data=object{userInfo=LoginUserInfoResponse,roles=[]string,accessToken=string,refreshToken=string,expires=string}}  "登录成功"
 // @Failure      400   {object}  response.Response  "邮箱或密码格式不正确"
 // @Failure      401   {object}  response.Response  "认证失败"
+// @Failure      429   {object}  response.Response  "失败次数过多，账户或IP已被临时锁定"
 // @Failure      500   {object}  response.Response  "内部错误"
 // @Router       /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
@@ -146,32 +160,65 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	// 1. 调用认证服务进行登录逻辑处理
+	// 1. 账户或IP因失败次数过多被锁定时，不再校验密码
+	ip := c.ClientIP()
+	if err := h.guardSvc.Check(c.Request.Context(), auth.AttemptScopeLogin, req.Email, ip); err != nil {
+		failTooManyAttempts(c, err)
+		return
+	}
+
+	// 2. 调用认证服务进行登录逻辑处理
 	user, err := h.authSvc.Login(c.Request.Context(), req.Email, req.Password)
 	if err != nil {
+		if lockErr := h.guardSvc.RecordFailure(c.Request.Context(), auth.AttemptScopeLogin, req.Email, ip); lockErr != nil {
+			failTooManyAttempts(c, lockErr)
+			return
+		}
 		response.Fail(c, http.StatusUnauthorized, err.Error())
 		return
 	}
+	h.guardSvc.RecordSuccess(c.Request.Context(), auth.AttemptScopeLogin, req.Email)
+
+	// 3. 用户启用了两步验证（或其用户组强制要求）时，先返回挑战令牌，通过第二步验证后再签发会话令牌
+	challenge, err := h.twoFactorSvc.NewLoginChallenge(c.Request.Context(), user)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if challenge != nil {
+		response.Success(c, gin.H{
+			"twoFactorRequired":  true,
+			"challengeToken":     challenge.ChallengeToken,
+			"expires":            challenge.Expires,
+			"enrollmentRequired": challenge.EnrollmentRequired,
+		}, "请完成两步验证")
+		return
+	}
+
+	h.respondLoginSuccess(c, user, nil)
+}
 
-	// 2. 调用令牌服务生成会话令牌
+// respondLoginSuccess 为已通过全部验证的用户签发会话令牌并返回登录成功响应，extra 中的字段会合并到响应数据中
+func (h *AuthHandler) respondLoginSuccess(c *gin.Context, user *model.User, extra gin.H) {
+	// 1. 调用令牌服务生成会话令牌
 	// 注意：这里的 GenerateSessionTokens 内部也需要更新为使用 GeneratePublicID
-	accessToken, refreshToken, expires, err := h.tokenSvc.GenerateSessionTokens(c.Request.Context(), user)
+	accessToken, refreshToken, expires, err := h.tokenSvc.GenerateSessionTokens(c.Request.Context(), user, clientInfo(c))
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "生成令牌失败: "+err.Error())
 		return
 	}
 
-	// 3. 构建 roles 数组
+	// 2. 构建 roles 数组
 	roles := []string{fmt.Sprintf("%d", user.UserGroupID)}
 
-	// 4. 生成用户的公共 ID
+	// 3. 生成用户的公共 ID
 	publicUserID, err := idgen.GeneratePublicID(user.ID, idgen.EntityTypeUser) // 统一使用 GeneratePublicID
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "生成用户公共ID失败")
 		return
 	}
 
-	// 5. 生成用户组的公共 ID
+	// 4. 生成用户组的公共 ID
 	publicUserGroupID, err := idgen.GeneratePublicID(user.UserGroup.ID, idgen.EntityTypeUserGroup) // 统一使用 GeneratePublicID
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "生成用户组公共ID失败")
@@ -185,7 +232,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		avatar = gravatarBaseURL + avatar
 	}
 
-	// 6. 构建 LoginUserInfoResponse DTO，只包含需要暴露给客户端的字段
+	// 5. 构建 LoginUserInfoResponse DTO，只包含需要暴露给客户端的字段
 	userInfoResp := LoginUserInfoResponse{
 		ID:          publicUserID, // 返回公共ID
 		CreatedAt:   user.CreatedAt,
@@ -204,25 +251,31 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		Status: user.Status,
 	}
 
-	// 7. 返回成功响应
-	response.Success(c, gin.H{
+	// 6. 返回成功响应
+	data := gin.H{
 		"userInfo":     userInfoResp, // 返回包含公共ID和用户组信息的 DTO
 		"roles":        roles,
 		"accessToken":  accessToken,
 		"refreshToken": refreshToken,
 		"expires":      expires,
-	}, "登录成功")
+	}
+	for k, v := range extra {
+		data[k] = v
+	}
+	response.Success(c, data, "登录成功")
 }
 
 // Register 处理用户注册请求
 // @Summary      用户注册
-// @Description  创建新用户账号
+// @Description  创建新用户账号。未开放注册时需要提供邀请码
 // @Tags         用户认证
 // @Accept       json
 // @Produce      json
 // @Param        body  body      RegisterRequest  true  "注册信息"
 // @Success      200   {object}  response.Response  "注册成功"
-// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      400   {object}  response.Response  "参数错误或邀请码无效"
+// @Failure      403   {object}  response.Response  "未开放注册"
+// @Failure      409   {object}  response.Response  "邮箱已被注册"
 // @Failure      500   {object}  response.Response  "内部错误"
 // @Router       /auth/register [post]
 func (h *AuthHandler) Register(c *gin.Context) {
@@ -251,9 +304,16 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		return
 	}
 
-	activationRequired, err := h.authSvc.Register(c.Request.Context(), req.Email, req.Nickname, req.Password)
+	activationRequired, err := h.authSvc.Register(c.Request.Context(), req.Email, req.Nickname, req.Password, req.InviteCode)
 	if err != nil {
-		response.Fail(c, http.StatusConflict, err.Error())
+		switch {
+		case errors.Is(err, constant.ErrRegistrationClosed):
+			response.Fail(c, http.StatusForbidden, err.Error())
+		case errors.Is(err, constant.ErrInvitationInvalid):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		default:
+			response.Fail(c, http.StatusConflict, err.Error())
+		}
 		return
 	}
 
@@ -264,6 +324,11 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	response.Success(c, gin.H{"activation_required": activationRequired}, message)
 }
 
+// clientInfo 获取发起请求的客户端信息，记录到登录会话中
+func clientInfo(c *gin.Context) auth.ClientInfo {
+	return auth.ClientInfo{IP: c.ClientIP(), UserAgent: c.Request.UserAgent()}
+}
+
 // RefreshToken 刷新访问 Token
 // @Summary      刷新访问令牌
 // @Description  使用刷新令牌获取新的访问令牌
@@ -293,7 +358,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	}
 
 	// 注意：这里的 RefreshAccessToken 内部也需要更新为使用 DecodePublicID
-	accessToken, expires, err := h.tokenSvc.RefreshAccessToken(c.Request.Context(), refreshToken)
+	accessToken, expires, err := h.tokenSvc.RefreshAccessToken(c.Request.Context(), refreshToken, clientInfo(c))
 	if err != nil {
 		response.Fail(c, http.StatusUnauthorized, err.Error())
 		return
@@ -315,6 +380,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 // @Success      200  {object}  response.Response{data=object{userInfo=LoginUserInfoResponse,roles=[]string,accessToken=string,refreshToken=string,expires=string}}  "账户已成功激活并登录"
 // @Failure      400  {object}  response.Response  "参数错误或激活链接无效"
 // @Failure      401  {object}  response.Response  "激活失败"
+// @Failure      429  {object}  response.Response  "失败次数过多，请稍后再试"
 // @Router       /auth/activate [post]
 func (h *AuthHandler) ActivateUser(c *gin.Context) {
 	var req ActivateUserRequest
@@ -334,10 +400,20 @@ func (h *AuthHandler) ActivateUser(c *gin.Context) {
 		return
 	}
 
+	ip := c.ClientIP()
+	if err := h.guardSvc.Check(c.Request.Context(), auth.AttemptScopeActivate, req.PublicUserID, ip); err != nil {
+		failTooManyAttempts(c, err)
+		return
+	}
 	if err := h.authSvc.ActivateUser(c.Request.Context(), userID, req.Sign); err != nil { // 传递数据库ID
+		if lockErr := h.guardSvc.RecordFailure(c.Request.Context(), auth.AttemptScopeActivate, req.PublicUserID, ip); lockErr != nil {
+			failTooManyAttempts(c, lockErr)
+			return
+		}
 		response.Fail(c, http.StatusUnauthorized, err.Error())
 		return
 	}
+	h.guardSvc.RecordSuccess(c.Request.Context(), auth.AttemptScopeActivate, req.PublicUserID)
 
 	// 激活成功后，获取用户信息并生成登录令牌
 	user, err := h.authSvc.GetUserByID(c.Request.Context(), userID)
@@ -346,8 +422,24 @@ func (h *AuthHandler) ActivateUser(c *gin.Context) {
 		return
 	}
 
+	// 用户组强制两步验证时，激活后同样需要先完成绑定才能登录
+	challenge, err := h.twoFactorSvc.NewLoginChallenge(c.Request.Context(), user)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "激活成功，但检查两步验证状态失败")
+		return
+	}
+	if challenge != nil {
+		response.Success(c, gin.H{
+			"twoFactorRequired":  true,
+			"challengeToken":     challenge.ChallengeToken,
+			"expires":            challenge.Expires,
+			"enrollmentRequired": challenge.EnrollmentRequired,
+		}, "账户已成功激活，请完成两步验证")
+		return
+	}
+
 	// 生成会话令牌
-	accessToken, refreshToken, expires, err := h.tokenSvc.GenerateSessionTokens(c.Request.Context(), user)
+	accessToken, refreshToken, expires, err := h.tokenSvc.GenerateSessionTokens(c.Request.Context(), user, clientInfo(c))
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "激活成功，但生成登录令牌失败")
 		return
@@ -415,6 +507,7 @@ func (h *AuthHandler) ActivateUser(c *gin.Context) {
 // @Param        body  body  object{email=string}  true  "邮箱地址"
 // @Success      200  {object}  response.Response  "如果该邮箱已注册，将收到重置邮件"
 // @Failure      400  {object}  response.Response  "邮箱格式不正确"
+// @Failure      429  {object}  response.Response  "请求过于频繁，请稍后再试"
 // @Router       /auth/forgot-password [post]
 func (h *AuthHandler) ForgotPasswordRequest(c *gin.Context) {
 	var req ForgotPasswordRequest
@@ -438,6 +531,17 @@ func (h *AuthHandler) ForgotPasswordRequest(c *gin.Context) {
 		return
 	}
 
+	// 每次请求都计入尝试次数，防止被用于向他人邮箱批量发送邮件
+	ip := c.ClientIP()
+	if err := h.guardSvc.Check(c.Request.Context(), auth.AttemptScopeForgotPassword, req.Email, ip); err != nil {
+		failTooManyAttempts(c, err)
+		return
+	}
+	if err := h.guardSvc.RecordFailure(c.Request.Context(), auth.AttemptScopeForgotPassword, req.Email, ip); err != nil {
+		// 触发锁定的这次请求仍然正常处理，之后的请求才会被拒绝
+		log.Printf("[ForgotPassword] %s 的找回密码请求过于频繁: %v", req.Email, err)
+	}
+
 	// 调用 service，无论用户是否存在，都返回成功，防止邮箱枚举攻击
 	h.authSvc.RequestPasswordReset(c.Request.Context(), req.Email)
 	response.Success(c, nil, "如果该邮箱已注册，您将会收到一封密码重置邮件。")
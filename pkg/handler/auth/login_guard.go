@@ -0,0 +1,68 @@
+package auth_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ClearLockoutRequest 定义了解除锁定的请求结构
+type ClearLockoutRequest struct {
+	Scope   string `json:"scope" binding:"required"`
+	Kind    string `json:"kind" binding:"required,oneof=account ip"`
+	Subject string `json:"subject" binding:"required"`
+}
+
+// AdminListLockouts 获取当前生效中的锁定
+// @Summary      获取登录锁定列表
+// @Description  列出因失败次数过多而被临时锁定的账户和IP。scope 为 login/forgot_password/activate/subscribe_code/subscribe
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]auth.Lockout}  "获取成功"
+// @Failure      500  {object}  response.Response  "服务器内部错误"
+// @Router       /admin/lockouts [get]
+func (h *AuthHandler) AdminListLockouts(c *gin.Context) {
+	lockouts, err := h.guardSvc.ListLockouts(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, lockouts, "获取成功")
+}
+
+// AdminClearLockout 解除锁定
+// @Summary      解除登录锁定
+// @Description  解除指定账户或IP的锁定，并清空其失败次数
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      ClearLockoutRequest  true  "锁定信息"
+// @Success      200   {object}  response.Response  "已解除锁定"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Router       /admin/lockouts [delete]
+func (h *AuthHandler) AdminClearLockout(c *gin.Context) {
+	var req ClearLockoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+	if err := h.guardSvc.ClearLockout(c.Request.Context(), auth.AttemptScope(req.Scope), auth.LockoutKind(req.Kind), req.Subject); err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, nil, "已解除锁定")
+}
+
+// failTooManyAttempts 以 429 响应锁定错误，并通过 Retry-After 头告知剩余的锁定时长
+func failTooManyAttempts(c *gin.Context, err error) {
+	if lockErr, ok := auth.AsLockoutError(err); ok {
+		c.Header("Retry-After", strconv.Itoa(int(lockErr.RetryAfter.Seconds())+1))
+	}
+	response.Fail(c, http.StatusTooManyRequests, err.Error())
+}
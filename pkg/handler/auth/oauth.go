@@ -0,0 +1,253 @@
+package auth_handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OAuthAuthorizeRequest 定义了发起第三方登录的请求结构
+type OAuthAuthorizeRequest struct {
+	Redirect string `json:"redirect"` // 登录完成后跳转的站内路径，默认为首页
+}
+
+// OAuthExchangeRequest 定义了兑换第三方登录凭据的请求结构
+type OAuthExchangeRequest struct {
+	Ticket string `json:"ticket" binding:"required"`
+}
+
+// OAuthIdentityResponse 定义了已绑定第三方账号列表中每一项的响应结构
+type OAuthIdentityResponse struct {
+	ID          string     `json:"id"` // 绑定关系的公共ID
+	Provider    string     `json:"provider"`
+	Email       string     `json:"email"`
+	DisplayName string     `json:"display_name"`
+	AvatarURL   string     `json:"avatar_url"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+}
+
+// ListOAuthProviders 获取已启用的第三方登录方式
+// @Summary      获取第三方登录方式
+// @Description  获取后台已配置并启用的第三方登录方式，不包含密钥
+// @Tags         用户认证
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]oauth.PublicProvider}  "获取成功"
+// @Router       /auth/oauth/providers [get]
+func (h *AuthHandler) ListOAuthProviders(c *gin.Context) {
+	response.Success(c, h.oauthSvc.ListProviders(), "获取成功")
+}
+
+// OAuthAuthorize 发起第三方登录
+// @Summary      发起第三方登录
+// @Description  返回跳转到提供方的授权地址（授权码模式 + PKCE）。授权完成后提供方会回调 /auth/oauth/{provider}/callback
+// @Tags         用户认证
+// @Accept       json
+// @Produce      json
+// @Param        provider  path      string                 true   "提供方ID"
+// @Param        body      body      OAuthAuthorizeRequest  false  "跳转信息"
+// @Success      200       {object}  response.Response{data=object{url=string}}  "获取成功"
+// @Failure      404       {object}  response.Response  "登录方式不存在"
+// @Router       /auth/oauth/:provider/authorize [post]
+func (h *AuthHandler) OAuthAuthorize(c *gin.Context) {
+	h.oauthAuthorize(c, 0)
+}
+
+// LinkOAuthIdentity 为当前用户绑定第三方账号
+// @Summary      绑定第三方账号
+// @Description  返回跳转到提供方的授权地址，授权完成后第三方账号将绑定到当前用户
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        provider  path      string                 true   "提供方ID"
+// @Param        body      body      OAuthAuthorizeRequest  false  "跳转信息"
+// @Success      200       {object}  response.Response{data=object{url=string}}  "获取成功"
+// @Failure      401       {object}  response.Response  "未授权"
+// @Failure      404       {object}  response.Response  "登录方式不存在"
+// @Router       /user/oauth/:provider/link [post]
+func (h *AuthHandler) LinkOAuthIdentity(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	h.oauthAuthorize(c, user.ID)
+}
+
+func (h *AuthHandler) oauthAuthorize(c *gin.Context, linkUserID uint) {
+	var req OAuthAuthorizeRequest
+	// 请求体可以为空
+	_ = c.ShouldBindJSON(&req)
+
+	authURL, err := h.oauthSvc.AuthorizeURL(c.Request.Context(), c.Param("provider"), req.Redirect, linkUserID)
+	if err != nil {
+		response.Fail(c, oauthErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, gin.H{"url": authURL}, "获取成功")
+}
+
+// OAuthCallback 处理提供方的授权回调
+// @Summary      第三方登录回调
+// @Description  由提供方重定向调用。处理完成后重定向到前端 /oauth/callback 页面，并携带一次性凭据 ticket 或错误信息 error
+// @Tags         用户认证
+// @Param        provider  path   string  true   "提供方ID"
+// @Param        code      query  string  false  "授权码"
+// @Param        state     query  string  false  "状态参数"
+// @Success      302  "重定向到前端页面"
+// @Router       /auth/oauth/:provider/callback [get]
+func (h *AuthHandler) OAuthCallback(c *gin.Context) {
+	query := url.Values{}
+	if providerErr := c.Query("error"); providerErr != "" {
+		// 用户在提供方处拒绝授权
+		query.Set("error", "第三方授权已取消")
+	} else {
+		result, err := h.oauthSvc.HandleCallback(c.Request.Context(), c.Param("provider"), c.Query("code"), c.Query("state"))
+		if err != nil {
+			query.Set("error", err.Error())
+		} else {
+			query.Set("ticket", result.Ticket)
+			query.Set("redirect", result.Redirect)
+		}
+	}
+
+	siteURL := strings.TrimRight(h.settingSvc.Get(constant.KeySiteURL.String()), "/")
+	c.Redirect(http.StatusFound, siteURL+"/oauth/callback?"+query.Encode())
+}
+
+// OAuthExchange 使用一次性凭据完成第三方登录
+// @Summary      兑换第三方登录凭据
+// @Description  前端回调页使用 ticket 换取会话令牌。用户启用了两步验证时返回挑战令牌；绑定操作则返回 linked=true
+// @Tags         用户认证
+// @Accept       json
+// @Produce      json
+// @Param        body  body      OAuthExchangeRequest  true  "凭据"
+// @Success      200   {object}  response.Response{data=object{userInfo=LoginUserInfoResponse,roles=[]string,accessToken=string,refreshToken=string,expires=string}}  "登录成功"
+// @Failure      400   {object}  response.Response  "凭据无效或已过期"
+// @Router       /auth/oauth/exchange [post]
+func (h *AuthHandler) OAuthExchange(c *gin.Context) {
+	var req OAuthExchangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+
+	user, linked, err := h.oauthSvc.RedeemTicket(c.Request.Context(), req.Ticket)
+	if err != nil {
+		response.Fail(c, oauthErrorStatus(err), err.Error())
+		return
+	}
+	if linked {
+		response.Success(c, gin.H{"linked": true}, "第三方账号绑定成功")
+		return
+	}
+
+	// 第三方登录只能证明对第三方账号的控制权，启用了两步验证的用户仍需完成第二步
+	challenge, err := h.twoFactorSvc.NewLoginChallenge(c.Request.Context(), user)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if challenge != nil {
+		response.Success(c, gin.H{
+			"twoFactorRequired":  true,
+			"challengeToken":     challenge.ChallengeToken,
+			"expires":            challenge.Expires,
+			"enrollmentRequired": challenge.EnrollmentRequired,
+		}, "请完成两步验证")
+		return
+	}
+
+	h.respondLoginSuccess(c, user, nil)
+}
+
+// ListOAuthIdentities 获取当前用户绑定的第三方账号
+// @Summary      获取已绑定的第三方账号
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]OAuthIdentityResponse}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /user/oauth/identities [get]
+func (h *AuthHandler) ListOAuthIdentities(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	identities, err := h.oauthSvc.ListIdentities(c.Request.Context(), user.ID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	list := make([]OAuthIdentityResponse, 0, len(identities))
+	for _, identity := range identities {
+		list = append(list, toOAuthIdentityResponse(identity))
+	}
+	response.Success(c, list, "获取成功")
+}
+
+// UnlinkOAuthIdentity 解除第三方账号绑定
+// @Summary      解除第三方账号绑定
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path      string  true  "绑定关系ID"
+// @Success      200  {object}  response.Response  "解除成功"
+// @Failure      404  {object}  response.Response  "绑定关系不存在"
+// @Router       /user/oauth/identities/:id [delete]
+func (h *AuthHandler) UnlinkOAuthIdentity(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	identityID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeUserIdentity {
+		response.Fail(c, http.StatusBadRequest, "绑定关系ID无效")
+		return
+	}
+
+	if err := h.oauthSvc.Unlink(c.Request.Context(), user.ID, identityID); err != nil {
+		response.Fail(c, oauthErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, nil, "已解除绑定")
+}
+
+func toOAuthIdentityResponse(identity *model.UserIdentity) OAuthIdentityResponse {
+	publicID, _ := idgen.GeneratePublicID(identity.ID, idgen.EntityTypeUserIdentity)
+	return OAuthIdentityResponse{
+		ID:          publicID,
+		Provider:    identity.Provider,
+		Email:       identity.Email,
+		DisplayName: identity.DisplayName,
+		AvatarURL:   identity.AvatarURL,
+		CreatedAt:   identity.CreatedAt,
+		LastLoginAt: identity.LastLoginAt,
+	}
+}
+
+// oauthErrorStatus 将第三方登录相关的业务错误转换为 HTTP 状态码
+func oauthErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, constant.ErrOAuthProviderNotFound), errors.Is(err, constant.ErrOAuthIdentityNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, constant.ErrOAuthStateInvalid):
+		return http.StatusBadRequest
+	case errors.Is(err, constant.ErrOAuthIdentityConflict):
+		return http.StatusConflict
+	case errors.Is(err, constant.ErrOAuthEmailUnverified):
+		return http.StatusForbidden
+	default:
+		return http.StatusBadRequest
+	}
+}
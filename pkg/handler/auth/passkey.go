@@ -0,0 +1,292 @@
+package auth_handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	internal_auth "github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/webauthn"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PasskeyLoginFinishRequest 定义了完成通行密钥登录的请求结构
+type PasskeyLoginFinishRequest struct {
+	SessionID  string                     `json:"sessionId" binding:"required"`
+	Credential webauthn.AssertionResponse `json:"credential"`
+}
+
+// PasskeyRegisterFinishRequest 定义了完成通行密钥注册的请求结构
+type PasskeyRegisterFinishRequest struct {
+	SessionID  string                        `json:"sessionId" binding:"required"`
+	Name       string                        `json:"name" binding:"max=64"`
+	Credential webauthn.RegistrationResponse `json:"credential"`
+}
+
+// PasskeyRenameRequest 定义了重命名通行密钥的请求结构
+type PasskeyRenameRequest struct {
+	Name string `json:"name" binding:"required,max=64"`
+}
+
+// PasskeyResponse 定义了通行密钥列表中每一项的响应结构
+type PasskeyResponse struct {
+	ID         string     `json:"id"` // 通行密钥的公共ID
+	Name       string     `json:"name"`
+	AAGUID     string     `json:"aaguid,omitempty"`
+	Transports []string   `json:"transports"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+// PasskeyLoginBegin 开始通行密钥登录
+// @Summary      开始通行密钥登录
+// @Description  生成 WebAuthn 认证参数，前端调用 navigator.credentials.get 后将结果提交到 /auth/passkey/login/finish
+// @Tags         用户认证
+// @Produce      json
+// @Success      200  {object}  response.Response{data=auth.PasskeyLoginBegin}  "获取成功"
+// @Failure      500  {object}  response.Response  "站点地址未配置"
+// @Router       /auth/passkey/login/begin [post]
+func (h *AuthHandler) PasskeyLoginBegin(c *gin.Context) {
+	begin, err := h.passkeySvc.BeginLogin(c.Request.Context())
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, begin, "获取成功")
+}
+
+// PasskeyLoginFinish 完成通行密钥登录
+// @Summary      完成通行密钥登录
+// @Description  校验通行密钥的认证结果，通过后签发与密码登录相同的会话令牌
+// @Tags         用户认证
+// @Accept       json
+// @Produce      json
+// @Param        body  body      PasskeyLoginFinishRequest  true  "认证结果"
+// @Success      200   {object}  response.Response{data=object{userInfo=LoginUserInfoResponse,roles=[]string,accessToken=string,refreshToken=string,expires=string}}  "登录成功"
+// @Failure      400   {object}  response.Response  "请求已过期"
+// @Failure      401   {object}  response.Response  "验证失败"
+// @Router       /auth/passkey/login/finish [post]
+func (h *AuthHandler) PasskeyLoginFinish(c *gin.Context) {
+	var req PasskeyLoginFinishRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+
+	user, err := h.passkeySvc.FinishLogin(c.Request.Context(), req.SessionID, &req.Credential)
+	if err != nil {
+		response.Fail(c, passkeyErrorStatus(err), err.Error())
+		return
+	}
+	h.respondLoginSuccess(c, user, nil)
+}
+
+// ListPasskeys 获取当前用户的通行密钥列表
+// @Summary      获取通行密钥列表
+// @Description  获取当前用户已注册的全部通行密钥
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]PasskeyResponse}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /user/passkeys [get]
+func (h *AuthHandler) ListPasskeys(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	passkeys, err := h.passkeySvc.List(c.Request.Context(), user.ID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	list := make([]PasskeyResponse, 0, len(passkeys))
+	for _, p := range passkeys {
+		list = append(list, toPasskeyResponse(p))
+	}
+	response.Success(c, list, "获取成功")
+}
+
+// PasskeyRegisterBegin 开始注册通行密钥
+// @Summary      开始注册通行密钥
+// @Description  生成 WebAuthn 注册参数，前端调用 navigator.credentials.create 后将结果提交到 /user/passkeys/register/finish
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=auth.PasskeyRegistrationBegin}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /user/passkeys/register/begin [post]
+func (h *AuthHandler) PasskeyRegisterBegin(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	begin, err := h.passkeySvc.BeginRegistration(c.Request.Context(), user)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, begin, "获取成功")
+}
+
+// PasskeyRegisterFinish 完成通行密钥注册
+// @Summary      完成通行密钥注册
+// @Description  校验注册结果并保存通行密钥
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      PasskeyRegisterFinishRequest  true  "注册结果"
+// @Success      200   {object}  response.Response{data=PasskeyResponse}  "注册成功"
+// @Failure      400   {object}  response.Response  "请求已过期"
+// @Failure      401   {object}  response.Response  "验证失败"
+// @Router       /user/passkeys/register/finish [post]
+func (h *AuthHandler) PasskeyRegisterFinish(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	var req PasskeyRegisterFinishRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+
+	passkey, err := h.passkeySvc.FinishRegistration(c.Request.Context(), user, req.SessionID, req.Name, &req.Credential)
+	if err != nil {
+		response.Fail(c, passkeyErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, toPasskeyResponse(passkey), "通行密钥注册成功")
+}
+
+// RenamePasskey 重命名通行密钥
+// @Summary      重命名通行密钥
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                true  "通行密钥ID"
+// @Param        body  body      PasskeyRenameRequest  true  "新名称"
+// @Success      200   {object}  response.Response  "修改成功"
+// @Failure      404   {object}  response.Response  "通行密钥不存在"
+// @Router       /user/passkeys/:id [put]
+func (h *AuthHandler) RenamePasskey(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	passkeyID, ok := parsePasskeyID(c)
+	if !ok {
+		return
+	}
+	var req PasskeyRenameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "名称不能为空且不能超过 64 个字符")
+		return
+	}
+
+	if err := h.passkeySvc.Rename(c.Request.Context(), user.ID, passkeyID, req.Name); err != nil {
+		response.Fail(c, passkeyErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, nil, "修改成功")
+}
+
+// DeletePasskey 撤销通行密钥
+// @Summary      撤销通行密钥
+// @Description  删除后该通行密钥将无法再用于登录
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path      string  true  "通行密钥ID"
+// @Success      200  {object}  response.Response  "删除成功"
+// @Failure      404  {object}  response.Response  "通行密钥不存在"
+// @Router       /user/passkeys/:id [delete]
+func (h *AuthHandler) DeletePasskey(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	passkeyID, ok := parsePasskeyID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.passkeySvc.Delete(c.Request.Context(), user.ID, passkeyID); err != nil {
+		response.Fail(c, passkeyErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, nil, "通行密钥已撤销")
+}
+
+// currentUser 从 JWT Claims 中解析出当前登录的用户
+func (h *AuthHandler) currentUser(c *gin.Context) (*model.User, bool) {
+	claimsValue, exists := c.Get(internal_auth.ClaimsKey)
+	if !exists {
+		response.Fail(c, http.StatusUnauthorized, "无法获取用户信息，请确认是否已登录")
+		return nil, false
+	}
+	claims, ok := claimsValue.(*internal_auth.CustomClaims)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户信息格式不正确")
+		return nil, false
+	}
+	userID, entityType, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusUnauthorized, "用户ID无效")
+		return nil, false
+	}
+	user, err := h.authSvc.GetUserByID(c.Request.Context(), userID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return nil, false
+	}
+	return user, true
+}
+
+// parsePasskeyID 解析路径中的通行密钥公共ID
+func parsePasskeyID(c *gin.Context) (uint, bool) {
+	id, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypePasskey {
+		response.Fail(c, http.StatusBadRequest, "通行密钥ID无效")
+		return 0, false
+	}
+	return id, true
+}
+
+func toPasskeyResponse(p *model.Passkey) PasskeyResponse {
+	publicID, _ := idgen.GeneratePublicID(p.ID, idgen.EntityTypePasskey)
+	transports := p.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+	return PasskeyResponse{
+		ID:         publicID,
+		Name:       p.Name,
+		AAGUID:     p.AAGUID,
+		Transports: transports,
+		CreatedAt:  p.CreatedAt,
+		LastUsedAt: p.LastUsedAt,
+	}
+}
+
+// passkeyErrorStatus 将通行密钥相关的业务错误转换为 HTTP 状态码
+func passkeyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, constant.ErrPasskeyChallengeInvalid):
+		return http.StatusBadRequest
+	case errors.Is(err, constant.ErrPasskeyVerificationFailed):
+		return http.StatusUnauthorized
+	case errors.Is(err, constant.ErrPasskeyNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusBadRequest
+	}
+}
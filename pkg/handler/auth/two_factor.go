@@ -0,0 +1,98 @@
+package auth_handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TwoFactorChallengeRequest 定义了登录第二步绑定验证器的请求结构
+type TwoFactorChallengeRequest struct {
+	ChallengeToken string `json:"challengeToken" binding:"required"`
+}
+
+// TwoFactorLoginRequest 定义了登录第二步验证的请求结构
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challengeToken" binding:"required"`
+	Code           string `json:"code" binding:"required"` // 验证器生成的 6 位验证码或恢复码
+}
+
+// TwoFactorSetup 为被强制启用两步验证、但尚未绑定验证器的用户生成绑定信息
+// @Summary      登录时绑定两步验证
+// @Description  用户组强制启用两步验证且用户尚未绑定时，使用登录返回的挑战令牌获取绑定二维码
+// @Tags         用户认证
+// @Accept       json
+// @Produce      json
+// @Param        body  body      TwoFactorChallengeRequest  true  "挑战令牌"
+// @Success      200   {object}  response.Response{data=auth.TwoFactorEnrollment}  "获取成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      401   {object}  response.Response  "挑战令牌无效或已过期"
+// @Router       /auth/2fa/setup [post]
+func (h *AuthHandler) TwoFactorSetup(c *gin.Context) {
+	var req TwoFactorChallengeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+
+	enrollment, err := h.twoFactorSvc.BeginChallengeEnrollment(c.Request.Context(), req.ChallengeToken)
+	if err != nil {
+		response.Fail(c, twoFactorErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, enrollment, "请使用验证器扫描二维码")
+}
+
+// TwoFactorLogin 处理登录第二步验证，通过后签发会话令牌
+// @Summary      两步验证登录
+// @Description  使用登录返回的挑战令牌和验证码（或恢复码）完成登录。若本次同时完成了验证器绑定，响应中会额外返回 recoveryCodes
+// @Tags         用户认证
+// @Accept       json
+// @Produce      json
+// @Param        body  body      TwoFactorLoginRequest  true  "挑战令牌和验证码"
+// @Success      200   {object}  response.Response{data=object{userInfo=LoginUserInfoResponse,roles=[]string,accessToken=string,refreshToken=string,expires=string,recoveryCodes=[]string}}  "登录成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      401   {object}  response.Response  "挑战令牌或验证码无效"
+// @Failure      429   {object}  response.Response  "尝试次数过多"
+// @Router       /auth/2fa/login [post]
+func (h *AuthHandler) TwoFactorLogin(c *gin.Context) {
+	var req TwoFactorLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+
+	user, recoveryCodes, err := h.twoFactorSvc.CompleteLogin(c.Request.Context(), req.ChallengeToken, req.Code)
+	if err != nil {
+		response.Fail(c, twoFactorErrorStatus(err), err.Error())
+		return
+	}
+
+	var extra gin.H
+	if len(recoveryCodes) > 0 {
+		extra = gin.H{"recoveryCodes": recoveryCodes}
+	}
+	h.respondLoginSuccess(c, user, extra)
+}
+
+// twoFactorErrorStatus 将两步验证相关的业务错误转换为 HTTP 状态码
+func twoFactorErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, constant.ErrTwoFactorCodeInvalid), errors.Is(err, constant.ErrTwoFactorChallengeInvalid):
+		return http.StatusUnauthorized
+	case errors.Is(err, constant.ErrTwoFactorTooManyAttempts):
+		return http.StatusTooManyRequests
+	case errors.Is(err, constant.ErrTwoFactorAlreadyEnabled):
+		return http.StatusConflict
+	case errors.Is(err, constant.ErrTwoFactorNotEnabled):
+		return http.StatusBadRequest
+	case errors.Is(err, constant.ErrTwoFactorEnforced):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,144 @@
+// pkg/handler/blocklist/handler.go
+package blocklist
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/blocklist"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 负责处理黑名单管理相关的 API 请求
+type Handler struct {
+	svc blocklist.Service
+}
+
+// NewHandler 是 Handler 的构造函数
+func NewHandler(svc blocklist.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// List
+// @Summary      获取黑名单规则列表
+// @Description  分页获取黑名单规则，可按类型和内容筛选
+// @Tags         黑名单管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量" default(20)
+// @Param        type query string false "规则类型" Enums(ip, email, email_md5, user_agent, user)
+// @Param        value query string false "规则内容（模糊匹配）"
+// @Success      200 {object} response.Response{data=repository.PageResult[model.BlockRule]} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /blocklist [get]
+func (h *Handler) List(c *gin.Context) {
+	var query model.BlockRuleListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	result, err := h.svc.List(c.Request.Context(), &query)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取黑名单失败: "+err.Error())
+		return
+	}
+	response.Success(c, result, "获取黑名单成功")
+}
+
+// Create
+// @Summary      新增黑名单规则
+// @Description  新增一条 IP/CIDR、邮箱、邮箱MD5 或 UA 正则规则，可设置过期时间
+// @Tags         黑名单管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.SaveBlockRuleRequest true "黑名单规则"
+// @Success      200 {object} response.Response{data=model.BlockRule} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Router       /blocklist [post]
+func (h *Handler) Create(c *gin.Context) {
+	var req model.SaveBlockRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	rule, err := h.svc.Create(c.Request.Context(), &req)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, rule, "添加黑名单规则成功")
+}
+
+// Update
+// @Summary      更新黑名单规则
+// @Tags         黑名单管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "规则ID"
+// @Param        body body model.SaveBlockRuleRequest true "黑名单规则"
+// @Success      200 {object} response.Response{data=model.BlockRule} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "规则不存在"
+// @Router       /blocklist/{id} [put]
+func (h *Handler) Update(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "无效的规则ID")
+		return
+	}
+
+	var req model.SaveBlockRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	rule, err := h.svc.Update(c.Request.Context(), uint(id), &req)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			response.Fail(c, http.StatusNotFound, "黑名单规则不存在")
+		} else {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	response.Success(c, rule, "更新黑名单规则成功")
+}
+
+// Delete
+// @Summary      删除黑名单规则
+// @Tags         黑名单管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "规则ID"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "规则不存在"
+// @Router       /blocklist/{id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "无效的规则ID")
+		return
+	}
+
+	if err := h.svc.Delete(c.Request.Context(), uint(id)); err != nil {
+		if ent.IsNotFound(err) {
+			response.Fail(c, http.StatusNotFound, "黑名单规则不存在")
+		} else {
+			response.Fail(c, http.StatusInternalServerError, "删除黑名单规则失败: "+err.Error())
+		}
+		return
+	}
+	response.Success(c, nil, "删除黑名单规则成功")
+}
@@ -1,7 +1,11 @@
 // internal/app/handler/comment/dto/dto.go
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
 
 // CreateRequest 定义了创建评论的API请求体。
 // 它现在使用 TargetPath 来标识评论所属的页面。
@@ -18,8 +22,8 @@ type CreateRequest struct {
 	// 回复目标评论的公共ID，用于构建对话链。如果直接回复顶级评论，可以与 ParentID 相同或为 null。
 	ReplyToID *string `json:"reply_to_id"`
 
-	// 评论者的昵称。
-	Nickname string `json:"nickname" binding:"required,min=2,max=50"`
+	// 评论者的昵称。已登录用户可省略，由账户资料补全。
+	Nickname string `json:"nickname" binding:"omitempty,min=2,max=50"`
 
 	// 评论者的邮箱，用于接收回复通知。
 	Email *string `json:"email" binding:"omitempty,email"`
@@ -78,6 +82,26 @@ type UpdateContentRequest struct {
 	Content string `json:"content" binding:"required,min=1,max=1000"` // 更新后的 Markdown 内容
 }
 
+// AuthorUpdateRequest 定义了评论作者使用编辑令牌自助修改评论的API请求体。
+type AuthorUpdateRequest struct {
+	EditToken string `json:"edit_token" binding:"required"`             // 创建评论时返回的编辑令牌
+	Content   string `json:"content" binding:"required,min=1,max=1000"` // 更新后的 Markdown 内容
+}
+
+// AuthorDeleteRequest 定义了评论作者使用编辑令牌自助删除评论的API请求体。
+type AuthorDeleteRequest struct {
+	EditToken string `json:"edit_token" binding:"required"` // 创建评论时返回的编辑令牌
+}
+
+// BlockAuthorRequest 定义了管理员从评论列表直接拉黑评论者的API请求体。
+type BlockAuthorRequest struct {
+	BlockIP        bool       `json:"block_ip"`         // 封禁评论者的IP地址
+	BlockEmail     bool       `json:"block_email"`      // 封禁评论者的邮箱
+	BlockUserAgent bool       `json:"block_user_agent"` // 封禁评论者的 User Agent（精确匹配）
+	Reason         string     `json:"reason" binding:"max=255"`
+	ExpiresAt      *time.Time `json:"expires_at"` // 为空表示永久封禁
+}
+
 // UpdateCommentRequest 定义了更新评论信息（包括用户信息和内容）的API请求体。
 type UpdateCommentRequest struct {
 	// 评论内容（Markdown原文），可选
@@ -115,11 +139,18 @@ type Response struct {
 	TotalChildren  int64       `json:"total_children"`
 	Children       []*Response `json:"children,omitempty"`
 
+	EditedAt *time.Time `json:"edited_at,omitempty"` // 作者最后一次自助编辑的时间
+
+	// --- 仅在评论创建时返回给作者的字段 ---
+	EditToken     *string    `json:"edit_token,omitempty"`     // 用于自助编辑/删除评论的签名令牌
+	EditableUntil *time.Time `json:"editable_until,omitempty"` // 编辑令牌的截止时间
+
 	// --- 仅限管理员视图的字段 ---
-	Email     *string `json:"email,omitempty"`
-	IPAddress *string `json:"ip_address,omitempty"`
-	Content   *string `json:"content,omitempty"` // Markdown原文
-	Status    *int    `json:"status,omitempty"`
+	Email       *string                   `json:"email,omitempty"`
+	IPAddress   *string                   `json:"ip_address,omitempty"`
+	Content     *string                   `json:"content,omitempty"` // Markdown原文
+	Status      *int                      `json:"status,omitempty"`
+	EditHistory []model.CommentEditRecord `json:"edit_history,omitempty"` // 作者自助编辑的历史记录
 }
 
 // ListResponse 定义了评论列表的API响应结构。
@@ -129,6 +160,9 @@ type ListResponse struct {
 	TotalWithChildren int64       `json:"total_with_children"` // 包含所有子评论的总数（用于前端显示）
 	Page              int         `json:"page"`
 	PageSize          int         `json:"pageSize"`
+
+	// 评论区状态 (open/closed/readonly)，仅按路径查询时返回，主题可据此隐藏评论表单
+	ThreadState model.CommentThreadState `json:"thread_state,omitempty"`
 }
 
 // UploadImageResponse 是评论图片上传成功后返回的数据结构。
@@ -156,3 +190,9 @@ type ImportResult struct {
 	FailedCount   int      `json:"failed_count"`   // 失败数
 	ErrorMessages []string `json:"error_messages"` // 错误信息列表
 }
+
+// AuthorEmailRequest 定义了按评论者邮箱导出或清除评论的请求体，邮箱和邮箱MD5至少提供一个。
+type AuthorEmailRequest struct {
+	Email    string `json:"email" binding:"omitempty,email"`
+	EmailMD5 string `json:"email_md5" binding:"omitempty,len=32,hexadecimal"`
+}
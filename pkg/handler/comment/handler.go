@@ -9,12 +9,16 @@ import (
 	"net/http"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/ent"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/comment"
 	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
@@ -22,13 +26,17 @@ import (
 )
 
 type Handler struct {
-	svc *comment.Service
+	svc       *comment.Service
+	streamHub *comment.StreamHub
 }
 
-func NewHandler(svc *comment.Service) *Handler {
-	return &Handler{svc: svc}
+func NewHandler(svc *comment.Service, streamHub *comment.StreamHub) *Handler {
+	return &Handler{svc: svc, streamHub: streamHub}
 }
 
+// streamHeartbeatInterval SSE 连接的心跳间隔，防止代理因空闲断开连接
+const streamHeartbeatInterval = 30 * time.Second
+
 // ListChildren
 // @Summary      获取指定评论的子评论列表（分页）
 // @Description  分页获取指定根评论下的所有回复评论
@@ -183,6 +191,127 @@ func (h *Handler) SetPin(c *gin.Context) {
 	response.Success(c, updatedCommentDTO, "评论置顶状态更新成功")
 }
 
+// BlockAuthor
+// @Summary      管理员拉黑评论者
+// @Description  将指定评论作者的IP、邮箱或 User Agent 加入黑名单
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "评论的公共ID"
+// @Param        block_request body dto.BlockAuthorRequest true "拉黑选项"
+// @Success      200 {object} response.Response{data=[]model.BlockRule} "成功响应，返回新增的黑名单规则"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      401 {object} response.Response "未授权"
+// @Failure      404 {object} response.Response "评论不存在"
+// @Router       /comments/{id}/block [post]
+func (h *Handler) BlockAuthor(c *gin.Context) {
+	commentID := c.Param("id")
+	if commentID == "" {
+		response.Fail(c, http.StatusBadRequest, "评论ID不能为空")
+		return
+	}
+
+	var req dto.BlockAuthorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	rules, err := h.svc.BlockAuthor(c.Request.Context(), commentID, &req)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			response.Fail(c, http.StatusNotFound, "评论不存在")
+		} else {
+			response.Fail(c, http.StatusBadRequest, "拉黑评论者失败: "+err.Error())
+		}
+		return
+	}
+
+	response.Success(c, rules, "已将评论者加入黑名单")
+}
+
+// ListThreads
+// @Summary      获取评论区状态列表
+// @Description  分页获取管理员单独设置过状态的评论区（文章、页面、即刻等），未设置的路径按默认规则判定
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量" default(20)
+// @Param        target_path query string false "目标路径（模糊匹配）"
+// @Param        state query string false "评论区状态" Enums(open, closed, readonly)
+// @Success      200 {object} response.Response{data=repository.PageResult[model.CommentThread]} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/threads [get]
+func (h *Handler) ListThreads(c *gin.Context) {
+	var query model.CommentThreadListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	result, err := h.svc.ListThreads(c.Request.Context(), &query)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取评论区状态列表失败: "+err.Error())
+		return
+	}
+	response.Success(c, result, "获取评论区状态列表成功")
+}
+
+// SetThreadState
+// @Summary      设置评论区状态
+// @Description  为指定路径单独设置评论区状态：open(开放)、closed(关闭并隐藏已有评论)、readonly(只读)。单独设置优先于页面的 show_comment 和自动关闭规则
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body body model.SetCommentThreadStateRequest true "评论区状态"
+// @Success      200 {object} response.Response{data=model.CommentThread} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/threads [put]
+func (h *Handler) SetThreadState(c *gin.Context) {
+	var req model.SetCommentThreadStateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	thread, err := h.svc.SetThreadState(c.Request.Context(), &req)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, thread, "评论区状态设置成功")
+}
+
+// ResetThreadState
+// @Summary      重置评论区状态
+// @Description  删除指定路径的单独设置，使其恢复为默认规则
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        target_path query string true "目标路径"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /comments/threads [delete]
+func (h *Handler) ResetThreadState(c *gin.Context) {
+	targetPath := c.Query("target_path")
+	if targetPath == "" {
+		response.Fail(c, http.StatusBadRequest, "target_path 不能为空")
+		return
+	}
+
+	if err := h.svc.ResetThreadState(c.Request.Context(), targetPath); err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "评论区状态已重置")
+}
+
 // UpdateStatus
 // @Summary      管理员更新评论状态
 // @Description  更新指定ID的评论的状态（例如，通过审核发布或设为待审核）
@@ -221,6 +350,11 @@ func (h *Handler) UpdateStatus(c *gin.Context) {
 		return
 	}
 
+	if req.Status == 1 {
+		audit.SetTarget(c.Request.Context(), "", "将评论设置为已发布")
+	} else {
+		audit.SetTarget(c.Request.Context(), "", "将评论设置为待审核")
+	}
 	response.Success(c, updatedCommentDTO, "评论状态更新成功")
 }
 
@@ -281,7 +415,8 @@ func (h *Handler) Create(c *gin.Context) {
 
 	commentDTO, err := h.svc.Create(c.Request.Context(), &req, ip, ua, referer, claims)
 	if err != nil {
-		if errors.Is(err, constant.ErrAdminEmailUsedByGuest) {
+		if errors.Is(err, constant.ErrAdminEmailUsedByGuest) || errors.Is(err, constant.ErrBlocked) ||
+			errors.Is(err, constant.ErrCommentThreadClosed) {
 			response.Fail(c, http.StatusForbidden, err.Error())
 		} else {
 			response.Fail(c, http.StatusInternalServerError, "创建评论失败: "+err.Error())
@@ -292,6 +427,156 @@ func (h *Handler) Create(c *gin.Context) {
 	response.Success(c, commentDTO, "评论发布成功")
 }
 
+// AuthorUpdate
+// @Summary      评论作者自助修改评论
+// @Description  评论作者凭创建评论时返回的编辑令牌，在时间窗口内修改自己的评论。修改后的内容会重新经过安全处理和违禁词检测。
+// @Tags         公开评论
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "评论的公共ID"
+// @Param        update_request body dto.AuthorUpdateRequest true "编辑令牌与新的评论内容"
+// @Success      200 {object} response.Response{data=dto.Response} "成功响应，返回更新后的评论对象"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "编辑令牌无效或已超出可编辑时间"
+// @Failure      404 {object} response.Response "评论不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /public/comments/{id} [put]
+func (h *Handler) AuthorUpdate(c *gin.Context) {
+	publicID := c.Param("id")
+	if publicID == "" {
+		response.Fail(c, http.StatusBadRequest, "评论ID不能为空")
+		return
+	}
+
+	var req dto.AuthorUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	ip := util.GetRealClientIP(c)
+	ua := c.Request.UserAgent()
+	referer := c.GetHeader("Referer")
+
+	updatedComment, err := h.svc.AuthorUpdate(c.Request.Context(), publicID, &req, ip, ua, referer)
+	if err != nil {
+		h.failAuthorEdit(c, "修改评论失败", err)
+		return
+	}
+
+	response.Success(c, updatedComment, "评论修改成功")
+}
+
+// AuthorDelete
+// @Summary      评论作者自助删除评论
+// @Description  评论作者凭创建评论时返回的编辑令牌，在时间窗口内删除自己的评论。
+// @Tags         公开评论
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "评论的公共ID"
+// @Param        delete_request body dto.AuthorDeleteRequest true "编辑令牌"
+// @Success      200 {object} response.Response "删除成功"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "编辑令牌无效或已超出可编辑时间"
+// @Failure      404 {object} response.Response "评论不存在"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /public/comments/{id} [delete]
+func (h *Handler) AuthorDelete(c *gin.Context) {
+	publicID := c.Param("id")
+	if publicID == "" {
+		response.Fail(c, http.StatusBadRequest, "评论ID不能为空")
+		return
+	}
+
+	var req dto.AuthorDeleteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	if err := h.svc.AuthorDelete(c.Request.Context(), publicID, req.EditToken); err != nil {
+		h.failAuthorEdit(c, "删除评论失败", err)
+		return
+	}
+
+	response.Success(c, nil, "评论删除成功")
+}
+
+// failAuthorEdit 将评论者自助编辑/删除过程中的错误转换为对应的HTTP状态码。
+func (h *Handler) failAuthorEdit(c *gin.Context, prefix string, err error) {
+	switch {
+	case errors.Is(err, constant.ErrCommentAuthorEditDisabled),
+		errors.Is(err, constant.ErrCommentEditTokenInvalid),
+		errors.Is(err, constant.ErrCommentEditWindowExpired),
+		errors.Is(err, constant.ErrBlocked):
+		response.Fail(c, http.StatusForbidden, err.Error())
+	case ent.IsNotFound(err):
+		response.Fail(c, http.StatusNotFound, "评论不存在")
+	default:
+		response.Fail(c, http.StatusInternalServerError, prefix+": "+err.Error())
+	}
+}
+
+// Stream
+// @Summary      订阅指定路径的实时评论流
+// @Description  通过 Server-Sent Events 推送指定路径下新发布的评论及评论状态变更
+// @Tags         公开评论
+// @Produce      text/event-stream
+// @Param        target_path query string true "目标路径 (例如 /posts/some-slug)"
+// @Success      200 {object} comment.StreamEvent "事件流"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Router       /public/comments/stream [get]
+func (h *Handler) Stream(c *gin.Context) {
+	path := c.Query("target_path")
+	if path == "" {
+		response.Fail(c, http.StatusBadRequest, "目标路径不能为空")
+		return
+	}
+	h.serveStream(c, h.streamHub.Subscribe(path, false))
+}
+
+// AdminStream
+// @Summary      订阅全站评论审核流
+// @Description  通过 Server-Sent Events 推送全站的新评论（包括待审核评论）及评论状态变更
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Produce      text/event-stream
+// @Success      200 {object} comment.StreamEvent "事件流"
+// @Failure      401 {object} response.Response "未授权"
+// @Router       /comments/stream [get]
+func (h *Handler) AdminStream(c *gin.Context) {
+	h.serveStream(c, h.streamHub.Subscribe("", true))
+}
+
+// serveStream 持续将订阅到的评论事件以 SSE 格式写给客户端，直到客户端断开连接
+func (h *Handler) serveStream(c *gin.Context, sub *comment.StreamSubscription) {
+	defer h.streamHub.Unsubscribe(sub)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // 禁用 Nginx 缓冲
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case ev, ok := <-sub.Events():
+			if !ok {
+				return false
+			}
+			c.SSEvent(ev.Type, ev)
+			return true
+		case <-heartbeat.C:
+			c.SSEvent("ping", time.Now().Unix())
+			return true
+		}
+	})
+}
+
 // ListByPath
 // @Summary      获取指定路径的评论列表（分页）
 // @Description  分页获取指定路径下的根评论，并附带其所有子评论
@@ -438,6 +723,7 @@ func (h *Handler) Delete(c *gin.Context) {
 		return
 	}
 
+	audit.SetTarget(c.Request.Context(), strings.Join(req.IDs, ","), fmt.Sprintf("删除 %d 条评论", deletedCount))
 	response.Success(c, deletedCount, fmt.Sprintf("成功删除 %d 条评论", deletedCount))
 }
 
@@ -609,6 +895,61 @@ func (h *Handler) ExportComments(c *gin.Context) {
 	c.Data(http.StatusOK, "application/zip", zipData)
 }
 
+// ExportCommentsByEmail
+// @Summary      按邮箱导出评论
+// @Description  导出与指定邮箱或邮箱MD5关联的全部评论（包括待审核的），用于响应评论者的个人数据请求
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      application/zip
+// @Param        body  body      dto.AuthorEmailRequest  true  "评论者邮箱或邮箱MD5"
+// @Success      200   {file}    file  "ZIP文件下载"
+// @Failure      400   {object}  response.Response "请求参数错误"
+// @Router       /comments/by-email/export [post]
+func (h *Handler) ExportCommentsByEmail(c *gin.Context) {
+	var req dto.AuthorEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil || (req.Email == "" && req.EmailMD5 == "") {
+		response.Fail(c, http.StatusBadRequest, "请提供有效的邮箱或邮箱MD5")
+		return
+	}
+	zipData, err := h.svc.ExportCommentsByAuthorToZip(c.Request.Context(), comment.AuthorFilterByEmail(req.Email, req.EmailMD5))
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "导出评论失败: "+err.Error())
+		return
+	}
+	c.Header("Content-Disposition", "attachment; filename=comments_by_email.zip")
+	c.Header("Content-Length", strconv.Itoa(len(zipData)))
+	c.Data(http.StatusOK, "application/zip", zipData)
+}
+
+// EraseCommentsByEmail
+// @Summary      按邮箱清除评论
+// @Description  清除与指定邮箱或邮箱MD5关联的全部评论的内容和个人信息并删除，操作不可恢复
+// @Tags         评论管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.AuthorEmailRequest  true  "评论者邮箱或邮箱MD5"
+// @Success      200   {object}  response.Response{data=object{erased=int}}  "清除成功"
+// @Failure      400   {object}  response.Response "请求参数错误"
+// @Router       /comments/by-email/erase [post]
+func (h *Handler) EraseCommentsByEmail(c *gin.Context) {
+	var req dto.AuthorEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil || (req.Email == "" && req.EmailMD5 == "") {
+		response.Fail(c, http.StatusBadRequest, "请提供有效的邮箱或邮箱MD5")
+		return
+	}
+	filter := comment.AuthorFilterByEmail(req.Email, req.EmailMD5)
+	erased, err := h.svc.EraseCommentsByAuthor(c.Request.Context(), filter)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	// 审计日志中只记录邮箱的 MD5，避免留下被清除者的邮箱
+	audit.SetTarget(c.Request.Context(), filter.EmailMD5, fmt.Sprintf("清除了 %d 条评论", erased))
+	response.Success(c, gin.H{"erased": erased}, "清除成功")
+}
+
 // ImportComments
 // @Summary      管理员导入评论
 // @Description  从 JSON 或 ZIP 文件导入评论
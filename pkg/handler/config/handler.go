@@ -10,6 +10,7 @@ import (
 	"net/http"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/config"
 	"github.com/gin-gonic/gin"
 )
@@ -123,6 +124,8 @@ func (h *ConfigBackupHandler) RestoreBackup(c *gin.Context) {
 		return
 	}
 
+	audit.SetTarget(c.Request.Context(), req.Filename, "从备份 "+req.Filename+" 恢复配置")
+
 	response.Success(c, nil, "配置已恢复成功，建议重启应用以使配置生效")
 }
 
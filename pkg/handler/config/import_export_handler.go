@@ -9,6 +9,7 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/config"
 	"github.com/gin-gonic/gin"
 )
@@ -107,6 +108,8 @@ func (h *ConfigImportExportHandler) ImportConfig(c *gin.Context) {
 		return
 	}
 
+	audit.SetTarget(c.Request.Context(), file.Filename, "从文件 "+file.Filename+" 导入配置")
+
 	c.JSON(http.StatusOK, gin.H{
 		"code":    http.StatusOK,
 		"message": "配置导入成功，已更新到数据库",
@@ -172,8 +172,8 @@ func (h *DirectLinkHandler) HandleDirectDownload(c *gin.Context) {
 		return
 	}
 
-	if policy.Type == constant.PolicyTypeLocal {
-		// 本地存储：直接流式传输
+	if policy.Type.ProxiesDownloads() {
+		// 本地、SFTP 和 WebDAV 存储：由服务端直接流式传输
 		encodedFileName := url.QueryEscape(filename)
 		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename*=UTF-8''%s", encodedFileName))
 
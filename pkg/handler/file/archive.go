@@ -0,0 +1,114 @@
+package file
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DownloadZip 把选中的文件和文件夹打包为 zip 流式下载 (e.g., POST /api/file/archive/download)
+// @Summary      打包下载
+// @Description  把一个或多个文件/文件夹打包为 zip 下载，文件夹会包含其中的全部文件
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      application/zip
+// @Param        body  body  model.ZipDownloadRequest  true  "打包请求"
+// @Success      200  {file}    file  "zip 内容"
+// @Failure      400  {object}  response.Response  "请求参数无效"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      403  {object}  response.Response  "无权下载"
+// @Failure      404  {object}  response.Response  "文件不存在"
+// @Failure      500  {object}  response.Response  "打包失败"
+// @Router       /file/archive/download [post]
+func (h *FileHandler) DownloadZip(c *gin.Context) {
+	var req model.ZipDownloadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	ownerID, ok := currentOwnerID(c)
+	if !ok {
+		return
+	}
+
+	archive, err := h.archiveSvc.PrepareZip(c.Request.Context(), ownerID, req.IDs)
+	if err != nil {
+		response.Fail(c, fileErrorStatus(err), "打包失败: "+err.Error())
+		return
+	}
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename*=UTF-8''%s", url.PathEscape(archive.Name)))
+	c.Status(http.StatusOK)
+	if err := h.archiveSvc.WriteZip(c.Request.Context(), archive, c.Writer); err != nil {
+		// 响应头已经发出，只能中断传输，客户端会得到一个不完整的 zip
+		log.Printf("[DownloadZip] 打包下载 '%s' 失败: %v", archive.Name, err)
+	}
+}
+
+// ExtractArchive 在后台把压缩包解压到目标文件夹 (e.g., POST /api/file/archive/extract)
+// @Summary      解压压缩包
+// @Description  在服务端把 zip 或 tar.gz 解压到目标文件夹，立即返回任务进度，解压遵循容量上限和允许的文件类型
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  model.ArchiveExtractRequest  true  "解压请求"
+// @Success      200  {object}  response.Response{data=model.ArchiveExtractStatus}  "解压已开始"
+// @Failure      400  {object}  response.Response  "请求参数无效或格式不支持"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      403  {object}  response.Response  "无权操作"
+// @Failure      404  {object}  response.Response  "文件不存在"
+// @Failure      500  {object}  response.Response  "解压失败"
+// @Router       /file/archive/extract [post]
+func (h *FileHandler) ExtractArchive(c *gin.Context) {
+	var req model.ArchiveExtractRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+	ownerID, ok := currentOwnerID(c)
+	if !ok {
+		return
+	}
+
+	status, err := h.archiveSvc.StartExtraction(c.Request.Context(), ownerID, &req)
+	if err != nil {
+		response.Fail(c, fileErrorStatus(err), "解压失败: "+err.Error())
+		return
+	}
+	response.Success(c, status, "解压已开始")
+}
+
+// GetExtractStatus 获取解压任务的进度 (e.g., GET /api/file/archive/extract/:jobID)
+// @Summary      获取解压进度
+// @Description  获取当前用户的解压任务进度
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        jobID  path  string  true  "解压任务ID"
+// @Success      200  {object}  response.Response{data=model.ArchiveExtractStatus}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      404  {object}  response.Response  "任务不存在"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /file/archive/extract/{jobID} [get]
+func (h *FileHandler) GetExtractStatus(c *gin.Context) {
+	ownerID, ok := currentOwnerID(c)
+	if !ok {
+		return
+	}
+
+	status, err := h.archiveSvc.GetExtractionStatus(c.Request.Context(), ownerID, c.Param("jobID"))
+	if err != nil {
+		response.Fail(c, fileErrorStatus(err), "获取解压进度失败: "+err.Error())
+		return
+	}
+	response.Success(c, status, "获取成功")
+}
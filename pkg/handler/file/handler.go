@@ -18,6 +18,8 @@ type FileHandler struct {
 	fileSvc    file_service.FileService
 	uploadSvc  file_service.IUploadService
 	settingSvc setting.SettingService
+	quotaSvc   file_service.IQuotaService
+	archiveSvc file_service.IArchiveService
 }
 
 // NewHandler 是 FileHandler 的构造函数
@@ -25,10 +27,14 @@ func NewHandler(
 	fileSvc file_service.FileService,
 	uploadSvc file_service.IUploadService,
 	settingSvc setting.SettingService,
+	quotaSvc file_service.IQuotaService,
+	archiveSvc file_service.IArchiveService,
 ) *FileHandler {
 	return &FileHandler{
 		fileSvc:    fileSvc,
 		uploadSvc:  uploadSvc,
 		settingSvc: settingSvc,
+		quotaSvc:   quotaSvc,
+		archiveSvc: archiveSvc,
 	}
 }
@@ -58,6 +58,8 @@ func (h *FileHandler) CopyItems(c *gin.Context) {
 			response.Fail(c, http.StatusNotFound, "复制失败: "+err.Error())
 		case errors.Is(err, constant.ErrInvalidOperation):
 			response.Fail(c, http.StatusBadRequest, "复制失败: "+err.Error())
+		case errors.Is(err, constant.ErrStorageQuotaExceeded):
+			response.Fail(c, http.StatusRequestEntityTooLarge, "复制失败: "+err.Error())
 		default:
 			response.Fail(c, http.StatusInternalServerError, "复制失败: "+err.Error())
 		}
@@ -165,7 +167,7 @@ func (h *FileHandler) CreateEmptyFile(c *gin.Context) {
 
 // DeleteItems 处理删除文件或文件夹的请求 (DELETE /api/files)
 // @Summary      删除文件/文件夹
-// @Description  删除一个或多个文件/文件夹
+// @Description  将一个或多个文件/文件夹移入回收站，permanent 为 true 时直接永久删除
 // @Tags         文件管理
 // @Security     BearerAuth
 // @Accept       json
@@ -195,10 +197,16 @@ func (h *FileHandler) DeleteItems(c *gin.Context) {
 		return
 	}
 
-	err = h.fileSvc.DeleteItems(c.Request.Context(), ownerID, req.IDs)
+	if req.Permanent {
+		err = h.fileSvc.DeleteItemsPermanently(c.Request.Context(), ownerID, req.IDs)
+	} else {
+		err = h.fileSvc.DeleteItems(c.Request.Context(), ownerID, req.IDs)
+	}
 	if err != nil {
 		if errors.Is(err, constant.ErrForbidden) {
 			response.Fail(c, http.StatusForbidden, "删除失败: "+err.Error())
+		} else if errors.Is(err, constant.ErrInvalidOperation) {
+			response.Fail(c, http.StatusBadRequest, "删除失败: "+err.Error())
 		} else {
 			response.Fail(c, http.StatusInternalServerError, "删除失败: "+err.Error())
 		}
@@ -208,6 +216,88 @@ func (h *FileHandler) DeleteItems(c *gin.Context) {
 	response.Success(c, nil, "项目已删除")
 }
 
+// RestoreItems 处理从回收站还原文件或文件夹的请求 (POST /api/file/trash/restore)
+// @Summary      还原回收站项目
+// @Description  将回收站中的一个或多个项目还原到删除前的位置，原所在文件夹也在回收站中时需要先还原该文件夹，原位置已存在同名项目时还原失败
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body  model.RestoreItemsRequest  true  "还原请求"
+// @Success      200  {object}  response.Response  "项目已还原"
+// @Failure      400  {object}  response.Response  "请求参数无效"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      403  {object}  response.Response  "无权限"
+// @Failure      404  {object}  response.Response  "项目不在回收站中"
+// @Failure      409  {object}  response.Response  "原所在文件夹已被删除或原位置已存在同名项目"
+// @Failure      500  {object}  response.Response  "还原失败"
+// @Router       /file/trash/restore [post]
+func (h *FileHandler) RestoreItems(c *gin.Context) {
+	var req model.RestoreItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+	ownerID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return
+	}
+
+	if err := h.fileSvc.RestoreItems(c.Request.Context(), ownerID, req.IDs); err != nil {
+		switch {
+		case errors.Is(err, constant.ErrForbidden):
+			response.Fail(c, http.StatusForbidden, "还原失败: "+err.Error())
+		case errors.Is(err, constant.ErrNotFound):
+			response.Fail(c, http.StatusNotFound, "还原失败: "+err.Error())
+		case errors.Is(err, constant.ErrConflict):
+			response.Fail(c, http.StatusConflict, "还原失败: "+err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, "还原失败: "+err.Error())
+		}
+		return
+	}
+
+	response.Success(c, nil, "项目已还原")
+}
+
+// EmptyTrash 处理清空回收站的请求 (DELETE /api/file/trash)
+// @Summary      清空回收站
+// @Description  永久删除当前用户回收站中的全部项目并释放存储空间
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=model.EmptyTrashResponse}  "回收站已清空"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      500  {object}  response.Response  "清空失败"
+// @Router       /file/trash [delete]
+func (h *FileHandler) EmptyTrash(c *gin.Context) {
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+	ownerID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return
+	}
+
+	purged, err := h.fileSvc.EmptyTrash(c.Request.Context(), ownerID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "清空回收站失败: "+err.Error())
+		return
+	}
+
+	response.Success(c, model.EmptyTrashResponse{Purged: purged}, "回收站已清空")
+}
+
 // RenameItem 处理重命名文件或文件夹的请求 (PUT /api/file/rename)
 // @Summary      重命名文件/文件夹
 // @Description  重命名文件或文件夹
@@ -361,6 +451,8 @@ func (h *FileHandler) UpdateFileContentByID(c *gin.Context) {
 		case errors.Is(err, constant.ErrConflict):
 			// 这个冲突现在有了更明确的含义：文件被移动或重命名了
 			response.Fail(c, http.StatusConflict, "File location or name has changed. Please refresh.")
+		case errors.Is(err, constant.ErrStorageQuotaExceeded):
+			response.Fail(c, http.StatusRequestEntityTooLarge, err.Error())
 		default:
 			log.Printf("[Handler-ERROR] UpdateFileContentByIDAndURI failed for ID '%s': %v", publicID, err)
 			response.Fail(c, http.StatusInternalServerError, "Failed to update file content")
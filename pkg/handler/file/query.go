@@ -8,6 +8,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/uri"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
 
@@ -63,16 +64,28 @@ func (h *FileHandler) GetFilesByPath(c *gin.Context) {
 	// 3. 将所有顶层查询参数（如 next_token）合并到URI对象中，以便传递给服务层
 	parsedURI.Query = c.Request.URL.Query()
 
-	// 4. 解析目标文件所有者ID
-	ownerID, err := h.resolveMyFSTarget(c, claims, parsedURI)
-	if err != nil {
-		// 错误响应已在 resolveMyFSTarget 中处理
-		return
+	// 4. 解析目标文件所有者ID，分享的 FSID 是分享ID，所有者由分享本身决定
+	var ownerID uint
+	if parsedURI.FSType == "share" {
+		if !claims.HasPermission(model.PermissionAccessShare) {
+			response.Fail(c, http.StatusForbidden, "当前用户组没有访问分享的权限")
+			return
+		}
+	} else {
+		ownerID, err = h.resolveMyFSTarget(c, claims, parsedURI)
+		if err != nil {
+			// 错误响应已在 resolveMyFSTarget 中处理
+			return
+		}
 	}
 
 	// 5. 调用服务层执行核心逻辑
 	fileListResponse, err := h.fileSvc.QueryByURI(c.Request.Context(), ownerID, viewerID, parsedURI)
 	if err != nil {
+		if status, ok := shareErrorStatus(err); ok {
+			response.Fail(c, status, err.Error())
+			return
+		}
 		response.Fail(c, http.StatusInternalServerError, "获取文件列表失败: "+err.Error())
 		return
 	}
@@ -221,6 +234,80 @@ func (h *FileHandler) GetFolderTree(c *gin.Context) {
 	response.Success(c, folderTreeResponse, "文件夹内容列表获取成功")
 }
 
+// GetStorageUsage 获取当前用户的存储用量
+// @Summary      获取存储用量
+// @Description  返回已使用的存储容量、所在用户组的容量上限和下载限速，0 表示不限制
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /file/storage [get]
+func (h *FileHandler) GetStorageUsage(c *gin.Context) {
+	ownerID, ok := h.storageOwnerID(c)
+	if !ok {
+		return
+	}
+	usage, err := h.quotaSvc.GetUsage(c.Request.Context(), ownerID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取存储用量失败: "+err.Error())
+		return
+	}
+	response.Success(c, usage, "获取成功")
+}
+
+// RecalculateStorageUsage 重新统计当前用户的存储用量
+// @Summary      重新统计存储用量
+// @Description  根据名下的全部文件重新统计已使用的存储容量，用于修正用量统计上线前已存在的文件
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response  "统计完成"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /file/storage/recalculate [post]
+func (h *FileHandler) RecalculateStorageUsage(c *gin.Context) {
+	ownerID, ok := h.storageOwnerID(c)
+	if !ok {
+		return
+	}
+	usage, err := h.quotaSvc.Recalculate(c.Request.Context(), ownerID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, usage, "统计完成")
+}
+
+// storageOwnerID 解析当前登录用户的数据库ID，失败时已写入响应
+func (h *FileHandler) storageOwnerID(c *gin.Context) (uint, bool) {
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return 0, false
+	}
+	ownerID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return 0, false
+	}
+	return ownerID, true
+}
+
+// shareErrorStatus 将访问分享时的错误转换为 HTTP 状态码，不是分享相关的错误时返回 false
+func shareErrorStatus(err error) (int, bool) {
+	switch {
+	case errors.Is(err, constant.ErrShareNotFound), errors.Is(err, constant.ErrNotFound):
+		return http.StatusNotFound, true
+	case errors.Is(err, constant.ErrShareUnavailable):
+		return http.StatusGone, true
+	case errors.Is(err, constant.ErrSharePasswordRequired), errors.Is(err, constant.ErrSharePasswordInvalid),
+		errors.Is(err, constant.ErrSharePreviewOnly):
+		return http.StatusForbidden, true
+	default:
+		return 0, false
+	}
+}
+
 // getClaims 从 gin.Context 中安全地提取 JWT Claims
 func getClaims(c *gin.Context) (*auth.CustomClaims, error) {
 	claimsValue, exists := c.Get(auth.ClaimsKey)
@@ -26,6 +26,7 @@ import (
 // @Failure      401  {object}  response.Response  "未授权"
 // @Failure      404  {object}  response.Response  "目标路径不存在"
 // @Failure      409  {object}  response.Response  "文件已存在"
+// @Failure      413  {object}  response.Response  "超出存储容量"
 // @Failure      500  {object}  response.Response  "创建失败"
 // @Router       /file/upload [put]
 func (h *FileHandler) CreateUploadSession(c *gin.Context) {
@@ -51,6 +52,8 @@ func (h *FileHandler) CreateUploadSession(c *gin.Context) {
 			response.Fail(c, http.StatusConflict, "创建失败: "+err.Error())
 		} else if errors.Is(err, constant.ErrNotFound) {
 			response.Fail(c, http.StatusNotFound, "创建失败: "+err.Error())
+		} else if errors.Is(err, constant.ErrStorageQuotaExceeded) {
+			response.Fail(c, http.StatusRequestEntityTooLarge, "创建失败: "+err.Error())
 		} else {
 			response.Fail(c, http.StatusInternalServerError, "创建失败: "+err.Error())
 		}
@@ -201,6 +204,7 @@ func (h *FileHandler) DeleteUploadSession(c *gin.Context) {
 // @Failure      400  {object}  response.Response  "请求参数无效"
 // @Failure      401  {object}  response.Response  "未授权"
 // @Failure      404  {object}  response.Response  "存储策略不存在"
+// @Failure      413  {object}  response.Response  "超出存储容量"
 // @Failure      500  {object}  response.Response  "创建文件记录失败"
 // @Router       /file/upload/finalize [post]
 func (h *FileHandler) FinalizeClientUpload(c *gin.Context) {
@@ -225,6 +229,8 @@ func (h *FileHandler) FinalizeClientUpload(c *gin.Context) {
 	if err != nil {
 		if errors.Is(err, constant.ErrNotFound) {
 			response.Fail(c, http.StatusNotFound, "创建失败: "+err.Error())
+		} else if errors.Is(err, constant.ErrStorageQuotaExceeded) {
+			response.Fail(c, http.StatusRequestEntityTooLarge, "创建失败: "+err.Error())
 		} else {
 			response.Fail(c, http.StatusInternalServerError, "创建文件记录失败: "+err.Error())
 		}
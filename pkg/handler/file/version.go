@@ -0,0 +1,163 @@
+// pkg/handler/file/version.go
+package file
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListFileVersions 处理获取文件历史版本列表的请求 (GET /api/file/versions/:id)
+// @Summary      获取文件历史版本
+// @Description  列出文件的当前版本和全部历史版本，按写入时间倒序排列。文件被重新上传或在线编辑时，
+// @Description  旧内容会按所在存储策略的历史版本数上限保留下来，历史版本计入存储容量
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path  string  true  "文件公共ID"
+// @Success      200  {object}  response.Response{data=[]model.FileVersionItem}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      403  {object}  response.Response  "无权访问此文件"
+// @Failure      404  {object}  response.Response  "文件不存在"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /file/versions/{id} [get]
+func (h *FileHandler) ListFileVersions(c *gin.Context) {
+	ownerID, ok := currentOwnerID(c)
+	if !ok {
+		return
+	}
+	items, err := h.fileSvc.ListVersions(c.Request.Context(), ownerID, c.Param("id"))
+	if err != nil {
+		response.Fail(c, fileErrorStatus(err), "获取历史版本失败: "+err.Error())
+		return
+	}
+	response.Success(c, items, "获取历史版本成功")
+}
+
+// DownloadFileVersion 处理下载文件历史版本的请求 (GET /api/file/versions/:id/:versionID/download)
+// @Summary      下载文件历史版本
+// @Description  下载文件的某个历史版本，云存储会重定向到临时下载链接
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Produce      octet-stream
+// @Param        id         path  string  true  "文件公共ID"
+// @Param        versionID  path  string  true  "版本公共ID"
+// @Success      200  {file}    file  "文件内容"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      403  {object}  response.Response  "无权下载此文件"
+// @Failure      404  {object}  response.Response  "文件或版本不存在"
+// @Failure      500  {object}  response.Response  "下载失败"
+// @Router       /file/versions/{id}/{versionID}/download [get]
+func (h *FileHandler) DownloadFileVersion(c *gin.Context) {
+	ownerID, ok := currentOwnerID(c)
+	if !ok {
+		return
+	}
+	fileMeta, err := h.fileSvc.DownloadVersion(c.Request.Context(), ownerID, c.Param("id"), c.Param("versionID"), c.Writer)
+	if err != nil {
+		if !c.Writer.Written() {
+			response.Fail(c, fileErrorStatus(err), "下载历史版本失败: "+err.Error())
+		}
+		return
+	}
+
+	if !c.Writer.Written() {
+		c.Header("Content-Type", "application/octet-stream")
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename*=UTF-8''%s", url.PathEscape(fileMeta.Name)))
+		c.Header("Content-Length", fmt.Sprintf("%d", fileMeta.Size))
+	}
+}
+
+// RestoreFileVersion 处理将历史版本恢复为当前内容的请求 (POST /api/file/versions/:id/:versionID/restore)
+// @Summary      恢复文件历史版本
+// @Description  用历史版本的内容覆盖文件，恢复前的内容会按存储策略的设置保留为新的历史版本
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id         path  string  true  "文件公共ID"
+// @Param        versionID  path  string  true  "版本公共ID"
+// @Success      200  {object}  response.Response{data=model.UpdateResult}  "历史版本已恢复"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      403  {object}  response.Response  "无权修改此文件"
+// @Failure      404  {object}  response.Response  "文件或版本不存在"
+// @Failure      413  {object}  response.Response  "存储空间不足"
+// @Failure      500  {object}  response.Response  "恢复失败"
+// @Router       /file/versions/{id}/{versionID}/restore [post]
+func (h *FileHandler) RestoreFileVersion(c *gin.Context) {
+	ownerID, ok := currentOwnerID(c)
+	if !ok {
+		return
+	}
+	result, err := h.fileSvc.RestoreVersion(c.Request.Context(), ownerID, c.Param("id"), c.Param("versionID"))
+	if err != nil {
+		response.Fail(c, fileErrorStatus(err), "恢复历史版本失败: "+err.Error())
+		return
+	}
+	response.Success(c, result, "历史版本已恢复")
+}
+
+// DeleteFileVersion 处理删除文件历史版本的请求 (DELETE /api/file/versions/:id/:versionID)
+// @Summary      删除文件历史版本
+// @Description  永久删除文件的某个历史版本并释放其占用的存储容量，文件的当前版本不能删除
+// @Tags         文件管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id         path  string  true  "文件公共ID"
+// @Param        versionID  path  string  true  "版本公共ID"
+// @Success      200  {object}  response.Response  "历史版本已删除"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      403  {object}  response.Response  "无权修改此文件"
+// @Failure      404  {object}  response.Response  "文件或版本不存在"
+// @Failure      500  {object}  response.Response  "删除失败"
+// @Router       /file/versions/{id}/{versionID} [delete]
+func (h *FileHandler) DeleteFileVersion(c *gin.Context) {
+	ownerID, ok := currentOwnerID(c)
+	if !ok {
+		return
+	}
+	if err := h.fileSvc.DeleteVersion(c.Request.Context(), ownerID, c.Param("id"), c.Param("versionID")); err != nil {
+		response.Fail(c, fileErrorStatus(err), "删除历史版本失败: "+err.Error())
+		return
+	}
+	response.Success(c, nil, "历史版本已删除")
+}
+
+// currentOwnerID 从登录信息中解析当前用户的数据库ID，失败时直接写出错误响应
+func currentOwnerID(c *gin.Context) (uint, bool) {
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return 0, false
+	}
+	ownerID, entityType, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return 0, false
+	}
+	return ownerID, true
+}
+
+// fileErrorStatus 将历史版本、打包和解压相关的错误转换为 HTTP 状态码
+func fileErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, constant.ErrNotFound), errors.Is(err, constant.ErrVersionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, constant.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, constant.ErrInvalidOperation):
+		return http.StatusBadRequest
+	case errors.Is(err, constant.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, constant.ErrStorageQuotaExceeded):
+		return http.StatusRequestEntityTooLarge
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -10,7 +10,9 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/blocklist"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/link"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 )
@@ -31,12 +33,13 @@ var (
 
 // Handler 负责处理友链相关的 API 请求。
 type Handler struct {
-	linkSvc link.Service
+	linkSvc      link.Service
+	blocklistSvc blocklist.Service
 }
 
 // NewHandler 是 Handler 的构造函数。
-func NewHandler(linkSvc link.Service) *Handler {
-	return &Handler{linkSvc: linkSvc}
+func NewHandler(linkSvc link.Service, blocklistSvc blocklist.Service) *Handler {
+	return &Handler{linkSvc: linkSvc, blocklistSvc: blocklistSvc}
 }
 
 // --- 前台公开接口 ---
@@ -71,6 +74,7 @@ func (h *Handler) GetRandomLinks(c *gin.Context) {
 // @Param        body  body  model.ApplyLinkRequest  true  "友链申请信息"
 // @Success      200  {object}  response.Response  "申请已提交"
 // @Failure      400  {object}  response.Response  "参数无效"
+// @Failure      403  {object}  response.Response  "已被列入黑名单"
 // @Failure      500  {object}  response.Response  "申请失败"
 // @Router       /public/links [post]
 func (h *Handler) ApplyLink(c *gin.Context) {
@@ -80,6 +84,12 @@ func (h *Handler) ApplyLink(c *gin.Context) {
 		return
 	}
 
+	subject := blocklist.Subject{IP: util.GetRealClientIP(c), Email: req.Email, UserAgent: c.Request.UserAgent()}
+	if err := h.blocklistSvc.Check(c.Request.Context(), subject); err != nil {
+		response.Fail(c, http.StatusForbidden, err.Error())
+		return
+	}
+
 	_, err := h.linkSvc.ApplyLink(c.Request.Context(), &req)
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "申请失败: "+err.Error())
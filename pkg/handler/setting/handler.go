@@ -8,13 +8,17 @@
 package setting_handler
 
 import (
+	"fmt"
 	"log"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/handler/setting/dto"
-	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/cdn"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/config"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
@@ -109,21 +113,18 @@ func (h *SettingHandler) GetSettingsByKeys(c *gin.Context) {
 		return
 	}
 
-	// 检查是否为管理员
-	isAdmin := false
+	// 检查是否拥有站点配置权限
+	canManage := false
 	claimsValue, exists := c.Get(auth.ClaimsKey)
 	if exists {
 		if claims, ok := claimsValue.(*auth.CustomClaims); ok {
-			userGroupID, entityType, err := idgen.DecodePublicID(claims.UserGroupID)
-			if err == nil && entityType == idgen.EntityTypeUserGroup && userGroupID == 1 {
-				isAdmin = true
-			}
+			canManage = claims.HasPermission(model.PermissionSettingManage)
 		}
 	}
 
 	var settings map[string]interface{}
-	if isAdmin {
-		// 管理员可以获取所有配置
+	if canManage {
+		// 拥有站点配置权限的用户可以获取所有配置
 		settings = h.settingSvc.GetByKeys(req.Keys)
 	} else {
 		// 普通用户只能获取公开配置
@@ -183,6 +184,15 @@ func (h *SettingHandler) UpdateSettings(c *gin.Context) {
 	// 检查是否有影响前端的公开配置被更新
 	needsPurgeCDN := h.checkIfNeedsPurgeCDN(settingsToUpdate)
 
+	// 记录更新前的值，用于审计日志
+	before := make(map[string]string, len(settingsToUpdate))
+	keys := make([]string, 0, len(settingsToUpdate))
+	for key := range settingsToUpdate {
+		before[key] = h.settingSvc.Get(key)
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
 	// 调用 Service 层执行更新
 	err := h.settingSvc.UpdateSettings(c.Request.Context(), settingsToUpdate)
 	if err != nil {
@@ -190,6 +200,8 @@ func (h *SettingHandler) UpdateSettings(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "更新配置失败，请查看服务器日志")
 		return
 	}
+	audit.SetTarget(c.Request.Context(), strings.Join(keys, ","), fmt.Sprintf("更新了 %d 项配置", len(keys)))
+	audit.SetChange(c.Request.Context(), before, settingsToUpdate)
 
 	// 如果需要，异步清除CDN缓存
 	if needsPurgeCDN && h.cdnSvc != nil {
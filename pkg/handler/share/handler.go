@@ -0,0 +1,403 @@
+package share
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/uri"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	auth_service "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	share_service "github.com/anzhiyu-c/anheyu-app/pkg/service/share"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 负责处理文件分享相关的HTTP请求
+type Handler struct {
+	svc      share_service.Service
+	fileSvc  file_service.FileService
+	guardSvc auth_service.LoginGuardService
+}
+
+// NewHandler 是 Handler 的构造函数
+func NewHandler(svc share_service.Service, fileSvc file_service.FileService, guardSvc auth_service.LoginGuardService) *Handler {
+	return &Handler{svc: svc, fileSvc: fileSvc, guardSvc: guardSvc}
+}
+
+// ListSharesRequest 定义了分享列表的查询参数
+type ListSharesRequest struct {
+	Page     int `form:"page"`
+	PageSize int `form:"pageSize" binding:"max=100"`
+}
+
+// ListSharesResponse 定义了分享列表的响应结构
+type ListSharesResponse struct {
+	List  []*model.ShareItem `json:"list"`
+	Total int                `json:"total"`
+	Page  int                `json:"page"`
+	Size  int                `json:"size"`
+}
+
+// CreateShare 创建分享链接
+// @Summary      创建分享链接
+// @Description  为自己的文件或文件夹创建分享链接，可设置访问密码、过期时间、下载次数上限和仅预览模式
+// @Tags         文件分享
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      model.CreateShareRequest  true  "分享设置"
+// @Success      200   {object}  response.Response{data=model.ShareItem}  "创建成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      403   {object}  response.Response  "无权分享此文件"
+// @Failure      404   {object}  response.Response  "文件不存在"
+// @Router       /shares [post]
+func (h *Handler) CreateShare(c *gin.Context) {
+	ownerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	var req model.CreateShareRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+	item, err := h.svc.Create(c.Request.Context(), ownerID, &req)
+	if err != nil {
+		failWithError(c, err)
+		return
+	}
+	response.Success(c, item, "分享创建成功")
+}
+
+// ListShares 分页获取自己创建的分享链接
+// @Summary      获取我的分享
+// @Tags         文件分享
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page      query     int  false  "页码，默认1"
+// @Param        pageSize  query     int  false  "每页数量，默认20"
+// @Success      200  {object}  response.Response{data=ListSharesResponse}  "获取成功"
+// @Failure      500  {object}  response.Response  "服务器内部错误"
+// @Router       /shares [get]
+func (h *Handler) ListShares(c *gin.Context) {
+	ownerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	var req ListSharesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 {
+		req.PageSize = 20
+	}
+	items, total, err := h.svc.List(c.Request.Context(), ownerID, req.Page, req.PageSize)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, ListSharesResponse{List: items, Total: total, Page: req.Page, Size: req.PageSize}, "获取成功")
+}
+
+// UpdateShare 修改分享链接
+// @Summary      修改分享链接
+// @Description  修改分享的访问密码、过期时间、下载次数上限和仅预览模式，未提供的字段保持不变
+// @Tags         文件分享
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                    true  "分享ID"
+// @Param        body  body      model.UpdateShareRequest  true  "分享设置"
+// @Success      200   {object}  response.Response{data=model.ShareItem}  "修改成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      404   {object}  response.Response  "分享不存在"
+// @Router       /shares/{id} [put]
+func (h *Handler) UpdateShare(c *gin.Context) {
+	ownerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	var req model.UpdateShareRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+	item, err := h.svc.Update(c.Request.Context(), ownerID, c.Param("id"), &req)
+	if err != nil {
+		failWithError(c, err)
+		return
+	}
+	response.Success(c, item, "分享已更新")
+}
+
+// DeleteShare 取消分享
+// @Summary      取消分享
+// @Tags         文件分享
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path      string  true  "分享ID"
+// @Success      200  {object}  response.Response  "取消成功"
+// @Failure      404  {object}  response.Response  "分享不存在"
+// @Router       /shares/{id} [delete]
+func (h *Handler) DeleteShare(c *gin.Context) {
+	ownerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	if err := h.svc.Delete(c.Request.Context(), ownerID, c.Param("id")); err != nil {
+		failWithError(c, err)
+		return
+	}
+	response.Success(c, nil, "分享已取消")
+}
+
+// GetShareInfo 获取分享信息
+// @Summary      获取分享信息
+// @Description  访问者打开分享链接时获取分享的基本信息，每次调用记录一次浏览。无需登录，有密码的分享需要提供密码
+// @Tags         文件分享
+// @Produce      json
+// @Param        id        path      string  true   "分享ID"
+// @Param        password  query     string  false  "访问密码"
+// @Success      200  {object}  response.Response{data=model.ShareInfo}  "获取成功"
+// @Failure      403  {object}  response.Response  "需要密码或密码错误"
+// @Failure      404  {object}  response.Response  "分享不存在"
+// @Failure      410  {object}  response.Response  "分享已过期或下载次数已用完"
+// @Failure      429  {object}  response.Response  "密码错误次数过多"
+// @Router       /share/{id} [get]
+func (h *Handler) GetShareInfo(c *gin.Context) {
+	viewerID, ok := h.authorizeVisitor(c)
+	if !ok {
+		return
+	}
+	info, err := h.svc.GetInfo(c.Request.Context(), c.Param("id"), c.Query("password"), viewerID)
+	if !h.checkResult(c, err) {
+		return
+	}
+	response.Success(c, info, "获取成功")
+}
+
+// ListShareFiles 浏览分享的文件夹
+// @Summary      浏览分享的文件夹
+// @Description  列出分享内指定路径下的文件，路径相对于被分享的文件夹。等同于以 anzhiyu://<分享ID>:<密码>@share/<路径> 调用文件列表接口，无需登录
+// @Tags         文件分享
+// @Produce      json
+// @Param        id          path      string  true   "分享ID"
+// @Param        path        query     string  false  "分享内的路径"  default(/)
+// @Param        password    query     string  false  "访问密码"
+// @Param        next_token  query     string  false  "分页令牌"
+// @Success      200  {object}  response.Response{data=model.FileListResponse}  "获取成功"
+// @Failure      403  {object}  response.Response  "需要密码或密码错误"
+// @Failure      404  {object}  response.Response  "分享或路径不存在"
+// @Failure      410  {object}  response.Response  "分享已过期或下载次数已用完"
+// @Failure      429  {object}  response.Response  "密码错误次数过多"
+// @Router       /share/{id}/files [get]
+func (h *Handler) ListShareFiles(c *gin.Context) {
+	viewerID, ok := h.authorizeVisitor(c)
+	if !ok {
+		return
+	}
+	shareURI := (&url.URL{
+		Scheme: "anzhiyu",
+		User:   url.UserPassword(c.Param("id"), c.Query("password")),
+		Host:   "share",
+		Path:   c.DefaultQuery("path", "/"),
+	}).String()
+	parsedURI, err := uri.Parse(shareURI)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "路径格式无效")
+		return
+	}
+	parsedURI.Query = c.Request.URL.Query()
+
+	list, err := h.fileSvc.QueryByURI(c.Request.Context(), 0, viewerID, parsedURI)
+	if !h.checkResult(c, err) {
+		return
+	}
+	response.Success(c, list, "文件列表获取成功")
+}
+
+// DownloadShareFile 下载分享内的文件
+// @Summary      下载分享内的文件
+// @Description  下载被分享的文件或分享文件夹内的文件，每次下载占用一次下载次数。仅预览的分享不允许下载
+// @Tags         文件分享
+// @Produce      octet-stream
+// @Param        id        path      string  true   "分享ID"
+// @Param        path      query     string  false  "分享内的文件路径，分享单个文件时留空"
+// @Param        password  query     string  false  "访问密码"
+// @Success      200  {file}    file  "文件内容"
+// @Success      302  {string}  string  "重定向到云存储下载链接"
+// @Failure      403  {object}  response.Response  "需要密码、密码错误或仅允许预览"
+// @Failure      404  {object}  response.Response  "分享或文件不存在"
+// @Failure      410  {object}  response.Response  "分享已过期或下载次数已用完"
+// @Failure      429  {object}  response.Response  "密码错误次数过多"
+// @Router       /share/{id}/download [get]
+func (h *Handler) DownloadShareFile(c *gin.Context) {
+	h.serveShareFile(c, false)
+}
+
+// PreviewShareFile 在线预览分享内的文件
+// @Summary      预览分享内的文件
+// @Description  以内联方式返回图片、音视频、PDF 和纯文本文件的内容供浏览器预览，不占用下载次数，仅预览的分享同样可用。其他类型只能下载
+// @Tags         文件分享
+// @Produce      octet-stream
+// @Param        id        path      string  true   "分享ID"
+// @Param        path      query     string  false  "分享内的文件路径，分享单个文件时留空"
+// @Param        password  query     string  false  "访问密码"
+// @Success      200  {file}    file  "文件内容"
+// @Failure      403  {object}  response.Response  "需要密码或密码错误"
+// @Failure      404  {object}  response.Response  "分享或文件不存在"
+// @Failure      410  {object}  response.Response  "分享已过期"
+// @Failure      415  {object}  response.Response  "该文件类型不支持在线预览"
+// @Failure      429  {object}  response.Response  "密码错误次数过多"
+// @Router       /share/{id}/preview [get]
+func (h *Handler) PreviewShareFile(c *gin.Context) {
+	h.serveShareFile(c, true)
+}
+
+// serveShareFile 校验分享后传输文件内容，preview 决定以内联还是附件方式返回
+func (h *Handler) serveShareFile(c *gin.Context, preview bool) {
+	if _, ok := h.authorizeVisitor(c); !ok {
+		return
+	}
+	ctx := c.Request.Context()
+	file, err := h.svc.PrepareDownload(ctx, c.Param("id"), c.Query("password"), c.DefaultQuery("path", "/"), preview)
+	if !h.checkResult(c, err) {
+		return
+	}
+
+	disposition := "attachment"
+	if preview {
+		disposition = "inline"
+		// 禁止浏览器按内容猜测类型，避免纯文本被当作 HTML 执行
+		c.Header("X-Content-Type-Options", "nosniff")
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("%s; filename*=UTF-8''%s", disposition, url.PathEscape(file.Name)))
+
+	publicFileID, err := idgen.GeneratePublicID(file.ID, idgen.EntityTypeFile)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "生成文件ID失败")
+		return
+	}
+	// 分享的访问者不是文件所有者，以 viewerID 为 0 的系统身份读取文件，按所有者的用户组限速
+	if _, err := h.fileSvc.Download(ctx, 0, publicFileID, c.Writer); err != nil && !c.Writer.Written() {
+		c.Writer.Header().Del("Content-Disposition")
+		failWithError(c, err)
+	}
+}
+
+// checkResult 处理访问分享的结果：密码错误时计入失败次数，次数过多时锁定该分享或IP；
+// 出错时已写入响应并返回 false
+func (h *Handler) checkResult(c *gin.Context, err error) bool {
+	ctx := c.Request.Context()
+	shareID := c.Param("id")
+	if err == nil {
+		if c.Query("password") != "" {
+			h.guardSvc.RecordSuccess(ctx, auth_service.AttemptScopeSharePassword, shareID)
+		}
+		return true
+	}
+	if errors.Is(err, constant.ErrSharePasswordInvalid) {
+		if lockErr := h.guardSvc.RecordFailure(ctx, auth_service.AttemptScopeSharePassword, shareID, util.GetRealClientIP(c)); lockErr != nil {
+			failTooManyAttempts(c, lockErr)
+			return false
+		}
+	}
+	failWithError(c, err)
+	return false
+}
+
+// authorizeVisitor 校验访问者能否访问分享：已登录用户按所在用户组判断，未登录的访问者按匿名用户组判断，
+// 提供了密码时还会检查该分享或IP是否因密码错误次数过多被锁定。
+// 返回访问者的用户ID，未登录时为 0
+func (h *Handler) authorizeVisitor(c *gin.Context) (uint, bool) {
+	if c.Query("password") != "" {
+		if err := h.guardSvc.Check(c.Request.Context(), auth_service.AttemptScopeSharePassword, c.Param("id"), util.GetRealClientIP(c)); err != nil {
+			failTooManyAttempts(c, err)
+			return 0, false
+		}
+	}
+
+	claimsValue, exists := c.Get(auth.ClaimsKey)
+	if !exists {
+		if !h.svc.GuestCanAccess(c.Request.Context()) {
+			response.Fail(c, http.StatusUnauthorized, "请登录后访问分享")
+			return 0, false
+		}
+		return 0, true
+	}
+	claims, ok := claimsValue.(*auth.CustomClaims)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户信息格式不正确")
+		return 0, false
+	}
+	if !claims.HasPermission(model.PermissionAccessShare) {
+		response.Fail(c, http.StatusForbidden, "当前用户组没有访问分享的权限")
+		return 0, false
+	}
+	viewerID, _, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return 0, false
+	}
+	return viewerID, true
+}
+
+// currentUserID 解析当前登录用户的数据库ID，失败时已写入响应
+func currentUserID(c *gin.Context) (uint, bool) {
+	claimsValue, exists := c.Get(auth.ClaimsKey)
+	if !exists {
+		response.Fail(c, http.StatusUnauthorized, "无法获取用户信息，请确认是否已登录")
+		return 0, false
+	}
+	claims, ok := claimsValue.(*auth.CustomClaims)
+	if !ok {
+		response.Fail(c, http.StatusUnauthorized, "用户信息格式不正确")
+		return 0, false
+	}
+	userID, entityType, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusUnauthorized, "无效的用户凭证")
+		return 0, false
+	}
+	return userID, true
+}
+
+// failWithError 将分享相关的错误转换为对应的 HTTP 状态码
+func failWithError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constant.ErrShareNotFound), errors.Is(err, constant.ErrNotFound):
+		response.Fail(c, http.StatusNotFound, err.Error())
+	case errors.Is(err, constant.ErrShareUnavailable):
+		response.Fail(c, http.StatusGone, err.Error())
+	case errors.Is(err, constant.ErrSharePasswordRequired), errors.Is(err, constant.ErrSharePasswordInvalid),
+		errors.Is(err, constant.ErrSharePreviewOnly), errors.Is(err, constant.ErrForbidden):
+		response.Fail(c, http.StatusForbidden, err.Error())
+	case errors.Is(err, constant.ErrSharePreviewUnsupported):
+		response.Fail(c, http.StatusUnsupportedMediaType, err.Error())
+	case errors.Is(err, constant.ErrInvalidOperation):
+		response.Fail(c, http.StatusBadRequest, err.Error())
+	default:
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// failTooManyAttempts 以 429 响应锁定错误，并通过 Retry-After 头告知剩余的锁定时长
+func failTooManyAttempts(c *gin.Context, err error) {
+	if lockErr, ok := auth_service.AsLockoutError(err); ok {
+		c.Header("Retry-After", strconv.Itoa(int(lockErr.RetryAfter.Seconds())+1))
+	}
+	response.Fail(c, http.StatusTooManyRequests, err.Error())
+}
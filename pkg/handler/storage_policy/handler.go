@@ -19,6 +19,8 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
 )
 
@@ -55,12 +57,13 @@ type PolicyListResponse struct {
 
 // StoragePolicyHandler 负责处理所有与存储策略相关的HTTP请求
 type StoragePolicyHandler struct {
-	svc volume.IStoragePolicyService
+	svc          volume.IStoragePolicyService
+	migrationSvc file_service.IPolicyMigrationService
 }
 
 // NewStoragePolicyHandler 是 StoragePolicyHandler 的构造函数
-func NewStoragePolicyHandler(svc volume.IStoragePolicyService) *StoragePolicyHandler {
-	return &StoragePolicyHandler{svc: svc}
+func NewStoragePolicyHandler(svc volume.IStoragePolicyService, migrationSvc file_service.IPolicyMigrationService) *StoragePolicyHandler {
+	return &StoragePolicyHandler{svc: svc, migrationSvc: migrationSvc}
 }
 
 // Create 处理创建存储策略的请求
@@ -119,6 +122,9 @@ func (h *StoragePolicyHandler) Create(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, err.Error())
 		return
 	}
+	policyPublicID, _ := idgen.GeneratePublicID(policy.ID, idgen.EntityTypeStoragePolicy)
+	audit.SetTarget(c.Request.Context(), policyPublicID, "创建存储策略 "+policy.Name)
+	audit.SetChange(c.Request.Context(), nil, policy)
 
 	responseItem, err := h.buildStoragePolicyResponseItem(policy)
 	if err != nil {
@@ -316,6 +322,8 @@ func (h *StoragePolicyHandler) Update(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "获取更新后的策略信息失败: "+err.Error())
 		return
 	}
+	audit.SetTarget(c.Request.Context(), "", "更新存储策略 "+updatedPolicy.Name)
+	audit.SetChange(c.Request.Context(), existingPolicy, updatedPolicy)
 
 	responseItem, err := h.buildStoragePolicyResponseItem(updatedPolicy)
 	if err != nil {
@@ -389,6 +397,82 @@ func (h *StoragePolicyHandler) AuthorizeOneDrive(c *gin.Context) {
 	response.Success(c, nil, "授权成功")
 }
 
+// Migrate 处理将存储策略中的文件迁移到另一个存储策略的请求
+// @Summary      迁移存储策略中的文件
+// @Description  在后台把源存储策略中的全部文件复制到目标存储策略，校验大小和哈希后切换存储位置，可选删除源文件。中断后再次发起会从剩余的文件继续
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path  string                        true  "源策略公共ID"
+// @Param        body  body  model.PolicyMigrationRequest  true  "迁移参数"
+// @Success      200  {object}  response.Response{data=model.PolicyMigrationStatus}  "迁移已开始"
+// @Failure      400  {object}  response.Response  "参数无效"
+// @Failure      404  {object}  response.Response  "策略未找到"
+// @Failure      409  {object}  response.Response  "迁移正在进行中"
+// @Failure      500  {object}  response.Response  "启动迁移失败"
+// @Router       /storage-policies/{id}/migrate [post]
+func (h *StoragePolicyHandler) Migrate(c *gin.Context) {
+	publicID := c.Param("id")
+	if publicID == "" {
+		response.Fail(c, http.StatusBadRequest, "ID 不能为空")
+		return
+	}
+
+	var req model.PolicyMigrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数无效: "+err.Error())
+		return
+	}
+
+	status, err := h.migrationSvc.StartMigration(c.Request.Context(), publicID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, constant.ErrPolicyNotFound):
+			response.Fail(c, http.StatusNotFound, err.Error())
+		case errors.Is(err, constant.ErrInvalidOperation), errors.Is(err, constant.ErrInvalidPublicID):
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		case errors.Is(err, constant.ErrConflict):
+			response.Fail(c, http.StatusConflict, err.Error())
+		default:
+			response.Fail(c, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+	audit.SetTarget(c.Request.Context(), publicID, "迁移存储策略到 "+req.TargetPolicyID)
+	response.Success(c, status, "迁移已开始")
+}
+
+// GetMigrationStatus 获取存储策略最近一次迁移的进度
+// @Summary      获取存储策略迁移进度
+// @Description  获取源存储策略最近一次迁移的进度，从未迁移过时返回空
+// @Tags         存储策略
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path  string  true  "源策略公共ID"
+// @Success      200  {object}  response.Response{data=model.PolicyMigrationStatus}  "获取成功"
+// @Failure      404  {object}  response.Response  "策略未找到"
+// @Failure      500  {object}  response.Response  "获取失败"
+// @Router       /storage-policies/{id}/migration [get]
+func (h *StoragePolicyHandler) GetMigrationStatus(c *gin.Context) {
+	publicID := c.Param("id")
+	if publicID == "" {
+		response.Fail(c, http.StatusBadRequest, "ID 不能为空")
+		return
+	}
+
+	status, err := h.migrationSvc.GetMigrationStatus(c.Request.Context(), publicID)
+	if err != nil {
+		if errors.Is(err, constant.ErrPolicyNotFound) {
+			response.Fail(c, http.StatusNotFound, "策略未找到")
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, status, "获取成功")
+}
+
 // buildStoragePolicyResponseItem 辅助函数，将 model.StoragePolicy 转换为 model.StoragePolicyResponse
 func (h *StoragePolicyHandler) buildStoragePolicyResponseItem(policy *model.StoragePolicy) (*model.StoragePolicyResponse, error) {
 	if policy == nil {
@@ -2,29 +2,65 @@
 package subscriber
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/blocklist"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/captcha"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/subscriber"
+	"github.com/anzhiyu-c/anheyu-app/pkg/util"
 
 	"github.com/gin-gonic/gin"
 )
 
 // Handler 订阅功能处理器
 type Handler struct {
-	svc        *subscriber.Service
-	captchaSvc captcha.CaptchaService
+	svc          *subscriber.Service
+	captchaSvc   captcha.CaptchaService
+	blocklistSvc blocklist.Service
+	guardSvc     auth.LoginGuardService
 }
 
 // NewHandler 创建订阅处理器实例
-func NewHandler(svc *subscriber.Service, captchaSvc captcha.CaptchaService) *Handler {
+func NewHandler(svc *subscriber.Service, captchaSvc captcha.CaptchaService, blocklistSvc blocklist.Service, guardSvc auth.LoginGuardService) *Handler {
 	return &Handler{
-		svc:        svc,
-		captchaSvc: captchaSvc,
+		svc:          svc,
+		captchaSvc:   captchaSvc,
+		blocklistSvc: blocklistSvc,
+		guardSvc:     guardSvc,
 	}
 }
 
+// checkBlocklist 检查订阅者是否命中黑名单，命中时直接写入 403 响应并返回 false
+func (h *Handler) checkBlocklist(c *gin.Context, email string) bool {
+	subject := blocklist.Subject{IP: util.GetRealClientIP(c), Email: email, UserAgent: c.Request.UserAgent()}
+	if err := h.blocklistSvc.Check(c.Request.Context(), subject); err != nil {
+		response.Fail(c, http.StatusForbidden, err.Error())
+		return false
+	}
+	return true
+}
+
+// checkAttempts 检查邮箱或IP是否因失败次数过多被锁定，锁定时直接写入 429 响应并返回 false
+func (h *Handler) checkAttempts(c *gin.Context, scope auth.AttemptScope, email string) bool {
+	if err := h.guardSvc.Check(c.Request.Context(), scope, email, util.GetRealClientIP(c)); err != nil {
+		failTooManyAttempts(c, err)
+		return false
+	}
+	return true
+}
+
+// failTooManyAttempts 以 429 响应锁定错误，并通过 Retry-After 头告知剩余的锁定时长
+func failTooManyAttempts(c *gin.Context, err error) {
+	if lockErr, ok := auth.AsLockoutError(err); ok {
+		c.Header("Retry-After", strconv.Itoa(int(lockErr.RetryAfter.Seconds())+1))
+	}
+	response.Fail(c, http.StatusTooManyRequests, err.Error())
+}
+
 // SubscribeRequest 订阅请求
 type SubscribeRequest struct {
 	Email string `json:"email" binding:"required,email"`
@@ -45,7 +81,9 @@ type UnsubscribeRequest struct {
 // @Param        subscribe_request body SubscribeRequest true "订阅请求"
 // @Success      200 {object} response.Response "订阅成功"
 // @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "已被列入黑名单"
 // @Failure      409 {object} response.Response "邮箱已订阅"
+// @Failure      429 {object} response.Response "验证码错误次数过多"
 // @Failure      500 {object} response.Response "服务器内部错误"
 // @Router       /public/subscribe [post]
 func (h *Handler) Subscribe(c *gin.Context) {
@@ -54,6 +92,12 @@ func (h *Handler) Subscribe(c *gin.Context) {
 		response.Fail(c, http.StatusBadRequest, "请输入有效的邮箱地址")
 		return
 	}
+	if !h.checkBlocklist(c, req.Email) {
+		return
+	}
+	if !h.checkAttempts(c, auth.AttemptScopeSubscribe, req.Email) {
+		return
+	}
 
 	err := h.svc.Subscribe(c.Request.Context(), req.Email, req.Code)
 	if err != nil {
@@ -61,9 +105,18 @@ func (h *Handler) Subscribe(c *gin.Context) {
 			response.Fail(c, http.StatusConflict, err.Error())
 			return
 		}
+		if errors.Is(err, subscriber.ErrCodeMismatch) || errors.Is(err, subscriber.ErrCodeExpired) {
+			if lockErr := h.guardSvc.RecordFailure(c.Request.Context(), auth.AttemptScopeSubscribe, req.Email, util.GetRealClientIP(c)); lockErr != nil {
+				failTooManyAttempts(c, lockErr)
+				return
+			}
+			response.Fail(c, http.StatusBadRequest, err.Error())
+			return
+		}
 		response.Fail(c, http.StatusInternalServerError, "订阅失败: "+err.Error())
 		return
 	}
+	h.guardSvc.RecordSuccess(c.Request.Context(), auth.AttemptScopeSubscribe, req.Email)
 
 	response.Success(c, nil, "订阅成功！您将在新文章发布时收到邮件通知")
 }
@@ -160,6 +213,7 @@ type SendVerificationCodeRequest struct {
 // @Param        request body SendVerificationCodeRequest true "发送验证码请求"
 // @Success      200 {object} response.Response "发送成功"
 // @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      429 {object} response.Response "请求过于频繁"
 // @Failure      500 {object} response.Response "服务器内部错误"
 // @Router       /public/subscribe/code [post]
 func (h *Handler) SendVerificationCode(c *gin.Context) {
@@ -168,6 +222,9 @@ func (h *Handler) SendVerificationCode(c *gin.Context) {
 		response.Fail(c, http.StatusBadRequest, "请输入有效的邮箱地址")
 		return
 	}
+	if !h.checkBlocklist(c, req.Email) {
+		return
+	}
 
 	// 验证人机验证（如果启用）
 	captchaParams := captcha.CaptchaParams{
@@ -184,6 +241,13 @@ func (h *Handler) SendVerificationCode(c *gin.Context) {
 		return
 	}
 
+	// 每次发送都计入尝试次数，防止被用于向他人邮箱批量发送邮件
+	if !h.checkAttempts(c, auth.AttemptScopeSubscribeCode, req.Email) {
+		return
+	}
+	// 触发锁定的这次请求仍然正常发送，之后的请求才会被拒绝
+	_ = h.guardSvc.RecordFailure(c.Request.Context(), auth.AttemptScopeSubscribeCode, req.Email, util.GetRealClientIP(c))
+
 	err := h.svc.SendVerificationCode(c.Request.Context(), req.Email)
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, err.Error())
@@ -21,6 +21,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/theme"
 	"github.com/gin-gonic/gin"
 )
@@ -217,6 +218,7 @@ func (h *Handler) InstallTheme(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "安装主题失败: "+err.Error())
 		return
 	}
+	audit.SetTarget(c.Request.Context(), req.ThemeName, "安装主题 "+req.ThemeName)
 
 	response.Success(c, nil, "主题安装成功")
 }
@@ -256,11 +258,14 @@ func (h *Handler) SwitchTheme(c *gin.Context) {
 		return
 	}
 
+	previous := h.currentThemeName(c, userID)
 	err = h.themeService.SwitchToTheme(c.Request.Context(), userID, req.ThemeName)
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "切换主题失败: "+err.Error())
 		return
 	}
+	audit.SetTarget(c.Request.Context(), req.ThemeName, "切换主题到 "+req.ThemeName)
+	audit.SetChange(c.Request.Context(), previous, req.ThemeName)
 
 	// 添加缓存清理头，告诉浏览器清理静态文件缓存
 	c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
@@ -292,11 +297,14 @@ func (h *Handler) SwitchToOfficial(c *gin.Context) {
 		return
 	}
 
+	previous := h.currentThemeName(c, userID)
 	err = h.themeService.SwitchToOfficial(c.Request.Context(), userID)
 	if err != nil {
 		response.Fail(c, http.StatusInternalServerError, "切换到官方主题失败: "+err.Error())
 		return
 	}
+	audit.SetTarget(c.Request.Context(), "official", "切换到官方主题")
+	audit.SetChange(c.Request.Context(), previous, h.currentThemeName(c, userID))
 
 	// 添加缓存清理头，告诉浏览器清理静态文件缓存
 	c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
@@ -346,6 +354,7 @@ func (h *Handler) UninstallTheme(c *gin.Context) {
 		response.Fail(c, http.StatusInternalServerError, "卸载主题失败: "+err.Error())
 		return
 	}
+	audit.SetTarget(c.Request.Context(), req.ThemeName, "卸载主题 "+req.ThemeName)
 
 	response.Success(c, nil, "主题卸载成功")
 }
@@ -555,3 +564,12 @@ func (h *Handler) FixThemeStatus(c *gin.Context) {
 	log.Printf("[Theme Handler] 用户 %d 的主题状态修复完成", userID)
 	response.Success(c, nil, "主题状态修复完成")
 }
+
+// currentThemeName 获取用户当前使用的主题名称，用于审计日志记录切换前后的主题，获取失败时返回空字符串
+func (h *Handler) currentThemeName(c *gin.Context, userID uint) string {
+	themeInfo, err := h.themeService.GetCurrentTheme(c.Request.Context(), userID)
+	if err != nil || themeInfo == nil {
+		return ""
+	}
+	return themeInfo.Name
+}
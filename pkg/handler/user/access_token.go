@@ -0,0 +1,190 @@
+package user_handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateAccessTokenRequest 定义了创建个人访问令牌的请求结构
+type CreateAccessTokenRequest struct {
+	Name          string   `json:"name" binding:"required,max=64"`
+	Scopes        []string `json:"scopes" binding:"required,min=1"`
+	ExpiresInDays int      `json:"expires_in_days" binding:"min=0,max=3650"` // 0 表示永不过期
+}
+
+// AccessTokenResponse 定义了个人访问令牌列表中每一项的响应结构
+type AccessTokenResponse struct {
+	ID          string     `json:"id"` // 令牌的公共ID
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIP  string     `json:"last_used_ip"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// CreateAccessTokenResponse 定义了创建令牌后的响应结构，明文令牌只返回这一次
+type CreateAccessTokenResponse struct {
+	AccessTokenResponse
+	Token string `json:"token"`
+}
+
+// ListAccessTokenScopes 获取可用的授权范围
+// @Summary      获取访问令牌授权范围
+// @Description  :read 范围只允许读取 (GET/HEAD) 请求，:write 范围同时包含对应的读取权限
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]string}  "获取成功"
+// @Router       /user/access-tokens/scopes [get]
+func (h *UserHandler) ListAccessTokenScopes(c *gin.Context) {
+	response.Success(c, model.AccessTokenScopes, "获取成功")
+}
+
+// ListAccessTokens 获取当前用户的个人访问令牌
+// @Summary      获取个人访问令牌列表
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]AccessTokenResponse}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /user/access-tokens [get]
+func (h *UserHandler) ListAccessTokens(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	tokens, err := h.accessTokenSvc.List(c.Request.Context(), user.ID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	list := make([]AccessTokenResponse, 0, len(tokens))
+	for _, t := range tokens {
+		list = append(list, toAccessTokenResponse(t))
+	}
+	response.Success(c, list, "获取成功")
+}
+
+// CreateAccessToken 创建个人访问令牌
+// @Summary      创建个人访问令牌
+// @Description  令牌用于 CI 等自动化场景，请求时放在 Authorization: Bearer 头中。明文令牌只在创建时返回一次
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      CreateAccessTokenRequest  true  "令牌信息"
+// @Success      200   {object}  response.Response{data=CreateAccessTokenResponse}  "创建成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Router       /user/access-tokens [post]
+func (h *UserHandler) CreateAccessToken(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	var req CreateAccessTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误：名称不能为空，至少需要一个授权范围")
+		return
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresInDays > 0 {
+		t := time.Now().AddDate(0, 0, req.ExpiresInDays)
+		expiresAt = &t
+	}
+	token, plaintext, err := h.accessTokenSvc.Create(c.Request.Context(), user.ID, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		response.Fail(c, accessTokenErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, CreateAccessTokenResponse{
+		AccessTokenResponse: toAccessTokenResponse(token),
+		Token:               plaintext,
+	}, "创建成功，请立即复制保存，令牌只显示这一次")
+}
+
+// RevokeAccessToken 撤销个人访问令牌
+// @Summary      撤销个人访问令牌
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path      string  true  "令牌ID"
+// @Success      200  {object}  response.Response  "撤销成功"
+// @Failure      404  {object}  response.Response  "令牌不存在"
+// @Router       /user/access-tokens/:id [delete]
+func (h *UserHandler) RevokeAccessToken(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	tokenID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeAccessToken {
+		response.Fail(c, http.StatusBadRequest, "令牌ID无效")
+		return
+	}
+
+	if err := h.accessTokenSvc.Revoke(c.Request.Context(), user.ID, tokenID); err != nil {
+		response.Fail(c, accessTokenErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, nil, "令牌已撤销")
+}
+
+// AdminRevokeAccessTokens 管理员撤销用户的全部个人访问令牌
+// @Summary      管理员撤销访问令牌
+// @Description  撤销指定用户的全部个人访问令牌，用于令牌泄露等情况
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path      string  true  "用户ID"
+// @Success      200  {object}  response.Response{data=object{revoked=int}}  "撤销成功"
+// @Failure      400  {object}  response.Response  "用户ID无效"
+// @Router       /admin/users/:id/access-tokens [delete]
+func (h *UserHandler) AdminRevokeAccessTokens(c *gin.Context) {
+	userID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusBadRequest, "用户ID无效")
+		return
+	}
+	revoked, err := h.accessTokenSvc.RevokeAll(c.Request.Context(), userID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, gin.H{"revoked": revoked}, "访问令牌已全部撤销")
+}
+
+func toAccessTokenResponse(t *model.AccessToken) AccessTokenResponse {
+	publicID, _ := idgen.GeneratePublicID(t.ID, idgen.EntityTypeAccessToken)
+	return AccessTokenResponse{
+		ID:          publicID,
+		Name:        t.Name,
+		TokenPrefix: t.TokenPrefix,
+		Scopes:      t.Scopes,
+		ExpiresAt:   t.ExpiresAt,
+		LastUsedAt:  t.LastUsedAt,
+		LastUsedIP:  t.LastUsedIP,
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+// accessTokenErrorStatus 将个人访问令牌相关的业务错误转换为 HTTP 状态码
+func accessTokenErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, constant.ErrAccessTokenNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusBadRequest
+	}
+}
@@ -19,8 +19,10 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
 	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	auth_service "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/direct_link"
 	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
@@ -31,28 +33,36 @@ import (
 
 // UserHandler 封装已登录用户账户相关的控制器方法
 type UserHandler struct {
-	userSvc       user.UserService
-	settingSvc    setting.SettingService
-	fileSvc       file_service.FileService
-	directLinkSvc direct_link.Service
+	userSvc        user.UserService
+	settingSvc     setting.SettingService
+	fileSvc        file_service.FileService
+	directLinkSvc  direct_link.Service
+	twoFactorSvc   auth_service.TwoFactorService
+	accessTokenSvc auth_service.AccessTokenService
+	sessionSvc     auth_service.SessionService
+	invitationSvc  auth_service.InvitationService
 }
 
 // NewUserHandler 是 UserHandler 的构造函数
-func NewUserHandler(userSvc user.UserService, settingSvc setting.SettingService, fileSvc file_service.FileService, directLinkSvc direct_link.Service) *UserHandler {
+func NewUserHandler(userSvc user.UserService, settingSvc setting.SettingService, fileSvc file_service.FileService, directLinkSvc direct_link.Service, twoFactorSvc auth_service.TwoFactorService, accessTokenSvc auth_service.AccessTokenService, sessionSvc auth_service.SessionService, invitationSvc auth_service.InvitationService) *UserHandler {
 	return &UserHandler{
-		userSvc:       userSvc,
-		settingSvc:    settingSvc,
-		fileSvc:       fileSvc,
-		directLinkSvc: directLinkSvc,
+		userSvc:        userSvc,
+		settingSvc:     settingSvc,
+		fileSvc:        fileSvc,
+		directLinkSvc:  directLinkSvc,
+		twoFactorSvc:   twoFactorSvc,
+		accessTokenSvc: accessTokenSvc,
+		sessionSvc:     sessionSvc,
+		invitationSvc:  invitationSvc,
 	}
 }
 
 // UserGroup 是内部用户组模型的简化版本，用于响应
 type UserGroup struct {
-	ID          string `json:"id"`          // 用户组的公共ID，改为 string 类型
-	Name        string `json:"name"`        // 用户组名称
-	Description string `json:"description"` // 用户组描述
-	// Permissions 和 Settings 根据需要决定是否包含或简化
+	ID          string        `json:"id"`          // 用户组的公共ID，改为 string 类型
+	Name        string        `json:"name"`        // 用户组名称
+	Description string        `json:"description"` // 用户组描述
+	Permissions model.Boolset `json:"permissions"` // 已授予的权限位列表，前端据此决定可见的后台菜单
 }
 
 // GetUserInfoResponse 用于定义获取用户信息时的响应结构体，包含公共ID
@@ -155,6 +165,7 @@ func (h *UserHandler) GetUserInfo(c *gin.Context) {
 			ID:          publicUserGroupID, // 用户组的公共ID
 			Name:        user.UserGroup.Name,
 			Description: user.UserGroup.Description,
+			Permissions: user.UserGroup.Permissions,
 		},
 		Status: user.Status,
 	}
@@ -390,6 +401,7 @@ func (h *UserHandler) AdminListUsers(c *gin.Context) {
 				ID:          publicGroupID,
 				Name:        user.UserGroup.Name,
 				Description: user.UserGroup.Description,
+				Permissions: user.UserGroup.Permissions,
 			},
 			Status: user.Status,
 		}
@@ -486,6 +498,7 @@ func (h *UserHandler) AdminCreateUser(c *gin.Context) {
 			ID:          publicGroupID,
 			Name:        user.UserGroup.Name,
 			Description: user.UserGroup.Description,
+			Permissions: user.UserGroup.Permissions,
 		},
 		Status: user.Status,
 	}
@@ -685,9 +698,22 @@ func (h *UserHandler) AdminUpdateUserStatus(c *gin.Context) {
 
 // UserGroupDTO 用户组数据传输对象
 type UserGroupDTO struct {
-	ID          string `json:"id"`          // 用户组公共ID
-	Name        string `json:"name"`        // 用户组名称
-	Description string `json:"description"` // 用户组描述
+	ID               string        `json:"id"`                 // 用户组公共ID
+	Name             string        `json:"name"`               // 用户组名称
+	Description      string        `json:"description"`        // 用户组描述
+	Permissions      model.Boolset `json:"permissions"`        // 已授予的权限位列表
+	MaxStorage       int64         `json:"max_storage"`        // 容量上限（字节），0 表示不限制
+	SpeedLimit       int64         `json:"speed_limit"`        // 下载限速（字节/秒），0 表示不限制
+	RequireTwoFactor bool          `json:"require_two_factor"` // 是否强制两步验证
+}
+
+// AdminUpdateUserGroupRequest 管理员更新用户组的请求体，未提供的字段保持不变
+type AdminUpdateUserGroupRequest struct {
+	Name        *string `json:"name" binding:"omitempty,max=50"`
+	Description *string `json:"description" binding:"omitempty,max=255"`
+	Permissions []uint  `json:"permissions"` // 完整的权限位列表，见 /admin/user-groups/permissions
+	MaxStorage  *int64  `json:"max_storage"`
+	SpeedLimit  *int64  `json:"speed_limit"`
 }
 
 // GetUserGroups 获取所有用户组列表
@@ -710,18 +736,70 @@ func (h *UserHandler) GetUserGroups(c *gin.Context) {
 	// 2. 转换为 DTO（包含公共ID）
 	groupDTOs := make([]UserGroupDTO, len(groups))
 	for i, group := range groups {
-		publicGroupID, _ := idgen.GeneratePublicID(group.ID, idgen.EntityTypeUserGroup)
-		groupDTOs[i] = UserGroupDTO{
-			ID:          publicGroupID,
-			Name:        group.Name,
-			Description: group.Description,
-		}
+		groupDTOs[i] = toUserGroupDTO(group)
 	}
 
 	// 3. 返回响应
 	response.Success(c, groupDTOs, "获取用户组列表成功")
 }
 
+// ListPermissions 获取全部权限位
+// @Summary      获取权限位列表
+// @Description  列出可以授予用户组的全部权限位，用于用户组编辑界面
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]model.PermissionInfo}  "获取成功"
+// @Router       /admin/user-groups/permissions [get]
+func (h *UserHandler) ListPermissions(c *gin.Context) {
+	response.Success(c, model.AllPermissions, "获取成功")
+}
+
+// AdminUpdateUserGroup 管理员更新用户组
+// @Summary      更新用户组
+// @Description  修改用户组的名称、描述、权限位、容量上限和下载限速。权限变更在组内用户的访问令牌刷新后生效
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                       true  "用户组ID"
+// @Param        body  body      AdminUpdateUserGroupRequest  true  "用户组信息"
+// @Success      200   {object}  response.Response{data=UserGroupDTO}  "更新成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Router       /admin/user-groups/:id [put]
+func (h *UserHandler) AdminUpdateUserGroup(c *gin.Context) {
+	groupID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeUserGroup {
+		response.Fail(c, http.StatusBadRequest, "用户组ID无效")
+		return
+	}
+	var req AdminUpdateUserGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+
+	group, err := h.userSvc.AdminUpdateUserGroup(c.Request.Context(), groupID, req.Name, req.Description, req.Permissions, req.MaxStorage, req.SpeedLimit)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, toUserGroupDTO(group), "用户组更新成功")
+}
+
+func toUserGroupDTO(group *model.UserGroup) UserGroupDTO {
+	publicGroupID, _ := idgen.GeneratePublicID(group.ID, idgen.EntityTypeUserGroup)
+	return UserGroupDTO{
+		ID:               publicGroupID,
+		Name:             group.Name,
+		Description:      group.Description,
+		Permissions:      group.Permissions,
+		MaxStorage:       group.MaxStorage,
+		SpeedLimit:       group.SpeedLimit,
+		RequireTwoFactor: group.Settings.RequireTwoFactor,
+	}
+}
+
 // UploadAvatar 处理用户头像上传请求
 // @Summary      上传用户头像
 // @Description  上传并设置用户自定义头像
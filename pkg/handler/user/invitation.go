@@ -0,0 +1,234 @@
+package user_handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+	auth_service "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateInvitationRequest 定义了生成邀请码的请求结构
+type CreateInvitationRequest struct {
+	UserGroupID   string `json:"user_group_id"`                            // 用户组的公共ID，为空时使用默认用户组
+	MaxUses       int    `json:"max_uses" binding:"min=0,max=10000"`       // 0 表示不限制
+	ExpiresInDays int    `json:"expires_in_days" binding:"min=0,max=3650"` // 0 表示永不过期
+	Email         string `json:"email" binding:"omitempty,email"`          // 限定使用的邮箱
+	Note          string `json:"note" binding:"max=255"`
+}
+
+// SendInvitationRequest 定义了发送邀请邮件的请求结构
+type SendInvitationRequest struct {
+	Email         string `json:"email" binding:"required,email"`
+	UserGroupID   string `json:"user_group_id"`
+	ExpiresInDays int    `json:"expires_in_days" binding:"min=0,max=3650"`
+	Note          string `json:"note" binding:"max=255"`
+}
+
+// InvitationResponse 定义了邀请码的响应结构
+type InvitationResponse struct {
+	ID          string     `json:"id"` // 邀请码的公共ID
+	Code        string     `json:"code"`
+	UserGroupID string     `json:"user_group_id"`
+	MaxUses     int        `json:"max_uses"`
+	UsedCount   int        `json:"used_count"`
+	Email       string     `json:"email"`
+	Note        string     `json:"note"`
+	Status      string     `json:"status"` // active/exhausted/expired/revoked
+	ExpiresAt   *time.Time `json:"expires_at"`
+	RevokedAt   *time.Time `json:"revoked_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ListInvitationsRequest 定义了邀请码列表的查询参数
+type ListInvitationsRequest struct {
+	Page     int `form:"page"`
+	PageSize int `form:"pageSize" binding:"max=100"`
+}
+
+// ListInvitationsResponse 定义了邀请码列表的响应结构
+type ListInvitationsResponse struct {
+	List  []InvitationResponse `json:"list"`
+	Total int                  `json:"total"`
+	Page  int                  `json:"page"`
+	Size  int                  `json:"size"`
+}
+
+// AdminListInvitations 分页获取邀请码
+// @Summary      获取邀请码列表
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page      query     int  false  "页码，默认1"
+// @Param        pageSize  query     int  false  "每页数量，默认20"
+// @Success      200  {object}  response.Response{data=ListInvitationsResponse}  "获取成功"
+// @Failure      500  {object}  response.Response  "服务器内部错误"
+// @Router       /admin/invitations [get]
+func (h *UserHandler) AdminListInvitations(c *gin.Context) {
+	var req ListInvitationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 {
+		req.PageSize = 20
+	}
+	codes, total, err := h.invitationSvc.List(c.Request.Context(), req.Page, req.PageSize)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	now := time.Now()
+	list := make([]InvitationResponse, 0, len(codes))
+	for _, code := range codes {
+		list = append(list, toInvitationResponse(code, now))
+	}
+	response.Success(c, ListInvitationsResponse{List: list, Total: total, Page: req.Page, Size: req.PageSize}, "获取成功")
+}
+
+// AdminCreateInvitation 生成邀请码
+// @Summary      生成邀请码
+// @Description  生成注册邀请码，可指定受邀用户的用户组、最大使用次数、有效期以及限定的邮箱
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      CreateInvitationRequest  true  "邀请码信息"
+// @Success      200   {object}  response.Response{data=InvitationResponse}  "生成成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Router       /admin/invitations [post]
+func (h *UserHandler) AdminCreateInvitation(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	var req CreateInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+	opts, ok := invitationOptions(c, req.UserGroupID, req.ExpiresInDays, req.Note)
+	if !ok {
+		return
+	}
+	opts.MaxUses = req.MaxUses
+	opts.Email = req.Email
+
+	code, err := h.invitationSvc.Create(c.Request.Context(), user.ID, opts)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp := toInvitationResponse(code, time.Now())
+	audit.SetTarget(c.Request.Context(), resp.ID, code.Code)
+	response.Success(c, resp, "生成成功")
+}
+
+// AdminSendInvitation 通过邮件发送邀请
+// @Summary      发送邀请邮件
+// @Description  为指定邮箱生成一个仅限该邮箱使用一次的邀请码，并通过邮件发送给对方
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      SendInvitationRequest  true  "邀请信息"
+// @Success      200   {object}  response.Response{data=InvitationResponse}  "邀请已发送"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Router       /admin/invitations/email [post]
+func (h *UserHandler) AdminSendInvitation(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	var req SendInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误：请填写有效的邮箱地址")
+		return
+	}
+	opts, ok := invitationOptions(c, req.UserGroupID, req.ExpiresInDays, req.Note)
+	if !ok {
+		return
+	}
+
+	code, err := h.invitationSvc.SendInvite(c.Request.Context(), user.ID, req.Email, opts)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp := toInvitationResponse(code, time.Now())
+	audit.SetTarget(c.Request.Context(), resp.ID, code.Email)
+	response.Success(c, resp, "邀请已发送")
+}
+
+// AdminRevokeInvitation 撤销邀请码
+// @Summary      撤销邀请码
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path      string  true  "邀请码ID"
+// @Success      200  {object}  response.Response  "撤销成功"
+// @Failure      404  {object}  response.Response  "邀请码不存在"
+// @Router       /admin/invitations/:id [delete]
+func (h *UserHandler) AdminRevokeInvitation(c *gin.Context) {
+	id, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeInvitationCode {
+		response.Fail(c, http.StatusBadRequest, "邀请码ID无效")
+		return
+	}
+	if err := h.invitationSvc.Revoke(c.Request.Context(), id); err != nil {
+		if errors.Is(err, constant.ErrInvitationNotFound) {
+			response.Fail(c, http.StatusNotFound, err.Error())
+			return
+		}
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	audit.SetTarget(c.Request.Context(), c.Param("id"), "")
+	response.Success(c, nil, "撤销成功")
+}
+
+// invitationOptions 解析生成邀请码时的公共参数，失败时已写入响应
+func invitationOptions(c *gin.Context, publicGroupID string, expiresInDays int, note string) (auth_service.InvitationOptions, bool) {
+	opts := auth_service.InvitationOptions{Note: note}
+	if publicGroupID != "" {
+		groupID, entityType, err := idgen.DecodePublicID(publicGroupID)
+		if err != nil || entityType != idgen.EntityTypeUserGroup {
+			response.Fail(c, http.StatusBadRequest, "用户组ID无效")
+			return opts, false
+		}
+		opts.UserGroupID = groupID
+	}
+	if expiresInDays > 0 {
+		t := time.Now().AddDate(0, 0, expiresInDays)
+		opts.ExpiresAt = &t
+	}
+	return opts, true
+}
+
+func toInvitationResponse(code *model.InvitationCode, now time.Time) InvitationResponse {
+	publicID, _ := idgen.GeneratePublicID(code.ID, idgen.EntityTypeInvitationCode)
+	publicGroupID, _ := idgen.GeneratePublicID(code.UserGroupID, idgen.EntityTypeUserGroup)
+	return InvitationResponse{
+		ID:          publicID,
+		Code:        code.Code,
+		UserGroupID: publicGroupID,
+		MaxUses:     code.MaxUses,
+		UsedCount:   code.UsedCount,
+		Email:       code.Email,
+		Note:        code.Note,
+		Status:      code.Status(now),
+		ExpiresAt:   code.ExpiresAt,
+		RevokedAt:   code.RevokedAt,
+		CreatedAt:   code.CreatedAt,
+	}
+}
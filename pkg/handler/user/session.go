@@ -0,0 +1,175 @@
+package user_handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SessionResponse 定义了登录会话列表中每一项的响应结构
+type SessionResponse struct {
+	ID         string    `json:"id"` // 会话的公共ID
+	Device     string    `json:"device"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	Current    bool      `json:"current"` // 是否为发起本次请求的会话
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// ListSessions 获取当前用户的登录会话
+// @Summary      获取登录会话列表
+// @Description  列出当前用户所有未过期的登录会话（设备），current 标记当前会话
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=[]SessionResponse}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /user/sessions [get]
+func (h *UserHandler) ListSessions(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	sessions, err := h.sessionSvc.List(c.Request.Context(), user.ID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	currentSessionID := currentSessionID(c)
+	list := make([]SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		list = append(list, toSessionResponse(s, currentSessionID))
+	}
+	response.Success(c, list, "获取成功")
+}
+
+// RevokeSession 撤销指定的登录会话
+// @Summary      撤销登录会话
+// @Description  撤销后该设备的刷新令牌和访问令牌立即失效
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path      string  true  "会话ID"
+// @Success      200  {object}  response.Response  "撤销成功"
+// @Failure      404  {object}  response.Response  "会话不存在"
+// @Router       /user/sessions/:id [delete]
+func (h *UserHandler) RevokeSession(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	sessionID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeUserSession {
+		response.Fail(c, http.StatusBadRequest, "会话ID无效")
+		return
+	}
+
+	if err := h.sessionSvc.Revoke(c.Request.Context(), user.ID, sessionID); err != nil {
+		response.Fail(c, sessionErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, nil, "会话已撤销")
+}
+
+// RevokeOtherSessions 退出其他所有设备
+// @Summary      退出其他设备
+// @Description  撤销除当前会话外的全部登录会话
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=object{revoked=int}}  "撤销成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /user/sessions [delete]
+func (h *UserHandler) RevokeOtherSessions(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	revoked, err := h.sessionSvc.RevokeOthers(c.Request.Context(), user.ID, currentSessionID(c))
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, gin.H{"revoked": revoked}, "已退出其他设备")
+}
+
+// Logout 退出登录
+// @Summary      退出登录
+// @Description  撤销当前会话，当前的刷新令牌和访问令牌立即失效
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response  "已退出登录"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /user/logout [post]
+func (h *UserHandler) Logout(c *gin.Context) {
+	if err := h.sessionSvc.RevokeBySessionID(c.Request.Context(), currentSessionID(c)); err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "已退出登录")
+}
+
+// AdminRevokeSessions 管理员强制用户下线
+// @Summary      强制用户下线
+// @Description  撤销指定用户的全部登录会话
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path      string  true  "用户ID"
+// @Success      200  {object}  response.Response  "操作成功"
+// @Failure      400  {object}  response.Response  "用户ID无效"
+// @Router       /admin/users/:id/sessions [delete]
+func (h *UserHandler) AdminRevokeSessions(c *gin.Context) {
+	userID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusBadRequest, "用户ID无效")
+		return
+	}
+	if err := h.sessionSvc.RevokeAll(c.Request.Context(), userID); err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "用户已被强制下线")
+}
+
+// currentSessionID 获取当前访问令牌所属的会话标识
+func currentSessionID(c *gin.Context) string {
+	claims, err := getClaims(c)
+	if err != nil {
+		return ""
+	}
+	return claims.SessionID
+}
+
+func toSessionResponse(s *model.UserSession, currentSessionID string) SessionResponse {
+	publicID, _ := idgen.GeneratePublicID(s.ID, idgen.EntityTypeUserSession)
+	return SessionResponse{
+		ID:         publicID,
+		Device:     s.Device,
+		IP:         s.IP,
+		UserAgent:  s.UserAgent,
+		Current:    currentSessionID != "" && s.SessionID == currentSessionID,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+		ExpiresAt:  s.ExpiresAt,
+	}
+}
+
+// sessionErrorStatus 将登录会话相关的业务错误转换为 HTTP 状态码
+func sessionErrorStatus(err error) int {
+	if errors.Is(err, constant.ErrSessionNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
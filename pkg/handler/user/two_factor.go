@@ -0,0 +1,245 @@
+package user_handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TwoFactorCodeRequest 是需要提交验证码的两步验证操作的请求体
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// AdminSetGroupTwoFactorRequest 管理员设置用户组是否强制两步验证的请求体
+type AdminSetGroupTwoFactorRequest struct {
+	Required bool `json:"required"`
+}
+
+// currentUser 从 JWT Claims 中解析出当前登录的用户
+func (h *UserHandler) currentUser(c *gin.Context) (*model.User, bool) {
+	claims, err := getClaims(c)
+	if err != nil {
+		response.Fail(c, http.StatusUnauthorized, err.Error())
+		return nil, false
+	}
+	userID, entityType, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusUnauthorized, "用户ID无效")
+		return nil, false
+	}
+	user, err := h.userSvc.GetUserInfoByID(c.Request.Context(), userID)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return nil, false
+	}
+	return user, true
+}
+
+// GetTwoFactorStatus 获取当前用户的两步验证状态
+// @Summary      获取两步验证状态
+// @Description  获取当前用户是否已启用两步验证、所在用户组是否强制启用以及剩余恢复码数量
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=auth.TwoFactorStatus}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Router       /user/2fa [get]
+func (h *UserHandler) GetTwoFactorStatus(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	status, err := h.twoFactorSvc.Status(c.Request.Context(), user)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, status, "获取成功")
+}
+
+// SetupTwoFactor 生成新的两步验证密钥和绑定二维码
+// @Summary      开始绑定两步验证
+// @Description  生成新的 TOTP 密钥，返回 otpauth 链接供验证器扫码，需调用 /user/2fa/enable 确认后才会生效
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Success      200  {object}  response.Response{data=auth.TwoFactorEnrollment}  "获取成功"
+// @Failure      401  {object}  response.Response  "未授权"
+// @Failure      409  {object}  response.Response  "两步验证已启用"
+// @Router       /user/2fa/setup [post]
+func (h *UserHandler) SetupTwoFactor(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	enrollment, err := h.twoFactorSvc.BeginEnrollment(c.Request.Context(), user)
+	if err != nil {
+		response.Fail(c, twoFactorErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, enrollment, "请使用验证器扫描二维码")
+}
+
+// EnableTwoFactor 用验证码确认绑定并启用两步验证
+// @Summary      启用两步验证
+// @Description  提交验证器生成的验证码确认绑定，成功后返回恢复码（仅展示这一次）
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      TwoFactorCodeRequest  true  "验证码"
+// @Success      200   {object}  response.Response{data=object{recoveryCodes=[]string}}  "启用成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Failure      401   {object}  response.Response  "验证码错误"
+// @Router       /user/2fa/enable [post]
+func (h *UserHandler) EnableTwoFactor(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请输入验证码")
+		return
+	}
+	codes, err := h.twoFactorSvc.ConfirmEnrollment(c.Request.Context(), user.ID, req.Code)
+	if err != nil {
+		response.Fail(c, twoFactorErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, gin.H{"recoveryCodes": codes}, "两步验证已启用，请妥善保存恢复码")
+}
+
+// DisableTwoFactor 关闭两步验证
+// @Summary      关闭两步验证
+// @Description  提交验证码或恢复码关闭两步验证；所在用户组强制启用时不可关闭
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      TwoFactorCodeRequest  true  "验证码或恢复码"
+// @Success      200   {object}  response.Response  "关闭成功"
+// @Failure      401   {object}  response.Response  "验证码错误"
+// @Failure      403   {object}  response.Response  "用户组强制启用两步验证"
+// @Router       /user/2fa/disable [post]
+func (h *UserHandler) DisableTwoFactor(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请输入验证码")
+		return
+	}
+	if err := h.twoFactorSvc.Disable(c.Request.Context(), user, req.Code); err != nil {
+		response.Fail(c, twoFactorErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, nil, "两步验证已关闭")
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码，旧的恢复码全部失效
+// @Summary      重新生成恢复码
+// @Description  提交验证器生成的验证码，重新生成一组恢复码，旧的恢复码全部失效
+// @Tags         用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      TwoFactorCodeRequest  true  "验证码"
+// @Success      200   {object}  response.Response{data=object{recoveryCodes=[]string}}  "生成成功"
+// @Failure      401   {object}  response.Response  "验证码错误"
+// @Router       /user/2fa/recovery-codes [post]
+func (h *UserHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	user, ok := h.currentUser(c)
+	if !ok {
+		return
+	}
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请输入验证码")
+		return
+	}
+	codes, err := h.twoFactorSvc.RegenerateRecoveryCodes(c.Request.Context(), user.ID, req.Code)
+	if err != nil {
+		response.Fail(c, twoFactorErrorStatus(err), err.Error())
+		return
+	}
+	response.Success(c, gin.H{"recoveryCodes": codes}, "恢复码已重新生成，请妥善保存")
+}
+
+// AdminResetTwoFactor 管理员重置用户的两步验证
+// @Summary      管理员重置两步验证
+// @Description  清除指定用户的两步验证配置，用于用户丢失验证器和恢复码的情况。若用户组强制启用，用户下次登录时需重新绑定
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id  path      string  true  "用户ID"
+// @Success      200  {object}  response.Response  "重置成功"
+// @Failure      400  {object}  response.Response  "用户ID无效"
+// @Router       /admin/users/:id/two-factor [delete]
+func (h *UserHandler) AdminResetTwoFactor(c *gin.Context) {
+	userID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeUser {
+		response.Fail(c, http.StatusBadRequest, "用户ID无效")
+		return
+	}
+	if err := h.twoFactorSvc.AdminReset(c.Request.Context(), userID); err != nil {
+		response.Fail(c, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response.Success(c, nil, "两步验证已重置")
+}
+
+// AdminSetGroupTwoFactor 管理员设置用户组是否强制两步验证
+// @Summary      设置用户组强制两步验证
+// @Description  开启后，该用户组中未绑定验证器的用户在下次登录时必须先完成绑定，且无法自行关闭两步验证
+// @Tags         管理员-用户管理
+// @Security     BearerAuth
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                         true  "用户组ID"
+// @Param        body  body      AdminSetGroupTwoFactorRequest  true  "是否强制"
+// @Success      200   {object}  response.Response  "设置成功"
+// @Failure      400   {object}  response.Response  "参数错误"
+// @Router       /admin/user-groups/:id/two-factor [put]
+func (h *UserHandler) AdminSetGroupTwoFactor(c *gin.Context) {
+	groupID, entityType, err := idgen.DecodePublicID(c.Param("id"))
+	if err != nil || entityType != idgen.EntityTypeUserGroup {
+		response.Fail(c, http.StatusBadRequest, "用户组ID无效")
+		return
+	}
+	var req AdminSetGroupTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "参数错误")
+		return
+	}
+	if err := h.userSvc.SetUserGroupRequireTwoFactor(c.Request.Context(), groupID, req.Required); err != nil {
+		response.Fail(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	response.Success(c, nil, "用户组设置已更新")
+}
+
+// twoFactorErrorStatus 将两步验证相关的业务错误转换为 HTTP 状态码
+func twoFactorErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, constant.ErrTwoFactorCodeInvalid):
+		return http.StatusUnauthorized
+	case errors.Is(err, constant.ErrTwoFactorAlreadyEnabled):
+		return http.StatusConflict
+	case errors.Is(err, constant.ErrTwoFactorNotEnabled):
+		return http.StatusBadRequest
+	case errors.Is(err, constant.ErrTwoFactorEnforced):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
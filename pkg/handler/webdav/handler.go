@@ -0,0 +1,270 @@
+// pkg/handler/webdav/handler.go
+package webdav
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	auth_service "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	webdav_service "github.com/anzhiyu-c/anheyu-app/pkg/service/webdav"
+
+	"github.com/gin-gonic/gin"
+	xwebdav "golang.org/x/net/webdav"
+)
+
+const (
+	// Prefix 是 WebDAV 端点的挂载路径
+	Prefix = "/dav"
+	realm  = `Basic realm="anheyu WebDAV", charset="UTF-8"`
+)
+
+// Methods 列出了 WebDAV 端点需要注册的全部 HTTP 方法
+var Methods = []string{
+	http.MethodOptions, http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete,
+	"PROPFIND", "PROPPATCH", "MKCOL", "COPY", "MOVE", "LOCK", "UNLOCK",
+}
+
+// readMethods 是不修改任何内容的方法，拥有 files:read 范围的访问令牌即可调用
+var readMethods = map[string]bool{
+	http.MethodOptions: true,
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	"PROPFIND":         true,
+}
+
+// Handler 负责处理 WebDAV 客户端的请求
+type Handler struct {
+	svc      webdav_service.Service
+	guardSvc auth_service.LoginGuardService
+	quotaSvc file_service.IQuotaService
+}
+
+// NewHandler 是 Handler 的构造函数
+func NewHandler(svc webdav_service.Service, guardSvc auth_service.LoginGuardService, quotaSvc file_service.IQuotaService) *Handler {
+	return &Handler{svc: svc, guardSvc: guardSvc, quotaSvc: quotaSvc}
+}
+
+// ServeDAV 处理 WebDAV 请求
+// @Summary      WebDAV 端点
+// @Description  使用 Basic Auth 认证（用户名为邮箱，密码为账户密码或个人访问令牌；启用两步验证的账户只能使用访问令牌），
+// @Description  支持 PROPFIND、GET、PUT、MKCOL、MOVE、COPY、DELETE、LOCK 等方法。需要用户组拥有 WebDAV 权限，写入和删除还分别需要上传、删除文件权限
+// @Tags         文件管理
+// @Security     BasicAuth
+// @Param        path  path  string  false  "文件路径"
+// @Success      200  "操作成功"
+// @Failure      401  "认证失败"
+// @Failure      403  "权限不足"
+// @Failure      429  "尝试次数过多"
+// @Failure      507  "存储空间不足"
+// @Router       /dav/{path} [get]
+func (h *Handler) ServeDAV(c *gin.Context) {
+	claims, ok := h.authenticate(c)
+	if !ok {
+		return
+	}
+	ownerID, entityType, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		c.String(http.StatusUnauthorized, "无效的用户凭据")
+		return
+	}
+	if !h.authorize(c, claims) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	fs := h.svc.FileSystem(ownerID)
+	ls := h.svc.LockSystem(ownerID)
+
+	switch c.Request.Method {
+	case http.MethodPut:
+		// 在接收请求体之前先按 Content-Length 校验容量，避免上传完才发现空间不足
+		if c.Request.ContentLength > 0 {
+			if err := h.quotaSvc.CheckQuota(ctx, ownerID, c.Request.ContentLength); err != nil {
+				c.String(statusForError(err), err.Error())
+				return
+			}
+		}
+	case "COPY":
+		if h.tryCopy(c, fs, ls) {
+			return
+		}
+	}
+
+	writer := http.ResponseWriter(c.Writer)
+	if c.Request.Method == http.MethodGet {
+		writer = &throttledResponseWriter{
+			ResponseWriter: c.Writer,
+			w:              utils.NewThrottledWriter(c.Writer, h.quotaSvc.GetSpeedLimit(ctx, ownerID), ctx),
+		}
+	}
+
+	davHandler := &xwebdav.Handler{
+		Prefix:     Prefix,
+		FileSystem: fs,
+		LockSystem: ls,
+		Logger: func(r *http.Request, err error) {
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				log.Printf("[WebDAV] 用户 %d %s %s 失败: %v", ownerID, r.Method, r.URL.Path, err)
+			}
+		},
+	}
+	davHandler.ServeHTTP(writer, c.Request)
+}
+
+// authenticate 校验 Basic Auth 凭据，失败次数过多时与网页登录共用锁定策略
+func (h *Handler) authenticate(c *gin.Context) (*auth.CustomClaims, bool) {
+	email, password, ok := c.Request.BasicAuth()
+	if !ok || email == "" || password == "" {
+		c.Header("WWW-Authenticate", realm)
+		c.String(http.StatusUnauthorized, "需要认证")
+		return nil, false
+	}
+
+	ctx := c.Request.Context()
+	ip := c.ClientIP()
+	if err := h.guardSvc.Check(ctx, auth_service.AttemptScopeLogin, email, ip); err != nil {
+		failTooManyAttempts(c, err)
+		return nil, false
+	}
+
+	claims, err := h.svc.Authenticate(ctx, email, password, ip)
+	if err != nil {
+		if errors.Is(err, constant.ErrWebDAVAppPasswordRequired) {
+			c.Header("WWW-Authenticate", realm)
+			c.String(http.StatusUnauthorized, err.Error())
+			return nil, false
+		}
+		if lockErr := h.guardSvc.RecordFailure(ctx, auth_service.AttemptScopeLogin, email, ip); lockErr != nil {
+			failTooManyAttempts(c, lockErr)
+			return nil, false
+		}
+		c.Header("WWW-Authenticate", realm)
+		c.String(http.StatusUnauthorized, "邮箱、密码或访问令牌错误")
+		return nil, false
+	}
+	h.guardSvc.RecordSuccess(ctx, auth_service.AttemptScopeLogin, email)
+	return claims, true
+}
+
+// authorize 校验用户组权限和访问令牌的授权范围
+func (h *Handler) authorize(c *gin.Context, claims *auth.CustomClaims) bool {
+	method := c.Request.Method
+	if !claims.HasPermission(model.PermissionWebDAV) {
+		c.String(http.StatusForbidden, "当前用户组未开放 WebDAV 访问")
+		return false
+	}
+
+	if claims.Scopes != nil {
+		allowed := slices.Contains(claims.Scopes, model.ScopeFilesWrite) ||
+			(readMethods[method] && slices.Contains(claims.Scopes, model.ScopeFilesRead))
+		if !allowed {
+			c.String(http.StatusForbidden, "访问令牌缺少所需的授权范围")
+			return false
+		}
+	}
+
+	switch method {
+	case http.MethodPut, "MKCOL", "COPY", "MOVE", "LOCK":
+		if !claims.HasPermission(model.PermissionUploadFile) {
+			c.String(http.StatusForbidden, "当前用户组没有上传文件的权限")
+			return false
+		}
+	case http.MethodDelete:
+		if !claims.HasPermission(model.PermissionDeleteFile) {
+			c.String(http.StatusForbidden, "当前用户组没有删除文件的权限")
+			return false
+		}
+	}
+	return true
+}
+
+// tryCopy 在目标与源同名且没有 If 条件头时，直接通过 FileService.CopyItems 完成复制，
+// 返回 false 表示需要交给 webdav 包按逐文件复制的方式处理
+func (h *Handler) tryCopy(c *gin.Context, fs *webdav_service.FileSystem, ls xwebdav.LockSystem) bool {
+	if c.Request.Header.Get("If") != "" || c.Request.Header.Get("Depth") == "0" {
+		return false
+	}
+	dest, err := url.Parse(c.Request.Header.Get("Destination"))
+	if err != nil || (dest.Host != "" && dest.Host != c.Request.Host) {
+		return false
+	}
+	src, ok1 := strings.CutPrefix(c.Request.URL.Path, Prefix)
+	dst, ok2 := strings.CutPrefix(dest.Path, Prefix)
+	if !ok1 || !ok2 || dst == "" || src == dst {
+		return false
+	}
+
+	// 目标已被锁定时交给 webdav 包返回 423
+	release, err := ls.Confirm(time.Now(), "", dst)
+	if err != nil {
+		return false
+	}
+	defer release()
+
+	created, err := fs.Copy(c.Request.Context(), src, dst, c.Request.Header.Get("Overwrite") != "F")
+	if errors.Is(err, webdav_service.ErrCopyFallback) {
+		return false
+	}
+	if err != nil {
+		c.String(statusForError(err), err.Error())
+		return true
+	}
+	if created {
+		c.Status(http.StatusCreated)
+	} else {
+		c.Status(http.StatusNoContent)
+	}
+	return true
+}
+
+// statusForError 将文件操作的错误转换为 WebDAV 客户端能理解的状态码
+func statusForError(err error) int {
+	switch {
+	case errors.Is(err, constant.ErrStorageQuotaExceeded):
+		return http.StatusInsufficientStorage
+	case errors.Is(err, webdav_service.ErrDestinationParentMissing):
+		return http.StatusConflict
+	case errors.Is(err, os.ErrExist):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, os.ErrNotExist), errors.Is(err, constant.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, constant.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, constant.ErrConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// failTooManyAttempts 返回 429 并在锁定信息中携带 Retry-After
+func failTooManyAttempts(c *gin.Context, err error) {
+	if lockErr, ok := auth_service.AsLockoutError(err); ok {
+		c.Header("Retry-After", strconv.Itoa(int(lockErr.RetryAfter.Seconds())+1))
+	}
+	c.String(http.StatusTooManyRequests, err.Error())
+}
+
+// throttledResponseWriter 按用户组的下载限速写出 GET 响应体
+type throttledResponseWriter struct {
+	http.ResponseWriter
+	w io.Writer
+}
+
+func (t *throttledResponseWriter) Write(p []byte) (int, error) {
+	return t.w.Write(p)
+}
@@ -0,0 +1,145 @@
+// pkg/handler/webmention/handler.go
+package webmention
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/response"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/webmention"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler 负责处理 Webmention 相关的 API 请求
+type Handler struct {
+	svc webmention.Service
+}
+
+// NewHandler 是 Handler 的构造函数
+func NewHandler(svc webmention.Service) *Handler {
+	return &Handler{svc: svc}
+}
+
+// receiveRequest 是 Webmention 规范规定的表单参数
+type receiveRequest struct {
+	Source string `form:"source" binding:"required,url,max=1024"`
+	Target string `form:"target" binding:"required,url,max=1024"`
+}
+
+// Receive
+// @Summary      接收 Webmention
+// @Description  W3C Webmention 接收端点。请求被接受后会在后台校验来源页是否链接到目标文章，校验通过后展示在文章下方
+// @Tags         Webmention
+// @Accept       x-www-form-urlencoded
+// @Produce      json
+// @Param        source formData string true "提及本站文章的来源页URL"
+// @Param        target formData string true "被提及的本站文章URL"
+// @Success      202 {object} response.Response "已接受，等待后台校验"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      403 {object} response.Response "本站未开启 Webmention 接收"
+// @Router       /public/webmention [post]
+func (h *Handler) Receive(c *gin.Context) {
+	var req receiveRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	if err := h.svc.Receive(c.Request.Context(), req.Source, req.Target); err != nil {
+		if errors.Is(err, constant.ErrWebmentionDisabled) {
+			response.Fail(c, http.StatusForbidden, err.Error())
+		} else {
+			response.Fail(c, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	response.SuccessWithStatus(c, http.StatusAccepted, nil, "Webmention 已接收，等待校验")
+}
+
+// ListByPath
+// @Summary      获取文章的 Webmention
+// @Description  获取指定文章路径下所有已校验通过的 Webmention（喜欢、转发、回复和提及）
+// @Tags         Webmention
+// @Produce      json
+// @Param        target_path query string true "文章路径，与评论的 target_path 一致"
+// @Success      200 {object} response.Response{data=[]model.Webmention} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /public/webmentions [get]
+func (h *Handler) ListByPath(c *gin.Context) {
+	targetPath := c.Query("target_path")
+	if targetPath == "" {
+		response.Fail(c, http.StatusBadRequest, "target_path 不能为空")
+		return
+	}
+
+	mentions, err := h.svc.ListByPath(c.Request.Context(), targetPath)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取 Webmention 失败: "+err.Error())
+		return
+	}
+	response.Success(c, mentions, "获取 Webmention 成功")
+}
+
+// List
+// @Summary      获取 Webmention 列表
+// @Description  后台分页获取所有 Webmention，可按路径、状态和类型筛选
+// @Tags         Webmention
+// @Security     BearerAuth
+// @Produce      json
+// @Param        page query int false "页码" default(1)
+// @Param        pageSize query int false "每页数量" default(20)
+// @Param        target_path query string false "文章路径"
+// @Param        status query string false "校验状态" Enums(pending, verified, rejected)
+// @Param        type query string false "互动类型" Enums(mention, like, repost, reply)
+// @Success      200 {object} response.Response{data=repository.PageResult[model.Webmention]} "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      500 {object} response.Response "服务器内部错误"
+// @Router       /webmentions [get]
+func (h *Handler) List(c *gin.Context) {
+	var query model.WebmentionListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		response.Fail(c, http.StatusBadRequest, "请求参数无效: "+err.Error())
+		return
+	}
+
+	result, err := h.svc.List(c.Request.Context(), &query)
+	if err != nil {
+		response.Fail(c, http.StatusInternalServerError, "获取 Webmention 列表失败: "+err.Error())
+		return
+	}
+	response.Success(c, result, "获取 Webmention 列表成功")
+}
+
+// Delete
+// @Summary      删除 Webmention
+// @Tags         Webmention
+// @Security     BearerAuth
+// @Produce      json
+// @Param        id path int true "Webmention ID"
+// @Success      200 {object} response.Response "成功响应"
+// @Failure      400 {object} response.Response "请求参数错误"
+// @Failure      404 {object} response.Response "Webmention 不存在"
+// @Router       /webmentions/{id} [delete]
+func (h *Handler) Delete(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Fail(c, http.StatusBadRequest, "无效的 Webmention ID")
+		return
+	}
+
+	if err := h.svc.Delete(c.Request.Context(), uint(id)); err != nil {
+		if ent.IsNotFound(err) {
+			response.Fail(c, http.StatusNotFound, "Webmention 不存在")
+		} else {
+			response.Fail(c, http.StatusInternalServerError, "删除 Webmention 失败: "+err.Error())
+		}
+		return
+	}
+	response.Success(c, nil, "删除 Webmention 成功")
+}
@@ -45,6 +45,13 @@ const (
 	EntityTypeTicketMessage  uint64 = 19 // 工单消息实体的类型标识
 	EntityTypeNotification   uint64 = 20 // 通知实体的类型标识
 	EntityTypeArticleHistory uint64 = 21 // 文章历史版本实体的类型标识
+	EntityTypePasskey        uint64 = 22 // 通行密钥实体的类型标识
+	EntityTypeUserIdentity   uint64 = 23 // 第三方登录绑定实体的类型标识
+	EntityTypeAccessToken    uint64 = 24 // 个人访问令牌实体的类型标识
+	EntityTypeUserSession    uint64 = 25 // 登录会话实体的类型标识
+	EntityTypeInvitationCode uint64 = 26 // 注册邀请码实体的类型标识
+	EntityTypeShare          uint64 = 27 // 文件分享实体的类型标识
+	EntityTypeFileVersion    uint64 = 28 // 文件历史版本实体的类型标识
 )
 
 // GenerateRandomSeed 生成一个随机的 16 字节种子（返回 32 字符的十六进制字符串）
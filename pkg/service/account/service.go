@@ -0,0 +1,530 @@
+// Package account 提供用户自助的个人数据导出和账户注销功能。
+package account
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/security"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	article_service "github.com/anzhiyu-c/anheyu-app/pkg/service/article"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	comment_service "github.com/anzhiyu-c/anheyu-app/pkg/service/comment"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// superAdminID 是超级管理员的用户ID，该账户不能注销；匿名化的文章会转到该账户名下
+const superAdminID = 1
+
+// Service 定义了个人数据导出和账户注销的业务逻辑接口
+type Service interface {
+	// Export 将用户的个人数据打包为 ZIP
+	Export(ctx context.Context, userID uint) ([]byte, error)
+
+	// RequestDeletion 提交注销申请，宽限期结束后自动执行
+	RequestDeletion(ctx context.Context, userID uint, password, contentMode string) (*model.AccountDeletion, error)
+	// CancelDeletion 在宽限期内撤销注销申请
+	CancelDeletion(ctx context.Context, userID uint) error
+	// GetDeletion 获取用户进行中的注销申请，没有时返回 nil
+	GetDeletion(ctx context.Context, userID uint) (*model.AccountDeletion, error)
+
+	// ProcessDueDeletions 执行所有已到期的注销申请，返回成功注销的账户数量
+	ProcessDueDeletions(ctx context.Context) (int, error)
+}
+
+type service struct {
+	userRepo         repository.UserRepository
+	deletionRepo     repository.AccountDeletionRepository
+	articleRepo      repository.ArticleRepository
+	fileRepo         repository.FileRepository
+	notifyConfigRepo repository.UserNotificationConfigRepository
+	identityRepo     repository.UserIdentityRepository
+	passkeyRepo      repository.PasskeyRepository
+	twoFactorRepo    repository.UserTwoFactorRepository
+	articleSvc       article_service.Service
+	commentSvc       *comment_service.Service
+	sessionSvc       auth.SessionService
+	accessTokenSvc   auth.AccessTokenService
+	settingSvc       setting.SettingService
+}
+
+// NewService 是 account 服务的构造函数
+func NewService(
+	userRepo repository.UserRepository,
+	deletionRepo repository.AccountDeletionRepository,
+	articleRepo repository.ArticleRepository,
+	fileRepo repository.FileRepository,
+	notifyConfigRepo repository.UserNotificationConfigRepository,
+	identityRepo repository.UserIdentityRepository,
+	passkeyRepo repository.PasskeyRepository,
+	twoFactorRepo repository.UserTwoFactorRepository,
+	articleSvc article_service.Service,
+	commentSvc *comment_service.Service,
+	sessionSvc auth.SessionService,
+	accessTokenSvc auth.AccessTokenService,
+	settingSvc setting.SettingService,
+) Service {
+	return &service{
+		userRepo:         userRepo,
+		deletionRepo:     deletionRepo,
+		articleRepo:      articleRepo,
+		fileRepo:         fileRepo,
+		notifyConfigRepo: notifyConfigRepo,
+		identityRepo:     identityRepo,
+		passkeyRepo:      passkeyRepo,
+		twoFactorRepo:    twoFactorRepo,
+		articleSvc:       articleSvc,
+		commentSvc:       commentSvc,
+		sessionSvc:       sessionSvc,
+		accessTokenSvc:   accessTokenSvc,
+		settingSvc:       settingSvc,
+	}
+}
+
+// --- 数据导出 ---
+
+// exportProfile 是导出包中 profile.json 的结构
+type exportProfile struct {
+	ID          string     `json:"id"`
+	Username    string     `json:"username"`
+	Nickname    string     `json:"nickname"`
+	Email       string     `json:"email"`
+	Website     string     `json:"website"`
+	Avatar      string     `json:"avatar"`
+	UserGroup   string     `json:"user_group"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+}
+
+// exportFile 是导出包中 files.json 的每一项
+type exportFile struct {
+	Path      string    `json:"path"`
+	Type      string    `json:"type"` // file 或 dir
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// exportNotificationSetting 是导出包中 notification_settings.json 的每一项
+type exportNotificationSetting struct {
+	Type              string                 `json:"type"`
+	Enabled           bool                   `json:"enabled"`
+	Channels          []string               `json:"channels"`
+	NotificationEmail string                 `json:"notification_email,omitempty"`
+	CustomSettings    map[string]interface{} `json:"custom_settings,omitempty"`
+}
+
+// Export 实现 Service 接口
+func (s *service) Export(ctx context.Context, userID uint) ([]byte, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("用户不存在")
+	}
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+
+	publicID, _ := idgen.GeneratePublicID(user.ID, idgen.EntityTypeUser)
+	profile := exportProfile{
+		ID:          publicID,
+		Username:    user.Username,
+		Nickname:    user.Nickname,
+		Email:       user.Email,
+		Website:     user.Website,
+		Avatar:      user.Avatar,
+		UserGroup:   user.UserGroup.Name,
+		CreatedAt:   user.CreatedAt,
+		LastLoginAt: user.LastLoginAt,
+	}
+	if err := writeJSON(zw, "profile.json", profile); err != nil {
+		return nil, err
+	}
+
+	articleCount, err := s.exportArticles(ctx, zw, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.commentSvc.ExportCommentsByAuthor(ctx, repository.CommentAuthorFilter{UserID: &user.ID, Email: user.Email})
+	if err != nil {
+		return nil, err
+	}
+	if err := writeJSON(zw, "comments.json", comments); err != nil {
+		return nil, err
+	}
+
+	files, err := s.fileManifest(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeJSON(zw, "files.json", files); err != nil {
+		return nil, err
+	}
+
+	configs, err := s.notifyConfigRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("获取通知设置失败: %w", err)
+	}
+	notifySettings := make([]exportNotificationSetting, 0, len(configs))
+	for _, c := range configs {
+		item := exportNotificationSetting{
+			Type:              strconv.FormatUint(uint64(c.NotificationTypeID), 10),
+			Enabled:           c.IsEnabled,
+			Channels:          c.EnabledChannels,
+			NotificationEmail: c.NotificationEmail,
+			CustomSettings:    c.CustomSettings,
+		}
+		if c.NotificationType != nil {
+			item.Type = c.NotificationType.Code
+		}
+		notifySettings = append(notifySettings, item)
+	}
+	if err := writeJSON(zw, "notification_settings.json", notifySettings); err != nil {
+		return nil, err
+	}
+
+	readme := fmt.Sprintf(`# 个人数据导出
+
+- 导出时间: %s
+- 文章数: %d
+- 评论数: %d
+- 文件数: %d
+
+## 文件说明
+
+- profile.json: 账户资料
+- articles/: 您撰写的文章，每篇一个 Markdown 文件
+- comments.json: 您发表的评论，包括以相同邮箱发表的访客评论
+- files.json: 您上传的文件清单（仅包含路径、大小和时间，不包含文件内容）
+- notification_settings.json: 通知设置
+`, time.Now().Format(time.RFC3339), articleCount, len(comments.Comments), countFiles(files))
+	if w, err := zw.Create("README.md"); err == nil {
+		w.Write([]byte(readme))
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("关闭 ZIP 文件失败: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// exportArticles 将用户的文章以带 front matter 的 Markdown 写入 articles/ 目录
+func (s *service) exportArticles(ctx context.Context, zw *zip.Writer, userID uint) (int, error) {
+	articles, _, err := s.articleRepo.List(ctx, &model.ListArticlesOptions{AuthorID: &userID, WithContent: true})
+	if err != nil {
+		return 0, fmt.Errorf("获取文章失败: %w", err)
+	}
+	for i, a := range articles {
+		var sb strings.Builder
+		sb.WriteString("---\n")
+		fmt.Fprintf(&sb, "title: %s\n", strconv.Quote(a.Title))
+		if a.Abbrlink != "" {
+			fmt.Fprintf(&sb, "abbrlink: %s\n", strconv.Quote(a.Abbrlink))
+		}
+		fmt.Fprintf(&sb, "status: %s\n", a.Status)
+		fmt.Fprintf(&sb, "date: %s\n", a.CreatedAt.Format(time.RFC3339))
+		fmt.Fprintf(&sb, "updated: %s\n", a.UpdatedAt.Format(time.RFC3339))
+		if len(a.PostTags) > 0 {
+			names := make([]string, len(a.PostTags))
+			for j, t := range a.PostTags {
+				names[j] = strconv.Quote(t.Name)
+			}
+			fmt.Fprintf(&sb, "tags: [%s]\n", strings.Join(names, ", "))
+		}
+		if len(a.PostCategories) > 0 {
+			names := make([]string, len(a.PostCategories))
+			for j, c := range a.PostCategories {
+				names[j] = strconv.Quote(c.Name)
+			}
+			fmt.Fprintf(&sb, "categories: [%s]\n", strings.Join(names, ", "))
+		}
+		if a.CoverURL != "" {
+			fmt.Fprintf(&sb, "cover: %s\n", strconv.Quote(a.CoverURL))
+		}
+		sb.WriteString("---\n\n")
+		sb.WriteString(a.ContentMd)
+
+		name := fmt.Sprintf("articles/%03d-%s.md", i+1, safeFileName(a.Title))
+		w, err := zw.Create(name)
+		if err != nil {
+			return 0, fmt.Errorf("创建 ZIP 文件失败: %w", err)
+		}
+		if _, err := w.Write([]byte(sb.String())); err != nil {
+			return 0, fmt.Errorf("写入文章失败: %w", err)
+		}
+	}
+	return len(articles), nil
+}
+
+// fileManifest 列出用户的全部文件和目录，并还原出完整路径
+func (s *service) fileManifest(ctx context.Context, userID uint) ([]exportFile, error) {
+	files, err := s.fileRepo.ListByOwnerID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("获取文件列表失败: %w", err)
+	}
+	byID := make(map[uint]*model.File, len(files))
+	for _, f := range files {
+		byID[f.ID] = f
+	}
+	paths := make(map[uint]string, len(files))
+	var pathOf func(f *model.File, depth int) string
+	pathOf = func(f *model.File, depth int) string {
+		if p, ok := paths[f.ID]; ok {
+			return p
+		}
+		p := "/" + f.Name
+		// 根目录的名称为空；父目录缺失或层级异常时停止向上查找
+		if f.ParentID.Valid && depth < 256 {
+			if parent, ok := byID[uint(f.ParentID.Int64)]; ok {
+				p = strings.TrimSuffix(pathOf(parent, depth+1), "/") + "/" + f.Name
+			}
+		}
+		paths[f.ID] = p
+		return p
+	}
+
+	manifest := make([]exportFile, 0, len(files))
+	for _, f := range files {
+		if !f.ParentID.Valid {
+			continue // 跳过用户根目录
+		}
+		fileType := "file"
+		if f.Type == model.FileTypeDir {
+			fileType = "dir"
+		}
+		manifest = append(manifest, exportFile{
+			Path:      pathOf(f, 0),
+			Type:      fileType,
+			Size:      f.Size,
+			CreatedAt: f.CreatedAt,
+			UpdatedAt: f.UpdatedAt,
+		})
+	}
+	return manifest, nil
+}
+
+// --- 账户注销 ---
+
+// RequestDeletion 实现 Service 接口
+func (s *service) RequestDeletion(ctx context.Context, userID uint, password, contentMode string) (*model.AccountDeletion, error) {
+	if userID == superAdminID {
+		return nil, fmt.Errorf("超级管理员账户不能注销")
+	}
+	if contentMode == "" {
+		contentMode = model.AccountContentAnonymize
+	}
+	if contentMode != model.AccountContentAnonymize && contentMode != model.AccountContentDelete {
+		return nil, fmt.Errorf("无效的内容处理方式")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("用户不存在")
+	}
+	if !security.CheckPasswordHash(password, user.PasswordHash) {
+		return nil, fmt.Errorf("密码错误")
+	}
+
+	existing, err := s.deletionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询注销申请失败: %w", err)
+	}
+	if existing != nil {
+		return nil, constant.ErrAccountDeletionPending
+	}
+
+	graceDays, _ := strconv.Atoi(s.settingSvc.Get(constant.KeyAccountDeleteGraceDays.String()))
+	if graceDays < 0 {
+		graceDays = 0
+	}
+	deletion := &model.AccountDeletion{
+		UserID:      userID,
+		ContentMode: contentMode,
+		ScheduledAt: time.Now().AddDate(0, 0, graceDays),
+	}
+	if err := s.deletionRepo.Create(ctx, deletion); err != nil {
+		return nil, fmt.Errorf("保存注销申请失败: %w", err)
+	}
+	log.Printf("[账户注销] 用户 %d 提交了注销申请，计划于 %s 执行", userID, deletion.ScheduledAt.Format(time.RFC3339))
+	return deletion, nil
+}
+
+// CancelDeletion 实现 Service 接口
+func (s *service) CancelDeletion(ctx context.Context, userID uint) error {
+	existing, err := s.deletionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("查询注销申请失败: %w", err)
+	}
+	if existing == nil {
+		return constant.ErrAccountDeletionNotFound
+	}
+	return s.deletionRepo.DeleteByUserID(ctx, userID)
+}
+
+// GetDeletion 实现 Service 接口
+func (s *service) GetDeletion(ctx context.Context, userID uint) (*model.AccountDeletion, error) {
+	return s.deletionRepo.FindByUserID(ctx, userID)
+}
+
+// ProcessDueDeletions 实现 Service 接口
+func (s *service) ProcessDueDeletions(ctx context.Context) (int, error) {
+	due, err := s.deletionRepo.ListDue(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("获取到期的注销申请失败: %w", err)
+	}
+	deleted := 0
+	for _, d := range due {
+		if err := s.deleteAccount(ctx, d); err != nil {
+			// 失败的申请保留，下次执行时重试
+			log.Printf("[账户注销] 注销用户 %d 失败: %v", d.UserID, err)
+			continue
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// deleteAccount 按申请中选择的方式处理用户的内容，然后清除个人信息并删除账户
+func (s *service) deleteAccount(ctx context.Context, d *model.AccountDeletion) error {
+	user, err := s.userRepo.FindByID(ctx, d.UserID)
+	if err != nil {
+		return fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil {
+		// 用户已被管理员删除，只需清理申请
+		return s.deletionRepo.DeleteByUserID(ctx, d.UserID)
+	}
+
+	if d.ContentMode == model.AccountContentDelete {
+		articles, _, err := s.articleRepo.List(ctx, &model.ListArticlesOptions{AuthorID: &user.ID})
+		if err != nil {
+			return fmt.Errorf("获取文章失败: %w", err)
+		}
+		for _, a := range articles {
+			if err := s.articleSvc.Delete(ctx, a.ID); err != nil {
+				return fmt.Errorf("删除文章 %s 失败: %w", a.ID, err)
+			}
+		}
+		if _, err := s.commentSvc.EraseCommentsByAuthor(ctx, repository.CommentAuthorFilter{UserID: &user.ID, Email: user.Email}); err != nil {
+			return err
+		}
+	} else {
+		if _, err := s.articleRepo.TransferOwnership(ctx, user.ID, superAdminID); err != nil {
+			return fmt.Errorf("转移文章失败: %w", err)
+		}
+		if _, err := s.commentSvc.AnonymizeUserComments(ctx, user.ID); err != nil {
+			return err
+		}
+	}
+
+	// 移除第三方登录绑定、通行密钥和两步验证，避免这些凭据继续指向已注销的账户
+	identities, err := s.identityRepo.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("获取第三方登录绑定失败: %w", err)
+	}
+	for _, identity := range identities {
+		if err := s.identityRepo.Delete(ctx, identity.ID); err != nil {
+			return fmt.Errorf("删除第三方登录绑定失败: %w", err)
+		}
+	}
+	passkeys, err := s.passkeyRepo.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("获取通行密钥失败: %w", err)
+	}
+	for _, p := range passkeys {
+		if err := s.passkeyRepo.Delete(ctx, p.ID); err != nil {
+			return fmt.Errorf("删除通行密钥失败: %w", err)
+		}
+	}
+	if err := s.twoFactorRepo.DeleteByUserID(ctx, user.ID); err != nil {
+		return fmt.Errorf("删除两步验证配置失败: %w", err)
+	}
+	if _, err := s.accessTokenSvc.RevokeAll(ctx, user.ID); err != nil {
+		return fmt.Errorf("撤销访问令牌失败: %w", err)
+	}
+	if err := s.sessionSvc.RevokeAll(ctx, user.ID); err != nil {
+		return fmt.Errorf("撤销登录会话失败: %w", err)
+	}
+
+	// 用户表使用软删除，先覆盖个人信息，同时释放邮箱以便重新注册
+	user.Username = fmt.Sprintf("deleted_%d", user.ID)
+	user.Email = fmt.Sprintf("deleted_%d@deleted.invalid", user.ID)
+	user.Nickname = comment_service.AnonymizedNickname
+	user.Avatar = ""
+	user.PasswordHash = ""
+	user.LastLoginAt = nil
+	user.Status = model.UserStatusBanned
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("清除用户信息失败: %w", err)
+	}
+	if err := s.userRepo.Delete(ctx, user.ID); err != nil {
+		return fmt.Errorf("删除用户失败: %w", err)
+	}
+	if err := s.deletionRepo.DeleteByUserID(ctx, user.ID); err != nil {
+		return fmt.Errorf("清理注销申请失败: %w", err)
+	}
+	log.Printf("[账户注销] 用户 %d 已注销，内容处理方式: %s", user.ID, d.ContentMode)
+	return nil
+}
+
+// writeJSON 将数据以格式化的 JSON 写入 ZIP
+func writeJSON(zw *zip.Writer, name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化 %s 失败: %w", name, err)
+	}
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("创建 ZIP 文件失败: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("写入 %s 失败: %w", name, err)
+	}
+	return nil
+}
+
+// countFiles 统计清单中的文件数量（不含目录）
+func countFiles(files []exportFile) int {
+	n := 0
+	for _, f := range files {
+		if f.Type == "file" {
+			n++
+		}
+	}
+	return n
+}
+
+// safeFileName 将文章标题转换为可用作文件名的字符串
+func safeFileName(title string) string {
+	name := strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', '\n', '\r', '\t':
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(title))
+	if utf8.RuneCountInString(name) > 60 {
+		name = string([]rune(name)[:60])
+	}
+	if name == "" {
+		name = "untitled"
+	}
+	return name
+}
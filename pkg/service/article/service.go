@@ -29,6 +29,7 @@ import (
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/subscriber"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/webmention"
 )
 
 // BatchDeleteResult 批量删除结果
@@ -70,6 +71,9 @@ type Service interface {
 	// SetHistoryRepo 设置文章历史版本仓储（可选注入，用于文章发布时自动记录历史版本）
 	SetHistoryRepo(historyRepo repository.ArticleHistoryRepository)
 
+	// SetWebmentionService 设置 Webmention 服务（可选注入，用于文章发布时向文中外链发送 Webmention）
+	SetWebmentionService(webmentionSvc webmention.Service)
+
 	// GetArticleStatistics 获取文章统计数据（用于前台展示）
 	GetArticleStatistics(ctx context.Context) (*model.ArticleStatistics, error)
 }
@@ -95,8 +99,9 @@ type serviceImpl struct {
 	cdnSvc           cdn.CDNService
 	subscriberSvc    *subscriber.Service
 
-	userRepo    repository.UserRepository
-	historyRepo repository.ArticleHistoryRepository // 文章历史版本仓储
+	userRepo      repository.UserRepository
+	historyRepo   repository.ArticleHistoryRepository // 文章历史版本仓储
+	webmentionSvc webmention.Service                  // Webmention 服务
 }
 
 func NewService(
@@ -149,6 +154,19 @@ func (s *serviceImpl) SetHistoryRepo(historyRepo repository.ArticleHistoryReposi
 	s.historyRepo = historyRepo
 }
 
+// SetWebmentionService 设置 Webmention 服务（可选注入）
+func (s *serviceImpl) SetWebmentionService(webmentionSvc webmention.Service) {
+	s.webmentionSvc = webmentionSvc
+}
+
+// notifyWebmention 文章发布后向文中外链发送 Webmention（内部方法）
+func (s *serviceImpl) notifyWebmention(article *model.Article) {
+	if s.webmentionSvc == nil {
+		return // Webmention 功能未启用
+	}
+	s.webmentionSvc.NotifyArticlePublished(article)
+}
+
 // createArticleHistory 创建文章历史版本（内部方法）
 func (s *serviceImpl) createArticleHistory(ctx context.Context, article *model.Article, editorID uint, changeNote string) {
 	if s.historyRepo == nil {
@@ -1184,6 +1202,7 @@ func (s *serviceImpl) Create(ctx context.Context, req *model.CreateArticleReques
 		if err := s.subscriberSvc.NotifyArticlePublished(ctx, newArticle); err != nil {
 			log.Printf("[Create] 触发订阅通知失败: %v", err)
 		}
+		s.notifyWebmention(newArticle)
 
 		// 创建历史版本记录（仅在发布时记录）
 		s.createArticleHistory(ctx, newArticle, req.OwnerID, "初次发布")
@@ -1530,6 +1549,7 @@ func (s *serviceImpl) Update(ctx context.Context, publicID string, req *model.Up
 		if err := s.subscriberSvc.NotifyArticlePublished(ctx, updatedArticle); err != nil {
 			log.Printf("[Update] 触发订阅通知失败: %v", err)
 		}
+		s.notifyWebmention(updatedArticle)
 	}
 
 	// 创建历史版本记录（仅在发布状态时记录）
@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"context"
+	"sync"
+)
+
+// Actor 描述发起操作的人，由认证中间件写入请求上下文
+type Actor struct {
+	UserID    uint
+	IP        string
+	UserAgent string
+}
+
+type actorKey struct{}
+
+type detailKey struct{}
+
+// detail 保存业务代码在处理过程中补充的审计信息。
+// 审计中间件在调用处理函数前放入上下文，处理完成后读取并写入日志。
+type detail struct {
+	mu        sync.Mutex
+	targetID  string
+	summary   string
+	before    interface{}
+	after     interface{}
+	hasChange bool
+	skipped   bool
+}
+
+// WithActor 返回携带操作者信息的上下文
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext 从上下文中取出操作者信息
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok
+}
+
+// Begin 返回一个可以通过 SetChange、SetTarget、Skip 补充审计信息的上下文
+func Begin(ctx context.Context) context.Context {
+	return context.WithValue(ctx, detailKey{}, &detail{})
+}
+
+func detailFromContext(ctx context.Context) *detail {
+	d, _ := ctx.Value(detailKey{}).(*detail)
+	return d
+}
+
+// SetChange 记录本次操作前后的数据，写入日志时只保留发生变化的字段。
+// before 或 after 可以为 nil，分别表示新建和删除。上下文未经 Begin 时为空操作。
+func SetChange(ctx context.Context, before, after interface{}) {
+	if d := detailFromContext(ctx); d != nil {
+		d.mu.Lock()
+		d.before, d.after, d.hasChange = before, after, true
+		d.mu.Unlock()
+	}
+}
+
+// SetTarget 覆盖操作对象的ID和摘要，适用于对象ID不在路由参数中的接口。参数为空字符串时保留原值。
+func SetTarget(ctx context.Context, targetID, summary string) {
+	if d := detailFromContext(ctx); d != nil {
+		d.mu.Lock()
+		if targetID != "" {
+			d.targetID = targetID
+		}
+		if summary != "" {
+			d.summary = summary
+		}
+		d.mu.Unlock()
+	}
+}
+
+// Skip 标记本次操作无需记录，例如请求成功但没有产生任何变更
+func Skip(ctx context.Context) {
+	if d := detailFromContext(ctx); d != nil {
+		d.mu.Lock()
+		d.skipped = true
+		d.mu.Unlock()
+	}
+}
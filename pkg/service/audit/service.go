@@ -0,0 +1,307 @@
+package audit
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// maskedValue 是敏感字段在日志中的替代值
+	maskedValue = "******"
+	// maxExportRows 限制单次导出的最大行数，避免一次性加载过多数据
+	maxExportRows = 50000
+	// maxSummaryLength 与数据库字段长度保持一致
+	maxSummaryLength = 512
+)
+
+// sensitiveKeywords 中的任一片段出现在字段名中 (不区分大小写) 时，该字段的值会被脱敏。
+// oauth_providers 是包含 client_secret 的 JSON 配置，整体脱敏。
+var sensitiveKeywords = []string{"secret", "password", "pass", "token", "api_key", "apikey", "access_key", "accesskey", "private_key", "credential", "oauth_providers"}
+
+// ignoredKeys 中的字段每次更新都会变化，对比时忽略
+var ignoredKeys = map[string]bool{"updated_at": true, "UpdatedAt": true}
+
+// Entry 描述一条待记录的操作
+type Entry struct {
+	Action     string
+	TargetType string
+	TargetID   string
+	Summary    string
+	Before     interface{}
+	After      interface{}
+}
+
+// Service 定义了审计日志服务的接口
+type Service interface {
+	// Record 记录一条操作日志，操作者从上下文中获取。
+	// 上下文经过 Begin 时，会合并业务代码通过 SetChange/SetTarget 补充的信息。
+	// 记录失败只打印日志，不影响业务流程。
+	Record(ctx context.Context, entry Entry)
+
+	// List 按条件分页查询审计日志
+	List(ctx context.Context, opts *model.ListAuditLogsOptions) ([]*model.AuditLog, int, error)
+
+	// ExportCSV 将符合条件的审计日志以 CSV 格式写入 w，最多导出 50000 条
+	ExportCSV(ctx context.Context, opts *model.ListAuditLogsOptions, w io.Writer) error
+
+	// Cleanup 按保留天数设置删除过期的审计日志（定时任务调用），返回删除的条数
+	Cleanup(ctx context.Context) (int, error)
+}
+
+type serviceImpl struct {
+	repo       repository.AuditLogRepository
+	userRepo   repository.UserRepository
+	settingSvc setting.SettingService
+}
+
+// NewService 创建审计日志服务
+func NewService(repo repository.AuditLogRepository, userRepo repository.UserRepository, settingSvc setting.SettingService) Service {
+	return &serviceImpl{
+		repo:       repo,
+		userRepo:   userRepo,
+		settingSvc: settingSvc,
+	}
+}
+
+func (s *serviceImpl) Record(ctx context.Context, entry Entry) {
+	if d := detailFromContext(ctx); d != nil {
+		d.mu.Lock()
+		if d.skipped {
+			d.mu.Unlock()
+			return
+		}
+		if d.targetID != "" {
+			entry.TargetID = d.targetID
+		}
+		if d.summary != "" {
+			entry.Summary = d.summary
+		}
+		if d.hasChange {
+			entry.Before, entry.After = d.before, d.after
+		}
+		d.mu.Unlock()
+	}
+
+	record := &model.AuditLog{
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   truncate(entry.TargetID, 255),
+		Summary:    truncate(entry.Summary, maxSummaryLength),
+		Changes:    Diff(entry.Before, entry.After),
+	}
+	if actor, ok := ActorFromContext(ctx); ok {
+		record.ActorID = actor.UserID
+		record.IP = actor.IP
+		record.UserAgent = truncate(actor.UserAgent, 512)
+		if actor.UserID > 0 {
+			if user, err := s.userRepo.FindByID(ctx, actor.UserID); err == nil && user != nil {
+				record.ActorName = user.Nickname
+				if record.ActorName == "" {
+					record.ActorName = user.Username
+				}
+			}
+		}
+	}
+
+	if err := s.repo.Create(context.WithoutCancel(ctx), record); err != nil {
+		log.Printf("[Audit] 记录操作 %s (%s:%s) 失败: %v", record.Action, record.TargetType, record.TargetID, err)
+	}
+}
+
+func (s *serviceImpl) List(ctx context.Context, opts *model.ListAuditLogsOptions) ([]*model.AuditLog, int, error) {
+	return s.repo.List(ctx, opts)
+}
+
+func (s *serviceImpl) ExportCSV(ctx context.Context, opts *model.ListAuditLogsOptions, w io.Writer) error {
+	query := model.ListAuditLogsOptions{}
+	if opts != nil {
+		query = *opts
+	}
+	query.Page, query.PageSize = 1, maxExportRows
+
+	items, _, err := s.repo.List(ctx, &query)
+	if err != nil {
+		return fmt.Errorf("查询审计日志失败: %w", err)
+	}
+
+	// 写入 UTF-8 BOM，避免 Excel 打开时中文乱码
+	if _, err := io.WriteString(w, "\xEF\xBB\xBF"); err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"时间", "操作者ID", "操作者", "IP", "User-Agent", "操作", "对象类型", "对象ID", "摘要", "变更"}); err != nil {
+		return err
+	}
+	for _, item := range items {
+		actorID := ""
+		if item.ActorID > 0 {
+			actorID, _ = idgen.GeneratePublicID(item.ActorID, idgen.EntityTypeUser)
+		}
+		changes := ""
+		if len(item.Changes) > 0 {
+			if raw, err := json.Marshal(item.Changes); err == nil {
+				changes = string(raw)
+			}
+		}
+		if err := writer.Write([]string{
+			item.CreatedAt.Format(time.RFC3339),
+			actorID,
+			item.ActorName,
+			item.IP,
+			item.UserAgent,
+			item.Action,
+			item.TargetType,
+			item.TargetID,
+			item.Summary,
+			changes,
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (s *serviceImpl) Cleanup(ctx context.Context) (int, error) {
+	days, err := strconv.Atoi(s.settingSvc.Get(constant.KeyAuditLogRetentionDays.String()))
+	if err != nil || days <= 0 {
+		return 0, nil
+	}
+	return s.repo.DeleteBefore(ctx, time.Now().AddDate(0, 0, -days))
+}
+
+// Diff 对比操作前后的数据，返回发生变化的字段，敏感字段 (包括嵌套字段) 的值会被脱敏。
+// 结构体和 map 按 JSON 字段逐个对比；其它类型作为整体记录在 "value" 字段下。
+func Diff(before, after interface{}) map[string]model.AuditChange {
+	if before == nil && after == nil {
+		return nil
+	}
+	beforeMap, beforeOK := toMap(before)
+	afterMap, afterOK := toMap(after)
+	if (before != nil && !beforeOK) || (after != nil && !afterOK) {
+		beforeValue, afterValue := normalize(before), normalize(after)
+		if reflect.DeepEqual(beforeValue, afterValue) {
+			return nil
+		}
+		return map[string]model.AuditChange{"value": {Before: maskNested(beforeValue), After: maskNested(afterValue)}}
+	}
+
+	changes := make(map[string]model.AuditChange)
+	for key, afterValue := range afterMap {
+		if ignoredKeys[key] {
+			continue
+		}
+		beforeValue, existed := beforeMap[key]
+		if existed && reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+		changes[key] = maskChange(key, beforeValue, afterValue)
+	}
+	for key, beforeValue := range beforeMap {
+		if _, exists := afterMap[key]; !exists && !ignoredKeys[key] {
+			changes[key] = maskChange(key, beforeValue, nil)
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return changes
+}
+
+func maskChange(key string, before, after interface{}) model.AuditChange {
+	if !isSensitiveKey(key) {
+		return model.AuditChange{Before: maskNested(before), After: maskNested(after)}
+	}
+	change := model.AuditChange{}
+	if before != nil && before != "" {
+		change.Before = maskedValue
+	}
+	if after != nil && after != "" {
+		change.After = maskedValue
+	}
+	return change
+}
+
+// maskNested 递归脱敏 map 和数组中的敏感字段，例如存储策略 settings 中的 refresh_token
+func maskNested(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		masked := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			if isSensitiveKey(key) && item != nil && item != "" {
+				masked[key] = maskedValue
+			} else {
+				masked[key] = maskNested(item)
+			}
+		}
+		return masked
+	case []interface{}:
+		masked := make([]interface{}, len(value))
+		for i, item := range value {
+			masked[i] = maskNested(item)
+		}
+		return masked
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, keyword := range sensitiveKeywords {
+		if strings.Contains(lower, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize 借助一次 JSON 编解码把任意值转换为 map/slice/基础类型，便于比较和存储
+func normalize(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	var result interface{}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return string(raw)
+	}
+	return result
+}
+
+func toMap(v interface{}) (map[string]interface{}, bool) {
+	if v == nil {
+		return nil, true
+	}
+	m, ok := normalize(v).(map[string]interface{})
+	return m, ok
+}
+
+// truncate 按字节截断字符串以符合数据库字段长度，且不会截断半个 UTF-8 字符
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	for max > 0 && !utf8.RuneStart(s[max]) {
+		max--
+	}
+	return s[:max]
+}
@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDiffKeepsOnlyChangedFieldsAndMasksSecrets(t *testing.T) {
+	type policy struct {
+		Name      string                 `json:"name"`
+		SecretKey string                 `json:"secret_key"`
+		MaxSize   int64                  `json:"max_size"`
+		Settings  map[string]interface{} `json:"settings"`
+	}
+	before := policy{Name: "oss", SecretKey: "old", MaxSize: 1, Settings: map[string]interface{}{"refresh_token": "a", "chunk": 1}}
+	after := policy{Name: "oss", SecretKey: "new", MaxSize: 2, Settings: map[string]interface{}{"refresh_token": "b", "chunk": 2}}
+
+	changes := Diff(before, after)
+	if _, ok := changes["name"]; ok {
+		t.Fatalf("未变化的字段不应出现在变更中: %v", changes)
+	}
+	if got := changes["max_size"]; got.Before != float64(1) || got.After != float64(2) {
+		t.Fatalf("max_size 变更错误: %+v", got)
+	}
+	if got := changes["secret_key"]; got.Before != maskedValue || got.After != maskedValue {
+		t.Fatalf("secret_key 应被脱敏: %+v", got)
+	}
+	settings, _ := changes["settings"].After.(map[string]interface{})
+	if settings["refresh_token"] != maskedValue || settings["chunk"] != float64(2) {
+		t.Fatalf("嵌套的敏感字段应被脱敏: %v", settings)
+	}
+}
+
+func TestDiffScalarAndCreate(t *testing.T) {
+	if changes := Diff("theme-a", "theme-a"); changes != nil {
+		t.Fatalf("相同的值不应产生变更: %v", changes)
+	}
+	if got := Diff("theme-a", "theme-b")["value"]; got.Before != "theme-a" || got.After != "theme-b" {
+		t.Fatalf("标量变更错误: %+v", got)
+	}
+	created := Diff(nil, map[string]string{"SMTP_PASSWORD": "x", "SITE_NAME": "blog"})
+	if created["SMTP_PASSWORD"].After != maskedValue || created["SITE_NAME"].After != "blog" {
+		t.Fatalf("新建时的变更错误: %v", created)
+	}
+}
+
+func TestContextHelpersWithoutBeginAreNoop(t *testing.T) {
+	ctx := context.Background()
+	SetChange(ctx, 1, 2)
+	SetTarget(ctx, "id", "summary")
+	Skip(ctx)
+
+	ctx = Begin(ctx)
+	SetTarget(ctx, "id", "")
+	SetTarget(ctx, "", "summary")
+	d := detailFromContext(ctx)
+	if d.targetID != "id" || d.summary != "summary" {
+		t.Fatalf("SetTarget 不应覆盖为空字符串: %+v", d)
+	}
+}
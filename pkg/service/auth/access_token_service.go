@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// AccessTokenPrefix 是个人访问令牌明文的固定前缀，中间件据此区分访问令牌和 JWT
+const AccessTokenPrefix = "ahy_pat_"
+
+const (
+	// maxAccessTokensPerUser 每个用户最多可以持有的令牌数量
+	maxAccessTokensPerUser = 50
+	// accessTokenUsageInterval 记录最近使用信息的最小间隔，避免每个请求都写数据库
+	accessTokenUsageInterval = time.Minute
+	// accessTokenDisplayLen 列表中展示的令牌明文长度
+	accessTokenDisplayLen = 12
+)
+
+// AccessTokenService 定义了个人访问令牌的业务逻辑接口
+type AccessTokenService interface {
+	// Create 创建令牌，返回的明文令牌只在此时可见。expiresAt 为 nil 表示永不过期
+	Create(ctx context.Context, userID uint, name string, scopes []string, expiresAt *time.Time) (*model.AccessToken, string, error)
+	List(ctx context.Context, userID uint) ([]*model.AccessToken, error)
+	Revoke(ctx context.Context, userID, tokenID uint) error
+	// RevokeAll 撤销用户的全部令牌，供管理员在账户泄露等情况下使用
+	RevokeAll(ctx context.Context, userID uint) (int, error)
+
+	// Authenticate 校验明文令牌，返回与会话令牌结构相同的 Claims，其中 Scopes 为令牌的授权范围
+	Authenticate(ctx context.Context, token, ip string) (*auth.CustomClaims, error)
+}
+
+type accessTokenService struct {
+	repo     repository.AccessTokenRepository
+	userRepo repository.UserRepository
+}
+
+// NewAccessTokenService 是 accessTokenService 的构造函数
+func NewAccessTokenService(repo repository.AccessTokenRepository, userRepo repository.UserRepository) AccessTokenService {
+	return &accessTokenService{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+// IsAccessToken 判断 Bearer 凭据是否为个人访问令牌
+func IsAccessToken(token string) bool {
+	return strings.HasPrefix(token, AccessTokenPrefix)
+}
+
+// Create 实现 AccessTokenService 接口
+func (s *accessTokenService) Create(ctx context.Context, userID uint, name string, scopes []string, expiresAt *time.Time) (*model.AccessToken, string, error) {
+	if len(scopes) == 0 {
+		return nil, "", constant.ErrAccessTokenScopeInvalid
+	}
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !model.IsValidAccessTokenScope(scope) {
+			return nil, "", constant.ErrAccessTokenScopeInvalid
+		}
+		if !slices.Contains(normalized, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", fmt.Errorf("过期时间必须晚于当前时间")
+	}
+
+	count, err := s.repo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("统计访问令牌数量失败: %w", err)
+	}
+	if count >= maxAccessTokensPerUser {
+		return nil, "", constant.ErrAccessTokenLimitReached
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("生成访问令牌失败: %w", err)
+	}
+	plaintext := AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	token := &model.AccessToken{
+		UserID:      userID,
+		Name:        strings.TrimSpace(name),
+		TokenHash:   hashAccessToken(plaintext),
+		TokenPrefix: plaintext[:accessTokenDisplayLen],
+		Scopes:      normalized,
+		ExpiresAt:   expiresAt,
+	}
+	if err := s.repo.Create(ctx, token); err != nil {
+		return nil, "", fmt.Errorf("保存访问令牌失败: %w", err)
+	}
+	return token, plaintext, nil
+}
+
+// List 实现 AccessTokenService 接口
+func (s *accessTokenService) List(ctx context.Context, userID uint) ([]*model.AccessToken, error) {
+	return s.repo.ListByUserID(ctx, userID)
+}
+
+// Revoke 实现 AccessTokenService 接口
+func (s *accessTokenService) Revoke(ctx context.Context, userID, tokenID uint) error {
+	token, err := s.repo.FindByID(ctx, tokenID)
+	if err != nil {
+		return fmt.Errorf("查询访问令牌失败: %w", err)
+	}
+	if token == nil || token.UserID != userID {
+		return constant.ErrAccessTokenNotFound
+	}
+	return s.repo.Delete(ctx, tokenID)
+}
+
+// RevokeAll 实现 AccessTokenService 接口
+func (s *accessTokenService) RevokeAll(ctx context.Context, userID uint) (int, error) {
+	return s.repo.DeleteByUserID(ctx, userID)
+}
+
+// Authenticate 实现 AccessTokenService 接口
+func (s *accessTokenService) Authenticate(ctx context.Context, plaintext, ip string) (*auth.CustomClaims, error) {
+	if !IsAccessToken(plaintext) {
+		return nil, constant.ErrAccessTokenInvalid
+	}
+	token, err := s.repo.FindByHash(ctx, hashAccessToken(plaintext))
+	if err != nil {
+		return nil, fmt.Errorf("查询访问令牌失败: %w", err)
+	}
+	if token == nil || token.IsExpired() {
+		return nil, constant.ErrAccessTokenInvalid
+	}
+
+	user, err := s.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	// 用户被封禁或未激活时，其令牌一并失效
+	if user == nil || user.Status != model.UserStatusActive {
+		return nil, constant.ErrAccessTokenInvalid
+	}
+
+	if token.LastUsedAt == nil || time.Since(*token.LastUsedAt) > accessTokenUsageInterval || token.LastUsedIP != ip {
+		if err := s.repo.UpdateUsage(ctx, token.ID, ip); err != nil {
+			log.Printf("[AccessToken] 警告：更新访问令牌 %d 的使用记录失败: %v", token.ID, err)
+		}
+	}
+
+	publicUserID, err := idgen.GeneratePublicID(user.ID, idgen.EntityTypeUser)
+	if err != nil {
+		return nil, fmt.Errorf("生成用户公共ID失败: %w", err)
+	}
+	publicGroupID, err := idgen.GeneratePublicID(user.UserGroup.ID, idgen.EntityTypeUserGroup)
+	if err != nil {
+		return nil, fmt.Errorf("生成用户组公共ID失败: %w", err)
+	}
+	claims := &auth.CustomClaims{
+		UserID:      publicUserID,
+		UserGroupID: publicGroupID,
+		Permissions: []byte(user.UserGroup.Permissions),
+		Scopes:      token.Scopes,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: fmt.Sprintf("access_token:%d", token.ID),
+		},
+	}
+	if token.ExpiresAt != nil {
+		claims.ExpiresAt = jwt.NewNumericDate(*token.ExpiresAt)
+	}
+	return claims, nil
+}
+
+// hashAccessToken 计算令牌明文的哈希。令牌本身是 256 位随机数，无需加盐或慢哈希
+func hashAccessToken(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
@@ -32,7 +32,8 @@ import (
 // AuthService 定义了所有认证授权相关的业务逻辑接口
 type AuthService interface {
 	Login(ctx context.Context, email, password string) (*model.User, error)
-	Register(ctx context.Context, email, nickname, password string) (activationRequired bool, err error)
+	// Register 注册新用户。inviteCode 为空表示普通注册；未开放注册时只能凭邀请码注册
+	Register(ctx context.Context, email, nickname, password, inviteCode string) (activationRequired bool, err error)
 	// ActivateUser 现在接收内部数据库 ID (uint)
 	ActivateUser(ctx context.Context, userID uint, sign string) error
 	RequestPasswordReset(ctx context.Context, email string) error
@@ -41,6 +42,8 @@ type AuthService interface {
 	CheckEmailExists(ctx context.Context, email string) (bool, error)
 	// GetUserByID 通过用户ID获取用户信息
 	GetUserByID(ctx context.Context, userID uint) (*model.User, error)
+	// RegisterExternalUser 为通过第三方登录、且邮箱已被提供方验证的新用户创建账户，无需激活
+	RegisterExternalUser(ctx context.Context, email, nickname, avatarURL string) (*model.User, error)
 }
 
 // authService 是 AuthService 接口的实现
@@ -51,6 +54,8 @@ type authService struct {
 	emailSvc   utility.EmailService
 	txManager  repository.TransactionManager
 	articleSvc articleSvc.Service
+	sessionSvc SessionService
+	inviteSvc  InvitationService
 }
 
 // NewAuthService 是 authService 的构造函数
@@ -61,6 +66,8 @@ func NewAuthService(
 	emailSvc utility.EmailService,
 	txManager repository.TransactionManager,
 	articleSvc articleSvc.Service,
+	sessionSvc SessionService,
+	inviteSvc InvitationService,
 ) AuthService {
 	return &authService{
 		userRepo:   userRepo,
@@ -69,6 +76,8 @@ func NewAuthService(
 		emailSvc:   emailSvc,
 		txManager:  txManager,
 		articleSvc: articleSvc,
+		sessionSvc: sessionSvc,
+		inviteSvc:  inviteSvc,
 	}
 }
 
@@ -163,7 +172,8 @@ func (s *authService) Login(ctx context.Context, email, password string) (*model
 
 // Register 实现了最终的用户注册逻辑
 // 它会为新用户创建根目录，并在首次注册时初始化系统内置的存储策略及其关联的虚拟目录。
-func (s *authService) Register(ctx context.Context, email, nickname, password string) (bool, error) {
+// 凭邀请码注册的用户加入邀请码指定的用户组，并可按配置跳过邮箱激活。
+func (s *authService) Register(ctx context.Context, email, nickname, password, inviteCode string) (bool, error) {
 	// email转为小写
 	email = strings.ToLower(strings.TrimSpace(email))
 	// nickname去除首尾空格
@@ -179,11 +189,30 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 		return false, fmt.Errorf("获取用户总数失败: %w", err)
 	}
 	isFirstUser := userCount == 0
+	inviteCode = strings.TrimSpace(inviteCode)
+	// 第一个用户（管理员）始终可以注册，且不使用邀请码
+	if isFirstUser {
+		inviteCode = ""
+	} else if inviteCode == "" && s.settingSvc.Get(constant.KeyEnableRegistration.String()) != "true" {
+		return false, constant.ErrRegistrationClosed
+	}
 	assignedUserGroupID := uint(2)
 	if isFirstUser {
 		assignedUserGroupID = 1
 	}
 	activationEnabled := s.settingSvc.Get(constant.KeyEnableUserActivation.String()) == "true"
+
+	var invitation *model.InvitationCode
+	if inviteCode != "" {
+		invitation, err = s.inviteSvc.Consume(ctx, inviteCode, email)
+		if err != nil {
+			return false, err
+		}
+		assignedUserGroupID = invitation.UserGroupID
+		if s.settingSvc.Get(constant.KeyInviteSkipActivation.String()) == "true" {
+			activationEnabled = false
+		}
+	}
 	hashedPassword, _ := security.HashPassword(password)
 	// 如果昵称为空，则使用邮箱前缀作为默认昵称
 	if nickname == "" {
@@ -210,7 +239,6 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 		userRepo := repos.User
 		fileRepo := repos.File
 		policyRepo := repos.StoragePolicy
-		userGroupRepo := repos.UserGroup
 
 		// 3a: 创建用户记录
 		if err := userRepo.Create(ctx, newUser); err != nil {
@@ -333,40 +361,20 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 			log.Printf("内置存储策略 '%s' 创建成功。", avatarPolicy.Name)
 		}
 
-		// 3d: 获取用户组的配置
-		userGroup, err := userGroupRepo.FindByID(ctx, newUser.UserGroupID)
-		if err != nil {
-			return fmt.Errorf("查找用户组配置失败 (ID: %d): %w", newUser.UserGroupID, err)
-		}
-
-		// 3e: 将除第一个策略外的其他策略，创建为根目录下的子目录
-		if len(userGroup.Settings.PolicyOrdering) > 1 {
-			remainingPolicyIDs := userGroup.Settings.PolicyOrdering[1:]
-			for _, policyID := range remainingPolicyIDs {
-				policy, err := policyRepo.FindByID(ctx, policyID)
-				if err != nil {
-					log.Printf("警告: 注册用户'%s'时，找不到ID为%d的策略，已跳过。", newUser.Email, policyID)
-					continue
-				}
-
-				mountPointDir := &model.File{
-					OwnerID:  newUser.ID,
-					ParentID: sql.NullInt64{Int64: int64(userRootDir.ID), Valid: true},
-					Name:     policy.Name, // 使用策略名作为目录名
-					Type:     model.FileTypeDir,
-				}
-				if err := fileRepo.Create(ctx, mountPointDir); err != nil {
-					return fmt.Errorf("为策略'%s'创建挂载点目录失败: %w", policy.Name, err)
-				}
-			}
-		}
-
-		return nil // 事务成功
+		// 3d/3e: 根据用户组配置创建存储策略挂载点目录
+		return createPolicyMountPoints(ctx, repos, newUser, userRootDir)
 	})
 
 	if err != nil {
+		// 注册失败时归还邀请码的使用名额
+		if invitation != nil {
+			s.inviteSvc.Release(context.Background(), invitation.ID)
+		}
 		return false, err
 	}
+	if invitation != nil {
+		log.Printf("用户 '%s' 使用邀请码 %s 注册成功", newUser.Email, invitation.Code)
+	}
 
 	// 异步为第一个用户（管理员）创建一篇默认文章
 	if isFirstUser {
@@ -390,6 +398,107 @@ func (s *authService) Register(ctx context.Context, email, nickname, password st
 	return activationEnabled, nil
 }
 
+// createPolicyMountPoints 根据用户组的存储策略顺序，将除第一个策略外的其他策略创建为根目录下的挂载点目录
+func createPolicyMountPoints(ctx context.Context, repos repository.Repositories, newUser *model.User, userRootDir *model.File) error {
+	userGroup, err := repos.UserGroup.FindByID(ctx, newUser.UserGroupID)
+	if err != nil {
+		return fmt.Errorf("查找用户组配置失败 (ID: %d): %w", newUser.UserGroupID, err)
+	}
+	if userGroup == nil || len(userGroup.Settings.PolicyOrdering) <= 1 {
+		return nil
+	}
+
+	for _, policyID := range userGroup.Settings.PolicyOrdering[1:] {
+		policy, err := repos.StoragePolicy.FindByID(ctx, policyID)
+		if err != nil {
+			log.Printf("警告: 注册用户'%s'时，找不到ID为%d的策略，已跳过。", newUser.Email, policyID)
+			continue
+		}
+
+		mountPointDir := &model.File{
+			OwnerID:  newUser.ID,
+			ParentID: sql.NullInt64{Int64: int64(userRootDir.ID), Valid: true},
+			Name:     policy.Name, // 使用策略名作为目录名
+			Type:     model.FileTypeDir,
+		}
+		if err := repos.File.Create(ctx, mountPointDir); err != nil {
+			return fmt.Errorf("为策略'%s'创建挂载点目录失败: %w", policy.Name, err)
+		}
+	}
+	return nil
+}
+
+// RegisterExternalUser 实现了第三方登录用户的注册逻辑。
+// 站点尚无用户时拒绝注册，避免第一个（管理员）账户被第三方登录抢先创建。
+func (s *authService) RegisterExternalUser(ctx context.Context, email, nickname, avatarURL string) (*model.User, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	nickname = strings.TrimSpace(nickname)
+
+	if s.settingSvc.Get(constant.KeyEnableRegistration.String()) != "true" {
+		return nil, fmt.Errorf("本站未开放注册")
+	}
+	if existing, err := s.userRepo.FindByEmail(ctx, email); err != nil {
+		return nil, fmt.Errorf("查询邮箱时数据库出错: %w", err)
+	} else if existing != nil {
+		return nil, fmt.Errorf("该邮箱已被注册")
+	}
+	userCount, err := s.userRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取用户总数失败: %w", err)
+	}
+	if userCount == 0 {
+		return nil, fmt.Errorf("请先使用邮箱注册管理员账户")
+	}
+
+	// 第三方登录用户没有可用的密码，需要时可以通过“忘记密码”设置
+	randomPassword, err := security.GenerateTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("生成随机密码失败: %w", err)
+	}
+	hashedPassword, err := security.HashPassword(randomPassword)
+	if err != nil {
+		return nil, fmt.Errorf("生成随机密码失败: %w", err)
+	}
+	if nickname == "" {
+		nickname = strings.Split(email, "@")[0]
+	}
+	if avatarURL == "" {
+		hasher := md5.New()
+		hasher.Write([]byte(email))
+		avatarURL = "avatar/" + hex.EncodeToString(hasher.Sum(nil)) + "?d=identicon"
+	}
+	newUser := &model.User{
+		Username:     email,
+		PasswordHash: hashedPassword,
+		Nickname:     nickname,
+		Avatar:       avatarURL,
+		Email:        email,
+		UserGroupID:  2,
+		Status:       model.UserStatusActive,
+	}
+
+	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		if err := repos.User.Create(ctx, newUser); err != nil {
+			return fmt.Errorf("创建用户失败: %w", err)
+		}
+		userRootDir := &model.File{
+			OwnerID: newUser.ID,
+			Name:    "", // 根目录的名称约定为空字符串
+			Type:    model.FileTypeDir,
+		}
+		if err := repos.File.Create(ctx, userRootDir); err != nil {
+			return fmt.Errorf("为用户创建根目录失败: %w", err)
+		}
+		return createPolicyMountPoints(ctx, repos, newUser, userRootDir)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 重新查询以带上用户组信息
+	return s.userRepo.FindByID(ctx, newUser.ID)
+}
+
 // ActivateUser 实现了激活用户的业务逻辑
 // userID 参数现在是内部数据库主键 ID (uint)
 func (s *authService) ActivateUser(ctx context.Context, userID uint, sign string) error {
@@ -466,7 +575,11 @@ func (s *authService) PerformPasswordReset(ctx context.Context, userID uint, sig
 	newHashedPassword, _ := security.HashPassword(newPassword)
 	user.PasswordHash = newHashedPassword
 
-	return s.userRepo.Update(ctx, user)
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+	// 重置密码后，所有设备都需要使用新密码重新登录
+	return s.sessionSvc.RevokeAll(ctx, user.ID)
 }
 
 // CheckEmailExists 实现了检查邮箱是否存在的业务逻辑
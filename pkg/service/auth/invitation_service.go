@@ -0,0 +1,213 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	// invitationCodeLen 邀请码长度
+	invitationCodeLen = 10
+	// invitationCodeAlphabet 邀请码字符集，去掉了容易混淆的 0/O、1/I
+	invitationCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	// defaultInviteUserGroupID 未指定用户组时，受邀用户加入的默认用户组
+	defaultInviteUserGroupID = 2
+)
+
+// InvitationOptions 是生成邀请码时的可选参数
+type InvitationOptions struct {
+	UserGroupID uint       // 为 0 时使用默认用户组
+	MaxUses     int        // 为 0 表示不限制使用次数
+	Email       string     // 限定只有该邮箱可以使用，为空表示不限
+	Note        string     // 备注
+	ExpiresAt   *time.Time // 为 nil 表示永不过期
+}
+
+// InvitationService 定义了注册邀请码的业务逻辑接口
+type InvitationService interface {
+	Create(ctx context.Context, creatorID uint, opts InvitationOptions) (*model.InvitationCode, error)
+	List(ctx context.Context, page, pageSize int) ([]*model.InvitationCode, int, error)
+	Revoke(ctx context.Context, id uint) error
+	// SendInvite 为指定邮箱生成一个单次使用的邀请码，并通过邮件发送给对方
+	SendInvite(ctx context.Context, creatorID uint, email string, opts InvitationOptions) (*model.InvitationCode, error)
+
+	// Consume 校验邀请码并占用一次使用名额，注册失败时应调用 Release 归还
+	Consume(ctx context.Context, code, email string) (*model.InvitationCode, error)
+	Release(ctx context.Context, id uint)
+}
+
+type invitationService struct {
+	repo          repository.InvitationCodeRepository
+	userRepo      repository.UserRepository
+	userGroupRepo repository.UserGroupRepository
+	emailSvc      utility.EmailService
+}
+
+// NewInvitationService 是 invitationService 的构造函数
+func NewInvitationService(
+	repo repository.InvitationCodeRepository,
+	userRepo repository.UserRepository,
+	userGroupRepo repository.UserGroupRepository,
+	emailSvc utility.EmailService,
+) InvitationService {
+	return &invitationService{
+		repo:          repo,
+		userRepo:      userRepo,
+		userGroupRepo: userGroupRepo,
+		emailSvc:      emailSvc,
+	}
+}
+
+// Create 实现 InvitationService 接口
+func (s *invitationService) Create(ctx context.Context, creatorID uint, opts InvitationOptions) (*model.InvitationCode, error) {
+	if opts.MaxUses < 0 {
+		return nil, fmt.Errorf("最大使用次数不能为负数")
+	}
+	if opts.ExpiresAt != nil && !opts.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("过期时间必须晚于当前时间")
+	}
+	if opts.UserGroupID == 0 {
+		opts.UserGroupID = defaultInviteUserGroupID
+	}
+	group, err := s.userGroupRepo.FindByID(ctx, opts.UserGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户组失败: %w", err)
+	}
+	if group == nil {
+		return nil, fmt.Errorf("用户组不存在")
+	}
+
+	code, err := generateInvitationCode()
+	if err != nil {
+		return nil, err
+	}
+	invitation := &model.InvitationCode{
+		Code:        code,
+		CreatorID:   creatorID,
+		UserGroupID: opts.UserGroupID,
+		MaxUses:     opts.MaxUses,
+		Email:       strings.ToLower(strings.TrimSpace(opts.Email)),
+		Note:        strings.TrimSpace(opts.Note),
+		ExpiresAt:   opts.ExpiresAt,
+	}
+	if err := s.repo.Create(ctx, invitation); err != nil {
+		return nil, fmt.Errorf("保存邀请码失败: %w", err)
+	}
+	return invitation, nil
+}
+
+// List 实现 InvitationService 接口
+func (s *invitationService) List(ctx context.Context, page, pageSize int) ([]*model.InvitationCode, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	return s.repo.List(ctx, page, pageSize)
+}
+
+// Revoke 实现 InvitationService 接口
+func (s *invitationService) Revoke(ctx context.Context, id uint) error {
+	invitation, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("查询邀请码失败: %w", err)
+	}
+	if invitation == nil {
+		return constant.ErrInvitationNotFound
+	}
+	if invitation.RevokedAt != nil {
+		return nil
+	}
+	return s.repo.Revoke(ctx, id)
+}
+
+// SendInvite 实现 InvitationService 接口
+func (s *invitationService) SendInvite(ctx context.Context, creatorID uint, email string, opts InvitationOptions) (*model.InvitationCode, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if existing, err := s.userRepo.FindByEmail(ctx, email); err != nil {
+		return nil, fmt.Errorf("查询邮箱时数据库出错: %w", err)
+	} else if existing != nil {
+		return nil, fmt.Errorf("该邮箱已被注册")
+	}
+
+	inviterName := "管理员"
+	if creator, err := s.userRepo.FindByID(ctx, creatorID); err == nil && creator != nil && creator.Nickname != "" {
+		inviterName = creator.Nickname
+	}
+
+	opts.Email = email
+	opts.MaxUses = 1
+	invitation, err := s.Create(ctx, creatorID, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.emailSvc.SendInvitationEmail(ctx, email, inviterName, invitation.Code, invitation.ExpiresAt); err != nil {
+		// 邮件未送达时撤销邀请码，避免留下无人知晓的可用邀请码
+		if revokeErr := s.repo.Revoke(ctx, invitation.ID); revokeErr != nil {
+			log.Printf("[WARNING] 撤销未送达的邀请码 %d 失败: %v", invitation.ID, revokeErr)
+		}
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// Consume 实现 InvitationService 接口
+func (s *invitationService) Consume(ctx context.Context, code, email string) (*model.InvitationCode, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	invitation, err := s.repo.FindByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("查询邀请码失败: %w", err)
+	}
+	if invitation == nil || invitation.Status(time.Now()) != model.InvitationStatusActive {
+		return nil, constant.ErrInvitationInvalid
+	}
+	if invitation.Email != "" && invitation.Email != strings.ToLower(strings.TrimSpace(email)) {
+		return nil, constant.ErrInvitationInvalid
+	}
+	// 用户组可能在邀请码生成后被删除
+	if group, err := s.userGroupRepo.FindByID(ctx, invitation.UserGroupID); err != nil {
+		return nil, fmt.Errorf("查询用户组失败: %w", err)
+	} else if group == nil {
+		return nil, constant.ErrInvitationInvalid
+	}
+
+	ok, err := s.repo.IncrementUsage(ctx, invitation.ID)
+	if err != nil {
+		return nil, fmt.Errorf("使用邀请码失败: %w", err)
+	}
+	if !ok {
+		return nil, constant.ErrInvitationInvalid
+	}
+	invitation.UsedCount++
+	return invitation, nil
+}
+
+// Release 实现 InvitationService 接口
+func (s *invitationService) Release(ctx context.Context, id uint) {
+	if err := s.repo.DecrementUsage(ctx, id); err != nil {
+		log.Printf("[WARNING] 归还邀请码 %d 的使用名额失败: %v", id, err)
+	}
+}
+
+// generateInvitationCode 生成随机邀请码
+func generateInvitationCode() (string, error) {
+	raw := make([]byte, invitationCodeLen)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("生成邀请码失败: %w", err)
+	}
+	code := make([]byte, invitationCodeLen)
+	for i, b := range raw {
+		code[i] = invitationCodeAlphabet[int(b)%len(invitationCodeAlphabet)]
+	}
+	return string(code), nil
+}
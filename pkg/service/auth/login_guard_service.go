@@ -0,0 +1,374 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// AttemptScope 标识受暴力破解保护的操作，不同操作分别计数
+type AttemptScope string
+
+const (
+	AttemptScopeLogin          AttemptScope = "login"           // 密码登录，失败时计数
+	AttemptScopeForgotPassword AttemptScope = "forgot_password" // 找回密码，每次请求都计数
+	AttemptScopeActivate       AttemptScope = "activate"        // 账户激活，链接无效时计数
+	AttemptScopeSubscribeCode  AttemptScope = "subscribe_code"  // 发送订阅验证码，每次请求都计数
+	AttemptScopeSubscribe      AttemptScope = "subscribe"       // 提交订阅验证码，验证失败时计数
+	AttemptScopeSharePassword  AttemptScope = "share_password"  // 输入分享密码，密码错误时计数，账户为分享ID
+)
+
+// LockoutKind 区分锁定的对象是账户还是IP
+type LockoutKind string
+
+const (
+	LockoutKindAccount LockoutKind = "account"
+	LockoutKindIP      LockoutKind = "ip"
+)
+
+// attemptPolicy 定义了某个操作允许的失败次数，超过后进入锁定
+type attemptPolicy struct {
+	maxAccountFailures int64
+	maxIPFailures      int64
+}
+
+var attemptPolicies = map[AttemptScope]attemptPolicy{
+	AttemptScopeLogin:          {maxAccountFailures: 5, maxIPFailures: 20},
+	AttemptScopeForgotPassword: {maxAccountFailures: 3, maxIPFailures: 10},
+	AttemptScopeActivate:       {maxAccountFailures: 5, maxIPFailures: 10},
+	AttemptScopeSubscribeCode:  {maxAccountFailures: 3, maxIPFailures: 10},
+	AttemptScopeSubscribe:      {maxAccountFailures: 5, maxIPFailures: 20},
+	AttemptScopeSharePassword:  {maxAccountFailures: 10, maxIPFailures: 30},
+}
+
+const (
+	// failureWindow 失败次数的统计窗口，窗口内没有新的失败则计数清零
+	failureWindow = 15 * time.Minute
+	// baseLockoutDuration 第一次锁定的时长，之后每次连续锁定时长翻倍
+	baseLockoutDuration = time.Minute
+	// maxLockoutDuration 锁定时长上限
+	maxLockoutDuration = 24 * time.Hour
+	// lockoutLevelTTL 连续锁定次数的保留时间，超过后锁定时长重新从基础时长开始
+	lockoutLevelTTL = 24 * time.Hour
+)
+
+// LockoutError 表示账户或IP正处于锁定状态，RetryAfter 为剩余的锁定时长
+type LockoutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockoutError) Error() string {
+	return fmt.Sprintf("尝试次数过多，请在 %s后重试", formatRetryAfter(e.RetryAfter))
+}
+
+// Unwrap 使 errors.Is(err, constant.ErrTooManyAttempts) 成立
+func (e *LockoutError) Unwrap() error {
+	return constant.ErrTooManyAttempts
+}
+
+// AsLockoutError 从错误链中取出 LockoutError
+func AsLockoutError(err error) (*LockoutError, bool) {
+	var lockErr *LockoutError
+	if errors.As(err, &lockErr) {
+		return lockErr, true
+	}
+	return nil, false
+}
+
+// Lockout 是一条锁定记录，供管理员查看
+type Lockout struct {
+	Scope       AttemptScope `json:"scope"`
+	Kind        LockoutKind  `json:"kind"`
+	Subject     string       `json:"subject"` // 账户标识（邮箱或用户ID）或IP
+	Level       int          `json:"level"`   // 连续锁定次数，锁定时长为基础时长的 2^(level-1) 倍
+	LockedUntil time.Time    `json:"locked_until"`
+}
+
+// LoginGuardService 为登录、找回密码、激活和订阅验证码等接口提供按账户和按IP的失败次数统计。
+// 失败次数超过阈值后临时锁定，连续锁定时锁定时长按指数增长；登录账户被锁定时会邮件通知账户所有者。
+type LoginGuardService interface {
+	// Check 检查账户或IP是否处于锁定状态，锁定时返回 *LockoutError
+	Check(ctx context.Context, scope AttemptScope, account, ip string) error
+	// RecordFailure 记录一次失败尝试，本次尝试触发锁定时返回 *LockoutError
+	RecordFailure(ctx context.Context, scope AttemptScope, account, ip string) error
+	// RecordSuccess 在操作成功后清除账户的失败计数
+	RecordSuccess(ctx context.Context, scope AttemptScope, account string)
+
+	// ListLockouts 列出当前所有生效中的锁定
+	ListLockouts(ctx context.Context) ([]*Lockout, error)
+	// ClearLockout 解除锁定并清空对应的失败计数
+	ClearLockout(ctx context.Context, scope AttemptScope, kind LockoutKind, subject string) error
+}
+
+type loginGuardService struct {
+	cacheSvc utility.CacheService
+	userRepo repository.UserRepository
+	emailSvc utility.EmailService
+}
+
+// NewLoginGuardService 是 loginGuardService 的构造函数
+func NewLoginGuardService(cacheSvc utility.CacheService, userRepo repository.UserRepository, emailSvc utility.EmailService) LoginGuardService {
+	return &loginGuardService{
+		cacheSvc: cacheSvc,
+		userRepo: userRepo,
+		emailSvc: emailSvc,
+	}
+}
+
+// IsValidAttemptScope 判断操作标识是否有效
+func IsValidAttemptScope(scope AttemptScope) bool {
+	_, ok := attemptPolicies[scope]
+	return ok
+}
+
+// Check 实现 LoginGuardService 接口
+func (s *loginGuardService) Check(ctx context.Context, scope AttemptScope, account, ip string) error {
+	for _, t := range guardTargets(account, ip) {
+		until, _, err := s.lockedUntil(ctx, scope, t.kind, t.subject)
+		if err != nil {
+			log.Printf("[LoginGuard] 警告：读取锁定状态失败: %v", err)
+			continue
+		}
+		if remaining := time.Until(until); remaining > 0 {
+			return &LockoutError{RetryAfter: remaining}
+		}
+	}
+	return nil
+}
+
+// RecordFailure 实现 LoginGuardService 接口
+func (s *loginGuardService) RecordFailure(ctx context.Context, scope AttemptScope, account, ip string) error {
+	policy, ok := attemptPolicies[scope]
+	if !ok {
+		return fmt.Errorf("未知的操作类型: %s", scope)
+	}
+
+	var lockErr *LockoutError
+	for _, t := range guardTargets(account, ip) {
+		limit := policy.maxAccountFailures
+		if t.kind == LockoutKindIP {
+			limit = policy.maxIPFailures
+		}
+
+		key := failureKey(scope, t.kind, t.subject)
+		count, err := s.cacheSvc.Increment(ctx, key)
+		if err != nil {
+			log.Printf("[LoginGuard] 警告：记录失败次数失败: %v", err)
+			continue
+		}
+		if count == 1 {
+			_ = s.cacheSvc.Expire(ctx, key, failureWindow)
+		}
+		if count < limit {
+			continue
+		}
+
+		duration, err := s.lock(ctx, scope, t.kind, t.subject)
+		if err != nil {
+			log.Printf("[LoginGuard] 警告：写入锁定状态失败: %v", err)
+			continue
+		}
+		log.Printf("[LoginGuard] %s 操作的%s %s 连续失败 %d 次，锁定 %s", scope, kindLabel(t.kind), t.subject, count, duration)
+		if scope == AttemptScopeLogin && t.kind == LockoutKindAccount {
+			s.notifyAccountLocked(ctx, t.subject, ip, time.Now().Add(duration))
+		}
+		if lockErr == nil || duration > lockErr.RetryAfter {
+			lockErr = &LockoutError{RetryAfter: duration}
+		}
+	}
+	if lockErr != nil {
+		return lockErr
+	}
+	return nil
+}
+
+// RecordSuccess 实现 LoginGuardService 接口
+func (s *loginGuardService) RecordSuccess(ctx context.Context, scope AttemptScope, account string) {
+	account = normalizeAccount(account)
+	if account == "" {
+		return
+	}
+	if err := s.cacheSvc.Delete(ctx,
+		failureKey(scope, LockoutKindAccount, account),
+		levelKey(scope, LockoutKindAccount, account),
+	); err != nil {
+		log.Printf("[LoginGuard] 警告：清除失败次数失败: %v", err)
+	}
+}
+
+// ListLockouts 实现 LoginGuardService 接口
+func (s *loginGuardService) ListLockouts(ctx context.Context) ([]*Lockout, error) {
+	keys, err := s.cacheSvc.Scan(ctx, lockKeyPrefix+"*")
+	if err != nil {
+		return nil, fmt.Errorf("查询锁定记录失败: %w", err)
+	}
+
+	lockouts := make([]*Lockout, 0, len(keys))
+	for _, key := range keys {
+		// 键的格式为 auth:guard:lock:<scope>:<kind>:<subject>，IPv6 地址中含有冒号，因此 subject 取剩余全部内容
+		parts := strings.SplitN(strings.TrimPrefix(key, lockKeyPrefix), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		scope, kind, subject := AttemptScope(parts[0]), LockoutKind(parts[1]), parts[2]
+		until, level, err := s.lockedUntil(ctx, scope, kind, subject)
+		if err != nil || !until.After(time.Now()) {
+			continue
+		}
+		lockouts = append(lockouts, &Lockout{
+			Scope:       scope,
+			Kind:        kind,
+			Subject:     subject,
+			Level:       level,
+			LockedUntil: until,
+		})
+	}
+	sort.Slice(lockouts, func(i, j int) bool {
+		return lockouts[i].LockedUntil.After(lockouts[j].LockedUntil)
+	})
+	return lockouts, nil
+}
+
+// ClearLockout 实现 LoginGuardService 接口
+func (s *loginGuardService) ClearLockout(ctx context.Context, scope AttemptScope, kind LockoutKind, subject string) error {
+	if !IsValidAttemptScope(scope) || (kind != LockoutKindAccount && kind != LockoutKindIP) {
+		return fmt.Errorf("锁定类型无效")
+	}
+	if kind == LockoutKindAccount {
+		subject = normalizeAccount(subject)
+	}
+	if err := s.cacheSvc.Delete(ctx,
+		lockKey(scope, kind, subject),
+		failureKey(scope, kind, subject),
+		levelKey(scope, kind, subject),
+	); err != nil {
+		return fmt.Errorf("解除锁定失败: %w", err)
+	}
+	log.Printf("[LoginGuard] 已解除 %s 操作对%s %s 的锁定", scope, kindLabel(kind), subject)
+	return nil
+}
+
+// lock 写入锁定状态并清空失败计数，返回本次锁定的时长
+func (s *loginGuardService) lock(ctx context.Context, scope AttemptScope, kind LockoutKind, subject string) (time.Duration, error) {
+	lKey := levelKey(scope, kind, subject)
+	level, err := s.cacheSvc.Increment(ctx, lKey)
+	if err != nil {
+		return 0, err
+	}
+	_ = s.cacheSvc.Expire(ctx, lKey, lockoutLevelTTL)
+
+	duration := lockoutDuration(int(level))
+	until := time.Now().Add(duration)
+	value := fmt.Sprintf("%d|%d", until.Unix(), level)
+	if err := s.cacheSvc.Set(ctx, lockKey(scope, kind, subject), value, duration); err != nil {
+		return 0, err
+	}
+	_ = s.cacheSvc.Delete(ctx, failureKey(scope, kind, subject))
+	return duration, nil
+}
+
+// lockedUntil 读取锁定截止时间和连续锁定次数，未锁定时返回零值
+func (s *loginGuardService) lockedUntil(ctx context.Context, scope AttemptScope, kind LockoutKind, subject string) (time.Time, int, error) {
+	value, err := s.cacheSvc.Get(ctx, lockKey(scope, kind, subject))
+	if err != nil || value == "" {
+		return time.Time{}, 0, err
+	}
+	untilStr, levelStr, _ := strings.Cut(value, "|")
+	unix, err := strconv.ParseInt(untilStr, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("锁定记录格式错误: %s", value)
+	}
+	level, _ := strconv.Atoi(levelStr)
+	return time.Unix(unix, 0), level, nil
+}
+
+// notifyAccountLocked 邮件通知账户所有者其账户因登录失败次数过多被临时锁定
+func (s *loginGuardService) notifyAccountLocked(ctx context.Context, email, ip string, until time.Time) {
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil || user == nil {
+		return
+	}
+	go func() {
+		if err := s.emailSvc.SendAccountLockedEmail(context.Background(), user.Email, user.Nickname, ip, until); err != nil {
+			log.Printf("[LoginGuard] 发送账户锁定通知邮件失败: %v", err)
+		}
+	}()
+}
+
+// lockoutDuration 计算第 level 次连续锁定的时长：基础时长 * 2^(level-1)，不超过上限
+func lockoutDuration(level int) time.Duration {
+	if level < 1 {
+		level = 1
+	}
+	duration := baseLockoutDuration
+	for i := 1; i < level; i++ {
+		duration *= 2
+		if duration >= maxLockoutDuration {
+			return maxLockoutDuration
+		}
+	}
+	return duration
+}
+
+type guardTarget struct {
+	kind    LockoutKind
+	subject string
+}
+
+// guardTargets 返回需要检查的账户和IP，为空的一方会被忽略
+func guardTargets(account, ip string) []guardTarget {
+	targets := make([]guardTarget, 0, 2)
+	if account = normalizeAccount(account); account != "" {
+		targets = append(targets, guardTarget{kind: LockoutKindAccount, subject: account})
+	}
+	if ip != "" {
+		targets = append(targets, guardTarget{kind: LockoutKindIP, subject: ip})
+	}
+	return targets
+}
+
+func normalizeAccount(account string) string {
+	return strings.ToLower(strings.TrimSpace(account))
+}
+
+func kindLabel(kind LockoutKind) string {
+	if kind == LockoutKindIP {
+		return "IP"
+	}
+	return "账户"
+}
+
+// formatRetryAfter 将剩余锁定时长格式化为便于阅读的文本
+func formatRetryAfter(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%d 秒", int(d.Seconds()+0.999))
+	}
+	minutes := int((d + time.Minute - 1) / time.Minute)
+	if minutes < 60 {
+		return fmt.Sprintf("%d 分钟", minutes)
+	}
+	return fmt.Sprintf("%d 小时 %d 分钟", minutes/60, minutes%60)
+}
+
+const lockKeyPrefix = "auth:guard:lock:"
+
+func lockKey(scope AttemptScope, kind LockoutKind, subject string) string {
+	return fmt.Sprintf("%s%s:%s:%s", lockKeyPrefix, scope, kind, subject)
+}
+
+func failureKey(scope AttemptScope, kind LockoutKind, subject string) string {
+	return fmt.Sprintf("auth:guard:fail:%s:%s:%s", scope, kind, subject)
+}
+
+func levelKey(scope AttemptScope, kind LockoutKind, subject string) string {
+	return fmt.Sprintf("auth:guard:level:%s:%s:%s", scope, kind, subject)
+}
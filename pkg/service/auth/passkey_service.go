@@ -0,0 +1,409 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/webauthn"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// passkeyCeremonyTTL 注册或登录仪式的有效期，同时作为浏览器端的超时时间
+const passkeyCeremonyTTL = 5 * time.Minute
+
+const (
+	passkeyCeremonyRegister = "register"
+	passkeyCeremonyLogin    = "login"
+)
+
+// PasskeyRegistrationBegin 是开始注册通行密钥时返回的信息
+type PasskeyRegistrationBegin struct {
+	SessionID string                    `json:"sessionId"`
+	Options   *webauthn.CreationOptions `json:"publicKey"`
+}
+
+// PasskeyLoginBegin 是开始使用通行密钥登录时返回的信息
+type PasskeyLoginBegin struct {
+	SessionID string                   `json:"sessionId"`
+	Options   *webauthn.RequestOptions `json:"publicKey"`
+}
+
+// PasskeyService 定义了 WebAuthn 通行密钥注册、登录和管理的业务逻辑接口
+type PasskeyService interface {
+	BeginRegistration(ctx context.Context, user *model.User) (*PasskeyRegistrationBegin, error)
+	FinishRegistration(ctx context.Context, user *model.User, sessionID, name string, resp *webauthn.RegistrationResponse) (*model.Passkey, error)
+
+	// BeginLogin 生成登录挑战。使用可发现凭证，用户无需先输入邮箱
+	BeginLogin(ctx context.Context) (*PasskeyLoginBegin, error)
+	// FinishLogin 校验认证结果，通过后返回对应的用户。
+	// 通行密钥要求认证器进行用户验证，本身即满足多因素认证，因此不再进行 TOTP 两步验证。
+	FinishLogin(ctx context.Context, sessionID string, resp *webauthn.AssertionResponse) (*model.User, error)
+
+	List(ctx context.Context, userID uint) ([]*model.Passkey, error)
+	Rename(ctx context.Context, userID, passkeyID uint, name string) error
+	Delete(ctx context.Context, userID, passkeyID uint) error
+}
+
+type passkeyService struct {
+	repo       repository.PasskeyRepository
+	userRepo   repository.UserRepository
+	settingSvc setting.SettingService
+	cacheSvc   utility.CacheService
+}
+
+// NewPasskeyService 是 passkeyService 的构造函数
+func NewPasskeyService(
+	repo repository.PasskeyRepository,
+	userRepo repository.UserRepository,
+	settingSvc setting.SettingService,
+	cacheSvc utility.CacheService,
+) PasskeyService {
+	return &passkeyService{
+		repo:       repo,
+		userRepo:   userRepo,
+		settingSvc: settingSvc,
+		cacheSvc:   cacheSvc,
+	}
+}
+
+// passkeySession 是缓存中保存的仪式状态
+type passkeySession struct {
+	Ceremony  string `json:"ceremony"`
+	Challenge string `json:"challenge"`
+	UserID    uint   `json:"user_id,omitempty"` // 仅注册仪式使用
+}
+
+// BeginRegistration 实现 PasskeyService 接口
+func (s *passkeyService) BeginRegistration(ctx context.Context, user *model.User) (*PasskeyRegistrationBegin, error) {
+	rp, err := s.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+	userHandle, err := passkeyUserHandle(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 已注册的凭证加入排除列表，避免同一个认证器重复注册
+	existing, err := s.repo.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查询通行密钥失败: %w", err)
+	}
+	exclude := make([]webauthn.CredentialDescriptor, 0, len(existing))
+	for _, p := range existing {
+		exclude = append(exclude, webauthn.CredentialDescriptor{Type: "public-key", ID: p.CredentialID, Transports: p.Transports})
+	}
+
+	sessionID, challenge, err := s.newSession(ctx, passkeySession{Ceremony: passkeyCeremonyRegister, UserID: user.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	displayName := user.Nickname
+	if displayName == "" {
+		displayName = user.Username
+	}
+	accountName := user.Email
+	if accountName == "" {
+		accountName = user.Username
+	}
+	return &PasskeyRegistrationBegin{
+		SessionID: sessionID,
+		Options:   webauthn.NewCreationOptions(*rp, userHandle, accountName, displayName, challenge, exclude, passkeyCeremonyTTL.Milliseconds()),
+	}, nil
+}
+
+// FinishRegistration 实现 PasskeyService 接口
+func (s *passkeyService) FinishRegistration(ctx context.Context, user *model.User, sessionID, name string, resp *webauthn.RegistrationResponse) (*model.Passkey, error) {
+	session, err := s.takeSession(ctx, sessionID, passkeyCeremonyRegister)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != user.ID {
+		return nil, constant.ErrPasskeyChallengeInvalid
+	}
+	rp, err := s.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := webauthn.VerifyRegistration(*rp, session.Challenge, resp)
+	if err != nil {
+		log.Printf("[Passkey] 用户 %d 注册通行密钥校验失败: %v", user.ID, err)
+		return nil, constant.ErrPasskeyVerificationFailed
+	}
+
+	credentialID := webauthn.EncodeBase64URL(cred.ID)
+	if existing, err := s.repo.FindByCredentialID(ctx, credentialID); err != nil {
+		return nil, fmt.Errorf("查询通行密钥失败: %w", err)
+	} else if existing != nil {
+		return nil, fmt.Errorf("该通行密钥已注册")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "通行密钥 " + time.Now().Format("2006-01-02")
+	}
+	if len([]rune(name)) > 64 {
+		name = string([]rune(name)[:64])
+	}
+
+	passkey := &model.Passkey{
+		UserID:       user.ID,
+		Name:         name,
+		CredentialID: credentialID,
+		PublicKey:    cred.PublicKey,
+		SignCount:    cred.SignCount,
+		AAGUID:       formatAAGUID(cred.AAGUID),
+		Transports:   resp.Response.Transports,
+	}
+	if err := s.repo.Create(ctx, passkey); err != nil {
+		return nil, fmt.Errorf("保存通行密钥失败: %w", err)
+	}
+	log.Printf("[Passkey] 用户 %d 注册了通行密钥 %d", user.ID, passkey.ID)
+	return passkey, nil
+}
+
+// BeginLogin 实现 PasskeyService 接口
+func (s *passkeyService) BeginLogin(ctx context.Context) (*PasskeyLoginBegin, error) {
+	rp, err := s.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+	sessionID, challenge, err := s.newSession(ctx, passkeySession{Ceremony: passkeyCeremonyLogin})
+	if err != nil {
+		return nil, err
+	}
+	return &PasskeyLoginBegin{
+		SessionID: sessionID,
+		Options:   webauthn.NewRequestOptions(*rp, challenge, nil, passkeyCeremonyTTL.Milliseconds()),
+	}, nil
+}
+
+// FinishLogin 实现 PasskeyService 接口
+func (s *passkeyService) FinishLogin(ctx context.Context, sessionID string, resp *webauthn.AssertionResponse) (*model.User, error) {
+	session, err := s.takeSession(ctx, sessionID, passkeyCeremonyLogin)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := s.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := resp.CredentialID()
+	if err != nil {
+		return nil, constant.ErrPasskeyVerificationFailed
+	}
+	passkey, err := s.repo.FindByCredentialID(ctx, webauthn.EncodeBase64URL(rawID))
+	if err != nil {
+		return nil, fmt.Errorf("查询通行密钥失败: %w", err)
+	}
+	if passkey == nil {
+		return nil, constant.ErrPasskeyVerificationFailed
+	}
+
+	// 可发现凭证会返回注册时的 userHandle，必须与凭证所属用户一致
+	if resp.Response.UserHandle != "" {
+		got, err := webauthn.DecodeBase64URL(resp.Response.UserHandle)
+		if err != nil {
+			return nil, constant.ErrPasskeyVerificationFailed
+		}
+		expected, err := passkeyUserHandle(passkey.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if subtle.ConstantTimeCompare(got, expected) != 1 {
+			return nil, constant.ErrPasskeyVerificationFailed
+		}
+	}
+
+	signCount, err := webauthn.VerifyAssertion(*rp, session.Challenge, passkey.PublicKey, resp)
+	if err != nil {
+		log.Printf("[Passkey] 通行密钥 %d 登录校验失败: %v", passkey.ID, err)
+		return nil, constant.ErrPasskeyVerificationFailed
+	}
+	if !webauthn.SignCountValid(passkey.SignCount, signCount) {
+		log.Printf("[Passkey] 警告：通行密钥 %d 的签名计数异常（存储 %d，收到 %d），可能已被克隆，拒绝登录", passkey.ID, passkey.SignCount, signCount)
+		return nil, constant.ErrPasskeyVerificationFailed
+	}
+
+	user, err := s.userRepo.FindByID(ctx, passkey.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil {
+		return nil, constant.ErrPasskeyVerificationFailed
+	}
+	if user.Status == model.UserStatusInactive {
+		return nil, fmt.Errorf("您的账户尚未激活，请检查您的邮箱以完成激活流程")
+	}
+	if user.Status == model.UserStatusBanned {
+		return nil, fmt.Errorf("您的账户已被封禁，请联系管理员")
+	}
+
+	if err := s.repo.UpdateUsage(ctx, passkey.ID, signCount); err != nil {
+		log.Printf("[Passkey] 警告：更新通行密钥 %d 的使用记录失败: %v", passkey.ID, err)
+	}
+
+	now := time.Now()
+	user.LastLoginAt = &now
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		log.Printf("[Passkey] 警告：更新用户 %d 的最后登录时间失败: %v", user.ID, err)
+	}
+	return user, nil
+}
+
+// List 实现 PasskeyService 接口
+func (s *passkeyService) List(ctx context.Context, userID uint) ([]*model.Passkey, error) {
+	passkeys, err := s.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询通行密钥失败: %w", err)
+	}
+	return passkeys, nil
+}
+
+// Rename 实现 PasskeyService 接口
+func (s *passkeyService) Rename(ctx context.Context, userID, passkeyID uint, name string) error {
+	if _, err := s.findOwned(ctx, userID, passkeyID); err != nil {
+		return err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > 64 {
+		return fmt.Errorf("名称不能为空且不能超过 64 个字符")
+	}
+	if err := s.repo.UpdateName(ctx, passkeyID, name); err != nil {
+		return fmt.Errorf("重命名通行密钥失败: %w", err)
+	}
+	return nil
+}
+
+// Delete 实现 PasskeyService 接口
+func (s *passkeyService) Delete(ctx context.Context, userID, passkeyID uint) error {
+	if _, err := s.findOwned(ctx, userID, passkeyID); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, passkeyID); err != nil {
+		return fmt.Errorf("删除通行密钥失败: %w", err)
+	}
+	log.Printf("[Passkey] 用户 %d 删除了通行密钥 %d", userID, passkeyID)
+	return nil
+}
+
+// findOwned 查找属于指定用户的通行密钥
+func (s *passkeyService) findOwned(ctx context.Context, userID, passkeyID uint) (*model.Passkey, error) {
+	passkey, err := s.repo.FindByID(ctx, passkeyID)
+	if err != nil {
+		return nil, fmt.Errorf("查询通行密钥失败: %w", err)
+	}
+	if passkey == nil || passkey.UserID != userID {
+		return nil, constant.ErrPasskeyNotFound
+	}
+	return passkey, nil
+}
+
+// relyingParty 根据站点地址确定 RP ID 和允许的来源
+func (s *passkeyService) relyingParty() (*webauthn.RelyingParty, error) {
+	siteURL := strings.TrimSpace(s.settingSvc.Get(constant.KeySiteURL.String()))
+	u, err := url.Parse(siteURL)
+	if err != nil || u.Hostname() == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return nil, fmt.Errorf("站点地址 (SITE_URL) 未正确配置，无法使用通行密钥")
+	}
+	name := s.settingSvc.Get(constant.KeyAppName.String())
+	if name == "" {
+		name = u.Hostname()
+	}
+	return &webauthn.RelyingParty{
+		ID:     u.Hostname(),
+		Name:   name,
+		Origin: u.Scheme + "://" + u.Host,
+	}, nil
+}
+
+// newSession 生成挑战并将仪式状态写入缓存，返回会话ID和挑战
+func (s *passkeyService) newSession(ctx context.Context, session passkeySession) (string, string, error) {
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return "", "", fmt.Errorf("生成挑战失败: %w", err)
+	}
+	sessionID, err := webauthn.NewChallenge()
+	if err != nil {
+		return "", "", fmt.Errorf("生成会话ID失败: %w", err)
+	}
+	session.Challenge = challenge
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return "", "", err
+	}
+	if err := s.cacheSvc.Set(ctx, passkeySessionKey(sessionID), string(data), passkeyCeremonyTTL); err != nil {
+		return "", "", fmt.Errorf("保存通行密钥会话失败: %w", err)
+	}
+	return sessionID, challenge, nil
+}
+
+// takeSession 取出并删除仪式状态，保证每个挑战只能使用一次
+func (s *passkeyService) takeSession(ctx context.Context, sessionID, ceremony string) (*passkeySession, error) {
+	if sessionID == "" {
+		return nil, constant.ErrPasskeyChallengeInvalid
+	}
+	key := passkeySessionKey(sessionID)
+	data, err := s.cacheSvc.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("读取通行密钥会话失败: %w", err)
+	}
+	if data == "" {
+		return nil, constant.ErrPasskeyChallengeInvalid
+	}
+	_ = s.cacheSvc.Delete(ctx, key)
+
+	var session passkeySession
+	if err := json.Unmarshal([]byte(data), &session); err != nil || session.Ceremony != ceremony {
+		return nil, constant.ErrPasskeyChallengeInvalid
+	}
+	return &session, nil
+}
+
+func passkeySessionKey(sessionID string) string {
+	return "auth:passkey_session:" + sessionID
+}
+
+// passkeyUserHandle 返回注册时写入认证器的用户句柄。使用用户的公共ID，不包含邮箱等个人信息
+func passkeyUserHandle(userID uint) ([]byte, error) {
+	publicUserID, err := idgen.GeneratePublicID(userID, idgen.EntityTypeUser)
+	if err != nil {
+		return nil, fmt.Errorf("生成用户公共ID失败: %w", err)
+	}
+	return []byte(publicUserID), nil
+}
+
+// formatAAGUID 将 16 字节的 AAGUID 格式化为 UUID 字符串，全零（未提供）时返回空
+func formatAAGUID(aaguid []byte) string {
+	if len(aaguid) != 16 {
+		return ""
+	}
+	allZero := true
+	for _, b := range aaguid {
+		if b != 0 {
+			allZero = false
+			break
+		}
+	}
+	if allZero {
+		return ""
+	}
+	h := hex.EncodeToString(aaguid)
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+// ClientInfo 是发起登录或刷新令牌的客户端信息，记录到会话中
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+// SessionService 定义了登录会话的查询和撤销接口。
+// 撤销会话会删除对应的刷新令牌，并将会话加入拒绝列表，使已签发的访问令牌立即失效。
+type SessionService interface {
+	List(ctx context.Context, userID uint) ([]*model.UserSession, error)
+	// Revoke 撤销用户的某个会话
+	Revoke(ctx context.Context, userID, id uint) error
+	// RevokeBySessionID 根据令牌中的会话标识撤销会话，用于退出登录
+	RevokeBySessionID(ctx context.Context, sessionID string) error
+	// RevokeOthers 撤销除当前会话外的全部会话，返回撤销的数量
+	RevokeOthers(ctx context.Context, userID uint, currentSessionID string) (int, error)
+	// RevokeAll 撤销用户的全部会话，用于修改密码、封禁和管理员重置等场景
+	RevokeAll(ctx context.Context, userID uint) error
+}
+
+type sessionService struct {
+	repo     repository.UserSessionRepository
+	cacheSvc utility.CacheService
+}
+
+// NewSessionService 是 sessionService 的构造函数
+func NewSessionService(repo repository.UserSessionRepository, cacheSvc utility.CacheService) SessionService {
+	return &sessionService{
+		repo:     repo,
+		cacheSvc: cacheSvc,
+	}
+}
+
+// List 实现 SessionService 接口
+func (s *sessionService) List(ctx context.Context, userID uint) ([]*model.UserSession, error) {
+	return s.repo.ListByUserID(ctx, userID)
+}
+
+// Revoke 实现 SessionService 接口
+func (s *sessionService) Revoke(ctx context.Context, userID, id uint) error {
+	session, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("查询会话失败: %w", err)
+	}
+	if session == nil || session.UserID != userID {
+		return constant.ErrSessionNotFound
+	}
+	return s.revoke(ctx, session)
+}
+
+// RevokeBySessionID 实现 SessionService 接口
+func (s *sessionService) RevokeBySessionID(ctx context.Context, sessionID string) error {
+	if sessionID == "" {
+		return nil
+	}
+	session, err := s.repo.FindBySessionID(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("查询会话失败: %w", err)
+	}
+	if session == nil {
+		// 会话已被删除，仍然将其加入拒绝列表，确保访问令牌失效
+		return denySession(ctx, s.cacheSvc, sessionID)
+	}
+	return s.revoke(ctx, session)
+}
+
+// RevokeOthers 实现 SessionService 接口
+func (s *sessionService) RevokeOthers(ctx context.Context, userID uint, currentSessionID string) (int, error) {
+	sessions, err := s.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("查询会话失败: %w", err)
+	}
+	revoked := 0
+	for _, session := range sessions {
+		if session.SessionID == currentSessionID {
+			continue
+		}
+		if err := s.revoke(ctx, session); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// RevokeAll 实现 SessionService 接口
+func (s *sessionService) RevokeAll(ctx context.Context, userID uint) error {
+	sessions, err := s.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("查询会话失败: %w", err)
+	}
+	for _, session := range sessions {
+		if err := denySession(ctx, s.cacheSvc, session.SessionID); err != nil {
+			return err
+		}
+	}
+	if err := s.repo.DeleteByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("删除会话失败: %w", err)
+	}
+	log.Printf("[Session] 已撤销用户 %d 的全部 %d 个会话", userID, len(sessions))
+	return nil
+}
+
+func (s *sessionService) revoke(ctx context.Context, session *model.UserSession) error {
+	if err := denySession(ctx, s.cacheSvc, session.SessionID); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, session.ID); err != nil {
+		return fmt.Errorf("删除会话失败: %w", err)
+	}
+	return nil
+}
+
+// --- 拒绝列表 ---
+
+// denySession 将会话加入拒绝列表。访问令牌最长有效期过后，令牌自然失效，拒绝列表项也随之过期
+func denySession(ctx context.Context, cacheSvc utility.CacheService, sessionID string) error {
+	if err := cacheSvc.Set(ctx, deniedSessionKey(sessionID), "1", auth.AccessTokenTTL); err != nil {
+		return fmt.Errorf("写入令牌拒绝列表失败: %w", err)
+	}
+	return nil
+}
+
+// isSessionDenied 判断会话是否已被撤销
+func isSessionDenied(ctx context.Context, cacheSvc utility.CacheService, sessionID string) (bool, error) {
+	v, err := cacheSvc.Get(ctx, deniedSessionKey(sessionID))
+	if err != nil {
+		return false, err
+	}
+	return v != "", nil
+}
+
+func deniedSessionKey(sessionID string) string {
+	return "auth:denied_session:" + sessionID
+}
+
+// newSessionID 生成随机的会话标识
+func newSessionID() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("生成会话标识失败: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// describeDevice 根据 User-Agent 生成简短的设备描述，例如 "Chrome · Windows"
+func describeDevice(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	if ua == "" {
+		return "未知设备"
+	}
+
+	var browser string
+	switch {
+	case strings.Contains(ua, "edg/"):
+		browser = "Edge"
+	case strings.Contains(ua, "firefox"):
+		browser = "Firefox"
+	case strings.Contains(ua, "chrome"):
+		browser = "Chrome"
+	case strings.Contains(ua, "safari"):
+		browser = "Safari"
+	case strings.Contains(ua, "curl"), strings.Contains(ua, "go-http-client"), strings.Contains(ua, "python"):
+		browser = "命令行工具"
+	default:
+		browser = "其他浏览器"
+	}
+
+	var os string
+	switch {
+	case strings.Contains(ua, "windows"):
+		os = "Windows"
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"):
+		os = "iOS"
+	case strings.Contains(ua, "mac os"), strings.Contains(ua, "macintosh"):
+		os = "macOS"
+	case strings.Contains(ua, "android"):
+		os = "Android"
+	case strings.Contains(ua, "linux"):
+		os = "Linux"
+	default:
+		return browser
+	}
+	return browser + " · " + os
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 	"time"
@@ -20,8 +21,10 @@ import (
 )
 
 type TokenService interface {
-	GenerateSessionTokens(ctx context.Context, user *model.User) (accessToken, refreshToken string, expiresAt int64, err error)
-	RefreshAccessToken(ctx context.Context, refreshToken string) (accessToken string, expiresAt int64, err error)
+	// GenerateSessionTokens 创建新的登录会话并签发访问令牌和刷新令牌
+	GenerateSessionTokens(ctx context.Context, user *model.User, client ClientInfo) (accessToken, refreshToken string, expiresAt int64, err error)
+	// RefreshAccessToken 使用刷新令牌换取新的访问令牌，刷新令牌对应的会话必须仍然存在
+	RefreshAccessToken(ctx context.Context, refreshToken string, client ClientInfo) (accessToken string, expiresAt int64, err error)
 	GenerateSignedToken(identifier string, duration time.Duration) (string, error)
 	VerifySignedToken(identifier, sign string) error
 	ParseAccessToken(ctx context.Context, accessToken string) (*auth.CustomClaims, error)
@@ -29,9 +32,10 @@ type TokenService interface {
 
 // tokenService 结构体增加了 cacheSvc 依赖
 type tokenService struct {
-	userRepo   repository.UserRepository
-	settingSvc setting.SettingService
-	cacheSvc   utility.CacheService
+	userRepo    repository.UserRepository
+	settingSvc  setting.SettingService
+	cacheSvc    utility.CacheService
+	sessionRepo repository.UserSessionRepository
 }
 
 // NewTokenService 构造函数
@@ -39,29 +43,51 @@ func NewTokenService(
 	userRepo repository.UserRepository,
 	settingSvc setting.SettingService,
 	cacheSvc utility.CacheService,
+	sessionRepo repository.UserSessionRepository,
 ) TokenService {
 	return &tokenService{
-		userRepo:   userRepo,
-		settingSvc: settingSvc,
-		cacheSvc:   cacheSvc,
+		userRepo:    userRepo,
+		settingSvc:  settingSvc,
+		cacheSvc:    cacheSvc,
+		sessionRepo: sessionRepo,
 	}
 }
 
 // --- JWT 会话令牌实现 ---
 
-func (s *tokenService) GenerateSessionTokens(ctx context.Context, user *model.User) (string, string, int64, error) {
+func (s *tokenService) GenerateSessionTokens(ctx context.Context, user *model.User, client ClientInfo) (string, string, int64, error) {
 	// 动态地从 SettingService 获取密钥
 	jwtSecret := s.settingSvc.Get(constant.KeyJWTSecret.String())
 	if jwtSecret == "" {
 		return "", "", 0, fmt.Errorf("JWT_SECRET 未从数据库加载, 无法生成令牌")
 	}
 
+	// 每次登录创建一个会话，刷新令牌与会话绑定，撤销会话即可让刷新令牌失效
+	sessionID, err := newSessionID()
+	if err != nil {
+		return "", "", 0, err
+	}
+	if err := s.sessionRepo.DeleteExpired(ctx, user.ID); err != nil {
+		log.Printf("[Session] 警告：清理用户 %d 的过期会话失败: %v", user.ID, err)
+	}
+	session := &model.UserSession{
+		UserID:    user.ID,
+		SessionID: sessionID,
+		Device:    describeDevice(client.UserAgent),
+		IP:        client.IP,
+		UserAgent: truncateUserAgent(client.UserAgent),
+		ExpiresAt: time.Now().Add(auth.RefreshTokenTTL),
+	}
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		return "", "", 0, fmt.Errorf("创建登录会话失败: %w", err)
+	}
+
 	// auth.GenerateToken 和 auth.GenerateRefreshToken 现在接收内部 uint ID，并在内部生成公共 ID
-	accessToken, err := auth.GenerateToken(user.ID, []byte(user.UserGroup.Permissions), user.UserGroup.ID, []byte(jwtSecret))
+	accessToken, err := auth.GenerateToken(user.ID, []byte(user.UserGroup.Permissions), user.UserGroup.ID, sessionID, []byte(jwtSecret))
 	if err != nil {
 		return "", "", 0, err
 	}
-	refreshToken, err := auth.GenerateRefreshToken(user.ID, []byte(jwtSecret))
+	refreshToken, err := auth.GenerateRefreshToken(user.ID, sessionID, []byte(jwtSecret))
 	if err != nil {
 		return "", "", 0, err
 	}
@@ -75,7 +101,7 @@ func (s *tokenService) GenerateSessionTokens(ctx context.Context, user *model.Us
 	return accessToken, refreshToken, expiresAt, nil
 }
 
-func (s *tokenService) RefreshAccessToken(ctx context.Context, refreshToken string) (string, int64, error) {
+func (s *tokenService) RefreshAccessToken(ctx context.Context, refreshToken string, client ClientInfo) (string, int64, error) {
 	jwtSecret := s.settingSvc.Get(constant.KeyJWTSecret.String())
 	if jwtSecret == "" {
 		return "", 0, fmt.Errorf("JWT_SECRET 未从数据库加载, 无法刷新令牌")
@@ -101,8 +127,23 @@ func (s *tokenService) RefreshAccessToken(ctx context.Context, refreshToken stri
 		return "", 0, fmt.Errorf("用户不存在或状态异常")
 	}
 
-	// 3. 重新生成 Access Token，auth.GenerateToken 现在接收内部 uint ID
-	accessToken, err := auth.GenerateToken(user.ID, []byte(user.UserGroup.Permissions), user.UserGroup.ID, []byte(jwtSecret))
+	// 3. 刷新令牌必须对应一个仍然存在的会话，会话被撤销后刷新令牌随之失效
+	if claims.ID == "" {
+		return "", 0, fmt.Errorf("会话已失效，请重新登录")
+	}
+	session, err := s.sessionRepo.FindBySessionID(ctx, claims.ID)
+	if err != nil {
+		return "", 0, fmt.Errorf("查询登录会话失败: %w", err)
+	}
+	if session == nil || session.UserID != user.ID || time.Now().After(session.ExpiresAt) {
+		return "", 0, fmt.Errorf("会话已失效，请重新登录")
+	}
+	if err := s.sessionRepo.Touch(ctx, session.ID, client.IP, truncateUserAgent(client.UserAgent)); err != nil {
+		log.Printf("[Session] 警告：更新会话 %d 的使用记录失败: %v", session.ID, err)
+	}
+
+	// 4. 重新生成 Access Token，auth.GenerateToken 现在接收内部 uint ID
+	accessToken, err := auth.GenerateToken(user.ID, []byte(user.UserGroup.Permissions), user.UserGroup.ID, session.SessionID, []byte(jwtSecret))
 	if err != nil {
 		return "", 0, err
 	}
@@ -176,5 +217,30 @@ func (s *tokenService) ParseAccessToken(ctx context.Context, accessToken string)
 		return nil, fmt.Errorf("JWT_SECRET 未配置，无法解析令牌")
 	}
 
-	return auth.ParseToken(accessToken, []byte(jwtSecret))
+	claims, err := auth.ParseToken(accessToken, []byte(jwtSecret))
+	if err != nil {
+		return nil, err
+	}
+	// 刷新令牌不携带用户组信息，不能当作访问令牌使用
+	if claims.UserGroupID == "" {
+		return nil, fmt.Errorf("令牌类型不正确")
+	}
+	if claims.SessionID != "" {
+		denied, err := isSessionDenied(ctx, s.cacheSvc, claims.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("检查令牌拒绝列表失败: %w", err)
+		}
+		if denied {
+			return nil, fmt.Errorf("会话已被撤销")
+		}
+	}
+	return claims, nil
+}
+
+// truncateUserAgent 截断过长的 User-Agent，与数据库字段长度一致
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) > 512 {
+		return userAgent[:512]
+	}
+	return userAgent
 }
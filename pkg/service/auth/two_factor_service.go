@@ -0,0 +1,420 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/security"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+)
+
+const (
+	// loginChallengeTTL 登录挑战令牌的有效期，用户需在此时间内完成第二步验证
+	loginChallengeTTL = 5 * time.Minute
+	// maxTwoFactorAttempts 每个用户在 loginChallengeTTL 内允许的最大验证失败次数
+	maxTwoFactorAttempts = 5
+	// recoveryCodeCount 每次生成的恢复码数量
+	recoveryCodeCount = 10
+)
+
+// TwoFactorStatus 描述了用户当前的两步验证状态
+type TwoFactorStatus struct {
+	Enabled                bool `json:"enabled"`
+	Required               bool `json:"required"` // 所在用户组是否强制启用
+	RecoveryCodesRemaining int  `json:"recovery_codes_remaining"`
+}
+
+// TwoFactorEnrollment 是开始绑定验证器时返回的信息
+type TwoFactorEnrollment struct {
+	Secret     string `json:"secret"`      // 供无法扫码时手动输入
+	OTPAuthURI string `json:"otpauth_uri"` // 前端将其渲染为二维码
+}
+
+// LoginChallenge 是密码验证通过后、签发会话令牌之前返回给客户端的第二步验证挑战
+type LoginChallenge struct {
+	ChallengeToken     string `json:"challengeToken"`
+	Expires            int64  `json:"expires"`            // 过期时间（毫秒时间戳）
+	EnrollmentRequired bool   `json:"enrollmentRequired"` // 用户组强制两步验证但用户尚未绑定，需要先完成绑定
+}
+
+// TwoFactorService 定义了 TOTP 两步验证相关的业务逻辑接口
+type TwoFactorService interface {
+	Status(ctx context.Context, user *model.User) (*TwoFactorStatus, error)
+	// BeginEnrollment 生成新的密钥，等待用户用验证码确认后才会生效
+	BeginEnrollment(ctx context.Context, user *model.User) (*TwoFactorEnrollment, error)
+	// ConfirmEnrollment 校验验证码并启用两步验证，返回一次性的恢复码明文
+	ConfirmEnrollment(ctx context.Context, userID uint, code string) ([]string, error)
+	Disable(ctx context.Context, user *model.User, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error)
+
+	// NewLoginChallenge 在密码验证通过后调用，用户无需两步验证时返回 nil
+	NewLoginChallenge(ctx context.Context, user *model.User) (*LoginChallenge, error)
+	// BeginChallengeEnrollment 供被强制要求两步验证的用户在登录过程中绑定验证器
+	BeginChallengeEnrollment(ctx context.Context, challengeToken string) (*TwoFactorEnrollment, error)
+	// CompleteLogin 校验挑战令牌和验证码（或恢复码），通过后返回用户；若本次同时完成了绑定，还会返回恢复码
+	CompleteLogin(ctx context.Context, challengeToken, code string) (*model.User, []string, error)
+
+	// AdminReset 管理员清除用户的两步验证配置（例如用户丢失了验证器和恢复码）
+	AdminReset(ctx context.Context, userID uint) error
+}
+
+type twoFactorService struct {
+	repo       repository.UserTwoFactorRepository
+	userRepo   repository.UserRepository
+	tokenSvc   TokenService
+	settingSvc setting.SettingService
+	cacheSvc   utility.CacheService
+}
+
+// NewTwoFactorService 是 twoFactorService 的构造函数
+func NewTwoFactorService(
+	repo repository.UserTwoFactorRepository,
+	userRepo repository.UserRepository,
+	tokenSvc TokenService,
+	settingSvc setting.SettingService,
+	cacheSvc utility.CacheService,
+) TwoFactorService {
+	return &twoFactorService{
+		repo:       repo,
+		userRepo:   userRepo,
+		tokenSvc:   tokenSvc,
+		settingSvc: settingSvc,
+		cacheSvc:   cacheSvc,
+	}
+}
+
+// Status 实现 TwoFactorService 接口
+func (s *twoFactorService) Status(ctx context.Context, user *model.User) (*TwoFactorStatus, error) {
+	tf, err := s.repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查询两步验证配置失败: %w", err)
+	}
+	status := &TwoFactorStatus{Required: user.UserGroup.Settings.RequireTwoFactor}
+	if tf != nil && tf.Enabled {
+		status.Enabled = true
+		status.RecoveryCodesRemaining = len(tf.RecoveryCodes)
+	}
+	return status, nil
+}
+
+// BeginEnrollment 实现 TwoFactorService 接口
+func (s *twoFactorService) BeginEnrollment(ctx context.Context, user *model.User) (*TwoFactorEnrollment, error) {
+	tf, err := s.repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查询两步验证配置失败: %w", err)
+	}
+	if tf != nil && tf.Enabled {
+		return nil, constant.ErrTwoFactorAlreadyEnabled
+	}
+	if tf == nil {
+		tf = &model.UserTwoFactor{UserID: user.ID}
+	}
+
+	secret, err := security.GenerateTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("生成两步验证密钥失败: %w", err)
+	}
+	tf.Secret = secret
+	tf.LastUsedStep = 0
+	if err := s.repo.Save(ctx, tf); err != nil {
+		return nil, fmt.Errorf("保存两步验证配置失败: %w", err)
+	}
+
+	account := user.Email
+	if account == "" {
+		account = user.Username
+	}
+	issuer := s.settingSvc.Get(constant.KeyAppName.String())
+	if issuer == "" {
+		issuer = "Anheyu"
+	}
+	return &TwoFactorEnrollment{
+		Secret:     secret,
+		OTPAuthURI: security.TOTPProvisioningURI(issuer, account, secret),
+	}, nil
+}
+
+// ConfirmEnrollment 实现 TwoFactorService 接口
+func (s *twoFactorService) ConfirmEnrollment(ctx context.Context, userID uint, code string) ([]string, error) {
+	tf, err := s.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询两步验证配置失败: %w", err)
+	}
+	if tf == nil {
+		return nil, constant.ErrTwoFactorNotEnabled
+	}
+	if tf.Enabled {
+		return nil, constant.ErrTwoFactorAlreadyEnabled
+	}
+	return s.enable(ctx, tf, code)
+}
+
+// enable 用验证码确认绑定，启用两步验证并生成恢复码
+func (s *twoFactorService) enable(ctx context.Context, tf *model.UserTwoFactor, code string) ([]string, error) {
+	step, ok := security.ValidateTOTP(tf.Secret, code, time.Now())
+	if !ok {
+		return nil, constant.ErrTwoFactorCodeInvalid
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("生成恢复码失败: %w", err)
+	}
+	now := time.Now()
+	tf.Enabled = true
+	tf.EnabledAt = &now
+	tf.LastUsedStep = step
+	tf.RecoveryCodes = hashes
+	if err := s.repo.Save(ctx, tf); err != nil {
+		return nil, fmt.Errorf("保存两步验证配置失败: %w", err)
+	}
+	log.Printf("[TwoFactor] 用户 %d 已启用两步验证", tf.UserID)
+	return codes, nil
+}
+
+// Disable 实现 TwoFactorService 接口
+func (s *twoFactorService) Disable(ctx context.Context, user *model.User, code string) error {
+	tf, err := s.repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("查询两步验证配置失败: %w", err)
+	}
+	if tf == nil || !tf.Enabled {
+		return constant.ErrTwoFactorNotEnabled
+	}
+	if user.UserGroup.Settings.RequireTwoFactor {
+		return constant.ErrTwoFactorEnforced
+	}
+	if err := s.verifyCode(ctx, tf, code, true); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteByUserID(ctx, user.ID); err != nil {
+		return fmt.Errorf("关闭两步验证失败: %w", err)
+	}
+	log.Printf("[TwoFactor] 用户 %d 已关闭两步验证", user.ID)
+	return nil
+}
+
+// RegenerateRecoveryCodes 实现 TwoFactorService 接口。为避免恢复码被用来续期恢复码，只接受验证器生成的验证码。
+func (s *twoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error) {
+	tf, err := s.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询两步验证配置失败: %w", err)
+	}
+	if tf == nil || !tf.Enabled {
+		return nil, constant.ErrTwoFactorNotEnabled
+	}
+	if err := s.verifyCode(ctx, tf, code, false); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("生成恢复码失败: %w", err)
+	}
+	tf.RecoveryCodes = hashes
+	if err := s.repo.Save(ctx, tf); err != nil {
+		return nil, fmt.Errorf("保存恢复码失败: %w", err)
+	}
+	return codes, nil
+}
+
+// NewLoginChallenge 实现 TwoFactorService 接口
+func (s *twoFactorService) NewLoginChallenge(ctx context.Context, user *model.User) (*LoginChallenge, error) {
+	tf, err := s.repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查询两步验证配置失败: %w", err)
+	}
+	enabled := tf != nil && tf.Enabled
+	required := user.UserGroup.Settings.RequireTwoFactor
+	if !enabled && !required {
+		return nil, nil
+	}
+
+	publicUserID, err := idgen.GeneratePublicID(user.ID, idgen.EntityTypeUser)
+	if err != nil {
+		return nil, fmt.Errorf("生成用户公共ID失败: %w", err)
+	}
+	sign, err := s.tokenSvc.GenerateSignedToken(loginChallengeIdentifier(publicUserID), loginChallengeTTL)
+	if err != nil {
+		return nil, fmt.Errorf("生成登录挑战令牌失败: %w", err)
+	}
+	return &LoginChallenge{
+		ChallengeToken:     publicUserID + ":" + sign,
+		Expires:            time.Now().Add(loginChallengeTTL).UnixMilli(),
+		EnrollmentRequired: !enabled,
+	}, nil
+}
+
+// BeginChallengeEnrollment 实现 TwoFactorService 接口
+func (s *twoFactorService) BeginChallengeEnrollment(ctx context.Context, challengeToken string) (*TwoFactorEnrollment, error) {
+	user, err := s.parseChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+	if !user.UserGroup.Settings.RequireTwoFactor {
+		return nil, constant.ErrTwoFactorChallengeInvalid
+	}
+	return s.BeginEnrollment(ctx, user)
+}
+
+// CompleteLogin 实现 TwoFactorService 接口
+func (s *twoFactorService) CompleteLogin(ctx context.Context, challengeToken, code string) (*model.User, []string, error) {
+	user, err := s.parseChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkAttempts(ctx, user.ID); err != nil {
+		return nil, nil, err
+	}
+
+	tf, err := s.repo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询两步验证配置失败: %w", err)
+	}
+	if tf == nil {
+		return nil, nil, constant.ErrTwoFactorNotEnabled
+	}
+
+	var recoveryCodes []string
+	if tf.Enabled {
+		err = s.verifyCode(ctx, tf, code, true)
+	} else if user.UserGroup.Settings.RequireTwoFactor {
+		// 被强制启用两步验证的用户在登录过程中完成绑定
+		recoveryCodes, err = s.enable(ctx, tf, code)
+	} else {
+		err = constant.ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.clearAttempts(ctx, user.ID)
+	return user, recoveryCodes, nil
+}
+
+// AdminReset 实现 TwoFactorService 接口
+func (s *twoFactorService) AdminReset(ctx context.Context, userID uint) error {
+	if err := s.repo.DeleteByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("重置两步验证失败: %w", err)
+	}
+	s.clearAttempts(ctx, userID)
+	log.Printf("[TwoFactor] 管理员已重置用户 %d 的两步验证", userID)
+	return nil
+}
+
+// parseChallenge 校验登录挑战令牌，返回对应的用户
+func (s *twoFactorService) parseChallenge(ctx context.Context, challengeToken string) (*model.User, error) {
+	publicUserID, sign, ok := strings.Cut(challengeToken, ":")
+	if !ok {
+		return nil, constant.ErrTwoFactorChallengeInvalid
+	}
+	if err := s.tokenSvc.VerifySignedToken(loginChallengeIdentifier(publicUserID), sign); err != nil {
+		return nil, constant.ErrTwoFactorChallengeInvalid
+	}
+
+	userID, entityType, err := idgen.DecodePublicID(publicUserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		return nil, constant.ErrTwoFactorChallengeInvalid
+	}
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil || user.Status != model.UserStatusActive {
+		return nil, constant.ErrTwoFactorChallengeInvalid
+	}
+	return user, nil
+}
+
+// verifyCode 校验验证器生成的验证码，allowRecovery 为 true 时也接受恢复码（使用后即失效）
+func (s *twoFactorService) verifyCode(ctx context.Context, tf *model.UserTwoFactor, code string, allowRecovery bool) error {
+	if step, ok := security.ValidateTOTP(tf.Secret, code, time.Now()); ok {
+		if step <= tf.LastUsedStep {
+			return constant.ErrTwoFactorCodeInvalid
+		}
+		tf.LastUsedStep = step
+		if err := s.repo.Save(ctx, tf); err != nil {
+			return fmt.Errorf("保存两步验证配置失败: %w", err)
+		}
+		return nil
+	}
+
+	if allowRecovery {
+		hash := hashRecoveryCode(code)
+		for i, stored := range tf.RecoveryCodes {
+			if stored != hash {
+				continue
+			}
+			tf.RecoveryCodes = append(tf.RecoveryCodes[:i:i], tf.RecoveryCodes[i+1:]...)
+			if err := s.repo.Save(ctx, tf); err != nil {
+				return fmt.Errorf("保存两步验证配置失败: %w", err)
+			}
+			log.Printf("[TwoFactor] 用户 %d 使用了一个恢复码，剩余 %d 个", tf.UserID, len(tf.RecoveryCodes))
+			return nil
+		}
+	}
+	return constant.ErrTwoFactorCodeInvalid
+}
+
+// checkAttempts 限制登录第二步的失败次数，防止在挑战令牌有效期内暴力枚举验证码
+func (s *twoFactorService) checkAttempts(ctx context.Context, userID uint) error {
+	key := twoFactorAttemptsKey(userID)
+	count, err := s.cacheSvc.Increment(ctx, key)
+	if err != nil {
+		log.Printf("[TwoFactor] 警告：记录两步验证尝试次数失败: %v", err)
+		return nil
+	}
+	if count == 1 {
+		_ = s.cacheSvc.Expire(ctx, key, loginChallengeTTL)
+	}
+	if count > maxTwoFactorAttempts {
+		return constant.ErrTwoFactorTooManyAttempts
+	}
+	return nil
+}
+
+func (s *twoFactorService) clearAttempts(ctx context.Context, userID uint) {
+	_ = s.cacheSvc.Delete(ctx, twoFactorAttemptsKey(userID))
+}
+
+func twoFactorAttemptsKey(userID uint) string {
+	return fmt.Sprintf("auth:2fa_attempts:%d", userID)
+}
+
+// loginChallengeIdentifier 返回签名登录挑战令牌时使用的标识符，加上前缀避免与其它签名令牌相互冒用
+func loginChallengeIdentifier(publicUserID string) string {
+	return "2fa_login:" + publicUserID
+}
+
+// generateRecoveryCodes 生成一组恢复码，返回明文（仅展示一次）和用于存储的哈希
+func generateRecoveryCodes() ([]string, []string, error) {
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		buf := make([]byte, 5)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(encoding.EncodeToString(buf)) // 8 个字符
+		codes[i] = raw[:4] + "-" + raw[4:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode 规范化恢复码（忽略大小写、空格和连字符）后计算哈希
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,277 @@
+// pkg/service/blocklist/service.go
+package blocklist
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// ruleCacheTTL 有效规则在内存中的缓存时长。
+// 本实例的增删改会立即刷新缓存，其它实例最多延迟该时长生效。
+const ruleCacheTTL = time.Minute
+
+// Subject 描述一次需要进行黑名单检查的访问者信息，未知的字段留空即可
+type Subject struct {
+	IP        string
+	Email     string
+	UserAgent string
+	// AccountEmail 和 UserID 是已登录用户账户的邮箱和公共ID，与请求中填写的邮箱分别检查
+	AccountEmail string
+	UserID       string
+}
+
+// Service 定义了黑名单的业务接口
+type Service interface {
+	// Check 检查访问者是否命中黑名单，命中时返回 constant.ErrBlocked
+	Check(ctx context.Context, subject Subject) error
+
+	List(ctx context.Context, query *model.BlockRuleListQuery) (*repository.PageResult[model.BlockRule], error)
+	Create(ctx context.Context, req *model.SaveBlockRuleRequest) (*model.BlockRule, error)
+	Update(ctx context.Context, id uint, req *model.SaveBlockRuleRequest) (*model.BlockRule, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// compiledRule 是预处理后的规则，避免每次检查时重复解析 CIDR 和正则
+type compiledRule struct {
+	rule  *model.BlockRule
+	ip    net.IP
+	ipNet *net.IPNet
+	uaRe  *regexp.Regexp
+}
+
+type service struct {
+	repo repository.BlockRuleRepository
+
+	mu       sync.RWMutex
+	rules    []*compiledRule
+	loadedAt time.Time
+}
+
+// NewService 创建黑名单服务实例
+func NewService(repo repository.BlockRuleRepository) Service {
+	return &service{repo: repo}
+}
+
+// Check 实现 Service 接口
+func (s *service) Check(ctx context.Context, subject Subject) error {
+	rules, err := s.activeRules(ctx)
+	if err != nil {
+		// 黑名单读取失败时放行，避免影响正常用户
+		log.Printf("[Blocklist.Check] 加载黑名单规则失败，跳过检查: %v", err)
+		return nil
+	}
+
+	ip := net.ParseIP(strings.TrimSpace(subject.IP))
+	emails := make([]string, 0, 2)
+	for _, e := range []string{subject.Email, subject.AccountEmail} {
+		if e = strings.ToLower(strings.TrimSpace(e)); e != "" {
+			emails = append(emails, e)
+		}
+	}
+	userID := strings.TrimSpace(subject.UserID)
+
+	now := time.Now()
+	for _, cr := range rules {
+		if cr.rule.IsExpired(now) || !cr.matchesAny(ip, emails, subject.UserAgent, userID) {
+			continue
+		}
+		log.Printf("[Blocklist.Check] 命中黑名单规则 #%d (%s: %s)，原因: %s", cr.rule.ID, cr.rule.Type, cr.rule.Value, cr.rule.Reason)
+		return constant.ErrBlocked
+	}
+	return nil
+}
+
+// matchesAny 判断规则是否命中访问者的任意一个邮箱或其他信息
+func (cr *compiledRule) matchesAny(ip net.IP, emails []string, userAgent, userID string) bool {
+	if len(emails) == 0 {
+		return cr.matches(ip, "", "", userAgent, userID)
+	}
+	for _, email := range emails {
+		emailMD5 := fmt.Sprintf("%x", md5.Sum([]byte(email)))
+		if cr.matches(ip, email, emailMD5, userAgent, userID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (cr *compiledRule) matches(ip net.IP, email, emailMD5, userAgent, userID string) bool {
+	switch cr.rule.Type {
+	case model.BlockRuleTypeIP:
+		if ip == nil {
+			return false
+		}
+		if cr.ipNet != nil {
+			return cr.ipNet.Contains(ip)
+		}
+		return cr.ip != nil && cr.ip.Equal(ip)
+	case model.BlockRuleTypeEmail:
+		return email != "" && strings.EqualFold(cr.rule.Value, email)
+	case model.BlockRuleTypeEmailMD5:
+		return emailMD5 != "" && strings.EqualFold(cr.rule.Value, emailMD5)
+	case model.BlockRuleTypeUserAgent:
+		return userAgent != "" && cr.uaRe != nil && cr.uaRe.MatchString(userAgent)
+	case model.BlockRuleTypeUser:
+		return userID != "" && cr.rule.Value == userID
+	}
+	return false
+}
+
+// activeRules 返回缓存中的有效规则，缓存过期时重新从数据库加载
+func (s *service) activeRules(ctx context.Context) ([]*compiledRule, error) {
+	s.mu.RLock()
+	if time.Since(s.loadedAt) < ruleCacheTTL {
+		rules := s.rules
+		s.mu.RUnlock()
+		return rules, nil
+	}
+	s.mu.RUnlock()
+
+	rules, err := s.repo.FindActive(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	compiled := make([]*compiledRule, 0, len(rules))
+	for _, rule := range rules {
+		cr, err := compileRule(rule)
+		if err != nil {
+			log.Printf("[Blocklist] 忽略无效的黑名单规则 #%d: %v", rule.ID, err)
+			continue
+		}
+		compiled = append(compiled, cr)
+	}
+
+	s.mu.Lock()
+	s.rules = compiled
+	s.loadedAt = time.Now()
+	s.mu.Unlock()
+	return compiled, nil
+}
+
+// invalidate 使规则缓存失效，下一次检查时重新加载
+func (s *service) invalidate() {
+	s.mu.Lock()
+	s.loadedAt = time.Time{}
+	s.mu.Unlock()
+}
+
+func compileRule(rule *model.BlockRule) (*compiledRule, error) {
+	cr := &compiledRule{rule: rule}
+	switch rule.Type {
+	case model.BlockRuleTypeIP:
+		if strings.Contains(rule.Value, "/") {
+			_, ipNet, err := net.ParseCIDR(rule.Value)
+			if err != nil {
+				return nil, fmt.Errorf("无效的CIDR网段: %s", rule.Value)
+			}
+			cr.ipNet = ipNet
+		} else if cr.ip = net.ParseIP(rule.Value); cr.ip == nil {
+			return nil, fmt.Errorf("无效的IP地址: %s", rule.Value)
+		}
+	case model.BlockRuleTypeUserAgent:
+		re, err := regexp.Compile(rule.Value)
+		if err != nil {
+			return nil, fmt.Errorf("无效的UA正则表达式: %w", err)
+		}
+		cr.uaRe = re
+	case model.BlockRuleTypeEmail, model.BlockRuleTypeEmailMD5, model.BlockRuleTypeUser:
+	default:
+		return nil, fmt.Errorf("未知的规则类型: %s", rule.Type)
+	}
+	return cr, nil
+}
+
+// List 实现 Service 接口
+func (s *service) List(ctx context.Context, query *model.BlockRuleListQuery) (*repository.PageResult[model.BlockRule], error) {
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.PageSize < 1 || query.PageSize > 100 {
+		query.PageSize = 20
+	}
+	return s.repo.List(ctx, query)
+}
+
+// Create 实现 Service 接口
+func (s *service) Create(ctx context.Context, req *model.SaveBlockRuleRequest) (*model.BlockRule, error) {
+	rule, err := buildRule(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, rule); err != nil {
+		return nil, fmt.Errorf("创建黑名单规则失败: %w", err)
+	}
+	s.invalidate()
+	return rule, nil
+}
+
+// Update 实现 Service 接口
+func (s *service) Update(ctx context.Context, id uint, req *model.SaveBlockRuleRequest) (*model.BlockRule, error) {
+	existing, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := buildRule(req)
+	if err != nil {
+		return nil, err
+	}
+	rule.ID = existing.ID
+	rule.CreatedAt = existing.CreatedAt
+	if err := s.repo.Update(ctx, rule); err != nil {
+		return nil, fmt.Errorf("更新黑名单规则失败: %w", err)
+	}
+	s.invalidate()
+	return rule, nil
+}
+
+// Delete 实现 Service 接口
+func (s *service) Delete(ctx context.Context, id uint) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.invalidate()
+	return nil
+}
+
+// buildRule 规范化并校验请求中的规则内容
+func buildRule(req *model.SaveBlockRuleRequest) (*model.BlockRule, error) {
+	value := strings.TrimSpace(req.Value)
+	if value == "" {
+		return nil, errors.New("规则内容不能为空")
+	}
+	if req.Type == model.BlockRuleTypeEmail || req.Type == model.BlockRuleTypeEmailMD5 {
+		value = strings.ToLower(value)
+	}
+	if req.Type == model.BlockRuleTypeUser {
+		if _, entityType, err := idgen.DecodePublicID(value); err != nil || entityType != idgen.EntityTypeUser {
+			return nil, fmt.Errorf("无效的用户ID: %s", value)
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, errors.New("过期时间必须晚于当前时间")
+	}
+
+	rule := &model.BlockRule{
+		Type:      req.Type,
+		Value:     value,
+		Reason:    strings.TrimSpace(req.Reason),
+		ExpiresAt: req.ExpiresAt,
+	}
+	if _, err := compileRule(rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
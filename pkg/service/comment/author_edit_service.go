@@ -0,0 +1,147 @@
+// anheyu-app/pkg/service/comment/author_edit_service.go
+package comment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/blocklist"
+)
+
+// editTokenIdentifier 返回签名编辑令牌时使用的标识符。
+// 加上固定前缀，避免与其它基于公共ID的签名令牌（如密码重置）相互冒用。
+func editTokenIdentifier(publicID string) string {
+	return "comment_edit:" + publicID
+}
+
+// authorEditWindow 读取评论者自助编辑的时间窗口，返回 0 表示功能关闭。
+func (s *Service) authorEditWindow() time.Duration {
+	minutes, err := strconv.Atoi(s.settingSvc.Get(constant.KeyCommentAuthorEditWindow.String()))
+	if err != nil || minutes <= 0 {
+		return 0
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// attachEditToken 为刚创建的评论签发编辑令牌，令牌有效期与编辑窗口一致。
+// 签发失败不影响评论本身的创建，只是作者无法自助编辑。
+func (s *Service) attachEditToken(resp *dto.Response, c *model.Comment) {
+	if resp == nil || c == nil || s.tokenSvc == nil {
+		return
+	}
+	window := s.authorEditWindow()
+	if window == 0 {
+		return
+	}
+
+	editableUntil := c.CreatedAt.Add(window)
+	token, err := s.tokenSvc.GenerateSignedToken(editTokenIdentifier(resp.ID), time.Until(editableUntil))
+	if err != nil {
+		log.Printf("[Comment.attachEditToken] 为评论 %s 生成编辑令牌失败: %v", resp.ID, err)
+		return
+	}
+	resp.EditToken = &token
+	resp.EditableUntil = &editableUntil
+}
+
+// authorizeAuthorEdit 校验编辑令牌与编辑窗口，通过后返回对应的评论。
+func (s *Service) authorizeAuthorEdit(ctx context.Context, publicID, editToken string) (*model.Comment, error) {
+	window := s.authorEditWindow()
+	if window == 0 || s.tokenSvc == nil {
+		return nil, constant.ErrCommentAuthorEditDisabled
+	}
+
+	dbID, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || entityType != idgen.EntityTypeComment {
+		return nil, errors.New("无效的评论ID")
+	}
+
+	if err := s.tokenSvc.VerifySignedToken(editTokenIdentifier(publicID), editToken); err != nil {
+		log.Printf("[Comment.authorizeAuthorEdit] 评论 %s 的编辑令牌校验失败: %v", publicID, err)
+		return nil, constant.ErrCommentEditTokenInvalid
+	}
+
+	comment, err := s.repo.FindByID(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 令牌自带过期时间，这里再以评论创建时间为准校验一次，管理员缩短窗口后可立即生效
+	if time.Now().After(comment.CreatedAt.Add(window)) {
+		return nil, constant.ErrCommentEditWindowExpired
+	}
+	return comment, nil
+}
+
+// AuthorUpdate 评论作者凭编辑令牌在时间窗口内修改自己的评论。
+// 修改后的内容会重新经过 Markdown 安全处理和违禁词检测，编辑前的版本会被记录到编辑历史中。
+func (s *Service) AuthorUpdate(ctx context.Context, publicID string, req *dto.AuthorUpdateRequest, ip, ua, referer string) (*dto.Response, error) {
+	comment, err := s.authorizeAuthorEdit(ctx, publicID, req.EditToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var email string
+	if comment.Author.Email != nil {
+		email = *comment.Author.Email
+	}
+	if err := s.blocklistSvc.Check(ctx, blocklist.Subject{IP: ip, Email: email, UserAgent: ua}); err != nil {
+		return nil, err
+	}
+
+	contentHTML, err := s.parserSvc.ToHTML(ctx, req.Content)
+	if err != nil {
+		return nil, fmt.Errorf("markdown内容解析失败: %w", err)
+	}
+
+	status, err := s.moderateContent(req.Content, referer)
+	if err != nil {
+		return nil, err
+	}
+	// 原本处于待审核的评论不能通过自助编辑绕过审核
+	if comment.Status == model.StatusPending {
+		status = model.StatusPending
+	}
+
+	updatedComment, err := s.repo.UpdateByAuthor(ctx, comment.ID, &repository.AuthorUpdateCommentParams{
+		Content:     req.Content,
+		ContentHTML: contentHTML,
+		Status:      int(status),
+		History: model.CommentEditRecord{
+			Content:   comment.Content,
+			EditedAt:  time.Now(),
+			IP:        ip,
+			UserAgent: ua,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("更新评论失败: %w", err)
+	}
+	if updatedComment.Status != comment.Status {
+		s.publishCommentEvent(event.CommentStatusChanged, updatedComment)
+	}
+
+	return s.toResponseDTO(ctx, updatedComment, nil, nil, false), nil
+}
+
+// AuthorDelete 评论作者凭编辑令牌在时间窗口内（软）删除自己的评论。
+func (s *Service) AuthorDelete(ctx context.Context, publicID, editToken string) error {
+	comment, err := s.authorizeAuthorEdit(ctx, publicID, editToken)
+	if err != nil {
+		return err
+	}
+	if _, err := s.repo.DeleteByIDs(ctx, []uint{comment.ID}); err != nil {
+		return fmt.Errorf("删除评论失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+// anheyu-app/pkg/service/comment/privacy_service.go
+package comment
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// AnonymizedNickname 是匿名化之后评论显示的昵称
+const AnonymizedNickname = "已注销用户"
+
+// AuthorFilterByEmail 根据邮箱或邮箱 MD5 构造作者条件。
+// 提供邮箱时同时按其 MD5 匹配，以覆盖邮箱字段已被修改但头像哈希仍然相同的评论。
+func AuthorFilterByEmail(email, emailMD5 string) repository.CommentAuthorFilter {
+	email = strings.ToLower(strings.TrimSpace(email))
+	emailMD5 = strings.ToLower(strings.TrimSpace(emailMD5))
+	if email != "" && emailMD5 == "" {
+		emailMD5 = fmt.Sprintf("%x", md5.Sum([]byte(email)))
+	}
+	return repository.CommentAuthorFilter{Email: email, EmailMD5: emailMD5}
+}
+
+// ExportCommentsByAuthor 导出符合作者条件的全部评论，格式与评论导出功能一致
+func (s *Service) ExportCommentsByAuthor(ctx context.Context, filter repository.CommentAuthorFilter) (*ExportCommentData, error) {
+	if filter.IsEmpty() {
+		return nil, fmt.Errorf("必须提供邮箱或邮箱MD5")
+	}
+	comments, err := s.repo.FindByAuthor(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("获取评论失败: %w", err)
+	}
+
+	exportData := &ExportCommentData{
+		Version:  "1.0",
+		ExportAt: time.Now(),
+		Comments: make([]ExportCommentItem, 0, len(comments)),
+		Meta: map[string]interface{}{
+			"total_comments": len(comments),
+			"export_by":      "anheyu-app",
+		},
+	}
+	for _, c := range comments {
+		exportData.Comments = append(exportData.Comments, toExportCommentItem(c))
+	}
+	return exportData, nil
+}
+
+// ExportCommentsByAuthorToZip 导出符合作者条件的全部评论并打包为 ZIP
+func (s *Service) ExportCommentsByAuthorToZip(ctx context.Context, filter repository.CommentAuthorFilter) ([]byte, error) {
+	exportData, err := s.ExportCommentsByAuthor(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	jsonData, err := json.MarshalIndent(exportData, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("序列化 JSON 失败: %w", err)
+	}
+
+	buf := new(bytes.Buffer)
+	zipWriter := zip.NewWriter(buf)
+	jsonFile, err := zipWriter.Create("comments.json")
+	if err != nil {
+		return nil, fmt.Errorf("创建 ZIP 文件失败: %w", err)
+	}
+	if _, err := jsonFile.Write(jsonData); err != nil {
+		return nil, fmt.Errorf("写入 JSON 数据失败: %w", err)
+	}
+	if err := zipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("关闭 ZIP 文件失败: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// EraseCommentsByAuthor 清除符合作者条件的全部评论的内容和个人信息并删除
+func (s *Service) EraseCommentsByAuthor(ctx context.Context, filter repository.CommentAuthorFilter) (int, error) {
+	if filter.IsEmpty() {
+		return 0, fmt.Errorf("必须提供邮箱或邮箱MD5")
+	}
+	erased, err := s.repo.EraseByAuthor(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("清除评论失败: %w", err)
+	}
+	log.Printf("[评论] 已按作者条件清除 %d 条评论", erased)
+	return erased, nil
+}
+
+// AnonymizeUserComments 解除用户与其评论的关联并清除个人信息，评论内容保留
+func (s *Service) AnonymizeUserComments(ctx context.Context, userID uint) (int, error) {
+	count, err := s.repo.AnonymizeByUser(ctx, userID, AnonymizedNickname)
+	if err != nil {
+		return 0, fmt.Errorf("匿名化用户评论失败: %w", err)
+	}
+	return count, nil
+}
+
+// toExportCommentItem 将评论转换为导出格式
+func toExportCommentItem(comment *model.Comment) ExportCommentItem {
+	publicID, _ := idgen.GeneratePublicID(comment.ID, idgen.EntityTypeComment)
+
+	var parentPublicID, replyToPublicID string
+	if comment.ParentID != nil {
+		parentPublicID, _ = idgen.GeneratePublicID(*comment.ParentID, idgen.EntityTypeComment)
+	}
+	if comment.ReplyToID != nil {
+		replyToPublicID, _ = idgen.GeneratePublicID(*comment.ReplyToID, idgen.EntityTypeComment)
+	}
+
+	var pinnedAtStr *string
+	if comment.PinnedAt != nil {
+		s := comment.PinnedAt.Format(time.RFC3339)
+		pinnedAtStr = &s
+	}
+
+	var email, website, targetTitle string
+	if comment.Author.Email != nil {
+		email = *comment.Author.Email
+	}
+	if comment.Author.Website != nil {
+		website = *comment.Author.Website
+	}
+	if comment.TargetTitle != nil {
+		targetTitle = *comment.TargetTitle
+	}
+
+	return ExportCommentItem{
+		ID:             publicID,
+		CreatedAt:      comment.CreatedAt,
+		UpdatedAt:      comment.UpdatedAt,
+		PinnedAt:       pinnedAtStr,
+		Content:        comment.Content,
+		ContentHTML:    comment.ContentHTML,
+		TargetPath:     comment.TargetPath,
+		TargetTitle:    targetTitle,
+		Nickname:       comment.Author.Nickname,
+		Email:          email,
+		Website:        website,
+		IPAddress:      comment.Author.IP,
+		IPLocation:     comment.Author.Location,
+		UserAgent:      comment.Author.UserAgent,
+		ParentID:       parentPublicID,
+		ReplyToID:      replyToPublicID,
+		Status:         int(comment.Status),
+		IsAdminComment: comment.IsAdminAuthor,
+		IsAnonymous:    comment.IsAnonymous,
+		LikeCount:      comment.LikeCount,
+	}
+}
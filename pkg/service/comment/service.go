@@ -20,11 +20,14 @@ import (
 
 	"github.com/anzhiyu-c/anheyu-app/internal/app/task"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
 	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	authsvc "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/blocklist"
 	filesvc "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/notification"
 	"github.com/anzhiyu-c/anheyu-app/pkg/service/parser"
@@ -76,6 +79,12 @@ type Service struct {
 	parserSvc                 *parser.Service
 	pushooSvc                 utility.PushooService
 	notificationSvc           notification.Service
+	tokenSvc                  authsvc.TokenService
+	eventBus                  *event.EventBus
+	blocklistSvc              blocklist.Service
+	threadRepo                repository.CommentThreadRepository
+	articleRepo               repository.ArticleRepository
+	pageRepo                  repository.PageRepository
 	inAppNotificationCallback InAppNotificationCallback // PRO版可注入的站内通知回调
 }
 
@@ -92,6 +101,12 @@ func NewService(
 	parserSvc *parser.Service,
 	pushooSvc utility.PushooService,
 	notificationSvc notification.Service,
+	tokenSvc authsvc.TokenService,
+	eventBus *event.EventBus,
+	blocklistSvc blocklist.Service,
+	threadRepo repository.CommentThreadRepository,
+	articleRepo repository.ArticleRepository,
+	pageRepo repository.PageRepository,
 ) *Service {
 	return &Service{
 		repo:            repo,
@@ -105,9 +120,34 @@ func NewService(
 		parserSvc:       parserSvc,
 		pushooSvc:       pushooSvc,
 		notificationSvc: notificationSvc,
+		tokenSvc:        tokenSvc,
+		eventBus:        eventBus,
+		blocklistSvc:    blocklistSvc,
+		threadRepo:      threadRepo,
+		articleRepo:     articleRepo,
+		pageRepo:        pageRepo,
 	}
 }
 
+// CommentEventPayload 评论事件载荷
+type CommentEventPayload struct {
+	CommentID  uint         `json:"comment_id"`
+	TargetPath string       `json:"target_path"`
+	Status     model.Status `json:"status"`
+}
+
+// publishCommentEvent 在事件总线上发布评论事件，供实时推送等订阅者使用。
+func (s *Service) publishCommentEvent(topic event.Topic, c *model.Comment) {
+	if s.eventBus == nil || c == nil {
+		return
+	}
+	s.eventBus.Publish(topic, CommentEventPayload{
+		CommentID:  c.ID,
+		TargetPath: c.TargetPath,
+		Status:     c.Status,
+	})
+}
+
 // SetInAppNotificationCallback 设置站内通知回调（供PRO版使用）
 func (s *Service) SetInAppNotificationCallback(callback InAppNotificationCallback) {
 	s.inAppNotificationCallback = callback
@@ -204,7 +244,58 @@ func (s *Service) ListLatest(ctx context.Context, page, pageSize int) (*dto.List
 	}, nil
 }
 
+// fillAuthorFromUser 使用当前登录用户的昵称和邮箱补全评论请求中未填写的字段
+func (s *Service) fillAuthorFromUser(ctx context.Context, req *dto.CreateRequest, claims *auth.CustomClaims) {
+	if req.Nickname != "" && req.Email != nil && *req.Email != "" {
+		return
+	}
+	userDBID, entityType, err := idgen.DecodePublicID(claims.UserID)
+	if err != nil || entityType != idgen.EntityTypeUser {
+		return
+	}
+	user, err := s.userRepo.FindByID(ctx, userDBID)
+	if err != nil || user == nil {
+		return
+	}
+	if req.Nickname == "" {
+		req.Nickname = user.Nickname
+	}
+	if req.Email == nil || *req.Email == "" {
+		email := user.Email
+		req.Email = &email
+	}
+}
+
 func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, referer string, claims *auth.CustomClaims) (*dto.Response, error) {
+	// 已登录（包括通过第三方登录）的用户可以省略昵称和邮箱，由账户资料补全
+	if claims != nil && !req.IsAnonymous {
+		s.fillAuthorFromUser(ctx, req, claims)
+	}
+	if strings.TrimSpace(req.Nickname) == "" {
+		return nil, errors.New("昵称不能为空")
+	}
+
+	var email string
+	if req.Email != nil {
+		email = *req.Email
+	}
+	subject := blocklist.Subject{IP: ip, Email: email, UserAgent: ua}
+	// 已登录用户填写其他邮箱或匿名评论时，仍按账户本身检查黑名单
+	if claims != nil {
+		subject.UserID = claims.UserID
+		if userDBID, entityType, err := idgen.DecodePublicID(claims.UserID); err == nil && entityType == idgen.EntityTypeUser {
+			if user, err := s.userRepo.FindByID(ctx, userDBID); err == nil && user != nil {
+				subject.AccountEmail = user.Email
+			}
+		}
+	}
+	if err := s.blocklistSvc.Check(ctx, subject); err != nil {
+		return nil, err
+	}
+	if !s.ThreadState(ctx, req.TargetPath).AcceptsComments() {
+		return nil, constant.ErrCommentThreadClosed
+	}
+
 	limitStr := s.settingSvc.Get(constant.KeyCommentLimitPerMinute.String())
 	limit, err := strconv.Atoi(limitStr)
 	if err == nil && limit > 0 {
@@ -280,40 +371,9 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 			ipLocation = location
 		}
 	}
-	status := model.StatusPublished
-	forbiddenWords := s.settingSvc.Get(constant.KeyCommentForbiddenWords.String())
-	if forbiddenWords != "" {
-		for _, word := range strings.Split(forbiddenWords, ",") {
-			trimmedWord := strings.TrimSpace(word)
-			if trimmedWord != "" && strings.Contains(req.Content, trimmedWord) {
-				status = model.StatusPending
-				break
-			}
-		}
-	}
-
-	// AI 违禁词检测
-	if status == model.StatusPublished {
-		aiDetectEnable := s.settingSvc.GetBool(constant.KeyCommentAIDetectEnable.String())
-		if aiDetectEnable {
-			aiDetectAPIURL := s.settingSvc.Get(constant.KeyCommentAIDetectAPIURL.String())
-			aiDetectAction := s.settingSvc.Get(constant.KeyCommentAIDetectAction.String())
-			aiDetectRiskLevel := s.settingSvc.Get(constant.KeyCommentAIDetectRiskLevel.String())
-
-			if aiDetectAPIURL != "" {
-				isViolation, riskLevel, err := s.checkAIForbiddenWords(req.Content, aiDetectAPIURL, referer)
-				if err != nil {
-					log.Printf("AI违禁词检测API调用失败: %v，跳过检测", err)
-				} else if isViolation && shouldTakeAction(riskLevel, aiDetectRiskLevel) {
-					if aiDetectAction == "reject" {
-						return nil, fmt.Errorf("评论内容包含违规内容，请修改后重新提交")
-					}
-					// 默认为 pending
-					status = model.StatusPending
-					log.Printf("AI违禁词检测：评论内容包含违规内容，风险等级: %s，已设置为待审核", riskLevel)
-				}
-			}
-		}
+	status, err := s.moderateContent(req.Content, referer)
+	if err != nil {
+		return nil, err
 	}
 	var isAdmin bool
 	var userID *uint
@@ -388,6 +448,7 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 	if err != nil {
 		return nil, fmt.Errorf("保存评论失败: %w", err)
 	}
+	s.publishCommentEvent(event.CommentCreated, newComment)
 
 	if newComment.IsPublished() {
 		log.Printf("[DEBUG] 评论已发布，开始处理通知逻辑，评论ID: %d", newComment.ID)
@@ -590,11 +651,65 @@ func (s *Service) Create(ctx context.Context, req *dto.CreateRequest, ip, ua, re
 		log.Printf("[DEBUG] 评论未发布，跳过所有通知逻辑")
 	}
 
-	return s.toResponseDTO(ctx, newComment, parentComment, replyToComment, false), nil
+	resp := s.toResponseDTO(ctx, newComment, parentComment, replyToComment, false)
+	s.attachEditToken(resp, newComment)
+	return resp, nil
+}
+
+// moderateContent 对评论内容执行违禁词与 AI 违禁词检测，返回评论应处于的状态。
+// 若 AI 检测配置为拒绝且命中违规内容，则返回错误。
+func (s *Service) moderateContent(content, referer string) (model.Status, error) {
+	status := model.StatusPublished
+	forbiddenWords := s.settingSvc.Get(constant.KeyCommentForbiddenWords.String())
+	if forbiddenWords != "" {
+		for _, word := range strings.Split(forbiddenWords, ",") {
+			trimmedWord := strings.TrimSpace(word)
+			if trimmedWord != "" && strings.Contains(content, trimmedWord) {
+				status = model.StatusPending
+				break
+			}
+		}
+	}
+
+	// AI 违禁词检测
+	if status == model.StatusPublished {
+		aiDetectEnable := s.settingSvc.GetBool(constant.KeyCommentAIDetectEnable.String())
+		if aiDetectEnable {
+			aiDetectAPIURL := s.settingSvc.Get(constant.KeyCommentAIDetectAPIURL.String())
+			aiDetectAction := s.settingSvc.Get(constant.KeyCommentAIDetectAction.String())
+			aiDetectRiskLevel := s.settingSvc.Get(constant.KeyCommentAIDetectRiskLevel.String())
+
+			if aiDetectAPIURL != "" {
+				isViolation, riskLevel, err := s.checkAIForbiddenWords(content, aiDetectAPIURL, referer)
+				if err != nil {
+					log.Printf("AI违禁词检测API调用失败: %v，跳过检测", err)
+				} else if isViolation && shouldTakeAction(riskLevel, aiDetectRiskLevel) {
+					if aiDetectAction == "reject" {
+						return status, fmt.Errorf("评论内容包含违规内容，请修改后重新提交")
+					}
+					// 默认为 pending
+					status = model.StatusPending
+					log.Printf("AI违禁词检测：评论内容包含违规内容，风险等级: %s，已设置为待审核", riskLevel)
+				}
+			}
+		}
+	}
+	return status, nil
 }
 
 // ListByPath
 func (s *Service) ListByPath(ctx context.Context, path string, page, pageSize int) (*dto.ListResponse, error) {
+	// 评论区关闭时不返回任何评论，只告知前端当前状态以便隐藏评论区
+	threadState := s.ThreadState(ctx, path)
+	if threadState == model.CommentThreadClosed {
+		return &dto.ListResponse{
+			List:        []*dto.Response{},
+			Page:        page,
+			PageSize:    pageSize,
+			ThreadState: threadState,
+		}, nil
+	}
+
 	// 1. 一次性获取该路径下的所有已发布评论
 	allComments, err := s.repo.FindAllPublishedByPath(ctx, path)
 	if err != nil {
@@ -664,6 +779,7 @@ func (s *Service) ListByPath(ctx context.Context, path string, page, pageSize in
 			TotalWithChildren: totalWithChildren,
 			Page:              page,
 			PageSize:          pageSize,
+			ThreadState:       threadState,
 		}, nil
 	}
 	if end > len(rootComments) {
@@ -763,6 +879,7 @@ func (s *Service) ListByPath(ctx context.Context, path string, page, pageSize in
 		TotalWithChildren: totalWithChildren,
 		Page:              page,
 		PageSize:          pageSize,
+		ThreadState:       threadState,
 	}, nil
 }
 
@@ -778,6 +895,9 @@ func (s *Service) ListChildren(ctx context.Context, parentPublicID string, page,
 	if err != nil {
 		return nil, fmt.Errorf("查找父评论失败: %w", err)
 	}
+	if s.ThreadState(ctx, parentComment.TargetPath) == model.CommentThreadClosed {
+		return &dto.ListResponse{List: []*dto.Response{}, Page: page, PageSize: pageSize}, nil
+	}
 
 	// 2. 获取该路径下的所有评论，以便构建完整的关系树
 	allComments, err := s.repo.FindAllPublishedByPath(ctx, parentComment.TargetPath)
@@ -1007,6 +1127,7 @@ func (s *Service) toResponseDTO(ctx context.Context, c *model.Comment, parent *m
 		ReplyToNick:    replyToNick,
 		LikeCount:      c.LikeCount,
 		Children:       []*dto.Response{},
+		EditedAt:       c.EditedAt,
 	}
 
 	if showUA {
@@ -1024,6 +1145,7 @@ func (s *Service) toResponseDTO(ctx context.Context, c *model.Comment, parent *m
 		resp.Content = &c.Content
 		status := int(c.Status)
 		resp.Status = &status
+		resp.EditHistory = c.EditHistory
 	}
 
 	return resp
@@ -1172,6 +1294,7 @@ func (s *Service) UpdateStatus(ctx context.Context, publicID string, status int)
 	if err != nil {
 		return nil, fmt.Errorf("更新评论状态失败: %w", err)
 	}
+	s.publishCommentEvent(event.CommentStatusChanged, updatedComment)
 	return s.toResponseDTO(ctx, updatedComment, nil, nil, true), nil
 }
 
@@ -1220,6 +1343,53 @@ func (s *Service) UpdateContent(ctx context.Context, publicID string, newContent
 	return s.toResponseDTO(ctx, updatedComment, nil, nil, true), nil
 }
 
+// BlockAuthor 根据评论中记录的作者信息，将其IP、邮箱或 User Agent 加入黑名单（仅限管理员）。
+func (s *Service) BlockAuthor(ctx context.Context, publicID string, req *dto.BlockAuthorRequest) ([]*model.BlockRule, error) {
+	dbID, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || entityType != idgen.EntityTypeComment {
+		return nil, errors.New("无效的评论ID")
+	}
+	c, err := s.repo.FindByID(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+	if c.IsAdminAuthor {
+		return nil, errors.New("不能拉黑管理员")
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("评论 %s", publicID)
+	}
+
+	var rules []*model.SaveBlockRuleRequest
+	if req.BlockIP && c.Author.IP != "" {
+		rules = append(rules, &model.SaveBlockRuleRequest{Type: model.BlockRuleTypeIP, Value: c.Author.IP})
+	}
+	// 匿名评论共用同一个匿名邮箱，拉黑邮箱会误伤所有匿名评论者
+	if req.BlockEmail && c.Author.Email != nil && *c.Author.Email != "" && !c.IsAnonymous {
+		rules = append(rules, &model.SaveBlockRuleRequest{Type: model.BlockRuleTypeEmail, Value: *c.Author.Email})
+	}
+	if req.BlockUserAgent && c.Author.UserAgent != "" {
+		rules = append(rules, &model.SaveBlockRuleRequest{Type: model.BlockRuleTypeUserAgent, Value: "^" + regexp.QuoteMeta(c.Author.UserAgent) + "$"})
+	}
+	if len(rules) == 0 {
+		return nil, errors.New("该评论没有可用于拉黑的作者信息")
+	}
+
+	created := make([]*model.BlockRule, 0, len(rules))
+	for _, r := range rules {
+		r.Reason = reason
+		r.ExpiresAt = req.ExpiresAt
+		rule, err := s.blocklistSvc.Create(ctx, r)
+		if err != nil {
+			return created, err
+		}
+		created = append(created, rule)
+	}
+	return created, nil
+}
+
 // UpdateCommentInfo 更新评论的用户信息和内容（仅限管理员）。
 func (s *Service) UpdateCommentInfo(ctx context.Context, publicID string, req *dto.UpdateCommentRequest) (*dto.Response, error) {
 	dbID, entityType, err := idgen.DecodePublicID(publicID)
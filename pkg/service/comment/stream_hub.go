@@ -0,0 +1,208 @@
+// anheyu-app/pkg/service/comment/stream_hub.go
+package comment
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/event"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/handler/comment/dto"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// StreamEventCreated 新评论事件
+	StreamEventCreated = "comment.created"
+	// StreamEventStatusChanged 评论状态变更事件
+	StreamEventStatusChanged = "comment.status_changed"
+
+	// streamRedisChannel 多实例部署时用于广播评论事件的 Redis 频道
+	streamRedisChannel = "anheyu:comment:stream"
+	// streamSubscriberBuffer 每个 SSE 连接的事件缓冲区大小
+	streamSubscriberBuffer = 16
+)
+
+// StreamEvent 是推送给 SSE 客户端的评论事件
+type StreamEvent struct {
+	Type       string        `json:"type"`
+	TargetPath string        `json:"target_path"`
+	CommentID  string        `json:"comment_id"`
+	Status     int           `json:"status"`
+	Comment    *dto.Response `json:"comment,omitempty"` // 评论未发布时，公开流中不携带评论内容
+}
+
+// streamEnvelope 是在实例之间传递的事件，同时携带公开视图和管理员视图
+type streamEnvelope struct {
+	Public *StreamEvent `json:"public,omitempty"` // 为 nil 表示该事件不推送给公开流
+	Admin  *StreamEvent `json:"admin"`
+}
+
+// StreamSubscription 代表一个 SSE 连接的订阅
+type StreamSubscription struct {
+	targetPath string
+	admin      bool
+	events     chan *StreamEvent
+}
+
+// Events 返回该订阅接收事件的通道
+func (sub *StreamSubscription) Events() <-chan *StreamEvent {
+	return sub.events
+}
+
+// StreamHub 负责将事件总线上的评论事件分发给所有 SSE 连接。
+// 配置了 Redis 时，事件会先经由 Redis Pub/Sub 广播，从而让所有实例上的连接都能收到。
+type StreamHub struct {
+	svc *Service
+	rdb *redis.Client
+
+	mu            sync.RWMutex
+	subscriptions map[*StreamSubscription]struct{}
+}
+
+// NewStreamHub 创建评论实时推送中心，并订阅评论相关的事件。
+func NewStreamHub(svc *Service, eventBus *event.EventBus, rdb *redis.Client) *StreamHub {
+	hub := &StreamHub{
+		svc:           svc,
+		rdb:           rdb,
+		subscriptions: make(map[*StreamSubscription]struct{}),
+	}
+	eventBus.Subscribe(event.CommentCreated, hub.handleCommentEvent(StreamEventCreated))
+	eventBus.Subscribe(event.CommentStatusChanged, hub.handleCommentEvent(StreamEventStatusChanged))
+
+	if rdb != nil {
+		go hub.listenRedis()
+	}
+	return hub
+}
+
+// Subscribe 注册一个新的 SSE 连接。admin 为 true 时接收全站的审核事件，否则只接收 targetPath 下已发布的评论。
+func (h *StreamHub) Subscribe(targetPath string, admin bool) *StreamSubscription {
+	sub := &StreamSubscription{
+		targetPath: targetPath,
+		admin:      admin,
+		events:     make(chan *StreamEvent, streamSubscriberBuffer),
+	}
+	h.mu.Lock()
+	h.subscriptions[sub] = struct{}{}
+	h.mu.Unlock()
+	return sub
+}
+
+// Unsubscribe 移除一个 SSE 连接并关闭其事件通道
+func (h *StreamHub) Unsubscribe(sub *StreamSubscription) {
+	h.mu.Lock()
+	if _, ok := h.subscriptions[sub]; ok {
+		delete(h.subscriptions, sub)
+		close(sub.events)
+	}
+	h.mu.Unlock()
+}
+
+// handleCommentEvent 返回处理指定类型评论事件的事件总线处理器
+func (h *StreamHub) handleCommentEvent(eventType string) event.Handler {
+	return func(payload interface{}) {
+		p, ok := payload.(CommentEventPayload)
+		if !ok {
+			log.Printf("[CommentStreamHub] 错误：收到的评论事件负载类型不正确")
+			return
+		}
+
+		envelope, err := h.buildEnvelope(context.Background(), eventType, p.CommentID)
+		if err != nil {
+			log.Printf("[CommentStreamHub] 构建评论 %d 的推送事件失败: %v", p.CommentID, err)
+			return
+		}
+
+		if h.rdb != nil {
+			data, err := json.Marshal(envelope)
+			if err == nil {
+				err = h.rdb.Publish(context.Background(), streamRedisChannel, data).Err()
+			}
+			if err == nil {
+				return
+			}
+			log.Printf("[CommentStreamHub] 通过 Redis 广播评论事件失败，仅推送到本实例: %v", err)
+		}
+		h.dispatch(envelope)
+	}
+}
+
+// buildEnvelope 重新读取评论，并分别渲染公开视图和管理员视图
+func (h *StreamHub) buildEnvelope(ctx context.Context, eventType string, commentID uint) (*streamEnvelope, error) {
+	c, err := h.svc.repo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var parent, replyTo *model.Comment
+	if c.ParentID != nil {
+		parent, _ = h.svc.repo.FindByID(ctx, *c.ParentID)
+	}
+	if c.ReplyToID != nil {
+		replyTo, _ = h.svc.repo.FindByID(ctx, *c.ReplyToID)
+	}
+
+	publicID, _ := idgen.GeneratePublicID(c.ID, idgen.EntityTypeComment)
+	newEvent := func(comment *dto.Response) *StreamEvent {
+		return &StreamEvent{
+			Type:       eventType,
+			TargetPath: c.TargetPath,
+			CommentID:  publicID,
+			Status:     int(c.Status),
+			Comment:    comment,
+		}
+	}
+
+	envelope := &streamEnvelope{
+		Admin: newEvent(h.svc.toResponseDTO(ctx, c, parent, replyTo, true)),
+	}
+	switch {
+	case c.IsPublished():
+		envelope.Public = newEvent(h.svc.toResponseDTO(ctx, c, parent, replyTo, false))
+	case eventType == StreamEventStatusChanged:
+		// 评论被撤回审核时只通知客户端移除，不再下发内容
+		envelope.Public = newEvent(nil)
+	}
+	return envelope, nil
+}
+
+// listenRedis 订阅 Redis 频道，把其它实例（包括本实例）广播的事件分发给本地连接
+func (h *StreamHub) listenRedis() {
+	pubsub := h.rdb.Subscribe(context.Background(), streamRedisChannel)
+	defer pubsub.Close()
+
+	for msg := range pubsub.Channel() {
+		var envelope streamEnvelope
+		if err := json.Unmarshal([]byte(msg.Payload), &envelope); err != nil {
+			log.Printf("[CommentStreamHub] 解析 Redis 评论事件失败: %v", err)
+			continue
+		}
+		h.dispatch(&envelope)
+	}
+	log.Printf("[CommentStreamHub] Redis 订阅已结束")
+}
+
+// dispatch 将事件推送给本实例上所有匹配的连接。连接消费过慢时丢弃事件，避免阻塞其它连接。
+func (h *StreamHub) dispatch(envelope *streamEnvelope) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.subscriptions {
+		ev := envelope.Admin
+		if !sub.admin {
+			if envelope.Public == nil || envelope.Public.TargetPath != sub.targetPath {
+				continue
+			}
+			ev = envelope.Public
+		}
+		select {
+		case sub.events <- ev:
+		default:
+			log.Printf("[CommentStreamHub] WARN: 连接事件缓冲区已满，丢弃评论 %s 的事件", ev.CommentID)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// anheyu-app/pkg/service/comment/thread_service.go
+package comment
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// ThreadState 返回评论区当前生效的状态，按以下优先级判定：
+//  1. 管理员为该路径单独设置的状态；
+//  2. 自定义页面关闭了 show_comment 时视为 closed；
+//  3. 开启了自动关闭且目标发布时间超过设定天数时视为 readonly；
+//  4. 其余情况为 open。
+//
+// 读取失败时按 open 处理，避免因为数据库异常导致全站无法评论。
+func (s *Service) ThreadState(ctx context.Context, targetPath string) model.CommentThreadState {
+	thread, err := s.threadRepo.FindByPath(ctx, targetPath)
+	if err != nil {
+		log.Printf("[Comment.ThreadState] 查询评论区 %s 的状态失败，按开放处理: %v", targetPath, err)
+		return model.CommentThreadOpen
+	}
+	if thread != nil {
+		return thread.State
+	}
+
+	var publishedAt *time.Time
+	if slug, ok := strings.CutPrefix(targetPath, "/posts/"); ok {
+		if article, err := s.articleRepo.GetBySlugOrID(ctx, slug); err == nil && article != nil {
+			publishedAt = &article.CreatedAt
+		}
+	} else if page, err := s.pageRepo.GetByPath(ctx, targetPath); err == nil && page != nil {
+		if !page.ShowComment {
+			return model.CommentThreadClosed
+		}
+		publishedAt = &page.CreatedAt
+	}
+
+	days, err := strconv.Atoi(s.settingSvc.Get(constant.KeyCommentAutoCloseDays.String()))
+	if err != nil || days <= 0 {
+		return model.CommentThreadOpen
+	}
+
+	// 即刻等没有独立发布时间的评论区，以第一条评论的时间作为评论区的开始时间
+	if publishedAt == nil {
+		publishedAt, err = s.repo.FindEarliestCreatedAtByPath(ctx, targetPath)
+		if err != nil || publishedAt == nil {
+			return model.CommentThreadOpen
+		}
+	}
+	if time.Since(*publishedAt) > time.Duration(days)*24*time.Hour {
+		return model.CommentThreadReadonly
+	}
+	return model.CommentThreadOpen
+}
+
+// ListThreads 分页获取管理员单独设置过状态的评论区
+func (s *Service) ListThreads(ctx context.Context, query *model.CommentThreadListQuery) (*repository.PageResult[model.CommentThread], error) {
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.PageSize <= 0 || query.PageSize > 100 {
+		query.PageSize = 20
+	}
+	return s.threadRepo.List(ctx, query)
+}
+
+// SetThreadState 为指定路径单独设置评论区状态，优先于 show_comment 和自动关闭规则
+func (s *Service) SetThreadState(ctx context.Context, req *model.SetCommentThreadStateRequest) (*model.CommentThread, error) {
+	thread, err := s.threadRepo.Upsert(ctx, req.TargetPath, req.State)
+	if err != nil {
+		return nil, fmt.Errorf("设置评论区状态失败: %w", err)
+	}
+	return thread, nil
+}
+
+// ResetThreadState 删除指定路径的单独设置，使其恢复为默认规则
+func (s *Service) ResetThreadState(ctx context.Context, targetPath string) error {
+	if err := s.threadRepo.DeleteByPath(ctx, targetPath); err != nil {
+		return fmt.Errorf("重置评论区状态失败: %w", err)
+	}
+	return nil
+}
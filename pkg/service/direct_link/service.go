@@ -61,7 +61,7 @@ func (s *directLinkServiceImpl) GetOrCreateDirectLinks(ctx context.Context, user
 			return nil, fmt.Errorf("查找用户组信息失败: %w", err)
 		}
 		if userGroup != nil {
-			speedLimitBytes = userGroup.SpeedLimit * 1024 * 1024
+			speedLimitBytes = userGroup.SpeedLimit
 		}
 	}
 
@@ -0,0 +1,553 @@
+// pkg/service/file/archive.go
+package file
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+const (
+	// extractStatusCachePrefix 是解压进度在缓存中的键前缀，后接用户ID和任务ID
+	extractStatusCachePrefix = "archive_extract:"
+	// extractStatusExpiration 是解压进度在缓存中的保留时间
+	extractStatusExpiration = 24 * time.Hour
+	// defaultArchiveTempDir 是解压前暂存压缩包的目录
+	defaultArchiveTempDir = "./data/temp/archives"
+)
+
+// 支持解压的压缩包格式
+const (
+	archiveFormatZip   = "zip"
+	archiveFormatTarGz = "tar.gz"
+)
+
+// IArchiveService 定义了打包下载和服务端解压的业务逻辑接口。
+type IArchiveService interface {
+	// PrepareZip 校验待打包项目的权限并收集其中的全部文件，此时还不会读取文件内容。
+	PrepareZip(ctx context.Context, ownerID uint, publicIDs []string) (*ZipArchive, error)
+	// WriteZip 把 PrepareZip 收集的文件逐个读取并以 zip 格式流式写入 w，不会把内容整体缓存在内存中。
+	WriteZip(ctx context.Context, archive *ZipArchive, w io.Writer) error
+	// StartExtraction 在后台把压缩包解压到目标文件夹，立即返回解压进度。
+	StartExtraction(ctx context.Context, ownerID uint, req *model.ArchiveExtractRequest) (*model.ArchiveExtractStatus, error)
+	// GetExtractionStatus 获取用户的解压任务进度，任务不存在时返回 constant.ErrNotFound。
+	GetExtractionStatus(ctx context.Context, ownerID uint, jobID string) (*model.ArchiveExtractStatus, error)
+}
+
+// ZipArchive 是一次打包下载要写入的内容
+type ZipArchive struct {
+	Name    string // 下载时使用的文件名
+	entries []zipEntry
+}
+
+// zipEntry 是 zip 中的一项，file 为 nil 时表示目录
+type zipEntry struct {
+	name string
+	file *model.File
+}
+
+// archiveEntry 是从压缩包中读出的一项
+type archiveEntry struct {
+	name    string
+	isDir   bool
+	regular bool // 普通文件，符号链接等其他类型不会被解压
+	size    int64
+}
+
+// archiveService 是 IArchiveService 接口的实现。
+type archiveService struct {
+	fileSvc    FileService
+	uploadSvc  IUploadService
+	vfsSvc     volume.IVFSService
+	quotaSvc   IQuotaService
+	settingSvc setting.SettingService
+	cacheSvc   utility.CacheService
+	tempDir    string
+
+	mu      sync.Mutex
+	running map[string]bool // 正在进行的解压任务ID
+}
+
+// NewArchiveService 是 archiveService 的构造函数
+func NewArchiveService(
+	fileSvc FileService,
+	uploadSvc IUploadService,
+	vfsSvc volume.IVFSService,
+	quotaSvc IQuotaService,
+	settingSvc setting.SettingService,
+	cacheSvc utility.CacheService,
+) IArchiveService {
+	tempDir := defaultArchiveTempDir
+	if err := os.MkdirAll(tempDir, os.ModePerm); err != nil {
+		log.Printf("警告: 无法创建压缩包临时目录 %s: %v", tempDir, err)
+	}
+	return &archiveService{
+		fileSvc:    fileSvc,
+		uploadSvc:  uploadSvc,
+		vfsSvc:     vfsSvc,
+		quotaSvc:   quotaSvc,
+		settingSvc: settingSvc,
+		cacheSvc:   cacheSvc,
+		tempDir:    tempDir,
+		running:    make(map[string]bool),
+	}
+}
+
+// PrepareZip 实现 IArchiveService 接口
+func (s *archiveService) PrepareZip(ctx context.Context, ownerID uint, publicIDs []string) (*ZipArchive, error) {
+	archive := &ZipArchive{}
+	for _, publicID := range publicIDs {
+		item, err := s.fileSvc.FindAndValidateFile(ctx, publicID, ownerID)
+		if err != nil {
+			return nil, err
+		}
+		if item.Type == model.FileTypeFile {
+			archive.entries = append(archive.entries, zipEntry{name: item.Name, file: item})
+			continue
+		}
+		entries, err := s.collectFolder(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		archive.entries = append(archive.entries, entries...)
+	}
+
+	archive.Name = "download.zip"
+	if len(publicIDs) == 1 && len(archive.entries) > 0 {
+		archive.Name = strings.SplitN(archive.entries[0].name, "/", 2)[0] + ".zip"
+	}
+	return archive, nil
+}
+
+// collectFolder 收集文件夹下的全部文件，zip 中的路径以该文件夹的名称开头
+func (s *archiveService) collectFolder(ctx context.Context, folder *model.File) ([]zipEntry, error) {
+	basePath, err := s.fileSvc.GetFolderPath(ctx, folder.ID)
+	if err != nil {
+		return nil, fmt.Errorf("获取文件夹 '%s' 的路径失败: %w", folder.Name, err)
+	}
+	files, err := s.fileSvc.ListAllDescendantFiles(ctx, folder.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 保留文件夹本身，空文件夹打包后也不会丢失
+	entries := []zipEntry{{name: folder.Name + "/"}}
+	parentPaths := map[int64]string{}
+	for _, file := range files {
+		parentPath, ok := parentPaths[file.ParentID.Int64]
+		if !ok {
+			parentPath, err = s.fileSvc.GetFolderPath(ctx, uint(file.ParentID.Int64))
+			if err != nil {
+				return nil, fmt.Errorf("获取文件 '%s' 的路径失败: %w", file.Name, err)
+			}
+			parentPaths[file.ParentID.Int64] = parentPath
+		}
+		relativeDir := strings.TrimPrefix(parentPath, basePath)
+		entries = append(entries, zipEntry{name: path.Join(folder.Name, relativeDir, file.Name), file: file})
+	}
+	return entries, nil
+}
+
+// WriteZip 实现 IArchiveService 接口
+func (s *archiveService) WriteZip(ctx context.Context, archive *ZipArchive, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	for _, entry := range archive.entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if entry.file == nil {
+			if _, err := zw.CreateHeader(&zip.FileHeader{Name: entry.name, Method: zip.Store}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := s.writeZipFile(ctx, zw, entry); err != nil {
+			return fmt.Errorf("打包文件 '%s' 失败: %w", entry.name, err)
+		}
+	}
+	return zw.Close()
+}
+
+// writeZipFile 把一个文件的内容从存储中读出并写入 zip
+func (s *archiveService) writeZipFile(ctx context.Context, zw *zip.Writer, entry zipEntry) error {
+	writer, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     entry.name,
+		Method:   zip.Deflate,
+		Modified: entry.file.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	// 空文件没有关联的物理实体
+	if !entry.file.PrimaryEntityID.Valid {
+		return nil
+	}
+	reader, err := s.vfsSvc.GetFileReader(ctx, entry.file)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	_, err = io.Copy(writer, reader)
+	return err
+}
+
+// StartExtraction 实现 IArchiveService 接口
+func (s *archiveService) StartExtraction(ctx context.Context, ownerID uint, req *model.ArchiveExtractRequest) (*model.ArchiveExtractStatus, error) {
+	archive, err := s.fileSvc.FindAndValidateFile(ctx, req.ArchiveID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if archive.Type != model.FileTypeFile {
+		return nil, fmt.Errorf("压缩包必须是一个文件: %w", constant.ErrInvalidOperation)
+	}
+	format := detectArchiveFormat(archive.Name)
+	if format == "" {
+		return nil, fmt.Errorf("不支持的压缩包格式，仅支持 zip 和 tar.gz: %w", constant.ErrInvalidOperation)
+	}
+	target, err := s.fileSvc.FindAndValidateFile(ctx, req.TargetID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if target.Type != model.FileTypeDir {
+		return nil, fmt.Errorf("解压的目标必须是一个文件夹: %w", constant.ErrInvalidOperation)
+	}
+	targetPath, err := s.fileSvc.GetFolderPath(ctx, target.ID)
+	if err != nil {
+		return nil, fmt.Errorf("获取目标文件夹的路径失败: %w", err)
+	}
+
+	now := time.Now()
+	status := &model.ArchiveExtractStatus{
+		JobID:     uuid.New().String(),
+		ArchiveID: req.ArchiveID,
+		TargetID:  req.TargetID,
+		IsRunning: true,
+		StartTime: &now,
+	}
+	if err := s.saveStatus(ctx, ownerID, status); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.running[status.JobID] = true
+	s.mu.Unlock()
+
+	snapshot := *status
+	// 使用新的 context，避免请求结束后解压被取消
+	go s.runExtraction(context.Background(), ownerID, archive, format, targetPath, req.Overwrite, status)
+	return &snapshot, nil
+}
+
+// GetExtractionStatus 实现 IArchiveService 接口
+func (s *archiveService) GetExtractionStatus(ctx context.Context, ownerID uint, jobID string) (*model.ArchiveExtractStatus, error) {
+	cached, err := s.cacheSvc.Get(ctx, extractStatusCacheKey(ownerID, jobID))
+	if err != nil || cached == "" {
+		return nil, constant.ErrNotFound
+	}
+	var status model.ArchiveExtractStatus
+	if err := json.Unmarshal([]byte(cached), &status); err != nil {
+		return nil, fmt.Errorf("解析解压进度失败: %w", err)
+	}
+	s.mu.Lock()
+	running := s.running[jobID]
+	s.mu.Unlock()
+	if status.IsRunning && !running {
+		// 服务重启时正在进行的解压会中断，已解压的文件不受影响
+		status.IsRunning = false
+		status.Error = "解压已中断，请重新发起解压"
+	}
+	return &status, nil
+}
+
+// runExtraction 把压缩包暂存到本地后逐项解压，每处理一个文件就更新一次进度
+func (s *archiveService) runExtraction(ctx context.Context, ownerID uint, archive *model.File, format, targetPath string, overwrite bool, status *model.ArchiveExtractStatus) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, status.JobID)
+		s.mu.Unlock()
+	}()
+	log.Printf("[ArchiveExtract] 开始将 '%s' 解压到 '%s'", archive.Name, targetPath)
+
+	runErr := s.extract(ctx, ownerID, archive, format, targetPath, overwrite, status)
+
+	endTime := time.Now()
+	status.IsRunning = false
+	status.EndTime = &endTime
+	if runErr != nil {
+		status.Error = runErr.Error()
+	} else if status.Failed > 0 {
+		status.Error = fmt.Sprintf("%d 个文件解压失败", status.Failed)
+	}
+	if err := s.saveStatus(ctx, ownerID, status); err != nil {
+		log.Printf("[ArchiveExtract] 保存解压进度失败: %v", err)
+	}
+	log.Printf("[ArchiveExtract] '%s' 解压结束，已解压 %d 个，跳过 %d 个，失败 %d 个", archive.Name, status.Extracted, status.Skipped, status.Failed)
+}
+
+// extract 先扫描一遍压缩包统计要解压的文件并校验容量，再逐个上传到目标文件夹
+func (s *archiveService) extract(ctx context.Context, ownerID uint, archive *model.File, format, targetPath string, overwrite bool, status *model.ArchiveExtractStatus) error {
+	tempFile, err := s.spoolArchive(ctx, archive)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tempFile.Close()
+		_ = os.Remove(tempFile.Name())
+	}()
+
+	allowedExtStr := s.settingSvc.Get(constant.KeyUploadAllowedExtensions.String())
+	err = walkArchive(tempFile, format, false, func(entry archiveEntry, _ io.Reader) error {
+		if _, ok := sanitizeArchivePath(entry.name); ok && entry.regular && isExtensionAllowed(allowedExtStr, entry.name) {
+			status.Total++
+			status.TotalSize += entry.size
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("读取压缩包失败: %w", err)
+	}
+	if err := s.quotaSvc.CheckQuota(ctx, ownerID, status.TotalSize); err != nil {
+		return err
+	}
+	if err := s.saveStatus(ctx, ownerID, status); err != nil {
+		log.Printf("[ArchiveExtract] 保存解压进度失败: %v", err)
+	}
+
+	return walkArchive(tempFile, format, true, func(entry archiveEntry, content io.Reader) error {
+		relativePath, ok := sanitizeArchivePath(entry.name)
+		switch {
+		case entry.isDir:
+			if ok {
+				s.ensureDirectory(ctx, ownerID, targetPath, relativePath)
+			}
+			return nil
+		case !ok || !entry.regular || !isExtensionAllowed(allowedExtStr, entry.name):
+			log.Printf("[ArchiveExtract] 跳过压缩包中的 '%s'", entry.name)
+			status.Skipped++
+		default:
+			err := s.extractFile(ctx, ownerID, path.Join(targetPath, relativePath), entry.size, content, overwrite)
+			if errors.Is(err, constant.ErrStorageQuotaExceeded) {
+				return err
+			}
+			if err != nil {
+				log.Printf("[ArchiveExtract] 解压 '%s' 失败: %v", entry.name, err)
+				status.Failed++
+			} else {
+				status.Extracted++
+				status.ExtractedSize += entry.size
+			}
+		}
+		if err := s.saveStatus(ctx, ownerID, status); err != nil {
+			log.Printf("[ArchiveExtract] 保存解压进度失败: %v", err)
+		}
+		return nil
+	})
+}
+
+// spoolArchive 把压缩包复制到本地临时文件，zip 需要随机读取，远程存储的文件也不适合长时间保持连接
+func (s *archiveService) spoolArchive(ctx context.Context, archive *model.File) (*os.File, error) {
+	reader, err := s.vfsSvc.GetFileReader(ctx, archive)
+	if err != nil {
+		return nil, fmt.Errorf("读取压缩包失败: %w", err)
+	}
+	defer reader.Close()
+
+	tempFile, err := os.CreateTemp(s.tempDir, "extract-*")
+	if err != nil {
+		return nil, fmt.Errorf("创建临时文件失败: %w", err)
+	}
+	if _, err := io.Copy(tempFile, reader); err != nil {
+		_ = tempFile.Close()
+		_ = os.Remove(tempFile.Name())
+		return nil, fmt.Errorf("下载压缩包失败: %w", err)
+	}
+	return tempFile, nil
+}
+
+// extractFile 把压缩包中的一个文件上传到 virtualPath，扩展名、容量和策略大小限制都由上传服务校验
+func (s *archiveService) extractFile(ctx context.Context, ownerID uint, virtualPath string, size int64, content io.Reader, overwrite bool) error {
+	policy, err := s.vfsSvc.FindPolicyForPath(ctx, virtualPath)
+	if err != nil {
+		return err
+	}
+	policyPublicID, err := idgen.GeneratePublicID(policy.ID, idgen.EntityTypeStoragePolicy)
+	if err != nil {
+		return err
+	}
+	return s.uploadSvc.UploadStream(ctx, ownerID, &model.CreateUploadRequest{
+		URI:       myFileURI(virtualPath),
+		Size:      size,
+		PolicyID:  policyPublicID,
+		Overwrite: overwrite,
+	}, io.LimitReader(content, size))
+}
+
+// ensureDirectory 逐级创建压缩包中的目录，使空目录在解压后也存在，已存在的目录会被忽略
+func (s *archiveService) ensureDirectory(ctx context.Context, ownerID uint, targetPath, relativePath string) {
+	current := targetPath
+	for _, segment := range strings.Split(relativePath, "/") {
+		current = path.Join(current, segment)
+		_, err := s.fileSvc.CreateEmptyFile(ctx, ownerID, &model.CreateFileRequest{
+			URI:  myFileURI(current),
+			Type: int(model.FileTypeDir),
+		})
+		if err != nil && !errors.Is(err, constant.ErrConflict) {
+			log.Printf("[ArchiveExtract] 创建目录 '%s' 失败: %v", current, err)
+			return
+		}
+	}
+}
+
+// saveStatus 把解压进度写入缓存
+func (s *archiveService) saveStatus(ctx context.Context, ownerID uint, status *model.ArchiveExtractStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return s.cacheSvc.Set(ctx, extractStatusCacheKey(ownerID, status.JobID), string(data), extractStatusExpiration)
+}
+
+// extractStatusCacheKey 返回解压进度的缓存键，键中包含用户ID，用户只能查询自己的解压任务
+func extractStatusCacheKey(ownerID uint, jobID string) string {
+	return fmt.Sprintf("%s%d:%s", extractStatusCachePrefix, ownerID, jobID)
+}
+
+// myFileURI 将虚拟路径转换为 anzhiyu://my 形式的 URI
+func myFileURI(virtualPath string) string {
+	return (&url.URL{Scheme: "anzhiyu", Host: "my", Path: virtualPath}).String()
+}
+
+// detectArchiveFormat 根据文件名判断压缩包格式，不支持时返回空字符串
+func detectArchiveFormat(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		return archiveFormatZip
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		return archiveFormatTarGz
+	}
+	return ""
+}
+
+// sanitizeArchivePath 把压缩包中的路径转换为相对于目标文件夹的路径。
+// 包含 ".." 的路径可能写到目标文件夹之外，返回 false
+func sanitizeArchivePath(name string) (string, bool) {
+	name = strings.ReplaceAll(name, "\\", "/")
+	for _, segment := range strings.Split(name, "/") {
+		if segment == ".." {
+			return "", false
+		}
+	}
+	cleaned := strings.Trim(path.Clean("/"+name), "/")
+	return cleaned, cleaned != ""
+}
+
+// walkArchive 依次读取压缩包中的每一项。withContent 为 false 时只读取文件头，用于统计
+func walkArchive(file *os.File, format string, withContent bool, fn func(entry archiveEntry, content io.Reader) error) error {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if format == archiveFormatZip {
+		return walkZip(file, withContent, fn)
+	}
+	return walkTarGz(file, fn)
+}
+
+// walkZip 读取 zip 中的每一项
+func walkZip(file *os.File, withContent bool, fn func(entry archiveEntry, content io.Reader) error) error {
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	zr, err := zip.NewReader(file, info.Size())
+	if err != nil {
+		return err
+	}
+	for _, zf := range zr.File {
+		entry := archiveEntry{
+			name:    decodeZipName(zf),
+			isDir:   zf.FileInfo().IsDir(),
+			regular: zf.FileInfo().Mode().IsRegular(),
+			size:    int64(zf.UncompressedSize64),
+		}
+		if !withContent || !entry.regular {
+			if err := fn(entry, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return err
+		}
+		err = fn(entry, rc)
+		_ = rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkTarGz 读取 tar.gz 中的每一项，tar 只能顺序读取，未读取的内容会被跳过
+func walkTarGz(file *os.File, fn func(entry archiveEntry, content io.Reader) error) error {
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		entry := archiveEntry{
+			name:  header.Name,
+			isDir: header.FileInfo().IsDir(),
+			// 硬链接的文件模式也是普通文件，需要按类型判断
+			regular: header.Typeflag == tar.TypeReg,
+			size:    header.Size,
+		}
+		if err := fn(entry, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// decodeZipName 返回 zip 中项目的名称。Windows 自带的压缩工具使用 GBK 编码文件名且不设置 UTF-8 标志，需要转换
+func decodeZipName(zf *zip.File) string {
+	if !zf.NonUTF8 || utf8.ValidString(zf.Name) {
+		return zf.Name
+	}
+	decoded, err := simplifiedchinese.GBK.NewDecoder().String(zf.Name)
+	if err != nil {
+		return zf.Name
+	}
+	return decoded
+}
@@ -0,0 +1,243 @@
+package file
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// gbk 返回字符串的 GBK 编码
+func gbk(t *testing.T, s string) string {
+	t.Helper()
+	encoded, err := simplifiedchinese.GBK.NewEncoder().String(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encoded
+}
+
+// walkedEntry 是遍历压缩包时读出的一项及其内容
+type walkedEntry struct {
+	archiveEntry
+	content string
+}
+
+// collectEntries 遍历压缩包并按名称收集读出的项目
+func collectEntries(t *testing.T, walk func(fn func(entry archiveEntry, content io.Reader) error) error) map[string]walkedEntry {
+	t.Helper()
+	entries := make(map[string]walkedEntry)
+	err := walk(func(entry archiveEntry, content io.Reader) error {
+		walked := walkedEntry{archiveEntry: entry}
+		if content != nil {
+			data, err := io.ReadAll(content)
+			if err != nil {
+				return err
+			}
+			walked.content = string(data)
+		}
+		entries[entry.name] = walked
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("遍历压缩包失败: %v", err)
+	}
+	return entries
+}
+
+func TestSanitizeArchivePath(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   string
+		wantOK bool
+	}{
+		{"普通文件", "a.txt", "a.txt", true},
+		{"子目录", "dir/sub/a.txt", "dir/sub/a.txt", true},
+		{"目录项", "dir/", "dir", true},
+		{"当前目录前缀", "./dir/a.txt", "dir/a.txt", true},
+		{"重复分隔符", "dir//a.txt", "dir/a.txt", true},
+		{"Windows 分隔符", "dir\\a.txt", "dir/a.txt", true},
+		{"绝对路径转为相对路径", "/etc/passwd", "etc/passwd", true},
+		{"Windows 绝对路径", "\\Windows\\system.ini", "Windows/system.ini", true},
+		{"上级目录", "../a.txt", "", false},
+		{"多级上级目录", "../../etc/passwd", "", false},
+		{"中间的上级目录", "dir/../../a.txt", "", false},
+		{"目录内抵消的上级目录", "dir/../a.txt", "", false},
+		{"Windows 上级目录", "..\\a.txt", "", false},
+		{"Windows 中间的上级目录", "dir\\..\\..\\a.txt", "", false},
+		{"绝对路径中的上级目录", "/../etc/passwd", "", false},
+		{"点开头的文件名", "..a.txt", "..a.txt", true},
+		{"空路径", "", "", false},
+		{"根目录", "/", "", false},
+		{"当前目录", ".", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := sanitizeArchivePath(tt.input)
+			if got != tt.want || ok != tt.wantOK {
+				t.Fatalf("sanitizeArchivePath(%q) = (%q, %v)，期望 (%q, %v)", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestDecodeZipName(t *testing.T) {
+	tests := []struct {
+		name    string
+		zipName string
+		nonUTF8 bool
+		want    string
+	}{
+		{"UTF-8 文件名", "中文.txt", false, "中文.txt"},
+		{"未设置 UTF-8 标志的 ASCII 文件名", "a.txt", true, "a.txt"},
+		{"未设置 UTF-8 标志的 UTF-8 文件名", "中文.txt", true, "中文.txt"},
+		{"GBK 文件名", gbk(t, "中文.txt"), true, "中文.txt"},
+		{"GBK 路径", gbk(t, "文件夹/报告.docx"), true, "文件夹/报告.docx"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zf := &zip.File{FileHeader: zip.FileHeader{Name: tt.zipName, NonUTF8: tt.nonUTF8}}
+			if got := decodeZipName(zf); got != tt.want {
+				t.Fatalf("decodeZipName() = %q，期望 %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWalkZip(t *testing.T) {
+	file, err := os.Create(filepath.Join(t.TempDir(), "test.zip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	zw := zip.NewWriter(file)
+	add := func(header *zip.FileHeader, content string) {
+		w, err := zw.CreateHeader(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(w, content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	add(&zip.FileHeader{Name: "dir/"}, "")
+	add(&zip.FileHeader{Name: "dir/a.txt"}, "hello")
+	add(&zip.FileHeader{Name: "../evil.txt"}, "evil")
+	symlink := &zip.FileHeader{Name: "link"}
+	symlink.SetMode(os.ModeSymlink | 0o777)
+	add(symlink, "/etc/passwd")
+	// Windows 自带的压缩工具写入的 GBK 文件名，不设置 UTF-8 标志
+	add(&zip.FileHeader{Name: gbk(t, "中文.txt"), NonUTF8: true}, "gbk")
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		isDir   bool
+		regular bool
+		content string
+	}{
+		{"dir/", true, false, ""},
+		{"dir/a.txt", false, true, "hello"},
+		{"../evil.txt", false, true, "evil"},
+		{"link", false, false, ""},
+		{"中文.txt", false, true, "gbk"},
+	}
+	for _, withContent := range []bool{false, true} {
+		entries := collectEntries(t, func(fn func(entry archiveEntry, content io.Reader) error) error {
+			return walkArchive(file, archiveFormatZip, withContent, fn)
+		})
+		if len(entries) != len(tests) {
+			t.Fatalf("应读出 %d 项，实际: %+v", len(tests), entries)
+		}
+		for _, tt := range tests {
+			entry, ok := entries[tt.name]
+			if !ok {
+				t.Fatalf("缺少 %q，实际: %+v", tt.name, entries)
+			}
+			if entry.isDir != tt.isDir || entry.regular != tt.regular {
+				t.Fatalf("%q 的类型错误: isDir=%v regular=%v", tt.name, entry.isDir, entry.regular)
+			}
+			wantContent := ""
+			if withContent {
+				wantContent = tt.content
+			}
+			if entry.content != wantContent {
+				t.Fatalf("%q 的内容应为 %q，实际为 %q", tt.name, wantContent, entry.content)
+			}
+		}
+	}
+}
+
+func TestWalkTarGz(t *testing.T) {
+	file, err := os.Create(filepath.Join(t.TempDir(), "test.tar.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+	add := func(header *tar.Header, content string) {
+		header.Size = int64(len(content))
+		if header.Mode == 0 {
+			header.Mode = 0o644
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(tw, content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	add(&tar.Header{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0o755}, "")
+	add(&tar.Header{Name: "dir/a.txt", Typeflag: tar.TypeReg}, "hello")
+	add(&tar.Header{Name: "../evil.txt", Typeflag: tar.TypeReg}, "evil")
+	add(&tar.Header{Name: "symlink", Typeflag: tar.TypeSymlink, Linkname: "/etc/passwd"}, "")
+	add(&tar.Header{Name: "hardlink", Typeflag: tar.TypeLink, Linkname: "../../etc/passwd"}, "")
+	add(&tar.Header{Name: "fifo", Typeflag: tar.TypeFifo}, "")
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := collectEntries(t, func(fn func(entry archiveEntry, content io.Reader) error) error {
+		return walkArchive(file, archiveFormatTarGz, true, fn)
+	})
+	tests := []struct {
+		name    string
+		isDir   bool
+		regular bool
+		content string
+	}{
+		{"dir/", true, false, ""},
+		{"dir/a.txt", false, true, "hello"},
+		{"../evil.txt", false, true, "evil"},
+		{"symlink", false, false, ""},
+		{"hardlink", false, false, ""},
+		{"fifo", false, false, ""},
+	}
+	if len(entries) != len(tests) {
+		t.Fatalf("应读出 %d 项，实际: %+v", len(tests), entries)
+	}
+	for _, tt := range tests {
+		entry, ok := entries[tt.name]
+		if !ok {
+			t.Fatalf("缺少 %q，实际: %+v", tt.name, entries)
+		}
+		if entry.isDir != tt.isDir || entry.regular != tt.regular {
+			t.Fatalf("%q 的类型错误: isDir=%v regular=%v", tt.name, entry.isDir, entry.regular)
+		}
+		if entry.content != tt.content {
+			t.Fatalf("%q 的内容应为 %q，实际为 %q", tt.name, tt.content, entry.content)
+		}
+	}
+}
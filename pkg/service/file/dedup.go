@@ -0,0 +1,230 @@
+// pkg/service/file/dedup.go
+package file
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// dedupBatchSize 是后台去重任务每批处理的实体数量
+const dedupBatchSize = 100
+
+// computeContentHash 计算内容的 SHA-256 哈希，返回十六进制字符串
+func computeContentHash(r io.Reader) (string, error) {
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// contentStoreVirtualPath 返回共享内容在存储策略中的存放位置，按哈希前两位分目录，保留原文件的扩展名
+func contentStoreVirtualPath(policy *model.StoragePolicy, contentHash, name string) string {
+	return path.Join(policy.VirtualPath, constant.ContentStoreDirName, contentHash[:2], contentHash+strings.ToLower(path.Ext(name)))
+}
+
+// FindReusableEntity 实现 FileService 接口
+func (s *serviceImpl) FindReusableEntity(ctx context.Context, policy *model.StoragePolicy, contentHash string, size int64, excludeEntityID uint) (*model.FileStorageEntity, error) {
+	candidates, err := s.entityRepo.FindByContentHash(ctx, policy.ID, contentHash)
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range candidates {
+		// 历史版本会随所属文件一起删除，不与其他文件共享
+		if candidate.ID == excludeEntityID || candidate.Size != size ||
+			!candidate.Source.Valid || isVersionSource(candidate.Source.String) {
+			continue
+		}
+		if !candidate.IsContentAddressed() {
+			if err := s.moveToContentStore(ctx, policy, candidate); err != nil {
+				return nil, err
+			}
+		}
+		return candidate, nil
+	}
+	return nil, nil
+}
+
+// moveToContentStore 把实体的内容从所属文件的路径移动到内容寻址目录。
+// 共享后的内容不再跟随任何一个文件重命名、移动或被覆盖；
+// 复制期间实体被修改（如文件被覆盖、重命名或迁移）时放弃本次移动，保留实体原来的位置
+func (s *serviceImpl) moveToContentStore(ctx context.Context, policy *model.StoragePolicy, entity *model.FileStorageEntity) error {
+	provider, err := s.GetProviderForPolicy(policy)
+	if err != nil {
+		return err
+	}
+	oldSource := entity.Source.String
+	reader, err := provider.Get(ctx, policy, oldSource)
+	if err != nil {
+		return fmt.Errorf("读取实体 %d 的内容失败: %w", entity.ID, err)
+	}
+	uploadResult, err := provider.Upload(ctx, reader, policy, contentStoreVirtualPath(policy, entity.ContentHash.String, oldSource))
+	_ = reader.Close()
+	if err != nil {
+		return fmt.Errorf("写入共享内容失败: %w", err)
+	}
+
+	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		current, err := repos.Entity.FindByID(ctx, entity.ID)
+		if err != nil {
+			return fmt.Errorf("重新读取实体 %d 失败: %w", entity.ID, err)
+		}
+		if current.PolicyID != policy.ID || current.Source.String != oldSource {
+			return fmt.Errorf("实体 %d 在移动到共享位置的过程中被修改", entity.ID)
+		}
+		current.Source = sql.NullString{String: uploadResult.Source, Valid: true}
+		if err := repos.Entity.Update(ctx, current); err != nil {
+			return fmt.Errorf("更新实体 %d 的存储位置失败: %w", entity.ID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		// 相同内容的共享位置可能已被其他实体使用，只删除没有登记的内容
+		if used, usedErr := s.entityRepo.IsSourceUsedByEntities(ctx, policy.ID, uploadResult.Source); usedErr != nil || used {
+			return err
+		}
+		if delErr := provider.Delete(ctx, policy, []string{uploadResult.Source}); delErr != nil {
+			log.Printf("[FileDedup] 删除未登记的共享内容 '%s' 失败: %v", uploadResult.Source, delErr)
+		}
+		return err
+	}
+	entity.Source = sql.NullString{String: uploadResult.Source, Valid: true}
+	if err := provider.Delete(ctx, policy, []string{oldSource}); err != nil {
+		log.Printf("[FileDedup] 删除实体 %d 原位置的内容 '%s' 失败: %v", entity.ID, oldSource, err)
+	}
+	log.Printf("[FileDedup] 实体 %d 的内容已移动到共享位置: %s", entity.ID, uploadResult.Source)
+	return nil
+}
+
+// releaseEntity 释放文件对实体的一个引用，这是最后一个引用时删除实体记录并返回 true，
+// 由调用方在事务提交后删除物理内容
+func releaseEntity(ctx context.Context, entityRepo repository.EntityRepository, entityID uint) (bool, error) {
+	last, err := entityRepo.ReleaseReference(ctx, entityID)
+	if err != nil || !last {
+		return false, err
+	}
+	if err := entityRepo.HardDelete(ctx, entityID); err != nil {
+		return false, fmt.Errorf("删除物理实体记录 %d 失败: %w", entityID, err)
+	}
+	return true, nil
+}
+
+// DeduplicateExistingFiles 实现 FileService 接口
+func (s *serviceImpl) DeduplicateExistingFiles(ctx context.Context) (int, error) {
+	var afterID uint
+	merged := 0
+	for {
+		batch, err := s.entityRepo.FindWithoutContentHash(ctx, afterID, dedupBatchSize)
+		if err != nil {
+			return merged, err
+		}
+		if len(batch) == 0 {
+			return merged, nil
+		}
+		for _, entity := range batch {
+			afterID = entity.ID
+			if ctx.Err() != nil {
+				return merged, ctx.Err()
+			}
+			ok, err := s.deduplicateEntity(ctx, entity)
+			if err != nil {
+				// 单个实体失败不影响其他实体，下次运行时会重新处理
+				log.Printf("[FileDedup] 处理实体 %d 失败: %v", entity.ID, err)
+				continue
+			}
+			if ok {
+				merged++
+			}
+		}
+	}
+}
+
+// deduplicateEntity 计算实体的内容哈希，存在内容相同的实体时把引用它的文件合并过去，返回是否发生了合并。
+// 实体来自批量读取，计算期间被修改（如文件被覆盖、保留为历史版本或迁移）时放弃本次处理，下次运行时重新计算
+func (s *serviceImpl) deduplicateEntity(ctx context.Context, entity *model.FileStorageEntity) (bool, error) {
+	policy, err := s.policySvc.GetPolicyByDatabaseID(ctx, entity.PolicyID)
+	if err != nil {
+		return false, fmt.Errorf("找不到存储策略: %w", err)
+	}
+	provider, err := s.GetProviderForPolicy(policy)
+	if err != nil {
+		return false, err
+	}
+	reader, err := provider.Get(ctx, policy, entity.Source.String)
+	if err != nil {
+		return false, fmt.Errorf("读取内容失败: %w", err)
+	}
+	contentHash, err := computeContentHash(reader)
+	_ = reader.Close()
+	if err != nil {
+		return false, fmt.Errorf("计算内容哈希失败: %w", err)
+	}
+
+	var canonical *model.FileStorageEntity
+	if !isVersionSource(entity.Source.String) {
+		canonical, err = s.FindReusableEntity(ctx, policy, contentHash, entity.Size, entity.ID)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	merged := false
+	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		current, err := repos.Entity.FindByID(ctx, entity.ID)
+		if err != nil {
+			return fmt.Errorf("重新读取实体 %d 失败: %w", entity.ID, err)
+		}
+		if current == nil || current.PolicyID != entity.PolicyID || current.Source.String != entity.Source.String {
+			return fmt.Errorf("实体 %d 在计算内容哈希的过程中被修改", entity.ID)
+		}
+		if err := repos.Entity.SetContentHash(ctx, entity.ID, contentHash); err != nil {
+			return fmt.Errorf("记录实体 %d 的内容哈希失败: %w", entity.ID, err)
+		}
+		if canonical == nil {
+			return nil
+		}
+		count, err := repos.File.ReplacePrimaryEntity(ctx, entity.ID, canonical.ID)
+		if err != nil {
+			return fmt.Errorf("替换文件的当前实体失败: %w", err)
+		}
+		if count == 0 {
+			// 没有文件以它为当前内容，只记录哈希供之后的上传复用
+			return nil
+		}
+		if err := repos.FileEntity.ReplaceEntityID(ctx, entity.ID, canonical.ID); err != nil {
+			return err
+		}
+		if err := repos.Entity.AddReference(ctx, canonical.ID, count); err != nil {
+			return fmt.Errorf("增加实体 %d 的引用失败: %w", canonical.ID, err)
+		}
+		if err := repos.Entity.HardDelete(ctx, entity.ID); err != nil {
+			return fmt.Errorf("删除重复的实体记录失败: %w", err)
+		}
+		merged = true
+		return nil
+	})
+	if err != nil || !merged {
+		return false, err
+	}
+	// 其他实体仍使用同一存储位置时保留内容
+	if used, err := s.entityRepo.IsSourceUsedByEntities(ctx, policy.ID, entity.Source.String); err != nil {
+		log.Printf("[FileDedup] 检查存储位置 '%s' 的使用情况失败: %v", entity.Source.String, err)
+	} else if !used {
+		if err := provider.Delete(ctx, policy, []string{entity.Source.String}); err != nil {
+			log.Printf("[FileDedup] 删除重复内容 '%s' 失败: %v", entity.Source.String, err)
+		}
+	}
+	log.Printf("[FileDedup] 实体 %d 与实体 %d 内容相同，已合并", entity.ID, canonical.ID)
+	return true, nil
+}
@@ -0,0 +1,153 @@
+package file
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHardDeleteSharedEntityReleasesOneReference(t *testing.T) {
+	env := newTestEnv(t)
+	content := []byte("shared content")
+	hash := hashOf(t, content)
+	shared := env.putEntity(t, env.primary, contentStoreVirtualPath(env.primary, hash, "a.txt"), content, hash)
+	if err := env.repos.Entity.AddReference(env.ctx, shared.ID, 1); err != nil {
+		t.Fatal(err)
+	}
+	first := env.putFile(t, "a.txt", shared)
+	second := env.putFile(t, "b.txt", shared)
+
+	env.hardDelete(t, first.ID)
+	entity := env.entity(t, shared.ID)
+	if entity == nil || entity.ReferenceCount != 1 {
+		t.Fatalf("删除其中一个文件后实体应保留且引用计数为 1，实际: %+v", entity)
+	}
+	if _, ok := env.primaryP.content(shared.Source.String); !ok {
+		t.Fatal("另一个文件仍在使用，共享内容不应被删除")
+	}
+	if used := env.storageUsed(t); used != int64(len(content)) {
+		t.Fatalf("存储用量应只释放被删除文件的大小，实际为 %d", used)
+	}
+	if env.file(t, second.ID).PrimaryEntityID.Uint64 != uint64(shared.ID) {
+		t.Fatal("另一个文件的当前实体不应改变")
+	}
+
+	env.hardDelete(t, second.ID)
+	if env.entity(t, shared.ID) != nil {
+		t.Fatal("最后一个引用释放后实体记录应被删除")
+	}
+	if _, ok := env.primaryP.content(shared.Source.String); ok {
+		t.Fatal("最后一个引用释放后共享内容应被删除")
+	}
+	if used := env.storageUsed(t); used != 0 {
+		t.Fatalf("全部删除后存储用量应为 0，实际为 %d", used)
+	}
+}
+
+func TestDeduplicateExistingFilesMergesIdenticalContent(t *testing.T) {
+	env := newTestEnv(t)
+	content := []byte("duplicated content")
+	other := []byte("unique content")
+	firstEntity := env.putEntity(t, env.primary, "/a.txt", content, "")
+	secondEntity := env.putEntity(t, env.primary, "/b.txt", content, "")
+	otherEntity := env.putEntity(t, env.primary, "/c.txt", other, "")
+	first := env.putFile(t, "a.txt", firstEntity)
+	second := env.putFile(t, "b.txt", secondEntity)
+	env.putFile(t, "c.txt", otherEntity)
+
+	merged, err := env.svc.DeduplicateExistingFiles(env.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merged != 1 {
+		t.Fatalf("应合并 1 个重复实体，实际合并 %d 个", merged)
+	}
+
+	canonical := env.entity(t, firstEntity.ID)
+	if canonical == nil || canonical.ReferenceCount != 2 {
+		t.Fatalf("保留的实体应被两个文件引用，实际: %+v", canonical)
+	}
+	wantSource := contentStoreSource(env.primary, content, "/a.txt", t)
+	if canonical.Source.String != wantSource {
+		t.Fatalf("共享的内容应移动到 %s，实际为 %s", wantSource, canonical.Source.String)
+	}
+	for _, f := range []uint{first.ID, second.ID} {
+		if got := env.file(t, f).PrimaryEntityID.Uint64; got != uint64(canonical.ID) {
+			t.Fatalf("文件 %d 的当前实体应为 %d，实际为 %d", f, canonical.ID, got)
+		}
+	}
+	versions, err := env.repos.FileEntity.ListByFileID(env.ctx, second.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || versions[0].EntityID != canonical.ID {
+		t.Fatalf("被合并文件的版本关联应指向保留的实体，实际: %+v", versions)
+	}
+	if env.entity(t, secondEntity.ID) != nil {
+		t.Fatal("重复的实体记录应被删除")
+	}
+	for _, source := range []string{"/a.txt", "/b.txt"} {
+		if _, ok := env.primaryP.content(source); ok {
+			t.Fatalf("原位置的内容 %s 应被删除", source)
+		}
+	}
+	if _, ok := env.primaryP.content(wantSource); !ok {
+		t.Fatal("共享位置缺少内容")
+	}
+	if e := env.entity(t, otherEntity.ID); !e.ContentHash.Valid || e.ContentHash.String != hashOf(t, other) || e.Source.String != "/c.txt" {
+		t.Fatalf("没有重复的实体只记录哈希，位置不变，实际: %+v", e)
+	}
+}
+
+func TestMoveToContentStoreAbortsWhenEntityChanged(t *testing.T) {
+	env := newTestEnv(t)
+	content := []byte("moving content")
+	entity := env.putEntity(t, env.primary, "/a.txt", content, hashOf(t, content))
+	env.putFile(t, "a.txt", entity)
+
+	// 复制期间文件被重命名，实体指向了新的位置
+	env.primaryP.onUpload = func(source string) {
+		if strings.Contains(source, "/.objects/") {
+			env.primaryP.put("/renamed.txt", content)
+			env.client.Entity.UpdateOneID(entity.ID).SetSource("/renamed.txt").ExecX(env.ctx)
+		}
+	}
+	if err := env.svc.moveToContentStore(env.ctx, env.primary, entity); err == nil {
+		t.Fatal("实体在复制期间被修改时应放弃移动")
+	}
+
+	if got := env.entity(t, entity.ID).Source.String; got != "/renamed.txt" {
+		t.Fatalf("实体的存储位置应保持并发修改后的值，实际为 %s", got)
+	}
+	if _, ok := env.primaryP.content(contentStoreSource(env.primary, content, "/a.txt", t)); ok {
+		t.Fatal("放弃移动后应删除已写入共享位置的内容")
+	}
+	if _, ok := env.primaryP.content("/renamed.txt"); !ok {
+		t.Fatal("放弃移动时不应删除实体当前位置的内容")
+	}
+}
+
+func TestDeduplicateEntityAbortsWhenEntityChanged(t *testing.T) {
+	env := newTestEnv(t)
+	content := []byte("duplicated content")
+	canonical := env.putEntity(t, env.primary, "/a.txt", content, hashOf(t, content))
+	env.putFile(t, "a.txt", canonical)
+	stale := env.putEntity(t, env.primary, "/b.txt", content, "")
+	file := env.putFile(t, "b.txt", stale)
+
+	// 批量读取实体之后，实体被迁移到了其他存储策略
+	env.backupP.put("/b.txt", content)
+	env.client.Entity.UpdateOneID(stale.ID).SetPolicyID(env.backup.ID).ExecX(env.ctx)
+	if _, err := env.svc.deduplicateEntity(env.ctx, stale); err == nil {
+		t.Fatal("实体在计算哈希期间被修改时应放弃处理")
+	}
+
+	if e := env.entity(t, stale.ID); e == nil || e.PolicyID != env.backup.ID || e.ContentHash.Valid {
+		t.Fatalf("放弃处理时应保留并发修改后的实体，实际: %+v", e)
+	}
+	if got := env.file(t, file.ID).PrimaryEntityID.Uint64; got != uint64(stale.ID) {
+		t.Fatalf("放弃处理时文件的当前实体不应改变，实际为 %d", got)
+	}
+	if _, ok := env.primaryP.content("/b.txt"); !ok {
+		t.Fatal("放弃处理时不应删除实体原位置的内容")
+	}
+}
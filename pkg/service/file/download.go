@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/utils"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
@@ -96,16 +97,31 @@ func (s *serviceImpl) Download(ctx context.Context, viewerID uint, publicFileID
 	if err != nil {
 		return nil, fmt.Errorf("找不到物理实体: %w", err)
 	}
+	if entity == nil {
+		return nil, fmt.Errorf("找不到物理实体: %w", constant.ErrNotFound)
+	}
+	if err := s.serveEntity(ctx, file, entity, writer); err != nil {
+		return nil, err
+	}
+
+	return &DownloadResult{
+		Name: file.Name,
+		Size: file.Size,
+	}, nil
+}
+
+// serveEntity 将文件的某个物理实体写出：本地、SFTP 和 WebDAV 存储由服务端流式传输，云存储重定向到临时下载链接。
+func (s *serviceImpl) serveEntity(ctx context.Context, file *model.File, entity *model.FileStorageEntity, writer io.Writer) error {
 	policy, err := s.policySvc.GetPolicyByDatabaseID(ctx, entity.PolicyID)
 	if err != nil {
-		return nil, fmt.Errorf("找不到存储策略: %w", err)
+		return fmt.Errorf("找不到存储策略: %w", err)
 	}
 	provider, err := s.GetProviderForPolicy(policy)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if policy.Type == constant.PolicyTypeLocal {
+	if policy.Type.ProxiesDownloads() {
 		// 在流式传输前，设置Content-Type和Content-Length
 		if w, ok := writer.(http.ResponseWriter); ok {
 			// 确定 Content-Type：优先使用数据库中的 MimeType，如果为空或无效则根据文件扩展名推断
@@ -117,30 +133,28 @@ func (s *serviceImpl) Download(ctx context.Context, viewerID uint, publicFileID
 				contentType = getContentTypeFromFilename(file.Name)
 			}
 			w.Header().Set("Content-Type", contentType)
-			w.Header().Set("Content-Length", strconv.FormatInt(file.Size, 10))
+			w.Header().Set("Content-Length", strconv.FormatInt(entity.Size, 10))
 		}
-		err = provider.Stream(ctx, policy, entity.Source.String, writer)
+		// 按文件所有者所在用户组的限速传输，签名下载等匿名访问同样受限
+		speedLimit := s.quotaSvc.GetSpeedLimit(ctx, file.OwnerID)
+		err = provider.Stream(ctx, policy, entity.Source.String, utils.NewThrottledWriter(writer, speedLimit, ctx))
 		if err != nil {
-			return nil, err
+			return err
 		}
 	} else {
 		options := storage.DownloadURLOptions{ExpiresIn: 3600}
 		downloadURL, err := provider.GetDownloadURL(ctx, policy, entity.Source.String, options)
 		if err != nil {
-			return nil, fmt.Errorf("无法从云存储获取下载链接: %w", err)
+			return fmt.Errorf("无法从云存储获取下载链接: %w", err)
 		}
 		if w, ok := writer.(http.ResponseWriter); ok {
 			w.Header().Set("Location", downloadURL)
 			w.WriteHeader(http.StatusFound)
 		} else {
-			return nil, errors.New("云存储下载需要一个 http.ResponseWriter 来执行重定向")
+			return errors.New("云存储下载需要一个 http.ResponseWriter 来执行重定向")
 		}
 	}
-
-	return &DownloadResult{
-		Name: file.Name,
-		Size: file.Size,
-	}, nil
+	return nil
 }
 
 // ProcessSignedDownload 处理带签名的下载请求，验证签名并提供文件。
@@ -251,10 +265,10 @@ func (s *serviceImpl) GetDownloadInfo(ctx context.Context, viewerID uint, public
 		FileSize: file.Size,
 	}
 
-	if policy.Type == constant.PolicyTypeLocal {
-		// 本地存储，前端需要通过API下载
+	if policy.Type.ProxiesDownloads() {
+		// 本地、SFTP 和 WebDAV 存储，前端需要通过API下载
 		downloadInfo.Type = "local"
-		downloadInfo.StorageType = "local"
+		downloadInfo.StorageType = string(policy.Type)
 	} else {
 		// 云存储，可以提供直接下载链接
 		downloadInfo.Type = "cloud"
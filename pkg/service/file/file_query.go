@@ -41,6 +41,13 @@ import (
 //
 // 返回: (*model.FileListResponse, error) - 包含文件列表及元数据的完整响应对象，或在发生错误时返回error
 func (s *serviceImpl) QueryByURI(ctx context.Context, ownerID, viewerID uint, parsedURI *uri.ParsedURI) (*model.FileListResponse, error) {
+	switch parsedURI.FSType {
+	case "trash":
+		return s.queryTrash(ctx, ownerID, viewerID, parsedURI)
+	case "share":
+		return s.queryShare(ctx, viewerID, parsedURI)
+	}
+
 	// --- 1. 初始化和参数确定 ---
 	policy, err := s.vfsSvc.FindPolicyForPath(ctx, parsedURI.Path)
 	if err != nil {
@@ -95,17 +102,8 @@ func (s *serviceImpl) QueryByURI(ctx context.Context, ownerID, viewerID uint, pa
 	}
 
 	// --- 2. 解析分页令牌 (Cursor) ---
-	var token *repository.PaginationToken
 	tokenStr := parsedURI.Query.Get("next_token")
-	if tokenStr != "" {
-		decoded, err := base64.StdEncoding.DecodeString(tokenStr)
-		if err == nil {
-			var t repository.PaginationToken
-			if json.Unmarshal(decoded, &t) == nil {
-				token = &t
-			}
-		}
-	}
+	token := decodePaginationToken(tokenStr)
 
 	var parentID uint = 0
 	if parentFolder != nil {
@@ -196,25 +194,7 @@ func (s *serviceImpl) QueryByURI(ctx context.Context, ownerID, viewerID uint, pa
 			finalChildren = dbChildren[:effectivePageSize]
 		}
 
-		var lastValue interface{}
-		switch orderBy {
-		case "name":
-			lastValue = lastItem.Name
-		case "size":
-			lastValue = lastItem.Size
-		case "created_at":
-			lastValue = lastItem.CreatedAt
-		default:
-			lastValue = lastItem.UpdatedAt
-		}
-
-		newToken := repository.PaginationToken{
-			LastID:           lastItem.ID,
-			LastValue:        lastValue,
-			LastPrimaryValue: lastItem.Type,
-		}
-		tokenBytes, _ := json.Marshal(newToken)
-		nextToken = base64.StdEncoding.EncodeToString(tokenBytes)
+		nextToken = encodePaginationToken(lastItem, orderBy)
 	}
 
 	// --- 6. 构建响应 DTO ---
@@ -252,6 +232,45 @@ func (s *serviceImpl) QueryByURI(ctx context.Context, ownerID, viewerID uint, pa
 	}, nil
 }
 
+// decodePaginationToken 解析游标分页令牌，令牌为空或无效时返回 nil，即从第一页开始
+func decodePaginationToken(tokenStr string) *repository.PaginationToken {
+	if tokenStr == "" {
+		return nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(tokenStr)
+	if err != nil {
+		return nil
+	}
+	var t repository.PaginationToken
+	if json.Unmarshal(decoded, &t) != nil {
+		return nil
+	}
+	return &t
+}
+
+// encodePaginationToken 以当前页的最后一项生成下一页的游标分页令牌
+func encodePaginationToken(lastItem *model.File, orderBy string) string {
+	var lastValue interface{}
+	switch orderBy {
+	case "name":
+		lastValue = lastItem.Name
+	case "size":
+		lastValue = lastItem.Size
+	case "created_at":
+		lastValue = lastItem.CreatedAt
+	default:
+		lastValue = lastItem.UpdatedAt
+	}
+
+	newToken := repository.PaginationToken{
+		LastID:           lastItem.ID,
+		LastValue:        lastValue,
+		LastPrimaryValue: lastItem.Type,
+	}
+	tokenBytes, _ := json.Marshal(newToken)
+	return base64.StdEncoding.EncodeToString(tokenBytes)
+}
+
 // GetFileInfo 根据文件的公共ID获取单个文件或目录的详细信息。
 func (s *serviceImpl) GetFileInfo(ctx context.Context, viewerID uint, publicFileID string) (*model.FileInfoResponse, error) {
 	dbID, entityType, err := idgen.DecodePublicID(publicFileID)
@@ -33,6 +33,7 @@ func (s *serviceImpl) CopyRecursively(
 	txFileRepo repository.FileRepository,
 	txEntityRepo repository.EntityRepository,
 	txMetadataRepo repository.MetadataRepository,
+	txUserRepo repository.UserRepository,
 ) error {
 	// 1. 检查名称冲突
 	if _, err := txFileRepo.FindByParentIDAndName(ctx, newParentFolder.ID, itemToCopy.Name); !errors.Is(err, constant.ErrNotFound) {
@@ -105,6 +106,11 @@ func (s *serviceImpl) CopyRecursively(
 	if err := txFileRepo.Create(ctx, newItem); err != nil {
 		return fmt.Errorf("创建复制记录 '%s' 失败: %w", newItem.Name, err)
 	}
+	if newItem.Type == model.FileTypeFile {
+		if err := txUserRepo.AdjustStorageUsed(ctx, ownerID, newItem.Size); err != nil {
+			return fmt.Errorf("更新存储用量失败: %w", err)
+		}
+	}
 
 	// 5. 复制描述性元数据，过滤掉状态性元数据
 	sourceMetas, err := txMetadataRepo.GetAll(ctx, itemToCopy.ID)
@@ -112,7 +118,7 @@ func (s *serviceImpl) CopyRecursively(
 		log.Printf("警告：复制文件 '%s' 时未能获取其元数据: %v", itemToCopy.Name, err)
 	} else {
 		for _, meta := range sourceMetas {
-			if strings.HasPrefix(meta.Name, "thumb_") {
+			if strings.HasPrefix(meta.Name, "thumb_") || meta.Name == model.MetaKeyRestoreURI {
 				continue
 			}
 			newMeta := &model.Metadata{
@@ -139,7 +145,7 @@ func (s *serviceImpl) CopyRecursively(
 			return fmt.Errorf("获取源文件夹 '%s' 的子项失败: %w", itemToCopy.Name, err)
 		}
 		for _, child := range children {
-			if err := s.CopyRecursively(ctx, ownerID, child, newItem, txFileRepo, txEntityRepo, txMetadataRepo); err != nil {
+			if err := s.CopyRecursively(ctx, ownerID, child, newItem, txFileRepo, txEntityRepo, txMetadataRepo, txUserRepo); err != nil {
 				return err
 			}
 		}
@@ -175,6 +181,7 @@ func (s *serviceImpl) GetFullVirtualPathWithRepo(ctx context.Context, file *mode
 //   - txMetadataRepo: 事务性的 MetadataRepository
 //   - txPolicyRepo: 事务性的 StoragePolicyRepository
 //   - txDirectLinkRepo: 事务性的 DirectLinkRepository
+//   - txUserRepo: 事务性的 UserRepository，用于释放文件占用的存储用量
 //
 // 返回: error - 如果操作过程中出现任何错误，则返回错误
 func (s *serviceImpl) HardDeleteRecursively(
@@ -187,6 +194,7 @@ func (s *serviceImpl) HardDeleteRecursively(
 	txMetadataRepo repository.MetadataRepository,
 	txPolicyRepo repository.StoragePolicyRepository,
 	txDirectLinkRepo repository.DirectLinkRepository,
+	txUserRepo repository.UserRepository,
 ) error {
 	// 1. 查找要删除的项目
 	item, err := txFileRepo.FindByIDUnscoped(ctx, fileID)
@@ -217,6 +225,8 @@ func (s *serviceImpl) HardDeleteRecursively(
 	}
 
 	// 3. 根据类型执行不同的物理删除逻辑
+	// releasedSize 只在实体确实被删除时计入，已经永久删除过的记录再次经过这里时不会重复释放存储用量
+	var releasedSize int64
 	if item.Type == model.FileTypeDir {
 		// 如果是目录，先递归删除所有子项
 		children, err := txFileRepo.ListByParentIDUnscoped(ctx, item.ID)
@@ -225,15 +235,22 @@ func (s *serviceImpl) HardDeleteRecursively(
 		}
 		for _, child := range children {
 			// 递归调用，并传入 txPolicyRepo
-			if err := s.HardDeleteRecursively(ctx, ownerID, child.File.ID, txFileRepo, txEntityRepo, txFileEntityRepo, txMetadataRepo, txPolicyRepo, txDirectLinkRepo); err != nil {
+			if err := s.HardDeleteRecursively(ctx, ownerID, child.File.ID, txFileRepo, txEntityRepo, txFileEntityRepo, txMetadataRepo, txPolicyRepo, txDirectLinkRepo, txUserRepo); err != nil {
 				return err // 如果任何子项删除失败，则中止并回滚
 			}
 		}
 
-		// 在删除所有子项后，删除物理空目录
-		fullVirtualPath, pathErr := s.GetFullVirtualPathWithRepo(ctx, item, txFileRepo)
+		// 在删除所有子项后，删除物理空目录。回收站中的文件夹只在数据库中改了名，物理目录仍在删除前的位置上；
+		// 该位置还被其他项目（新建的同名文件夹或其他回收站项目）占用时保留物理目录
+		fullVirtualPath, pathErr := s.originalVirtualPath(ctx, txFileRepo, txMetadataRepo, item)
+		var occupants []*model.File
+		if pathErr == nil {
+			occupants, pathErr = s.locationOccupants(ctx, txFileRepo, txMetadataRepo, item.OwnerID, fullVirtualPath)
+		}
 		if pathErr != nil {
 			log.Printf("【DELETE WARN】无法获取文件夹 %d 的路径，将跳过物理目录删除: %v", item.ID, pathErr)
+		} else if len(occupants) > 1 {
+			log.Printf("【DELETE INFO】物理目录 '%s' 仍被其他项目使用，跳过物理目录删除", fullVirtualPath)
 		} else {
 			policy, policyErr := s.vfsSvc.FindPolicyForPath(ctx, fullVirtualPath)
 			if policyErr != nil {
@@ -251,6 +268,14 @@ func (s *serviceImpl) HardDeleteRecursively(
 						physicalPath := filepath.Join(policy.BasePath, relativePath)
 						sourceToDelete = physicalPath
 						log.Printf("【DELETE INFO】正在删除本地物理目录: %s", sourceToDelete)
+					} else if policy.Type == constant.PolicyTypeSFTP {
+						// SFTP 存储需要远程服务器上的路径
+						sourceToDelete = storage.SFTPRemotePath(policy, fullVirtualPath)
+						log.Printf("【DELETE INFO】正在删除SFTP远程目录: %s", sourceToDelete)
+					} else if policy.Type == constant.PolicyTypeWebDAV {
+						// WebDAV 存储需要远程服务器上的路径
+						sourceToDelete = storage.WebDAVRemotePath(policy, fullVirtualPath)
+						log.Printf("【DELETE INFO】正在删除WebDAV远程目录: %s", sourceToDelete)
 					} else {
 						// 云存储需要虚拟路径
 						sourceToDelete = fullVirtualPath
@@ -266,6 +291,11 @@ func (s *serviceImpl) HardDeleteRecursively(
 			}
 		}
 	} else { // 如果是文件，删除其关联的实体和物理文件
+		versionSize, err := s.deleteFileVersions(ctx, item, txEntityRepo, txFileEntityRepo)
+		if err != nil {
+			return fmt.Errorf("删除文件 %d 的历史版本失败: %w", item.ID, err)
+		}
+		releasedSize = versionSize
 		log.Printf("【DELETE INFO】删除 file_entities 记录 for file_id: %d", item.ID)
 		if err := txFileEntityRepo.DeleteByFileID(ctx, item.ID); err != nil {
 			return fmt.Errorf("删除文件版本关联记录失败 for file_id %d: %w", item.ID, err)
@@ -276,9 +306,25 @@ func (s *serviceImpl) HardDeleteRecursively(
 			if findErr != nil && !errors.Is(findErr, constant.ErrNotFound) {
 				return fmt.Errorf("检查实体 %d 状态失败: %w", entityID, findErr)
 			}
+			// 实体还被其他文件共享时只释放当前文件的引用，保留物理文件
+			lastReference := false
 			if entity != nil {
+				lastReference, err = releaseEntity(ctx, txEntityRepo, entityID)
+				if err != nil {
+					return fmt.Errorf("释放实体 %d 的引用失败: %w", entityID, err)
+				}
+				releasedSize += item.Size
+			}
+			if lastReference {
+				// 回收站文件的原位置可能已写入新文件的内容，仍被其他实体使用的物理文件不能删除
+				sourceUsed, usedErr := txEntityRepo.IsSourceUsedByEntities(ctx, entity.PolicyID, entity.Source.String)
+				if usedErr != nil {
+					return fmt.Errorf("检查实体 %d 的存储位置失败: %w", entityID, usedErr)
+				}
 				policy, policyErr := s.policySvc.GetPolicyByDatabaseID(ctx, entity.PolicyID)
-				if policyErr != nil {
+				if sourceUsed {
+					log.Printf("【DELETE INFO】物理文件 '%s' 仍被其他实体使用，跳过物理删除", entity.Source.String)
+				} else if policyErr != nil {
 					log.Printf("【DELETE WARN】找不到实体 %d 的存储策略，无法删除物理文件: %v", entityID, policyErr)
 				} else {
 					provider, providerErr := s.GetProviderForPolicy(policy)
@@ -293,10 +339,7 @@ func (s *serviceImpl) HardDeleteRecursively(
 						}
 					}
 				}
-				log.Printf("【DELETE INFO】永久删除 entities 记录: ID %d", entityID)
-				if err := txEntityRepo.HardDelete(ctx, entityID); err != nil {
-					return fmt.Errorf("永久删除实体记录 %d 失败: %w", entityID, err)
-				}
+				log.Printf("【DELETE INFO】已永久删除 entities 记录: ID %d", entityID)
 			}
 		}
 	}
@@ -322,6 +365,13 @@ func (s *serviceImpl) HardDeleteRecursively(
 		return fmt.Errorf("永久删除文件/目录记录 %d 失败: %w", item.ID, err)
 	}
 
+	// 6. 释放文件占用的存储用量
+	if releasedSize > 0 {
+		if err := txUserRepo.AdjustStorageUsed(ctx, item.OwnerID, -releasedSize); err != nil {
+			return fmt.Errorf("更新存储用量失败: %w", err)
+		}
+	}
+
 	return nil
 }
 
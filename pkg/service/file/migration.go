@@ -0,0 +1,411 @@
+// pkg/service/file/migration.go
+package file
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+)
+
+const (
+	// migrationStatusCachePrefix 是迁移进度在缓存中的键前缀，后接源存储策略的数据库ID
+	migrationStatusCachePrefix = "policy_migration:"
+	// migrationStatusExpiration 是迁移进度在缓存中的保留时间
+	migrationStatusExpiration = 30 * 24 * time.Hour
+	// migrationBatchSize 是每批迁移的实体数量
+	migrationBatchSize = 100
+)
+
+// IPolicyMigrationService 定义了在存储策略之间迁移文件的业务逻辑接口。
+type IPolicyMigrationService interface {
+	// StartMigration 在后台把源存储策略中的全部实体迁移到目标存储策略，立即返回迁移进度。
+	// 上一次迁移中断或有失败的实体时，再次调用会从剩余的实体继续
+	StartMigration(ctx context.Context, sourcePolicyID string, req *model.PolicyMigrationRequest) (*model.PolicyMigrationStatus, error)
+	// GetMigrationStatus 获取源存储策略最近一次迁移的进度，从未迁移过时返回 nil。
+	GetMigrationStatus(ctx context.Context, sourcePolicyID string) (*model.PolicyMigrationStatus, error)
+}
+
+// policyMigrationService 是 IPolicyMigrationService 接口的实现。
+// 迁移后的内容存放在目标策略的内容寻址目录中，文件的虚拟路径和直链都不变，
+// 下载时按实体所属的存储策略读取，文章和评论中引用的直链可以继续访问
+type policyMigrationService struct {
+	txManager  repository.TransactionManager
+	entityRepo repository.EntityRepository
+	policySvc  volume.IStoragePolicyService
+	cacheSvc   utility.CacheService
+	fileSvc    FileService
+
+	mu      sync.Mutex
+	running map[uint]bool // 正在迁移的源存储策略，防止同一策略被并发迁移
+}
+
+// NewPolicyMigrationService 是 policyMigrationService 的构造函数
+func NewPolicyMigrationService(
+	txManager repository.TransactionManager,
+	entityRepo repository.EntityRepository,
+	policySvc volume.IStoragePolicyService,
+	cacheSvc utility.CacheService,
+	fileSvc FileService,
+) IPolicyMigrationService {
+	return &policyMigrationService{
+		txManager:  txManager,
+		entityRepo: entityRepo,
+		policySvc:  policySvc,
+		cacheSvc:   cacheSvc,
+		fileSvc:    fileSvc,
+		running:    make(map[uint]bool),
+	}
+}
+
+// StartMigration 实现 IPolicyMigrationService 接口
+func (s *policyMigrationService) StartMigration(ctx context.Context, sourcePolicyID string, req *model.PolicyMigrationRequest) (*model.PolicyMigrationStatus, error) {
+	source, err := s.policySvc.GetPolicyByID(ctx, sourcePolicyID)
+	if err != nil {
+		return nil, fmt.Errorf("找不到源存储策略: %w", err)
+	}
+	target, err := s.policySvc.GetPolicyByID(ctx, req.TargetPolicyID)
+	if err != nil {
+		return nil, fmt.Errorf("找不到目标存储策略: %w", err)
+	}
+	if source.ID == target.ID {
+		return nil, fmt.Errorf("源存储策略和目标存储策略不能相同: %w", constant.ErrInvalidOperation)
+	}
+	if _, err := s.fileSvc.GetProviderForPolicy(source); err != nil {
+		return nil, err
+	}
+	if _, err := s.fileSvc.GetProviderForPolicy(target); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	if s.running[source.ID] {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("存储策略 '%s' 正在迁移中: %w", source.Name, constant.ErrConflict)
+	}
+	s.running[source.ID] = true
+	s.mu.Unlock()
+
+	status, err := s.newRunStatus(ctx, source, sourcePolicyID, req)
+	if err != nil {
+		s.finishRun(source.ID)
+		return nil, err
+	}
+	if err := s.saveStatus(ctx, source.ID, status); err != nil {
+		s.finishRun(source.ID)
+		return nil, err
+	}
+
+	snapshot := *status
+	// 使用新的 context，避免请求结束后迁移被取消
+	go s.run(context.Background(), source, target, status)
+	return &snapshot, nil
+}
+
+// newRunStatus 创建本轮迁移的进度。目标与上一次相同时累计此前已迁移的数量，表示继续上一次迁移
+func (s *policyMigrationService) newRunStatus(ctx context.Context, source *model.StoragePolicy, sourcePolicyID string, req *model.PolicyMigrationRequest) (*model.PolicyMigrationStatus, error) {
+	remaining, remainingSize, err := s.entityRepo.CountEntityByStoragePolicyID(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	status := &model.PolicyMigrationStatus{
+		SourcePolicyID: sourcePolicyID,
+		TargetPolicyID: req.TargetPolicyID,
+		DeleteSource:   req.DeleteSource,
+		IsRunning:      true,
+		Total:          remaining,
+		TotalSize:      remainingSize,
+		StartTime:      &now,
+	}
+	previous, err := s.loadStatus(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	if previous != nil && previous.TargetPolicyID == req.TargetPolicyID {
+		status.Migrated = previous.Migrated
+		status.MigratedSize = previous.MigratedSize
+		status.Total += previous.Migrated
+		status.TotalSize += previous.MigratedSize
+	}
+	return status, nil
+}
+
+// GetMigrationStatus 实现 IPolicyMigrationService 接口
+func (s *policyMigrationService) GetMigrationStatus(ctx context.Context, sourcePolicyID string) (*model.PolicyMigrationStatus, error) {
+	source, err := s.policySvc.GetPolicyByID(ctx, sourcePolicyID)
+	if err != nil {
+		return nil, fmt.Errorf("找不到源存储策略: %w", err)
+	}
+	status, err := s.loadStatus(ctx, source.ID)
+	if err != nil || status == nil {
+		return status, err
+	}
+	s.mu.Lock()
+	running := s.running[source.ID]
+	s.mu.Unlock()
+	if status.IsRunning && !running {
+		// 服务重启时正在进行的迁移会中断，已迁移的实体不受影响
+		status.IsRunning = false
+		status.Error = "迁移已中断，重新发起迁移将从剩余的文件继续"
+	}
+	return status, nil
+}
+
+// run 分批迁移源存储策略中的实体，每处理一个实体就更新一次进度
+func (s *policyMigrationService) run(ctx context.Context, source, target *model.StoragePolicy, status *model.PolicyMigrationStatus) {
+	defer s.finishRun(source.ID)
+	log.Printf("[PolicyMigration] 开始将存储策略 '%s' 迁移到 '%s'", source.Name, target.Name)
+
+	var runErr error
+	var afterID uint
+	for runErr == nil {
+		batch, err := s.entityRepo.FindMigratableByStoragePolicyID(ctx, source.ID, afterID, migrationBatchSize)
+		if err != nil {
+			runErr = err
+			break
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for _, entity := range batch {
+			afterID = entity.ID
+			if err := s.migrateEntity(ctx, source, target, entity, status.DeleteSource); err != nil {
+				// 失败的实体仍留在源存储策略中，重新发起迁移时会再次尝试
+				log.Printf("[PolicyMigration] 迁移实体 %d 失败: %v", entity.ID, err)
+				status.Failed++
+			} else {
+				status.Migrated++
+				status.MigratedSize += entity.Size
+			}
+			status.LastEntityID = entity.ID
+			if err := s.saveStatus(ctx, source.ID, status); err != nil {
+				log.Printf("[PolicyMigration] 保存迁移进度失败: %v", err)
+			}
+		}
+	}
+
+	endTime := time.Now()
+	status.IsRunning = false
+	status.EndTime = &endTime
+	if runErr != nil {
+		status.Error = runErr.Error()
+	} else if status.Failed > 0 {
+		status.Error = fmt.Sprintf("%d 个文件迁移失败，可以重新发起迁移重试", status.Failed)
+	}
+	if err := s.saveStatus(ctx, source.ID, status); err != nil {
+		log.Printf("[PolicyMigration] 保存迁移进度失败: %v", err)
+	}
+	log.Printf("[PolicyMigration] 存储策略 '%s' 迁移结束，已迁移 %d 个，失败 %d 个", source.Name, status.Migrated, status.Failed)
+}
+
+// finishRun 清除源存储策略的迁移中标记
+func (s *policyMigrationService) finishRun(policyID uint) {
+	s.mu.Lock()
+	delete(s.running, policyID)
+	s.mu.Unlock()
+}
+
+// migrateEntity 把一个实体的内容复制到目标存储策略，校验大小和哈希后在事务中更新实体的存储策略和位置。
+// 目标策略中已有相同内容时直接合并到已有的实体
+func (s *policyMigrationService) migrateEntity(ctx context.Context, source, target *model.StoragePolicy, entity *model.FileStorageEntity, deleteSource bool) error {
+	sourceProvider, err := s.fileSvc.GetProviderForPolicy(source)
+	if err != nil {
+		return err
+	}
+	oldSource := entity.Source.String
+
+	contentHash := entity.ContentHash.String
+	if !entity.ContentHash.Valid {
+		reader, err := sourceProvider.Get(ctx, source, oldSource)
+		if err != nil {
+			return fmt.Errorf("读取源内容失败: %w", err)
+		}
+		contentHash, err = computeContentHash(reader)
+		_ = reader.Close()
+		if err != nil {
+			return fmt.Errorf("计算源内容哈希失败: %w", err)
+		}
+	}
+
+	canonical, err := s.fileSvc.FindReusableEntity(ctx, target, contentHash, entity.Size, 0)
+	if err != nil {
+		return err
+	}
+	if canonical != nil {
+		if err := s.mergeInto(ctx, source, entity, canonical); err != nil {
+			return err
+		}
+	} else {
+		if err := s.copyTo(ctx, source, target, entity, contentHash); err != nil {
+			return err
+		}
+	}
+
+	if deleteSource {
+		// 早期覆盖文件时遗留的旧实体与新实体共用同一个存储位置，还有实体使用时不能删除
+		used, err := s.entityRepo.IsSourceUsedByEntities(ctx, source.ID, oldSource)
+		if err != nil {
+			log.Printf("[PolicyMigration] 检查源文件 '%s' 的使用情况失败，保留源文件: %v", oldSource, err)
+		} else if !used {
+			if err := sourceProvider.Delete(ctx, source, []string{oldSource}); err != nil {
+				log.Printf("[PolicyMigration] 删除源文件 '%s' 失败: %v", oldSource, err)
+			}
+		}
+	}
+	return nil
+}
+
+// copyTo 把实体的内容上传到目标存储策略的内容寻址目录，校验通过后更新实体记录
+func (s *policyMigrationService) copyTo(ctx context.Context, source, target *model.StoragePolicy, entity *model.FileStorageEntity, contentHash string) error {
+	sourceProvider, err := s.fileSvc.GetProviderForPolicy(source)
+	if err != nil {
+		return err
+	}
+	targetProvider, err := s.fileSvc.GetProviderForPolicy(target)
+	if err != nil {
+		return err
+	}
+	oldSource := entity.Source.String
+
+	reader, err := sourceProvider.Get(ctx, source, oldSource)
+	if err != nil {
+		return fmt.Errorf("读取源内容失败: %w", err)
+	}
+	uploadResult, err := targetProvider.Upload(ctx, reader, target, contentStoreVirtualPath(target, contentHash, oldSource))
+	_ = reader.Close()
+	if err != nil {
+		return fmt.Errorf("上传到目标存储失败: %w", err)
+	}
+	discard := func() {
+		if err := targetProvider.Delete(ctx, target, []string{uploadResult.Source}); err != nil {
+			log.Printf("[PolicyMigration] 删除未登记的目标文件 '%s' 失败: %v", uploadResult.Source, err)
+		}
+	}
+
+	if err := verifyMigratedContent(ctx, targetProvider, target, uploadResult.Source, entity.Size, contentHash); err != nil {
+		discard()
+		return err
+	}
+
+	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		current, err := repos.Entity.FindByID(ctx, entity.ID)
+		if err != nil {
+			return fmt.Errorf("重新读取实体失败: %w", err)
+		}
+		if current.PolicyID != source.ID || current.Source.String != oldSource {
+			return errors.New("实体在迁移过程中被修改")
+		}
+		current.PolicyID = target.ID
+		current.Source = sql.NullString{String: uploadResult.Source, Valid: true}
+		current.ContentHash = sql.NullString{String: contentHash, Valid: true}
+		if err := repos.Entity.Update(ctx, current); err != nil {
+			return fmt.Errorf("更新实体的存储位置失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		discard()
+		return err
+	}
+	return nil
+}
+
+// mergeInto 把实体的全部引用转移到目标存储策略中内容相同的实体上，并删除该实体的记录
+func (s *policyMigrationService) mergeInto(ctx context.Context, source *model.StoragePolicy, entity, canonical *model.FileStorageEntity) error {
+	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		current, err := repos.Entity.FindByID(ctx, entity.ID)
+		if err != nil {
+			return fmt.Errorf("重新读取实体失败: %w", err)
+		}
+		if current.PolicyID != source.ID || current.Source.String != entity.Source.String {
+			return errors.New("实体在迁移过程中被修改")
+		}
+		if _, err := repos.File.ReplacePrimaryEntity(ctx, current.ID, canonical.ID); err != nil {
+			return fmt.Errorf("替换文件的当前实体失败: %w", err)
+		}
+		if err := repos.FileEntity.ReplaceEntityID(ctx, current.ID, canonical.ID); err != nil {
+			return err
+		}
+		if err := repos.Entity.AddReference(ctx, canonical.ID, current.ReferenceCount); err != nil {
+			return fmt.Errorf("增加实体 %d 的引用失败: %w", canonical.ID, err)
+		}
+		if err := repos.Entity.HardDelete(ctx, current.ID); err != nil {
+			return fmt.Errorf("删除已合并的实体记录失败: %w", err)
+		}
+		return nil
+	})
+}
+
+// verifyMigratedContent 从目标存储读回迁移后的内容，校验大小和哈希与源内容一致
+func verifyMigratedContent(ctx context.Context, provider storage.IStorageProvider, policy *model.StoragePolicy, source string, size int64, contentHash string) error {
+	reader, err := provider.Get(ctx, policy, source)
+	if err != nil {
+		return fmt.Errorf("读取目标内容失败: %w", err)
+	}
+	defer reader.Close()
+	counter := &countingWriter{}
+	actualHash, err := computeContentHash(io.TeeReader(reader, counter))
+	if err != nil {
+		return fmt.Errorf("计算目标内容哈希失败: %w", err)
+	}
+	if counter.n != size {
+		return fmt.Errorf("目标内容大小 %d 与源内容大小 %d 不一致", counter.n, size)
+	}
+	if actualHash != contentHash {
+		return fmt.Errorf("目标内容哈希 %s 与源内容哈希 %s 不一致", actualHash, contentHash)
+	}
+	return nil
+}
+
+// countingWriter 统计写入的字节数
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// loadStatus 从缓存中读取源存储策略的迁移进度，不存在时返回 nil
+func (s *policyMigrationService) loadStatus(ctx context.Context, policyID uint) (*model.PolicyMigrationStatus, error) {
+	value, err := s.cacheSvc.Get(ctx, migrationStatusKey(policyID))
+	if err != nil {
+		return nil, fmt.Errorf("读取迁移进度失败: %w", err)
+	}
+	if value == "" {
+		return nil, nil
+	}
+	var status model.PolicyMigrationStatus
+	if err := json.Unmarshal([]byte(value), &status); err != nil {
+		return nil, fmt.Errorf("解析迁移进度失败: %w", err)
+	}
+	return &status, nil
+}
+
+// saveStatus 把迁移进度写入缓存
+func (s *policyMigrationService) saveStatus(ctx context.Context, policyID uint, status *model.PolicyMigrationStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("序列化迁移进度失败: %w", err)
+	}
+	return s.cacheSvc.Set(ctx, migrationStatusKey(policyID), string(data), migrationStatusExpiration)
+}
+
+// migrationStatusKey 返回源存储策略的迁移进度在缓存中的键
+func migrationStatusKey(policyID uint) string {
+	return fmt.Sprintf("%s%d", migrationStatusCachePrefix, policyID)
+}
@@ -0,0 +1,182 @@
+package file
+
+import (
+	"bytes"
+	"testing"
+)
+
+func newTestMigrationService(env *testEnv) *policyMigrationService {
+	return &policyMigrationService{
+		txManager:  env.svc.txManager,
+		entityRepo: env.repos.Entity,
+		policySvc:  env.svc.policySvc,
+		fileSvc:    env.svc,
+		running:    make(map[uint]bool),
+	}
+}
+
+func TestCopyToMovesEntityToTargetPolicy(t *testing.T) {
+	env := newTestEnv(t)
+	svc := newTestMigrationService(env)
+	content := []byte("migrating content")
+	entity := env.putEntity(t, env.primary, "/a.txt", content, "")
+	env.putFile(t, "a.txt", entity)
+
+	if err := svc.copyTo(env.ctx, env.primary, env.backup, entity, hashOf(t, content)); err != nil {
+		t.Fatal(err)
+	}
+
+	migrated := env.entity(t, entity.ID)
+	wantSource := contentStoreSource(env.backup, content, "/a.txt", t)
+	if migrated.PolicyID != env.backup.ID || migrated.Source.String != wantSource {
+		t.Fatalf("实体应指向目标策略的 %s，实际为策略 %d 的 %s", wantSource, migrated.PolicyID, migrated.Source.String)
+	}
+	if migrated.ContentHash.String != hashOf(t, content) {
+		t.Fatal("迁移后的实体应记录内容哈希")
+	}
+	if got, ok := env.backupP.content(wantSource); !ok || !bytes.Equal(got, content) {
+		t.Fatal("目标存储中的内容与源内容不一致")
+	}
+	if _, ok := env.primaryP.content("/a.txt"); !ok {
+		t.Fatal("copyTo 不应删除源内容")
+	}
+}
+
+func TestCopyToDiscardsCorruptedCopy(t *testing.T) {
+	env := newTestEnv(t)
+	svc := newTestMigrationService(env)
+	content := []byte("migrating content")
+	entity := env.putEntity(t, env.primary, "/a.txt", content, "")
+	env.backupP.corrupt = true
+
+	if err := svc.copyTo(env.ctx, env.primary, env.backup, entity, hashOf(t, content)); err == nil {
+		t.Fatal("目标内容校验失败时应返回错误")
+	}
+	if e := env.entity(t, entity.ID); e.PolicyID != env.primary.ID || e.Source.String != "/a.txt" {
+		t.Fatalf("校验失败时实体不应改变，实际: %+v", e)
+	}
+	if n := env.backupP.count(); n != 0 {
+		t.Fatalf("校验失败的目标内容应被删除，实际剩余 %d 个", n)
+	}
+}
+
+func TestCopyToAbortsWhenEntityModified(t *testing.T) {
+	env := newTestEnv(t)
+	svc := newTestMigrationService(env)
+	content := []byte("migrating content")
+	entity := env.putEntity(t, env.primary, "/a.txt", content, "")
+
+	// 上传到目标存储期间文件被覆盖，实体指向了新的内容
+	env.backupP.onUpload = func(string) {
+		env.client.Entity.UpdateOneID(entity.ID).SetSource("/a-new.txt").ExecX(env.ctx)
+	}
+	if err := svc.copyTo(env.ctx, env.primary, env.backup, entity, hashOf(t, content)); err == nil {
+		t.Fatal("实体在迁移过程中被修改时应放弃迁移")
+	}
+	if e := env.entity(t, entity.ID); e.PolicyID != env.primary.ID || e.Source.String != "/a-new.txt" {
+		t.Fatalf("放弃迁移时应保留并发修改后的实体，实际: %+v", e)
+	}
+	if n := env.backupP.count(); n != 0 {
+		t.Fatalf("放弃迁移后应删除已上传的目标内容，实际剩余 %d 个", n)
+	}
+}
+
+func TestMergeIntoTransfersReferences(t *testing.T) {
+	env := newTestEnv(t)
+	svc := newTestMigrationService(env)
+	content := []byte("same content")
+	hash := hashOf(t, content)
+	canonical := env.putEntity(t, env.backup, contentStoreVirtualPath(env.backup, hash, "a.txt"), content, hash)
+	env.putFile(t, "a.txt", canonical)
+	entity := env.putEntity(t, env.primary, "/b.txt", content, hash)
+	file := env.putFile(t, "b.txt", entity)
+
+	if err := svc.mergeInto(env.ctx, env.primary, entity, canonical); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := env.file(t, file.ID).PrimaryEntityID.Uint64; got != uint64(canonical.ID) {
+		t.Fatalf("文件的当前实体应改为目标策略中的实体 %d，实际为 %d", canonical.ID, got)
+	}
+	if e := env.entity(t, canonical.ID); e.ReferenceCount != 2 {
+		t.Fatalf("目标实体的引用计数应为 2，实际为 %d", e.ReferenceCount)
+	}
+	if env.entity(t, entity.ID) != nil {
+		t.Fatal("被合并的实体记录应被删除")
+	}
+	versions, err := env.repos.FileEntity.ListByFileID(env.ctx, file.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || versions[0].EntityID != canonical.ID {
+		t.Fatalf("文件的版本关联应指向目标实体，实际: %+v", versions)
+	}
+}
+
+func TestMergeIntoAbortsWhenEntityModified(t *testing.T) {
+	env := newTestEnv(t)
+	svc := newTestMigrationService(env)
+	content := []byte("same content")
+	hash := hashOf(t, content)
+	canonical := env.putEntity(t, env.backup, contentStoreVirtualPath(env.backup, hash, "a.txt"), content, hash)
+	entity := env.putEntity(t, env.primary, "/b.txt", content, hash)
+	file := env.putFile(t, "b.txt", entity)
+
+	// 读取实体之后文件被覆盖，合并时使用的是过期的实体
+	env.client.Entity.UpdateOneID(entity.ID).SetSource("/b-new.txt").ExecX(env.ctx)
+	if err := svc.mergeInto(env.ctx, env.primary, entity, canonical); err == nil {
+		t.Fatal("实体在迁移过程中被修改时应放弃合并")
+	}
+	if got := env.file(t, file.ID).PrimaryEntityID.Uint64; got != uint64(entity.ID) {
+		t.Fatalf("放弃合并时文件的当前实体不应改变，实际为 %d", got)
+	}
+	if e := env.entity(t, canonical.ID); e.ReferenceCount != 1 {
+		t.Fatalf("放弃合并时目标实体的引用计数不应改变，实际为 %d", e.ReferenceCount)
+	}
+}
+
+func TestMigrateEntityDeletesSourceAfterCopy(t *testing.T) {
+	env := newTestEnv(t)
+	svc := newTestMigrationService(env)
+	content := []byte("migrating content")
+	entity := env.putEntity(t, env.primary, "/a.txt", content, "")
+	env.putFile(t, "a.txt", entity)
+
+	if err := svc.migrateEntity(env.ctx, env.primary, env.backup, entity, true); err != nil {
+		t.Fatal(err)
+	}
+	if e := env.entity(t, entity.ID); e.PolicyID != env.backup.ID {
+		t.Fatalf("实体应迁移到目标策略，实际为策略 %d", e.PolicyID)
+	}
+	if _, ok := env.primaryP.content("/a.txt"); ok {
+		t.Fatal("迁移成功且要求删除源文件时，源内容应被删除")
+	}
+}
+
+func TestVerifyMigratedContent(t *testing.T) {
+	env := newTestEnv(t)
+	content := []byte("verified content")
+	env.backupP.put("/x.txt", content)
+	hash := hashOf(t, content)
+
+	tests := []struct {
+		name    string
+		source  string
+		size    int64
+		hash    string
+		wantErr bool
+	}{
+		{"内容一致", "/x.txt", int64(len(content)), hash, false},
+		{"大小不一致", "/x.txt", int64(len(content)) + 1, hash, true},
+		{"哈希不一致", "/x.txt", int64(len(content)), hashOf(t, []byte("other content!!!")), true},
+		{"内容不存在", "/missing.txt", int64(len(content)), hash, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyMigratedContent(env.ctx, env.backupP, env.backup, tt.source, tt.size, tt.hash)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("verifyMigratedContent() 错误 = %v，期望返回错误: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package file
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -12,6 +14,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/types"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/uri"
 	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
@@ -90,6 +93,15 @@ func (s *serviceImpl) UploadFileByPolicyFlagWithGroup(ctx context.Context, viewe
 		return nil, fmt.Errorf("文件大小 (%d 字节) 超出策略限制 (%d 字节)", fileSize, policy.MaxSize)
 	}
 
+	// 同一存储策略中已有相同内容时直接复用已有的实体，查找失败时按普通上传处理
+	sum := sha256.Sum256(content)
+	contentHash := hex.EncodeToString(sum[:])
+	reusedEntity, err := s.FindReusableEntity(ctx, policy, contentHash, fileSize, 0)
+	if err != nil {
+		log.Printf("【WARN】查找可复用的实体失败，按普通上传处理: %v", err)
+		reusedEntity = nil
+	}
+
 	// 3. 在单个数据库事务中完成所有文件和实体的创建
 	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
 		txFileRepo := repos.File
@@ -116,23 +128,35 @@ func (s *serviceImpl) UploadFileByPolicyFlagWithGroup(ctx context.Context, viewe
 		// 7. 构建完整的虚拟路径并执行物理上传
 		// buildObjectKey 需要完整的虚拟路径（如 /s3/article_images/123.jpg）来正确计算对象键
 		fullVirtualPath := filepath.ToSlash(filepath.Join(parentVirtualPath, filename))
-		uploadResult, err := provider.Upload(ctx, bytes.NewReader(content), policy, fullVirtualPath)
-		if err != nil {
-			return fmt.Errorf("存储驱动上传失败: %w", err)
-		}
+		var newEntity *model.FileStorageEntity
+		var uploadResult *storage.UploadResult
+		if reusedEntity != nil {
+			// 复用的实体不属于本次上传，失败时不能删除它的物理内容
+			if err := txEntityRepo.AddReference(ctx, reusedEntity.ID, 1); err != nil {
+				return fmt.Errorf("增加实体 %d 的引用失败: %w", reusedEntity.ID, err)
+			}
+			newEntity = reusedEntity
+			uploadResult = &storage.UploadResult{Size: reusedEntity.Size}
+		} else {
+			uploadResult, err = provider.Upload(ctx, bytes.NewReader(content), policy, fullVirtualPath)
+			if err != nil {
+				return fmt.Errorf("存储驱动上传失败: %w", err)
+			}
 
-		// 8. 创建文件实体记录 (FileStorageEntity)，代表物理文件
-		newEntity := &model.FileStorageEntity{
-			PolicyID:  policy.ID,
-			CreatedBy: types.NullUint64{Uint64: uint64(viewerID), Valid: viewerID > 0}, // 支持游客上传
-			Source:    sql.NullString{String: uploadResult.Source, Valid: true},
-			Size:      uploadResult.Size,
-			MimeType:  sql.NullString{String: uploadResult.MimeType, Valid: true},
-		}
-		if err := txEntityRepo.Create(ctx, newEntity); err != nil {
-			// 如果数据库记录创建失败，尝试删除刚刚上传的物理文件，防止产生孤立文件
-			go provider.Delete(context.Background(), policy, []string{uploadResult.Source})
-			return fmt.Errorf("创建文件实体记录失败: %w", err)
+			// 8. 创建文件实体记录 (FileStorageEntity)，代表物理文件
+			newEntity = &model.FileStorageEntity{
+				PolicyID:    policy.ID,
+				CreatedBy:   types.NullUint64{Uint64: uint64(viewerID), Valid: viewerID > 0}, // 支持游客上传
+				Source:      sql.NullString{String: uploadResult.Source, Valid: true},
+				Size:        uploadResult.Size,
+				MimeType:    sql.NullString{String: uploadResult.MimeType, Valid: true},
+				ContentHash: sql.NullString{String: contentHash, Valid: true},
+			}
+			if err := txEntityRepo.Create(ctx, newEntity); err != nil {
+				// 如果数据库记录创建失败，尝试删除刚刚上传的物理文件，防止产生孤立文件
+				go provider.Delete(context.Background(), policy, []string{uploadResult.Source})
+				return fmt.Errorf("创建文件实体记录失败: %w", err)
+			}
 		}
 
 		// 9. 创建文件逻辑记录 (File)，代表虚拟文件系统中的条目
@@ -145,9 +169,17 @@ func (s *serviceImpl) UploadFileByPolicyFlagWithGroup(ctx context.Context, viewe
 			PrimaryEntityID: types.NullUint64{Uint64: uint64(newEntity.ID), Valid: true},
 		}
 		if err := txFileRepo.Create(ctx, newFile); err != nil {
-			go provider.Delete(context.Background(), policy, []string{uploadResult.Source})
+			if reusedEntity == nil {
+				go provider.Delete(context.Background(), policy, []string{uploadResult.Source})
+			}
 			return fmt.Errorf("创建文件记录失败: %w", err)
 		}
+		if err := repos.User.AdjustStorageUsed(ctx, systemOwnerID, newFile.Size); err != nil {
+			if reusedEntity == nil {
+				go provider.Delete(context.Background(), policy, []string{uploadResult.Source})
+			}
+			return fmt.Errorf("更新存储用量失败: %w", err)
+		}
 
 		// 将事务内创建的 newFile 赋值给外部变量
 		createdFile = newFile
@@ -202,6 +234,15 @@ func (s *serviceImpl) CopyItems(ctx context.Context, ownerID uint, sourcePublicI
 		return fmt.Errorf("无权复制到目标文件夹: %w", constant.ErrForbidden)
 	}
 
+	// 在创建任何物理副本之前校验容量
+	copySize, err := s.sumCopySize(ctx, sourcePublicIDs)
+	if err != nil {
+		return err
+	}
+	if err := s.quotaSvc.CheckQuota(ctx, ownerID, copySize); err != nil {
+		return err
+	}
+
 	// 2. 将所有复制操作包裹在单个事务中，以确保原子性
 	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
 		// 循环处理每一个要复制的源项目
@@ -219,9 +260,19 @@ func (s *serviceImpl) CopyItems(ctx context.Context, ownerID uint, sourcePublicI
 			if srcItem.OwnerID != ownerID {
 				return fmt.Errorf("无权复制项目 '%s': %w", srcItem.Name, constant.ErrForbidden)
 			}
+			if err := s.releaseName(ctx, repos, destFolder.ID, srcItem.Name); err != nil {
+				return err
+			}
+			destPath, err := s.GetFolderPathWithRepo(ctx, destFolder.ID, repos.File)
+			if err != nil {
+				return fmt.Errorf("无法获取目标文件夹的路径: %w", err)
+			}
+			if err := s.preserveTrashedContent(ctx, repos, ownerID, filepath.ToSlash(filepath.Join(destPath, srcItem.Name)), srcItem.Type == model.FileTypeDir); err != nil {
+				return err
+			}
 
 			// 调用递归辅助函数来执行真正的复制，并传入所有需要的事务性 repo
-			err = s.CopyRecursively(ctx, ownerID, srcItem, destFolder, repos.File, repos.Entity, repos.Metadata)
+			err = s.CopyRecursively(ctx, ownerID, srcItem, destFolder, repos.File, repos.Entity, repos.Metadata, repos.User)
 			if err != nil {
 				// 一旦有任何错误（包括命名冲突），立即返回错误。
 				// txManager 会捕获这个错误并回滚整个事务。
@@ -232,6 +283,36 @@ func (s *serviceImpl) CopyItems(ctx context.Context, ownerID uint, sourcePublicI
 	})
 }
 
+// sumCopySize 统计待复制项目的总大小，目录按其下全部文件计算。
+// 无效或不存在的ID在这里直接跳过，由后续的复制流程报告错误。
+func (s *serviceImpl) sumCopySize(ctx context.Context, sourcePublicIDs []string) (int64, error) {
+	var total int64
+	for _, srcPublicID := range sourcePublicIDs {
+		srcID, srcEntityType, err := idgen.DecodePublicID(srcPublicID)
+		if err != nil || srcEntityType != idgen.EntityTypeFile {
+			continue
+		}
+		srcItem, err := s.fileRepo.FindByID(ctx, srcID)
+		if err != nil {
+			continue
+		}
+		if srcItem.Type != model.FileTypeDir {
+			total += srcItem.Size
+			continue
+		}
+		infos, err := s.fileRepo.GetDescendantFileInfo(ctx, srcItem.ID)
+		if err != nil {
+			return 0, fmt.Errorf("统计文件夹 '%s' 的大小失败: %w", srcItem.Name, err)
+		}
+		for _, info := range infos {
+			if info != nil {
+				total += info.Size
+			}
+		}
+	}
+	return total, nil
+}
+
 // MoveItems 将一个或多个源文件/文件夹移动到目标文件夹。
 // 整个过程在一个单一的数据库事务中执行，以确保所有操作的原子性。
 func (s *serviceImpl) MoveItems(ctx context.Context, ownerID uint, sourcePublicIDs []string, destPublicFolderID string) error {
@@ -299,6 +380,9 @@ func (s *serviceImpl) MoveItems(ctx context.Context, ownerID uint, sourcePublicI
 				}
 				return fmt.Errorf("检查名称冲突时出错: %w", err)
 			}
+			if err := s.releaseName(ctx, repos, destFolder.ID, srcItem.Name); err != nil {
+				return err
+			}
 
 			// --- 4. 物理移动 ---
 			oldParentPath, err := s.GetFolderPathWithRepo(ctx, uint(srcItem.ParentID.Int64), repos.File)
@@ -311,6 +395,9 @@ func (s *serviceImpl) MoveItems(ctx context.Context, ownerID uint, sourcePublicI
 			}
 			oldVirtualPath := filepath.ToSlash(filepath.Join(oldParentPath, srcItem.Name))
 			newVirtualPath := filepath.ToSlash(filepath.Join(newParentPath, srcItem.Name))
+			if err := s.preserveTrashedContent(ctx, repos, ownerID, newVirtualPath, srcItem.Type == model.FileTypeDir); err != nil {
+				return err
+			}
 
 			policy, err := s.vfsSvc.FindPolicyForPath(ctx, oldVirtualPath)
 			if err != nil {
@@ -328,9 +415,17 @@ func (s *serviceImpl) MoveItems(ctx context.Context, ownerID uint, sourcePublicI
 				if err != nil {
 					return err
 				}
-				log.Printf("【MOVE INFO】准备物理移动: 从 '%s' 到 '%s'", oldRelativePath, newRelativePath)
-				if err := provider.Rename(ctx, policy, oldRelativePath, newRelativePath); err != nil {
-					return fmt.Errorf("物理移动失败: %w", err)
+				shared, err := isContentAddressedFile(ctx, repos.Entity, srcItem)
+				if err != nil {
+					return err
+				}
+				if shared {
+					log.Printf("【MOVE INFO】文件 '%s' 的内容位于共享位置，跳过物理移动", srcItem.Name)
+				} else {
+					log.Printf("【MOVE INFO】准备物理移动: 从 '%s' 到 '%s'", oldRelativePath, newRelativePath)
+					if err := provider.Rename(ctx, policy, oldRelativePath, newRelativePath); err != nil {
+						return fmt.Errorf("物理移动失败: %w", err)
+					}
 				}
 			}
 
@@ -345,24 +440,19 @@ func (s *serviceImpl) MoveItems(ctx context.Context, ownerID uint, sourcePublicI
 	})
 }
 
-// DeleteItems 是删除文件或文件夹的入口，执行永久删除。
-func (s *serviceImpl) DeleteItems(ctx context.Context, ownerID uint, publicIDs []string) error {
-	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
-		for _, publicID := range publicIDs {
-			dbID, entityType, err := idgen.DecodePublicID(publicID)
-			if err != nil || entityType != idgen.EntityTypeFile {
-				log.Printf("【DELETE WARN】无效的公共ID '%s' 或类型不匹配，跳过删除。", publicID)
-				continue
-			}
-
-			// 调用新的 HardDeleteRecursively，并传入所有需要的 repo
-			err = s.HardDeleteRecursively(ctx, ownerID, dbID, repos.File, repos.Entity, repos.FileEntity, repos.Metadata, repos.StoragePolicy, repos.DirectLink)
-			if err != nil {
-				return fmt.Errorf("删除项目 '%s' (ID: %d) 失败: %w", publicID, dbID, err)
-			}
+// isContentAddressedFile 判断文件的内容是否存放在内容寻址目录中，这样的文件在自身路径上没有物理文件
+func isContentAddressedFile(ctx context.Context, entityRepo repository.EntityRepository, file *model.File) (bool, error) {
+	if file.Type != model.FileTypeFile || !file.PrimaryEntityID.Valid {
+		return false, nil
+	}
+	entity, err := entityRepo.FindByID(ctx, uint(file.PrimaryEntityID.Uint64))
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return false, nil
 		}
-		return nil
-	})
+		return false, fmt.Errorf("查找文件 '%s' 的物理实体失败: %w", file.Name, err)
+	}
+	return entity != nil && entity.IsContentAddressed(), nil
 }
 
 // RenameItem 重命名一个文件或目录。
@@ -409,10 +499,16 @@ func (s *serviceImpl) RenameItem(ctx context.Context, ownerID uint, req *model.R
 			}
 			return fmt.Errorf("检查同名冲突时出错: %w", err)
 		}
+		if err := s.releaseName(ctx, repos, uint(itemToRename.ParentID.Int64), sanitizedNewName); err != nil {
+			return err
+		}
 
 		// 执行物理重命名
 		oldVirtualPath := filepath.ToSlash(filepath.Join(parentPath, itemToRename.Name))
 		newVirtualPath := filepath.ToSlash(filepath.Join(parentPath, sanitizedNewName))
+		if err := s.preserveTrashedContent(ctx, repos, ownerID, newVirtualPath, itemToRename.Type == model.FileTypeDir); err != nil {
+			return err
+		}
 
 		policy, err := s.vfsSvc.FindPolicyForPath(ctx, oldVirtualPath)
 		if err != nil {
@@ -429,8 +525,15 @@ func (s *serviceImpl) RenameItem(ctx context.Context, ownerID uint, req *model.R
 			return err
 		}
 
-		if err := provider.Rename(ctx, policy, oldRelativePath, newRelativePath); err != nil {
-			return fmt.Errorf("物理重命名失败: %w", err)
+		shared, err := isContentAddressedFile(ctx, repos.Entity, itemToRename)
+		if err != nil {
+			return err
+		}
+		// 共享内容不在文件自身的路径上，只需要修改数据库中的名称
+		if !shared {
+			if err := provider.Rename(ctx, policy, oldRelativePath, newRelativePath); err != nil {
+				return fmt.Errorf("物理重命名失败: %w", err)
+			}
 		}
 
 		// 更新数据库记录
@@ -492,6 +595,15 @@ func (s *serviceImpl) CreateEmptyFile(ctx context.Context, ownerID uint, req *mo
 			createdFileItem = s.BuildFileItemDTO(existing, ownerID, parentPath, "")
 			return nil
 		}
+		if err := s.releaseName(ctx, repos, parentFolder.ID, newItemName); err != nil {
+			return err
+		}
+		// 空文件之后写入内容时会直接覆盖该位置，需要先保留仍在这里的回收站文件
+		if fileType == model.FileTypeFile {
+			if err := s.preserveTrashedContent(ctx, repos, ownerID, parsedURI.Path, false); err != nil {
+				return err
+			}
+		}
 
 		// 获取存储策略和提供者
 		policy, _ := s.vfsSvc.FindPolicyForPath(ctx, parsedURI.Path)
@@ -580,63 +692,13 @@ func (s *serviceImpl) UpdateFileContentByIDAndURI(ctx context.Context, viewerPub
 		return nil, fmt.Errorf("读取内容流失败: %w", err)
 	}
 
-	// 5. 确定存储策略和驱动 (使用验证过的路径)
-	policy, err := s.vfsSvc.FindPolicyForPath(ctx, currentVirtualPath)
-	if err != nil {
-		return nil, fmt.Errorf("找不到路径 %s 的存储策略: %w", currentVirtualPath, err)
-	}
-
-	provider, err := s.GetProviderForPolicy(policy)
-	if err != nil {
-		return nil, err
-	}
-
-	// 6. 上传新内容
-	uploadResult, err := provider.Upload(ctx, bytes.NewReader(newContent), policy, currentVirtualPath)
-	if err != nil {
-		return nil, fmt.Errorf("存储驱动上传新内容失败: %w", err)
-	}
-
-	// 7. 在数据库事务中执行更新 (这部分逻辑与之前完全相同)
-	var updatedFile *model.File
-	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
-		// ... (创建新实体，更新文件记录) ...
-		// (省略重复代码)
-		txFileRepo := repos.File
-		txEntityRepo := repos.Entity
-
-		newEntity := &model.FileStorageEntity{
-			PolicyID:  policy.ID,
-			CreatedBy: types.NullUint64{Uint64: uint64(viewerID), Valid: true},
-			Source:    sql.NullString{String: uploadResult.Source, Valid: true},
-			Size:      uploadResult.Size,
-			MimeType:  sql.NullString{String: uploadResult.MimeType, Valid: true},
-		}
-		if err := txEntityRepo.Create(ctx, newEntity); err != nil {
-			return fmt.Errorf("创建新的文件实体失败: %w", err)
-		}
-
-		fileToUpdate, err := txFileRepo.FindByID(ctx, fileID)
-		if err != nil {
-			return err
-		}
-
-		fileToUpdate.Size = newEntity.Size
-		fileToUpdate.PrimaryEntityID = types.NullUint64{Uint64: uint64(newEntity.ID), Valid: true}
-		if err := txFileRepo.Update(ctx, fileToUpdate); err != nil {
-			return fmt.Errorf("更新文件记录失败: %w", err)
-		}
-
-		updatedFile = fileToUpdate
-		return nil
-	})
-
+	// 5. 保留旧内容并写入新内容
+	updatedFile, err := s.replaceContent(ctx, file, currentVirtualPath, bytes.NewReader(newContent), int64(len(newContent)), uint(viewerID))
 	if err != nil {
-		go provider.Delete(context.Background(), policy, []string{uploadResult.Source})
 		return nil, err
 	}
 
-	// 8. 准备并返回成功的响应DTO
+	// 6. 准备并返回成功的响应DTO
 	return &model.UpdateResult{
 		PublicID:  filePublicID,
 		Size:      updatedFile.Size,
@@ -0,0 +1,105 @@
+package file
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// StorageUsage 描述用户当前的存储用量和所在用户组的限制
+type StorageUsage struct {
+	Used       int64 `json:"used"`        // 已使用的存储容量（字节）
+	Total      int64 `json:"total"`       // 容量上限（字节），0 表示不限制
+	SpeedLimit int64 `json:"speed_limit"` // 下载限速（字节/秒），0 表示不限制
+}
+
+// IQuotaService 负责用户存储容量的校验与统计，以及下载限速的查询。
+// 用量在文件创建和删除时通过 repository.UserRepository.AdjustStorageUsed 增量维护。
+type IQuotaService interface {
+	// GetUsage 获取用户的存储用量
+	GetUsage(ctx context.Context, userID uint) (*StorageUsage, error)
+	// Recalculate 根据用户名下的文件重新统计存储用量，用于修正历史数据
+	Recalculate(ctx context.Context, userID uint) (*StorageUsage, error)
+	// CheckQuota 校验用户再写入 additional 字节后是否会超出容量上限，超出时返回 constant.ErrStorageQuotaExceeded
+	CheckQuota(ctx context.Context, userID uint, additional int64) error
+	// GetSpeedLimit 获取用户所在用户组的下载限速（字节/秒），0 表示不限制
+	GetSpeedLimit(ctx context.Context, userID uint) int64
+}
+
+type quotaService struct {
+	userRepo repository.UserRepository
+}
+
+// NewQuotaService 是 quotaService 的构造函数
+func NewQuotaService(userRepo repository.UserRepository) IQuotaService {
+	return &quotaService{userRepo: userRepo}
+}
+
+// GetUsage 实现 IQuotaService 接口
+func (s *quotaService) GetUsage(ctx context.Context, userID uint) (*StorageUsage, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toStorageUsage(user, user.StorageUsed), nil
+}
+
+// Recalculate 实现 IQuotaService 接口
+func (s *quotaService) Recalculate(ctx context.Context, userID uint) (*StorageUsage, error) {
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	used, err := s.userRepo.RecalculateStorageUsed(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("重新统计存储用量失败: %w", err)
+	}
+	return toStorageUsage(user, used), nil
+}
+
+// CheckQuota 实现 IQuotaService 接口
+func (s *quotaService) CheckQuota(ctx context.Context, userID uint, additional int64) error {
+	if additional <= 0 {
+		return nil
+	}
+	user, err := s.findUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	maxStorage := user.UserGroup.MaxStorage
+	if maxStorage > 0 && user.StorageUsed+additional > maxStorage {
+		return fmt.Errorf("%w（已用 %d 字节，上限 %d 字节，本次需要 %d 字节）", constant.ErrStorageQuotaExceeded, user.StorageUsed, maxStorage, additional)
+	}
+	return nil
+}
+
+// GetSpeedLimit 实现 IQuotaService 接口
+func (s *quotaService) GetSpeedLimit(ctx context.Context, userID uint) int64 {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return 0
+	}
+	return user.UserGroup.SpeedLimit
+}
+
+func (s *quotaService) findUser(ctx context.Context, userID uint) (*model.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("用户不存在: %w", constant.ErrNotFound)
+	}
+	return user, nil
+}
+
+func toStorageUsage(user *model.User, used int64) *StorageUsage {
+	return &StorageUsage{
+		Used:       used,
+		Total:      user.UserGroup.MaxStorage,
+		SpeedLimit: user.UserGroup.SpeedLimit,
+	}
+}
@@ -33,8 +33,39 @@ type FileService interface {
 	// UpdateFileContent 更新一个已存在的文件的内容
 	UpdateFileContentByIDAndURI(ctx context.Context, viewerPublicID, filePublicID, uriStr string, contentReader io.Reader) (*model.UpdateResult, error)
 
-	// DeleteItems 根据一个或多个公共ID，批量永久删除文件或目录。
+	// DeleteItems 根据一个或多个公共ID，批量将文件或目录移入回收站。
 	DeleteItems(ctx context.Context, ownerID uint, publicIDs []string) error
+	// DeleteItemsPermanently 根据一个或多个公共ID，批量永久删除文件或目录，包括回收站中的项目。
+	DeleteItemsPermanently(ctx context.Context, ownerID uint, publicIDs []string) error
+	// RestoreItems 将回收站中的项目还原到删除前的位置。
+	RestoreItems(ctx context.Context, ownerID uint, publicIDs []string) error
+	// EmptyTrash 永久删除用户回收站中的全部项目，返回删除的项目数。
+	EmptyTrash(ctx context.Context, ownerID uint) (int, error)
+	// PurgeExpiredTrash 永久删除所有用户在回收站中超过保留天数的项目，返回删除的项目数。
+	PurgeExpiredTrash(ctx context.Context) (int, error)
+	// ReleaseTrashedPath 在新项目写入虚拟路径前，释放已删除记录占用的名称，并保留物理内容仍在该路径上的回收站文件，必须在事务中调用。
+	ReleaseTrashedPath(ctx context.Context, repos repository.Repositories, ownerID uint, virtualPath string) error
+	// ArchiveVersion 在覆盖虚拟路径上已有文件的内容前，按存储策略的设置把当前内容复制到历史版本目录。
+	// incomingSize 是即将写入的新内容大小，保留旧版本时需要完整计入容量。路径上没有文件时不做任何操作
+	ArchiveVersion(ctx context.Context, ownerID uint, virtualPath string, incomingSize int64) error
+	// CommitVersion 在文件内容被替换后登记新的当前版本，把覆盖前保留的旧内容登记为历史版本并计入容量，
+	// 同时清理没有保留为历史版本的旧实体，必须在事务中调用。
+	CommitVersion(ctx context.Context, repos repository.Repositories, fileID, oldEntityID, newEntityID, uploaderID uint) error
+	// PruneVersions 按存储策略的版本数上限删除文件最旧的历史版本。
+	PruneVersions(ctx context.Context, fileID uint) error
+	// ListVersions 列出文件的当前版本和全部历史版本。
+	ListVersions(ctx context.Context, ownerID uint, filePublicID string) ([]*model.FileVersionItem, error)
+	// DownloadVersion 下载文件的某个历史版本。
+	DownloadVersion(ctx context.Context, ownerID uint, filePublicID, versionPublicID string, writer io.Writer) (*DownloadResult, error)
+	// RestoreVersion 将某个历史版本恢复为文件的当前内容，恢复前的内容会被保留为历史版本。
+	RestoreVersion(ctx context.Context, ownerID uint, filePublicID, versionPublicID string) (*model.UpdateResult, error)
+	// DeleteVersion 删除文件的某个历史版本并释放其占用的容量。
+	DeleteVersion(ctx context.Context, ownerID uint, filePublicID, versionPublicID string) error
+	// FindReusableEntity 在存储策略中查找内容哈希和大小都相同、可以直接复用的实体，没有时返回 nil。
+	// 找到的实体会先被移动到内容寻址目录，调用方复用时需要为它增加引用计数
+	FindReusableEntity(ctx context.Context, policy *model.StoragePolicy, contentHash string, size int64, excludeEntityID uint) (*model.FileStorageEntity, error)
+	// DeduplicateExistingFiles 为尚未计算内容哈希的实体补充哈希，并合并内容相同的实体，返回合并的实体数。
+	DeduplicateExistingFiles(ctx context.Context) (int, error)
 	// RenameItem 重命名一个文件或目录。
 	RenameItem(ctx context.Context, ownerID uint, req *model.RenameItemRequest) (*model.FileInfoResponse, error)
 	// Download 提供一个流式下载文件的服务。
@@ -81,6 +112,12 @@ type FileService interface {
 
 	// GetPolicyByFlag 根据策略标志（如 article_image）获取存储策略
 	GetPolicyByFlag(ctx context.Context, policyFlag string) (*model.StoragePolicy, error)
+
+	// ResolveShare 校验分享链接的状态和访问密码，并返回分享内 subPath 对应的文件或文件夹
+	ResolveShare(ctx context.Context, publicShareID, password, subPath string) (*model.Share, *model.File, error)
+
+	// BuildFileItemDTO 将文件模型转换为返回给前端的 FileItem
+	BuildFileItemDTO(file *model.File, viewerID uint, parentPath string, url string) *model.FileItem
 }
 
 // serviceImpl 是 FileService 接口的实现。
@@ -101,6 +138,8 @@ type serviceImpl struct {
 	storageProviders  map[constant.StoragePolicyType]storage.IStorageProvider
 	eventBus          *event.EventBus
 	pathLocker        *utility.PathLocker
+	quotaSvc          IQuotaService
+	shareRepo         repository.ShareRepository
 }
 
 // NewService 是 serviceImpl 的构造函数，通过依赖注入接收所有必要的依赖项。
@@ -121,6 +160,8 @@ func NewService(
 	providers map[constant.StoragePolicyType]storage.IStorageProvider,
 	eventBus *event.EventBus,
 	pathLocker *utility.PathLocker,
+	quotaSvc IQuotaService,
+	shareRepo repository.ShareRepository,
 ) FileService {
 	return &serviceImpl{
 		fileRepo:          fileRepo,
@@ -139,5 +180,7 @@ func NewService(
 		storageProviders:  providers,
 		eventBus:          eventBus,
 		pathLocker:        pathLocker,
+		quotaSvc:          quotaSvc,
+		shareRepo:         shareRepo,
 	}
 }
@@ -0,0 +1,356 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/anzhiyu-c/anheyu-app/ent"
+	_ "github.com/anzhiyu-c/anheyu-app/ent/runtime"
+	persistence "github.com/anzhiyu-c/anheyu-app/internal/infra/persistence/ent"
+	"github.com/anzhiyu-c/anheyu-app/internal/infra/storage"
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/types"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+)
+
+// memProvider 是保存在内存中的存储驱动，存储位置为去掉策略虚拟路径前缀后的路径
+type memProvider struct {
+	storage.IStorageProvider
+
+	mu    sync.Mutex
+	files map[string][]byte
+	// corrupt 为 true 时写入的内容会被篡改，模拟目标存储损坏
+	corrupt bool
+	// onUpload 在写入完成后调用，用于模拟写入期间数据库被并发修改
+	onUpload func(source string)
+	// deleted 按顺序记录传给 Delete 的存储位置
+	deleted []string
+}
+
+func newMemProvider() *memProvider {
+	return &memProvider{files: make(map[string][]byte)}
+}
+
+func (p *memProvider) Upload(ctx context.Context, r io.Reader, policy *model.StoragePolicy, virtualPath string) (*storage.UploadResult, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if p.corrupt && len(data) > 0 {
+		data = append([]byte{data[0] ^ 0xff}, data[1:]...)
+	}
+	source := strings.TrimPrefix(virtualPath, strings.TrimSuffix(policy.VirtualPath, "/"))
+	p.put(source, data)
+	if p.onUpload != nil {
+		p.onUpload(source)
+	}
+	return &storage.UploadResult{Source: source, Size: int64(len(data))}, nil
+}
+
+func (p *memProvider) Get(ctx context.Context, policy *model.StoragePolicy, source string) (io.ReadCloser, error) {
+	data, ok := p.content(source)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", source, os.ErrNotExist)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (p *memProvider) Delete(ctx context.Context, policy *model.StoragePolicy, sources []string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, source := range sources {
+		delete(p.files, source)
+		p.deleted = append(p.deleted, source)
+	}
+	return nil
+}
+
+func (p *memProvider) DeleteDirectory(ctx context.Context, policy *model.StoragePolicy, virtualPath string) error {
+	return nil
+}
+
+func (p *memProvider) put(source string, data []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.files[source] = data
+}
+
+func (p *memProvider) content(source string) ([]byte, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	data, ok := p.files[source]
+	return data, ok
+}
+
+func (p *memProvider) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.files)
+}
+
+// fakePolicyService 只实现按数据库ID查找存储策略
+type fakePolicyService struct {
+	volume.IStoragePolicyService
+	policies map[uint]*model.StoragePolicy
+}
+
+func (f *fakePolicyService) GetPolicyByDatabaseID(ctx context.Context, id uint) (*model.StoragePolicy, error) {
+	policy, ok := f.policies[id]
+	if !ok {
+		return nil, constant.ErrNotFound
+	}
+	return policy, nil
+}
+
+// fakeVFSService 按虚拟路径前缀在两个测试存储策略之间选择
+type fakeVFSService struct {
+	volume.IVFSService
+	env *testEnv
+}
+
+func (f *fakeVFSService) FindPolicyForPath(ctx context.Context, virtualPath string) (*model.StoragePolicy, error) {
+	if virtualPath == f.env.backup.VirtualPath || strings.HasPrefix(virtualPath, f.env.backup.VirtualPath+"/") {
+		return f.env.backup, nil
+	}
+	return f.env.primary, nil
+}
+
+// fakeQuotaService 不限制存储容量
+type fakeQuotaService struct {
+	IQuotaService
+}
+
+func (fakeQuotaService) CheckQuota(ctx context.Context, userID uint, additional int64) error {
+	return nil
+}
+
+// testEnv 是文件服务测试的运行环境：内存 SQLite 数据库中的真实仓储，
+// 以及两个分别使用独立内存存储驱动的存储策略
+type testEnv struct {
+	ctx      context.Context
+	client   *ent.Client
+	repos    repository.Repositories
+	svc      *serviceImpl
+	ownerID  uint
+	root     *model.File
+	primary  *model.StoragePolicy // 本地存储策略，虚拟路径为 /
+	backup   *model.StoragePolicy // SFTP 存储策略，虚拟路径为 /backup
+	primaryP *memProvider
+	backupP  *memProvider
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	ctx := context.Background()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_pragma=foreign_keys(1)", strings.ReplaceAll(t.Name(), "/", "_"))
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 内存数据库只在连接存活期间存在，单个连接同时保证事务串行执行
+	db.SetMaxOpenConns(1)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { _ = client.Close() })
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatalf("创建表结构失败: %v", err)
+	}
+
+	env := &testEnv{
+		ctx:    ctx,
+		client: client,
+		repos: repository.Repositories{
+			File:          persistence.NewEntFileRepository(client, db, "sqlite"),
+			Entity:        persistence.NewEntEntityRepository(client),
+			FileEntity:    persistence.NewEntFileEntityRepository(client),
+			Metadata:      persistence.NewEntMetadataRepository(client),
+			StoragePolicy: persistence.NewEntStoragePolicyRepository(client),
+			DirectLink:    persistence.NewEntDirectLinkRepository(client),
+			User:          persistence.NewEntUserRepository(client),
+		},
+		primaryP: newMemProvider(),
+		backupP:  newMemProvider(),
+	}
+
+	group, err := client.UserGroup.Create().SetName("用户").SetPermissions(model.Boolset{}).Save(ctx)
+	if err != nil {
+		t.Fatalf("创建用户组失败: %v", err)
+	}
+	owner, err := client.User.Create().SetUsername("owner").SetPasswordHash("x").SetUserGroupID(group.ID).Save(ctx)
+	if err != nil {
+		t.Fatalf("创建用户失败: %v", err)
+	}
+	env.ownerID = owner.ID
+	env.root = &model.File{OwnerID: owner.ID, Name: "", Type: model.FileTypeDir}
+	if err := env.repos.File.Create(ctx, env.root); err != nil {
+		t.Fatalf("创建根目录失败: %v", err)
+	}
+
+	env.primary = &model.StoragePolicy{Name: "本地", Type: constant.PolicyTypeLocal, VirtualPath: "/", Settings: model.StoragePolicySettings{}}
+	env.backup = &model.StoragePolicy{Name: "备份", Type: constant.PolicyTypeSFTP, VirtualPath: "/backup", Settings: model.StoragePolicySettings{}}
+	for _, policy := range []*model.StoragePolicy{env.primary, env.backup} {
+		if err := env.repos.StoragePolicy.Create(ctx, policy); err != nil {
+			t.Fatalf("创建存储策略失败: %v", err)
+		}
+	}
+
+	env.svc = &serviceImpl{
+		fileRepo:          env.repos.File,
+		storagePolicyRepo: env.repos.StoragePolicy,
+		txManager:         persistence.NewEntTransactionManager(client, db, "sqlite"),
+		entityRepo:        env.repos.Entity,
+		fileEntityRepo:    env.repos.FileEntity,
+		policySvc: &fakePolicyService{policies: map[uint]*model.StoragePolicy{
+			env.primary.ID: env.primary,
+			env.backup.ID:  env.backup,
+		}},
+		storageProviders: map[constant.StoragePolicyType]storage.IStorageProvider{
+			constant.PolicyTypeLocal: env.primaryP,
+			constant.PolicyTypeSFTP:  env.backupP,
+		},
+		quotaSvc: fakeQuotaService{},
+		vfsSvc:   &fakeVFSService{env: env},
+	}
+	return env
+}
+
+// putEntity 把内容写入存储策略的 virtualPath 并登记一个实体，contentHash 为空时不记录哈希
+func (env *testEnv) putEntity(t *testing.T, policy *model.StoragePolicy, virtualPath string, content []byte, contentHash string) *model.FileStorageEntity {
+	t.Helper()
+	provider := env.primaryP
+	if policy.ID == env.backup.ID {
+		provider = env.backupP
+	}
+	result, err := provider.Upload(env.ctx, bytes.NewReader(content), policy, virtualPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entity := &model.FileStorageEntity{
+		Type:     model.EntityTypeFileContentModel,
+		Source:   sql.NullString{String: result.Source, Valid: true},
+		Size:     int64(len(content)),
+		PolicyID: policy.ID,
+	}
+	if contentHash != "" {
+		entity.ContentHash = sql.NullString{String: contentHash, Valid: true}
+	}
+	if err := env.repos.Entity.Create(env.ctx, entity); err != nil {
+		t.Fatalf("创建实体失败: %v", err)
+	}
+	return entity
+}
+
+// putFile 在根目录下创建一个以 entity 为当前内容的文件，并计入所有者的存储用量
+func (env *testEnv) putFile(t *testing.T, name string, entity *model.FileStorageEntity) *model.File {
+	t.Helper()
+	return env.putFileIn(t, env.root, name, entity)
+}
+
+// putFileIn 在 parent 下创建一个以 entity 为当前内容的文件，并计入所有者的存储用量
+func (env *testEnv) putFileIn(t *testing.T, parent *model.File, name string, entity *model.FileStorageEntity) *model.File {
+	t.Helper()
+	file := &model.File{
+		OwnerID:         env.ownerID,
+		ParentID:        sql.NullInt64{Int64: int64(parent.ID), Valid: true},
+		Name:            name,
+		Size:            entity.Size,
+		Type:            model.FileTypeFile,
+		PrimaryEntityID: types.NullUint64{Uint64: uint64(entity.ID), Valid: true},
+	}
+	if err := env.repos.File.Create(env.ctx, file); err != nil {
+		t.Fatalf("创建文件失败: %v", err)
+	}
+	version := &model.FileStorageVersion{FileID: file.ID, EntityID: entity.ID, IsCurrent: true}
+	if err := env.repos.FileEntity.Create(env.ctx, version); err != nil {
+		t.Fatalf("创建文件版本关联记录失败: %v", err)
+	}
+	if err := env.repos.User.AdjustStorageUsed(env.ctx, env.ownerID, entity.Size); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// putDir 在 parent 下创建一个文件夹
+func (env *testEnv) putDir(t *testing.T, parent *model.File, name string) *model.File {
+	t.Helper()
+	dir := &model.File{
+		OwnerID:  env.ownerID,
+		ParentID: sql.NullInt64{Int64: int64(parent.ID), Valid: true},
+		Name:     name,
+		Type:     model.FileTypeDir,
+	}
+	if err := env.repos.File.Create(env.ctx, dir); err != nil {
+		t.Fatalf("创建文件夹失败: %v", err)
+	}
+	return dir
+}
+
+// entity 重新读取实体，不存在时返回 nil
+func (env *testEnv) entity(t *testing.T, id uint) *model.FileStorageEntity {
+	t.Helper()
+	entity, err := env.repos.Entity.FindByID(env.ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entity
+}
+
+// file 重新读取文件
+func (env *testEnv) file(t *testing.T, id uint) *model.File {
+	t.Helper()
+	file, err := env.repos.File.FindByID(env.ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// storageUsed 返回所有者当前的存储用量
+func (env *testEnv) storageUsed(t *testing.T) int64 {
+	t.Helper()
+	user, err := env.client.User.Get(env.ctx, env.ownerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return user.StorageUsed
+}
+
+// hardDelete 在事务中永久删除文件
+func (env *testEnv) hardDelete(t *testing.T, fileID uint) {
+	t.Helper()
+	err := env.svc.txManager.Do(env.ctx, func(repos repository.Repositories) error {
+		return env.svc.HardDeleteRecursively(env.ctx, env.ownerID, fileID, repos.File, repos.Entity, repos.FileEntity, repos.Metadata, repos.StoragePolicy, repos.DirectLink, repos.User)
+	})
+	if err != nil {
+		t.Fatalf("永久删除文件 %d 失败: %v", fileID, err)
+	}
+}
+
+// hashOf 返回内容的 SHA-256 哈希
+func hashOf(t *testing.T, content []byte) string {
+	t.Helper()
+	hash, err := computeContentHash(bytes.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hash
+}
+
+// contentStoreSource 返回内容在策略内容寻址目录中的存储位置
+func contentStoreSource(policy *model.StoragePolicy, content []byte, name string, t *testing.T) string {
+	return strings.TrimPrefix(contentStoreVirtualPath(policy, hashOf(t, content), name), strings.TrimSuffix(policy.VirtualPath, "/"))
+}
@@ -0,0 +1,148 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/uri"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// ShareURI 生成分享内某个路径的虚拟 URI，形如 anzhiyu://<分享ID>@share/<路径>。
+// URI 中不包含访问密码，访问者需要自行附加。
+func ShareURI(publicShareID, sharePath string) string {
+	return (&url.URL{Scheme: "anzhiyu", User: url.User(publicShareID), Host: "share", Path: sharePath}).String()
+}
+
+// ResolveShare 校验分享链接的状态和访问密码，并返回分享内 subPath 对应的文件或文件夹。
+// subPath 是相对于被分享项目的路径，"/" 表示被分享的项目本身。
+func (s *serviceImpl) ResolveShare(ctx context.Context, publicShareID, password, subPath string) (*model.Share, *model.File, error) {
+	shareID, entityType, err := idgen.DecodePublicID(publicShareID)
+	if err != nil || entityType != idgen.EntityTypeShare {
+		return nil, nil, constant.ErrShareNotFound
+	}
+	share, err := s.shareRepo.FindByID(ctx, shareID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询分享失败: %w", err)
+	}
+	if share == nil {
+		return nil, nil, constant.ErrShareNotFound
+	}
+	if share.Status(time.Now()) != model.ShareStatusActive {
+		return nil, nil, constant.ErrShareUnavailable
+	}
+	if share.Password != "" && password == "" {
+		return nil, nil, constant.ErrSharePasswordRequired
+	}
+	if !share.CheckPassword(password) {
+		return nil, nil, constant.ErrSharePasswordInvalid
+	}
+
+	// 被分享的项目移入回收站或被删除后，分享随之失效
+	current, err := s.fileRepo.FindByID(ctx, share.FileID)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil, nil, constant.ErrShareNotFound
+		}
+		return nil, nil, fmt.Errorf("查询被分享的文件失败: %w", err)
+	}
+	if current.OwnerID != share.OwnerID {
+		return nil, nil, constant.ErrShareNotFound
+	}
+
+	// path.Clean 会消除 ".."，访问者无法越过被分享的文件夹
+	cleaned := strings.Trim(path.Clean("/"+subPath), "/")
+	if cleaned == "" {
+		return share, current, nil
+	}
+	for _, segment := range strings.Split(cleaned, "/") {
+		if current.Type != model.FileTypeDir {
+			return nil, nil, constant.ErrNotFound
+		}
+		current, err = s.fileRepo.FindByParentIDAndName(ctx, current.ID, segment)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return share, current, nil
+}
+
+// queryShare 列出分享内的文件，供访问者浏览分享的文件夹。
+// URI 形如 anzhiyu://<分享ID>:<密码>@share/<子路径>，被分享的是单个文件时只能访问根路径。
+func (s *serviceImpl) queryShare(ctx context.Context, viewerID uint, parsedURI *uri.ParsedURI) (*model.FileListResponse, error) {
+	_, target, err := s.ResolveShare(ctx, parsedURI.FSID, parsedURI.Password, parsedURI.Path)
+	if err != nil {
+		return nil, err
+	}
+	sharePath := path.Clean("/" + parsedURI.Path)
+
+	if target.Type != model.FileTypeDir {
+		s.metadataService.HydrateFile(ctx, target)
+		dto := s.BuildFileItemDTO(target, viewerID, "/", "")
+		dto.Path = ShareURI(parsedURI.FSID, sharePath)
+		return &model.FileListResponse{
+			Files:       []*model.FileItem{dto},
+			Pagination:  &model.Pagination{Page: 1, PageSize: 1},
+			Props:       &model.Props{},
+			ContextHint: "分享: " + target.Name,
+			View:        GetViewConfig(nil),
+		}, nil
+	}
+
+	view := s.GetInheritedViewConfig(ctx, target)
+	orderBy, direction := view.Order, view.OrderDirection
+	switch orderBy {
+	case "name", "size", "created_at", "updated_at":
+	default:
+		orderBy = "updated_at"
+	}
+	if direction != "asc" && direction != "desc" {
+		direction = "desc"
+	}
+
+	tokenStr := parsedURI.Query.Get("next_token")
+	children, err := s.fileRepo.ListByParentIDWithCursor(ctx, target.ID, orderBy, direction, view.PageSize+1, decodePaginationToken(tokenStr))
+	if err != nil {
+		return nil, err
+	}
+	var nextToken string
+	if len(children) > view.PageSize {
+		children = children[:view.PageSize]
+		nextToken = encodePaginationToken(children[len(children)-1], orderBy)
+	}
+
+	filesDTO := make([]*model.FileItem, len(children))
+	for i, child := range children {
+		s.metadataService.HydrateFile(ctx, child)
+		dto := s.BuildFileItemDTO(child, viewerID, sharePath, "")
+		dto.Path = ShareURI(parsedURI.FSID, path.Join(sharePath, child.Name))
+		filesDTO[i] = dto
+	}
+	parentDTO := s.BuildFileItemDTO(target, viewerID, path.Dir(sharePath), "")
+	parentDTO.Path = ShareURI(parsedURI.FSID, sharePath)
+
+	pageValue := 0
+	if tokenStr == "" {
+		pageValue = 1
+	}
+	return &model.FileListResponse{
+		Files:  filesDTO,
+		Parent: parentDTO,
+		Pagination: &model.Pagination{
+			Page:      pageValue,
+			PageSize:  view.PageSize,
+			NextToken: nextToken,
+			IsCursor:  true,
+		},
+		Props:       &model.Props{OrderByOptions: []string{"name", "size", "updated_at", "created_at"}, OrderDirectionOptions: []string{"asc", "desc"}},
+		ContextHint: "分享目录: " + sharePath,
+		View:        view,
+	}, nil
+}
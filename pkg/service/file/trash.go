@@ -0,0 +1,533 @@
+package file
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/uri"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// 回收站的实现方式：
+//   - 删除时顶层项目在数据库中改名为 ".deleted-<ID>"，随后与后代以同一个删除时间软删除，
+//     删除前的位置记录在 restore_uri 元数据中，带有该元数据的已删除记录即为回收站中的项目。
+//     原名称在删除时即被释放，新项目可以直接使用，不会与回收站中的内容发生冲突。
+//   - 移入回收站不修改存储中的内容，回收站项目及其后代的物理文件仍在删除前的位置上。
+//     新内容写入这些位置之前，由 preserveTrashedContent 把仍在该位置的回收站文件复制到同目录下的 ".deleted-<ID>"；
+//     永久删除时，仍被其他实体使用的物理文件和仍被其他项目占用的物理目录不会被删除。
+//   - 还原时改回原名称，原名称已被其他项目占用时返回 constant.ErrConflict；
+//     只恢复删除时间相同的后代，删除前已被单独移入回收站的后代仍留在回收站中。
+//   - 回收站中的项目只会被用户永久删除、清空回收站或过期清理删除，不会因为其他写入而被删除。
+
+// DeleteItems 是删除文件或文件夹的入口，将项目移入回收站。
+func (s *serviceImpl) DeleteItems(ctx context.Context, ownerID uint, publicIDs []string) error {
+	deletedAt := time.Now()
+	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		for _, publicID := range publicIDs {
+			dbID, entityType, err := idgen.DecodePublicID(publicID)
+			if err != nil || entityType != idgen.EntityTypeFile {
+				log.Printf("【TRASH WARN】无效的公共ID '%s' 或类型不匹配，跳过删除。", publicID)
+				continue
+			}
+			if err := s.trashItem(ctx, repos, ownerID, dbID, deletedAt); err != nil {
+				return fmt.Errorf("删除项目 '%s' (ID: %d) 失败: %w", publicID, dbID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteItemsPermanently 永久删除文件或文件夹，对回收站中的项目同样有效。
+func (s *serviceImpl) DeleteItemsPermanently(ctx context.Context, ownerID uint, publicIDs []string) error {
+	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		for _, publicID := range publicIDs {
+			dbID, entityType, err := idgen.DecodePublicID(publicID)
+			if err != nil || entityType != idgen.EntityTypeFile {
+				log.Printf("【DELETE WARN】无效的公共ID '%s' 或类型不匹配，跳过删除。", publicID)
+				continue
+			}
+
+			err = s.HardDeleteRecursively(ctx, ownerID, dbID, repos.File, repos.Entity, repos.FileEntity, repos.Metadata, repos.StoragePolicy, repos.DirectLink, repos.User)
+			if err != nil {
+				return fmt.Errorf("删除项目 '%s' (ID: %d) 失败: %w", publicID, dbID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// trashItem 将单个项目及其后代移入回收站，并记录它删除前的位置。
+func (s *serviceImpl) trashItem(ctx context.Context, repos repository.Repositories, ownerID, fileID uint, deletedAt time.Time) error {
+	item, err := repos.File.FindByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			log.Printf("【TRASH INFO】项目 ID %d 已不存在，跳过删除。", fileID)
+			return nil
+		}
+		return fmt.Errorf("查找待删除项 %d 失败: %w", fileID, err)
+	}
+	if item.OwnerID != ownerID {
+		return fmt.Errorf("无权删除项目 '%s' (ID: %d): %w", item.Name, item.ID, constant.ErrForbidden)
+	}
+	if !item.ParentID.Valid {
+		return fmt.Errorf("不能删除根目录: %w", constant.ErrInvalidOperation)
+	}
+
+	// 挂载点文件夹会在同步时被重新创建，不能放入回收站
+	if item.Type == model.FileTypeDir {
+		linkedPolicy, err := repos.StoragePolicy.FindByNodeID(ctx, item.ID)
+		if err != nil {
+			return fmt.Errorf("检查文件夹 '%s' (ID: %d) 的策略关联失败，中止操作: %w", item.Name, item.ID, err)
+		}
+		if linkedPolicy != nil {
+			return fmt.Errorf("文件夹 '%s' 是存储策略 '%s' 的挂载点，不能移入回收站: %w", item.Name, linkedPolicy.Name, constant.ErrInvalidOperation)
+		}
+	}
+
+	fullVirtualPath, err := s.GetFullVirtualPathWithRepo(ctx, item, repos.File)
+	if err != nil {
+		return fmt.Errorf("获取项目 '%s' 的路径失败: %w", item.Name, err)
+	}
+
+	log.Printf("【TRASH INFO】将 '%s' (ID: %d) 移入回收站", fullVirtualPath, item.ID)
+	// 只在数据库中改为不会冲突的名称，原名称随即可以被新项目使用，物理文件保持不动
+	item.Name = fmt.Sprintf(".deleted-%d", item.ID)
+	if err := repos.File.Update(ctx, item); err != nil {
+		return fmt.Errorf("释放项目 '%s' 的名称失败: %w", path.Base(fullVirtualPath), err)
+	}
+	if err := repos.File.SoftDeleteTree(ctx, item.ID, deletedAt); err != nil {
+		return fmt.Errorf("软删除项目 '%s' 失败: %w", item.Name, err)
+	}
+	restoreURI := (&url.URL{Scheme: "anzhiyu", Host: "my", Path: fullVirtualPath}).String()
+	if err := repos.Metadata.Set(ctx, &model.Metadata{FileID: item.ID, Name: model.MetaKeyRestoreURI, Value: restoreURI}); err != nil {
+		return fmt.Errorf("记录项目 '%s' 的原位置失败: %w", item.Name, err)
+	}
+	return nil
+}
+
+// isTrashed 判断一条已删除的记录是否是回收站中的顶层项目。
+func isTrashed(ctx context.Context, repos repository.Repositories, item *model.File) (bool, error) {
+	if item.DeletedAt == nil {
+		return false, nil
+	}
+	meta, err := repos.Metadata.Get(ctx, item.ID, model.MetaKeyRestoreURI)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return meta.DeletedAt == nil, nil
+}
+
+// RestoreItems 将回收站中的项目还原到删除前的位置。
+func (s *serviceImpl) RestoreItems(ctx context.Context, ownerID uint, publicIDs []string) error {
+	return s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		for _, publicID := range publicIDs {
+			dbID, entityType, err := idgen.DecodePublicID(publicID)
+			if err != nil || entityType != idgen.EntityTypeFile {
+				return fmt.Errorf("项目ID '%s' 无效", publicID)
+			}
+
+			item, err := repos.File.FindByIDUnscoped(ctx, dbID)
+			if err != nil {
+				if errors.Is(err, constant.ErrNotFound) {
+					return fmt.Errorf("找不到项目 '%s': %w", publicID, constant.ErrNotFound)
+				}
+				return err
+			}
+			if item.OwnerID != ownerID {
+				return fmt.Errorf("无权还原项目 '%s': %w", item.Name, constant.ErrForbidden)
+			}
+			trashed, err := isTrashed(ctx, repos, item)
+			if err != nil {
+				return fmt.Errorf("检查项目 '%s' 的状态失败: %w", item.Name, err)
+			}
+			if !trashed {
+				return fmt.Errorf("项目 '%s' 不在回收站中: %w", item.Name, constant.ErrNotFound)
+			}
+
+			originalPath, err := trashOriginalPath(ctx, repos, item)
+			if err != nil {
+				return err
+			}
+			originalName := path.Base(originalPath)
+
+			// 项目始终还原到原来的父目录下，父目录本身也在回收站中时需要先还原父目录
+			if _, err := repos.File.FindByID(ctx, uint(item.ParentID.Int64)); err != nil {
+				if errors.Is(err, constant.ErrNotFound) {
+					return fmt.Errorf("项目 '%s' 原来所在的文件夹已被删除，请先还原该文件夹: %w", originalName, constant.ErrConflict)
+				}
+				return err
+			}
+			if item.Name != originalName {
+				if _, err := repos.File.FindByParentIDAndName(ctx, uint(item.ParentID.Int64), originalName); !errors.Is(err, constant.ErrNotFound) {
+					if err == nil {
+						return fmt.Errorf("原位置已存在同名项目 '%s'，请先重命名或删除它: %w", originalName, constant.ErrConflict)
+					}
+					return fmt.Errorf("检查同名冲突时出错: %w", err)
+				}
+				if err := s.releaseName(ctx, repos, uint(item.ParentID.Int64), originalName); err != nil {
+					return err
+				}
+			}
+
+			log.Printf("【TRASH INFO】从回收站还原 '%s' (ID: %d)", originalPath, item.ID)
+			if err := repos.File.RestoreTree(ctx, item.ID, *item.DeletedAt); err != nil {
+				return fmt.Errorf("还原项目 '%s' 失败: %w", originalName, err)
+			}
+			if item.Name != originalName {
+				item.DeletedAt = nil
+				item.Name = originalName
+				if err := repos.File.Update(ctx, item); err != nil {
+					return fmt.Errorf("恢复项目 '%s' 的名称失败: %w", originalName, err)
+				}
+			}
+			if err := repos.Metadata.Delete(ctx, item.ID, model.MetaKeyRestoreURI); err != nil {
+				return fmt.Errorf("清除项目 '%s' 的回收站标记失败: %w", item.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// EmptyTrash 永久删除用户回收站中的全部项目。
+func (s *serviceImpl) EmptyTrash(ctx context.Context, ownerID uint) (int, error) {
+	items, err := s.fileRepo.ListTrashed(ctx, ownerID)
+	if err != nil {
+		return 0, fmt.Errorf("查询回收站失败: %w", err)
+	}
+	return s.purgeTrashedItems(ctx, items)
+}
+
+// PurgeExpiredTrash 永久删除在回收站中超过保留天数的项目，保留天数为 0 时不自动清理。
+func (s *serviceImpl) PurgeExpiredTrash(ctx context.Context) (int, error) {
+	days, err := strconv.Atoi(s.settingSvc.Get(constant.KeyTrashRetentionDays.String()))
+	if err != nil || days <= 0 {
+		return 0, nil
+	}
+	items, err := s.fileRepo.ListTrashedBefore(ctx, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return 0, fmt.Errorf("查询过期的回收站项目失败: %w", err)
+	}
+	return s.purgeTrashedItems(ctx, items)
+}
+
+// purgeTrashedItems 逐个永久删除回收站项目，每个项目使用独立的事务，单个失败不影响其余项目。
+func (s *serviceImpl) purgeTrashedItems(ctx context.Context, items []*model.File) (int, error) {
+	purged := 0
+	var errs []error
+	for _, item := range items {
+		err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
+			// 父目录先被清理时，子项目已随之永久删除，这里需要跳过以免重复释放存储用量
+			current, err := repos.File.FindByIDUnscoped(ctx, item.ID)
+			if err != nil {
+				return err
+			}
+			trashed, err := isTrashed(ctx, repos, current)
+			if err != nil || !trashed {
+				return err
+			}
+			if err := s.HardDeleteRecursively(ctx, current.OwnerID, current.ID, repos.File, repos.Entity, repos.FileEntity, repos.Metadata, repos.StoragePolicy, repos.DirectLink, repos.User); err != nil {
+				return err
+			}
+			purged++
+			return nil
+		})
+		if err != nil {
+			log.Printf("【TRASH ERROR】永久删除回收站项目 '%s' (ID: %d) 失败: %v", item.Name, item.ID, err)
+			errs = append(errs, fmt.Errorf("永久删除 '%s' 失败: %w", item.Name, err))
+		}
+	}
+	return purged, errors.Join(errs...)
+}
+
+// ReleaseTrashedPath 沿虚拟路径逐级查找，第一个不存在的名称如果被已删除的记录占用，则释放该名称；
+// 并保留物理内容仍在该路径上的回收站文件。必须在写入物理文件之前、在事务中调用。
+func (s *serviceImpl) ReleaseTrashedPath(ctx context.Context, repos repository.Repositories, ownerID uint, virtualPath string) error {
+	if err := s.preserveTrashedContent(ctx, repos, ownerID, virtualPath, false); err != nil {
+		return err
+	}
+	parent, err := repos.File.FindByPath(ctx, ownerID, "/")
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	for _, segment := range strings.Split(strings.Trim(virtualPath, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		child, err := repos.File.FindByParentIDAndName(ctx, parent.ID, segment)
+		if err == nil {
+			parent = child
+			continue
+		}
+		if !errors.Is(err, constant.ErrNotFound) {
+			return err
+		}
+		return s.releaseName(ctx, repos, parent.ID, segment)
+	}
+	return nil
+}
+
+// releaseName 释放已删除记录占用的名称，记录在数据库中改名为 ".deleted-<ID>"。
+// 移入回收站的项目在删除时已经改名，仍以原名称占用的只有修复前移入回收站的旧项目，
+// 它们的原位置记录在 restore_uri 中，改名后仍可以正常还原。
+// 名称被未删除的项目占用时不做任何处理，由调用方按同名冲突处理。
+func (s *serviceImpl) releaseName(ctx context.Context, repos repository.Repositories, parentID uint, name string) error {
+	occupant, err := repos.File.FindByParentIDAndNameUnscoped(ctx, parentID, name)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("检查名称 '%s' 的占用情况失败: %w", name, err)
+	}
+	if occupant.DeletedAt == nil {
+		return nil
+	}
+
+	occupant.Name = fmt.Sprintf(".deleted-%d", occupant.ID)
+	if err := repos.File.Update(ctx, occupant); err != nil {
+		return fmt.Errorf("释放名称 '%s' 失败: %w", name, err)
+	}
+	return nil
+}
+
+// preserveTrashedContent 在新内容写入 virtualPath 之前，把物理内容仍在该位置的回收站文件复制到同目录下的 ".deleted-<ID>"，
+// subtree 为 true 时还包括该位置下的全部文件，用于移动或复制文件夹。必须在事务中调用
+func (s *serviceImpl) preserveTrashedContent(ctx context.Context, repos repository.Repositories, ownerID uint, virtualPath string, subtree bool) error {
+	occupants, err := s.locationOccupants(ctx, repos.File, repos.Metadata, ownerID, virtualPath)
+	if err != nil {
+		return fmt.Errorf("查找位于 '%s' 的回收站内容失败: %w", virtualPath, err)
+	}
+	for _, occupant := range occupants {
+		if err := s.preserveDeletedItem(ctx, repos, occupant, virtualPath, subtree); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// preserveDeletedItem 保留位于 virtualPath 的单个记录，文件夹在 subtree 为 true 时递归处理其下的已删除记录
+func (s *serviceImpl) preserveDeletedItem(ctx context.Context, repos repository.Repositories, item *model.File, virtualPath string, subtree bool) error {
+	if item.Type == model.FileTypeFile {
+		if item.DeletedAt == nil {
+			return nil
+		}
+		return s.preserveTrashedFile(ctx, repos, item, virtualPath)
+	}
+	if !subtree {
+		return nil
+	}
+	children, err := repos.File.ListByParentIDUnscoped(ctx, item.ID)
+	if err != nil {
+		return fmt.Errorf("列出文件夹 '%s' 的内容失败: %w", item.Name, err)
+	}
+	for _, child := range children {
+		name, err := originalName(ctx, repos.Metadata, child.File)
+		if err != nil {
+			return err
+		}
+		if err := s.preserveDeletedItem(ctx, repos, child.File, path.Join(virtualPath, name), true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// preserveTrashedFile 把仍在 virtualPath 上的已删除文件内容复制到同目录下的 ".deleted-<ID>"，并让实体指向副本。
+// 只复制不移动：事务回滚时实体仍指向原位置上完好的内容，最多留下一个无用的副本
+func (s *serviceImpl) preserveTrashedFile(ctx context.Context, repos repository.Repositories, item *model.File, virtualPath string) error {
+	if !item.PrimaryEntityID.Valid {
+		return nil
+	}
+	entity, err := repos.Entity.FindByID(ctx, uint(item.PrimaryEntityID.Uint64))
+	if err != nil && !errors.Is(err, constant.ErrNotFound) {
+		return fmt.Errorf("查找文件 '%s' 的物理实体失败: %w", item.Name, err)
+	}
+	// 共享内容、历史版本和已经复制过的内容都不在文件原名称的位置上
+	if entity == nil || !entity.Source.Valid || entity.IsContentAddressed() || isVersionSource(entity.Source.String) ||
+		path.Base(filepath.ToSlash(entity.Source.String)) != path.Base(virtualPath) {
+		return nil
+	}
+	policy, err := s.vfsSvc.FindPolicyForPath(ctx, virtualPath)
+	if err != nil {
+		return fmt.Errorf("为路径 '%s' 定位存储策略失败: %w", virtualPath, err)
+	}
+	// 已迁移到其他存储策略的内容不会被这里的写入覆盖
+	if policy.ID != entity.PolicyID {
+		return nil
+	}
+	provider, err := s.GetProviderForPolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	reader, err := provider.Get(ctx, policy, entity.Source.String)
+	if err != nil {
+		return fmt.Errorf("读取回收站文件 '%s' 的内容失败: %w", virtualPath, err)
+	}
+	defer reader.Close()
+	preservedPath := path.Join(path.Dir(virtualPath), fmt.Sprintf(".deleted-%d", item.ID))
+	result, err := provider.Upload(ctx, reader, policy, preservedPath)
+	if err != nil {
+		return fmt.Errorf("保留回收站文件 '%s' 的内容失败: %w", virtualPath, err)
+	}
+	entity.Source = sql.NullString{String: result.Source, Valid: true}
+	if err := repos.Entity.Update(ctx, entity); err != nil {
+		return fmt.Errorf("更新实体 %d 的存储位置失败: %w", entity.ID, err)
+	}
+	log.Printf("【TRASH INFO】新内容将写入 '%s'，回收站中的文件 (ID: %d) 已复制到 '%s'", virtualPath, item.ID, preservedPath)
+	return nil
+}
+
+// locationOccupants 返回删除前或当前位于 virtualPath 的全部记录，包括未删除的项目、回收站项目及其后代
+func (s *serviceImpl) locationOccupants(ctx context.Context, fileRepo repository.FileRepository, metadataRepo repository.MetadataRepository, ownerID uint, virtualPath string) ([]*model.File, error) {
+	root, err := fileRepo.FindByPath(ctx, ownerID, "/")
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	occupants := []*model.File{root}
+	for _, segment := range strings.Split(strings.Trim(virtualPath, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		var next []*model.File
+		for _, folder := range occupants {
+			if folder.Type != model.FileTypeDir {
+				continue
+			}
+			children, err := fileRepo.ListByParentIDUnscoped(ctx, folder.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, child := range children {
+				name, err := originalName(ctx, metadataRepo, child.File)
+				if err != nil {
+					return nil, err
+				}
+				if name == segment {
+					next = append(next, child.File)
+				}
+			}
+		}
+		occupants = next
+	}
+	return occupants, nil
+}
+
+// originalVirtualPath 返回项目删除前的虚拟路径，未删除的项目返回当前路径
+func (s *serviceImpl) originalVirtualPath(ctx context.Context, fileRepo repository.FileRepository, metadataRepo repository.MetadataRepository, item *model.File) (string, error) {
+	if item.DeletedAt == nil || !item.ParentID.Valid {
+		return s.GetFullVirtualPathWithRepo(ctx, item, fileRepo)
+	}
+	if restorePath, ok, err := trashRestorePath(ctx, metadataRepo, item); err != nil || ok {
+		return restorePath, err
+	}
+	parent, err := fileRepo.FindByIDUnscoped(ctx, uint(item.ParentID.Int64))
+	if err != nil {
+		return "", err
+	}
+	parentPath, err := s.originalVirtualPath(ctx, fileRepo, metadataRepo, parent)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(parentPath, item.Name), nil
+}
+
+// originalName 返回项目删除前的名称，回收站中的顶层项目使用的是 ".deleted-<ID>"，原名称记录在 restore_uri 中
+func originalName(ctx context.Context, metadataRepo repository.MetadataRepository, item *model.File) (string, error) {
+	if item.DeletedAt == nil || !strings.HasPrefix(item.Name, ".deleted-") {
+		return item.Name, nil
+	}
+	restorePath, ok, err := trashRestorePath(ctx, metadataRepo, item)
+	if err != nil || !ok {
+		return item.Name, err
+	}
+	return path.Base(restorePath), nil
+}
+
+// trashRestorePath 返回回收站顶层项目删除前的虚拟路径，不是回收站顶层项目时 ok 为 false
+func trashRestorePath(ctx context.Context, metadataRepo repository.MetadataRepository, item *model.File) (restorePath string, ok bool, err error) {
+	meta, err := metadataRepo.Get(ctx, item.ID, model.MetaKeyRestoreURI)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	if meta.DeletedAt != nil {
+		return "", false, nil
+	}
+	restorePath, err = parseRestoreURI(meta.Value)
+	return restorePath, err == nil, err
+}
+
+// trashOriginalPath 返回回收站项目删除前的虚拟路径
+func trashOriginalPath(ctx context.Context, repos repository.Repositories, item *model.File) (string, error) {
+	meta, err := repos.Metadata.Get(ctx, item.ID, model.MetaKeyRestoreURI)
+	if err != nil {
+		return "", fmt.Errorf("读取项目 %d 的原位置失败: %w", item.ID, err)
+	}
+	return parseRestoreURI(meta.Value)
+}
+
+// parseRestoreURI 从 restore_uri 元数据中解析出删除前的虚拟路径
+func parseRestoreURI(restoreURI string) (string, error) {
+	parsed, err := url.Parse(restoreURI)
+	if err != nil || parsed.Path == "" || parsed.Path == "/" {
+		return "", fmt.Errorf("无效的原位置 '%s': %w", restoreURI, constant.ErrInvalidOperation)
+	}
+	return parsed.Path, nil
+}
+
+// queryTrash 列出 anzhiyu://trash/ 下的回收站项目，项目的 metadata 中带有删除前的位置 restore_uri。
+func (s *serviceImpl) queryTrash(ctx context.Context, ownerID, viewerID uint, parsedURI *uri.ParsedURI) (*model.FileListResponse, error) {
+	if parsedURI.Path != "/" {
+		return nil, fmt.Errorf("回收站不支持浏览子目录: %w", constant.ErrInvalidOperation)
+	}
+	items, err := s.fileRepo.ListTrashed(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("查询回收站失败: %w", err)
+	}
+
+	filesDTO := make([]*model.FileItem, len(items))
+	for i, item := range items {
+		s.metadataService.HydrateFile(ctx, item)
+		// 项目在回收站中使用的是 ".deleted-<ID>" 名称，展示时换回删除前的名称
+		if originalPath, err := parseRestoreURI(item.Metas[model.MetaKeyRestoreURI]); err == nil {
+			item.Name = path.Base(originalPath)
+		}
+		dto := s.BuildFileItemDTO(item, viewerID, "/", "")
+		dto.Path = (&url.URL{Scheme: "anzhiyu", Host: "trash", Path: "/" + item.Name}).String()
+		filesDTO[i] = dto
+	}
+
+	return &model.FileListResponse{
+		Files: filesDTO,
+		Pagination: &model.Pagination{
+			Page:     1,
+			PageSize: len(filesDTO),
+		},
+		Props:       &model.Props{OrderByOptions: []string{"deleted_at"}, OrderDirectionOptions: []string{"desc"}},
+		ContextHint: "回收站",
+		View:        GetViewConfig(nil),
+	}, nil
+}
@@ -0,0 +1,159 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// publicID 返回文件的公共ID
+func publicID(t *testing.T, file *model.File) string {
+	t.Helper()
+	if err := idgen.InitSqidsEncoder(); err != nil {
+		t.Fatal(err)
+	}
+	id, err := idgen.GeneratePublicID(file.ID, idgen.EntityTypeFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// trash 把文件移入回收站
+func (env *testEnv) trash(t *testing.T, file *model.File) {
+	t.Helper()
+	if err := env.svc.DeleteItems(env.ctx, env.ownerID, []string{publicID(t, file)}); err != nil {
+		t.Fatalf("移入回收站失败: %v", err)
+	}
+}
+
+// releasePath 按上传流程在写入 virtualPath 之前释放该路径
+func (env *testEnv) releasePath(t *testing.T, virtualPath string) {
+	t.Helper()
+	err := env.svc.txManager.Do(env.ctx, func(repos repository.Repositories) error {
+		return env.svc.ReleaseTrashedPath(env.ctx, repos, env.ownerID, virtualPath)
+	})
+	if err != nil {
+		t.Fatalf("释放路径 %s 失败: %v", virtualPath, err)
+	}
+}
+
+func TestTrashLeavesContentInPlaceAndRestores(t *testing.T) {
+	env := newTestEnv(t)
+	entity := env.putEntity(t, env.primary, "/a.txt", []byte("v1"), "")
+	file := env.putFile(t, "a.txt", entity)
+
+	env.trash(t, file)
+	trashed, err := env.repos.File.FindByIDUnscoped(env.ctx, file.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trashed.DeletedAt == nil || trashed.Name != fmt.Sprintf(".deleted-%d", file.ID) {
+		t.Fatalf("回收站中的文件应在数据库中改名并标记删除，实际: %+v", trashed)
+	}
+	if got := env.entity(t, entity.ID).Source.String; got != "/a.txt" {
+		t.Fatalf("移入回收站不应修改实体的存储位置，实际为 %s", got)
+	}
+	if got := env.readSource(t, env.primaryP, "/a.txt"); got != "v1" || len(env.primaryP.deleted) != 0 {
+		t.Fatalf("移入回收站不应修改存储中的内容，实际内容 %q，删除记录 %v", got, env.primaryP.deleted)
+	}
+
+	if err := env.svc.RestoreItems(env.ctx, env.ownerID, []string{publicID(t, file)}); err != nil {
+		t.Fatal(err)
+	}
+	if restored := env.file(t, file.ID); restored.Name != "a.txt" || restored.DeletedAt != nil {
+		t.Fatalf("还原后应恢复原名称，实际: %+v", restored)
+	}
+}
+
+func TestWriteOverTrashedFilePreservesItsContent(t *testing.T) {
+	env := newTestEnv(t)
+	oldEntity := env.putEntity(t, env.primary, "/a.txt", []byte("v1"), "")
+	oldFile := env.putFile(t, "a.txt", oldEntity)
+	env.trash(t, oldFile)
+
+	// 上传同名的新文件
+	env.releasePath(t, "/a.txt")
+	preserved := fmt.Sprintf("/.deleted-%d", oldFile.ID)
+	if got := env.entity(t, oldEntity.ID).Source.String; got != preserved {
+		t.Fatalf("回收站文件的实体应指向副本 %s，实际为 %s", preserved, got)
+	}
+	newEntity := env.putEntity(t, env.primary, "/a.txt", []byte("v2"), "")
+	env.putFile(t, "a.txt", newEntity)
+	if got := env.readSource(t, env.primaryP, preserved); got != "v1" {
+		t.Fatalf("回收站文件的内容应保留为 v1，实际为 %q", got)
+	}
+
+	err := env.svc.RestoreItems(env.ctx, env.ownerID, []string{publicID(t, oldFile)})
+	if !errors.Is(err, constant.ErrConflict) {
+		t.Fatalf("原位置已有同名文件时还原应返回冲突，实际: %v", err)
+	}
+
+	if purged, err := env.svc.EmptyTrash(env.ctx, env.ownerID); err != nil || purged != 1 {
+		t.Fatalf("清空回收站应删除 1 个项目，实际 %d 个，错误: %v", purged, err)
+	}
+	if _, ok := env.primaryP.content(preserved); ok {
+		t.Fatal("清空回收站后应删除回收站文件的副本")
+	}
+	if got := env.readSource(t, env.primaryP, "/a.txt"); got != "v2" {
+		t.Fatalf("清空回收站不应影响新文件的内容，实际为 %q", got)
+	}
+}
+
+func TestPurgeKeepsContentUsedByAnotherEntity(t *testing.T) {
+	env := newTestEnv(t)
+	oldFile := env.putFile(t, "a.txt", env.putEntity(t, env.primary, "/a.txt", []byte("v1"), ""))
+	env.trash(t, oldFile)
+	// 修复前写入的新文件直接覆盖了回收站文件的原位置，两个实体指向同一个存储位置
+	env.putFile(t, "a.txt", env.putEntity(t, env.primary, "/a.txt", []byte("v2"), ""))
+
+	if _, err := env.svc.EmptyTrash(env.ctx, env.ownerID); err != nil {
+		t.Fatal(err)
+	}
+	if got := env.readSource(t, env.primaryP, "/a.txt"); got != "v2" {
+		t.Fatalf("仍被新文件使用的物理文件不应被删除，实际为 %q", got)
+	}
+}
+
+func TestPurgeTrashedFolderKeepsDirectoryOfNewFolder(t *testing.T) {
+	env := newTestEnv(t)
+	oldDir := env.putDir(t, env.root, "docs")
+	oldEntity := env.putEntity(t, env.primary, "/docs/a.txt", []byte("v1"), "")
+	env.putFileIn(t, oldDir, "a.txt", oldEntity)
+	env.trash(t, oldDir)
+
+	// 新建同名文件夹并上传同名文件，物理上与回收站中的文件夹位于同一位置
+	newDir := env.putDir(t, env.root, "docs")
+	env.releasePath(t, "/docs/a.txt")
+	preserved := env.entity(t, oldEntity.ID).Source.String
+	if preserved == "/docs/a.txt" {
+		t.Fatal("写入新文件前应保留回收站文件夹中的同名文件")
+	}
+	env.putFileIn(t, newDir, "a.txt", env.putEntity(t, env.primary, "/docs/a.txt", []byte("v2"), ""))
+
+	if purged, err := env.svc.EmptyTrash(env.ctx, env.ownerID); err != nil || purged != 1 {
+		t.Fatalf("清空回收站应删除 1 个项目，实际 %d 个，错误: %v", purged, err)
+	}
+	for _, source := range env.primaryP.deleted {
+		if source == "docs" {
+			t.Fatal("物理目录仍被新建的文件夹使用，不应被删除")
+		}
+	}
+	if _, ok := env.primaryP.content(preserved); ok {
+		t.Fatal("清空回收站后应删除回收站文件的副本")
+	}
+	if got := env.readSource(t, env.primaryP, "/docs/a.txt"); got != "v2" {
+		t.Fatalf("清空回收站不应影响新文件的内容，实际为 %q", got)
+	}
+
+	// 没有其他项目使用该位置后，永久删除文件夹时一并删除物理目录
+	env.hardDelete(t, newDir.ID)
+	if n := len(env.primaryP.deleted); n == 0 || env.primaryP.deleted[n-1] != "docs" {
+		t.Fatalf("永久删除最后一个使用该位置的文件夹时应删除物理目录，实际删除记录: %v", env.primaryP.deleted)
+	}
+}
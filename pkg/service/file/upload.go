@@ -51,6 +51,8 @@ type IUploadService interface {
 	CleanupAbandonedUploads(ctx context.Context) (int, error)
 	// FinalizeClientUpload 处理客户端直传完成后的回调，在数据库中创建文件记录。
 	FinalizeClientUpload(ctx context.Context, ownerID uint, req *model.FinalizeUploadRequest) (*model.File, error)
+	// UploadStream 由服务端接收完整的文件内容并完成上传，不受策略的客户端直传设置影响。
+	UploadStream(ctx context.Context, ownerID uint, req *model.CreateUploadRequest, reader io.Reader) error
 }
 
 // uploadService 是 IUploadService 接口的实现。
@@ -62,6 +64,8 @@ type uploadService struct {
 	cacheSvc         utility.CacheService                                    // 缓存服务，用于存储上传会话
 	policySvc        volume.IStoragePolicyService                            // 存储策略服务
 	settingSvc       setting.SettingService                                  // 系统设置服务
+	quotaSvc         IQuotaService                                           // 存储容量服务
+	fileSvc          FileService                                             // 文件服务，用于在写入前释放已删除记录占用的名称并保留回收站内容
 	storageProviders map[constant.StoragePolicyType]storage.IStorageProvider // 存储驱动提供者集合
 	uploadTempDir    string                                                  // 临时上传目录
 }
@@ -76,6 +80,8 @@ func NewUploadService(
 	policySvc volume.IStoragePolicyService,
 	settingSvc setting.SettingService,
 	providers map[constant.StoragePolicyType]storage.IStorageProvider,
+	quotaSvc IQuotaService,
+	fileSvc FileService,
 ) IUploadService {
 
 	tempDir := defaultUploadTempDir
@@ -91,6 +97,8 @@ func NewUploadService(
 		cacheSvc:         cacheSvc,
 		policySvc:        policySvc,
 		settingSvc:       settingSvc,
+		quotaSvc:         quotaSvc,
+		fileSvc:          fileSvc,
 		storageProviders: providers,
 		uploadTempDir:    tempDir,
 	}
@@ -138,46 +146,10 @@ func (s *uploadService) CleanupAbandonedUploads(ctx context.Context) (int, error
 
 // CreateUploadSession 在上传流程开始时，负责进行前置校验、确保目标目录存在，并创建一个临时的物理实体记录。
 func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, req *model.CreateUploadRequest) (*model.UploadSessionData, error) {
-	// 步骤 1: 基本校验
-	if strings.HasSuffix(req.URI, "/") {
-		return nil, errors.New("无法为目录创建上传会话，请提供完整的文件路径")
-	}
-
 	fileName := filepath.Base(req.URI)
-	fileExt := strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), "."))
-
-	// 步骤 2: 从全局设置服务获取允许的扩展名并校验
-	allowedExtStr := s.settingSvc.Get(constant.KeyUploadAllowedExtensions.String())
-	if allowedExtStr != "" {
-		allowedList := strings.Split(allowedExtStr, ",")
-		isAllowed := false
-		for _, allowed := range allowedList {
-			if strings.TrimSpace(allowed) == fileExt {
-				isAllowed = true
-				break
-			}
-		}
-		if !isAllowed {
-			return nil, fmt.Errorf("不支持的文件类型: .%s", fileExt)
-		}
-	}
-
-	// 步骤 3: 根据请求中的 PolicyID 获取策略并校验文件大小
-	policy, err := s.policySvc.GetPolicyByID(ctx, req.PolicyID)
+	policy, parsedURI, err := s.validateUploadRequest(ctx, ownerID, req)
 	if err != nil {
-		if errors.Is(err, constant.ErrNotFound) {
-			return nil, errors.New("指定的存储策略不存在")
-		}
-		return nil, fmt.Errorf("获取存储策略失败: %w", err)
-	}
-	if policy.MaxSize > 0 && req.Size > policy.MaxSize {
-		return nil, fmt.Errorf("文件大小超出策略限制")
-	}
-
-	// 步骤 4: 路径解析
-	parsedURI, err := uri.Parse(req.URI)
-	if err != nil {
-		return nil, fmt.Errorf("解析目标URI失败: %w", err)
+		return nil, err
 	}
 
 	// 步骤 5: 根据策略决定上传方式并执行相应逻辑
@@ -196,6 +168,10 @@ func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, r
 	if uploadMethod == constant.UploadMethodClient && clientUploadSupportedTypes[policy.Type] {
 		// 在获取直传链接前，同样需要检查路径和冲突，确保这是一个合法的上传位置
 		err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
+			// 客户端会直接写入目标位置，需要在获取直传链接前释放已删除记录占用的名称并保留回收站内容
+			if err := s.fileSvc.ReleaseTrashedPath(ctx, repos, ownerID, parsedURI.Path); err != nil {
+				return fmt.Errorf("释放目标路径失败: %w", err)
+			}
 			parentPath := filepath.Dir(parsedURI.Path)
 			parentFolder, err := s.findOrCreatePath(ctx, ownerID, parentPath, repos.File)
 			if err != nil {
@@ -215,6 +191,13 @@ func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, r
 		if err != nil {
 			return nil, err
 		}
+		// 客户端会直接覆盖目标位置的内容，需要在签发直传链接前把旧内容复制到历史版本目录；
+		// 历史版本在 FinalizeClientUpload 提交覆盖时才登记并计入容量，放弃的直传不会多计容量
+		if req.Overwrite {
+			if err := s.fileSvc.ArchiveVersion(ctx, ownerID, parsedURI.Path, req.Size); err != nil {
+				return nil, fmt.Errorf("保留文件历史版本失败: %w", err)
+			}
+		}
 
 		// 执行获取直传链接的逻辑
 		provider, err := s.getProviderForPolicy(policy)
@@ -248,9 +231,87 @@ func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, r
 	}
 
 	// --- 服务端上传逻辑 (默认) ---
+	session, err := s.createServerSession(ctx, ownerID, req, policy, parsedURI)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UploadSessionData{
+		Expires:      session.ExpireAt.Unix(),
+		UploadMethod: constant.UploadMethodServer,
+		SessionID:    session.SessionID,
+		ChunkSize:    session.ChunkSize,
+		StoragePolicy: &model.StoragePolicyInfo{
+			ID:      req.PolicyID,
+			Name:    policy.Name,
+			Type:    string(policy.Type),
+			MaxSize: policy.MaxSize,
+		},
+	}, nil
+}
+
+// validateUploadRequest 执行上传前的通用校验：目标路径、扩展名白名单、策略大小限制和用户容量，
+// 返回解析后的存储策略与目标URI。
+func (s *uploadService) validateUploadRequest(ctx context.Context, ownerID uint, req *model.CreateUploadRequest) (*model.StoragePolicy, *uri.ParsedURI, error) {
+	// 步骤 1: 基本校验
+	if strings.HasSuffix(req.URI, "/") {
+		return nil, nil, errors.New("无法为目录创建上传会话，请提供完整的文件路径")
+	}
+
+	fileName := filepath.Base(req.URI)
+
+	// 步骤 2: 从全局设置服务获取允许的扩展名并校验
+	allowedExtStr := s.settingSvc.Get(constant.KeyUploadAllowedExtensions.String())
+	if !isExtensionAllowed(allowedExtStr, fileName) {
+		return nil, nil, fmt.Errorf("不支持的文件类型: .%s", strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), ".")))
+	}
+
+	// 步骤 3: 根据请求中的 PolicyID 获取策略并校验文件大小
+	policy, err := s.policySvc.GetPolicyByID(ctx, req.PolicyID)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil, nil, errors.New("指定的存储策略不存在")
+		}
+		return nil, nil, fmt.Errorf("获取存储策略失败: %w", err)
+	}
+	if policy.MaxSize > 0 && req.Size > policy.MaxSize {
+		return nil, nil, fmt.Errorf("文件大小超出策略限制")
+	}
+	if err := s.quotaSvc.CheckQuota(ctx, ownerID, req.Size); err != nil {
+		return nil, nil, err
+	}
+
+	// 步骤 4: 路径解析
+	parsedURI, err := uri.Parse(req.URI)
+	if err != nil {
+		return nil, nil, fmt.Errorf("解析目标URI失败: %w", err)
+	}
+	return policy, parsedURI, nil
+}
+
+// isExtensionAllowed 判断文件名的扩展名是否在允许上传的扩展名列表中，列表为空时不限制
+func isExtensionAllowed(allowedExtStr, fileName string) bool {
+	if allowedExtStr == "" {
+		return true
+	}
+	fileExt := strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), "."))
+	for _, allowed := range strings.Split(allowedExtStr, ",") {
+		if strings.TrimSpace(allowed) == fileExt {
+			return true
+		}
+	}
+	return false
+}
+
+// createServerSession 确保目标目录存在并检查同名冲突，然后创建临时物理实体和缓存中的服务端上传会话。
+func (s *uploadService) createServerSession(ctx context.Context, ownerID uint, req *model.CreateUploadRequest, policy *model.StoragePolicy, parsedURI *uri.ParsedURI) (*model.UploadSession, error) {
+	fileName := filepath.Base(parsedURI.Path)
 	var sessionID string
 	var tempEntityID uint
-	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
+	err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		if err := s.fileSvc.ReleaseTrashedPath(ctx, repos, ownerID, parsedURI.Path); err != nil {
+			return fmt.Errorf("释放目标路径失败: %w", err)
+		}
 		parentPath := filepath.Dir(parsedURI.Path)
 		parentFolder, err := s.findOrCreatePath(ctx, ownerID, parentPath, repos.File)
 		if err != nil {
@@ -314,18 +375,42 @@ func (s *uploadService) CreateUploadSession(ctx context.Context, ownerID uint, r
 		return nil, fmt.Errorf("无法创建上传会话缓存: %w", err)
 	}
 
-	return &model.UploadSessionData{
-		Expires:      session.ExpireAt.Unix(),
-		UploadMethod: constant.UploadMethodServer,
-		SessionID:    sessionID,
-		ChunkSize:    chunkSize,
-		StoragePolicy: &model.StoragePolicyInfo{
-			ID:      req.PolicyID,
-			Name:    policy.Name,
-			Type:    string(policy.Type),
-			MaxSize: policy.MaxSize,
-		},
-	}, nil
+	return session, nil
+}
+
+// UploadStream 由服务端一次性接收完整的文件内容并写入 req.URI 指向的位置。
+// 无论策略是否配置为客户端直传，都会经由服务端上传会话完成，适用于 WebDAV 等无法使用直传链接的场景。
+func (s *uploadService) UploadStream(ctx context.Context, ownerID uint, req *model.CreateUploadRequest, reader io.Reader) error {
+	policy, parsedURI, err := s.validateUploadRequest(ctx, ownerID, req)
+	if err != nil {
+		return err
+	}
+	session, err := s.createServerSession(ctx, ownerID, req, policy, parsedURI)
+	if err != nil {
+		return err
+	}
+
+	// 空文件没有任何分片，直接进入定稿流程
+	totalChunks := (int(session.FileSize) + session.ChunkSize - 1) / session.ChunkSize
+	if totalChunks == 0 {
+		if err := os.MkdirAll(filepath.Join(s.uploadTempDir, session.SessionID), os.ModePerm); err != nil {
+			_ = s.DeleteUploadSession(context.Background(), ownerID, &model.DeleteUploadRequest{ID: session.SessionID})
+			return fmt.Errorf("无法创建会话临时目录: %w", err)
+		}
+		if err := s.completeFileUpload(ctx, session); err != nil {
+			_ = s.DeleteUploadSession(context.Background(), ownerID, &model.DeleteUploadRequest{ID: session.SessionID})
+			return fmt.Errorf("文件上传完成处理失败: %w", err)
+		}
+		return nil
+	}
+
+	for i := 0; i < totalChunks; i++ {
+		if err := s.UploadChunk(ctx, session.SessionID, i, io.LimitReader(reader, int64(session.ChunkSize))); err != nil {
+			_ = s.DeleteUploadSession(context.Background(), ownerID, &model.DeleteUploadRequest{ID: session.SessionID})
+			return err
+		}
+	}
+	return nil
 }
 
 // getProviderForPolicy 是一个辅助函数，用于根据存储策略获取对应的存储驱动实例。
@@ -486,12 +571,41 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 	defer fileToUpload.Close()
 
 	parsedURI, _ := uri.Parse(session.URI)
-	uploadResult, err := provider.Upload(ctx, fileToUpload, policy, parsedURI.Path)
+	// 覆盖已有文件时，新内容会写入同一位置，需要先保留旧内容
+	if err := s.fileSvc.ArchiveVersion(ctx, session.OwnerID, parsedURI.Path, session.FileSize); err != nil {
+		return fmt.Errorf("保留文件历史版本失败: %w", err)
+	}
+	contentHash, err := computeContentHash(fileToUpload)
 	if err != nil {
-		return fmt.Errorf("存储提供者上传失败: %w", err)
+		return fmt.Errorf("计算文件内容哈希失败: %w", err)
+	}
+	if _, err := fileToUpload.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("无法重置合并后文件的读取位置: %w", err)
+	}
+
+	// 同一存储策略中已有相同内容时直接复用已有的实体，不再重复写入
+	reusedEntity := s.findReusableEntity(ctx, session, policy, parsedURI.Path, contentHash)
+	var uploadResult *storage.UploadResult
+	if reusedEntity != nil {
+		uploadResult = &storage.UploadResult{
+			Source:    reusedEntity.Source.String,
+			Size:      reusedEntity.Size,
+			MimeType:  reusedEntity.MimeType.String,
+			Dimension: reusedEntity.Dimension.String,
+		}
+		// 内容存放在共享位置，仍需要在文件所在位置建立目录，避免同步时把父目录当作已删除
+		if err := provider.CreateDirectory(ctx, policy, filepath.ToSlash(filepath.Dir(parsedURI.Path))); err != nil {
+			log.Printf("[UploadService] 创建目录 '%s' 失败: %v", filepath.Dir(parsedURI.Path), err)
+		}
+	} else {
+		uploadResult, err = provider.Upload(ctx, fileToUpload, policy, parsedURI.Path)
+		if err != nil {
+			return fmt.Errorf("存储提供者上传失败: %w", err)
+		}
 	}
 
 	var fileToPublishEvent *model.File // **修改点：用于存储需要发布事件的文件对象**
+	var committedFileID uint
 
 	// 3. 在数据库事务中完成记录创建
 	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
@@ -500,13 +614,24 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 			return fmt.Errorf("找不到上传会话关联的临时实体: %w", err)
 		}
 
-		entityToUpdate.Source = sql.NullString{String: uploadResult.Source, Valid: true}
-		entityToUpdate.MimeType = sql.NullString{String: uploadResult.MimeType, Valid: true}
-		entityToUpdate.Dimension = sql.NullString{String: uploadResult.Dimension, Valid: uploadResult.Dimension != ""}
-		entityToUpdate.Size = uploadResult.Size
-		entityToUpdate.UploadSessionID = sql.NullString{Valid: false}
-		if err := repos.Entity.Update(ctx, entityToUpdate); err != nil {
-			return fmt.Errorf("更新物理实体失败: %w", err)
+		if reusedEntity != nil {
+			if err := repos.Entity.HardDelete(ctx, entityToUpdate.ID); err != nil {
+				return fmt.Errorf("删除上传会话关联的临时实体失败: %w", err)
+			}
+			if err := repos.Entity.AddReference(ctx, reusedEntity.ID, 1); err != nil {
+				return fmt.Errorf("增加实体 %d 的引用失败: %w", reusedEntity.ID, err)
+			}
+			entityToUpdate = reusedEntity
+		} else {
+			entityToUpdate.Source = sql.NullString{String: uploadResult.Source, Valid: true}
+			entityToUpdate.MimeType = sql.NullString{String: uploadResult.MimeType, Valid: true}
+			entityToUpdate.Dimension = sql.NullString{String: uploadResult.Dimension, Valid: uploadResult.Dimension != ""}
+			entityToUpdate.Size = uploadResult.Size
+			entityToUpdate.UploadSessionID = sql.NullString{Valid: false}
+			entityToUpdate.ContentHash = sql.NullString{String: contentHash, Valid: true}
+			if err := repos.Entity.Update(ctx, entityToUpdate); err != nil {
+				return fmt.Errorf("更新物理实体失败: %w", err)
+			}
 		}
 
 		parentPath := filepath.Dir(parsedURI.Path)
@@ -516,6 +641,15 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 			return fmt.Errorf("找不到目标父目录 '%s': %w", parentPath, err)
 		}
 
+		replacedSize, err := replacedFileSize(ctx, repos.File, parentFolder.ID, fileName)
+		if err != nil {
+			return err
+		}
+		oldEntityID, err := currentEntityID(ctx, repos.File, parentFolder.ID, fileName)
+		if err != nil {
+			return err
+		}
+
 		fileToUpsert := &model.File{
 			OwnerID:         session.OwnerID,
 			ParentID:        sql.NullInt64{Int64: int64(parentFolder.ID), Valid: true},
@@ -528,6 +662,9 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 		if err != nil {
 			return fmt.Errorf("创建或更新逻辑文件记录失败: %w", err)
 		}
+		if err := repos.User.AdjustStorageUsed(ctx, session.OwnerID, targetFile.Size-replacedSize); err != nil {
+			return fmt.Errorf("更新存储用量失败: %w", err)
+		}
 
 		if targetFile.Type == model.FileTypeFile {
 			fileToPublishEvent = targetFile
@@ -535,15 +672,10 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 
 		go s.metadataSvc.Set(context.Background(), targetFile.ID, model.MetaKeyPhysicalName, filepath.Base(uploadResult.Source))
 
-		newVersion := &model.FileStorageVersion{
-			FileID:           targetFile.ID,
-			EntityID:         entityToUpdate.ID,
-			IsCurrent:        true,
-			UploadedByUserID: types.NullUint64{Uint64: uint64(session.OwnerID), Valid: true},
-		}
-		if err := repos.FileEntity.Create(ctx, newVersion); err != nil {
-			return fmt.Errorf("创建文件版本关联记录失败: %w", err)
+		if err := s.fileSvc.CommitVersion(ctx, repos, targetFile.ID, oldEntityID, entityToUpdate.ID, session.OwnerID); err != nil {
+			return err
 		}
+		committedFileID = targetFile.ID
 
 		return s.cacheSvc.Delete(ctx, uploadSessionCachePrefix+session.SessionID)
 	})
@@ -552,6 +684,10 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 		return err
 	}
 
+	if err := s.fileSvc.PruneVersions(ctx, committedFileID); err != nil {
+		log.Printf("[UploadService] 清理文件 %d 多余的历史版本失败: %v", committedFileID, err)
+	}
+
 	// 4. 在事务成功后，进行过滤并发布事件
 	if fileToPublishEvent != nil {
 		if s.isThumbnailable(fileToPublishEvent) {
@@ -565,6 +701,36 @@ func (s *uploadService) completeFileUpload(ctx context.Context, session *model.U
 	return nil
 }
 
+// findReusableEntity 查找可以被本次上传复用的实体。查找失败时只记录日志，按普通上传处理
+func (s *uploadService) findReusableEntity(ctx context.Context, session *model.UploadSession, policy *model.StoragePolicy, virtualPath, contentHash string) *model.FileStorageEntity {
+	// 被覆盖的文件当前的实体即将被释放，不能复用
+	var excludeEntityID uint
+	err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		parentFolder, err := repos.File.FindByPath(ctx, session.OwnerID, filepath.ToSlash(filepath.Dir(virtualPath)))
+		if err != nil {
+			if errors.Is(err, constant.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		excludeEntityID, err = currentEntityID(ctx, repos.File, parentFolder.ID, filepath.Base(virtualPath))
+		return err
+	})
+	if err != nil {
+		log.Printf("[UploadService] 查找被覆盖文件的实体失败，跳过内容去重: %v", err)
+		return nil
+	}
+	entity, err := s.fileSvc.FindReusableEntity(ctx, policy, contentHash, session.FileSize, excludeEntityID)
+	if err != nil {
+		log.Printf("[UploadService] 查找可复用的实体失败，按普通上传处理: %v", err)
+		return nil
+	}
+	if entity != nil {
+		log.Printf("[UploadService] 上传内容与实体 %d 相同，直接复用", entity.ID)
+	}
+	return entity
+}
+
 // DeleteUploadSession 用于客户端主动取消一个正在进行的上传会话。
 func (s *uploadService) DeleteUploadSession(ctx context.Context, ownerID uint, req *model.DeleteUploadRequest) error {
 	sessionKey := uploadSessionCachePrefix + req.ID
@@ -717,6 +883,19 @@ func (s *uploadService) FinalizeClientUpload(ctx context.Context, ownerID uint,
 			return fmt.Errorf("创建或查找父目录'%s'失败: %w", parentPath, err)
 		}
 
+		// 客户端直传时文件大小由客户端上报，需要在入库前再次校验容量
+		replacedSize, err := replacedFileSize(ctx, repos.File, parentFolder.ID, fileName)
+		if err != nil {
+			return err
+		}
+		if err := s.quotaSvc.CheckQuota(ctx, ownerID, req.Size-replacedSize); err != nil {
+			return err
+		}
+		oldEntityID, err := currentEntityID(ctx, repos.File, parentFolder.ID, fileName)
+		if err != nil {
+			return err
+		}
+
 		// 创建物理实体记录
 		newEntity := &model.FileStorageEntity{
 			Source:   sql.NullString{String: objectKey, Valid: true},
@@ -748,19 +927,13 @@ func (s *uploadService) FinalizeClientUpload(ctx context.Context, ownerID uint,
 		if err != nil {
 			return fmt.Errorf("创建逻辑文件记录失败: %w", err)
 		}
+		if err := repos.User.AdjustStorageUsed(ctx, ownerID, targetFile.Size-replacedSize); err != nil {
+			return fmt.Errorf("更新存储用量失败: %w", err)
+		}
 
 		// 创建文件版本关联
-		newVersion := &model.FileStorageVersion{
-			FileID:    targetFile.ID,
-			EntityID:  newEntity.ID,
-			IsCurrent: true,
-			UploadedByUserID: types.NullUint64{
-				Uint64: uint64(ownerID),
-				Valid:  true,
-			},
-		}
-		if err := repos.FileEntity.Create(ctx, newVersion); err != nil {
-			return fmt.Errorf("创建文件版本关联记录失败: %w", err)
+		if err := s.fileSvc.CommitVersion(ctx, repos, targetFile.ID, oldEntityID, newEntity.ID, ownerID); err != nil {
+			return err
 		}
 
 		// 保存物理文件名元数据
@@ -771,9 +944,19 @@ func (s *uploadService) FinalizeClientUpload(ctx context.Context, ownerID uint,
 	})
 
 	if err != nil {
+		if errors.Is(err, constant.ErrStorageQuotaExceeded) {
+			// 文件已经由客户端上传到云端，超出容量时一并删除，避免占用存储空间
+			if delErr := provider.Delete(ctx, policy, []string{objectKey}); delErr != nil {
+				log.Printf("[FinalizeClientUpload] 删除超出容量的云端文件 '%s' 失败: %v", objectKey, delErr)
+			}
+		}
 		return nil, err
 	}
 
+	if err := s.fileSvc.PruneVersions(ctx, createdFile.ID); err != nil {
+		log.Printf("[FinalizeClientUpload] 清理文件 %d 多余的历史版本失败: %v", createdFile.ID, err)
+	}
+
 	// 步骤 5: 发布文件创建事件（用于缩略图生成等）
 	if createdFile != nil && s.isThumbnailable(createdFile) {
 		log.Printf("[FinalizeClientUpload] 发布 FileCreated 事件，FileID: %d", createdFile.ID)
@@ -784,6 +967,36 @@ func (s *uploadService) FinalizeClientUpload(ctx context.Context, ownerID uint,
 	return createdFile, nil
 }
 
+// replacedFileSize 返回将被覆盖或恢复的同名文件原本计入存储用量的大小，不存在时返回 0
+func replacedFileSize(ctx context.Context, fileRepo repository.FileRepository, parentID uint, name string) (int64, error) {
+	existing, err := fileRepo.FindByParentIDAndNameUnscoped(ctx, parentID, name)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("查找同名文件失败: %w", err)
+	}
+	if existing.Type != model.FileTypeFile {
+		return 0, nil
+	}
+	return existing.Size, nil
+}
+
+// currentEntityID 返回将被覆盖的同名文件当前的物理实体ID，不存在时返回 0
+func currentEntityID(ctx context.Context, fileRepo repository.FileRepository, parentID uint, name string) (uint, error) {
+	existing, err := fileRepo.FindByParentIDAndName(ctx, parentID, name)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("查找同名文件失败: %w", err)
+	}
+	if existing.Type != model.FileTypeFile || !existing.PrimaryEntityID.Valid {
+		return 0, nil
+	}
+	return uint(existing.PrimaryEntityID.Uint64), nil
+}
+
 // buildObjectKey 是一个辅助函数，用于构建云存储对象键
 //
 // 【路径转换规则】
@@ -0,0 +1,625 @@
+// pkg/service/file/version.go
+package file
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/types"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+)
+
+// versionDirName 是存储策略根目录下存放历史版本的隐藏目录，同步时会跳过以 "." 开头的目录
+const versionDirName = ".versions"
+
+// maxVersions 返回存储策略允许每个文件保留的历史版本数，0 表示不保留
+func maxVersions(policy *model.StoragePolicy) int {
+	return policy.Settings.GetInt(constant.MaxVersionsSettingKey, 0)
+}
+
+// versionVirtualPath 返回历史版本在存储策略中的存放位置，按文件ID分目录，重命名文件不影响已保留的版本
+func versionVirtualPath(policy *model.StoragePolicy, fileID, entityID uint, name string) string {
+	return path.Join(policy.VirtualPath, versionDirName, strconv.FormatUint(uint64(fileID), 10), fmt.Sprintf("%d_%s", entityID, name))
+}
+
+// versionDirVirtualPath 返回某个文件的历史版本目录
+func versionDirVirtualPath(policy *model.StoragePolicy, fileID uint) string {
+	return path.Join(policy.VirtualPath, versionDirName, strconv.FormatUint(uint64(fileID), 10))
+}
+
+// isVersionSource 判断实体的存储位置是否位于历史版本目录中。
+// 功能上线前被覆盖的旧实体与新内容共用同一个存储位置，它们不能作为历史版本使用
+func isVersionSource(source string) bool {
+	source = filepath.ToSlash(source)
+	return strings.HasPrefix(source, versionDirName+"/") || strings.Contains(source, "/"+versionDirName+"/")
+}
+
+// ArchiveVersion 实现 FileService 接口
+func (s *serviceImpl) ArchiveVersion(ctx context.Context, ownerID uint, virtualPath string, incomingSize int64) error {
+	file, err := s.fileRepo.FindByPath(ctx, ownerID, virtualPath)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("查找待覆盖的文件失败: %w", err)
+	}
+	return s.archiveFileVersion(ctx, file, incomingSize)
+}
+
+// archiveFileVersion 将文件当前的内容复制到历史版本目录，并把当前实体指向复制后的位置。
+// 新内容随后写入原位置时，旧内容就作为历史版本保留了下来。这里只移动内容，
+// 版本关联和容量由 CommitVersion 在覆盖提交时登记，覆盖没有完成时文件仍使用复制后的内容，容量不变
+func (s *serviceImpl) archiveFileVersion(ctx context.Context, file *model.File, incomingSize int64) error {
+	if file.Type != model.FileTypeFile || !file.PrimaryEntityID.Valid {
+		return nil
+	}
+	entity, err := s.entityRepo.FindByID(ctx, uint(file.PrimaryEntityID.Uint64))
+	if err != nil {
+		return fmt.Errorf("查找文件当前的物理实体失败: %w", err)
+	}
+	if entity == nil || !entity.Source.Valid || isVersionSource(entity.Source.String) {
+		// 上一次覆盖在保留旧版本后失败时，当前实体已经位于历史版本目录中，无需再次复制
+		return nil
+	}
+	policy, err := s.policySvc.GetPolicyByDatabaseID(ctx, entity.PolicyID)
+	if err != nil {
+		return fmt.Errorf("找不到实体 %d 的存储策略: %w", entity.ID, err)
+	}
+	if maxVersions(policy) <= 0 {
+		return nil
+	}
+	// 旧内容保留为历史版本后，新内容需要完整计入存储容量
+	if err := s.quotaSvc.CheckQuota(ctx, file.OwnerID, incomingSize); err != nil {
+		return err
+	}
+	// 共享内容不会被新内容覆盖，覆盖提交时直接保留原实体作为历史版本，不需要复制
+	if entity.IsContentAddressed() {
+		return nil
+	}
+
+	provider, err := s.GetProviderForPolicy(policy)
+	if err != nil {
+		return err
+	}
+	reader, err := provider.Get(ctx, policy, entity.Source.String)
+	if err != nil {
+		return fmt.Errorf("读取文件当前内容失败: %w", err)
+	}
+	uploadResult, err := provider.Upload(ctx, reader, policy, versionVirtualPath(policy, file.ID, entity.ID, file.Name))
+	_ = reader.Close()
+	if err != nil {
+		return fmt.Errorf("保存历史版本失败: %w", err)
+	}
+	versionSource := uploadResult.Source
+
+	entity.Source = sql.NullString{String: versionSource, Valid: true}
+	if err := s.entityRepo.Update(ctx, entity); err != nil {
+		if delErr := provider.Delete(ctx, policy, []string{versionSource}); delErr != nil {
+			log.Printf("[FileVersion] 删除未登记的历史版本 '%s' 失败: %v", versionSource, delErr)
+		}
+		return fmt.Errorf("更新历史版本实体失败: %w", err)
+	}
+	log.Printf("[FileVersion] 文件 %d 的实体 %d 已复制到历史版本目录: %s", file.ID, entity.ID, versionSource)
+	return nil
+}
+
+// CommitVersion 实现 FileService 接口
+func (s *serviceImpl) CommitVersion(ctx context.Context, repos repository.Repositories, fileID, oldEntityID, newEntityID, uploaderID uint) error {
+	// 被替换的旧内容在覆盖前已经保留时，与覆盖在同一个事务中登记为历史版本并计入容量
+	keptOld := false
+	if oldEntityID != 0 && oldEntityID != newEntityID {
+		var err error
+		if keptOld, err = s.keepReplacedVersion(ctx, repos, fileID, oldEntityID); err != nil {
+			return err
+		}
+	}
+
+	// 新内容与文件自己的某个历史版本相同时复用了该版本的实体，它不再作为历史版本单独占用引用和容量
+	if newEntityID != oldEntityID {
+		reused, err := repos.FileEntity.FindByFileAndEntityID(ctx, fileID, newEntityID)
+		if err != nil {
+			return fmt.Errorf("查询文件版本关联记录失败: %w", err)
+		}
+		if reused != nil {
+			if err := s.releaseReusedVersion(ctx, repos, fileID, newEntityID); err != nil {
+				return err
+			}
+		}
+	}
+
+	newVersion := &model.FileStorageVersion{
+		FileID:           fileID,
+		EntityID:         newEntityID,
+		IsCurrent:        true,
+		UploadedByUserID: types.NullUint64{Uint64: uint64(uploaderID), Valid: uploaderID != 0},
+	}
+	if err := repos.FileEntity.Create(ctx, newVersion); err != nil {
+		return fmt.Errorf("创建文件版本关联记录失败: %w", err)
+	}
+	if err := repos.FileEntity.MarkOldVersionsAsNotCurrent(ctx, fileID, newVersion.ID); err != nil {
+		return fmt.Errorf("更新旧版本状态失败: %w", err)
+	}
+
+	// 没有被保留为历史版本的旧实体不再被任何版本引用，包括功能上线前覆盖时遗留的旧实体
+	staleIDs := make([]uint, 0)
+	if oldEntityID != 0 && oldEntityID != newEntityID {
+		staleIDs = append(staleIDs, oldEntityID)
+	}
+	versions, err := repos.FileEntity.ListByFileID(ctx, fileID)
+	if err != nil {
+		return err
+	}
+	for _, v := range versions {
+		if v.EntityID != newEntityID && v.EntityID != oldEntityID {
+			staleIDs = append(staleIDs, v.EntityID)
+		}
+	}
+
+	newEntity, err := repos.Entity.FindByID(ctx, newEntityID)
+	if err != nil {
+		return fmt.Errorf("查找新的物理实体失败: %w", err)
+	}
+	for _, entityID := range staleIDs {
+		oldEntity, err := repos.Entity.FindByID(ctx, entityID)
+		if err != nil {
+			return fmt.Errorf("查找旧的物理实体失败: %w", err)
+		}
+		if oldEntity == nil || (entityID == oldEntityID && keptOld) {
+			continue
+		}
+		// 共享内容在保留历史版本时不会被复制，除刚被替换的实体外都是已保留的历史版本
+		if entityID != oldEntityID && ((oldEntity.Source.Valid && isVersionSource(oldEntity.Source.String)) || oldEntity.IsContentAddressed()) {
+			continue
+		}
+		// 实体可能还被其他文件共享，只删除当前文件的关联记录
+		if err := repos.FileEntity.DeleteByFileAndEntityID(ctx, fileID, entityID); err != nil {
+			return err
+		}
+		last, err := releaseEntity(ctx, repos.Entity, entityID)
+		if err != nil {
+			return err
+		}
+		// 存储位置相同时旧内容已被新内容覆盖，只有存放在别处的旧内容需要删除
+		if last && newEntity != nil && oldEntity.Source.Valid &&
+			(oldEntity.PolicyID != newEntity.PolicyID || oldEntity.Source.String != newEntity.Source.String) {
+			s.deleteEntityContent(ctx, fileID, oldEntity)
+		}
+	}
+	return nil
+}
+
+// keepReplacedVersion 判断被替换的旧实体是否在覆盖前保留了下来，保留时登记为历史版本并计入存储用量，必须在事务中调用。
+// 复制过的旧实体位于历史版本目录中，共享内容在开启版本保留时原样保留
+func (s *serviceImpl) keepReplacedVersion(ctx context.Context, repos repository.Repositories, fileID, entityID uint) (bool, error) {
+	entity, err := repos.Entity.FindByID(ctx, entityID)
+	if err != nil {
+		return false, fmt.Errorf("查找旧的物理实体失败: %w", err)
+	}
+	if entity == nil {
+		return false, nil
+	}
+	if !entity.Source.Valid || !isVersionSource(entity.Source.String) {
+		if !entity.IsContentAddressed() {
+			return false, nil
+		}
+		policy, err := s.policySvc.GetPolicyByDatabaseID(ctx, entity.PolicyID)
+		if err != nil {
+			return false, fmt.Errorf("找不到实体 %d 的存储策略: %w", entity.ID, err)
+		}
+		if maxVersions(policy) <= 0 {
+			return false, nil
+		}
+	}
+
+	existing, err := repos.FileEntity.FindByFileAndEntityID(ctx, fileID, entityID)
+	if err != nil {
+		return false, fmt.Errorf("查询文件版本关联记录失败: %w", err)
+	}
+	if existing == nil {
+		version := &model.FileStorageVersion{
+			FileID:           fileID,
+			EntityID:         entityID,
+			IsCurrent:        true,
+			UploadedByUserID: entity.CreatedBy,
+		}
+		if err := repos.FileEntity.Create(ctx, version); err != nil {
+			return false, fmt.Errorf("创建文件版本关联记录失败: %w", err)
+		}
+	}
+	file, err := repos.File.FindByID(ctx, fileID)
+	if err != nil {
+		return false, fmt.Errorf("查找文件 %d 失败: %w", fileID, err)
+	}
+	// 旧内容从此作为历史版本单独计入用量，覆盖时按新旧大小之差调整的逻辑保持不变
+	if err := repos.User.AdjustStorageUsed(ctx, file.OwnerID, entity.Size); err != nil {
+		return false, fmt.Errorf("更新存储用量失败: %w", err)
+	}
+	return true, nil
+}
+
+// releaseReusedVersion 删除被新内容复用的历史版本的关联记录，并释放它作为历史版本占用的引用和容量
+func (s *serviceImpl) releaseReusedVersion(ctx context.Context, repos repository.Repositories, fileID, entityID uint) error {
+	file, err := repos.File.FindByID(ctx, fileID)
+	if err != nil {
+		return fmt.Errorf("查找文件 %d 失败: %w", fileID, err)
+	}
+	entity, err := repos.Entity.FindByID(ctx, entityID)
+	if err != nil {
+		return fmt.Errorf("查找历史版本的物理实体失败: %w", err)
+	}
+	if err := repos.FileEntity.DeleteByFileAndEntityID(ctx, fileID, entityID); err != nil {
+		return err
+	}
+	// 文件已经为新内容增加过引用，这里不会是最后一个引用
+	if _, err := repos.Entity.ReleaseReference(ctx, entityID); err != nil {
+		return err
+	}
+	return repos.User.AdjustStorageUsed(ctx, file.OwnerID, -entity.Size)
+}
+
+// historicalVersion 是一个已保留的历史版本及其物理实体
+type historicalVersion struct {
+	version *model.FileStorageVersion
+	entity  *model.FileStorageEntity
+}
+
+// listHistoricalVersions 按写入时间倒序返回文件已保留的历史版本，不包含当前内容
+func (s *serviceImpl) listHistoricalVersions(ctx context.Context, file *model.File) ([]historicalVersion, error) {
+	return findHistoricalVersions(ctx, s.fileEntityRepo, s.entityRepo, file)
+}
+
+// findHistoricalVersions 使用给定的仓库查找文件已保留的历史版本，可以在事务中使用
+func findHistoricalVersions(ctx context.Context, fileEntityRepo repository.FileEntityRepository, entityRepo repository.EntityRepository, file *model.File) ([]historicalVersion, error) {
+	versions, err := fileEntityRepo.ListByFileID(ctx, file.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]historicalVersion, 0, len(versions))
+	for _, v := range versions {
+		if v.IsCurrent || (file.PrimaryEntityID.Valid && uint(file.PrimaryEntityID.Uint64) == v.EntityID) {
+			continue
+		}
+		entity, err := entityRepo.FindByID(ctx, v.EntityID)
+		if err != nil {
+			return nil, fmt.Errorf("查找历史版本的物理实体失败: %w", err)
+		}
+		if entity == nil || !entity.Source.Valid || !(isVersionSource(entity.Source.String) || entity.IsContentAddressed()) {
+			continue
+		}
+		result = append(result, historicalVersion{version: v, entity: entity})
+	}
+	return result, nil
+}
+
+// PruneVersions 实现 FileService 接口
+func (s *serviceImpl) PruneVersions(ctx context.Context, fileID uint) error {
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		return fmt.Errorf("查找文件 %d 失败: %w", fileID, err)
+	}
+	versions, err := s.listHistoricalVersions(ctx, file)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return nil
+	}
+	policy, err := s.policySvc.GetPolicyByDatabaseID(ctx, versions[0].entity.PolicyID)
+	if err != nil {
+		return fmt.Errorf("找不到实体 %d 的存储策略: %w", versions[0].entity.ID, err)
+	}
+	// 关闭版本保留后不会自动清理已有的历史版本，用户可以手动删除
+	limit := maxVersions(policy)
+	if limit <= 0 || len(versions) <= limit {
+		return nil
+	}
+	for _, v := range versions[limit:] {
+		if err := s.deleteHistoricalVersion(ctx, file, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteHistoricalVersion 删除一个历史版本的物理内容和数据库记录，并释放其占用的容量
+func (s *serviceImpl) deleteHistoricalVersion(ctx context.Context, file *model.File, v historicalVersion) error {
+	deleteContent := false
+	err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		if err := repos.FileEntity.HardDelete(ctx, v.version.ID); err != nil {
+			return fmt.Errorf("删除文件版本关联记录失败: %w", err)
+		}
+		last, err := releaseEntity(ctx, repos.Entity, v.entity.ID)
+		if err != nil {
+			return fmt.Errorf("删除历史版本实体失败: %w", err)
+		}
+		deleteContent = last
+		return repos.User.AdjustStorageUsed(ctx, file.OwnerID, -v.entity.Size)
+	})
+	if err != nil {
+		return err
+	}
+	if deleteContent {
+		s.deleteEntityContent(ctx, file.ID, v.entity)
+	}
+	return nil
+}
+
+// deleteFileVersions 在永久删除文件时删除它全部的历史版本，返回释放的容量，必须在事务中调用。
+// 历史版本的实体只通过版本关联挂在文件上，需要在删除版本关联之前调用
+func (s *serviceImpl) deleteFileVersions(ctx context.Context, file *model.File, txEntityRepo repository.EntityRepository, txFileEntityRepo repository.FileEntityRepository) (int64, error) {
+	versions, err := findHistoricalVersions(ctx, txFileEntityRepo, txEntityRepo, file)
+	if err != nil {
+		return 0, err
+	}
+	var released int64
+	for _, v := range versions {
+		if err := txFileEntityRepo.HardDelete(ctx, v.version.ID); err != nil {
+			return 0, fmt.Errorf("删除文件版本关联记录失败: %w", err)
+		}
+		last, err := releaseEntity(ctx, txEntityRepo, v.entity.ID)
+		if err != nil {
+			return 0, fmt.Errorf("删除历史版本实体 %d 失败: %w", v.entity.ID, err)
+		}
+		if last {
+			s.deleteEntityContent(ctx, file.ID, v.entity)
+		}
+		released += v.entity.Size
+	}
+	return released, nil
+}
+
+// deleteEntityContent 删除实体的物理内容，删除的是历史版本时，文件的历史版本目录空了之后一并删除。
+// 物理删除失败只记录日志
+func (s *serviceImpl) deleteEntityContent(ctx context.Context, fileID uint, entity *model.FileStorageEntity) {
+	policy, err := s.policySvc.GetPolicyByDatabaseID(ctx, entity.PolicyID)
+	if err != nil {
+		log.Printf("[FileVersion] 找不到实体 %d 的存储策略，无法删除物理内容: %v", entity.ID, err)
+		return
+	}
+	provider, err := s.GetProviderForPolicy(policy)
+	if err != nil {
+		log.Printf("[FileVersion] 获取实体 %d 的存储驱动失败: %v", entity.ID, err)
+		return
+	}
+	if err := provider.Delete(ctx, policy, []string{entity.Source.String}); err != nil {
+		log.Printf("[FileVersion] 删除物理内容 '%s' 失败: %v", entity.Source.String, err)
+		return
+	}
+	if isVersionSource(entity.Source.String) {
+		_ = provider.DeleteDirectory(ctx, policy, versionDirVirtualPath(policy, fileID))
+	}
+}
+
+// findFileForVersions 查找用户拥有的文件，用于版本相关的操作
+func (s *serviceImpl) findFileForVersions(ctx context.Context, ownerID uint, filePublicID string) (*model.File, error) {
+	fileID, entityType, err := idgen.DecodePublicID(filePublicID)
+	if err != nil || entityType != idgen.EntityTypeFile {
+		return nil, constant.ErrNotFound
+	}
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		return nil, constant.ErrNotFound
+	}
+	if file.OwnerID != ownerID {
+		return nil, constant.ErrForbidden
+	}
+	if file.Type != model.FileTypeFile {
+		return nil, fmt.Errorf("目录没有历史版本: %w", constant.ErrInvalidOperation)
+	}
+	return file, nil
+}
+
+// findHistoricalVersion 查找文件的某个历史版本，当前内容不算作历史版本
+func (s *serviceImpl) findHistoricalVersion(ctx context.Context, file *model.File, versionPublicID string) (historicalVersion, error) {
+	versionID, entityType, err := idgen.DecodePublicID(versionPublicID)
+	if err != nil || entityType != idgen.EntityTypeFileVersion {
+		return historicalVersion{}, constant.ErrVersionNotFound
+	}
+	versions, err := s.listHistoricalVersions(ctx, file)
+	if err != nil {
+		return historicalVersion{}, err
+	}
+	for _, v := range versions {
+		if v.version.ID == versionID {
+			return v, nil
+		}
+	}
+	return historicalVersion{}, constant.ErrVersionNotFound
+}
+
+// ListVersions 实现 FileService 接口
+func (s *serviceImpl) ListVersions(ctx context.Context, ownerID uint, filePublicID string) ([]*model.FileVersionItem, error) {
+	file, err := s.findFileForVersions(ctx, ownerID, filePublicID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.FileVersionItem, 0)
+	if file.PrimaryEntityID.Valid {
+		current := &model.FileVersionItem{Size: file.Size, IsCurrent: true, CreatedAt: file.UpdatedAt}
+		if v, err := s.fileEntityRepo.FindByFileAndEntityID(ctx, file.ID, uint(file.PrimaryEntityID.Uint64)); err == nil && v != nil {
+			current.ID, _ = idgen.GeneratePublicID(v.ID, idgen.EntityTypeFileVersion)
+			current.CreatedAt = v.CreatedAt
+			current.UploadedBy = publicUserID(v.UploadedByUserID)
+		}
+		items = append(items, current)
+	}
+
+	versions, err := s.listHistoricalVersions(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		publicID, err := idgen.GeneratePublicID(v.version.ID, idgen.EntityTypeFileVersion)
+		if err != nil {
+			return nil, fmt.Errorf("生成版本公共ID失败: %w", err)
+		}
+		items = append(items, &model.FileVersionItem{
+			ID:         publicID,
+			Size:       v.entity.Size,
+			CreatedAt:  v.version.CreatedAt,
+			UploadedBy: publicUserID(v.version.UploadedByUserID),
+		})
+	}
+	return items, nil
+}
+
+// publicUserID 将可空的用户ID转换为公共ID，为空时返回空字符串
+func publicUserID(userID types.NullUint64) string {
+	if !userID.Valid {
+		return ""
+	}
+	publicID, _ := idgen.GeneratePublicID(uint(userID.Uint64), idgen.EntityTypeUser)
+	return publicID
+}
+
+// DownloadVersion 实现 FileService 接口
+func (s *serviceImpl) DownloadVersion(ctx context.Context, ownerID uint, filePublicID, versionPublicID string, writer io.Writer) (*DownloadResult, error) {
+	file, err := s.findFileForVersions(ctx, ownerID, filePublicID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.findHistoricalVersion(ctx, file, versionPublicID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.serveEntity(ctx, file, v.entity, writer); err != nil {
+		return nil, err
+	}
+	return &DownloadResult{Name: file.Name, Size: v.entity.Size}, nil
+}
+
+// RestoreVersion 实现 FileService 接口
+func (s *serviceImpl) RestoreVersion(ctx context.Context, ownerID uint, filePublicID, versionPublicID string) (*model.UpdateResult, error) {
+	file, err := s.findFileForVersions(ctx, ownerID, filePublicID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.findHistoricalVersion(ctx, file, versionPublicID)
+	if err != nil {
+		return nil, err
+	}
+	virtualPath, err := s.GetFullVirtualPath(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("无法获取文件的当前路径: %w", err)
+	}
+
+	versionPolicy, err := s.policySvc.GetPolicyByDatabaseID(ctx, v.entity.PolicyID)
+	if err != nil {
+		return nil, fmt.Errorf("找不到历史版本的存储策略: %w", err)
+	}
+	versionProvider, err := s.GetProviderForPolicy(versionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := versionProvider.Get(ctx, versionPolicy, v.entity.Source.String)
+	if err != nil {
+		return nil, fmt.Errorf("读取历史版本内容失败: %w", err)
+	}
+	defer reader.Close()
+
+	// 恢复相当于用历史版本的内容再写一次文件，当前内容同样会被保留为历史版本
+	updatedFile, err := s.replaceContent(ctx, file, virtualPath, reader, v.entity.Size, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.UpdateResult{
+		PublicID:  filePublicID,
+		Size:      updatedFile.Size,
+		UpdatedAt: updatedFile.UpdatedAt,
+	}, nil
+}
+
+// DeleteVersion 实现 FileService 接口
+func (s *serviceImpl) DeleteVersion(ctx context.Context, ownerID uint, filePublicID, versionPublicID string) error {
+	file, err := s.findFileForVersions(ctx, ownerID, filePublicID)
+	if err != nil {
+		return err
+	}
+	v, err := s.findHistoricalVersion(ctx, file, versionPublicID)
+	if err != nil {
+		return err
+	}
+	return s.deleteHistoricalVersion(ctx, file, v)
+}
+
+// replaceContent 用新内容覆盖文件，覆盖前按存储策略的设置保留旧内容，返回更新后的文件
+func (s *serviceImpl) replaceContent(ctx context.Context, file *model.File, virtualPath string, content io.Reader, size int64, uploaderID uint) (*model.File, error) {
+	if err := s.quotaSvc.CheckQuota(ctx, file.OwnerID, size-file.Size); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.vfsSvc.FindPolicyForPath(ctx, virtualPath)
+	if err != nil {
+		return nil, fmt.Errorf("找不到路径 %s 的存储策略: %w", virtualPath, err)
+	}
+	provider, err := s.GetProviderForPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.archiveFileVersion(ctx, file, size); err != nil {
+		return nil, err
+	}
+
+	uploadResult, err := provider.Upload(ctx, content, policy, virtualPath)
+	if err != nil {
+		return nil, fmt.Errorf("存储驱动上传新内容失败: %w", err)
+	}
+
+	var updatedFile *model.File
+	err = s.txManager.Do(ctx, func(repos repository.Repositories) error {
+		newEntity := &model.FileStorageEntity{
+			PolicyID:  policy.ID,
+			CreatedBy: types.NullUint64{Uint64: uint64(uploaderID), Valid: true},
+			Source:    sql.NullString{String: uploadResult.Source, Valid: true},
+			Size:      uploadResult.Size,
+			MimeType:  sql.NullString{String: uploadResult.MimeType, Valid: true},
+		}
+		if err := repos.Entity.Create(ctx, newEntity); err != nil {
+			return fmt.Errorf("创建新的文件实体失败: %w", err)
+		}
+
+		fileToUpdate, err := repos.File.FindByID(ctx, file.ID)
+		if err != nil {
+			return err
+		}
+
+		oldSize := fileToUpdate.Size
+		oldEntityID := uint(fileToUpdate.PrimaryEntityID.Uint64)
+		fileToUpdate.Size = newEntity.Size
+		fileToUpdate.PrimaryEntityID = types.NullUint64{Uint64: uint64(newEntity.ID), Valid: true}
+		if err := repos.File.Update(ctx, fileToUpdate); err != nil {
+			return fmt.Errorf("更新文件记录失败: %w", err)
+		}
+		if err := repos.User.AdjustStorageUsed(ctx, fileToUpdate.OwnerID, fileToUpdate.Size-oldSize); err != nil {
+			return fmt.Errorf("更新存储用量失败: %w", err)
+		}
+		if err := s.CommitVersion(ctx, repos, fileToUpdate.ID, oldEntityID, newEntity.ID, uploaderID); err != nil {
+			return err
+		}
+
+		updatedFile = fileToUpdate
+		return nil
+	})
+	if err != nil {
+		go provider.Delete(context.Background(), policy, []string{uploadResult.Source})
+		return nil, err
+	}
+
+	if err := s.PruneVersions(ctx, updatedFile.ID); err != nil {
+		log.Printf("[FileVersion] 清理文件 %d 多余的历史版本失败: %v", updatedFile.ID, err)
+	}
+	return updatedFile, nil
+}
@@ -0,0 +1,206 @@
+package file
+
+import (
+	"io"
+	"testing"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/types"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+)
+
+// overwrite 按上传流程用 entity 替换文件的当前内容，reused 表示 entity 是复用的已有实体
+func (env *testEnv) overwrite(t *testing.T, file *model.File, entity *model.FileStorageEntity, reused bool) {
+	t.Helper()
+	if err := env.svc.ArchiveVersion(env.ctx, env.ownerID, "/"+file.Name, entity.Size); err != nil {
+		t.Fatalf("保留历史版本失败: %v", err)
+	}
+	err := env.svc.txManager.Do(env.ctx, func(repos repository.Repositories) error {
+		if reused {
+			if err := repos.Entity.AddReference(env.ctx, entity.ID, 1); err != nil {
+				return err
+			}
+		}
+		current, err := repos.File.FindByID(env.ctx, file.ID)
+		if err != nil {
+			return err
+		}
+		oldEntityID := uint(current.PrimaryEntityID.Uint64)
+		replacedSize := current.Size
+		current.PrimaryEntityID = types.NullUint64{Uint64: uint64(entity.ID), Valid: true}
+		current.Size = entity.Size
+		if err := repos.File.Update(env.ctx, current); err != nil {
+			return err
+		}
+		if err := repos.User.AdjustStorageUsed(env.ctx, env.ownerID, entity.Size-replacedSize); err != nil {
+			return err
+		}
+		return env.svc.CommitVersion(env.ctx, repos, file.ID, oldEntityID, entity.ID, env.ownerID)
+	})
+	if err != nil {
+		t.Fatalf("覆盖文件失败: %v", err)
+	}
+}
+
+// readSource 读取存储位置中的内容
+func (env *testEnv) readSource(t *testing.T, provider *memProvider, source string) string {
+	t.Helper()
+	reader, err := provider.Get(env.ctx, env.primary, source)
+	if err != nil {
+		t.Fatalf("读取 %s 失败: %v", source, err)
+	}
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestOverwriteKeepsPreviousVersion(t *testing.T) {
+	env := newTestEnv(t)
+	env.primary.Settings[constant.MaxVersionsSettingKey] = float64(3)
+	old := env.putEntity(t, env.primary, "/a.txt", []byte("v1"), "")
+	file := env.putFile(t, "a.txt", old)
+
+	// 新内容写入同一位置前，旧内容被复制到历史版本目录
+	if err := env.svc.ArchiveVersion(env.ctx, env.ownerID, "/a.txt", 3); err != nil {
+		t.Fatal(err)
+	}
+	archived := env.entity(t, old.ID)
+	if !isVersionSource(archived.Source.String) {
+		t.Fatalf("旧实体应指向历史版本目录，实际为 %s", archived.Source.String)
+	}
+	newEntity := env.putEntity(t, env.primary, "/a.txt", []byte("v22"), "")
+	env.overwrite(t, file, newEntity, false)
+
+	versions, err := env.svc.listHistoricalVersions(env.ctx, env.file(t, file.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || versions[0].entity.ID != old.ID {
+		t.Fatalf("应保留 1 个历史版本，实际: %+v", versions)
+	}
+	if got := env.readSource(t, env.primaryP, versions[0].entity.Source.String); got != "v1" {
+		t.Fatalf("历史版本的内容应为 v1，实际为 %q", got)
+	}
+	if got := env.readSource(t, env.primaryP, "/a.txt"); got != "v22" {
+		t.Fatalf("文件的当前内容应为 v22，实际为 %q", got)
+	}
+	if used := env.storageUsed(t); used != 5 {
+		t.Fatalf("存储用量应包含历史版本和当前内容共 5 字节，实际为 %d", used)
+	}
+
+	// 永久删除文件时历史版本一并删除
+	env.hardDelete(t, file.ID)
+	if env.entity(t, old.ID) != nil || env.entity(t, newEntity.ID) != nil {
+		t.Fatal("永久删除文件后当前实体和历史版本实体都应被删除")
+	}
+	if n := env.primaryP.count(); n != 0 {
+		t.Fatalf("永久删除文件后不应留下物理内容，实际剩余 %d 个", n)
+	}
+	if used := env.storageUsed(t); used != 0 {
+		t.Fatalf("永久删除文件后存储用量应为 0，实际为 %d", used)
+	}
+}
+
+func TestUncommittedOverwriteDoesNotChargeVersion(t *testing.T) {
+	env := newTestEnv(t)
+	env.primary.Settings[constant.MaxVersionsSettingKey] = float64(3)
+	old := env.putEntity(t, env.primary, "/a.txt", []byte("v1"), "")
+	file := env.putFile(t, "a.txt", old)
+
+	// 保留旧内容后覆盖没有提交，例如客户端放弃了直传或新内容上传失败
+	if err := env.svc.ArchiveVersion(env.ctx, env.ownerID, "/a.txt", 3); err != nil {
+		t.Fatal(err)
+	}
+	if used := env.storageUsed(t); used != 2 {
+		t.Fatalf("覆盖提交前不应计入历史版本，存储用量应为 2，实际为 %d", used)
+	}
+	archived := env.entity(t, old.ID)
+	if got := env.readSource(t, env.primaryP, archived.Source.String); got != "v1" {
+		t.Fatalf("文件应继续使用复制后的旧内容，实际为 %q", got)
+	}
+
+	// 再次覆盖时不重复复制，旧内容在提交时登记为历史版本
+	newEntity := env.putEntity(t, env.primary, "/a.txt", []byte("v22"), "")
+	env.overwrite(t, file, newEntity, false)
+	if used := env.storageUsed(t); used != 5 {
+		t.Fatalf("存储用量应包含历史版本和当前内容共 5 字节，实际为 %d", used)
+	}
+
+	env.hardDelete(t, file.ID)
+	if used := env.storageUsed(t); used != 0 {
+		t.Fatalf("永久删除文件后存储用量应为 0，实际为 %d", used)
+	}
+}
+
+func TestOverwriteWithoutVersionRetentionReleasesOldEntity(t *testing.T) {
+	env := newTestEnv(t)
+	old := env.putEntity(t, env.primary, "/a.txt", []byte("v1"), "")
+	file := env.putFile(t, "a.txt", old)
+
+	newEntity := env.putEntity(t, env.primary, "/a.txt", []byte("v22"), "")
+	env.overwrite(t, file, newEntity, false)
+
+	if env.entity(t, old.ID) != nil {
+		t.Fatal("未开启版本保留时旧实体应被删除")
+	}
+	if got := env.readSource(t, env.primaryP, "/a.txt"); got != "v22" {
+		t.Fatalf("与旧实体位置相同的新内容不应被删除，实际为 %q", got)
+	}
+	if used := env.storageUsed(t); used != 3 {
+		t.Fatalf("存储用量应只包含当前内容，实际为 %d", used)
+	}
+}
+
+func TestCommitVersionReusesHistoricalEntity(t *testing.T) {
+	env := newTestEnv(t)
+	env.primary.Settings[constant.MaxVersionsSettingKey] = float64(3)
+	v1 := []byte("v1")
+	hash := hashOf(t, v1)
+	// 共享内容保留为历史版本时不会被复制，之后可以被相同内容的上传复用
+	shared := env.putEntity(t, env.primary, contentStoreVirtualPath(env.primary, hash, "a.txt"), v1, hash)
+	file := env.putFile(t, "a.txt", shared)
+
+	v2 := env.putEntity(t, env.primary, "/a.txt", []byte("v22"), "")
+	env.overwrite(t, file, v2, false)
+	if e := env.entity(t, shared.ID); e == nil || e.Source.String != shared.Source.String {
+		t.Fatalf("共享内容应原样保留为历史版本，实际: %+v", e)
+	}
+	if used := env.storageUsed(t); used != 5 {
+		t.Fatalf("存储用量应包含历史版本和当前内容共 5 字节，实际为 %d", used)
+	}
+
+	reusable, err := env.svc.FindReusableEntity(env.ctx, env.primary, hash, int64(len(v1)), v2.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reusable == nil || reusable.ID != shared.ID {
+		t.Fatalf("相同内容的上传应复用历史版本的实体，实际: %+v", reusable)
+	}
+	env.overwrite(t, file, reusable, true)
+
+	current := env.file(t, file.ID)
+	if current.PrimaryEntityID.Uint64 != uint64(shared.ID) {
+		t.Fatalf("文件的当前实体应为复用的实体 %d，实际为 %d", shared.ID, current.PrimaryEntityID.Uint64)
+	}
+	if e := env.entity(t, shared.ID); e == nil || e.ReferenceCount != 1 {
+		t.Fatalf("复用的实体不再作为历史版本，引用计数应为 1，实际: %+v", e)
+	}
+	// 被替换的 v2 在覆盖前被复制到历史版本目录，成为唯一的历史版本；复用的实体不再作为历史版本列出
+	historical, err := env.svc.listHistoricalVersions(env.ctx, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(historical) != 1 || historical[0].entity.ID != v2.ID {
+		t.Fatalf("应只剩 v2 一个历史版本，实际: %+v", historical)
+	}
+	if got := env.readSource(t, env.primaryP, historical[0].entity.Source.String); got != "v22" {
+		t.Fatalf("历史版本的内容应为 v22，实际为 %q", got)
+	}
+	if used := env.storageUsed(t); used != 5 {
+		t.Fatalf("复用的历史版本不应重复计入存储用量，实际为 %d", used)
+	}
+}
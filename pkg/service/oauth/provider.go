@@ -0,0 +1,518 @@
+package oauth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// 支持的提供方类型
+const (
+	ProviderTypeGitHub = "github"
+	ProviderTypeGoogle = "google"
+	ProviderTypeGitee  = "gitee"
+	ProviderTypeQQ     = "qq"
+	ProviderTypeOIDC   = "oidc"   // 任意支持 OpenID Connect Discovery 的身份提供方
+	ProviderTypeOAuth2 = "oauth2" // 手动填写端点的通用 OAuth2 提供方
+)
+
+// ProviderConfig 是设置项 OAUTH_PROVIDERS 中每个提供方的配置
+type ProviderConfig struct {
+	ID           string   `json:"id"`   // 唯一标识，出现在回调地址中，例如 github
+	Name         string   `json:"name"` // 展示名称
+	Type         string   `json:"type"`
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	Issuer       string   `json:"issuer,omitempty"`       // oidc 类型必填
+	AuthURL      string   `json:"auth_url,omitempty"`     // oauth2 类型必填，其余类型可覆盖默认值
+	TokenURL     string   `json:"token_url,omitempty"`    // 同上
+	UserInfoURL  string   `json:"userinfo_url,omitempty"` // 同上
+	Scopes       []string `json:"scopes,omitempty"`
+	Icon         string   `json:"icon,omitempty"`
+	Enabled      *bool    `json:"enabled,omitempty"` // 缺省为启用
+}
+
+// IsEnabled 判断提供方是否启用
+func (c *ProviderConfig) IsEnabled() bool {
+	return c.Enabled == nil || *c.Enabled
+}
+
+// ExternalIdentity 是从提供方获取到的用户资料
+type ExternalIdentity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool // 只有提供方确认过的邮箱才会用于关联已有账户
+	Name          string
+	AvatarURL     string
+}
+
+// provider 是解析完端点后可直接使用的提供方
+type provider struct {
+	cfg         ProviderConfig
+	oauth2      *oauth2.Config
+	issuer      string // 仅 oidc 类型
+	userInfoURL string
+	httpClient  *http.Client
+}
+
+// presetEndpoints 是内置提供方的默认端点
+var presetEndpoints = map[string]struct {
+	authURL, tokenURL, userInfoURL string
+	scopes                         []string
+}{
+	ProviderTypeGitHub: {
+		authURL:     "https://github.com/login/oauth/authorize",
+		tokenURL:    "https://github.com/login/oauth/access_token",
+		userInfoURL: "https://api.github.com/user",
+		scopes:      []string{"read:user", "user:email"},
+	},
+	ProviderTypeGitee: {
+		authURL:     "https://gitee.com/oauth/authorize",
+		tokenURL:    "https://gitee.com/oauth/token",
+		userInfoURL: "https://gitee.com/api/v5/user",
+		scopes:      []string{"user_info", "emails"},
+	},
+	ProviderTypeQQ: {
+		authURL:     "https://graph.qq.com/oauth2.0/authorize",
+		tokenURL:    "https://graph.qq.com/oauth2.0/token?fmt=json",
+		userInfoURL: "https://graph.qq.com/user/get_user_info",
+		scopes:      []string{"get_user_info"},
+	},
+}
+
+const googleIssuer = "https://accounts.google.com"
+
+// newProvider 根据配置解析出完整的端点信息，oidc 类型会通过 Discovery 获取端点
+func newProvider(ctx context.Context, cfg ProviderConfig, redirectURL string, httpClient *http.Client) (*provider, error) {
+	if cfg.ClientID == "" {
+		return nil, fmt.Errorf("第三方登录 %s 未配置 client_id", cfg.ID)
+	}
+	p := &provider{cfg: cfg, httpClient: httpClient}
+	authURL, tokenURL, userInfoURL, scopes := cfg.AuthURL, cfg.TokenURL, cfg.UserInfoURL, cfg.Scopes
+
+	switch cfg.Type {
+	case ProviderTypeGitHub, ProviderTypeGitee, ProviderTypeQQ:
+		preset := presetEndpoints[cfg.Type]
+		authURL = firstNonEmpty(authURL, preset.authURL)
+		tokenURL = firstNonEmpty(tokenURL, preset.tokenURL)
+		userInfoURL = firstNonEmpty(userInfoURL, preset.userInfoURL)
+		if len(scopes) == 0 {
+			scopes = preset.scopes
+		}
+	case ProviderTypeGoogle, ProviderTypeOIDC:
+		issuer := cfg.Issuer
+		if cfg.Type == ProviderTypeGoogle {
+			issuer = firstNonEmpty(issuer, googleIssuer)
+		}
+		if issuer == "" {
+			return nil, fmt.Errorf("第三方登录 %s 未配置 issuer", cfg.ID)
+		}
+		meta, err := discover(ctx, httpClient, issuer)
+		if err != nil {
+			return nil, err
+		}
+		p.issuer = meta.Issuer
+		authURL = firstNonEmpty(authURL, meta.AuthorizationEndpoint)
+		tokenURL = firstNonEmpty(tokenURL, meta.TokenEndpoint)
+		userInfoURL = firstNonEmpty(userInfoURL, meta.UserinfoEndpoint)
+		if len(scopes) == 0 {
+			scopes = []string{"openid", "email", "profile"}
+		} else if !slices.Contains(scopes, "openid") {
+			scopes = append([]string{"openid"}, scopes...)
+		}
+	case ProviderTypeOAuth2:
+		if authURL == "" || tokenURL == "" || userInfoURL == "" {
+			return nil, fmt.Errorf("第三方登录 %s 需要配置 auth_url、token_url 和 userinfo_url", cfg.ID)
+		}
+	default:
+		return nil, fmt.Errorf("不支持的第三方登录类型: %s", cfg.Type)
+	}
+
+	p.userInfoURL = userInfoURL
+	p.oauth2 = &oauth2.Config{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		Endpoint:     oauth2.Endpoint{AuthURL: authURL, TokenURL: tokenURL, AuthStyle: oauth2.AuthStyleInParams},
+		RedirectURL:  redirectURL,
+		Scopes:       scopes,
+	}
+	return p, nil
+}
+
+// authCodeURL 生成跳转到提供方的授权地址，使用 PKCE (S256)
+func (p *provider) authCodeURL(state, verifier, nonce string) string {
+	opts := []oauth2.AuthCodeOption{oauth2.S256ChallengeOption(verifier)}
+	if p.issuer != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("nonce", nonce))
+	}
+	return p.oauth2.AuthCodeURL(state, opts...)
+}
+
+// exchange 用授权码换取令牌并获取用户资料
+func (p *provider) exchange(ctx context.Context, code, verifier, nonce string) (*ExternalIdentity, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+	token, err := p.oauth2.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("获取访问令牌失败: %w", err)
+	}
+
+	var identity *ExternalIdentity
+	switch p.cfg.Type {
+	case ProviderTypeGitHub:
+		identity, err = p.githubIdentity(ctx, token)
+	case ProviderTypeGitee:
+		identity, err = p.giteeIdentity(ctx, token)
+	case ProviderTypeQQ:
+		identity, err = p.qqIdentity(ctx, token)
+	case ProviderTypeGoogle, ProviderTypeOIDC:
+		identity, err = p.oidcIdentity(ctx, token, nonce)
+	default:
+		identity, err = p.genericIdentity(ctx, token)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if identity.Subject == "" {
+		return nil, errors.New("第三方账号未返回用户标识")
+	}
+	identity.Email = strings.ToLower(strings.TrimSpace(identity.Email))
+	return identity, nil
+}
+
+// oidcIdentity 从 ID Token 中读取用户资料，并用 UserInfo 端点补全。
+// ID Token 是通过 TLS 直接从令牌端点获取的，按 OIDC Core 3.1.3.7 可以不校验签名，但仍需校验 iss、aud、exp 和 nonce。
+func (p *provider) oidcIdentity(ctx context.Context, token *oauth2.Token, nonce string) (*ExternalIdentity, error) {
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, errors.New("身份提供方未返回 id_token")
+	}
+	claims, err := parseIDToken(rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Issuer != p.issuer {
+		return nil, fmt.Errorf("id_token 的签发方 %s 与配置不一致", claims.Issuer)
+	}
+	if !claims.Audience.contains(p.cfg.ClientID) {
+		return nil, errors.New("id_token 的受众与 client_id 不一致")
+	}
+	if claims.Expiry == 0 || time.Unix(claims.Expiry, 0).Before(time.Now().Add(-time.Minute)) {
+		return nil, errors.New("id_token 已过期")
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("id_token 的 nonce 不匹配")
+	}
+
+	identity := &ExternalIdentity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          firstNonEmpty(claims.Name, claims.PreferredUsername),
+		AvatarURL:     claims.Picture,
+	}
+	if p.userInfoURL != "" {
+		var info oidcClaims
+		if err := p.getJSON(ctx, token, p.userInfoURL, &info); err == nil && info.Subject == claims.Subject {
+			if info.Email != "" {
+				identity.Email = info.Email
+				identity.EmailVerified = bool(info.EmailVerified)
+			}
+			identity.Name = firstNonEmpty(info.Name, info.PreferredUsername, identity.Name)
+			identity.AvatarURL = firstNonEmpty(info.Picture, identity.AvatarURL)
+		}
+	}
+	return identity, nil
+}
+
+// githubIdentity 获取 GitHub 用户资料，邮箱取自 /user/emails 中已验证的主邮箱
+func (p *provider) githubIdentity(ctx context.Context, token *oauth2.Token) (*ExternalIdentity, error) {
+	var user struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := p.getJSON(ctx, token, p.userInfoURL, &user); err != nil {
+		return nil, err
+	}
+	identity := &ExternalIdentity{
+		Subject:   fmt.Sprint(user.ID),
+		Name:      firstNonEmpty(user.Name, user.Login),
+		AvatarURL: user.AvatarURL,
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	emailsURL := strings.TrimSuffix(p.userInfoURL, "/user") + "/user/emails"
+	if err := p.getJSON(ctx, token, emailsURL, &emails); err == nil {
+		for _, e := range emails {
+			if e.Primary && e.Verified {
+				identity.Email, identity.EmailVerified = e.Email, true
+				break
+			}
+		}
+	}
+	return identity, nil
+}
+
+// giteeIdentity 获取 Gitee 用户资料，邮箱取自 /emails 中已确认的邮箱
+func (p *provider) giteeIdentity(ctx context.Context, token *oauth2.Token) (*ExternalIdentity, error) {
+	var user struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := p.getJSON(ctx, token, p.userInfoURL, &user); err != nil {
+		return nil, err
+	}
+	identity := &ExternalIdentity{
+		Subject:   fmt.Sprint(user.ID),
+		Name:      firstNonEmpty(user.Name, user.Login),
+		AvatarURL: user.AvatarURL,
+	}
+
+	var emails []struct {
+		Email string `json:"email"`
+		State string `json:"state"`
+	}
+	emailsURL := strings.TrimSuffix(p.userInfoURL, "/user") + "/emails"
+	if err := p.getJSON(ctx, token, emailsURL, &emails); err == nil {
+		for _, e := range emails {
+			if e.State == "confirmed" {
+				identity.Email, identity.EmailVerified = e.Email, true
+				break
+			}
+		}
+	}
+	return identity, nil
+}
+
+// qqIdentity 获取 QQ 用户资料。QQ 互联不提供邮箱，只能在登录后手动绑定
+func (p *provider) qqIdentity(ctx context.Context, token *oauth2.Token) (*ExternalIdentity, error) {
+	base, err := url.Parse(p.userInfoURL)
+	if err != nil {
+		return nil, err
+	}
+	meURL := base.Scheme + "://" + base.Host + "/oauth2.0/me?fmt=json&access_token=" + url.QueryEscape(token.AccessToken)
+	var me struct {
+		OpenID string `json:"openid"`
+	}
+	if err := p.getJSON(ctx, nil, meURL, &me); err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	q.Set("access_token", token.AccessToken)
+	q.Set("oauth_consumer_key", p.cfg.ClientID)
+	q.Set("openid", me.OpenID)
+	var info struct {
+		Ret       int    `json:"ret"`
+		Nickname  string `json:"nickname"`
+		Avatar    string `json:"figureurl_qq_2"`
+		AvatarAlt string `json:"figureurl_qq_1"`
+	}
+	if err := p.getJSON(ctx, nil, p.userInfoURL+"?"+q.Encode(), &info); err != nil {
+		return nil, err
+	}
+	return &ExternalIdentity{
+		Subject:   me.OpenID,
+		Name:      info.Nickname,
+		AvatarURL: firstNonEmpty(info.Avatar, info.AvatarAlt),
+	}, nil
+}
+
+// genericIdentity 兼容常见字段命名的通用 UserInfo 解析
+func (p *provider) genericIdentity(ctx context.Context, token *oauth2.Token) (*ExternalIdentity, error) {
+	var info map[string]any
+	if err := p.getJSON(ctx, token, p.userInfoURL, &info); err != nil {
+		return nil, err
+	}
+	str := func(keys ...string) string {
+		for _, k := range keys {
+			switch v := info[k].(type) {
+			case string:
+				if v != "" {
+					return v
+				}
+			case float64:
+				return fmt.Sprintf("%.0f", v)
+			}
+		}
+		return ""
+	}
+	verified, _ := info["email_verified"].(bool)
+	return &ExternalIdentity{
+		Subject:       str("sub", "id", "user_id", "openid"),
+		Email:         str("email"),
+		EmailVerified: verified,
+		Name:          str("name", "nickname", "preferred_username", "login"),
+		AvatarURL:     str("picture", "avatar_url", "avatar"),
+	}, nil
+}
+
+// getJSON 请求 JSON 接口，token 不为空时携带 Bearer 令牌
+func (p *provider) getJSON(ctx context.Context, token *oauth2.Token, endpoint string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if token != nil {
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("请求 %s 失败: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("请求 %s 返回状态码 %d", endpoint, resp.StatusCode)
+	}
+	return json.Unmarshal(body, out)
+}
+
+// --- OIDC Discovery ---
+
+type oidcMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+type cachedMetadata struct {
+	meta      *oidcMetadata
+	fetchedAt time.Time
+}
+
+// discoveryTTL Discovery 文档的缓存时间
+const discoveryTTL = time.Hour
+
+var discoveryCache sync.Map // issuer -> cachedMetadata
+
+// discover 获取并缓存 issuer 的 OpenID Provider Metadata
+func discover(ctx context.Context, httpClient *http.Client, issuer string) (*oidcMetadata, error) {
+	issuer = strings.TrimSuffix(issuer, "/")
+	if v, ok := discoveryCache.Load(issuer); ok {
+		cached := v.(cachedMetadata)
+		if time.Since(cached.fetchedAt) < discoveryTTL {
+			return cached.meta, nil
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("获取 OIDC 配置失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("获取 OIDC 配置失败，状态码 %d", resp.StatusCode)
+	}
+	var meta oidcMetadata
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&meta); err != nil {
+		return nil, fmt.Errorf("解析 OIDC 配置失败: %w", err)
+	}
+	if strings.TrimSuffix(meta.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("OIDC 配置中的 issuer %s 与配置不一致", meta.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" {
+		return nil, errors.New("OIDC 配置缺少授权或令牌端点")
+	}
+
+	discoveryCache.Store(issuer, cachedMetadata{meta: &meta, fetchedAt: time.Now()})
+	return &meta, nil
+}
+
+// --- ID Token ---
+
+type oidcClaims struct {
+	Issuer            string       `json:"iss"`
+	Subject           string       `json:"sub"`
+	Audience          audience     `json:"aud"`
+	Expiry            int64        `json:"exp"`
+	Nonce             string       `json:"nonce"`
+	Email             string       `json:"email"`
+	EmailVerified     flexibleBool `json:"email_verified"`
+	Name              string       `json:"name"`
+	PreferredUsername string       `json:"preferred_username"`
+	Picture           string       `json:"picture"`
+}
+
+// audience 兼容 aud 为字符串或字符串数组两种形式
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multi []string
+	if err := json.Unmarshal(data, &multi); err != nil {
+		return err
+	}
+	*a = multi
+	return nil
+}
+
+func (a audience) contains(v string) bool {
+	return slices.Contains(a, v)
+}
+
+// flexibleBool 兼容部分提供方把 email_verified 返回为字符串 "true"
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	*b = flexibleBool(s == "true")
+	return nil
+}
+
+// parseIDToken 解码 ID Token 的载荷部分
+func parseIDToken(raw string) (*oidcClaims, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("id_token 格式无效")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, errors.New("id_token 编码无效")
+	}
+	var claims oidcClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.New("id_token 载荷无效")
+	}
+	return &claims, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,154 @@
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// mockIssuer 是一个最小化的本地 OIDC 身份提供方，用于测试授权码 + PKCE 流程
+type mockIssuer struct {
+	server   *httptest.Server
+	clientID string
+	// 授权阶段记录的参数，令牌端点据此校验 PKCE 和 nonce
+	challenge string
+	nonce     string
+}
+
+func newMockIssuer(t *testing.T, clientID string) *mockIssuer {
+	t.Helper()
+	m := &mockIssuer{clientID: clientID}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.server.URL,
+			"authorization_endpoint": m.server.URL + "/authorize",
+			"token_endpoint":         m.server.URL + "/token",
+			"userinfo_endpoint":      m.server.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "bad form", http.StatusBadRequest)
+			return
+		}
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "test-code" ||
+			r.PostForm.Get("client_id") != m.clientID ||
+			base64.RawURLEncoding.EncodeToString(sum[:]) != m.challenge {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "test-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token": fakeIDToken(map[string]any{
+				"iss":            m.server.URL,
+				"sub":            "user-42",
+				"aud":            m.clientID,
+				"exp":            time.Now().Add(time.Hour).Unix(),
+				"nonce":          m.nonce,
+				"email":          "Alice@Example.com",
+				"email_verified": true,
+				"name":           "Alice",
+			}),
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"sub":     "user-42",
+			"picture": "https://example.com/alice.png",
+		})
+	})
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+// authorize 模拟用户在提供方处同意授权，记录 PKCE 挑战和 nonce
+func (m *mockIssuer) authorize(t *testing.T, authURL string) {
+	t.Helper()
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("解析授权地址失败: %v", err)
+	}
+	q := u.Query()
+	if q.Get("code_challenge_method") != "S256" {
+		t.Fatalf("期望使用 S256 PKCE，实际为 %q", q.Get("code_challenge_method"))
+	}
+	if q.Get("client_id") != m.clientID || q.Get("state") != "test-state" {
+		t.Fatalf("授权参数不正确: %s", u.RawQuery)
+	}
+	m.challenge = q.Get("code_challenge")
+	m.nonce = q.Get("nonce")
+}
+
+func fakeIDToken(claims map[string]any) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+	payload, _ := json.Marshal(claims)
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+func TestOIDCProviderAuthorizationCodeFlow(t *testing.T) {
+	issuer := newMockIssuer(t, "test-client")
+	cfg := ProviderConfig{ID: "mock", Type: ProviderTypeOIDC, ClientID: "test-client", ClientSecret: "secret", Issuer: issuer.server.URL}
+	ctx := context.Background()
+
+	p, err := newProvider(ctx, cfg, "https://blog.example.com/api/auth/oauth/mock/callback", issuer.server.Client())
+	if err != nil {
+		t.Fatalf("newProvider 失败: %v", err)
+	}
+
+	verifier := "test-verifier-0123456789-0123456789-0123456789"
+	issuer.authorize(t, p.authCodeURL("test-state", verifier, "test-nonce"))
+
+	identity, err := p.exchange(ctx, "test-code", verifier, "test-nonce")
+	if err != nil {
+		t.Fatalf("exchange 失败: %v", err)
+	}
+	if identity.Subject != "user-42" || identity.Email != "alice@example.com" || !identity.EmailVerified {
+		t.Fatalf("用户资料不正确: %+v", identity)
+	}
+	if identity.Name != "Alice" || identity.AvatarURL != "https://example.com/alice.png" {
+		t.Fatalf("未合并 UserInfo 资料: %+v", identity)
+	}
+
+	// 使用错误的 verifier 时令牌端点应拒绝
+	if _, err := p.exchange(ctx, "test-code", "wrong-verifier", "test-nonce"); err == nil {
+		t.Fatal("期望 PKCE 校验失败")
+	}
+	// nonce 不匹配时应拒绝 id_token
+	if _, err := p.exchange(ctx, "test-code", verifier, "other-nonce"); err == nil {
+		t.Fatal("期望 nonce 校验失败")
+	}
+}
+
+func TestSanitizeRedirect(t *testing.T) {
+	cases := map[string]string{
+		"/posts/1":            "/posts/1",
+		"":                    "/",
+		"https://evil.com":    "/",
+		"//evil.com":          "/",
+		"/\\evil.com":         "/",
+		"javascript:alert(1)": "/",
+	}
+	for in, want := range cases {
+		if got := sanitizeRedirect(in); got != want {
+			t.Errorf("sanitizeRedirect(%q) = %q, 期望 %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,438 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/utility"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// stateTTL 从跳转到提供方到回调返回的最长时间
+	stateTTL = 10 * time.Minute
+	// ticketTTL 回调完成后，前端用一次性凭据换取登录令牌的有效期
+	ticketTTL = 2 * time.Minute
+)
+
+// PublicProvider 是返回给前端的提供方信息，不包含密钥
+type PublicProvider struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Icon string `json:"icon,omitempty"`
+}
+
+// CallbackResult 是回调处理完成后交给前端的结果
+type CallbackResult struct {
+	Ticket   string // 一次性凭据，前端通过 /auth/oauth/exchange 换取登录令牌
+	Redirect string // 发起登录时指定的站内跳转地址
+}
+
+// Service 定义了第三方登录（OAuth2 / OIDC）的业务逻辑接口
+type Service interface {
+	// ListProviders 获取已启用的提供方列表
+	ListProviders() []PublicProvider
+	// AuthorizeURL 生成跳转到提供方的授权地址。linkUserID 不为 0 时表示为该用户绑定第三方账号
+	AuthorizeURL(ctx context.Context, providerID, redirect string, linkUserID uint) (string, error)
+	// HandleCallback 处理提供方的回调：校验 state、用授权码换取令牌并解析出对应的本站用户
+	HandleCallback(ctx context.Context, providerID, code, state string) (*CallbackResult, error)
+	// RedeemTicket 兑换一次性凭据，返回登录的用户；linked 为 true 表示本次为绑定操作
+	RedeemTicket(ctx context.Context, ticket string) (user *model.User, linked bool, err error)
+
+	ListIdentities(ctx context.Context, userID uint) ([]*model.UserIdentity, error)
+	Unlink(ctx context.Context, userID, identityID uint) error
+}
+
+type service struct {
+	identityRepo repository.UserIdentityRepository
+	userRepo     repository.UserRepository
+	authSvc      auth.AuthService
+	settingSvc   setting.SettingService
+	cacheSvc     utility.CacheService
+	httpClient   *http.Client
+}
+
+// NewService 是 service 的构造函数
+func NewService(
+	identityRepo repository.UserIdentityRepository,
+	userRepo repository.UserRepository,
+	authSvc auth.AuthService,
+	settingSvc setting.SettingService,
+	cacheSvc utility.CacheService,
+) Service {
+	return &service{
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		authSvc:      authSvc,
+		settingSvc:   settingSvc,
+		cacheSvc:     cacheSvc,
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// oauthState 是发起授权时写入缓存的状态
+type oauthState struct {
+	Provider   string `json:"provider"`
+	Verifier   string `json:"verifier"`
+	Nonce      string `json:"nonce"`
+	Redirect   string `json:"redirect"`
+	LinkUserID uint   `json:"link_user_id,omitempty"`
+}
+
+// oauthTicket 是回调完成后写入缓存的一次性凭据
+type oauthTicket struct {
+	UserID uint `json:"user_id"`
+	Linked bool `json:"linked"`
+}
+
+// ListProviders 实现 Service 接口
+func (s *service) ListProviders() []PublicProvider {
+	configs := s.loadConfigs()
+	list := make([]PublicProvider, 0, len(configs))
+	for _, cfg := range configs {
+		list = append(list, PublicProvider{ID: cfg.ID, Name: cfg.Name, Type: cfg.Type, Icon: cfg.Icon})
+	}
+	return list
+}
+
+// AuthorizeURL 实现 Service 接口
+func (s *service) AuthorizeURL(ctx context.Context, providerID, redirect string, linkUserID uint) (string, error) {
+	p, err := s.provider(ctx, providerID)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := randomString()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return "", err
+	}
+	st := oauthState{
+		Provider:   providerID,
+		Verifier:   oauth2.GenerateVerifier(),
+		Nonce:      nonce,
+		Redirect:   sanitizeRedirect(redirect),
+		LinkUserID: linkUserID,
+	}
+	data, err := json.Marshal(st)
+	if err != nil {
+		return "", err
+	}
+	if err := s.cacheSvc.Set(ctx, stateKey(state), string(data), stateTTL); err != nil {
+		return "", fmt.Errorf("保存第三方登录状态失败: %w", err)
+	}
+	return p.authCodeURL(state, st.Verifier, st.Nonce), nil
+}
+
+// HandleCallback 实现 Service 接口
+func (s *service) HandleCallback(ctx context.Context, providerID, code, state string) (*CallbackResult, error) {
+	if state == "" || code == "" {
+		return nil, constant.ErrOAuthStateInvalid
+	}
+	// state 只能使用一次
+	key := stateKey(state)
+	data, err := s.cacheSvc.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("读取第三方登录状态失败: %w", err)
+	}
+	if data == "" {
+		return nil, constant.ErrOAuthStateInvalid
+	}
+	_ = s.cacheSvc.Delete(ctx, key)
+
+	var st oauthState
+	if err := json.Unmarshal([]byte(data), &st); err != nil || st.Provider != providerID {
+		return nil, constant.ErrOAuthStateInvalid
+	}
+
+	p, err := s.provider(ctx, providerID)
+	if err != nil {
+		return nil, err
+	}
+	ext, err := p.exchange(ctx, code, st.Verifier, st.Nonce)
+	if err != nil {
+		log.Printf("[OAuth] 提供方 %s 授权码兑换失败: %v", providerID, err)
+		return nil, fmt.Errorf("第三方登录失败，请重试")
+	}
+
+	var user *model.User
+	if st.LinkUserID != 0 {
+		user, err = s.link(ctx, st.LinkUserID, providerID, ext)
+	} else {
+		user, err = s.resolveUser(ctx, providerID, ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+	ticketData, err := json.Marshal(oauthTicket{UserID: user.ID, Linked: st.LinkUserID != 0})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cacheSvc.Set(ctx, ticketKey(ticket), string(ticketData), ticketTTL); err != nil {
+		return nil, fmt.Errorf("保存第三方登录凭据失败: %w", err)
+	}
+	return &CallbackResult{Ticket: ticket, Redirect: st.Redirect}, nil
+}
+
+// RedeemTicket 实现 Service 接口
+func (s *service) RedeemTicket(ctx context.Context, ticket string) (*model.User, bool, error) {
+	if ticket == "" {
+		return nil, false, constant.ErrOAuthStateInvalid
+	}
+	key := ticketKey(ticket)
+	data, err := s.cacheSvc.Get(ctx, key)
+	if err != nil {
+		return nil, false, fmt.Errorf("读取第三方登录凭据失败: %w", err)
+	}
+	if data == "" {
+		return nil, false, constant.ErrOAuthStateInvalid
+	}
+	_ = s.cacheSvc.Delete(ctx, key)
+
+	var t oauthTicket
+	if err := json.Unmarshal([]byte(data), &t); err != nil {
+		return nil, false, constant.ErrOAuthStateInvalid
+	}
+	user, err := s.userRepo.FindByID(ctx, t.UserID)
+	if err != nil {
+		return nil, false, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil {
+		return nil, false, constant.ErrOAuthStateInvalid
+	}
+	if user.Status == model.UserStatusBanned {
+		return nil, false, fmt.Errorf("您的账户已被封禁，请联系管理员")
+	}
+	if !t.Linked {
+		now := time.Now()
+		user.LastLoginAt = &now
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			log.Printf("[OAuth] 警告：更新用户 %d 的最后登录时间失败: %v", user.ID, err)
+		}
+	}
+	return user, t.Linked, nil
+}
+
+// ListIdentities 实现 Service 接口
+func (s *service) ListIdentities(ctx context.Context, userID uint) ([]*model.UserIdentity, error) {
+	return s.identityRepo.ListByUserID(ctx, userID)
+}
+
+// Unlink 实现 Service 接口
+func (s *service) Unlink(ctx context.Context, userID, identityID uint) error {
+	identity, err := s.identityRepo.FindByID(ctx, identityID)
+	if err != nil {
+		return fmt.Errorf("查询第三方账号绑定失败: %w", err)
+	}
+	if identity == nil || identity.UserID != userID {
+		return constant.ErrOAuthIdentityNotFound
+	}
+	return s.identityRepo.Delete(ctx, identityID)
+}
+
+// resolveUser 根据第三方身份找到对应的本站用户：
+// 已绑定的直接登录；否则按提供方验证过的邮箱关联已有用户；都没有时自动注册。
+func (s *service) resolveUser(ctx context.Context, providerID string, ext *ExternalIdentity) (*model.User, error) {
+	identity, err := s.identityRepo.FindByProviderSubject(ctx, providerID, ext.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("查询第三方账号绑定失败: %w", err)
+	}
+	if identity != nil {
+		user, err := s.userRepo.FindByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("查询用户失败: %w", err)
+		}
+		if user == nil {
+			return nil, constant.ErrOAuthIdentityNotFound
+		}
+		if err := checkUserStatus(user); err != nil {
+			return nil, err
+		}
+		s.syncProfile(ctx, identity, ext)
+		return user, nil
+	}
+
+	if !ext.EmailVerified || ext.Email == "" {
+		return nil, constant.ErrOAuthEmailUnverified
+	}
+	email := strings.ToLower(strings.TrimSpace(ext.Email))
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil {
+		user, err = s.authSvc.RegisterExternalUser(ctx, email, ext.Name, ext.AvatarURL)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[OAuth] 用户 %s 通过 %s 完成注册", email, providerID)
+	} else {
+		if user.Status == model.UserStatusBanned {
+			return nil, fmt.Errorf("您的账户已被封禁，请联系管理员")
+		}
+		// 提供方已经验证过该邮箱，相当于完成了邮箱激活
+		if user.Status == model.UserStatusInactive {
+			user.Status = model.UserStatusActive
+			if err := s.userRepo.Update(ctx, user); err != nil {
+				return nil, fmt.Errorf("激活用户失败: %w", err)
+			}
+		}
+	}
+
+	if err := s.createIdentity(ctx, user.ID, providerID, ext); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// link 将第三方身份绑定到已登录的用户
+func (s *service) link(ctx context.Context, userID uint, providerID string, ext *ExternalIdentity) (*model.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil {
+		return nil, constant.ErrOAuthStateInvalid
+	}
+
+	identity, err := s.identityRepo.FindByProviderSubject(ctx, providerID, ext.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("查询第三方账号绑定失败: %w", err)
+	}
+	if identity != nil {
+		if identity.UserID != userID {
+			return nil, constant.ErrOAuthIdentityConflict
+		}
+		s.syncProfile(ctx, identity, ext)
+		return user, nil
+	}
+	if err := s.createIdentity(ctx, userID, providerID, ext); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *service) createIdentity(ctx context.Context, userID uint, providerID string, ext *ExternalIdentity) error {
+	now := time.Now()
+	identity := &model.UserIdentity{
+		UserID:      userID,
+		Provider:    providerID,
+		Subject:     ext.Subject,
+		Email:       ext.Email,
+		DisplayName: ext.Name,
+		AvatarURL:   ext.AvatarURL,
+		LastLoginAt: &now,
+	}
+	if err := s.identityRepo.Create(ctx, identity); err != nil {
+		return fmt.Errorf("保存第三方账号绑定失败: %w", err)
+	}
+	return nil
+}
+
+// syncProfile 同步提供方返回的最新资料，失败不影响登录
+func (s *service) syncProfile(ctx context.Context, identity *model.UserIdentity, ext *ExternalIdentity) {
+	now := time.Now()
+	identity.Email = ext.Email
+	identity.DisplayName = ext.Name
+	identity.AvatarURL = ext.AvatarURL
+	identity.LastLoginAt = &now
+	if err := s.identityRepo.UpdateProfile(ctx, identity); err != nil {
+		log.Printf("[OAuth] 警告：更新第三方账号 %d 的资料失败: %v", identity.ID, err)
+	}
+}
+
+// loadConfigs 从设置项 OAUTH_PROVIDERS 中读取已启用的提供方
+func (s *service) loadConfigs() []ProviderConfig {
+	raw := strings.TrimSpace(s.settingSvc.Get(constant.KeyOAuthProviders.String()))
+	if raw == "" {
+		return nil
+	}
+	var configs []ProviderConfig
+	if err := json.Unmarshal([]byte(raw), &configs); err != nil {
+		log.Printf("[OAuth] 警告：第三方登录配置 (OAUTH_PROVIDERS) 格式错误: %v", err)
+		return nil
+	}
+	enabled := configs[:0]
+	for _, cfg := range configs {
+		if cfg.ID != "" && cfg.IsEnabled() {
+			enabled = append(enabled, cfg)
+		}
+	}
+	return enabled
+}
+
+// provider 根据ID查找已启用的提供方并解析端点
+func (s *service) provider(ctx context.Context, providerID string) (*provider, error) {
+	for _, cfg := range s.loadConfigs() {
+		if cfg.ID != providerID {
+			continue
+		}
+		siteURL := strings.TrimRight(strings.TrimSpace(s.settingSvc.Get(constant.KeySiteURL.String())), "/")
+		if siteURL == "" {
+			return nil, fmt.Errorf("站点地址 (SITE_URL) 未配置，无法使用第三方登录")
+		}
+		return newProvider(ctx, cfg, CallbackURL(siteURL, providerID), s.httpClient)
+	}
+	return nil, constant.ErrOAuthProviderNotFound
+}
+
+// CallbackURL 返回需要在提供方处登记的回调地址
+func CallbackURL(siteURL, providerID string) string {
+	return strings.TrimRight(siteURL, "/") + "/api/auth/oauth/" + providerID + "/callback"
+}
+
+func checkUserStatus(user *model.User) error {
+	switch user.Status {
+	case model.UserStatusInactive:
+		return fmt.Errorf("您的账户尚未激活，请检查您的邮箱以完成激活流程")
+	case model.UserStatusBanned:
+		return fmt.Errorf("您的账户已被封禁，请联系管理员")
+	}
+	return nil
+}
+
+// sanitizeRedirect 只允许站内相对路径，防止开放重定向
+func sanitizeRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		return "/"
+	}
+	return redirect
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("生成随机数失败: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func stateKey(state string) string {
+	return "oauth:state:" + state
+}
+
+func ticketKey(ticket string) string {
+	return "oauth:ticket:" + ticket
+}
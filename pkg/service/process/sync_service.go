@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"path"
 	"path/filepath"
 	"strings"
 	"time"
@@ -206,6 +207,14 @@ func (s *syncService) SyncDirectory(ctx context.Context, ownerID uint, policy *m
 				continue
 			}
 
+			// 保护共享内容的文件：去重后内容存放在内容寻址目录中，文件自身的路径上本来就没有物理文件
+			if dbItem.File.Type == model.FileTypeFile {
+				entity, err := s.entityRepo.FindByID(ctx, uint(dbItem.File.PrimaryEntityID.Uint64))
+				if err == nil && entity != nil && entity.IsContentAddressed() {
+					continue
+				}
+			}
+
 			log.Printf("【SYNC DELETE】检测到存储中不存在 '%s'，将从数据库删除。", dbItem.File.Name)
 			err := s.txManager.Do(ctx, func(repos repository.Repositories) error {
 				return s.hardDeleteRecursively(ctx, ownerID, dbItem.File.ID, repos.File, repos.Entity, repos.FileEntity, repos.Metadata, repos.StoragePolicy, repos.DirectLink)
@@ -265,6 +274,12 @@ func (s *syncService) SyncDirectory(ctx context.Context, ownerID uint, policy *m
 						// 需要从 virtualPath 中计算出相对路径
 						relativePath := strings.TrimPrefix(virtualPath, policy.VirtualPath)
 						sourceValue = filepath.Join(policy.BasePath, relativePath, item.Name)
+					} else if policy.Type == constant.PolicyTypeSFTP {
+						// 对于SFTP策略，Source 是远程服务器上的路径（与Upload方法保持一致）
+						sourceValue = storage.SFTPRemotePath(policy, path.Join(virtualPath, item.Name))
+					} else if policy.Type == constant.PolicyTypeWebDAV {
+						// 对于WebDAV策略，Source 是WebDAV服务器上的路径（与Upload方法保持一致）
+						sourceValue = storage.WebDAVRemotePath(policy, path.Join(virtualPath, item.Name))
 					} else {
 						// 对于云存储策略，Source 是对象存储的键（与Upload方法保持一致）
 						// 计算相对路径
@@ -412,9 +427,16 @@ func (s *syncService) hardDeleteRecursively(
 		if err := txFileEntityRepo.DeleteByFileID(ctx, item.ID); err != nil {
 			return fmt.Errorf("删除文件版本关联 %d 失败: %w", item.ID, err)
 		}
-		log.Printf("【SYNC CLEANUP】...删除物理实体 (ID: %d)", entityID)
-		if err := txEntityRepo.HardDelete(ctx, entityID); err != nil {
-			log.Printf("警告: 硬删除实体 %d 失败 (可能已被删除): %v", entityID, err)
+		// 实体还被其他文件共享时只释放当前文件的引用
+		last, err := txEntityRepo.ReleaseReference(ctx, entityID)
+		if err != nil {
+			return err
+		}
+		if last {
+			log.Printf("【SYNC CLEANUP】...删除物理实体 (ID: %d)", entityID)
+			if err := txEntityRepo.HardDelete(ctx, entityID); err != nil {
+				log.Printf("警告: 硬删除实体 %d 失败 (可能已被删除): %v", entityID, err)
+			}
 		}
 	}
 	log.Printf("【SYNC CLEANUP】正在删除文件/目录 (ID: %d) 的元数据...", item.ID)
@@ -0,0 +1,312 @@
+// Package share 实现文件和文件夹的公开分享链接：分享者管理自己的分享，访问者通过链接浏览、预览和下载。
+package share
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+// guestGroupID 是匿名用户组的ID，未登录的访问者按该用户组的权限判断能否访问分享
+const guestGroupID = 3
+
+// previewableExts 是可以不占用下载次数在线预览的文件扩展名，只包含浏览器能直接内联展示且不会执行脚本的类型。
+// 其他类型只能下载，预览也就无法绕过仅预览限制和下载次数上限
+var previewableExts = map[string]bool{
+	// 图片（不含可以内嵌脚本的 SVG）
+	".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".webp": true, ".bmp": true, ".ico": true, ".avif": true,
+	// 音视频
+	".mp3": true, ".wav": true, ".ogg": true, ".flac": true, ".m4a": true, ".aac": true,
+	".mp4": true, ".webm": true, ".mov": true, ".m4v": true,
+	// 文档
+	".pdf": true, ".txt": true, ".md": true,
+}
+
+// isPreviewable 判断文件能否在分享中在线预览
+func isPreviewable(name string) bool {
+	return previewableExts[strings.ToLower(path.Ext(name))]
+}
+
+// Service 定义了文件分享的业务接口
+type Service interface {
+	// Create 为分享者自己的文件或文件夹创建分享链接
+	Create(ctx context.Context, ownerID uint, req *model.CreateShareRequest) (*model.ShareItem, error)
+	// List 分页获取分享者创建的分享链接
+	List(ctx context.Context, ownerID uint, page, pageSize int) ([]*model.ShareItem, int, error)
+	// Update 修改分享链接的密码、过期时间、下载次数上限和预览模式
+	Update(ctx context.Context, ownerID uint, publicID string, req *model.UpdateShareRequest) (*model.ShareItem, error)
+	// Delete 取消分享
+	Delete(ctx context.Context, ownerID uint, publicID string) error
+
+	// GetInfo 校验访问密码后返回分享的基本信息，并记录一次浏览
+	GetInfo(ctx context.Context, publicID, password string, viewerID uint) (*model.ShareInfo, error)
+	// PrepareDownload 校验分享后返回分享内 subPath 对应的文件。
+	// preview 为 false 时表示下载：仅预览的分享会被拒绝，并占用一次下载次数；
+	// preview 为 true 时不占用下载次数，只允许 isPreviewable 的文件类型。
+	PrepareDownload(ctx context.Context, publicID, password, subPath string, preview bool) (*model.File, error)
+	// GuestCanAccess 返回未登录的访问者是否可以访问分享
+	GuestCanAccess(ctx context.Context) bool
+}
+
+type service struct {
+	shareRepo     repository.ShareRepository
+	fileRepo      repository.FileRepository
+	userRepo      repository.UserRepository
+	userGroupRepo repository.UserGroupRepository
+	fileSvc       file_service.FileService
+	settingSvc    setting.SettingService
+}
+
+// NewService 是分享服务的构造函数
+func NewService(
+	shareRepo repository.ShareRepository,
+	fileRepo repository.FileRepository,
+	userRepo repository.UserRepository,
+	userGroupRepo repository.UserGroupRepository,
+	fileSvc file_service.FileService,
+	settingSvc setting.SettingService,
+) Service {
+	return &service{
+		shareRepo:     shareRepo,
+		fileRepo:      fileRepo,
+		userRepo:      userRepo,
+		userGroupRepo: userGroupRepo,
+		fileSvc:       fileSvc,
+		settingSvc:    settingSvc,
+	}
+}
+
+// Create 实现 Service 接口
+func (s *service) Create(ctx context.Context, ownerID uint, req *model.CreateShareRequest) (*model.ShareItem, error) {
+	fileID, entityType, err := idgen.DecodePublicID(req.FileID)
+	if err != nil || entityType != idgen.EntityTypeFile {
+		return nil, constant.ErrNotFound
+	}
+	file, err := s.fileRepo.FindByID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if file.OwnerID != ownerID {
+		return nil, constant.ErrForbidden
+	}
+	if !file.ParentID.Valid {
+		return nil, fmt.Errorf("不能分享根目录: %w", constant.ErrInvalidOperation)
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("过期时间必须晚于当前时间: %w", constant.ErrInvalidOperation)
+	}
+
+	share := &model.Share{
+		OwnerID:       ownerID,
+		FileID:        file.ID,
+		Password:      strings.TrimSpace(req.Password),
+		ExpiresAt:     req.ExpiresAt,
+		DownloadLimit: req.DownloadLimit,
+		PreviewOnly:   req.PreviewOnly,
+	}
+	if err := s.shareRepo.Create(ctx, share); err != nil {
+		return nil, fmt.Errorf("创建分享失败: %w", err)
+	}
+	return s.toShareItem(share, file), nil
+}
+
+// List 实现 Service 接口
+func (s *service) List(ctx context.Context, ownerID uint, page, pageSize int) ([]*model.ShareItem, int, error) {
+	shares, total, err := s.shareRepo.ListByOwner(ctx, ownerID, page, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询分享列表失败: %w", err)
+	}
+
+	fileIDs := make([]uint, 0, len(shares))
+	for _, share := range shares {
+		fileIDs = append(fileIDs, share.FileID)
+	}
+	files, err := s.fileRepo.FindBatchByIDs(ctx, fileIDs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询被分享的文件失败: %w", err)
+	}
+	fileMap := make(map[uint]*model.File, len(files))
+	for _, f := range files {
+		if f.DeletedAt == nil {
+			fileMap[f.ID] = f
+		}
+	}
+
+	items := make([]*model.ShareItem, 0, len(shares))
+	for _, share := range shares {
+		items = append(items, s.toShareItem(share, fileMap[share.FileID]))
+	}
+	return items, total, nil
+}
+
+// Update 实现 Service 接口
+func (s *service) Update(ctx context.Context, ownerID uint, publicID string, req *model.UpdateShareRequest) (*model.ShareItem, error) {
+	share, err := s.findOwned(ctx, ownerID, publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Password != nil {
+		share.Password = strings.TrimSpace(*req.Password)
+	}
+	if req.NeverExpire {
+		share.ExpiresAt = nil
+	} else if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(time.Now()) {
+			return nil, fmt.Errorf("过期时间必须晚于当前时间: %w", constant.ErrInvalidOperation)
+		}
+		share.ExpiresAt = req.ExpiresAt
+	}
+	if req.DownloadLimit != nil {
+		share.DownloadLimit = *req.DownloadLimit
+	}
+	if req.PreviewOnly != nil {
+		share.PreviewOnly = *req.PreviewOnly
+	}
+	if err := s.shareRepo.Update(ctx, share); err != nil {
+		return nil, fmt.Errorf("更新分享失败: %w", err)
+	}
+
+	file, err := s.fileRepo.FindByID(ctx, share.FileID)
+	if err != nil && !errors.Is(err, constant.ErrNotFound) {
+		return nil, err
+	}
+	return s.toShareItem(share, file), nil
+}
+
+// Delete 实现 Service 接口
+func (s *service) Delete(ctx context.Context, ownerID uint, publicID string) error {
+	share, err := s.findOwned(ctx, ownerID, publicID)
+	if err != nil {
+		return err
+	}
+	if err := s.shareRepo.Delete(ctx, share.ID); err != nil {
+		return fmt.Errorf("取消分享失败: %w", err)
+	}
+	return nil
+}
+
+// GetInfo 实现 Service 接口
+func (s *service) GetInfo(ctx context.Context, publicID, password string, viewerID uint) (*model.ShareInfo, error) {
+	share, file, err := s.fileSvc.ResolveShare(ctx, publicID, password, "/")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.shareRepo.IncrementViews(ctx, share.ID); err != nil {
+		log.Printf("[分享] 记录分享 %d 的浏览次数失败: %v", share.ID, err)
+	} else {
+		share.Views++
+	}
+
+	var ownerName string
+	if owner, err := s.userRepo.FindByID(ctx, share.OwnerID); err == nil && owner != nil {
+		ownerName = owner.Nickname
+	}
+
+	fileDTO := s.fileSvc.BuildFileItemDTO(file, viewerID, "/", "")
+	fileDTO.Path = file_service.ShareURI(publicID, "/")
+	return &model.ShareInfo{
+		ID:            publicID,
+		URI:           file_service.ShareURI(publicID, "/"),
+		Owner:         ownerName,
+		File:          fileDTO,
+		ExpiresAt:     share.ExpiresAt,
+		DownloadLimit: share.DownloadLimit,
+		Downloads:     share.Downloads,
+		Views:         share.Views,
+		PreviewOnly:   share.PreviewOnly,
+		CreatedAt:     share.CreatedAt,
+	}, nil
+}
+
+// PrepareDownload 实现 Service 接口
+func (s *service) PrepareDownload(ctx context.Context, publicID, password, subPath string, preview bool) (*model.File, error) {
+	share, file, err := s.fileSvc.ResolveShare(ctx, publicID, password, subPath)
+	if err != nil {
+		return nil, err
+	}
+	if file.Type != model.FileTypeFile {
+		return nil, fmt.Errorf("目标不是一个文件: %w", constant.ErrInvalidOperation)
+	}
+	if preview {
+		if !isPreviewable(file.Name) {
+			return nil, constant.ErrSharePreviewUnsupported
+		}
+		return file, nil
+	}
+	if share.PreviewOnly {
+		return nil, constant.ErrSharePreviewOnly
+	}
+
+	ok, err := s.shareRepo.IncrementDownloads(ctx, share.ID)
+	if err != nil {
+		return nil, fmt.Errorf("记录下载次数失败: %w", err)
+	}
+	if !ok {
+		return nil, constant.ErrShareUnavailable
+	}
+	return file, nil
+}
+
+// GuestCanAccess 实现 Service 接口
+func (s *service) GuestCanAccess(ctx context.Context) bool {
+	group, err := s.userGroupRepo.FindByID(ctx, guestGroupID)
+	if err != nil || group == nil {
+		return false
+	}
+	return group.Permissions.Enabled(model.PermissionAccessShare)
+}
+
+// findOwned 查找属于 ownerID 的分享，他人的分享按不存在处理
+func (s *service) findOwned(ctx context.Context, ownerID uint, publicID string) (*model.Share, error) {
+	id, entityType, err := idgen.DecodePublicID(publicID)
+	if err != nil || entityType != idgen.EntityTypeShare {
+		return nil, constant.ErrShareNotFound
+	}
+	share, err := s.shareRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("查询分享失败: %w", err)
+	}
+	if share == nil || share.OwnerID != ownerID {
+		return nil, constant.ErrShareNotFound
+	}
+	return share, nil
+}
+
+// toShareItem 组装分享者看到的分享信息，file 为 nil 表示被分享的项目已被删除
+func (s *service) toShareItem(share *model.Share, file *model.File) *model.ShareItem {
+	publicID, _ := idgen.GeneratePublicID(share.ID, idgen.EntityTypeShare)
+	siteURL := strings.TrimSuffix(s.settingSvc.Get(constant.KeySiteURL.String()), "/")
+	item := &model.ShareItem{
+		ID:            publicID,
+		URL:           fmt.Sprintf("%s/s/%s", siteURL, publicID),
+		URI:           file_service.ShareURI(publicID, "/"),
+		Password:      share.Password,
+		ExpiresAt:     share.ExpiresAt,
+		DownloadLimit: share.DownloadLimit,
+		Downloads:     share.Downloads,
+		Views:         share.Views,
+		PreviewOnly:   share.PreviewOnly,
+		Status:        share.Status(time.Now()),
+		CreatedAt:     share.CreatedAt,
+	}
+	if file == nil {
+		item.Status = model.ShareStatusInvalid
+		return item
+	}
+	item.FileID, _ = idgen.GeneratePublicID(file.ID, idgen.EntityTypeFile)
+	item.FileName = file.Name
+	item.FileType = int(file.Type)
+	return item
+}
@@ -19,6 +19,13 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+var (
+	// ErrCodeExpired 表示订阅验证码不存在或已过期
+	ErrCodeExpired = errors.New("验证码已过期或无效")
+	// ErrCodeMismatch 表示订阅验证码与发送的不一致
+	ErrCodeMismatch = errors.New("验证码错误")
+)
+
 // Service 订阅服务
 type Service struct {
 	db       *ent.Client
@@ -52,13 +59,13 @@ func (s *Service) Subscribe(ctx context.Context, email, code string) error {
 		savedCode, err := s.rdb.Get(ctx, key).Result()
 		if err != nil {
 			if err == redis.Nil {
-				return errors.New("验证码已过期或无效")
+				return ErrCodeExpired
 			}
 			log.Printf("[Subscriber.Subscribe] 获取验证码失败: %v", err)
 			return errors.New("系统错误，请稍后重试")
 		}
 		if savedCode != code {
-			return errors.New("验证码错误")
+			return ErrCodeMismatch
 		}
 		// 验证通过后删除验证码
 		s.rdb.Del(ctx, key)
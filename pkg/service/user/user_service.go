@@ -14,9 +14,13 @@ import (
 	"fmt"
 	"strings"
 
+	internal_auth "github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
 	"github.com/anzhiyu-c/anheyu-app/internal/pkg/security"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
 	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/audit"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
 )
 
 // UserService 定义了用户相关的业务逻辑接口
@@ -39,19 +43,24 @@ type UserService interface {
 
 	// 用户组管理方法
 	ListUserGroups(ctx context.Context) ([]*model.UserGroup, error)
+	SetUserGroupRequireTwoFactor(ctx context.Context, groupID uint, required bool) error
+	// AdminUpdateUserGroup 更新用户组的名称、描述、权限位、容量和下载限速，为 nil 的参数保持不变
+	AdminUpdateUserGroup(ctx context.Context, groupID uint, name, description *string, permissions []uint, maxStorage, speedLimit *int64) (*model.UserGroup, error)
 }
 
 // userService 是 UserService 接口的实现
 type userService struct {
 	userRepo      repository.UserRepository
 	userGroupRepo repository.UserGroupRepository
+	sessionSvc    auth.SessionService
 }
 
 // NewUserService 是 userService 的构造函数
-func NewUserService(userRepo repository.UserRepository, userGroupRepo repository.UserGroupRepository) UserService {
+func NewUserService(userRepo repository.UserRepository, userGroupRepo repository.UserGroupRepository, sessionSvc auth.SessionService) UserService {
 	return &userService{
 		userRepo:      userRepo,
 		userGroupRepo: userGroupRepo,
+		sessionSvc:    sessionSvc,
 	}
 }
 
@@ -107,7 +116,8 @@ func (s *userService) UpdateUserPassword(ctx context.Context, username, oldPassw
 		return fmt.Errorf("更新密码失败: %w", err)
 	}
 
-	return nil
+	// 5. 修改密码后撤销全部会话，其他设备需要使用新密码重新登录
+	return s.sessionSvc.RevokeAll(ctx, user.ID)
 }
 
 // UpdateUserPasswordByID 实现了根据用户ID修改密码的业务逻辑
@@ -138,7 +148,8 @@ func (s *userService) UpdateUserPasswordByID(ctx context.Context, userID uint, o
 		return fmt.Errorf("更新密码失败: %w", err)
 	}
 
-	return nil
+	// 5. 修改密码后撤销全部会话，其他设备需要使用新密码重新登录
+	return s.sessionSvc.RevokeAll(ctx, user.ID)
 }
 
 // UpdateUserProfile 实现了更新用户基本信息的业务逻辑
@@ -296,6 +307,9 @@ func (s *userService) AdminCreateUser(ctx context.Context, username, password, e
 	if err != nil {
 		return nil, fmt.Errorf("查询创建的用户失败: %w", err)
 	}
+	publicUserID, _ := idgen.GeneratePublicID(user.ID, idgen.EntityTypeUser)
+	audit.SetTarget(ctx, publicUserID, "创建用户 "+username)
+	audit.SetChange(ctx, nil, createdUser)
 
 	return createdUser, nil
 }
@@ -310,6 +324,7 @@ func (s *userService) AdminUpdateUser(ctx context.Context, userID uint, username
 	if user == nil {
 		return fmt.Errorf("用户不存在")
 	}
+	before := *user
 
 	// 2. 更新用户名（如果提供）
 	if username != nil && *username != user.Username {
@@ -360,6 +375,13 @@ func (s *userService) AdminUpdateUser(ctx context.Context, userID uint, username
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		return fmt.Errorf("更新用户失败: %w", err)
 	}
+	audit.SetTarget(ctx, "", "更新用户 "+user.Username)
+	audit.SetChange(ctx, before, user)
+
+	// 8. 封禁用户时立即撤销其全部会话
+	if status != nil && *status == model.UserStatusBanned {
+		return s.sessionSvc.RevokeAll(ctx, userID)
+	}
 
 	return nil
 }
@@ -379,10 +401,10 @@ func (s *userService) AdminDeleteUser(ctx context.Context, userID uint) error {
 	if err := s.userRepo.Delete(ctx, userID); err != nil {
 		return fmt.Errorf("删除用户失败: %w", err)
 	}
+	audit.SetTarget(ctx, "", "删除用户 "+user.Username)
+	audit.SetChange(ctx, user, nil)
 
-	return nil
-
-	return nil
+	return s.sessionSvc.RevokeAll(ctx, userID)
 }
 
 // AdminResetPassword 管理员重置用户密码
@@ -415,8 +437,10 @@ func (s *userService) AdminResetPassword(ctx context.Context, userID uint, newPa
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		return fmt.Errorf("重置密码失败: %w", err)
 	}
+	audit.SetTarget(ctx, "", "重置用户 "+user.Username+" 的密码")
 
-	return nil
+	// 5. 撤销全部会话，用户需要使用新密码重新登录
+	return s.sessionSvc.RevokeAll(ctx, userID)
 }
 
 // AdminUpdateUserStatus 管理员更新用户状态
@@ -436,10 +460,18 @@ func (s *userService) AdminUpdateUserStatus(ctx context.Context, userID uint, st
 	}
 
 	// 3. 更新状态
+	before := *user
 	user.Status = status
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		return fmt.Errorf("更新用户状态失败: %w", err)
 	}
+	audit.SetTarget(ctx, "", "更新用户 "+user.Username+" 的状态")
+	audit.SetChange(ctx, before, user)
+
+	// 4. 封禁用户时立即撤销其全部会话，已签发的访问令牌也随之失效
+	if status == model.UserStatusBanned {
+		return s.sessionSvc.RevokeAll(ctx, userID)
+	}
 
 	return nil
 }
@@ -452,3 +484,79 @@ func (s *userService) ListUserGroups(ctx context.Context) ([]*model.UserGroup, e
 	}
 	return groups, nil
 }
+
+// SetUserGroupRequireTwoFactor 设置用户组是否强制成员启用两步验证
+func (s *userService) SetUserGroupRequireTwoFactor(ctx context.Context, groupID uint, required bool) error {
+	group, err := s.userGroupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("查询用户组失败: %w", err)
+	}
+	if group == nil {
+		return fmt.Errorf("用户组不存在")
+	}
+
+	before := *group
+	group.Settings.RequireTwoFactor = required
+	if err := s.userGroupRepo.Save(ctx, group); err != nil {
+		return fmt.Errorf("更新用户组设置失败: %w", err)
+	}
+	audit.SetTarget(ctx, "", "设置用户组 "+group.Name+" 的两步验证要求")
+	audit.SetChange(ctx, before, group)
+	return nil
+}
+
+// AdminUpdateUserGroup 管理员更新用户组。权限位写入访问令牌，组内用户在令牌刷新后生效
+func (s *userService) AdminUpdateUserGroup(ctx context.Context, groupID uint, name, description *string, permissions []uint, maxStorage, speedLimit *int64) (*model.UserGroup, error) {
+	group, err := s.userGroupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户组失败: %w", err)
+	}
+	if group == nil {
+		return nil, fmt.Errorf("用户组不存在")
+	}
+	before := *group
+
+	if name != nil {
+		trimmed := strings.TrimSpace(*name)
+		if trimmed == "" {
+			return nil, fmt.Errorf("用户组名称不能为空")
+		}
+		group.Name = trimmed
+	}
+	if description != nil {
+		group.Description = strings.TrimSpace(*description)
+	}
+	if permissions != nil {
+		bs := model.Boolset{}
+		for _, p := range permissions {
+			if !model.IsValidPermission(p) {
+				return nil, fmt.Errorf("权限位 %d 不存在", p)
+			}
+			bs.Set(p, true)
+		}
+		// 内置管理员组必须保留管理员权限，避免所有人失去后台访问权限
+		if groupID == internal_auth.AdminGroupID && !bs.Enabled(model.PermissionAdmin) {
+			return nil, fmt.Errorf("不能移除管理员组的管理员权限")
+		}
+		group.Permissions = bs
+	}
+	if maxStorage != nil {
+		if *maxStorage < 0 {
+			return nil, fmt.Errorf("容量上限不能为负数")
+		}
+		group.MaxStorage = *maxStorage
+	}
+	if speedLimit != nil {
+		if *speedLimit < 0 {
+			return nil, fmt.Errorf("下载限速不能为负数")
+		}
+		group.SpeedLimit = *speedLimit
+	}
+
+	if err := s.userGroupRepo.Save(ctx, group); err != nil {
+		return nil, fmt.Errorf("更新用户组失败: %w", err)
+	}
+	audit.SetTarget(ctx, "", "编辑用户组 "+group.Name)
+	audit.SetChange(ctx, before, group)
+	return group, nil
+}
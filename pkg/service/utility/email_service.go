@@ -11,6 +11,7 @@ import (
 	"log"
 	"net"
 	"net/smtp"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +38,10 @@ type EmailService interface {
 	SendVerificationEmail(ctx context.Context, toEmail, code string) error
 	// SendArticlePushEmail 发送文章更新推送邮件
 	SendArticlePushEmail(ctx context.Context, toEmail, unsubscribeToken string, article *model.Article) error
+	// SendAccountLockedEmail 通知账户所有者账户因登录失败次数过多被临时锁定
+	SendAccountLockedEmail(ctx context.Context, toEmail, nickname, ip string, lockedUntil time.Time) error
+	// SendInvitationEmail 向受邀人发送注册邀请码，expiresAt 为空表示永不过期
+	SendInvitationEmail(ctx context.Context, toEmail, inviterName, code string, expiresAt *time.Time) error
 }
 
 // emailService 是 EmailService 接口的实现
@@ -629,6 +634,92 @@ func (s *emailService) SendVerificationEmail(ctx context.Context, toEmail, code
 	}
 }
 
+// SendAccountLockedEmail 发送账户锁定通知邮件
+func (s *emailService) SendAccountLockedEmail(ctx context.Context, toEmail, nickname, ip string, lockedUntil time.Time) error {
+	appName := s.settingSvc.Get(constant.KeyAppName.String())
+	siteURL := s.settingSvc.Get(constant.KeySiteURL.String())
+
+	// 🔧 处理 siteURL，确保有效
+	if siteURL == "" || siteURL == "https://" || siteURL == "http://" {
+		log.Printf("[WARNING] 站点URL未正确配置（当前值: %s），使用默认值 https://anheyu.com", siteURL)
+		siteURL = "https://anheyu.com"
+	}
+	siteURL = strings.TrimRight(siteURL, "/")
+	if ip == "" {
+		ip = "未知"
+	}
+
+	subject := fmt.Sprintf("【%s】您的账户已被临时锁定", appName)
+	body := fmt.Sprintf(`<div style="background-color:#f4f5f7;padding:30px 0;">
+	<div style="max-width:600px;margin:0 auto;background:#fff;border-radius:8px;overflow:hidden;box-shadow:0 2px 8px rgba(0,0,0,0.1);">
+		<div style="background:linear-gradient(135deg,#f5576c 0%%,#f093fb 100%%);padding:30px;text-align:center;">
+			<h1 style="color:#fff;margin:0;font-size:24px;">账户安全提醒</h1>
+		</div>
+		<div style="padding:30px;">
+			<p style="font-size:16px;line-height:1.8;color:#333;">%s，您好！</p>
+			<p style="font-size:14px;line-height:1.8;color:#666;">您在 <strong><a href="%s" style="color:#f5576c;text-decoration:none;">%s</a></strong> 的账户连续多次登录失败，为保护账户安全，已被临时锁定至 <strong>%s</strong>。</p>
+			<p style="font-size:14px;line-height:1.8;color:#666;">最近一次失败的登录来自IP：<strong>%s</strong></p>
+			<p style="font-size:14px;line-height:1.8;color:#666;">如果这是您本人的操作，请在锁定结束后重试，或通过“忘记密码”重置密码。如果不是您本人的操作，建议您在锁定结束后尽快修改密码并开启两步验证。</p>
+		</div>
+		<div style="background:#f8f9fa;padding:20px;text-align:center;color:#999;font-size:12px;">
+			<p style="margin:5px 0;">本邮件由系统自动发送，请勿直接回复</p>
+			<p style="margin:5px 0;">© %s</p>
+		</div>
+	</div>
+</div>`, template.HTMLEscapeString(nickname), siteURL, appName, lockedUntil.Format("2006-01-02 15:04:05"), template.HTMLEscapeString(ip), appName)
+
+	if err := s.send(toEmail, subject, body); err != nil {
+		return fmt.Errorf("发送账户锁定通知邮件失败: %w", err)
+	}
+	log.Printf("[INFO] 账户锁定通知邮件已发送到: %s", toEmail)
+	return nil
+}
+
+// SendInvitationEmail 发送注册邀请邮件
+func (s *emailService) SendInvitationEmail(ctx context.Context, toEmail, inviterName, code string, expiresAt *time.Time) error {
+	appName := s.settingSvc.Get(constant.KeyAppName.String())
+	siteURL := s.settingSvc.Get(constant.KeySiteURL.String())
+
+	// 🔧 处理 siteURL，确保有效
+	if siteURL == "" || siteURL == "https://" || siteURL == "http://" {
+		log.Printf("[WARNING] 站点URL未正确配置（当前值: %s），使用默认值 https://anheyu.com", siteURL)
+		siteURL = "https://anheyu.com"
+	}
+	siteURL = strings.TrimRight(siteURL, "/")
+	registerLink := fmt.Sprintf("%s/login?invite=%s", siteURL, url.QueryEscape(code))
+	expiresText := "永久有效"
+	if expiresAt != nil {
+		expiresText = "有效期至 " + expiresAt.Format("2006-01-02 15:04:05")
+	}
+
+	subject := fmt.Sprintf("【%s】%s 邀请您加入", appName, inviterName)
+	body := fmt.Sprintf(`<div style="background-color:#f4f5f7;padding:30px 0;">
+	<div style="max-width:600px;margin:0 auto;background:#fff;border-radius:8px;overflow:hidden;box-shadow:0 2px 8px rgba(0,0,0,0.1);">
+		<div style="background:linear-gradient(135deg,#667eea 0%%,#764ba2 100%%);padding:30px;text-align:center;">
+			<h1 style="color:#fff;margin:0;font-size:24px;">注册邀请</h1>
+		</div>
+		<div style="padding:30px;">
+			<p style="font-size:16px;line-height:1.8;color:#333;">您好！</p>
+			<p style="font-size:14px;line-height:1.8;color:#666;"><strong>%s</strong> 邀请您注册 <strong><a href="%s" style="color:#667eea;text-decoration:none;">%s</a></strong>。</p>
+			<p style="font-size:14px;line-height:1.8;color:#666;">您的邀请码（%s）：</p>
+			<p style="font-size:24px;letter-spacing:4px;text-align:center;color:#333;"><strong>%s</strong></p>
+			<p style="text-align:center;margin:30px 0;"><a href="%s" style="display:inline-block;padding:12px 30px;background:#667eea;color:#fff;text-decoration:none;border-radius:4px;">立即注册</a></p>
+			<p style="font-size:14px;line-height:1.8;color:#666;">请使用本邮箱地址注册。如果您不认识邀请人，请忽略这封邮件。</p>
+		</div>
+		<div style="background:#f8f9fa;padding:20px;text-align:center;color:#999;font-size:12px;">
+			<p style="margin:5px 0;">本邮件由系统自动发送，请勿直接回复</p>
+			<p style="margin:5px 0;">© %s</p>
+		</div>
+	</div>
+</div>`, template.HTMLEscapeString(inviterName), siteURL, appName, expiresText, template.HTMLEscapeString(code), registerLink, appName)
+
+	if err := s.send(toEmail, subject, body); err != nil {
+		return fmt.Errorf("发送注册邀请邮件失败: %w", err)
+	}
+	log.Printf("[INFO] 注册邀请邮件已发送到: %s", toEmail)
+	return nil
+}
+
 // SendArticlePushEmail 发送文章更新推送邮件
 func (s *emailService) SendArticlePushEmail(ctx context.Context, toEmail, unsubscribeToken string, article *model.Article) error {
 	appName := s.settingSvc.Get(constant.KeyAppName.String())
@@ -14,6 +14,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -118,6 +119,21 @@ func (s *storagePolicyService) CreatePolicy(ctx context.Context, ownerID uint, p
 		if cdnDomain, ok := policy.Settings["cdn_domain"].(string); !ok || cdnDomain == "" {
 			return errors.New("对于七牛云存储策略, settings.cdn_domain (访问域名) 是必填项")
 		}
+	case constant.PolicyTypeSFTP:
+		if policy.Server == "" || policy.AccessKey == "" || policy.BasePath == "" {
+			return errors.New("对于SFTP策略, server (主机:端口), access_key (用户名), 和 base_path (远程存储目录) 是必填项")
+		}
+		// 密码 (secret_key) 和私钥 (settings.private_key) 至少需要提供一种
+		if privateKey, _ := policy.Settings[constant.SFTPPrivateKeySettingKey].(string); policy.SecretKey == "" && privateKey == "" {
+			return errors.New("对于SFTP策略, secret_key (密码) 和 settings.private_key (私钥) 至少需要填写一项")
+		}
+	case constant.PolicyTypeWebDAV:
+		if policy.Server == "" || policy.BasePath == "" {
+			return errors.New("对于WebDAV策略, server (WebDAV地址) 和 base_path (远程存储目录) 是必填项")
+		}
+		if u, err := url.Parse(policy.Server); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			return errors.New("对于WebDAV策略, server 必须是以 http:// 或 https:// 开头的完整地址")
+		}
 	}
 
 	// 1c. 委托给策略处理器，验证 settings 内部的字段
@@ -0,0 +1,92 @@
+// pkg/service/volume/strategy/sftp.go
+package strategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// SFTPStrategy 实现了 IPolicyTypeStrategy 接口
+type SFTPStrategy struct{}
+
+// NewSFTPStrategy 是 SFTPStrategy 的构造函数
+func NewSFTPStrategy() IPolicyTypeStrategy {
+	return &SFTPStrategy{}
+}
+
+// ValidateSettings 验证SFTP策略的配置
+// 文件内容由服务端中转，只支持服务端上传；私钥需要能被正确解析，主机公钥指纹必须配置
+func (s *SFTPStrategy) ValidateSettings(settings map[string]interface{}) error {
+	if val, ok := settings[constant.UploadMethodSettingKey]; ok {
+		method, isString := val.(string)
+		if !isString {
+			return errors.New("settings 中的 'upload_method' 字段必须是字符串")
+		}
+		if method != constant.UploadMethodServer {
+			return fmt.Errorf("SFTP存储策略只支持 'server' 上传方式，当前值: %s", method)
+		}
+	}
+
+	var privateKey, passphrase string
+	if val, ok := settings[constant.SFTPPrivateKeySettingKey]; ok {
+		key, isString := val.(string)
+		if !isString {
+			return errors.New("settings 中的 'private_key' 字段必须是字符串")
+		}
+		privateKey = key
+	}
+	if val, ok := settings[constant.SFTPPrivateKeyPassphraseSettingKey]; ok {
+		p, isString := val.(string)
+		if !isString {
+			return errors.New("settings 中的 'private_key_passphrase' 字段必须是字符串")
+		}
+		passphrase = p
+	}
+	if privateKey != "" {
+		var err error
+		if passphrase != "" {
+			_, err = ssh.ParsePrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase))
+		} else {
+			_, err = ssh.ParsePrivateKey([]byte(privateKey))
+		}
+		if err != nil {
+			return fmt.Errorf("无法解析 SFTP 私钥: %w", err)
+		}
+	}
+
+	// 主机公钥指纹是必填项，否则无法防止中间人冒充服务器截获密码和文件内容
+	fingerprint, isString := settings[constant.SFTPHostKeyFingerprintSettingKey].(string)
+	if !isString || fingerprint == "" {
+		return errors.New("SFTP 存储策略必须在 settings 中配置 'host_key_fingerprint' 主机公钥指纹")
+	}
+	if !strings.HasPrefix(fingerprint, "SHA256:") {
+		return fmt.Errorf("主机公钥指纹必须是 'SHA256:' 开头的格式，当前值: %s", fingerprint)
+	}
+
+	if val, ok := settings[constant.SFTPMaxConnectionsSettingKey]; ok {
+		n, isNumber := val.(float64)
+		if !isNumber || n < 1 || n > 64 || n != float64(int(n)) {
+			return errors.New("settings 中的 'max_connections' 字段必须是 1 到 64 之间的整数")
+		}
+	}
+
+	return nil
+}
+
+// GetAuthHandler SFTP使用密码或私钥认证，不需要OAuth2流程，返回 nil
+func (s *SFTPStrategy) GetAuthHandler() IPolicyAuthHandler {
+	return nil
+}
+
+// BeforeDelete 在删除SFTP策略前执行的操作
+func (s *SFTPStrategy) BeforeDelete(ctx context.Context, policy *model.StoragePolicy) error {
+	// SFTP删除前无需特殊操作，远程文件由存储提供者清理
+	return nil
+}
@@ -0,0 +1,52 @@
+// pkg/service/volume/strategy/webdav.go
+package strategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// WebDAVStrategy 实现了 IPolicyTypeStrategy 接口
+type WebDAVStrategy struct{}
+
+// NewWebDAVStrategy 是 WebDAVStrategy 的构造函数
+func NewWebDAVStrategy() IPolicyTypeStrategy {
+	return &WebDAVStrategy{}
+}
+
+// ValidateSettings 验证WebDAV策略的配置
+// 文件内容由服务端中转，只支持服务端上传
+func (s *WebDAVStrategy) ValidateSettings(settings map[string]interface{}) error {
+	if val, ok := settings[constant.UploadMethodSettingKey]; ok {
+		method, isString := val.(string)
+		if !isString {
+			return errors.New("settings 中的 'upload_method' 字段必须是字符串")
+		}
+		if method != constant.UploadMethodServer {
+			return fmt.Errorf("WebDAV存储策略只支持 'server' 上传方式，当前值: %s", method)
+		}
+	}
+
+	if val, ok := settings[constant.WebDAVChunkedUploadSettingKey]; ok {
+		if _, isBool := val.(bool); !isBool {
+			return errors.New("settings 中的 'chunked_upload' 字段必须是布尔值")
+		}
+	}
+
+	return nil
+}
+
+// GetAuthHandler WebDAV使用Basic认证，不需要OAuth2流程，返回 nil
+func (s *WebDAVStrategy) GetAuthHandler() IPolicyAuthHandler {
+	return nil
+}
+
+// BeforeDelete 在删除WebDAV策略前执行的操作
+func (s *WebDAVStrategy) BeforeDelete(ctx context.Context, policy *model.StoragePolicy) error {
+	// WebDAV删除前无需特殊操作，远程文件由存储提供者清理
+	return nil
+}
@@ -14,7 +14,6 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"path/filepath"
 	"strings"
 	"time"
 
@@ -135,28 +134,7 @@ func (s *vfsService) GetFileReader(ctx context.Context, file *model.File) (io.Re
 		return nil, errors.New("不能为目录获取文件读取器")
 	}
 
-	// 1. 获取文件的完整虚拟路径，用于查找策略
-	//    注意：这一步仅用于定位策略，其结果不应作为物理路径使用。
-	fullVirtualPath, err := s.getFilePath(ctx, file)
-	if err != nil {
-		return nil, fmt.Errorf("无法确定文件ID %d 的虚拟路径: %w", file.ID, err)
-	}
-
-	// 2. 查找此虚拟路径对应的存储策略
-	policy, err := s.FindPolicyForPath(ctx, fullVirtualPath)
-	if err != nil {
-		return nil, err
-	}
-
-	// 3. 获取此策略对应的存储驱动
-	provider, err := s.getProviderForPolicy(policy)
-	if err != nil {
-		return nil, err
-	}
-
-	// 4. 确定要从存储驱动中获取的源(source)路径
-	//    核心修复：不再区分本地或远程策略，统一从数据库的实体记录中获取源路径。
-	//    这遵循了“数据库中存储的路径是可直接使用的”这一设计原则。
+	// 1. 获取文件关联的物理实体
 	if !file.PrimaryEntityID.Valid {
 		return nil, fmt.Errorf("文件 '%s' (ID: %d) 没有关联的物理实体", file.Name, file.ID)
 	}
@@ -170,7 +148,20 @@ func (s *vfsService) GetFileReader(ctx context.Context, file *model.File) (io.Re
 		return nil, fmt.Errorf("文件 '%s' 的物理实体(ID: %d)没有源路径信息", file.Name, entity.ID)
 	}
 
-	// 直接使用数据库中存储的路径
+	// 2. 使用实体所属的存储策略，而不是按虚拟路径匹配：
+	//    迁移到其他存储策略的文件虚拟路径不变，只有实体记录了内容的实际位置
+	policy, err := s.policySvc.GetPolicyByDatabaseID(ctx, entity.PolicyID)
+	if err != nil {
+		return nil, fmt.Errorf("查找文件 '%s' 的存储策略失败: %w", file.Name, err)
+	}
+
+	// 3. 获取此策略对应的存储驱动
+	provider, err := s.getProviderForPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 直接使用数据库中存储的路径
 	sourceToGet := entity.Source.String
 
 	//  添加调试日志，确认最终传递给 provider 的路径
@@ -180,41 +171,6 @@ func (s *vfsService) GetFileReader(ctx context.Context, file *model.File) (io.Re
 	return provider.Get(ctx, policy, sourceToGet)
 }
 
-// getFilePath 是一个私有辅助函数，用于获取文件的完整虚拟路径
-func (s *vfsService) getFilePath(ctx context.Context, file *model.File) (string, error) {
-	if !file.ParentID.Valid {
-		return "/" + file.Name, nil
-	}
-	parentPath, err := s.getFolderPath(ctx, uint(file.ParentID.Int64))
-	if err != nil {
-		return "", err
-	}
-	return filepath.ToSlash(filepath.Join(parentPath, file.Name)), nil
-}
-
-// getFolderPath 是一个私有辅助函数，用于递归获取文件夹的完整路径
-func (s *vfsService) getFolderPath(ctx context.Context, folderID uint) (string, error) {
-	var pathSegments []string
-	currentFolderID := folderID
-	for i := 0; i < 100; i++ { // 防止无限循环
-		folder, err := s.fileRepo.FindByIDUnscoped(ctx, currentFolderID)
-		if err != nil {
-			if errors.Is(err, constant.ErrNotFound) {
-				return "", fmt.Errorf("路径构建中断，找不到ID为 %d 的父文件夹", currentFolderID)
-			}
-			return "", fmt.Errorf("查找路径段时失败, ID: %d, err: %w", currentFolderID, err)
-		}
-		if folder.ParentID.Valid {
-			pathSegments = append([]string{folder.Name}, pathSegments...)
-			currentFolderID = uint(folder.ParentID.Int64)
-		} else {
-			// 到达根目录
-			return "/" + strings.Join(pathSegments, "/"), nil
-		}
-	}
-	return "", errors.New("目录层级过深或存在循环引用")
-}
-
 // getProviderForPolicy 是一个私有辅助函数，用于获取策略对应的存储驱动
 func (s *vfsService) getProviderForPolicy(policy *model.StoragePolicy) (storage.IStorageProvider, error) {
 	if policy == nil {
@@ -0,0 +1,449 @@
+package webdav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"time"
+
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+
+	xwebdav "golang.org/x/net/webdav"
+)
+
+var (
+	// ErrCopyFallback 表示本次复制无法直接映射为 FileService.CopyItems（例如复制时改名），
+	// 调用方应回退为逐个文件读写的复制方式
+	ErrCopyFallback = errors.New("复制需要回退为逐文件复制")
+	// ErrDestinationParentMissing 表示复制目标的父目录不存在，对应 WebDAV 的 409
+	ErrDestinationParentMissing = errors.New("目标父目录不存在")
+)
+
+// FileSystem 将单个用户的虚拟文件系统适配为 golang.org/x/net/webdav 的 FileSystem。
+// 读取经由 IVFSService 按路径解析的存储策略获取文件流，写入经由 IUploadService 完成，
+// 目录创建、删除、移动和复制则映射到 FileService 的对应操作，因此容量和权限校验与网页端一致。
+type FileSystem struct {
+	ownerID   uint
+	fileRepo  repository.FileRepository
+	fileSvc   file_service.FileService
+	uploadSvc file_service.IUploadService
+	vfsSvc    volume.IVFSService
+}
+
+var _ xwebdav.FileSystem = (*FileSystem)(nil)
+
+// Mkdir 对应 MKCOL，父目录必须已存在
+func (f *FileSystem) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	name = cleanPath(name)
+	if name == "/" {
+		return os.ErrExist
+	}
+	if _, err := f.find(ctx, path.Dir(name)); err != nil {
+		return err
+	}
+	_, err := f.fileSvc.CreateEmptyFile(ctx, f.ownerID, &model.CreateFileRequest{
+		URI:           fileURI(name),
+		Type:          int(model.FileTypeDir),
+		ErrOnConflict: true,
+	})
+	if errors.Is(err, constant.ErrConflict) {
+		return os.ErrExist
+	}
+	return err
+}
+
+// OpenFile 以只读方式打开时返回可按需拉取文件流的句柄；带写入标志时返回先写入临时文件、关闭时再上传的句柄
+func (f *FileSystem) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (xwebdav.File, error) {
+	name = cleanPath(name)
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC) == 0 {
+		file, err := f.find(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return &readFile{fs: f, ctx: ctx, file: file}, nil
+	}
+
+	if name == "/" {
+		return nil, os.ErrPermission
+	}
+	existing, err := f.find(ctx, name)
+	switch {
+	case err == nil:
+		if existing.Type == model.FileTypeDir {
+			return nil, os.ErrPermission
+		}
+		if flag&os.O_EXCL != 0 {
+			return nil, os.ErrExist
+		}
+	case errors.Is(err, os.ErrNotExist):
+		if flag&os.O_CREATE == 0 {
+			return nil, err
+		}
+		if _, err := f.find(ctx, path.Dir(name)); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	tmp, err := os.CreateTemp("", "anheyu-webdav-*")
+	if err != nil {
+		return nil, fmt.Errorf("创建临时文件失败: %w", err)
+	}
+	return &writeFile{fs: f, ctx: ctx, name: name, tmp: tmp}, nil
+}
+
+// RemoveAll 对应 DELETE，目录会被递归删除
+func (f *FileSystem) RemoveAll(ctx context.Context, name string) error {
+	name = cleanPath(name)
+	if name == "/" {
+		return os.ErrPermission
+	}
+	file, err := f.find(ctx, name)
+	if err != nil {
+		return err
+	}
+	publicID, err := idgen.GeneratePublicID(file.ID, idgen.EntityTypeFile)
+	if err != nil {
+		return err
+	}
+	return f.fileSvc.DeleteItems(ctx, f.ownerID, []string{publicID})
+}
+
+// Rename 对应 MOVE：跨目录时调用 MoveItems，名称变化时调用 RenameItem
+func (f *FileSystem) Rename(ctx context.Context, oldName, newName string) error {
+	oldName, newName = cleanPath(oldName), cleanPath(newName)
+	if oldName == "/" || newName == "/" {
+		return os.ErrPermission
+	}
+	src, err := f.find(ctx, oldName)
+	if err != nil {
+		return err
+	}
+	publicID, err := idgen.GeneratePublicID(src.ID, idgen.EntityTypeFile)
+	if err != nil {
+		return err
+	}
+
+	if path.Dir(oldName) != path.Dir(newName) {
+		destParent, err := f.find(ctx, path.Dir(newName))
+		if err != nil {
+			return err
+		}
+		destPublicID, err := idgen.GeneratePublicID(destParent.ID, idgen.EntityTypeFile)
+		if err != nil {
+			return err
+		}
+		if err := f.fileSvc.MoveItems(ctx, f.ownerID, []string{publicID}, destPublicID); err != nil {
+			return err
+		}
+	}
+	if newBase := path.Base(newName); newBase != src.Name {
+		if _, err := f.fileSvc.RenameItem(ctx, f.ownerID, &model.RenameItemRequest{ID: publicID, NewName: newBase}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stat 返回文件或目录的信息
+func (f *FileSystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	file, err := f.find(ctx, cleanPath(name))
+	if err != nil {
+		return nil, err
+	}
+	return newFileInfo(file), nil
+}
+
+// Copy 对应 COPY：目标与源同名时直接调用 FileService.CopyItems 完成整棵目录树的复制。
+// 目标改名时返回 ErrCopyFallback；created 表示目标原本不存在。
+func (f *FileSystem) Copy(ctx context.Context, src, dst string, overwrite bool) (created bool, err error) {
+	src, dst = cleanPath(src), cleanPath(dst)
+	if src == "/" || dst == "/" || path.Base(src) != path.Base(dst) {
+		return false, ErrCopyFallback
+	}
+	srcFile, err := f.find(ctx, src)
+	if err != nil {
+		return false, err
+	}
+	destParent, err := f.find(ctx, path.Dir(dst))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, ErrDestinationParentMissing
+		}
+		return false, err
+	}
+
+	created = true
+	if _, err := f.find(ctx, dst); err == nil {
+		if !overwrite {
+			return false, os.ErrExist
+		}
+		if err := f.RemoveAll(ctx, dst); err != nil {
+			return false, err
+		}
+		created = false
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	srcPublicID, err := idgen.GeneratePublicID(srcFile.ID, idgen.EntityTypeFile)
+	if err != nil {
+		return false, err
+	}
+	destPublicID, err := idgen.GeneratePublicID(destParent.ID, idgen.EntityTypeFile)
+	if err != nil {
+		return false, err
+	}
+	if err := f.fileSvc.CopyItems(ctx, f.ownerID, []string{srcPublicID}, destPublicID); err != nil {
+		return false, err
+	}
+	return created, nil
+}
+
+// find 按路径查找用户的文件，不存在时返回 os.ErrNotExist，便于 webdav 包映射为正确的状态码
+func (f *FileSystem) find(ctx context.Context, name string) (*model.File, error) {
+	if name == "/" || name == "." {
+		return f.fileRepo.FindOrCreateRootDirectory(ctx, f.ownerID)
+	}
+	file, err := f.fileRepo.FindByPath(ctx, f.ownerID, name)
+	if err != nil {
+		if errors.Is(err, constant.ErrNotFound) {
+			return nil, os.ErrNotExist
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+// upload 将临时文件的内容上传到 name，存储策略由 IVFSService 按路径解析
+func (f *FileSystem) upload(ctx context.Context, name string, content io.Reader, size int64) error {
+	policy, err := f.vfsSvc.FindPolicyForPath(ctx, name)
+	if err != nil {
+		return err
+	}
+	policyPublicID, err := idgen.GeneratePublicID(policy.ID, idgen.EntityTypeStoragePolicy)
+	if err != nil {
+		return err
+	}
+	return f.uploadSvc.UploadStream(ctx, f.ownerID, &model.CreateUploadRequest{
+		URI:       fileURI(name),
+		Size:      size,
+		PolicyID:  policyPublicID,
+		Overwrite: true,
+	}, content)
+}
+
+// readFile 是只读打开的文件或目录句柄。文件流在第一次读取时才打开，
+// Seek 到其他位置后会重新打开并跳过前面的内容，以支持 Range 请求
+type readFile struct {
+	fs     *FileSystem
+	ctx    context.Context
+	file   *model.File
+	offset int64
+
+	reader    io.ReadCloser
+	readerPos int64
+	listed    bool
+}
+
+func (r *readFile) Read(p []byte) (int, error) {
+	if r.file.Type == model.FileTypeDir {
+		return 0, os.ErrInvalid
+	}
+	if r.offset >= r.file.Size {
+		return 0, io.EOF
+	}
+	if r.reader == nil || r.readerPos != r.offset {
+		if err := r.openAt(r.offset); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.reader.Read(p)
+	r.offset += int64(n)
+	r.readerPos += int64(n)
+	return n, err
+}
+
+// openAt 重新打开文件流并跳过 offset 之前的内容
+func (r *readFile) openAt(offset int64) error {
+	if r.reader != nil {
+		_ = r.reader.Close()
+		r.reader = nil
+	}
+	reader, err := r.fs.vfsSvc.GetFileReader(r.ctx, r.file)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		if _, err := io.CopyN(io.Discard, reader, offset); err != nil {
+			_ = reader.Close()
+			return err
+		}
+	}
+	r.reader = reader
+	r.readerPos = offset
+	return nil
+}
+
+func (r *readFile) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.offset + offset
+	case io.SeekEnd:
+		abs = r.file.Size + offset
+	default:
+		return 0, os.ErrInvalid
+	}
+	if abs < 0 {
+		return 0, os.ErrInvalid
+	}
+	r.offset = abs
+	return abs, nil
+}
+
+func (r *readFile) Readdir(count int) ([]fs.FileInfo, error) {
+	if r.file.Type != model.FileTypeDir {
+		return nil, os.ErrInvalid
+	}
+	if r.listed {
+		if count > 0 {
+			return nil, io.EOF
+		}
+		return nil, nil
+	}
+	r.listed = true
+	children, err := r.fs.fileRepo.ListByParentID(r.ctx, r.file.ID)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]fs.FileInfo, 0, len(children))
+	for _, child := range children {
+		infos = append(infos, newFileInfo(child))
+	}
+	return infos, nil
+}
+
+func (r *readFile) Stat() (fs.FileInfo, error) {
+	return newFileInfo(r.file), nil
+}
+
+func (r *readFile) Write(p []byte) (int, error) {
+	return 0, os.ErrPermission
+}
+
+func (r *readFile) Close() error {
+	if r.reader != nil {
+		return r.reader.Close()
+	}
+	return nil
+}
+
+// writeFile 把客户端写入的内容暂存到本地临时文件，关闭时一次性上传，
+// 这样上传前就能知道文件大小，用于策略大小限制和容量校验
+type writeFile struct {
+	fs   *FileSystem
+	ctx  context.Context
+	name string
+	tmp  *os.File
+}
+
+func (w *writeFile) Write(p []byte) (int, error) {
+	return w.tmp.Write(p)
+}
+
+func (w *writeFile) Read(p []byte) (int, error) {
+	return 0, os.ErrPermission
+}
+
+func (w *writeFile) Seek(offset int64, whence int) (int64, error) {
+	return w.tmp.Seek(offset, whence)
+}
+
+func (w *writeFile) Readdir(count int) ([]fs.FileInfo, error) {
+	return nil, os.ErrInvalid
+}
+
+func (w *writeFile) Stat() (fs.FileInfo, error) {
+	info, err := w.tmp.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return &fileInfo{name: path.Base(w.name), size: info.Size(), modTime: info.ModTime()}, nil
+}
+
+func (w *writeFile) Close() error {
+	defer os.Remove(w.tmp.Name())
+	defer w.tmp.Close()
+
+	info, err := w.tmp.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err := w.tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return w.fs.upload(w.ctx, w.name, w.tmp, info.Size())
+}
+
+// fileInfo 实现 os.FileInfo，并提供基于扩展名的内容类型，避免 PROPFIND 时逐个读取文件内容来嗅探类型
+type fileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	isDir   bool
+}
+
+func newFileInfo(file *model.File) *fileInfo {
+	return &fileInfo{
+		name:    file.Name,
+		size:    file.Size,
+		modTime: file.UpdatedAt,
+		isDir:   file.Type == model.FileTypeDir,
+	}
+}
+
+func (i *fileInfo) Name() string       { return i.name }
+func (i *fileInfo) Size() int64        { return i.size }
+func (i *fileInfo) ModTime() time.Time { return i.modTime }
+func (i *fileInfo) IsDir() bool        { return i.isDir }
+func (i *fileInfo) Sys() any           { return nil }
+
+func (i *fileInfo) Mode() fs.FileMode {
+	if i.isDir {
+		return fs.ModeDir | 0755
+	}
+	return 0644
+}
+
+// ContentType 实现 webdav.ContentTyper
+func (i *fileInfo) ContentType(ctx context.Context) (string, error) {
+	if ctype := mime.TypeByExtension(path.Ext(i.name)); ctype != "" {
+		return ctype, nil
+	}
+	return "application/octet-stream", nil
+}
+
+// cleanPath 规范化 WebDAV 请求路径
+func cleanPath(name string) string {
+	return path.Clean("/" + name)
+}
+
+// fileURI 将虚拟路径转换为 anzhiyu://my 形式的 URI，路径中的特殊字符会被转义
+func fileURI(name string) string {
+	return (&url.URL{Scheme: "anzhiyu", Host: "my", Path: name}).String()
+}
@@ -0,0 +1,148 @@
+// Package webdav 将用户的虚拟文件系统以 WebDAV 协议暴露给 Finder、Windows 资源管理器和 rclone 等客户端。
+package webdav
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/anzhiyu-c/anheyu-app/internal/pkg/auth"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/idgen"
+	auth_service "github.com/anzhiyu-c/anheyu-app/pkg/service/auth"
+	file_service "github.com/anzhiyu-c/anheyu-app/pkg/service/file"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/volume"
+
+	xwebdav "golang.org/x/net/webdav"
+)
+
+// Service 定义了 WebDAV 访问所需的认证和文件系统接口
+type Service interface {
+	// Authenticate 校验 Basic Auth 凭据：用户名为邮箱，密码为账户密码或个人访问令牌。
+	// 启用了两步验证的账户只接受访问令牌
+	Authenticate(ctx context.Context, email, password, ip string) (*auth.CustomClaims, error)
+	// FileSystem 返回指定用户的文件系统视图
+	FileSystem(ownerID uint) *FileSystem
+	// LockSystem 返回指定用户独立的锁管理器，避免不同用户的同名路径互相影响
+	LockSystem(ownerID uint) xwebdav.LockSystem
+}
+
+type service struct {
+	authSvc        auth_service.AuthService
+	accessTokenSvc auth_service.AccessTokenService
+	twoFactorSvc   auth_service.TwoFactorService
+	userRepo       repository.UserRepository
+	fileRepo       repository.FileRepository
+	fileSvc        file_service.FileService
+	uploadSvc      file_service.IUploadService
+	vfsSvc         volume.IVFSService
+
+	lockSystems sync.Map // ownerID -> xwebdav.LockSystem
+}
+
+// NewService 是 WebDAV 服务的构造函数
+func NewService(
+	authSvc auth_service.AuthService,
+	accessTokenSvc auth_service.AccessTokenService,
+	twoFactorSvc auth_service.TwoFactorService,
+	userRepo repository.UserRepository,
+	fileRepo repository.FileRepository,
+	fileSvc file_service.FileService,
+	uploadSvc file_service.IUploadService,
+	vfsSvc volume.IVFSService,
+) Service {
+	return &service{
+		authSvc:        authSvc,
+		accessTokenSvc: accessTokenSvc,
+		twoFactorSvc:   twoFactorSvc,
+		userRepo:       userRepo,
+		fileRepo:       fileRepo,
+		fileSvc:        fileSvc,
+		uploadSvc:      uploadSvc,
+		vfsSvc:         vfsSvc,
+	}
+}
+
+// Authenticate 实现 Service 接口。
+// 每个请求都会重新校验凭据，令牌吊销、会话吊销、修改密码和封禁都会立即生效
+func (s *service) Authenticate(ctx context.Context, email, password, ip string) (*auth.CustomClaims, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if auth_service.IsAccessToken(password) {
+		return s.authenticateAccessToken(ctx, email, password, ip)
+	}
+	return s.authenticatePassword(ctx, email, password)
+}
+
+// authenticateAccessToken 校验访问令牌，并要求令牌属于用户名对应的账户
+func (s *service) authenticateAccessToken(ctx context.Context, email, token, ip string) (*auth.CustomClaims, error) {
+	claims, err := s.accessTokenSvc.Authenticate(ctx, token, ip)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	if user == nil {
+		return nil, constant.ErrAccessTokenInvalid
+	}
+	publicUserID, err := idgen.GeneratePublicID(user.ID, idgen.EntityTypeUser)
+	if err != nil {
+		return nil, fmt.Errorf("生成用户公共ID失败: %w", err)
+	}
+	if publicUserID != claims.UserID {
+		return nil, constant.ErrAccessTokenInvalid
+	}
+	return claims, nil
+}
+
+// authenticatePassword 使用账户密码登录，启用两步验证的账户不能仅凭密码访问
+func (s *service) authenticatePassword(ctx context.Context, email, password string) (*auth.CustomClaims, error) {
+	user, err := s.authSvc.Login(ctx, email, password)
+	if err != nil {
+		return nil, err
+	}
+	status, err := s.twoFactorSvc.Status(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if status.Enabled || status.Required {
+		return nil, constant.ErrWebDAVAppPasswordRequired
+	}
+
+	publicUserID, err := idgen.GeneratePublicID(user.ID, idgen.EntityTypeUser)
+	if err != nil {
+		return nil, fmt.Errorf("生成用户公共ID失败: %w", err)
+	}
+	publicGroupID, err := idgen.GeneratePublicID(user.UserGroup.ID, idgen.EntityTypeUserGroup)
+	if err != nil {
+		return nil, fmt.Errorf("生成用户组公共ID失败: %w", err)
+	}
+	return &auth.CustomClaims{
+		UserID:      publicUserID,
+		UserGroupID: publicGroupID,
+		Permissions: []byte(user.UserGroup.Permissions),
+	}, nil
+}
+
+// FileSystem 实现 Service 接口
+func (s *service) FileSystem(ownerID uint) *FileSystem {
+	return &FileSystem{
+		ownerID:   ownerID,
+		fileRepo:  s.fileRepo,
+		fileSvc:   s.fileSvc,
+		uploadSvc: s.uploadSvc,
+		vfsSvc:    s.vfsSvc,
+	}
+}
+
+// LockSystem 实现 Service 接口
+func (s *service) LockSystem(ownerID uint) xwebdav.LockSystem {
+	if ls, ok := s.lockSystems.Load(ownerID); ok {
+		return ls.(xwebdav.LockSystem)
+	}
+	ls, _ := s.lockSystems.LoadOrStore(ownerID, xwebdav.NewMemLS())
+	return ls.(xwebdav.LockSystem)
+}
@@ -0,0 +1,198 @@
+// pkg/service/webmention/sender.go
+package webmention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+)
+
+// NotifyArticlePublished 实现 Service 接口。
+// 提取文章正文中指向外部站点的链接，为每个链接派发一个可重试的发送任务。
+func (s *service) NotifyArticlePublished(article *model.Article) {
+	if article == nil || s.settingSvc.Get(constant.KeyWebmentionSendEnable.String()) != "true" {
+		return
+	}
+
+	siteURL, err := url.Parse(strings.TrimRight(strings.TrimSpace(s.settingSvc.Get(constant.KeySiteURL.String())), "/"))
+	if err != nil || siteURL.Host == "" {
+		log.Printf("[Webmention.NotifyArticlePublished] 站点URL未配置，跳过发送 Webmention")
+		return
+	}
+	source := siteURL.String() + articleTargetPath(article)
+
+	targets, err := outboundLinks(article.ContentHTML, siteURL)
+	if err != nil {
+		log.Printf("[Webmention.NotifyArticlePublished] 解析文章 %s 的正文失败: %v", article.ID, err)
+		return
+	}
+	if len(targets) == 0 {
+		return
+	}
+
+	log.Printf("[Webmention.NotifyArticlePublished] 文章 %s 包含 %d 个外链，准备发送 Webmention", article.ID, len(targets))
+	for _, target := range targets {
+		s.broker.DispatchWithRetry("WebmentionSend "+target, maxAttempts, func() error {
+			return s.send(context.Background(), source, target)
+		})
+	}
+}
+
+// send 发现 target 的 Webmention 端点并发送通知。
+// 对方不支持 Webmention 或明确拒绝（4xx）时不再重试。
+func (s *service) send(ctx context.Context, source, target string) error {
+	endpoint, err := s.discoverEndpoint(ctx, target)
+	if errors.Is(err, errPermanent) {
+		log.Printf("[Webmention.send] 跳过 %s: %v", target, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if endpoint == "" {
+		return nil
+	}
+
+	form := url.Values{"source": {source}, "target": {target}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		log.Printf("[Webmention.send] %s 的端点 %s 无效: %v", target, endpoint, err)
+		return nil
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		if errors.Is(err, errForbiddenAddress) {
+			log.Printf("[Webmention.send] 跳过 %s: %v", target, err)
+			return nil
+		}
+		return fmt.Errorf("向 %s 发送 Webmention 失败: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxBodySize))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		log.Printf("[Webmention.send] 已向 %s 发送 Webmention，状态码: %d", target, resp.StatusCode)
+		return nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		log.Printf("[Webmention.send] %s 拒绝了 Webmention，状态码: %d", endpoint, resp.StatusCode)
+		return nil
+	default:
+		return fmt.Errorf("端点 %s 返回状态码 %d", endpoint, resp.StatusCode)
+	}
+}
+
+// discoverEndpoint 按规范发现 target 声明的 Webmention 端点：
+// 优先使用 HTTP Link 头，其次是文档中第一个 rel="webmention" 的 <link> 或 <a>。未声明时返回空字符串。
+func (s *service) discoverEndpoint(ctx context.Context, target string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", errPermanent, err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.1")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		if errors.Is(err, errForbiddenAddress) {
+			return "", fmt.Errorf("%w: %v", errPermanent, err)
+		}
+		return "", fmt.Errorf("请求 %s 失败: %w", target, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		return "", fmt.Errorf("%w: 返回状态码 %d", errPermanent, resp.StatusCode)
+	}
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("请求 %s 返回状态码 %d", target, resp.StatusCode)
+	}
+
+	// 相对地址以重定向后的最终地址为基准解析
+	base := resp.Request.URL
+	for _, value := range resp.Header.Values("Link") {
+		if href, ok := parseLinkHeader(value); ok {
+			return resolveEndpoint(base, href), nil
+		}
+	}
+
+	if !strings.Contains(resp.Header.Get("Content-Type"), "html") {
+		return "", nil
+	}
+	doc, err := goquery.NewDocumentFromReader(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return "", fmt.Errorf("解析 %s 失败: %w", target, err)
+	}
+	if href, ok := doc.Find(`link[rel~="webmention"][href], a[rel~="webmention"][href]`).First().Attr("href"); ok {
+		return resolveEndpoint(base, href), nil
+	}
+	return "", nil
+}
+
+// resolveEndpoint 解析端点地址，空的 href 表示端点就是页面本身
+func resolveEndpoint(base *url.URL, href string) string {
+	if strings.TrimSpace(href) == "" {
+		return base.String()
+	}
+	return resolveURL(base, href)
+}
+
+// parseLinkHeader 从一个 Link 头中找出 rel 包含 webmention 的地址
+func parseLinkHeader(header string) (string, bool) {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		href := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(href, "<") || !strings.HasSuffix(href, ">") {
+			continue
+		}
+		for _, param := range parts[1:] {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(key), "rel") {
+				continue
+			}
+			for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(value), `"`)) {
+				if strings.EqualFold(rel, "webmention") {
+					return strings.TrimSuffix(strings.TrimPrefix(href, "<"), ">"), true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// outboundLinks 提取正文中指向其它站点的 http/https 链接，按出现顺序去重
+func outboundLinks(contentHTML string, siteURL *url.URL) ([]string, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(contentHTML))
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{})
+	var links []string
+	doc.Find("a[href]").Each(func(_ int, sel *goquery.Selection) {
+		href, _ := sel.Attr("href")
+		u, err := parseHTTPURL(href)
+		if err != nil || strings.EqualFold(u.Host, siteURL.Host) {
+			return
+		}
+		link := normalizeURL(u)
+		if _, ok := seen[link]; ok {
+			return
+		}
+		seen[link] = struct{}{}
+		links = append(links, link)
+	})
+	return links, nil
+}
@@ -0,0 +1,420 @@
+// pkg/service/webmention/service.go
+package webmention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/anzhiyu-c/anheyu-app/pkg/constant"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/model"
+	"github.com/anzhiyu-c/anheyu-app/pkg/domain/repository"
+	"github.com/anzhiyu-c/anheyu-app/pkg/service/setting"
+)
+
+const (
+	// maxAttempts 校验和发送任务的最大尝试次数
+	maxAttempts = 5
+	// maxBodySize 抓取远程页面时读取的最大字节数
+	maxBodySize = 2 << 20
+	// maxContentLength 保存的回复摘要最大字符数
+	maxContentLength = 500
+	// userAgent 抓取和发送时使用的 User-Agent
+	userAgent = "anheyu-app Webmention (+https://www.w3.org/TR/webmention/)"
+)
+
+// errPermanent 表示不可重试的失败，任务遇到该错误时直接结束
+var errPermanent = errors.New("webmention: permanent failure")
+
+// TaskBroker 定义任务调度器的接口，用于解耦循环依赖。
+type TaskBroker interface {
+	DispatchWithRetry(name string, maxAttempts int, fn func() error)
+}
+
+// Service 定义了 Webmention 的业务接口
+type Service interface {
+	// Receive 接收一条 Webmention，校验参数后保存为待校验状态，并派发后台校验任务
+	Receive(ctx context.Context, source, target string) error
+	// ListByPath 返回指定文章路径下所有已校验通过的 Webmention
+	ListByPath(ctx context.Context, targetPath string) ([]*model.Webmention, error)
+	// NotifyArticlePublished 在文章发布后向文中外链所在站点发送 Webmention
+	NotifyArticlePublished(article *model.Article)
+
+	List(ctx context.Context, query *model.WebmentionListQuery) (*repository.PageResult[model.Webmention], error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type service struct {
+	repo        repository.WebmentionRepository
+	articleRepo repository.ArticleRepository
+	settingSvc  setting.SettingService
+	broker      TaskBroker
+	httpClient  *http.Client
+}
+
+// NewService 创建 Webmention 服务实例
+func NewService(
+	repo repository.WebmentionRepository,
+	articleRepo repository.ArticleRepository,
+	settingSvc setting.SettingService,
+	broker TaskBroker,
+) Service {
+	return &service{
+		repo:        repo,
+		articleRepo: articleRepo,
+		settingSvc:  settingSvc,
+		broker:      broker,
+		httpClient:  newSafeHTTPClient(),
+	}
+}
+
+// Receive 实现 Service 接口
+func (s *service) Receive(ctx context.Context, source, target string) error {
+	if s.settingSvc.Get(constant.KeyWebmentionReceiveEnable.String()) != "true" {
+		return constant.ErrWebmentionDisabled
+	}
+
+	sourceURL, err := parseHTTPURL(source)
+	if err != nil {
+		return fmt.Errorf("source 参数无效: %w", err)
+	}
+	targetURL, err := parseHTTPURL(target)
+	if err != nil {
+		return fmt.Errorf("target 参数无效: %w", err)
+	}
+	if normalizeURL(sourceURL) == normalizeURL(targetURL) {
+		return errors.New("source 与 target 不能相同")
+	}
+
+	targetPath, err := s.resolveTargetPath(ctx, targetURL)
+	if err != nil {
+		return err
+	}
+
+	mention, err := s.repo.FindBySourceAndTarget(ctx, source, target)
+	if err != nil {
+		return fmt.Errorf("查询 Webmention 失败: %w", err)
+	}
+	if mention == nil {
+		mention = &model.Webmention{
+			Source:     source,
+			Target:     target,
+			TargetPath: targetPath,
+			Type:       model.WebmentionTypeMention,
+			Status:     model.WebmentionStatusPending,
+		}
+		if err := s.repo.Create(ctx, mention); err != nil {
+			return fmt.Errorf("保存 Webmention 失败: %w", err)
+		}
+	} else {
+		// 来源页更新后重新发送的 Webmention，保留已有状态直到重新校验完成
+		mention.TargetPath = targetPath
+		if err := s.repo.Update(ctx, mention); err != nil {
+			return fmt.Errorf("更新 Webmention 失败: %w", err)
+		}
+	}
+
+	mentionID := mention.ID
+	s.broker.DispatchWithRetry(fmt.Sprintf("WebmentionVerify#%d", mentionID), maxAttempts, func() error {
+		return s.verify(context.Background(), mentionID)
+	})
+	return nil
+}
+
+// resolveTargetPath 确认 target 指向本站一篇已发布的文章，并返回与评论一致的 target_path
+func (s *service) resolveTargetPath(ctx context.Context, targetURL *url.URL) (string, error) {
+	siteURL, err := url.Parse(strings.TrimSpace(s.settingSvc.Get(constant.KeySiteURL.String())))
+	if err != nil || siteURL.Host == "" {
+		return "", errors.New("站点URL未配置，无法接收 Webmention")
+	}
+	if !strings.EqualFold(siteURL.Host, targetURL.Host) {
+		return "", errors.New("target 不属于本站")
+	}
+
+	slug, ok := strings.CutPrefix(targetURL.Path, "/posts/")
+	slug = strings.Trim(slug, "/")
+	if !ok || slug == "" || strings.Contains(slug, "/") {
+		return "", errors.New("target 不是本站的文章地址")
+	}
+
+	article, err := s.articleRepo.GetBySlugOrID(ctx, slug)
+	if err != nil || article == nil || article.Status != "PUBLISHED" {
+		return "", errors.New("target 对应的文章不存在")
+	}
+	return articleTargetPath(article), nil
+}
+
+// verify 抓取来源页，确认其链接到了目标文章，并解析 h-entry 中的作者和互动类型。
+// 返回 error 表示可重试的失败（如网络错误），来源页不存在或未链接目标则直接标记为 rejected。
+func (s *service) verify(ctx context.Context, id uint) error {
+	mention, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("[Webmention.verify] 查询 Webmention %d 失败，可能已被删除: %v", id, err)
+		return nil
+	}
+
+	doc, err := s.fetchSource(ctx, mention.Source)
+	if errors.Is(err, errPermanent) {
+		log.Printf("[Webmention.verify] 来源页 %s 不可用，标记为 rejected: %v", mention.Source, err)
+		return s.reject(ctx, mention)
+	}
+	if err != nil {
+		return err
+	}
+
+	sourceURL, _ := url.Parse(mention.Source)
+	links := findLinks(doc, sourceURL, mention.Target)
+	if links.Length() == 0 {
+		log.Printf("[Webmention.verify] 来源页 %s 未链接到 %s，标记为 rejected", mention.Source, mention.Target)
+		return s.reject(ctx, mention)
+	}
+
+	parseEntry(doc, sourceURL, links, mention)
+	now := time.Now()
+	mention.Status = model.WebmentionStatusVerified
+	mention.VerifiedAt = &now
+	if err := s.repo.Update(ctx, mention); err != nil {
+		return fmt.Errorf("保存校验结果失败: %w", err)
+	}
+	log.Printf("[Webmention.verify] 来源页 %s 校验通过，类型: %s", mention.Source, mention.Type)
+	return nil
+}
+
+func (s *service) reject(ctx context.Context, mention *model.Webmention) error {
+	mention.Status = model.WebmentionStatusRejected
+	if err := s.repo.Update(ctx, mention); err != nil {
+		return fmt.Errorf("保存校验结果失败: %w", err)
+	}
+	return nil
+}
+
+// fetchSource 抓取并解析来源页。4xx 视为永久失败，5xx 和网络错误可重试。
+func (s *service) fetchSource(ctx context.Context, source string) (*goquery.Document, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errPermanent, err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.1")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		if errors.Is(err, errForbiddenAddress) {
+			return nil, fmt.Errorf("%w: %v", errPermanent, err)
+		}
+		return nil, fmt.Errorf("请求来源页失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		return nil, fmt.Errorf("%w: 来源页返回状态码 %d", errPermanent, resp.StatusCode)
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("来源页返回状态码 %d", resp.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("解析来源页失败: %w", err)
+	}
+	return doc, nil
+}
+
+// ListByPath 实现 Service 接口
+func (s *service) ListByPath(ctx context.Context, targetPath string) ([]*model.Webmention, error) {
+	return s.repo.FindVerifiedByPath(ctx, targetPath)
+}
+
+// List 实现 Service 接口
+func (s *service) List(ctx context.Context, query *model.WebmentionListQuery) (*repository.PageResult[model.Webmention], error) {
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.PageSize <= 0 || query.PageSize > 100 {
+		query.PageSize = 20
+	}
+	return s.repo.List(ctx, query)
+}
+
+// Delete 实现 Service 接口
+func (s *service) Delete(ctx context.Context, id uint) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// findLinks 查找来源页中所有指向 target 的元素
+func findLinks(doc *goquery.Document, base *url.URL, target string) *goquery.Selection {
+	targetURL, err := url.Parse(target)
+	if err != nil {
+		return doc.Selection.Slice(0, 0)
+	}
+	want := strings.TrimSuffix(normalizeURL(targetURL), "/")
+	return doc.Find("a[href], link[href], area[href]").FilterFunction(func(_ int, sel *goquery.Selection) bool {
+		href, _ := sel.Attr("href")
+		resolved := resolveURL(base, href)
+		if resolved == "" {
+			return false
+		}
+		u, err := url.Parse(resolved)
+		if err != nil {
+			return false
+		}
+		return strings.TrimSuffix(normalizeURL(u), "/") == want
+	})
+}
+
+// parseEntry 解析来源页的 h-entry，填充互动类型、作者和内容摘要
+func parseEntry(doc *goquery.Document, base *url.URL, links *goquery.Selection, mention *model.Webmention) {
+	mention.Type = model.WebmentionTypeMention
+	links.EachWithBreak(func(_ int, sel *goquery.Selection) bool {
+		switch {
+		case sel.HasClass("u-in-reply-to"):
+			mention.Type = model.WebmentionTypeReply
+		case sel.HasClass("u-like-of"):
+			mention.Type = model.WebmentionTypeLike
+		case sel.HasClass("u-repost-of"):
+			mention.Type = model.WebmentionTypeRepost
+		default:
+			return true
+		}
+		return false
+	})
+
+	entry := doc.Find(".h-entry").First()
+	if entry.Length() == 0 {
+		entry = doc.Selection
+	}
+
+	author := entry.Find(".p-author").First()
+	if author.Length() == 0 {
+		author = doc.Find(".h-card").First()
+	}
+	mention.AuthorName, mention.AuthorURL, mention.AuthorPhoto = "", "", ""
+	if author.Length() > 0 {
+		if name := author.Find(".p-name").First(); name.Length() > 0 {
+			mention.AuthorName = truncate(strings.TrimSpace(name.Text()), 100)
+		} else if !author.HasClass("h-card") {
+			mention.AuthorName = truncate(strings.TrimSpace(author.Text()), 100)
+		}
+		if href, ok := author.Attr("href"); ok {
+			mention.AuthorURL = resolveURL(base, href)
+		} else if href, ok := author.Find(".u-url").First().Attr("href"); ok {
+			mention.AuthorURL = resolveURL(base, href)
+		}
+		if src, ok := author.Find(".u-photo").First().Attr("src"); ok {
+			mention.AuthorPhoto = resolveURL(base, src)
+		}
+	}
+
+	content := entry.Find(".e-content, .p-content").First()
+	if content.Length() == 0 {
+		content = entry.Find(".p-summary").First()
+	}
+	if content.Length() == 0 {
+		content = doc.Find("title").First()
+	}
+	mention.Content = truncate(strings.Join(strings.Fields(content.Text()), " "), maxContentLength)
+
+	mention.PublishedAt = nil
+	if published, ok := entry.Find(".dt-published").First().Attr("datetime"); ok {
+		if t, err := time.Parse(time.RFC3339, published); err == nil {
+			mention.PublishedAt = &t
+		}
+	}
+}
+
+// articleTargetPath 构造文章的评论路径：优先使用 abbrlink，如果没有则使用公共ID
+func articleTargetPath(article *model.Article) string {
+	if article.Abbrlink != "" {
+		return "/posts/" + article.Abbrlink
+	}
+	return "/posts/" + article.ID
+}
+
+func parseHTTPURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.New("仅支持 http/https 地址")
+	}
+	return u, nil
+}
+
+// normalizeURL 去掉锚点，并将协议和主机名转为小写
+func normalizeURL(u *url.URL) string {
+	n := *u
+	n.Fragment = ""
+	n.Scheme = strings.ToLower(n.Scheme)
+	n.Host = strings.ToLower(n.Host)
+	return n.String()
+}
+
+func resolveURL(base *url.URL, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return ""
+	}
+	u, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+	if base != nil {
+		u = base.ResolveReference(u)
+	}
+	return u.String()
+}
+
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n]) + "…"
+}
+
+// errForbiddenAddress 表示目标地址解析到了内网，拒绝访问以防止 SSRF
+var errForbiddenAddress = errors.New("禁止访问内网地址")
+
+// newSafeHTTPClient 创建抓取远程页面用的 HTTP 客户端。
+// Webmention 的 source 和外链端点都来自外部输入，因此在建立连接时拒绝回环、内网和链路本地地址。
+func newSafeHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() ||
+				ip.IsLinkLocalMulticast() || ip.IsUnspecified() || ip.IsMulticast() {
+				return fmt.Errorf("%w: %s", errForbiddenAddress, host)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   20 * time.Second,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("重定向次数过多")
+			}
+			return nil
+		},
+	}
+}